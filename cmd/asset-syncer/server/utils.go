@@ -472,13 +472,14 @@ func pullAndExtract(repoURL *url.URL, appName, tag string, puller helm.ChartPull
 
 	// Format Data
 	chartVersion := models.ChartVersion{
-		Version:    chartMetadata.Version,
-		AppVersion: chartMetadata.AppVersion,
-		Digest:     digest,
-		URLs:       chartMetadata.Sources,
-		Readme:     files.Readme,
-		Values:     files.Values,
-		Schema:     files.Schema,
+		Version:     chartMetadata.Version,
+		AppVersion:  chartMetadata.AppVersion,
+		KubeVersion: chartMetadata.KubeVersion,
+		Digest:      digest,
+		URLs:        chartMetadata.Sources,
+		Readme:      files.Readme,
+		Values:      files.Values,
+		Schema:      files.Schema,
 	}
 
 	maintainers := []chart.Maintainer{}
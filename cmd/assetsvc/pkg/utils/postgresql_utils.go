@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/kubeapps/common/datastore"
 	"github.com/kubeapps/kubeapps/pkg/chart/models"
@@ -267,6 +268,18 @@ func (m *PostgresAssetManager) GenerateWhereClause(cq ChartQuery) (string, []int
 			fmt.Sprintf("(info -> 'maintainers' ->> 'name' ILIKE $%d))", len(whereQueryParams))
 		whereClauses = append(whereClauses, searchClause)
 	}
+	if cq.Maintainer != "" {
+		whereQueryParams = append(whereQueryParams, "%"+cq.Maintainer+"%")
+		whereClauses = append(whereClauses, fmt.Sprintf(
+			"(EXISTS (SELECT 1 FROM jsonb_array_elements(info -> 'maintainers') AS maintainer WHERE (maintainer ->> 'name' ILIKE $%d) OR (maintainer ->> 'email' ILIKE $%d)))", len(whereQueryParams), len(whereQueryParams),
+		))
+	}
+	if !cq.ModifiedSince.IsZero() {
+		whereQueryParams = append(whereQueryParams, cq.ModifiedSince.UTC().Format(time.RFC3339Nano))
+		whereClauses = append(whereClauses, fmt.Sprintf(
+			"(EXISTS (SELECT 1 FROM jsonb_array_elements(info -> 'chartVersions') AS chart_version WHERE (chart_version ->> 'created')::timestamptz > $%d::timestamptz))", len(whereQueryParams),
+		))
+	}
 	if len(whereClauses) > 0 {
 		whereQuery = "WHERE " + strings.Join(whereClauses, " AND ")
 	}
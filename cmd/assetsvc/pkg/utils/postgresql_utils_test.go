@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/go-cmp/cmp"
@@ -453,6 +454,8 @@ func Test_GenerateWhereClause(t *testing.T) {
 		repos          []string
 		categories     []string
 		query          string
+		maintainer     string
+		modifiedSince  time.Time
 		expectedClause string
 		expectedParams []interface{}
 	}{
@@ -636,6 +639,32 @@ func Test_GenerateWhereClause(t *testing.T) {
 			expectedClause: `WHERE (repo_namespace = $1 OR repo_namespace = $2) AND ((info ->> 'name' ILIKE $3) OR (info ->> 'description' ILIKE $3) OR (info -> 'repo' ->> 'name' ILIKE $3) OR (info ->> 'keywords' ILIKE $3) OR (info ->> 'sources' ILIKE $3) OR (info -> 'maintainers' ->> 'name' ILIKE $3))`,
 			expectedParams: []interface{}{string(""), string("kubeapps"), string("%my%2Fchart%")},
 		},
+		{
+			name:           "returns where clause - single param - maintainer",
+			namespace:      "",
+			chartName:      "",
+			version:        "",
+			appVersion:     "",
+			repos:          []string{""},
+			categories:     []string{""},
+			query:          "",
+			maintainer:     "acme.example",
+			expectedClause: `WHERE (repo_namespace = $1 OR repo_namespace = $2) AND (EXISTS (SELECT 1 FROM jsonb_array_elements(info -> 'maintainers') AS maintainer WHERE (maintainer ->> 'name' ILIKE $3) OR (maintainer ->> 'email' ILIKE $3)))`,
+			expectedParams: []interface{}{string(""), string("kubeapps"), string("%acme.example%")},
+		},
+		{
+			name:           "returns where clause - single param - modified since",
+			namespace:      "",
+			chartName:      "",
+			version:        "",
+			appVersion:     "",
+			repos:          []string{""},
+			categories:     []string{""},
+			query:          "",
+			modifiedSince:  time.Date(2021, time.September, 1, 10, 0, 0, 0, time.UTC),
+			expectedClause: `WHERE (repo_namespace = $1 OR repo_namespace = $2) AND (EXISTS (SELECT 1 FROM jsonb_array_elements(info -> 'chartVersions') AS chart_version WHERE (chart_version ->> 'created')::timestamptz > $3::timestamptz))`,
+			expectedParams: []interface{}{string(""), string("kubeapps"), string("2021-09-01T10:00:00Z")},
+		},
 		{
 			name:           "returns where clause - every param",
 			namespace:      "my-ns",
@@ -655,13 +684,15 @@ func Test_GenerateWhereClause(t *testing.T) {
 			defer cleanup()
 
 			cq := ChartQuery{
-				Namespace:   tt.namespace,
-				ChartName:   tt.chartName,
-				Version:     tt.version,
-				AppVersion:  tt.appVersion,
-				SearchQuery: tt.query,
-				Repos:       tt.repos,
-				Categories:  tt.categories,
+				Namespace:     tt.namespace,
+				ChartName:     tt.chartName,
+				Version:       tt.version,
+				AppVersion:    tt.appVersion,
+				SearchQuery:   tt.query,
+				Repos:         tt.repos,
+				Categories:    tt.categories,
+				Maintainer:    tt.maintainer,
+				ModifiedSince: tt.modifiedSince,
 			}
 			whereQuery, whereQueryParams := pgManager.GenerateWhereClause(cq)
 
@@ -17,6 +17,8 @@ limitations under the License.
 package utils
 
 import (
+	"time"
+
 	"github.com/kubeapps/common/datastore"
 	"github.com/kubeapps/kubeapps/pkg/chart/models"
 )
@@ -40,6 +42,12 @@ type ChartQuery struct {
 	SearchQuery string
 	Repos       []string
 	Categories  []string
+	// Maintainer matches the charts with a maintainer whose name or email
+	// contains it, case-insensitively.
+	Maintainer string
+	// ModifiedSince, if not zero, matches the charts with a version created
+	// after it.
+	ModifiedSince time.Time
 }
 
 func NewManager(databaseType string, config datastore.Config, kubeappsNamespace string) (AssetManager, error) {
@@ -17,8 +17,10 @@ limitations under the License.
 package cmd
 
 import (
+	"compress/gzip"
 	"fmt"
 	"os"
+	"time"
 
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
@@ -29,8 +31,12 @@ import (
 )
 
 var (
-	cfgFile   string
-	serveOpts server.ServeOptions
+	cfgFile            string
+	serveOpts          server.ServeOptions
+	pluginFeatureFlags []string
+	pluginEndpoints    []string
+	pluginCallTimeouts map[string]string
+	tenantNamespaces   []string
 	// This version var is updated during the build
 	// see the -ldflags option in the Dockerfile
 	version = "devel"
@@ -47,8 +53,29 @@ func newRootCmd() *cobra.Command {
 
 The api service serves both gRPC and HTTP requests for the configured APIs.`,
 
-		PreRun: func(cmd *cobra.Command, args []string) {
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			featureFlags, err := server.ParsePluginFeatureFlags(pluginFeatureFlags)
+			if err != nil {
+				return err
+			}
+			serveOpts.PluginFeatureFlags = featureFlags
+			endpoints, err := server.ParsePluginEndpoints(pluginEndpoints)
+			if err != nil {
+				return err
+			}
+			serveOpts.PluginEndpoints = endpoints
+			callTimeouts, err := server.ParsePluginCallTimeouts(pluginCallTimeouts)
+			if err != nil {
+				return err
+			}
+			serveOpts.PluginCallTimeouts = callTimeouts
+			namespaces, err := server.ParseTenantNamespaces(tenantNamespaces)
+			if err != nil {
+				return err
+			}
+			serveOpts.TenantNamespaces = namespaces
 			log.Infof("kubeapps-apis has been configured with: %#v", serveOpts)
+			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return server.Serve(serveOpts)
@@ -76,6 +103,58 @@ func setFlags(c *cobra.Command) {
 	c.Flags().StringSliceVar(&serveOpts.PluginDirs, "plugin-dir", []string{"."}, "A directory to be scanned for .so plugins. May be specified multiple times.")
 	c.Flags().StringVar(&serveOpts.ClustersConfigPath, "clusters-config-path", "", "Configuration for clusters")
 	c.Flags().StringVar(&serveOpts.PinnipedProxyURL, "pinniped-proxy-url", "http://kubeapps-internal-pinniped-proxy.kubeapps:3333", "internal url to be used for requests to clusters configured for credential proxying via pinniped")
+	c.Flags().IntVar(&serveOpts.CompressionLevel, "compression-level", gzip.DefaultCompression, "The gzip compression level (-1 for the default, 1 for best speed up to 9 for best compression) used when a client negotiates compressed responses.")
+	c.Flags().StringToStringVar(&serveOpts.NamespaceClusterMapping, "namespace-cluster-mapping", nil, "A mapping of namespace prefixes to cluster names (eg. team-a-=cluster-a) used to select the cluster of requests including a namespace but no cluster. May be specified multiple times.")
+	c.Flags().StringVar(&serveOpts.DefaultTargetCluster, "default-target-cluster", "", "The cluster targeted by the requests without a cluster nor a namespace mapped to a cluster, when it differs from the cluster on which Kubeapps is installed. By default, the Kubeapps cluster.")
+	c.Flags().StringSliceVar(&serveOpts.CategoryOrder, "category-order", nil, "A list of categories to be returned first, in the given order, before the rest of the categories sorted alphabetically. May be specified multiple times.")
+	c.Flags().StringSliceVar(&serveOpts.PluginOrder, "plugin-order", nil, "A list of plugin names whose available packages are returned first, in the given order, among the packages with the same name, the rest being ordered by plugin name. May be specified multiple times.")
+	c.Flags().BoolVar(&serveOpts.MergeConflictingInstalledPackages, "merge-conflicting-installed-packages", false, "if true, a single summary is returned for an installed package reported by several plugins, the one of the first configured plugin, listing the other plugins as conflicting.")
+	c.Flags().IntVar(&serveOpts.MaxPlugins, "max-plugins", 0, "The maximum number of plugins to be loaded (0 for no limit). The server refuses to start when more plugins are found, unless --truncate-plugins is set.")
+	c.Flags().BoolVar(&serveOpts.TruncatePlugins, "truncate-plugins", false, "if true, only the first --max-plugins plugins found are loaded instead of refusing to start when the limit is exceeded.")
+	c.Flags().StringVar(&serveOpts.DuplicatePluginPolicy, "duplicate-plugin-policy", server.DuplicatePluginPolicyReject, "How the plugins with the same name and version as an already registered plugin are handled: \"reject\" refuses to start while \"keep-first\" ignores them with a warning.")
+	c.Flags().DurationVar(&serveOpts.PluginRegistrationTimeout, "plugin-registration-timeout", 0, "The time given to each plugin to register (eg. 30s), so that a plugin blocking on load cannot block the startup. Not limited by default.")
+	c.Flags().StringVar(&serveOpts.PluginRegistrationTimeoutPolicy, "plugin-registration-timeout-policy", server.RegistrationTimeoutPolicyFail, "How the plugins which do not register within the --plugin-registration-timeout are handled: \"fail\" refuses to start while \"skip\" ignores them with a warning.")
+	c.Flags().IntVar(&serveOpts.PluginCallRetries, "plugin-call-retries", 2, "The number of times a plugin call failing with a transient error is retried. Create, update and delete calls are only retried when including an idempotency key.")
+	c.Flags().BoolVar(&serveOpts.RetryFailedInstalls, "retry-failed-installs", false, "if true, the creations and updates of installed packages failing with a transient plugin error are retried once, even without an idempotency key.")
+	c.Flags().DurationVar(&serveOpts.PluginCallTimeout, "plugin-call-timeout", 0, "The duration after which a plugin call, including its retries, is cancelled (eg. 30s). No timeout by default.")
+	c.Flags().StringToStringVar(&pluginCallTimeouts, "plugin-call-timeouts", nil, "A mapping of plugin names to the timeout of their calls (eg. fluxv2.packages=1m), overriding --plugin-call-timeout for the slower plugins. May be specified multiple times.")
+	c.Flags().DurationVar(&serveOpts.KeepaliveTime, "keepalive-time", 2*time.Hour, "The duration after which the server pings an idle client connection to check it is still alive.")
+	c.Flags().DurationVar(&serveOpts.KeepaliveTimeout, "keepalive-timeout", 20*time.Second, "The duration the server waits for the acknowledgement of a keepalive ping before closing the connection.")
+	c.Flags().DurationVar(&serveOpts.KeepaliveMinTime, "keepalive-min-time", 5*time.Minute, "The minimum duration clients should wait between keepalive pings. Connections of clients pinging more often are closed.")
+	c.Flags().BoolVar(&serveOpts.KeepalivePermitWithoutStream, "keepalive-permit-without-stream", false, "if true, clients are allowed to send keepalive pings even when there are no active streams.")
+	c.Flags().StringVar(&serveOpts.AuditLogPath, "audit-log-path", "", "The file to which an audit record is appended for each create, update or delete operation, or \"stdout\". Audit records are disabled by default.")
+	c.Flags().StringSliceVar(&serveOpts.ForwardMetadataKeys, "forward-metadata-keys", nil, "A list of request metadata keys (eg. x-request-id), in addition to the authorization, which are forwarded to the plugins. Any other request metadata is dropped. May be specified multiple times.")
+	c.Flags().DurationVar(&serveOpts.VersionsCacheTTL, "versions-cache-ttl", 0, "The duration for which the package version listings returned by the plugins are cached, per user (eg. 30s). The cache is disabled by default.")
+	c.Flags().DurationVar(&serveOpts.VersionsCacheRefreshInterval, "versions-cache-refresh-interval", 0, "The interval, extended by a random jitter, between the background refreshes of the cached anonymous package version listings which were requested again and expire before the next refresh (eg. 20s), made with the token of the service account of the server. The listings of the users are not refreshed. The refresh is disabled by default and requires --allow-anonymous-reads.")
+	c.Flags().IntVar(&serveOpts.VersionsCacheRefreshConcurrency, "versions-cache-refresh-concurrency", 4, "The maximum number of cached package version listings fetched concurrently by a background refresh.")
+	c.Flags().StringVar(&serveOpts.ClientErrorVerbosity, "client-error-verbosity", server.ClientErrorVerbosityDetailed, "How much of the errors is returned to the clients: \"detailed\" for the full error or \"minimal\" for a generic message with a correlation id, the error being only logged. The invalid argument, not found and already exists errors are always returned in full.")
+	c.Flags().IntVar(&serveOpts.MaxValuesSize, "max-values-size", 0, "The maximum size, in bytes, of the values when creating or updating an installed package (0 for no limit). Requests with larger values are rejected.")
+	c.Flags().BoolVar(&serveOpts.AllowAnonymousReads, "allow-anonymous-reads", false, "Allow the catalog reads (the GetAvailablePackage* requests) without a token, made with the in-cluster config. The other requests without a token are rejected.")
+	c.Flags().StringSliceVar(&pluginFeatureFlags, "plugin-feature-flags", nil, "A list of feature flags passed to the plugins when registered, as <plugin-name>:<flag>=<bool> (eg. helm.packages:oci-charts=true). May be specified multiple times.")
+	c.Flags().StringSliceVar(&pluginEndpoints, "plugin-endpoints", nil, "A list of the backends serving the calls of a plugin, as <plugin-name>:<read|write>=<address> (eg. helm.packages:read=helm-read:50051), the reads being routed to the read backend and the mutations to the write one. The plugin loaded in-process is used for a missing backend. May be specified multiple times.")
+	c.Flags().StringVar(&serveOpts.PluginEndpointsTLS.CAFile, "plugin-endpoints-ca-file", "", "The file of the PEM encoded CAs verifying the TLS certificates of the plugin endpoints. The system CAs are used by default.")
+	c.Flags().StringVar(&serveOpts.PluginEndpointsTLS.CertFile, "plugin-endpoints-cert-file", "", "The file of the PEM encoded client certificate presented to the plugin endpoints, with --plugin-endpoints-key-file.")
+	c.Flags().StringVar(&serveOpts.PluginEndpointsTLS.KeyFile, "plugin-endpoints-key-file", "", "The file of the PEM encoded key of the client certificate presented to the plugin endpoints.")
+	c.Flags().BoolVar(&serveOpts.PluginEndpointsTLS.Insecure, "plugin-endpoints-insecure", false, "Connect to the plugin endpoints in plaintext, without TLS.")
+	c.Flags().BoolVar(&serveOpts.PluginVersionFallback, "plugin-version-fallback", false, "if true, the read requests use the requested version of the plugin, if loaded, or else the nearest loaded version of the same plugin. By default, the requests are routed by the plugin name only.")
+	c.Flags().BoolVar(&serveOpts.QualifyAmbiguousIdentifiers, "qualify-ambiguous-identifiers", false, "if true, the identifiers of the available packages published by several repositories of the same plugin are qualified with their repository (eg. \"ns-1/repo-a:pkg-1\").")
+	c.Flags().IntVar(&serveOpts.MaxConcurrentInstalls, "max-concurrent-installs", 0, "The maximum number of create or update operations of installed packages running concurrently across all plugins (0 for no limit). Further operations are rejected, after waiting up to --install-queue-timeout.")
+	c.Flags().DurationVar(&serveOpts.InstallQueueTimeout, "install-queue-timeout", 0, "The duration an install operation waits for another one to complete when --max-concurrent-installs is reached, before being rejected (eg. 10s). Rejected immediately by default.")
+	c.Flags().BoolVar(&serveOpts.ValidateRequests, "validate-requests", false, "if true, the requests violating the validation rules of their message are rejected as invalid arguments, with the field violations as details, before reaching the handlers.")
+	c.Flags().StringVar(&serveOpts.EmptyNamespacePolicy, "empty-namespace-policy", "", "How the requests creating, updating or deleting an installed package with a blank namespace are handled: \"reject\" to reject them or \"default\" to use the default namespace of the cluster, rejecting them if none. By default, they are forwarded to the plugins unless the cluster has a default namespace.")
+	c.Flags().BoolVar(&serveOpts.NilPluginResponsesAsErrors, "nil-plugin-responses-as-errors", false, "if true, the plugin reads returning neither a response nor an error fail as internal errors, rather than being treated as empty responses with a logged warning. Such mutations always fail.")
+	c.Flags().StringVar(&serveOpts.MetricsNamespace, "metrics-namespace", "", "The prefix, followed by an underscore, of the names of all the exported prometheus metrics (eg. \"myteam\"), so that they do not collide in a shared prometheus. Not prefixed by default.")
+	c.Flags().BoolVar(&serveOpts.SlimResponses, "slim-responses", false, "if true, the cluster and namespace equal to the default target cluster and its default namespace are omitted from the contexts of the packages service responses, which clients should read as those defaults. The cluster is kept for the namespaces mapped by --namespace-cluster-mapping.")
+	c.Flags().IntVar(&serveOpts.BatchConcurrency, "batch-concurrency", 10, "The maximum number of installed packages of a batch request processed concurrently, the rest being queued. Not limited when 0.")
+	c.Flags().StringVar(&serveOpts.NamespaceDefaultingOrder, "namespace-defaulting-order", "", "The order in which a blank cluster and a blank namespace are defaulted: \"cluster-first\" to resolve the cluster before using its default namespace or \"namespace-first\" to resolve the namespace first, routing it with the namespace cluster mapping. Defaults to \"cluster-first\".")
+	c.Flags().StringVar(&serveOpts.TenantMetadataKey, "tenant-metadata-key", "", "The request metadata key (eg. x-tenant-id) identifying the tenant of a call, whose contexts are then restricted to the namespaces of the tenant, rejected as permission denied otherwise. The calls without the key are rejected. The tenant is not verified, so the key must be set by a trusted proxy replacing the one sent by the clients. Calls are not scoped by default.")
+	c.Flags().StringSliceVar(&tenantNamespaces, "tenant-namespaces", nil, "A list of the namespaces accessible by each tenant, as <tenant>:<cluster>/<namespace> (eg. tenant-a:default/team-a-dev). May be specified multiple times.")
+	c.Flags().BoolVar(&serveOpts.ValidateClusterPolicies, "validate-cluster-policies", false, "if true, the resources rendered when previewing an install are checked against the target cluster, the preview failing when they reference storage or ingress classes missing from the cluster.")
+	c.Flags().IntVar(&serveOpts.RequestLogSampling, "request-log-sampling", 0, "If set, a line is logged per request with its result and duration, only one in every N successful reads being logged (eg. 100) while the mutations and the failed requests are always logged. Disabled when 0.")
+	c.Flags().StringVar(&serveOpts.ExpiredTokenPolicy, "expired-token-policy", "", "How the requests with an expired JWT are handled: \"reject\" to reject them as unauthenticated with a \"token expired\" error, so that clients refresh the token, or \"forward\" to forward them to the plugins. Defaults to \"forward\".")
+	c.Flags().DurationVar(&serveOpts.ExpiredTokenLeeway, "expired-token-leeway", 0, "The duration after their expiry during which the tokens are still accepted when rejecting the expired tokens (eg. 30s), to allow for the clock skew with the token issuer.")
+	c.Flags().DurationVar(&serveOpts.DefaultInstallTimeout, "default-install-timeout", 0, "The install timeout forwarded to the plugins with the requests creating an installed package which do not specify one (eg. 10m), such as the helm --timeout bounding the Kubernetes operations of the install, without waiting for the release, or the flux HelmRelease timeout. It can't exceed the plugin call timeout. None by default.")
+	c.Flags().BoolVar(&serveOpts.UseKubeappsClusterAPIServiceURL, "use-kubeapps-cluster-api-service-url", false, "if true, the cluster on which Kubeapps is installed is reached through its configured apiServiceURL, as the other clusters, rather than through the in-cluster configuration. The cluster must then have an apiServiceURL.")
 	c.Flags().BoolVar(&serveOpts.UnsafeUseDemoSA, "unsafe-use-demo-sa", false, "if true, it will create and use a privileged Service Account for interacting with the resources instead of acting on a user's behalf.")
 	c.Flags().BoolVar(&serveOpts.UnsafeLocalDevKubeconfig, "unsafe-local-dev-kubeconfig", false, "if true, it will use the local kubeconfig at the KUBECONFIG env var instead of using the inCluster configuration.")
 }
@@ -19,6 +19,7 @@ package cmd
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/server"
@@ -38,16 +39,133 @@ func TestParseFlagsCorrect(t *testing.T) {
 				"--plugin-dir", "foo01",
 				"--clusters-config-path", "foo02",
 				"--pinniped-proxy-url", "foo03",
+				"--compression-level", "1",
+				"--namespace-cluster-mapping", "team-a-=cluster-a",
+				"--namespace-cluster-mapping", "team-b-=cluster-b",
+				"--default-target-cluster", "cluster-b",
+				"--category-order", "Database,Analytics",
+				"--plugin-order", "helm.packages,fluxv2.packages",
+				"--merge-conflicting-installed-packages", "true",
+				"--max-plugins", "5",
+				"--truncate-plugins", "true",
+				"--duplicate-plugin-policy", "keep-first",
+				"--plugin-registration-timeout", "30s",
+				"--plugin-registration-timeout-policy", "skip",
+				"--plugin-call-retries", "3",
+				"--retry-failed-installs", "true",
+				"--plugin-call-timeout", "30s",
+				"--plugin-call-timeouts", "fluxv2.packages=1m",
+				"--keepalive-time", "30s",
+				"--keepalive-timeout", "10s",
+				"--keepalive-min-time", "15s",
+				"--keepalive-permit-without-stream", "true",
+				"--audit-log-path", "stdout",
+				"--forward-metadata-keys", "x-request-id,traceparent",
+				"--versions-cache-ttl", "30s",
+				"--versions-cache-refresh-interval", "20s",
+				"--versions-cache-refresh-concurrency", "2",
+				"--client-error-verbosity", "minimal",
+				"--max-values-size", "1048576",
+				"--allow-anonymous-reads", "true",
+				"--plugin-feature-flags", "helm.packages:oci-charts=true,fluxv2.packages:auto-update=false",
+				"--plugin-endpoints", "helm.packages:read=helm-read:50051,helm.packages:write=helm-write:50051",
+				"--plugin-endpoints-ca-file", "/etc/plugin-endpoints/ca.crt",
+				"--plugin-endpoints-cert-file", "/etc/plugin-endpoints/tls.crt",
+				"--plugin-endpoints-key-file", "/etc/plugin-endpoints/tls.key",
+				"--plugin-endpoints-insecure", "true",
+				"--plugin-version-fallback", "true",
+				"--qualify-ambiguous-identifiers", "true",
+				"--max-concurrent-installs", "10",
+				"--install-queue-timeout", "5s",
+				"--validate-requests", "true",
+				"--empty-namespace-policy", "reject",
+				"--nil-plugin-responses-as-errors", "true",
+				"--metrics-namespace", "myteam",
+				"--slim-responses", "true",
+				"--batch-concurrency", "3",
+				"--namespace-defaulting-order", "namespace-first",
+				"--tenant-metadata-key", "x-tenant-id",
+				"--tenant-namespaces", "tenant-a:default/team-a-dev,tenant-a:default/team-a-prod",
+				"--validate-cluster-policies", "true",
+				"--request-log-sampling", "100",
+				"--expired-token-policy", "reject",
+				"--expired-token-leeway", "30s",
+				"--default-install-timeout", "10m",
+				"--use-kubeapps-cluster-api-service-url", "true",
 				"--unsafe-use-demo-sa", "true",
 				"--unsafe-local-dev-kubeconfig", "true",
 			},
 			server.ServeOptions{
-				Port:                     901,
-				PluginDirs:               []string{"foo01"},
-				ClustersConfigPath:       "foo02",
-				PinnipedProxyURL:         "foo03",
-				UnsafeUseDemoSA:          true,
-				UnsafeLocalDevKubeconfig: true,
+				Port:               901,
+				PluginDirs:         []string{"foo01"},
+				ClustersConfigPath: "foo02",
+				PinnipedProxyURL:   "foo03",
+				CompressionLevel:   1,
+				NamespaceClusterMapping: map[string]string{
+					"team-a-": "cluster-a",
+					"team-b-": "cluster-b",
+				},
+				DefaultTargetCluster:              "cluster-b",
+				CategoryOrder:                     []string{"Database", "Analytics"},
+				PluginOrder:                       []string{"helm.packages", "fluxv2.packages"},
+				MergeConflictingInstalledPackages: true,
+				MaxPlugins:                        5,
+				TruncatePlugins:                   true,
+				DuplicatePluginPolicy:             "keep-first",
+				PluginRegistrationTimeout:         30 * time.Second,
+				PluginRegistrationTimeoutPolicy:   "skip",
+				PluginCallRetries:                 3,
+				RetryFailedInstalls:               true,
+				PluginCallTimeout:                 30 * time.Second,
+				PluginCallTimeouts:                map[string]time.Duration{"fluxv2.packages": time.Minute},
+				KeepaliveTime:                     30 * time.Second,
+				KeepaliveTimeout:                  10 * time.Second,
+				KeepaliveMinTime:                  15 * time.Second,
+				KeepalivePermitWithoutStream:      true,
+				AuditLogPath:                      "stdout",
+				ForwardMetadataKeys:               []string{"x-request-id", "traceparent"},
+				VersionsCacheTTL:                  30 * time.Second,
+				VersionsCacheRefreshInterval:      20 * time.Second,
+				VersionsCacheRefreshConcurrency:   2,
+				ClientErrorVerbosity:              "minimal",
+				MaxValuesSize:                     1048576,
+				AllowAnonymousReads:               true,
+				PluginFeatureFlags: map[string]map[string]bool{
+					"helm.packages":   {"oci-charts": true},
+					"fluxv2.packages": {"auto-update": false},
+				},
+				PluginEndpoints: map[string]server.PluginEndpoints{
+					"helm.packages": {Read: "helm-read:50051", Write: "helm-write:50051"},
+				},
+				PluginEndpointsTLS: server.PluginEndpointsTLS{
+					CAFile:   "/etc/plugin-endpoints/ca.crt",
+					CertFile: "/etc/plugin-endpoints/tls.crt",
+					KeyFile:  "/etc/plugin-endpoints/tls.key",
+					Insecure: true,
+				},
+				PluginVersionFallback:       true,
+				QualifyAmbiguousIdentifiers: true,
+				MaxConcurrentInstalls:       10,
+				InstallQueueTimeout:         5 * time.Second,
+				ValidateRequests:            true,
+				EmptyNamespacePolicy:        "reject",
+				NilPluginResponsesAsErrors:  true,
+				MetricsNamespace:            "myteam",
+				SlimResponses:               true,
+				BatchConcurrency:            3,
+				NamespaceDefaultingOrder:    "namespace-first",
+				TenantMetadataKey:           "x-tenant-id",
+				TenantNamespaces: map[string][]string{
+					"tenant-a": {"default/team-a-dev", "default/team-a-prod"},
+				},
+				ValidateClusterPolicies:         true,
+				RequestLogSampling:              100,
+				ExpiredTokenPolicy:              "reject",
+				ExpiredTokenLeeway:              30 * time.Second,
+				DefaultInstallTimeout:           10 * time.Minute,
+				UseKubeappsClusterAPIServiceURL: true,
+				UnsafeUseDemoSA:                 true,
+				UnsafeLocalDevKubeconfig:        true,
 			},
 		},
 	}
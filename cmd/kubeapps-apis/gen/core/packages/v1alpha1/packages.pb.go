@@ -80,7 +80,172 @@ func (x InstalledPackageStatus_StatusReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InstalledPackageStatus_StatusReason.Descriptor instead.
 func (InstalledPackageStatus_StatusReason) EnumDescriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{27, 0}
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{62, 0}
+}
+
+// VersionRelation
+//
+// The relation of a package version to the installed version.
+type PackageAppVersion_VersionRelation int32
+
+const (
+	PackageAppVersion_VERSION_RELATION_UNSPECIFIED PackageAppVersion_VersionRelation = 0
+	PackageAppVersion_VERSION_RELATION_OLDER       PackageAppVersion_VersionRelation = 1
+	PackageAppVersion_VERSION_RELATION_CURRENT     PackageAppVersion_VersionRelation = 2
+	PackageAppVersion_VERSION_RELATION_NEWER       PackageAppVersion_VersionRelation = 3
+)
+
+// Enum value maps for PackageAppVersion_VersionRelation.
+var (
+	PackageAppVersion_VersionRelation_name = map[int32]string{
+		0: "VERSION_RELATION_UNSPECIFIED",
+		1: "VERSION_RELATION_OLDER",
+		2: "VERSION_RELATION_CURRENT",
+		3: "VERSION_RELATION_NEWER",
+	}
+	PackageAppVersion_VersionRelation_value = map[string]int32{
+		"VERSION_RELATION_UNSPECIFIED": 0,
+		"VERSION_RELATION_OLDER":       1,
+		"VERSION_RELATION_CURRENT":     2,
+		"VERSION_RELATION_NEWER":       3,
+	}
+)
+
+func (x PackageAppVersion_VersionRelation) Enum() *PackageAppVersion_VersionRelation {
+	p := new(PackageAppVersion_VersionRelation)
+	*p = x
+	return p
+}
+
+func (x PackageAppVersion_VersionRelation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PackageAppVersion_VersionRelation) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[1].Descriptor()
+}
+
+func (PackageAppVersion_VersionRelation) Type() protoreflect.EnumType {
+	return &file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[1]
+}
+
+func (x PackageAppVersion_VersionRelation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PackageAppVersion_VersionRelation.Descriptor instead.
+func (PackageAppVersion_VersionRelation) EnumDescriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{66, 0}
+}
+
+// VerificationStatus
+//
+// Generic verification statuses of a package signature.
+type PackageProvenance_VerificationStatus int32
+
+const (
+	PackageProvenance_VERIFICATION_STATUS_UNSPECIFIED PackageProvenance_VerificationStatus = 0
+	PackageProvenance_VERIFICATION_STATUS_VERIFIED    PackageProvenance_VerificationStatus = 1
+	PackageProvenance_VERIFICATION_STATUS_FAILED      PackageProvenance_VerificationStatus = 2
+)
+
+// Enum value maps for PackageProvenance_VerificationStatus.
+var (
+	PackageProvenance_VerificationStatus_name = map[int32]string{
+		0: "VERIFICATION_STATUS_UNSPECIFIED",
+		1: "VERIFICATION_STATUS_VERIFIED",
+		2: "VERIFICATION_STATUS_FAILED",
+	}
+	PackageProvenance_VerificationStatus_value = map[string]int32{
+		"VERIFICATION_STATUS_UNSPECIFIED": 0,
+		"VERIFICATION_STATUS_VERIFIED":    1,
+		"VERIFICATION_STATUS_FAILED":      2,
+	}
+)
+
+func (x PackageProvenance_VerificationStatus) Enum() *PackageProvenance_VerificationStatus {
+	p := new(PackageProvenance_VerificationStatus)
+	*p = x
+	return p
+}
+
+func (x PackageProvenance_VerificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PackageProvenance_VerificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[2].Descriptor()
+}
+
+func (PackageProvenance_VerificationStatus) Type() protoreflect.EnumType {
+	return &file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[2]
+}
+
+func (x PackageProvenance_VerificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PackageProvenance_VerificationStatus.Descriptor instead.
+func (PackageProvenance_VerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{69, 0}
+}
+
+// Operation
+//
+// The kinds of operations on an installed package.
+type PackageActivity_Operation int32
+
+const (
+	PackageActivity_OPERATION_UNSPECIFIED PackageActivity_Operation = 0
+	PackageActivity_OPERATION_INSTALL     PackageActivity_Operation = 1
+	PackageActivity_OPERATION_UPDATE      PackageActivity_Operation = 2
+	PackageActivity_OPERATION_ROLLBACK    PackageActivity_Operation = 3
+	PackageActivity_OPERATION_DELETE      PackageActivity_Operation = 4
+)
+
+// Enum value maps for PackageActivity_Operation.
+var (
+	PackageActivity_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_INSTALL",
+		2: "OPERATION_UPDATE",
+		3: "OPERATION_ROLLBACK",
+		4: "OPERATION_DELETE",
+	}
+	PackageActivity_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_INSTALL":     1,
+		"OPERATION_UPDATE":      2,
+		"OPERATION_ROLLBACK":    3,
+		"OPERATION_DELETE":      4,
+	}
+)
+
+func (x PackageActivity_Operation) Enum() *PackageActivity_Operation {
+	p := new(PackageActivity_Operation)
+	*p = x
+	return p
+}
+
+func (x PackageActivity_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PackageActivity_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[3].Descriptor()
+}
+
+func (PackageActivity_Operation) Type() protoreflect.EnumType {
+	return &file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[3]
+}
+
+func (x PackageActivity_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PackageActivity_Operation.Descriptor instead.
+func (PackageActivity_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{82, 0}
 }
 
 // GetAvailablePackageSummariesRequest
@@ -97,6 +262,45 @@ type GetAvailablePackageSummariesRequest struct {
 	FilterOptions *FilterOptions `protobuf:"bytes,2,opt,name=filter_options,json=filterOptions,proto3" json:"filter_options,omitempty"`
 	// Pagination options specifying where to start and how many results to include.
 	PaginationOptions *PaginationOptions `protobuf:"bytes,3,opt,name=pagination_options,json=paginationOptions,proto3" json:"pagination_options,omitempty"`
+	// Include installed count
+	//
+	// Whether to compute, for each available package, the number of times it is
+	// installed. Disabled by default as it requires listing the installed packages.
+	IncludeInstalledCount bool `protobuf:"varint,4,opt,name=include_installed_count,json=includeInstalledCount,proto3" json:"include_installed_count,omitempty"`
+	// Accept language
+	//
+	// The preferred languages of the user, in the format of an Accept-Language
+	// header (eg. "fr-CA,fr;q=0.9,en;q=0.8"). The package descriptions are
+	// returned in the best matching language available, falling back to the
+	// default descriptions otherwise.
+	AcceptLanguage string `protobuf:"bytes,5,opt,name=accept_language,json=acceptLanguage,proto3" json:"accept_language,omitempty"`
+	// Max description length
+	//
+	// The optional maximum length, in characters, of the returned short
+	// descriptions. Longer descriptions are truncated with an ellipsis, while
+	// they are returned intact when unset (0).
+	MaxDescriptionLength int32 `protobuf:"varint,6,opt,name=max_description_length,json=maxDescriptionLength,proto3" json:"max_description_length,omitempty"`
+	// Modified since
+	//
+	// An optional RFC 3339 timestamp (eg. "2021-09-01T10:00:00Z") so that only
+	// the packages modified after it are returned, for clients polling for
+	// changes. Plugins without change tracking ignore it and return all the
+	// packages: currently only the helm plugin, matching the packages with a
+	// version created after it, supports it.
+	ModifiedSince string `protobuf:"bytes,7,opt,name=modified_since,json=modifiedSince,proto3" json:"modified_since,omitempty"`
+	// Include category counts
+	//
+	// Whether to return, for each category, the number of available packages
+	// matching the FilterOptions within it, for faceted browsing. Disabled by
+	// default as it requires fetching all the packages of every plugin, even
+	// when paginating.
+	IncludeCategoryCounts bool `protobuf:"varint,8,opt,name=include_category_counts,json=includeCategoryCounts,proto3" json:"include_category_counts,omitempty"`
+	// Plugin names
+	//
+	// Optional names of the plugins (eg. "helm.packages") whose available
+	// packages are returned, the other plugins not being queried. All the
+	// plugins are queried when empty.
+	PluginNames []string `protobuf:"bytes,9,rep,name=plugin_names,json=pluginNames,proto3" json:"plugin_names,omitempty"`
 }
 
 func (x *GetAvailablePackageSummariesRequest) Reset() {
@@ -152,6 +356,48 @@ func (x *GetAvailablePackageSummariesRequest) GetPaginationOptions() *Pagination
 	return nil
 }
 
+func (x *GetAvailablePackageSummariesRequest) GetIncludeInstalledCount() bool {
+	if x != nil {
+		return x.IncludeInstalledCount
+	}
+	return false
+}
+
+func (x *GetAvailablePackageSummariesRequest) GetAcceptLanguage() string {
+	if x != nil {
+		return x.AcceptLanguage
+	}
+	return ""
+}
+
+func (x *GetAvailablePackageSummariesRequest) GetMaxDescriptionLength() int32 {
+	if x != nil {
+		return x.MaxDescriptionLength
+	}
+	return 0
+}
+
+func (x *GetAvailablePackageSummariesRequest) GetModifiedSince() string {
+	if x != nil {
+		return x.ModifiedSince
+	}
+	return ""
+}
+
+func (x *GetAvailablePackageSummariesRequest) GetIncludeCategoryCounts() bool {
+	if x != nil {
+		return x.IncludeCategoryCounts
+	}
+	return false
+}
+
+func (x *GetAvailablePackageSummariesRequest) GetPluginNames() []string {
+	if x != nil {
+		return x.PluginNames
+	}
+	return nil
+}
+
 // GetAvailablePackageDetailRequest
 //
 // Request for GetAvailablePackageDetail
@@ -165,8 +411,27 @@ type GetAvailablePackageDetailRequest struct {
 	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
 	// Optional specific version (or version reference) to request.
 	// By default the latest version (or latest version matching the reference)
-	// will be returned.
+	// will be returned. The "latest" pseudo-version can also be requested
+	// explicitly and is resolved to the newest (semver) available version.
 	PkgVersion string `protobuf:"bytes,2,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
+	// Accept language
+	//
+	// The preferred languages of the user, in the format of an Accept-Language
+	// header (eg. "fr-CA,fr;q=0.9,en;q=0.8"). The package descriptions are
+	// returned in the best matching language available, falling back to the
+	// default descriptions otherwise.
+	AcceptLanguage string `protobuf:"bytes,3,opt,name=accept_language,json=acceptLanguage,proto3" json:"accept_language,omitempty"`
+	// Target cluster
+	//
+	// The optional cluster on which the package is to be installed. When set,
+	// the Kubernetes version of the cluster is checked against the kube_version
+	// of the package and a warning is returned if it is not supported.
+	TargetCluster string `protobuf:"bytes,4,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	// Include pre-release
+	//
+	// Whether the "latest" pseudo-version can be resolved to a pre-release
+	// version (eg. "2.0.0-beta.1"), rather than to the newest stable version.
+	IncludePrerelease bool `protobuf:"varint,6,opt,name=include_prerelease,json=includePrerelease,proto3" json:"include_prerelease,omitempty"`
 }
 
 func (x *GetAvailablePackageDetailRequest) Reset() {
@@ -215,10 +480,31 @@ func (x *GetAvailablePackageDetailRequest) GetPkgVersion() string {
 	return ""
 }
 
-// GetAvailablePackageVersionsRequest
+func (x *GetAvailablePackageDetailRequest) GetAcceptLanguage() string {
+	if x != nil {
+		return x.AcceptLanguage
+	}
+	return ""
+}
+
+func (x *GetAvailablePackageDetailRequest) GetTargetCluster() string {
+	if x != nil {
+		return x.TargetCluster
+	}
+	return ""
+}
+
+func (x *GetAvailablePackageDetailRequest) GetIncludePrerelease() bool {
+	if x != nil {
+		return x.IncludePrerelease
+	}
+	return false
+}
+
+// GetAvailablePackageReadmeRequest
 //
-// Request for GetAvailablePackageVersions
-type GetAvailablePackageVersionsRequest struct {
+// Request for GetAvailablePackageReadme
+type GetAvailablePackageReadmeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -226,15 +512,13 @@ type GetAvailablePackageVersionsRequest struct {
 	// The information required to uniquely
 	// identify an available package
 	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
-	// Optional version reference for which full version history is required.  By
-	// default a summary of versions is returned as outlined in the response.
-	// Plugins can choose not to implement this and provide the summary only, it
-	// is provided for completeness only.
+	// Optional specific version (or version reference) to request, as for
+	// GetAvailablePackageDetail.
 	PkgVersion string `protobuf:"bytes,2,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
 }
 
-func (x *GetAvailablePackageVersionsRequest) Reset() {
-	*x = GetAvailablePackageVersionsRequest{}
+func (x *GetAvailablePackageReadmeRequest) Reset() {
+	*x = GetAvailablePackageReadmeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -242,13 +526,13 @@ func (x *GetAvailablePackageVersionsRequest) Reset() {
 	}
 }
 
-func (x *GetAvailablePackageVersionsRequest) String() string {
+func (x *GetAvailablePackageReadmeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailablePackageVersionsRequest) ProtoMessage() {}
+func (*GetAvailablePackageReadmeRequest) ProtoMessage() {}
 
-func (x *GetAvailablePackageVersionsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAvailablePackageReadmeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -260,41 +544,66 @@ func (x *GetAvailablePackageVersionsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailablePackageVersionsRequest.ProtoReflect.Descriptor instead.
-func (*GetAvailablePackageVersionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAvailablePackageReadmeRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageReadmeRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetAvailablePackageVersionsRequest) GetAvailablePackageRef() *AvailablePackageReference {
+func (x *GetAvailablePackageReadmeRequest) GetAvailablePackageRef() *AvailablePackageReference {
 	if x != nil {
 		return x.AvailablePackageRef
 	}
 	return nil
 }
 
-func (x *GetAvailablePackageVersionsRequest) GetPkgVersion() string {
+func (x *GetAvailablePackageReadmeRequest) GetPkgVersion() string {
 	if x != nil {
 		return x.PkgVersion
 	}
 	return ""
 }
 
-// GetInstalledPackageSummariesRequest
+// GetAvailablePackageVersionsRequest
 //
-// Request for GetInstalledPackageSummaries
-type GetInstalledPackageSummariesRequest struct {
+// Request for GetAvailablePackageVersions
+type GetAvailablePackageVersionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The context (cluster/namespace) for the request.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// Pagination options specifying where to start and how many results to include.
-	PaginationOptions *PaginationOptions `protobuf:"bytes,2,opt,name=pagination_options,json=paginationOptions,proto3" json:"pagination_options,omitempty"`
+	// The information required to uniquely
+	// identify an available package
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// Optional version reference for which full version history is required.  By
+	// default a summary of versions is returned as outlined in the response.
+	// Plugins can choose not to implement this and provide the summary only, it
+	// is provided for completeness only.
+	PkgVersion string `protobuf:"bytes,2,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
+	// Optional channel (or track, eg. "stable" or "edge") to which the returned
+	// versions are restricted, for repositories organizing the versions of a
+	// package into channels. Plugins without channels ignore it.
+	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Optional flag requesting the versions to be also returned grouped by
+	// major.minor, each group with its newest patch version, for the clients
+	// displaying a collapsed view of long version lists.
+	GroupByMinor bool `protobuf:"varint,4,opt,name=group_by_minor,json=groupByMinor,proto3" json:"group_by_minor,omitempty"`
+	// Include pre-release
+	//
+	// Whether to return the pre-release versions (eg. "2.0.0-beta.1"), which
+	// are excluded by default. The versions which are not valid semantic
+	// versions are always returned, their pre-release status being unknown.
+	IncludePrerelease bool `protobuf:"varint,5,opt,name=include_prerelease,json=includePrerelease,proto3" json:"include_prerelease,omitempty"`
+	// Installed version
+	//
+	// Optional version of the package currently installed (eg. "10.2.5"), when
+	// listing the versions to which an installed package can be updated. Each
+	// returned version is then annotated with its relation to the installed
+	// one, using the semantic versioning ordering.
+	InstalledVersion string `protobuf:"bytes,6,opt,name=installed_version,json=installedVersion,proto3" json:"installed_version,omitempty"`
 }
 
-func (x *GetInstalledPackageSummariesRequest) Reset() {
-	*x = GetInstalledPackageSummariesRequest{}
+func (x *GetAvailablePackageVersionsRequest) Reset() {
+	*x = GetAvailablePackageVersionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -302,13 +611,13 @@ func (x *GetInstalledPackageSummariesRequest) Reset() {
 	}
 }
 
-func (x *GetInstalledPackageSummariesRequest) String() string {
+func (x *GetAvailablePackageVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInstalledPackageSummariesRequest) ProtoMessage() {}
+func (*GetAvailablePackageVersionsRequest) ProtoMessage() {}
 
-func (x *GetInstalledPackageSummariesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAvailablePackageVersionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -320,40 +629,72 @@ func (x *GetInstalledPackageSummariesRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInstalledPackageSummariesRequest.ProtoReflect.Descriptor instead.
-func (*GetInstalledPackageSummariesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAvailablePackageVersionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageVersionsRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetInstalledPackageSummariesRequest) GetContext() *Context {
+func (x *GetAvailablePackageVersionsRequest) GetAvailablePackageRef() *AvailablePackageReference {
 	if x != nil {
-		return x.Context
+		return x.AvailablePackageRef
 	}
 	return nil
 }
 
-func (x *GetInstalledPackageSummariesRequest) GetPaginationOptions() *PaginationOptions {
+func (x *GetAvailablePackageVersionsRequest) GetPkgVersion() string {
 	if x != nil {
-		return x.PaginationOptions
+		return x.PkgVersion
 	}
-	return nil
+	return ""
 }
 
-// GetInstalledPackageDetailRequest
+func (x *GetAvailablePackageVersionsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GetAvailablePackageVersionsRequest) GetGroupByMinor() bool {
+	if x != nil {
+		return x.GroupByMinor
+	}
+	return false
+}
+
+func (x *GetAvailablePackageVersionsRequest) GetIncludePrerelease() bool {
+	if x != nil {
+		return x.IncludePrerelease
+	}
+	return false
+}
+
+func (x *GetAvailablePackageVersionsRequest) GetInstalledVersion() string {
+	if x != nil {
+		return x.InstalledVersion
+	}
+	return ""
+}
+
+// GetAvailablePackageDependenciesRequest
 //
-// Request for GetInstalledPackageDetail
-type GetInstalledPackageDetailRequest struct {
+// Request for GetAvailablePackageDependencies
+type GetAvailablePackageDependenciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// The information required to uniquely
-	// identify an installed package
-	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// identify an available package
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// Optional specific version of the package for which the dependencies
+	// are requested. By default the dependencies of the latest version
+	// will be returned.
+	PkgVersion string `protobuf:"bytes,2,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
 }
 
-func (x *GetInstalledPackageDetailRequest) Reset() {
-	*x = GetInstalledPackageDetailRequest{}
+func (x *GetAvailablePackageDependenciesRequest) Reset() {
+	*x = GetAvailablePackageDependenciesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -361,13 +702,13 @@ func (x *GetInstalledPackageDetailRequest) Reset() {
 	}
 }
 
-func (x *GetInstalledPackageDetailRequest) String() string {
+func (x *GetAvailablePackageDependenciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInstalledPackageDetailRequest) ProtoMessage() {}
+func (*GetAvailablePackageDependenciesRequest) ProtoMessage() {}
 
-func (x *GetInstalledPackageDetailRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAvailablePackageDependenciesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -379,47 +720,44 @@ func (x *GetInstalledPackageDetailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInstalledPackageDetailRequest.ProtoReflect.Descriptor instead.
-func (*GetInstalledPackageDetailRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAvailablePackageDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageDependenciesRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetInstalledPackageDetailRequest) GetInstalledPackageRef() *InstalledPackageReference {
+func (x *GetAvailablePackageDependenciesRequest) GetAvailablePackageRef() *AvailablePackageReference {
 	if x != nil {
-		return x.InstalledPackageRef
+		return x.AvailablePackageRef
 	}
 	return nil
 }
 
-// CreateInstalledPackageRequest
+func (x *GetAvailablePackageDependenciesRequest) GetPkgVersion() string {
+	if x != nil {
+		return x.PkgVersion
+	}
+	return ""
+}
+
+// GetAvailablePackageDefaultValuesRequest
 //
-// Request for CreateInstalledPackage
-type CreateInstalledPackageRequest struct {
+// Request for GetAvailablePackageDefaultValues
+type GetAvailablePackageDefaultValuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A reference uniquely identifying the package available for installation.
+	// The information required to uniquely
+	// identify an available package
 	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
-	// The target context where the package is intended to be installed.
-	TargetContext *Context `protobuf:"bytes,2,opt,name=target_context,json=targetContext,proto3" json:"target_context,omitempty"`
-	// A user-provided name for the installed package (eg. project-x-db)
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// For helm this will be the exact version in VersionReference.version
-	// For other plugins we can extend the VersionReference as needed.
-	PkgVersionReference *VersionReference `protobuf:"bytes,4,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
-	// An optional serialized values string to be included when templating a package
-	// in the format expected by the plugin. Included when the backend format doesn't
-	// use secrets or configmaps for values or supports both. These values are layered
-	// on top of any values refs above, when relevant.
-	Values string `protobuf:"bytes,5,opt,name=values,proto3" json:"values,omitempty"`
-	// An optional field for specifying data common to systems that reconcile
-	// the package on the cluster.
-	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,6,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
+	// Optional specific version of the package for which the default values
+	// are requested. By default the default values of the latest version
+	// will be returned.
+	PkgVersion string `protobuf:"bytes,2,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
 }
 
-func (x *CreateInstalledPackageRequest) Reset() {
-	*x = CreateInstalledPackageRequest{}
+func (x *GetAvailablePackageDefaultValuesRequest) Reset() {
+	*x = GetAvailablePackageDefaultValuesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -427,13 +765,13 @@ func (x *CreateInstalledPackageRequest) Reset() {
 	}
 }
 
-func (x *CreateInstalledPackageRequest) String() string {
+func (x *GetAvailablePackageDefaultValuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInstalledPackageRequest) ProtoMessage() {}
+func (*GetAvailablePackageDefaultValuesRequest) ProtoMessage() {}
 
-func (x *CreateInstalledPackageRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAvailablePackageDefaultValuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -445,90 +783,51 @@ func (x *CreateInstalledPackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInstalledPackageRequest.ProtoReflect.Descriptor instead.
-func (*CreateInstalledPackageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAvailablePackageDefaultValuesRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageDefaultValuesRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateInstalledPackageRequest) GetAvailablePackageRef() *AvailablePackageReference {
+func (x *GetAvailablePackageDefaultValuesRequest) GetAvailablePackageRef() *AvailablePackageReference {
 	if x != nil {
 		return x.AvailablePackageRef
 	}
 	return nil
 }
 
-func (x *CreateInstalledPackageRequest) GetTargetContext() *Context {
-	if x != nil {
-		return x.TargetContext
-	}
-	return nil
-}
-
-func (x *CreateInstalledPackageRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateInstalledPackageRequest) GetPkgVersionReference() *VersionReference {
-	if x != nil {
-		return x.PkgVersionReference
-	}
-	return nil
-}
-
-func (x *CreateInstalledPackageRequest) GetValues() string {
+func (x *GetAvailablePackageDefaultValuesRequest) GetPkgVersion() string {
 	if x != nil {
-		return x.Values
+		return x.PkgVersion
 	}
 	return ""
 }
 
-func (x *CreateInstalledPackageRequest) GetReconciliationOptions() *ReconciliationOptions {
-	if x != nil {
-		return x.ReconciliationOptions
-	}
-	return nil
-}
-
-// UpdateInstalledPackageRequest
+// GetAvailablePackageChangelogRequest
 //
-// Request for UpdateInstalledPackage. The intent is to reach the desired state specified
-// by the fields in the request, while leaving other fields intact. This is a whole
-// object "Update" semantics rather than "Patch" semantics. The caller will provide the
-// values for the fields fields below, which will replace, or be overlayed onto, the
-// corresponding fields in the existing resource. For example, with the
-// UpdateInstalledPackageRequest, it is not possible to change just the 'package version
-// reference' without also specifying 'values' field. As a side effect, not specifying the
-// 'values' field in the request means there are no values specified in the desired state.
-// So the meaning of each field value is describing the desired state of the corresponding
-// field in the resource after the update operation has completed the renconciliation.
-type UpdateInstalledPackageRequest struct {
+// Request for GetAvailablePackageChangelog
+type GetAvailablePackageChangelogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A reference uniquely identifying the installed package being updated.
-	// Required
-	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
-	// For helm this will be the exact version in VersionReference.version
-	// For fluxv2 this could be any semver constraint expression
-	// For other plugins we can extend the VersionReference as needed. Optional
-	PkgVersionReference *VersionReference `protobuf:"bytes,2,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
-	// An optional serialized values string to be included when templating a
-	// package in the format expected by the plugin. Included when the backend
-	// format doesn't use secrets or configmaps for values or supports both.
-	// These values are layered on top of any values refs above, when
-	// relevant.
-	Values string `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
-	// An optional field for specifying data common to systems that reconcile
-	// the package on the cluster.
-	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,4,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
+	// The information required to uniquely
+	// identify an available package
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// From package version
+	//
+	// The version from which the changes are requested, typically the version
+	// of an installed package, whose own changes are not returned. By default
+	// the changes of all the versions up to the target version are returned.
+	FromPkgVersion string `protobuf:"bytes,2,opt,name=from_pkg_version,json=fromPkgVersion,proto3" json:"from_pkg_version,omitempty"`
+	// To package version
+	//
+	// The target version up to which the changes are requested, included.
+	// By default the changes up to the latest version are returned.
+	ToPkgVersion string `protobuf:"bytes,3,opt,name=to_pkg_version,json=toPkgVersion,proto3" json:"to_pkg_version,omitempty"`
 }
 
-func (x *UpdateInstalledPackageRequest) Reset() {
-	*x = UpdateInstalledPackageRequest{}
+func (x *GetAvailablePackageChangelogRequest) Reset() {
+	*x = GetAvailablePackageChangelogRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -536,13 +835,13 @@ func (x *UpdateInstalledPackageRequest) Reset() {
 	}
 }
 
-func (x *UpdateInstalledPackageRequest) String() string {
+func (x *GetAvailablePackageChangelogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInstalledPackageRequest) ProtoMessage() {}
+func (*GetAvailablePackageChangelogRequest) ProtoMessage() {}
 
-func (x *UpdateInstalledPackageRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAvailablePackageChangelogRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,53 +853,58 @@ func (x *UpdateInstalledPackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInstalledPackageRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInstalledPackageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAvailablePackageChangelogRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageChangelogRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateInstalledPackageRequest) GetInstalledPackageRef() *InstalledPackageReference {
-	if x != nil {
-		return x.InstalledPackageRef
-	}
-	return nil
-}
-
-func (x *UpdateInstalledPackageRequest) GetPkgVersionReference() *VersionReference {
+func (x *GetAvailablePackageChangelogRequest) GetAvailablePackageRef() *AvailablePackageReference {
 	if x != nil {
-		return x.PkgVersionReference
+		return x.AvailablePackageRef
 	}
 	return nil
 }
 
-func (x *UpdateInstalledPackageRequest) GetValues() string {
+func (x *GetAvailablePackageChangelogRequest) GetFromPkgVersion() string {
 	if x != nil {
-		return x.Values
+		return x.FromPkgVersion
 	}
 	return ""
 }
 
-func (x *UpdateInstalledPackageRequest) GetReconciliationOptions() *ReconciliationOptions {
+func (x *GetAvailablePackageChangelogRequest) GetToPkgVersion() string {
 	if x != nil {
-		return x.ReconciliationOptions
+		return x.ToPkgVersion
 	}
-	return nil
+	return ""
 }
 
-// DeleteInstalledPackageRequest
+// GetAvailablePackageValuesDiffRequest
 //
-// Request for DeleteInstalledPackage
-type DeleteInstalledPackageRequest struct {
+// Request for GetAvailablePackageValuesDiff
+type GetAvailablePackageValuesDiffRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A reference to uniquely identify the installed package to be deleted.
-	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// The information required to uniquely
+	// identify an available package
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// From package version
+	//
+	// The version whose default values are compared, typically the version of
+	// an installed package being upgraded.
+	// Required
+	FromPkgVersion string `protobuf:"bytes,2,opt,name=from_pkg_version,json=fromPkgVersion,proto3" json:"from_pkg_version,omitempty"`
+	// To package version
+	//
+	// The version whose default values are compared with the ones of the from
+	// version. By default the latest version is used.
+	ToPkgVersion string `protobuf:"bytes,3,opt,name=to_pkg_version,json=toPkgVersion,proto3" json:"to_pkg_version,omitempty"`
 }
 
-func (x *DeleteInstalledPackageRequest) Reset() {
-	*x = DeleteInstalledPackageRequest{}
+func (x *GetAvailablePackageValuesDiffRequest) Reset() {
+	*x = GetAvailablePackageValuesDiffRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -608,13 +912,13 @@ func (x *DeleteInstalledPackageRequest) Reset() {
 	}
 }
 
-func (x *DeleteInstalledPackageRequest) String() string {
+func (x *GetAvailablePackageValuesDiffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInstalledPackageRequest) ProtoMessage() {}
+func (*GetAvailablePackageValuesDiffRequest) ProtoMessage() {}
 
-func (x *DeleteInstalledPackageRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAvailablePackageValuesDiffRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,43 +930,62 @@ func (x *DeleteInstalledPackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInstalledPackageRequest.ProtoReflect.Descriptor instead.
-func (*DeleteInstalledPackageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAvailablePackageValuesDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageValuesDiffRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeleteInstalledPackageRequest) GetInstalledPackageRef() *InstalledPackageReference {
+func (x *GetAvailablePackageValuesDiffRequest) GetAvailablePackageRef() *AvailablePackageReference {
 	if x != nil {
-		return x.InstalledPackageRef
+		return x.AvailablePackageRef
 	}
 	return nil
 }
 
-// GetAvailablePackageSummariesResponse
+func (x *GetAvailablePackageValuesDiffRequest) GetFromPkgVersion() string {
+	if x != nil {
+		return x.FromPkgVersion
+	}
+	return ""
+}
+
+func (x *GetAvailablePackageValuesDiffRequest) GetToPkgVersion() string {
+	if x != nil {
+		return x.ToPkgVersion
+	}
+	return ""
+}
+
+// RenderAvailablePackageRequest
 //
-// Response for GetAvailablePackageSummaries
-type GetAvailablePackageSummariesResponse struct {
+// Request for RenderAvailablePackage
+type RenderAvailablePackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Available packages summaries
-	//
-	// List of AvailablePackageSummary
-	AvailablePackageSummaries []*AvailablePackageSummary `protobuf:"bytes,1,rep,name=available_package_summaries,json=availablePackageSummaries,proto3" json:"available_package_summaries,omitempty"`
-	// Next page token
-	//
-	// This field represents the pagination token to retrieve the next page of
-	// results. If the value is "", it means no further results for the request.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// Categories
+	// The information required to uniquely
+	// identify an available package
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// Optional specific version of the package to be rendered. By default
+	// the latest version will be rendered.
+	PkgVersion string `protobuf:"bytes,2,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
+	// The optional values with which the package is rendered, as in the
+	// CreateInstalledPackageRequest.
+	Values string `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
+	// The optional context (cluster/namespace) and name of the installed
+	// package as if it were created, used by the templates referring to them.
+	TargetContext *Context `protobuf:"bytes,4,opt,name=target_context,json=targetContext,proto3" json:"target_context,omitempty"`
+	Name          string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// Include apply order
 	//
-	// This optional field contains the distinct category names considering the FilterOptions.
-	Categories []string `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Whether to also return the order in which the rendered resources would
+	// be applied when installing the package, if supported by the plugin.
+	IncludeApplyOrder bool `protobuf:"varint,6,opt,name=include_apply_order,json=includeApplyOrder,proto3" json:"include_apply_order,omitempty"`
 }
 
-func (x *GetAvailablePackageSummariesResponse) Reset() {
-	*x = GetAvailablePackageSummariesResponse{}
+func (x *RenderAvailablePackageRequest) Reset() {
+	*x = RenderAvailablePackageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -670,13 +993,13 @@ func (x *GetAvailablePackageSummariesResponse) Reset() {
 	}
 }
 
-func (x *GetAvailablePackageSummariesResponse) String() string {
+func (x *RenderAvailablePackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailablePackageSummariesResponse) ProtoMessage() {}
+func (*RenderAvailablePackageRequest) ProtoMessage() {}
 
-func (x *GetAvailablePackageSummariesResponse) ProtoReflect() protoreflect.Message {
+func (x *RenderAvailablePackageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -688,48 +1011,69 @@ func (x *GetAvailablePackageSummariesResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailablePackageSummariesResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailablePackageSummariesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenderAvailablePackageRequest.ProtoReflect.Descriptor instead.
+func (*RenderAvailablePackageRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetAvailablePackageSummariesResponse) GetAvailablePackageSummaries() []*AvailablePackageSummary {
+func (x *RenderAvailablePackageRequest) GetAvailablePackageRef() *AvailablePackageReference {
 	if x != nil {
-		return x.AvailablePackageSummaries
+		return x.AvailablePackageRef
 	}
 	return nil
 }
 
-func (x *GetAvailablePackageSummariesResponse) GetNextPageToken() string {
+func (x *RenderAvailablePackageRequest) GetPkgVersion() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.PkgVersion
 	}
 	return ""
 }
 
-func (x *GetAvailablePackageSummariesResponse) GetCategories() []string {
+func (x *RenderAvailablePackageRequest) GetValues() string {
 	if x != nil {
-		return x.Categories
+		return x.Values
+	}
+	return ""
+}
+
+func (x *RenderAvailablePackageRequest) GetTargetContext() *Context {
+	if x != nil {
+		return x.TargetContext
 	}
 	return nil
 }
 
-// GetAvailablePackageDetailResponse
+func (x *RenderAvailablePackageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenderAvailablePackageRequest) GetIncludeApplyOrder() bool {
+	if x != nil {
+		return x.IncludeApplyOrder
+	}
+	return false
+}
+
+// GetInstalledPackageSummariesRequest
 //
-// Response for GetAvailablePackageDetail
-type GetAvailablePackageDetailResponse struct {
+// Request for GetInstalledPackageSummaries
+type GetInstalledPackageSummariesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Available package detail
-	//
-	// The requested AvailablePackageDetail
-	AvailablePackageDetail *AvailablePackageDetail `protobuf:"bytes,1,opt,name=available_package_detail,json=availablePackageDetail,proto3" json:"available_package_detail,omitempty"`
+	// The context (cluster/namespace) for the request.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// Pagination options specifying where to start and how many results to include.
+	PaginationOptions *PaginationOptions `protobuf:"bytes,2,opt,name=pagination_options,json=paginationOptions,proto3" json:"pagination_options,omitempty"`
 }
 
-func (x *GetAvailablePackageDetailResponse) Reset() {
-	*x = GetAvailablePackageDetailResponse{}
+func (x *GetInstalledPackageSummariesRequest) Reset() {
+	*x = GetInstalledPackageSummariesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -737,13 +1081,13 @@ func (x *GetAvailablePackageDetailResponse) Reset() {
 	}
 }
 
-func (x *GetAvailablePackageDetailResponse) String() string {
+func (x *GetInstalledPackageSummariesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailablePackageDetailResponse) ProtoMessage() {}
+func (*GetInstalledPackageSummariesRequest) ProtoMessage() {}
 
-func (x *GetAvailablePackageDetailResponse) ProtoReflect() protoreflect.Message {
+func (x *GetInstalledPackageSummariesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -755,54 +1099,45 @@ func (x *GetAvailablePackageDetailResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailablePackageDetailResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailablePackageDetailResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInstalledPackageSummariesRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageSummariesRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetAvailablePackageDetailResponse) GetAvailablePackageDetail() *AvailablePackageDetail {
+func (x *GetInstalledPackageSummariesRequest) GetContext() *Context {
 	if x != nil {
-		return x.AvailablePackageDetail
+		return x.Context
 	}
 	return nil
 }
 
-// GetAvailablePackageVersionsResponse
+func (x *GetInstalledPackageSummariesRequest) GetPaginationOptions() *PaginationOptions {
+	if x != nil {
+		return x.PaginationOptions
+	}
+	return nil
+}
+
+// GetInstalledPackageDetailRequest
 //
-// Response for GetAvailablePackageVersions
-type GetAvailablePackageVersionsResponse struct {
+// Request for GetInstalledPackageDetail
+type GetInstalledPackageDetailRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Package app versions
-	//
-	// By default (when version_query is empty or ignored) the response
-	// should contain an ordered summary of versions including the most recent three
-	// patch versions of the most recent three minor versions of the most recent three
-	// major versions when available, something like:
-	// [
-	//   { pkg_version: "10.3.19", app_version: "2.16.8" },
-	//   { pkg_version: "10.3.18", app_version: "2.16.8" },
-	//   { pkg_version: "10.3.17", app_version: "2.16.7" },
-	//   { pkg_version: "10.2.6", app_version: "2.15.3" },
-	//   { pkg_version: "10.2.5", app_version: "2.15.2" },
-	//   { pkg_version: "10.2.4", app_version: "2.15.2" },
-	//   { pkg_version: "10.1.8", app_version: "2.13.5" },
-	//   { pkg_version: "10.1.7", app_version: "2.13.5" },
-	//   { pkg_version: "10.1.6", app_version: "2.13.5" },
-	//   { pkg_version: "9.5.4", app_version: "2.8.9" },
-	//   ...
-	//   { pkg_version: "8.2.5", app_version: "1.19.5" },
-	//   ...
-	// ]
-	// If a version_query is present and the plugin chooses to support it,
-	// the full history of versions matching the version query should be returned.
-	PackageAppVersions []*PackageAppVersion `protobuf:"bytes,1,rep,name=package_app_versions,json=packageAppVersions,proto3" json:"package_app_versions,omitempty"`
+	// The information required to uniquely
+	// identify an installed package
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// Optional flag to include, by plugins supporting it, a rollup of the
+	// status of the workloads (eg. deployments) of the installed package
+	// in the workload_status of the detail. Omitted by default as it requires
+	// fetching each workload from the cluster.
+	IncludeWorkloadStatus bool `protobuf:"varint,2,opt,name=include_workload_status,json=includeWorkloadStatus,proto3" json:"include_workload_status,omitempty"`
 }
 
-func (x *GetAvailablePackageVersionsResponse) Reset() {
-	*x = GetAvailablePackageVersionsResponse{}
+func (x *GetInstalledPackageDetailRequest) Reset() {
+	*x = GetInstalledPackageDetailRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -810,13 +1145,13 @@ func (x *GetAvailablePackageVersionsResponse) Reset() {
 	}
 }
 
-func (x *GetAvailablePackageVersionsResponse) String() string {
+func (x *GetInstalledPackageDetailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailablePackageVersionsResponse) ProtoMessage() {}
+func (*GetInstalledPackageDetailRequest) ProtoMessage() {}
 
-func (x *GetAvailablePackageVersionsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetInstalledPackageDetailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -828,39 +1163,45 @@ func (x *GetAvailablePackageVersionsResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailablePackageVersionsResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailablePackageVersionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInstalledPackageDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageDetailRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetAvailablePackageVersionsResponse) GetPackageAppVersions() []*PackageAppVersion {
+func (x *GetInstalledPackageDetailRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.PackageAppVersions
+		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-// GetInstalledPackageSummariesResponse
+func (x *GetInstalledPackageDetailRequest) GetIncludeWorkloadStatus() bool {
+	if x != nil {
+		return x.IncludeWorkloadStatus
+	}
+	return false
+}
+
+// GetInstalledPackageDetailsBatchRequest
 //
-// Response for GetInstalledPackageSummaries
-type GetInstalledPackageSummariesResponse struct {
+// Request for GetInstalledPackageDetailsBatch
+type GetInstalledPackageDetailsBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Installed packages summaries
-	//
-	// List of InstalledPackageSummary
-	InstalledPackageSummaries []*InstalledPackageSummary `protobuf:"bytes,1,rep,name=installed_package_summaries,json=installedPackageSummaries,proto3" json:"installed_package_summaries,omitempty"`
-	// Next page token
+	// The references to uniquely identify the installed packages, possibly
+	// managed by different plugins.
+	InstalledPackageRefs []*InstalledPackageReference `protobuf:"bytes,1,rep,name=installed_package_refs,json=installedPackageRefs,proto3" json:"installed_package_refs,omitempty"`
+	// Include workload status
 	//
-	// This field represents the pagination token to retrieve the next page of
-	// results. If the value is "", it means no further results for the request.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Whether to include the rollup of the workload status of each installed
+	// package, as for GetInstalledPackageDetail.
+	IncludeWorkloadStatus bool `protobuf:"varint,2,opt,name=include_workload_status,json=includeWorkloadStatus,proto3" json:"include_workload_status,omitempty"`
 }
 
-func (x *GetInstalledPackageSummariesResponse) Reset() {
-	*x = GetInstalledPackageSummariesResponse{}
+func (x *GetInstalledPackageDetailsBatchRequest) Reset() {
+	*x = GetInstalledPackageDetailsBatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -868,13 +1209,13 @@ func (x *GetInstalledPackageSummariesResponse) Reset() {
 	}
 }
 
-func (x *GetInstalledPackageSummariesResponse) String() string {
+func (x *GetInstalledPackageDetailsBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInstalledPackageSummariesResponse) ProtoMessage() {}
+func (*GetInstalledPackageDetailsBatchRequest) ProtoMessage() {}
 
-func (x *GetInstalledPackageSummariesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetInstalledPackageDetailsBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -886,41 +1227,40 @@ func (x *GetInstalledPackageSummariesResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInstalledPackageSummariesResponse.ProtoReflect.Descriptor instead.
-func (*GetInstalledPackageSummariesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInstalledPackageDetailsBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageDetailsBatchRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetInstalledPackageSummariesResponse) GetInstalledPackageSummaries() []*InstalledPackageSummary {
+func (x *GetInstalledPackageDetailsBatchRequest) GetInstalledPackageRefs() []*InstalledPackageReference {
 	if x != nil {
-		return x.InstalledPackageSummaries
+		return x.InstalledPackageRefs
 	}
 	return nil
 }
 
-func (x *GetInstalledPackageSummariesResponse) GetNextPageToken() string {
+func (x *GetInstalledPackageDetailsBatchRequest) GetIncludeWorkloadStatus() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.IncludeWorkloadStatus
 	}
-	return ""
+	return false
 }
 
-// GetInstalledPackageDetailResponse
+// GetInstalledPackageValuesRequest
 //
-// Response for GetInstalledPackageDetail
-type GetInstalledPackageDetailResponse struct {
+// Request for GetInstalledPackageValues
+type GetInstalledPackageValuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// InstalledPackageDetail
-	//
-	// The requested InstalledPackageDetail
-	InstalledPackageDetail *InstalledPackageDetail `protobuf:"bytes,1,opt,name=installed_package_detail,json=installedPackageDetail,proto3" json:"installed_package_detail,omitempty"`
+	// The information required to uniquely
+	// identify an installed package
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
 }
 
-func (x *GetInstalledPackageDetailResponse) Reset() {
-	*x = GetInstalledPackageDetailResponse{}
+func (x *GetInstalledPackageValuesRequest) Reset() {
+	*x = GetInstalledPackageValuesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -928,13 +1268,13 @@ func (x *GetInstalledPackageDetailResponse) Reset() {
 	}
 }
 
-func (x *GetInstalledPackageDetailResponse) String() string {
+func (x *GetInstalledPackageValuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInstalledPackageDetailResponse) ProtoMessage() {}
+func (*GetInstalledPackageValuesRequest) ProtoMessage() {}
 
-func (x *GetInstalledPackageDetailResponse) ProtoReflect() protoreflect.Message {
+func (x *GetInstalledPackageValuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -946,31 +1286,73 @@ func (x *GetInstalledPackageDetailResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInstalledPackageDetailResponse.ProtoReflect.Descriptor instead.
-func (*GetInstalledPackageDetailResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInstalledPackageValuesRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageValuesRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetInstalledPackageDetailResponse) GetInstalledPackageDetail() *InstalledPackageDetail {
+func (x *GetInstalledPackageValuesRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.InstalledPackageDetail
+		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-// CreateInstalledPackageResponse
+// CreateInstalledPackageRequest
 //
-// Response for CreateInstalledPackage
-type CreateInstalledPackageResponse struct {
+// Request for CreateInstalledPackage
+type CreateInstalledPackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// A reference uniquely identifying the package available for installation.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// The target context where the package is intended to be installed.
+	TargetContext *Context `protobuf:"bytes,2,opt,name=target_context,json=targetContext,proto3" json:"target_context,omitempty"`
+	// A user-provided name for the installed package (eg. project-x-db)
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// For helm this will be the exact version in VersionReference.version
+	// For other plugins we can extend the VersionReference as needed.
+	PkgVersionReference *VersionReference `protobuf:"bytes,4,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// An optional serialized values string to be included when templating a package
+	// in the format expected by the plugin. Included when the backend format doesn't
+	// use secrets or configmaps for values or supports both. These values are layered
+	// on top of any values refs above, when relevant.
+	Values string `protobuf:"bytes,5,opt,name=values,proto3" json:"values,omitempty"`
+	// An optional field for specifying data common to systems that reconcile
+	// the package on the cluster.
+	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,6,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
+	// Reconcile interval
+	//
+	// An optional interval, as a duration (eg. "10m" or "1h30m"), with which
+	// the package is checked for reconciliation by plugins supporting it (eg.
+	// flux). It overrides the interval of the reconciliation options and is
+	// ignored by the other plugins.
+	ReconcileInterval string `protobuf:"bytes,7,opt,name=reconcile_interval,json=reconcileInterval,proto3" json:"reconcile_interval,omitempty"`
+	// Labels
+	//
+	// Optional labels (eg. team or cost-center) applied to the resources created
+	// for the installed package by plugins supporting it. Ignored by the other
+	// plugins.
+	Labels map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Annotations
+	//
+	// Optional annotations applied to the resources created for the installed
+	// package by plugins supporting it. Ignored by the other plugins.
+	Annotations map[string]string `protobuf:"bytes,9,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Install timeout
+	//
+	// An optional timeout, as a duration (eg. "5m"), forwarded to the plugins
+	// supporting it: the helm plugin bounds with it the Kubernetes operations of
+	// the install, such as the hooks, as the helm --timeout flag without waiting
+	// for the release, while the flux plugin sets the HelmRelease timeout. The
+	// default install timeout of the server, if any, is used when not specified.
+	InstallTimeout string `protobuf:"bytes,10,opt,name=install_timeout,json=installTimeout,proto3" json:"install_timeout,omitempty"`
 }
 
-func (x *CreateInstalledPackageResponse) Reset() {
-	*x = CreateInstalledPackageResponse{}
+func (x *CreateInstalledPackageRequest) Reset() {
+	*x = CreateInstalledPackageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -978,13 +1360,13 @@ func (x *CreateInstalledPackageResponse) Reset() {
 	}
 }
 
-func (x *CreateInstalledPackageResponse) String() string {
+func (x *CreateInstalledPackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInstalledPackageResponse) ProtoMessage() {}
+func (*CreateInstalledPackageRequest) ProtoMessage() {}
 
-func (x *CreateInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateInstalledPackageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -996,31 +1378,118 @@ func (x *CreateInstalledPackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInstalledPackageResponse.ProtoReflect.Descriptor instead.
-func (*CreateInstalledPackageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateInstalledPackageRequest.ProtoReflect.Descriptor instead.
+func (*CreateInstalledPackageRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateInstalledPackageResponse) GetInstalledPackageRef() *InstalledPackageReference {
+func (x *CreateInstalledPackageRequest) GetAvailablePackageRef() *AvailablePackageReference {
 	if x != nil {
-		return x.InstalledPackageRef
+		return x.AvailablePackageRef
 	}
 	return nil
 }
 
-// UpdateInstalledPackageResponse
+func (x *CreateInstalledPackageRequest) GetTargetContext() *Context {
+	if x != nil {
+		return x.TargetContext
+	}
+	return nil
+}
+
+func (x *CreateInstalledPackageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateInstalledPackageRequest) GetPkgVersionReference() *VersionReference {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
+}
+
+func (x *CreateInstalledPackageRequest) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *CreateInstalledPackageRequest) GetReconciliationOptions() *ReconciliationOptions {
+	if x != nil {
+		return x.ReconciliationOptions
+	}
+	return nil
+}
+
+func (x *CreateInstalledPackageRequest) GetReconcileInterval() string {
+	if x != nil {
+		return x.ReconcileInterval
+	}
+	return ""
+}
+
+func (x *CreateInstalledPackageRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateInstalledPackageRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *CreateInstalledPackageRequest) GetInstallTimeout() string {
+	if x != nil {
+		return x.InstallTimeout
+	}
+	return ""
+}
+
+// UpdateInstalledPackageRequest
 //
-// Response for UpdateInstalledPackage
-type UpdateInstalledPackageResponse struct {
+// Request for UpdateInstalledPackage. The intent is to reach the desired state specified
+// by the fields in the request, while leaving other fields intact. This is a whole
+// object "Update" semantics rather than "Patch" semantics. The caller will provide the
+// values for the fields fields below, which will replace, or be overlayed onto, the
+// corresponding fields in the existing resource. For example, with the
+// UpdateInstalledPackageRequest, it is not possible to change just the 'package version
+// reference' without also specifying 'values' field. As a side effect, not specifying the
+// 'values' field in the request means there are no values specified in the desired state.
+// So the meaning of each field value is describing the desired state of the corresponding
+// field in the resource after the update operation has completed the renconciliation.
+type UpdateInstalledPackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// A reference uniquely identifying the installed package being updated.
+	// Required
 	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// For helm this will be the exact version in VersionReference.version
+	// For fluxv2 this could be any semver constraint expression
+	// For other plugins we can extend the VersionReference as needed. Optional
+	PkgVersionReference *VersionReference `protobuf:"bytes,2,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// An optional serialized values string to be included when templating a
+	// package in the format expected by the plugin. Included when the backend
+	// format doesn't use secrets or configmaps for values or supports both.
+	// These values are layered on top of any values refs above, when
+	// relevant.
+	Values string `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
+	// An optional field for specifying data common to systems that reconcile
+	// the package on the cluster.
+	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,4,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
 }
 
-func (x *UpdateInstalledPackageResponse) Reset() {
-	*x = UpdateInstalledPackageResponse{}
+func (x *UpdateInstalledPackageRequest) Reset() {
+	*x = UpdateInstalledPackageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1028,13 +1497,13 @@ func (x *UpdateInstalledPackageResponse) Reset() {
 	}
 }
 
-func (x *UpdateInstalledPackageResponse) String() string {
+func (x *UpdateInstalledPackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInstalledPackageResponse) ProtoMessage() {}
+func (*UpdateInstalledPackageRequest) ProtoMessage() {}
 
-func (x *UpdateInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateInstalledPackageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1046,29 +1515,60 @@ func (x *UpdateInstalledPackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInstalledPackageResponse.ProtoReflect.Descriptor instead.
-func (*UpdateInstalledPackageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateInstalledPackageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstalledPackageRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateInstalledPackageResponse) GetInstalledPackageRef() *InstalledPackageReference {
+func (x *UpdateInstalledPackageRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
 		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-// DeleteInstalledPackageResponse
+func (x *UpdateInstalledPackageRequest) GetPkgVersionReference() *VersionReference {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
+}
+
+func (x *UpdateInstalledPackageRequest) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *UpdateInstalledPackageRequest) GetReconciliationOptions() *ReconciliationOptions {
+	if x != nil {
+		return x.ReconciliationOptions
+	}
+	return nil
+}
+
+// GetInstalledPackageUpgradeDiffRequest
 //
-// Response for DeleteInstalledPackage
-type DeleteInstalledPackageResponse struct {
+// Request for GetInstalledPackageUpgradeDiff, with the same fields as the
+// UpdateInstalledPackageRequest for which the diff is previewed.
+type GetInstalledPackageUpgradeDiffRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// A reference uniquely identifying the installed package to be updated.
+	// Required
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// The target version of the package, as in the UpdateInstalledPackageRequest.
+	PkgVersionReference *VersionReference `protobuf:"bytes,2,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// The optional values for the target version, as in the
+	// UpdateInstalledPackageRequest.
+	Values string `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *DeleteInstalledPackageResponse) Reset() {
-	*x = DeleteInstalledPackageResponse{}
+func (x *GetInstalledPackageUpgradeDiffRequest) Reset() {
+	*x = GetInstalledPackageUpgradeDiffRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1076,13 +1576,13 @@ func (x *DeleteInstalledPackageResponse) Reset() {
 	}
 }
 
-func (x *DeleteInstalledPackageResponse) String() string {
+func (x *GetInstalledPackageUpgradeDiffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInstalledPackageResponse) ProtoMessage() {}
+func (*GetInstalledPackageUpgradeDiffRequest) ProtoMessage() {}
 
-func (x *DeleteInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+func (x *GetInstalledPackageUpgradeDiffRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1094,54 +1594,48 @@ func (x *DeleteInstalledPackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInstalledPackageResponse.ProtoReflect.Descriptor instead.
-func (*DeleteInstalledPackageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInstalledPackageUpgradeDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageUpgradeDiffRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{15}
 }
 
-// AvailablePackageSummary
-//
-// An AvailablePackageSummary provides a summary of a package available for installation
-// useful when aggregating many available packages.
-type AvailablePackageSummary struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetInstalledPackageUpgradeDiffRequest) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
 
-	// Available package reference
-	//
-	// A reference uniquely identifying the package.
-	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
-	// Available package name
-	//
-	// The name of the available package
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Latest available version
-	//
-	// The latest version available for this package. Often expected when viewing
-	// a summary of many available packages.
-	LatestVersion *PackageAppVersion `protobuf:"bytes,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
-	// Available package Icon URL
-	//
-	// A url for an icon.
-	IconUrl string `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	// Available package display name
-	//
-	// A name as displayed to users
-	DisplayName string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// Available package short description
-	//
-	// A short description of the app provided by the package
-	ShortDescription string `protobuf:"bytes,6,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
-	// Available package categories
-	//
-	// A user-facing list of category names useful for creating richer user interfaces.
-	// Plugins can choose not to implement this
-	Categories []string `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+func (x *GetInstalledPackageUpgradeDiffRequest) GetPkgVersionReference() *VersionReference {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
 }
 
-func (x *AvailablePackageSummary) Reset() {
-	*x = AvailablePackageSummary{}
+func (x *GetInstalledPackageUpgradeDiffRequest) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+// GetInstalledPackageDriftRequest
+//
+// Request for GetInstalledPackageDrift
+type GetInstalledPackageDriftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A reference uniquely identifying the installed package to be compared
+	// with the current state of the cluster.
+	// Required
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+}
+
+func (x *GetInstalledPackageDriftRequest) Reset() {
+	*x = GetInstalledPackageDriftRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1149,13 +1643,13 @@ func (x *AvailablePackageSummary) Reset() {
 	}
 }
 
-func (x *AvailablePackageSummary) String() string {
+func (x *GetInstalledPackageDriftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailablePackageSummary) ProtoMessage() {}
+func (*GetInstalledPackageDriftRequest) ProtoMessage() {}
 
-func (x *AvailablePackageSummary) ProtoReflect() protoreflect.Message {
+func (x *GetInstalledPackageDriftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1167,149 +1661,113 @@ func (x *AvailablePackageSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailablePackageSummary.ProtoReflect.Descriptor instead.
-func (*AvailablePackageSummary) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInstalledPackageDriftRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageDriftRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AvailablePackageSummary) GetAvailablePackageRef() *AvailablePackageReference {
+func (x *GetInstalledPackageDriftRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.AvailablePackageRef
+		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-func (x *AvailablePackageSummary) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// GetInstalledPackageUpdateAvailableRequest
+//
+// Request for GetInstalledPackageUpdateAvailable
+type GetInstalledPackageUpdateAvailableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A reference uniquely identifying the installed package whose version is
+	// compared with the available versions.
+	// Required
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// Include pre-release
+	//
+	// Whether the pre-release versions (eg. "2.0.0-beta.1") are considered as
+	// updates, rather than only the stable versions.
+	IncludePrerelease bool `protobuf:"varint,2,opt,name=include_prerelease,json=includePrerelease,proto3" json:"include_prerelease,omitempty"`
 }
 
-func (x *AvailablePackageSummary) GetLatestVersion() *PackageAppVersion {
-	if x != nil {
-		return x.LatestVersion
+func (x *GetInstalledPackageUpdateAvailableRequest) Reset() {
+	*x = GetInstalledPackageUpdateAvailableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AvailablePackageSummary) GetIconUrl() string {
-	if x != nil {
-		return x.IconUrl
-	}
-	return ""
+func (x *GetInstalledPackageUpdateAvailableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AvailablePackageSummary) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
+func (*GetInstalledPackageUpdateAvailableRequest) ProtoMessage() {}
+
+func (x *GetInstalledPackageUpdateAvailableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AvailablePackageSummary) GetShortDescription() string {
+// Deprecated: Use GetInstalledPackageUpdateAvailableRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageUpdateAvailableRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetInstalledPackageUpdateAvailableRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.ShortDescription
+		return x.InstalledPackageRef
 	}
-	return ""
+	return nil
 }
 
-func (x *AvailablePackageSummary) GetCategories() []string {
+func (x *GetInstalledPackageUpdateAvailableRequest) GetIncludePrerelease() bool {
 	if x != nil {
-		return x.Categories
+		return x.IncludePrerelease
 	}
-	return nil
+	return false
 }
 
-// AvailablePackageDetail
+// ExportInstalledPackageRequest
 //
-// An AvailablePackageDetail provides additional details required when
-// inspecting an individual package.
-type AvailablePackageDetail struct {
+// Request for ExportInstalledPackage
+type ExportInstalledPackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Available package reference
-	//
-	// A reference uniquely identifying the package.
-	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
-	// Available package name
-	//
-	// The name of the available package
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Available version
-	//
-	// The version of the package and application.
-	Version *PackageAppVersion `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	// the url of the package repository that contains this package
-	RepoUrl string `protobuf:"bytes,4,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
-	// the url of the “home” for the package
-	HomeUrl string `protobuf:"bytes,5,opt,name=home_url,json=homeUrl,proto3" json:"home_url,omitempty"`
-	// Available package icon URL
-	//
-	// A url for an icon.
-	IconUrl string `protobuf:"bytes,6,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	// Available package display name
-	//
-	// A name as displayed to users
-	DisplayName string `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// Available package short description
-	//
-	// A short description of the app provided by the package
-	ShortDescription string `protobuf:"bytes,8,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
-	// Available package long description
-	//
-	// A longer description of the package, a few sentences.
-	LongDescription string `protobuf:"bytes,9,opt,name=long_description,json=longDescription,proto3" json:"long_description,omitempty"`
-	// Available package readme
-	//
-	// A longer README with potentially pages of formatted Markdown.
-	Readme string `protobuf:"bytes,10,opt,name=readme,proto3" json:"readme,omitempty"`
-	// Available package default values
-	//
-	// An example of default values used during package templating that can serve
-	// as documentation or a starting point for user customization.
-	DefaultValues string `protobuf:"bytes,11,opt,name=default_values,json=defaultValues,proto3" json:"default_values,omitempty"`
-	ValuesSchema  string `protobuf:"bytes,12,opt,name=values_schema,json=valuesSchema,proto3" json:"values_schema,omitempty"`
-	// source urls for the package
-	SourceUrls []string `protobuf:"bytes,13,rep,name=source_urls,json=sourceUrls,proto3" json:"source_urls,omitempty"`
-	// Available package maintainers
-	//
-	// List of Maintainer
-	Maintainers []*Maintainer `protobuf:"bytes,14,rep,name=maintainers,proto3" json:"maintainers,omitempty"`
-	// Available package categories
-	//
-	// A user-facing list of category names useful for creating richer user interfaces.
-	// Plugins can choose not to implement this
-	Categories []string `protobuf:"bytes,15,rep,name=categories,proto3" json:"categories,omitempty"`
-	// Custom data added by the plugin
-	//
-	// A plugin can define custom details for data which is not yet, or never will
-	// be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
-	// of an `Any` field means that each plugin can define the structure of this
-	// message as required, while still satisfying the core interface.
-	// See https://developers.google.com/protocol-buffers/docs/proto3#any
-	CustomDetail *anypb.Any `protobuf:"bytes,16,opt,name=custom_detail,json=customDetail,proto3" json:"custom_detail,omitempty"`
+	// A reference uniquely identifying the installed package to be exported.
+	// Required
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
 }
 
-func (x *AvailablePackageDetail) Reset() {
-	*x = AvailablePackageDetail{}
+func (x *ExportInstalledPackageRequest) Reset() {
+	*x = ExportInstalledPackageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AvailablePackageDetail) String() string {
+func (x *ExportInstalledPackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailablePackageDetail) ProtoMessage() {}
+func (*ExportInstalledPackageRequest) ProtoMessage() {}
 
-func (x *AvailablePackageDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17]
+func (x *ExportInstalledPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,200 +1778,161 @@ func (x *AvailablePackageDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailablePackageDetail.ProtoReflect.Descriptor instead.
-func (*AvailablePackageDetail) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ExportInstalledPackageRequest.ProtoReflect.Descriptor instead.
+func (*ExportInstalledPackageRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AvailablePackageDetail) GetAvailablePackageRef() *AvailablePackageReference {
+func (x *ExportInstalledPackageRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.AvailablePackageRef
+		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-func (x *AvailablePackageDetail) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// DeleteInstalledPackageRequest
+//
+// Request for DeleteInstalledPackage
+type DeleteInstalledPackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A reference to uniquely identify the installed package to be deleted.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
 }
 
-func (x *AvailablePackageDetail) GetVersion() *PackageAppVersion {
-	if x != nil {
-		return x.Version
+func (x *DeleteInstalledPackageRequest) Reset() {
+	*x = DeleteInstalledPackageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AvailablePackageDetail) GetRepoUrl() string {
-	if x != nil {
-		return x.RepoUrl
-	}
-	return ""
+func (x *DeleteInstalledPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AvailablePackageDetail) GetHomeUrl() string {
-	if x != nil {
-		return x.HomeUrl
+func (*DeleteInstalledPackageRequest) ProtoMessage() {}
+
+func (x *DeleteInstalledPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AvailablePackageDetail) GetIconUrl() string {
-	if x != nil {
-		return x.IconUrl
-	}
-	return ""
+// Deprecated: Use DeleteInstalledPackageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInstalledPackageRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AvailablePackageDetail) GetDisplayName() string {
+func (x *DeleteInstalledPackageRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.DisplayName
+		return x.InstalledPackageRef
 	}
-	return ""
+	return nil
 }
 
-func (x *AvailablePackageDetail) GetShortDescription() string {
-	if x != nil {
-		return x.ShortDescription
-	}
-	return ""
-}
+// DeleteInstalledPackagesBatchRequest
+//
+// Request for DeleteInstalledPackagesBatch
+type DeleteInstalledPackagesBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AvailablePackageDetail) GetLongDescription() string {
-	if x != nil {
-		return x.LongDescription
-	}
-	return ""
+	// The references to uniquely identify the installed packages to be deleted.
+	InstalledPackageRefs []*InstalledPackageReference `protobuf:"bytes,1,rep,name=installed_package_refs,json=installedPackageRefs,proto3" json:"installed_package_refs,omitempty"`
+	// Concurrent
+	//
+	// Whether the installed packages are deleted concurrently rather than
+	// one after the other.
+	Concurrent bool `protobuf:"varint,2,opt,name=concurrent,proto3" json:"concurrent,omitempty"`
 }
 
-func (x *AvailablePackageDetail) GetReadme() string {
-	if x != nil {
-		return x.Readme
+func (x *DeleteInstalledPackagesBatchRequest) Reset() {
+	*x = DeleteInstalledPackagesBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AvailablePackageDetail) GetDefaultValues() string {
-	if x != nil {
-		return x.DefaultValues
-	}
-	return ""
+func (x *DeleteInstalledPackagesBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AvailablePackageDetail) GetValuesSchema() string {
-	if x != nil {
-		return x.ValuesSchema
-	}
-	return ""
-}
+func (*DeleteInstalledPackagesBatchRequest) ProtoMessage() {}
 
-func (x *AvailablePackageDetail) GetSourceUrls() []string {
-	if x != nil {
-		return x.SourceUrls
+func (x *DeleteInstalledPackagesBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AvailablePackageDetail) GetMaintainers() []*Maintainer {
-	if x != nil {
-		return x.Maintainers
-	}
-	return nil
+// Deprecated: Use DeleteInstalledPackagesBatchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInstalledPackagesBatchRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *AvailablePackageDetail) GetCategories() []string {
+func (x *DeleteInstalledPackagesBatchRequest) GetInstalledPackageRefs() []*InstalledPackageReference {
 	if x != nil {
-		return x.Categories
+		return x.InstalledPackageRefs
 	}
 	return nil
 }
 
-func (x *AvailablePackageDetail) GetCustomDetail() *anypb.Any {
+func (x *DeleteInstalledPackagesBatchRequest) GetConcurrent() bool {
 	if x != nil {
-		return x.CustomDetail
+		return x.Concurrent
 	}
-	return nil
+	return false
 }
 
-// InstalledPackageSummary
+// GetCatalogStatsRequest
 //
-// An InstalledPackageSummary provides a summary of an installed package
-// useful when aggregating many installed packages.
-type InstalledPackageSummary struct {
+// Request for GetCatalogStats
+type GetCatalogStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// InstalledPackageReference
-	//
-	// A reference uniquely identifying the package.
-	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
-	// Name
-	//
-	// A name given to the installation of the package (eg. "my-postgresql-for-testing").
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// PkgVersionReference
-	//
-	// The package version reference defines a version or constraint limiting
-	// matching package versions.
-	PkgVersionReference *VersionReference `protobuf:"bytes,3,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
-	// CurrentVersion
-	//
-	// The current version of the package being reconciled, which may be
-	// in one of these states:
-	//  - has been successfully installed/upgraded or
-	//  - is currently being installed/upgraded or
-	//  - has failed to install/upgrade
-	CurrentVersion *PackageAppVersion `protobuf:"bytes,4,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
-	// Installed package icon URL
-	//
-	// A url for an icon.
-	IconUrl string `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	// PackageDisplayName
-	//
-	// The package name as displayed to users (provided by the package, eg. "PostgreSQL")
-	PkgDisplayName string `protobuf:"bytes,6,opt,name=pkg_display_name,json=pkgDisplayName,proto3" json:"pkg_display_name,omitempty"`
-	// ShortDescription
-	//
-	// A short description of the package (provided by the package)
-	ShortDescription string `protobuf:"bytes,7,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
-	// LatestMatchingVersion
-	//
-	// Only non-empty if an available upgrade matches the specified pkg_version_reference.
-	// For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
-	// is installed, then:
-	//   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
-	//   * if 10.4 is available while >10.3.1 is not, this should remain empty.
-	LatestMatchingVersion *PackageAppVersion `protobuf:"bytes,8,opt,name=latest_matching_version,json=latestMatchingVersion,proto3" json:"latest_matching_version,omitempty"`
-	// LatestVersion
-	//
-	// The latest version available for this package, regardless of the pkg_version_reference.
-	LatestVersion *PackageAppVersion `protobuf:"bytes,9,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
-	// Status
-	//
-	// The current status of the installed package.
-	Status *InstalledPackageStatus `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// The context (cluster/namespace) for the request
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 }
 
-func (x *InstalledPackageSummary) Reset() {
-	*x = InstalledPackageSummary{}
+func (x *GetCatalogStatsRequest) Reset() {
+	*x = GetCatalogStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstalledPackageSummary) String() string {
+func (x *GetCatalogStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledPackageSummary) ProtoMessage() {}
+func (*GetCatalogStatsRequest) ProtoMessage() {}
 
-func (x *InstalledPackageSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18]
+func (x *GetCatalogStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,169 +1943,167 @@ func (x *InstalledPackageSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledPackageSummary.ProtoReflect.Descriptor instead.
-func (*InstalledPackageSummary) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetCatalogStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogStatsRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *InstalledPackageSummary) GetInstalledPackageRef() *InstalledPackageReference {
+func (x *GetCatalogStatsRequest) GetContext() *Context {
 	if x != nil {
-		return x.InstalledPackageRef
+		return x.Context
 	}
 	return nil
 }
 
-func (x *InstalledPackageSummary) GetName() string {
-	if x != nil {
-		return x.Name
+// GetRecentActivityRequest
+//
+// Request for GetRecentActivity
+type GetRecentActivityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The context (cluster/namespace) for the request
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// Pagination options specifying where to start and how many results to include.
+	PaginationOptions *PaginationOptions `protobuf:"bytes,2,opt,name=pagination_options,json=paginationOptions,proto3" json:"pagination_options,omitempty"`
+}
+
+func (x *GetRecentActivityRequest) Reset() {
+	*x = GetRecentActivityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *InstalledPackageSummary) GetPkgVersionReference() *VersionReference {
-	if x != nil {
-		return x.PkgVersionReference
+func (x *GetRecentActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentActivityRequest) ProtoMessage() {}
+
+func (x *GetRecentActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *InstalledPackageSummary) GetCurrentVersion() *PackageAppVersion {
+// Deprecated: Use GetRecentActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentActivityRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRecentActivityRequest) GetContext() *Context {
 	if x != nil {
-		return x.CurrentVersion
+		return x.Context
 	}
 	return nil
 }
 
-func (x *InstalledPackageSummary) GetIconUrl() string {
+func (x *GetRecentActivityRequest) GetPaginationOptions() *PaginationOptions {
 	if x != nil {
-		return x.IconUrl
+		return x.PaginationOptions
 	}
-	return ""
+	return nil
 }
 
-func (x *InstalledPackageSummary) GetPkgDisplayName() string {
-	if x != nil {
-		return x.PkgDisplayName
-	}
-	return ""
+// GetUserPermissionsRequest
+//
+// Request for GetUserPermissions
+type GetUserPermissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The context (cluster/namespace) in which the permissions are checked
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// The plugin managing the packages for which the permissions are checked
+	Plugin *v1alpha1.Plugin `protobuf:"bytes,2,opt,name=plugin,proto3" json:"plugin,omitempty"`
 }
 
-func (x *InstalledPackageSummary) GetShortDescription() string {
-	if x != nil {
-		return x.ShortDescription
+func (x *GetUserPermissionsRequest) Reset() {
+	*x = GetUserPermissionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *InstalledPackageSummary) GetLatestMatchingVersion() *PackageAppVersion {
-	if x != nil {
-		return x.LatestMatchingVersion
+func (x *GetUserPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPermissionsRequest) ProtoMessage() {}
+
+func (x *GetUserPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *InstalledPackageSummary) GetLatestVersion() *PackageAppVersion {
+// Deprecated: Use GetUserPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetUserPermissionsRequest) GetContext() *Context {
 	if x != nil {
-		return x.LatestVersion
+		return x.Context
 	}
 	return nil
 }
 
-func (x *InstalledPackageSummary) GetStatus() *InstalledPackageStatus {
+func (x *GetUserPermissionsRequest) GetPlugin() *v1alpha1.Plugin {
 	if x != nil {
-		return x.Status
+		return x.Plugin
 	}
 	return nil
 }
 
-// InstalledPackageDetail
+// GetCatalogIndexRequest
 //
-// An InstalledPackageDetail includes details about the installed package that are
-// typically useful when presenting a single installed package.
-type InstalledPackageDetail struct {
+// Request for GetCatalogIndex
+type GetCatalogIndexRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// InstalledPackageReference
-	//
-	// A reference uniquely identifying the installed package.
-	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
-	// PkgVersionReference
-	//
-	// The package version reference defines a version or constraint limiting
-	// matching package versions.
-	PkgVersionReference *VersionReference `protobuf:"bytes,2,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
-	// Installed package name
-	//
-	// The name given to the installed package
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// CurrentVersion
-	//
-	// The version of the package which is currently installed.
-	CurrentVersion *PackageAppVersion `protobuf:"bytes,4,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
-	// ValuesApplied
-	//
-	// The values applied currently for the installed package.
-	ValuesApplied string `protobuf:"bytes,5,opt,name=values_applied,json=valuesApplied,proto3" json:"values_applied,omitempty"`
-	// ReconciliationOptions
-	//
-	// An optional field specifying data common to systems that reconcile
-	// the package installation on the cluster asynchronously. In particular,
-	// this specifies the service account used to perform the reconcilliation.
-	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,6,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
-	// Status
-	//
-	// The current status of the installed package.
-	Status *InstalledPackageStatus `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	// PostInstallationNotes
-	//
-	// Optional notes generated by package and intended for the user post installation.
-	PostInstallationNotes string `protobuf:"bytes,8,opt,name=post_installation_notes,json=postInstallationNotes,proto3" json:"post_installation_notes,omitempty"`
-	// Available package reference
-	//
-	// A reference to the available package for this installation.
-	// Useful to lookup the package display name, icon and other info.
-	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,9,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
-	// LatestMatchingVersion
-	//
-	// Only non-empty if an available upgrade matches the specified pkg_version_reference.
-	// For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
-	// is installed, then:
-	//   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
-	//   * if 10.4 is available while >10.3.1 is not, this should remain empty.
-	LatestMatchingVersion *PackageAppVersion `protobuf:"bytes,10,opt,name=latest_matching_version,json=latestMatchingVersion,proto3" json:"latest_matching_version,omitempty"`
-	// LatestVersion
-	//
-	// The latest version available for this package, regardless of the pkg_version_reference.
-	LatestVersion *PackageAppVersion `protobuf:"bytes,11,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
-	// Custom data added by the plugin
-	//
-	// A plugin can define custom details for data which is not yet, or never will
-	// be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
-	// of an `Any` field means that each plugin can define the structure of this
-	// message as required, while still satisfying the core interface.
-	// See https://developers.google.com/protocol-buffers/docs/proto3#any
-	CustomDetail *anypb.Any `protobuf:"bytes,14,opt,name=custom_detail,json=customDetail,proto3" json:"custom_detail,omitempty"`
+	// The context (cluster/namespace) for the request
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 }
 
-func (x *InstalledPackageDetail) Reset() {
-	*x = InstalledPackageDetail{}
+func (x *GetCatalogIndexRequest) Reset() {
+	*x = GetCatalogIndexRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstalledPackageDetail) String() string {
+func (x *GetCatalogIndexRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledPackageDetail) ProtoMessage() {}
+func (*GetCatalogIndexRequest) ProtoMessage() {}
 
-func (x *InstalledPackageDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19]
+func (x *GetCatalogIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1697,136 +2114,142 @@ func (x *InstalledPackageDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledPackageDetail.ProtoReflect.Descriptor instead.
-func (*InstalledPackageDetail) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetCatalogIndexRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogIndexRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *InstalledPackageDetail) GetInstalledPackageRef() *InstalledPackageReference {
+func (x *GetCatalogIndexRequest) GetContext() *Context {
 	if x != nil {
-		return x.InstalledPackageRef
+		return x.Context
 	}
 	return nil
 }
 
-func (x *InstalledPackageDetail) GetPkgVersionReference() *VersionReference {
-	if x != nil {
-		return x.PkgVersionReference
-	}
-	return nil
-}
+// GetAvailablePackageSummariesResponse
+//
+// Response for GetAvailablePackageSummaries
+type GetAvailablePackageSummariesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *InstalledPackageDetail) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+	// Available packages summaries
+	//
+	// List of AvailablePackageSummary
+	AvailablePackageSummaries []*AvailablePackageSummary `protobuf:"bytes,1,rep,name=available_package_summaries,json=availablePackageSummaries,proto3" json:"available_package_summaries,omitempty"`
+	// Next page token
+	//
+	// This field represents the pagination token to retrieve the next page of
+	// results. If the value is "", it means no further results for the request.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Categories
+	//
+	// This optional field contains the distinct category names considering the FilterOptions.
+	Categories []string `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Category counts
+	//
+	// The number of available packages matching the FilterOptions in each
+	// category, across all the pages, ordered as the categories. Only returned
+	// when include_category_counts is set.
+	CategoryCounts []*CategoryCount `protobuf:"bytes,4,rep,name=category_counts,json=categoryCounts,proto3" json:"category_counts,omitempty"`
 }
 
-func (x *InstalledPackageDetail) GetCurrentVersion() *PackageAppVersion {
-	if x != nil {
-		return x.CurrentVersion
+func (x *GetAvailablePackageSummariesResponse) Reset() {
+	*x = GetAvailablePackageSummariesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *InstalledPackageDetail) GetValuesApplied() string {
-	if x != nil {
-		return x.ValuesApplied
-	}
-	return ""
+func (x *GetAvailablePackageSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InstalledPackageDetail) GetReconciliationOptions() *ReconciliationOptions {
-	if x != nil {
-		return x.ReconciliationOptions
-	}
-	return nil
-}
+func (*GetAvailablePackageSummariesResponse) ProtoMessage() {}
 
-func (x *InstalledPackageDetail) GetStatus() *InstalledPackageStatus {
-	if x != nil {
-		return x.Status
+func (x *GetAvailablePackageSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *InstalledPackageDetail) GetPostInstallationNotes() string {
-	if x != nil {
-		return x.PostInstallationNotes
-	}
-	return ""
+// Deprecated: Use GetAvailablePackageSummariesResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *InstalledPackageDetail) GetAvailablePackageRef() *AvailablePackageReference {
+func (x *GetAvailablePackageSummariesResponse) GetAvailablePackageSummaries() []*AvailablePackageSummary {
 	if x != nil {
-		return x.AvailablePackageRef
+		return x.AvailablePackageSummaries
 	}
 	return nil
 }
 
-func (x *InstalledPackageDetail) GetLatestMatchingVersion() *PackageAppVersion {
+func (x *GetAvailablePackageSummariesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.LatestMatchingVersion
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *InstalledPackageDetail) GetLatestVersion() *PackageAppVersion {
+func (x *GetAvailablePackageSummariesResponse) GetCategories() []string {
 	if x != nil {
-		return x.LatestVersion
+		return x.Categories
 	}
 	return nil
 }
 
-func (x *InstalledPackageDetail) GetCustomDetail() *anypb.Any {
+func (x *GetAvailablePackageSummariesResponse) GetCategoryCounts() []*CategoryCount {
 	if x != nil {
-		return x.CustomDetail
+		return x.CategoryCounts
 	}
 	return nil
 }
 
-// Context
+// CategoryCount
 //
-// A Context specifies the context of the message
-type Context struct {
+// The number of available packages in a category
+type CategoryCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Cluster
+	// Name
 	//
-	// A cluster name can be provided to target a specific cluster if multiple
-	// clusters are configured, otherwise all clusters will be assumed.
-	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	// Namespace
+	// The name of the category
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Count
 	//
-	// A namespace must be provided if the context of the operation is for a resource
-	// or resources in a particular namespace.
-	// For requests to list items, not including a namespace here implies that the context
-	// for the request is everything the requesting user can read, though the result can
-	// be filtered by any filtering options of the request. Plugins may choose to return
-	// Unimplemented for some queries for which we do not yet have a need.
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The number of available packages in the category
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *Context) Reset() {
-	*x = Context{}
+func (x *CategoryCount) Reset() {
+	*x = CategoryCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Context) String() string {
+func (x *CategoryCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Context) ProtoMessage() {}
+func (*CategoryCount) ProtoMessage() {}
 
-func (x *Context) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20]
+func (x *CategoryCount) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1837,76 +2260,56 @@ func (x *Context) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Context.ProtoReflect.Descriptor instead.
-func (*Context) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CategoryCount.ProtoReflect.Descriptor instead.
+func (*CategoryCount) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Context) GetCluster() string {
+func (x *CategoryCount) GetName() string {
 	if x != nil {
-		return x.Cluster
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Context) GetNamespace() string {
+func (x *CategoryCount) GetCount() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-// AvailablePackageReference
+// GetAvailablePackageDetailResponse
 //
-// An AvailablePackageReference has the minimum information required to uniquely
-// identify an available package. This is re-used on the summary and details of an
-// available package.
-type AvailablePackageReference struct {
+// Response for GetAvailablePackageDetail
+type GetAvailablePackageDetailResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Available package context
-	//
-	// The context (cluster/namespace) for the package.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// Available package identifier
-	//
-	// The fully qualified identifier for the available package
-	// (ie. a unique name for the context). For some packaging systems
-	// (particularly those where an available package is backed by a CR) this
-	// will just be the name, but for others such as those where an available
-	// package is not backed by a CR (eg. standard helm) it may be necessary
-	// to include the repository in the name or even the repo namespace
-	// to ensure this is unique.
-	// For example two helm repositories can define
-	// an "apache" chart that is available globally, the names would need to
-	// encode that to be unique (ie. "repoA:apache" and "repoB:apache").
-	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	// Plugin for the available package
+	// Available package detail
 	//
-	// The plugin used to interact with this available package.
-	// This field should be omitted when the request is in the context of a specific plugin.
-	Plugin *v1alpha1.Plugin `protobuf:"bytes,3,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// The requested AvailablePackageDetail
+	AvailablePackageDetail *AvailablePackageDetail `protobuf:"bytes,1,opt,name=available_package_detail,json=availablePackageDetail,proto3" json:"available_package_detail,omitempty"`
 }
 
-func (x *AvailablePackageReference) Reset() {
-	*x = AvailablePackageReference{}
+func (x *GetAvailablePackageDetailResponse) Reset() {
+	*x = GetAvailablePackageDetailResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AvailablePackageReference) String() string {
+func (x *GetAvailablePackageDetailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailablePackageReference) ProtoMessage() {}
+func (*GetAvailablePackageDetailResponse) ProtoMessage() {}
 
-func (x *AvailablePackageReference) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21]
+func (x *GetAvailablePackageDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,67 +2320,50 @@ func (x *AvailablePackageReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailablePackageReference.ProtoReflect.Descriptor instead.
-func (*AvailablePackageReference) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *AvailablePackageReference) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
-}
-
-func (x *AvailablePackageReference) GetIdentifier() string {
-	if x != nil {
-		return x.Identifier
-	}
-	return ""
+// Deprecated: Use GetAvailablePackageDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageDetailResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *AvailablePackageReference) GetPlugin() *v1alpha1.Plugin {
+func (x *GetAvailablePackageDetailResponse) GetAvailablePackageDetail() *AvailablePackageDetail {
 	if x != nil {
-		return x.Plugin
+		return x.AvailablePackageDetail
 	}
 	return nil
 }
 
-// Maintainer
+// GetAvailablePackageReadmeResponse
 //
-// Maintainers for the package.
-type Maintainer struct {
+// Response for GetAvailablePackageReadme, streamed once per chunk
+type GetAvailablePackageReadmeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Maintainer name
+	// README chunk
 	//
-	// A maintainer name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Maintainer email
-	//
-	// A maintainer email
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// The next chunk of the README of the package, to be appended to the
+	// previous ones. Chunks are split on character boundaries.
+	Chunk string `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
 
-func (x *Maintainer) Reset() {
-	*x = Maintainer{}
+func (x *GetAvailablePackageReadmeResponse) Reset() {
+	*x = GetAvailablePackageReadmeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Maintainer) String() string {
+func (x *GetAvailablePackageReadmeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Maintainer) ProtoMessage() {}
+func (*GetAvailablePackageReadmeResponse) ProtoMessage() {}
 
-func (x *Maintainer) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22]
+func (x *GetAvailablePackageReadmeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1988,72 +2374,75 @@ func (x *Maintainer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Maintainer.ProtoReflect.Descriptor instead.
-func (*Maintainer) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *Maintainer) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetAvailablePackageReadmeResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageReadmeResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Maintainer) GetEmail() string {
+func (x *GetAvailablePackageReadmeResponse) GetChunk() string {
 	if x != nil {
-		return x.Email
+		return x.Chunk
 	}
 	return ""
 }
 
-// FilterOptions
+// GetAvailablePackageVersionsResponse
 //
-// FilterOptions available when requesting summaries
-type FilterOptions struct {
+// Response for GetAvailablePackageVersions
+type GetAvailablePackageVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Text query
-	//
-	// Text query for the request
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	// Categories
-	//
-	// Collection of categories for the request
-	Categories []string `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
-	// Repositories
-	//
-	// Collection of repositories where the packages belong to
-	Repositories []string `protobuf:"bytes,3,rep,name=repositories,proto3" json:"repositories,omitempty"`
-	// Package version
+	// Package app versions
 	//
-	// Package version for the request
-	PkgVersion string `protobuf:"bytes,4,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
-	// App version
+	// By default (when version_query is empty or ignored) the response
+	// should contain an ordered summary of versions including the most recent three
+	// patch versions of the most recent three minor versions of the most recent three
+	// major versions when available, something like:
+	// [
+	//   { pkg_version: "10.3.19", app_version: "2.16.8" },
+	//   { pkg_version: "10.3.18", app_version: "2.16.8" },
+	//   { pkg_version: "10.3.17", app_version: "2.16.7" },
+	//   { pkg_version: "10.2.6", app_version: "2.15.3" },
+	//   { pkg_version: "10.2.5", app_version: "2.15.2" },
+	//   { pkg_version: "10.2.4", app_version: "2.15.2" },
+	//   { pkg_version: "10.1.8", app_version: "2.13.5" },
+	//   { pkg_version: "10.1.7", app_version: "2.13.5" },
+	//   { pkg_version: "10.1.6", app_version: "2.13.5" },
+	//   { pkg_version: "9.5.4", app_version: "2.8.9" },
+	//   ...
+	//   { pkg_version: "8.2.5", app_version: "1.19.5" },
+	//   ...
+	// ]
+	// If a version_query is present and the plugin chooses to support it,
+	// the full history of versions matching the version query should be returned.
+	PackageAppVersions []*PackageAppVersion `protobuf:"bytes,1,rep,name=package_app_versions,json=packageAppVersions,proto3" json:"package_app_versions,omitempty"`
+	// Version groups
 	//
-	// Packaged app version for the request
-	AppVersion string `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// The package app versions grouped by major.minor, newest first, when
+	// requested with group_by_minor. The versions which are not valid semantic
+	// versions are not grouped.
+	VersionGroups []*PackageVersionGroup `protobuf:"bytes,2,rep,name=version_groups,json=versionGroups,proto3" json:"version_groups,omitempty"`
 }
 
-func (x *FilterOptions) Reset() {
-	*x = FilterOptions{}
+func (x *GetAvailablePackageVersionsResponse) Reset() {
+	*x = GetAvailablePackageVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilterOptions) String() string {
+func (x *GetAvailablePackageVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterOptions) ProtoMessage() {}
+func (*GetAvailablePackageVersionsResponse) ProtoMessage() {}
 
-func (x *FilterOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23]
+func (x *GetAvailablePackageVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2064,86 +2453,57 @@ func (x *FilterOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterOptions.ProtoReflect.Descriptor instead.
-func (*FilterOptions) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *FilterOptions) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
+// Deprecated: Use GetAvailablePackageVersionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *FilterOptions) GetCategories() []string {
+func (x *GetAvailablePackageVersionsResponse) GetPackageAppVersions() []*PackageAppVersion {
 	if x != nil {
-		return x.Categories
+		return x.PackageAppVersions
 	}
 	return nil
 }
 
-func (x *FilterOptions) GetRepositories() []string {
+func (x *GetAvailablePackageVersionsResponse) GetVersionGroups() []*PackageVersionGroup {
 	if x != nil {
-		return x.Repositories
+		return x.VersionGroups
 	}
 	return nil
 }
 
-func (x *FilterOptions) GetPkgVersion() string {
-	if x != nil {
-		return x.PkgVersion
-	}
-	return ""
-}
-
-func (x *FilterOptions) GetAppVersion() string {
-	if x != nil {
-		return x.AppVersion
-	}
-	return ""
-}
-
-// PaginationOptions
+// GetAvailablePackageDependenciesResponse
 //
-// The PaginationOptions based on the example proto at:
-// https://cloud.google.com/apis/design/design_patterns#list_pagination
-// just encapsulated in a message so it can be reused on different request messages.
-type PaginationOptions struct {
+// Response for GetAvailablePackageDependencies
+type GetAvailablePackageDependenciesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Page token
-	//
-	// The client uses this field to request a specific page of the list results.
-	PageToken string `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Page size
+	// Package dependencies
 	//
-	// Clients use this field to specify the maximum number of results to be
-	// returned by the server. The server may further constrain the maximum number
-	// of results returned in a single page. If the page_size is 0, the server
-	// will decide the number of results to be returned.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The tree of dependencies of the requested package. Plugins which
+	// do not have any dependency information return an empty list.
+	PackageDependencies []*PackageDependency `protobuf:"bytes,1,rep,name=package_dependencies,json=packageDependencies,proto3" json:"package_dependencies,omitempty"`
 }
 
-func (x *PaginationOptions) Reset() {
-	*x = PaginationOptions{}
+func (x *GetAvailablePackageDependenciesResponse) Reset() {
+	*x = GetAvailablePackageDependenciesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PaginationOptions) String() string {
+func (x *GetAvailablePackageDependenciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationOptions) ProtoMessage() {}
+func (*GetAvailablePackageDependenciesResponse) ProtoMessage() {}
 
-func (x *PaginationOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24]
+func (x *GetAvailablePackageDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,63 +2514,50 @@ func (x *PaginationOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationOptions.ProtoReflect.Descriptor instead.
-func (*PaginationOptions) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *PaginationOptions) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
+// Deprecated: Use GetAvailablePackageDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *PaginationOptions) GetPageSize() int32 {
+func (x *GetAvailablePackageDependenciesResponse) GetPackageDependencies() []*PackageDependency {
 	if x != nil {
-		return x.PageSize
+		return x.PackageDependencies
 	}
-	return 0
+	return nil
 }
 
-// InstalledPackageReference
+// GetAvailablePackageDefaultValuesResponse
 //
-// An InstalledPackageReference has the minimum information required to uniquely
-// identify an installed package.
-type InstalledPackageReference struct {
+// Response for GetAvailablePackageDefaultValues
+type GetAvailablePackageDefaultValuesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Installed package context
+	// Default values
 	//
-	// The context (cluster/namespace) for the package.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// The fully qualified identifier for the installed package
-	// (ie. a unique name for the context).
-	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	// The plugin used to identify and interact with the installed package.
-	// This field can be omitted when the request is in the context of a specific plugin.
-	Plugin *v1alpha1.Plugin `protobuf:"bytes,3,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// The raw default values YAML of the requested package version, to be
+	// edited before installing it. Empty when the package has no values.
+	DefaultValues string `protobuf:"bytes,1,opt,name=default_values,json=defaultValues,proto3" json:"default_values,omitempty"`
 }
 
-func (x *InstalledPackageReference) Reset() {
-	*x = InstalledPackageReference{}
+func (x *GetAvailablePackageDefaultValuesResponse) Reset() {
+	*x = GetAvailablePackageDefaultValuesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstalledPackageReference) String() string {
+func (x *GetAvailablePackageDefaultValuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledPackageReference) ProtoMessage() {}
+func (*GetAvailablePackageDefaultValuesResponse) ProtoMessage() {}
 
-func (x *InstalledPackageReference) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25]
+func (x *GetAvailablePackageDefaultValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2221,67 +2568,50 @@ func (x *InstalledPackageReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledPackageReference.ProtoReflect.Descriptor instead.
-func (*InstalledPackageReference) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *InstalledPackageReference) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
+// Deprecated: Use GetAvailablePackageDefaultValuesResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageDefaultValuesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *InstalledPackageReference) GetIdentifier() string {
+func (x *GetAvailablePackageDefaultValuesResponse) GetDefaultValues() string {
 	if x != nil {
-		return x.Identifier
+		return x.DefaultValues
 	}
 	return ""
 }
 
-func (x *InstalledPackageReference) GetPlugin() *v1alpha1.Plugin {
-	if x != nil {
-		return x.Plugin
-	}
-	return nil
-}
-
-// VersionReference
+// GetAvailablePackageChangelogResponse
 //
-// A VersionReference defines a version or constraint limiting matching versions.
-// The reason it is a separate message is so that in the future we can add other
-// fields as necessary (such as something similar to Carvel's `prereleases` option
-// to its versionSelection).
-type VersionReference struct {
+// Response for GetAvailablePackageChangelog
+type GetAvailablePackageChangelogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Version
+	// Changelog entries
 	//
-	// The format of the version constraint depends on the backend. For example,
-	// for a flux v2 and Carvel it’s a semver expression, such as ">=10.3 < 10.4"
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The changes of each version in the requested range, the most recent
+	// version first. Empty when the package does not ship a changelog.
+	ChangelogEntries []*PackageChangelogEntry `protobuf:"bytes,1,rep,name=changelog_entries,json=changelogEntries,proto3" json:"changelog_entries,omitempty"`
 }
 
-func (x *VersionReference) Reset() {
-	*x = VersionReference{}
+func (x *GetAvailablePackageChangelogResponse) Reset() {
+	*x = GetAvailablePackageChangelogResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[26]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VersionReference) String() string {
+func (x *GetAvailablePackageChangelogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionReference) ProtoMessage() {}
+func (*GetAvailablePackageChangelogResponse) ProtoMessage() {}
 
-func (x *VersionReference) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[26]
+func (x *GetAvailablePackageChangelogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2292,57 +2622,61 @@ func (x *VersionReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionReference.ProtoReflect.Descriptor instead.
-func (*VersionReference) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetAvailablePackageChangelogResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageChangelogResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *VersionReference) GetVersion() string {
+func (x *GetAvailablePackageChangelogResponse) GetChangelogEntries() []*PackageChangelogEntry {
 	if x != nil {
-		return x.Version
+		return x.ChangelogEntries
 	}
-	return ""
+	return nil
 }
 
-// InstalledPackageStatus
+// GetAvailablePackageValuesDiffResponse
 //
-// An InstalledPackageStatus reports on the current status of the installation.
-type InstalledPackageStatus struct {
+// Response for GetAvailablePackageValuesDiff. The keys are the dot-separated
+// paths of the default values (eg. "image.tag"), in alphabetical order, the
+// lists being compared as a whole.
+type GetAvailablePackageValuesDiffResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Ready
+	// Added keys
 	//
-	// An indication of whether the installation is ready or not
-	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
-	// Reason
+	// The keys of the default values of the to version missing from the from
+	// version.
+	AddedKeys []string `protobuf:"bytes,1,rep,name=added_keys,json=addedKeys,proto3" json:"added_keys,omitempty"`
+	// Removed keys
 	//
-	// An enum indicating the reason for the current status.
-	Reason InstalledPackageStatus_StatusReason `protobuf:"varint,2,opt,name=reason,proto3,enum=kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus_StatusReason" json:"reason,omitempty"`
-	// UserReason
+	// The keys of the default values of the from version missing from the to
+	// version.
+	RemovedKeys []string `protobuf:"bytes,2,rep,name=removed_keys,json=removedKeys,proto3" json:"removed_keys,omitempty"`
+	// Changed keys
 	//
-	// Optional text to return for user context, which may be plugin specific.
-	UserReason string `protobuf:"bytes,3,opt,name=user_reason,json=userReason,proto3" json:"user_reason,omitempty"`
+	// The keys of the default values of both versions with a different value.
+	ChangedKeys []string `protobuf:"bytes,3,rep,name=changed_keys,json=changedKeys,proto3" json:"changed_keys,omitempty"`
 }
 
-func (x *InstalledPackageStatus) Reset() {
-	*x = InstalledPackageStatus{}
+func (x *GetAvailablePackageValuesDiffResponse) Reset() {
+	*x = GetAvailablePackageValuesDiffResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[27]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstalledPackageStatus) String() string {
+func (x *GetAvailablePackageValuesDiffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledPackageStatus) ProtoMessage() {}
+func (*GetAvailablePackageValuesDiffResponse) ProtoMessage() {}
 
-func (x *InstalledPackageStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[27]
+func (x *GetAvailablePackageValuesDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2353,75 +2687,71 @@ func (x *InstalledPackageStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledPackageStatus.ProtoReflect.Descriptor instead.
-func (*InstalledPackageStatus) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetAvailablePackageValuesDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageValuesDiffResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *InstalledPackageStatus) GetReady() bool {
+func (x *GetAvailablePackageValuesDiffResponse) GetAddedKeys() []string {
 	if x != nil {
-		return x.Ready
+		return x.AddedKeys
 	}
-	return false
+	return nil
 }
 
-func (x *InstalledPackageStatus) GetReason() InstalledPackageStatus_StatusReason {
+func (x *GetAvailablePackageValuesDiffResponse) GetRemovedKeys() []string {
 	if x != nil {
-		return x.Reason
+		return x.RemovedKeys
 	}
-	return InstalledPackageStatus_STATUS_REASON_UNSPECIFIED
+	return nil
 }
 
-func (x *InstalledPackageStatus) GetUserReason() string {
+func (x *GetAvailablePackageValuesDiffResponse) GetChangedKeys() []string {
 	if x != nil {
-		return x.UserReason
+		return x.ChangedKeys
 	}
-	return ""
+	return nil
 }
 
-// ReconciliationOptions
+// RenderAvailablePackageResponse
 //
-// ReconciliationOptions enable specifying standard fields for backends that continuously
-// reconcile a package install as new matching versions are released. Most of the naming
-// is from the flux HelmReleaseSpec though it maps directly to equivalent fields on Carvel's
-// InstalledPackage.
-type ReconciliationOptions struct {
+// Response for RenderAvailablePackage
+type RenderAvailablePackageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Reconciliation Interval
-	//
-	// The interval with which the package is checked for reconciliation (in seconds)
-	Interval int32 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
-	// Suspend
+	// Manifest
 	//
-	// Whether reconciliation should be suspended until otherwise enabled.
-	Suspend bool `protobuf:"varint,2,opt,name=suspend,proto3" json:"suspend,omitempty"`
-	// ServiceAccountName
+	// The manifests rendered from the templates of the package with the
+	// requested values, without anything being created in the cluster.
+	Manifest string `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// Apply order
 	//
-	// A name for a service account in the same namespace which should be used
-	// to perform the reconciliation.
-	ServiceAccountName string `protobuf:"bytes,3,opt,name=service_account_name,json=serviceAccountName,proto3" json:"service_account_name,omitempty"`
+	// The rendered resources in the order in which they would be applied when
+	// installing the package (eg. the CustomResourceDefinitions and the
+	// Namespaces first). Only returned when requested, empty if the plugin
+	// does not support it.
+	ApplyOrder []*RenderedResource `protobuf:"bytes,2,rep,name=apply_order,json=applyOrder,proto3" json:"apply_order,omitempty"`
 }
 
-func (x *ReconciliationOptions) Reset() {
-	*x = ReconciliationOptions{}
+func (x *RenderAvailablePackageResponse) Reset() {
+	*x = RenderAvailablePackageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[28]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReconciliationOptions) String() string {
+func (x *RenderAvailablePackageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconciliationOptions) ProtoMessage() {}
+func (*RenderAvailablePackageResponse) ProtoMessage() {}
 
-func (x *ReconciliationOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[28]
+func (x *RenderAvailablePackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2432,67 +2762,175 @@ func (x *ReconciliationOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconciliationOptions.ProtoReflect.Descriptor instead.
-func (*ReconciliationOptions) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use RenderAvailablePackageResponse.ProtoReflect.Descriptor instead.
+func (*RenderAvailablePackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ReconciliationOptions) GetInterval() int32 {
+func (x *RenderAvailablePackageResponse) GetManifest() string {
 	if x != nil {
-		return x.Interval
+		return x.Manifest
 	}
-	return 0
+	return ""
 }
 
-func (x *ReconciliationOptions) GetSuspend() bool {
+func (x *RenderAvailablePackageResponse) GetApplyOrder() []*RenderedResource {
 	if x != nil {
-		return x.Suspend
+		return x.ApplyOrder
 	}
-	return false
+	return nil
 }
 
-func (x *ReconciliationOptions) GetServiceAccountName() string {
+// GetInstalledPackageSummariesResponse
+//
+// Response for GetInstalledPackageSummaries
+type GetInstalledPackageSummariesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Installed packages summaries
+	//
+	// List of InstalledPackageSummary
+	InstalledPackageSummaries []*InstalledPackageSummary `protobuf:"bytes,1,rep,name=installed_package_summaries,json=installedPackageSummaries,proto3" json:"installed_package_summaries,omitempty"`
+	// Next page token
+	//
+	// This field represents the pagination token to retrieve the next page of
+	// results. If the value is "", it means no further results for the request.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetInstalledPackageSummariesResponse) Reset() {
+	*x = GetInstalledPackageSummariesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageSummariesResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageSummariesResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetInstalledPackageSummariesResponse) GetInstalledPackageSummaries() []*InstalledPackageSummary {
 	if x != nil {
-		return x.ServiceAccountName
+		return x.InstalledPackageSummaries
+	}
+	return nil
+}
+
+func (x *GetInstalledPackageSummariesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-// Package AppVersion
+// GetInstalledPackageDetailResponse
 //
-// PackageAppVersion conveys both the package version and the packaged app version.
-type PackageAppVersion struct {
+// Response for GetInstalledPackageDetail
+type GetInstalledPackageDetailResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Package version
+	// InstalledPackageDetail
 	//
-	// Version of the package itself
-	PkgVersion string `protobuf:"bytes,1,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
-	// Application version
+	// The requested InstalledPackageDetail
+	InstalledPackageDetail *InstalledPackageDetail `protobuf:"bytes,1,opt,name=installed_package_detail,json=installedPackageDetail,proto3" json:"installed_package_detail,omitempty"`
+}
+
+func (x *GetInstalledPackageDetailResponse) Reset() {
+	*x = GetInstalledPackageDetailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageDetailResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageDetailResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetInstalledPackageDetailResponse) GetInstalledPackageDetail() *InstalledPackageDetail {
+	if x != nil {
+		return x.InstalledPackageDetail
+	}
+	return nil
+}
+
+// GetInstalledPackageDetailsBatchResponse
+//
+// Response for GetInstalledPackageDetailsBatch
+type GetInstalledPackageDetailsBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Results
 	//
-	// Version of the packaged application
-	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// The detail, or the error, of each requested installed package, in the
+	// order of the request. A failed reference does not abort the others.
+	Results []*GetInstalledPackageDetailResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *PackageAppVersion) Reset() {
-	*x = PackageAppVersion{}
+func (x *GetInstalledPackageDetailsBatchResponse) Reset() {
+	*x = GetInstalledPackageDetailsBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[29]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PackageAppVersion) String() string {
+func (x *GetInstalledPackageDetailsBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackageAppVersion) ProtoMessage() {}
+func (*GetInstalledPackageDetailsBatchResponse) ProtoMessage() {}
 
-func (x *PackageAppVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[29]
+func (x *GetInstalledPackageDetailsBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2503,100 +2941,4257 @@ func (x *PackageAppVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackageAppVersion.ProtoReflect.Descriptor instead.
-func (*PackageAppVersion) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetInstalledPackageDetailsBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageDetailsBatchResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *PackageAppVersion) GetPkgVersion() string {
+func (x *GetInstalledPackageDetailsBatchResponse) GetResults() []*GetInstalledPackageDetailResult {
 	if x != nil {
-		return x.PkgVersion
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *PackageAppVersion) GetAppVersion() string {
+// GetInstalledPackageValuesResponse
+//
+// Response for GetInstalledPackageValues
+type GetInstalledPackageValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Values
+	//
+	// The YAML values supplied by the user which are currently in effect for
+	// the installed package, without the default values of the package, to be
+	// edited before an UpdateInstalledPackage. Empty when none were supplied.
+	Values string `protobuf:"bytes,1,opt,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetInstalledPackageValuesResponse) Reset() {
+	*x = GetInstalledPackageValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageValuesResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageValuesResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageValuesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetInstalledPackageValuesResponse) GetValues() string {
 	if x != nil {
-		return x.AppVersion
+		return x.Values
 	}
 	return ""
 }
 
-var File_kubeappsapis_core_packages_v1alpha1_packages_proto protoreflect.FileDescriptor
+// CreateInstalledPackageResponse
+//
+// Response for CreateInstalledPackage
+type CreateInstalledPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDesc = []byte{
-	0x0a, 0x32, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x63,
-	0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x23, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
-	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x30, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
-	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
-	0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x02, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b,
-	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x65, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75,
-	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62,
-	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12,
-	0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0xb9, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
-	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
-	0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd4, 0x01, 0x0a,
-	0x23, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
-	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x65, 0x0a, 0x12,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+}
+
+func (x *CreateInstalledPackageResponse) Reset() {
+	*x = CreateInstalledPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInstalledPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInstalledPackageResponse) ProtoMessage() {}
+
+func (x *CreateInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInstalledPackageResponse.ProtoReflect.Descriptor instead.
+func (*CreateInstalledPackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateInstalledPackageResponse) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+// UpdateInstalledPackageResponse
+//
+// Response for UpdateInstalledPackage
+type UpdateInstalledPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+}
+
+func (x *UpdateInstalledPackageResponse) Reset() {
+	*x = UpdateInstalledPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateInstalledPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstalledPackageResponse) ProtoMessage() {}
+
+func (x *UpdateInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstalledPackageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInstalledPackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateInstalledPackageResponse) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+// GetInstalledPackageUpgradeDiffResponse
+//
+// Response for GetInstalledPackageUpgradeDiff
+type GetInstalledPackageUpgradeDiffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Diff
+	//
+	// The unified diff between the rendered manifests of the installed package
+	// and the ones of the target version. Empty when they are the same.
+	Diff string `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *GetInstalledPackageUpgradeDiffResponse) Reset() {
+	*x = GetInstalledPackageUpgradeDiffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageUpgradeDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageUpgradeDiffResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageUpgradeDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageUpgradeDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageUpgradeDiffResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetInstalledPackageUpgradeDiffResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+// GetInstalledPackageDriftResponse
+//
+// Response for GetInstalledPackageDrift
+type GetInstalledPackageDriftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Drifted
+	//
+	// Whether any resource of the installed package differs from the one
+	// currently in the cluster.
+	Drifted bool `protobuf:"varint,1,opt,name=drifted,proto3" json:"drifted,omitempty"`
+	// Resources
+	//
+	// The resources of the installed package which differ from the ones
+	// currently in the cluster, or are missing from it.
+	Resources []*ResourceDrift `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *GetInstalledPackageDriftResponse) Reset() {
+	*x = GetInstalledPackageDriftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageDriftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageDriftResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageDriftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageDriftResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageDriftResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetInstalledPackageDriftResponse) GetDrifted() bool {
+	if x != nil {
+		return x.Drifted
+	}
+	return false
+}
+
+func (x *GetInstalledPackageDriftResponse) GetResources() []*ResourceDrift {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// GetInstalledPackageUpdateAvailableResponse
+//
+// Response for GetInstalledPackageUpdateAvailable
+type GetInstalledPackageUpdateAvailableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Update available
+	//
+	// Whether a version newer than the installed one is available.
+	UpdateAvailable bool `protobuf:"varint,1,opt,name=update_available,json=updateAvailable,proto3" json:"update_available,omitempty"`
+	// Latest version
+	//
+	// The latest available version of the package, regardless of whether it
+	// is newer than the installed one.
+	LatestVersion *PackageAppVersion `protobuf:"bytes,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+}
+
+func (x *GetInstalledPackageUpdateAvailableResponse) Reset() {
+	*x = GetInstalledPackageUpdateAvailableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageUpdateAvailableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageUpdateAvailableResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageUpdateAvailableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageUpdateAvailableResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageUpdateAvailableResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetInstalledPackageUpdateAvailableResponse) GetUpdateAvailable() bool {
+	if x != nil {
+		return x.UpdateAvailable
+	}
+	return false
+}
+
+func (x *GetInstalledPackageUpdateAvailableResponse) GetLatestVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return nil
+}
+
+// ExportInstalledPackageResponse
+//
+// Response for ExportInstalledPackage
+type ExportInstalledPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Manifest
+	//
+	// The YAML document declaring the installed package, such as the custom
+	// resource reconciled by a GitOps controller, without the state managed by
+	// the cluster, suitable for committing to a git repository.
+	Manifest string `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+}
+
+func (x *ExportInstalledPackageResponse) Reset() {
+	*x = ExportInstalledPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportInstalledPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInstalledPackageResponse) ProtoMessage() {}
+
+func (x *ExportInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInstalledPackageResponse.ProtoReflect.Descriptor instead.
+func (*ExportInstalledPackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportInstalledPackageResponse) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+// DeleteInstalledPackageResponse
+//
+// Response for DeleteInstalledPackage
+type DeleteInstalledPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteInstalledPackageResponse) Reset() {
+	*x = DeleteInstalledPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteInstalledPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInstalledPackageResponse) ProtoMessage() {}
+
+func (x *DeleteInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInstalledPackageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInstalledPackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{45}
+}
+
+// DeleteInstalledPackagesBatchResponse
+//
+// Response for DeleteInstalledPackagesBatch
+type DeleteInstalledPackagesBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Results
+	//
+	// The result of the deletion of each requested installed package, in the
+	// order of the request. A failed deletion does not abort the others.
+	Results []*DeleteInstalledPackageResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *DeleteInstalledPackagesBatchResponse) Reset() {
+	*x = DeleteInstalledPackagesBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteInstalledPackagesBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInstalledPackagesBatchResponse) ProtoMessage() {}
+
+func (x *DeleteInstalledPackagesBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInstalledPackagesBatchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInstalledPackagesBatchResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteInstalledPackagesBatchResponse) GetResults() []*DeleteInstalledPackageResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// GetCatalogStatsResponse
+//
+// Response for GetCatalogStats
+type GetCatalogStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Catalog stats
+	//
+	// The statistics of the available and installed packages of all the plugins
+	CatalogStats *CatalogStats `protobuf:"bytes,1,opt,name=catalog_stats,json=catalogStats,proto3" json:"catalog_stats,omitempty"`
+}
+
+func (x *GetCatalogStatsResponse) Reset() {
+	*x = GetCatalogStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCatalogStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogStatsResponse) ProtoMessage() {}
+
+func (x *GetCatalogStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogStatsResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCatalogStatsResponse) GetCatalogStats() *CatalogStats {
+	if x != nil {
+		return x.CatalogStats
+	}
+	return nil
+}
+
+// GetRecentActivityResponse
+//
+// Response for GetRecentActivity
+type GetRecentActivityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Activities
+	//
+	// The operations on the installed packages of the plugins tracking their
+	// history, most recent first.
+	Activities []*PackageActivity `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+	// Next page token
+	//
+	// This field represents the pagination token to retrieve the next page of
+	// results. If the value is "", it means no further results for the request.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetRecentActivityResponse) Reset() {
+	*x = GetRecentActivityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentActivityResponse) ProtoMessage() {}
+
+func (x *GetRecentActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentActivityResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetRecentActivityResponse) GetActivities() []*PackageActivity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *GetRecentActivityResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetUserPermissionsResponse
+//
+// Response for GetUserPermissions
+type GetUserPermissionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Can install
+	//
+	// Whether the user can create installed packages of the plugin in the
+	// requested context
+	CanInstall bool `protobuf:"varint,1,opt,name=can_install,json=canInstall,proto3" json:"can_install,omitempty"`
+	// Can update
+	//
+	// Whether the user can update the installed packages of the plugin in the
+	// requested context
+	CanUpdate bool `protobuf:"varint,2,opt,name=can_update,json=canUpdate,proto3" json:"can_update,omitempty"`
+	// Can delete
+	//
+	// Whether the user can delete the installed packages of the plugin in the
+	// requested context
+	CanDelete bool `protobuf:"varint,3,opt,name=can_delete,json=canDelete,proto3" json:"can_delete,omitempty"`
+}
+
+func (x *GetUserPermissionsResponse) Reset() {
+	*x = GetUserPermissionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPermissionsResponse) ProtoMessage() {}
+
+func (x *GetUserPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetUserPermissionsResponse) GetCanInstall() bool {
+	if x != nil {
+		return x.CanInstall
+	}
+	return false
+}
+
+func (x *GetUserPermissionsResponse) GetCanUpdate() bool {
+	if x != nil {
+		return x.CanUpdate
+	}
+	return false
+}
+
+func (x *GetUserPermissionsResponse) GetCanDelete() bool {
+	if x != nil {
+		return x.CanDelete
+	}
+	return false
+}
+
+// GetCatalogIndexResponse
+//
+// Response for GetCatalogIndex
+type GetCatalogIndexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index
+	//
+	// The gzip-compressed JSON encoding of the CatalogIndex of the available
+	// packages of all the plugins, for the clients filtering the whole catalog
+	// themselves.
+	Index []byte `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Package count
+	//
+	// The number of available packages in the index
+	PackageCount int32 `protobuf:"varint,2,opt,name=package_count,json=packageCount,proto3" json:"package_count,omitempty"`
+}
+
+func (x *GetCatalogIndexResponse) Reset() {
+	*x = GetCatalogIndexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCatalogIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogIndexResponse) ProtoMessage() {}
+
+func (x *GetCatalogIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogIndexResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogIndexResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetCatalogIndexResponse) GetIndex() []byte {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *GetCatalogIndexResponse) GetPackageCount() int32 {
+	if x != nil {
+		return x.PackageCount
+	}
+	return 0
+}
+
+// AvailablePackageSummary
+//
+// An AvailablePackageSummary provides a summary of a package available for installation
+// useful when aggregating many available packages.
+type AvailablePackageSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Available package reference
+	//
+	// A reference uniquely identifying the package.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// Available package name
+	//
+	// The name of the available package
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Latest available version
+	//
+	// The latest version available for this package. Often expected when viewing
+	// a summary of many available packages.
+	LatestVersion *PackageAppVersion `protobuf:"bytes,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// Available package Icon URL
+	//
+	// A url for an icon.
+	IconUrl string `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	// Available package display name
+	//
+	// A name as displayed to users
+	DisplayName string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Available package short description
+	//
+	// A short description of the app provided by the package
+	ShortDescription string `protobuf:"bytes,6,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
+	// Available package categories
+	//
+	// A user-facing list of category names useful for creating richer user interfaces.
+	// Plugins can choose not to implement this
+	Categories []string `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Available package installed count
+	//
+	// The number of times the package is installed, useful as a popularity metric.
+	// Only computed when requested with include_installed_count.
+	InstalledCount int32 `protobuf:"varint,8,opt,name=installed_count,json=installedCount,proto3" json:"installed_count,omitempty"`
+	// Available package repository
+	//
+	// A reference to the repository from which the package is available, useful
+	// for grouping packages by repository and linking to the source.
+	// Plugins can choose not to implement this
+	Repository *PackageRepositoryReference `protobuf:"bytes,9,opt,name=repository,proto3" json:"repository,omitempty"`
+	// Available package localized descriptions
+	//
+	// The descriptions of the package in other languages than the default one.
+	// Plugins can choose not to implement this
+	LocalizedDescriptions []*LocalizedDescription `protobuf:"bytes,10,rep,name=localized_descriptions,json=localizedDescriptions,proto3" json:"localized_descriptions,omitempty"`
+	// Available package deprecated
+	//
+	// Whether the whole package, rather than some of its versions, is deprecated
+	// upstream.
+	// Plugins can choose not to implement this
+	Deprecated bool `protobuf:"varint,11,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// Available package deprecation message
+	//
+	// The reason for the deprecation of the package or its replacement, if any.
+	DeprecationMessage string `protobuf:"bytes,12,opt,name=deprecation_message,json=deprecationMessage,proto3" json:"deprecation_message,omitempty"`
+}
+
+func (x *AvailablePackageSummary) Reset() {
+	*x = AvailablePackageSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailablePackageSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailablePackageSummary) ProtoMessage() {}
+
+func (x *AvailablePackageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailablePackageSummary.ProtoReflect.Descriptor instead.
+func (*AvailablePackageSummary) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AvailablePackageSummary) GetAvailablePackageRef() *AvailablePackageReference {
+	if x != nil {
+		return x.AvailablePackageRef
+	}
+	return nil
+}
+
+func (x *AvailablePackageSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AvailablePackageSummary) GetLatestVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return nil
+}
+
+func (x *AvailablePackageSummary) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *AvailablePackageSummary) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *AvailablePackageSummary) GetShortDescription() string {
+	if x != nil {
+		return x.ShortDescription
+	}
+	return ""
+}
+
+func (x *AvailablePackageSummary) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *AvailablePackageSummary) GetInstalledCount() int32 {
+	if x != nil {
+		return x.InstalledCount
+	}
+	return 0
+}
+
+func (x *AvailablePackageSummary) GetRepository() *PackageRepositoryReference {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *AvailablePackageSummary) GetLocalizedDescriptions() []*LocalizedDescription {
+	if x != nil {
+		return x.LocalizedDescriptions
+	}
+	return nil
+}
+
+func (x *AvailablePackageSummary) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *AvailablePackageSummary) GetDeprecationMessage() string {
+	if x != nil {
+		return x.DeprecationMessage
+	}
+	return ""
+}
+
+// AvailablePackageDetail
+//
+// An AvailablePackageDetail provides additional details required when
+// inspecting an individual package.
+type AvailablePackageDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Available package reference
+	//
+	// A reference uniquely identifying the package.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// Available package name
+	//
+	// The name of the available package
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Available version
+	//
+	// The version of the package and application.
+	Version *PackageAppVersion `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// the url of the package repository that contains this package
+	RepoUrl string `protobuf:"bytes,4,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// the url of the “home” for the package
+	HomeUrl string `protobuf:"bytes,5,opt,name=home_url,json=homeUrl,proto3" json:"home_url,omitempty"`
+	// Available package icon URL
+	//
+	// A url for an icon.
+	IconUrl string `protobuf:"bytes,6,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	// Available package display name
+	//
+	// A name as displayed to users
+	DisplayName string `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Available package short description
+	//
+	// A short description of the app provided by the package
+	ShortDescription string `protobuf:"bytes,8,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
+	// Available package long description
+	//
+	// A longer description of the package, a few sentences.
+	LongDescription string `protobuf:"bytes,9,opt,name=long_description,json=longDescription,proto3" json:"long_description,omitempty"`
+	// Available package readme
+	//
+	// A longer README with potentially pages of formatted Markdown.
+	Readme string `protobuf:"bytes,10,opt,name=readme,proto3" json:"readme,omitempty"`
+	// Available package default values
+	//
+	// An example of default values used during package templating that can serve
+	// as documentation or a starting point for user customization.
+	DefaultValues string `protobuf:"bytes,11,opt,name=default_values,json=defaultValues,proto3" json:"default_values,omitempty"`
+	ValuesSchema  string `protobuf:"bytes,12,opt,name=values_schema,json=valuesSchema,proto3" json:"values_schema,omitempty"`
+	// source urls for the package
+	SourceUrls []string `protobuf:"bytes,13,rep,name=source_urls,json=sourceUrls,proto3" json:"source_urls,omitempty"`
+	// Available package maintainers
+	//
+	// List of Maintainer
+	Maintainers []*Maintainer `protobuf:"bytes,14,rep,name=maintainers,proto3" json:"maintainers,omitempty"`
+	// Available package categories
+	//
+	// A user-facing list of category names useful for creating richer user interfaces.
+	// Plugins can choose not to implement this
+	Categories []string `protobuf:"bytes,15,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Custom data added by the plugin
+	//
+	// A plugin can define custom details for data which is not yet, or never will
+	// be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
+	// of an `Any` field means that each plugin can define the structure of this
+	// message as required, while still satisfying the core interface.
+	// See https://developers.google.com/protocol-buffers/docs/proto3#any
+	CustomDetail *anypb.Any `protobuf:"bytes,16,opt,name=custom_detail,json=customDetail,proto3" json:"custom_detail,omitempty"`
+	// Available package provenance
+	//
+	// Provenance information (such as a signature) of the package, populated
+	// by plugins which support verifying it. Empty otherwise.
+	Provenance *PackageProvenance `protobuf:"bytes,17,opt,name=provenance,proto3" json:"provenance,omitempty"`
+	// Available package localized descriptions
+	//
+	// The descriptions of the package in other languages than the default one.
+	// Plugins can choose not to implement this
+	LocalizedDescriptions []*LocalizedDescription `protobuf:"bytes,18,rep,name=localized_descriptions,json=localizedDescriptions,proto3" json:"localized_descriptions,omitempty"`
+	// Available package deprecated
+	//
+	// Whether the whole package, rather than some of its versions, is deprecated
+	// upstream.
+	// Plugins can choose not to implement this
+	Deprecated bool `protobuf:"varint,19,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// Available package deprecation message
+	//
+	// The reason for the deprecation of the package or its replacement, if any.
+	DeprecationMessage string `protobuf:"bytes,20,opt,name=deprecation_message,json=deprecationMessage,proto3" json:"deprecation_message,omitempty"`
+	// Available package Kubernetes version
+	//
+	// The semver constraint of the Kubernetes versions supported by the package
+	// (eg. ">=1.19.0-0"), empty when the package does not restrict them.
+	// Plugins can choose not to implement this
+	KubeVersion string `protobuf:"bytes,21,opt,name=kube_version,json=kubeVersion,proto3" json:"kube_version,omitempty"`
+}
+
+func (x *AvailablePackageDetail) Reset() {
+	*x = AvailablePackageDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailablePackageDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailablePackageDetail) ProtoMessage() {}
+
+func (x *AvailablePackageDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailablePackageDetail.ProtoReflect.Descriptor instead.
+func (*AvailablePackageDetail) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AvailablePackageDetail) GetAvailablePackageRef() *AvailablePackageReference {
+	if x != nil {
+		return x.AvailablePackageRef
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetVersion() *PackageAppVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetHomeUrl() string {
+	if x != nil {
+		return x.HomeUrl
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetShortDescription() string {
+	if x != nil {
+		return x.ShortDescription
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetLongDescription() string {
+	if x != nil {
+		return x.LongDescription
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetReadme() string {
+	if x != nil {
+		return x.Readme
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetDefaultValues() string {
+	if x != nil {
+		return x.DefaultValues
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetValuesSchema() string {
+	if x != nil {
+		return x.ValuesSchema
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetSourceUrls() []string {
+	if x != nil {
+		return x.SourceUrls
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetMaintainers() []*Maintainer {
+	if x != nil {
+		return x.Maintainers
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetCustomDetail() *anypb.Any {
+	if x != nil {
+		return x.CustomDetail
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetProvenance() *PackageProvenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetLocalizedDescriptions() []*LocalizedDescription {
+	if x != nil {
+		return x.LocalizedDescriptions
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *AvailablePackageDetail) GetDeprecationMessage() string {
+	if x != nil {
+		return x.DeprecationMessage
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetKubeVersion() string {
+	if x != nil {
+		return x.KubeVersion
+	}
+	return ""
+}
+
+// InstalledPackageSummary
+//
+// An InstalledPackageSummary provides a summary of an installed package
+// useful when aggregating many installed packages.
+type InstalledPackageSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// InstalledPackageReference
+	//
+	// A reference uniquely identifying the package.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// Name
+	//
+	// A name given to the installation of the package (eg. "my-postgresql-for-testing").
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// PkgVersionReference
+	//
+	// The package version reference defines a version or constraint limiting
+	// matching package versions.
+	PkgVersionReference *VersionReference `protobuf:"bytes,3,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// CurrentVersion
+	//
+	// The current version of the package being reconciled, which may be
+	// in one of these states:
+	//  - has been successfully installed/upgraded or
+	//  - is currently being installed/upgraded or
+	//  - has failed to install/upgrade
+	CurrentVersion *PackageAppVersion `protobuf:"bytes,4,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// Installed package icon URL
+	//
+	// A url for an icon.
+	IconUrl string `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	// PackageDisplayName
+	//
+	// The package name as displayed to users (provided by the package, eg. "PostgreSQL")
+	PkgDisplayName string `protobuf:"bytes,6,opt,name=pkg_display_name,json=pkgDisplayName,proto3" json:"pkg_display_name,omitempty"`
+	// ShortDescription
+	//
+	// A short description of the package (provided by the package)
+	ShortDescription string `protobuf:"bytes,7,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
+	// LatestMatchingVersion
+	//
+	// Only non-empty if an available upgrade matches the specified pkg_version_reference.
+	// For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
+	// is installed, then:
+	//   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
+	//   * if 10.4 is available while >10.3.1 is not, this should remain empty.
+	LatestMatchingVersion *PackageAppVersion `protobuf:"bytes,8,opt,name=latest_matching_version,json=latestMatchingVersion,proto3" json:"latest_matching_version,omitempty"`
+	// LatestVersion
+	//
+	// The latest version available for this package, regardless of the pkg_version_reference.
+	LatestVersion *PackageAppVersion `protobuf:"bytes,9,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// Status
+	//
+	// The current status of the installed package.
+	Status *InstalledPackageStatus `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// Conflicting plugins
+	//
+	// The other plugins which also reported this installed package (same cluster,
+	// namespace and identifier), when the server is configured to merge them. Only
+	// the summary of the first configured plugin is returned, the others being
+	// listed here so clients can flag the conflict.
+	ConflictingPlugins []*v1alpha1.Plugin `protobuf:"bytes,11,rep,name=conflicting_plugins,json=conflictingPlugins,proto3" json:"conflicting_plugins,omitempty"`
+	// Available package reference
+	//
+	// A reference to the available package from which the package was installed,
+	// when the plugin can determine it.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,12,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+}
+
+func (x *InstalledPackageSummary) Reset() {
+	*x = InstalledPackageSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledPackageSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledPackageSummary) ProtoMessage() {}
+
+func (x *InstalledPackageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledPackageSummary.ProtoReflect.Descriptor instead.
+func (*InstalledPackageSummary) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *InstalledPackageSummary) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstalledPackageSummary) GetPkgVersionReference() *VersionReference {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetCurrentVersion() *PackageAppVersion {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *InstalledPackageSummary) GetPkgDisplayName() string {
+	if x != nil {
+		return x.PkgDisplayName
+	}
+	return ""
+}
+
+func (x *InstalledPackageSummary) GetShortDescription() string {
+	if x != nil {
+		return x.ShortDescription
+	}
+	return ""
+}
+
+func (x *InstalledPackageSummary) GetLatestMatchingVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestMatchingVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetLatestVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetStatus() *InstalledPackageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetConflictingPlugins() []*v1alpha1.Plugin {
+	if x != nil {
+		return x.ConflictingPlugins
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetAvailablePackageRef() *AvailablePackageReference {
+	if x != nil {
+		return x.AvailablePackageRef
+	}
+	return nil
+}
+
+// InstalledPackageDetail
+//
+// An InstalledPackageDetail includes details about the installed package that are
+// typically useful when presenting a single installed package.
+type InstalledPackageDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// InstalledPackageReference
+	//
+	// A reference uniquely identifying the installed package.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// PkgVersionReference
+	//
+	// The package version reference defines a version or constraint limiting
+	// matching package versions.
+	PkgVersionReference *VersionReference `protobuf:"bytes,2,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// Installed package name
+	//
+	// The name given to the installed package
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// CurrentVersion
+	//
+	// The version of the package which is currently installed.
+	CurrentVersion *PackageAppVersion `protobuf:"bytes,4,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// ValuesApplied
+	//
+	// The values applied currently for the installed package.
+	ValuesApplied string `protobuf:"bytes,5,opt,name=values_applied,json=valuesApplied,proto3" json:"values_applied,omitempty"`
+	// ReconciliationOptions
+	//
+	// An optional field specifying data common to systems that reconcile
+	// the package installation on the cluster asynchronously. In particular,
+	// this specifies the service account used to perform the reconcilliation.
+	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,6,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
+	// Status
+	//
+	// The current status of the installed package.
+	Status *InstalledPackageStatus `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// PostInstallationNotes
+	//
+	// Optional notes generated by package and intended for the user post installation.
+	PostInstallationNotes string `protobuf:"bytes,8,opt,name=post_installation_notes,json=postInstallationNotes,proto3" json:"post_installation_notes,omitempty"`
+	// Available package reference
+	//
+	// A reference to the available package for this installation.
+	// Useful to lookup the package display name, icon and other info.
+	// Only set when the plugin can determine the available package from which
+	// the package was installed, left empty otherwise.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,9,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// LatestMatchingVersion
+	//
+	// Only non-empty if an available upgrade matches the specified pkg_version_reference.
+	// For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
+	// is installed, then:
+	//   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
+	//   * if 10.4 is available while >10.3.1 is not, this should remain empty.
+	LatestMatchingVersion *PackageAppVersion `protobuf:"bytes,10,opt,name=latest_matching_version,json=latestMatchingVersion,proto3" json:"latest_matching_version,omitempty"`
+	// LatestVersion
+	//
+	// The latest version available for this package, regardless of the pkg_version_reference.
+	LatestVersion *PackageAppVersion `protobuf:"bytes,11,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// Custom data added by the plugin
+	//
+	// A plugin can define custom details for data which is not yet, or never will
+	// be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
+	// of an `Any` field means that each plugin can define the structure of this
+	// message as required, while still satisfying the core interface.
+	// See https://developers.google.com/protocol-buffers/docs/proto3#any
+	CustomDetail *anypb.Any `protobuf:"bytes,14,opt,name=custom_detail,json=customDetail,proto3" json:"custom_detail,omitempty"`
+	// WorkloadStatus
+	//
+	// A rollup of the status of the workloads of the installed package, only
+	// set when requested with include_workload_status by plugins supporting it.
+	WorkloadStatus *WorkloadStatus `protobuf:"bytes,15,opt,name=workload_status,json=workloadStatus,proto3" json:"workload_status,omitempty"`
+	// Available package repository
+	//
+	// A reference to the repository of the available package from which the
+	// package was installed, to link back to its catalog entry. Only set, as
+	// the available_package_ref, when the plugin can determine it.
+	AvailablePackageRepository *PackageRepositoryReference `protobuf:"bytes,16,opt,name=available_package_repository,json=availablePackageRepository,proto3" json:"available_package_repository,omitempty"`
+}
+
+func (x *InstalledPackageDetail) Reset() {
+	*x = InstalledPackageDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledPackageDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledPackageDetail) ProtoMessage() {}
+
+func (x *InstalledPackageDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledPackageDetail.ProtoReflect.Descriptor instead.
+func (*InstalledPackageDetail) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *InstalledPackageDetail) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetPkgVersionReference() *VersionReference {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstalledPackageDetail) GetCurrentVersion() *PackageAppVersion {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetValuesApplied() string {
+	if x != nil {
+		return x.ValuesApplied
+	}
+	return ""
+}
+
+func (x *InstalledPackageDetail) GetReconciliationOptions() *ReconciliationOptions {
+	if x != nil {
+		return x.ReconciliationOptions
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetStatus() *InstalledPackageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetPostInstallationNotes() string {
+	if x != nil {
+		return x.PostInstallationNotes
+	}
+	return ""
+}
+
+func (x *InstalledPackageDetail) GetAvailablePackageRef() *AvailablePackageReference {
+	if x != nil {
+		return x.AvailablePackageRef
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetLatestMatchingVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestMatchingVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetLatestVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetCustomDetail() *anypb.Any {
+	if x != nil {
+		return x.CustomDetail
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetWorkloadStatus() *WorkloadStatus {
+	if x != nil {
+		return x.WorkloadStatus
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetAvailablePackageRepository() *PackageRepositoryReference {
+	if x != nil {
+		return x.AvailablePackageRepository
+	}
+	return nil
+}
+
+// Context
+//
+// A Context specifies the context of the message
+type Context struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cluster
+	//
+	// A cluster name can be provided to target a specific cluster if multiple
+	// clusters are configured, otherwise all clusters will be assumed.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Namespace
+	//
+	// A namespace must be provided if the context of the operation is for a resource
+	// or resources in a particular namespace.
+	// For requests to list items, not including a namespace here implies that the context
+	// for the request is everything the requesting user can read, though the result can
+	// be filtered by any filtering options of the request. Plugins may choose to return
+	// Unimplemented for some queries for which we do not yet have a need.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *Context) Reset() {
+	*x = Context{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Context) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Context) ProtoMessage() {}
+
+func (x *Context) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Context.ProtoReflect.Descriptor instead.
+func (*Context) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Context) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *Context) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// AvailablePackageReference
+//
+// An AvailablePackageReference has the minimum information required to uniquely
+// identify an available package. This is re-used on the summary and details of an
+// available package.
+type AvailablePackageReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Available package context
+	//
+	// The context (cluster/namespace) for the package.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// Available package identifier
+	//
+	// The fully qualified identifier for the available package
+	// (ie. a unique name for the context). For some packaging systems
+	// (particularly those where an available package is backed by a CR) this
+	// will just be the name, but for others such as those where an available
+	// package is not backed by a CR (eg. standard helm) it may be necessary
+	// to include the repository in the name or even the repo namespace
+	// to ensure this is unique.
+	// For example two helm repositories can define
+	// an "apache" chart that is available globally, the names would need to
+	// encode that to be unique (ie. "repoA:apache" and "repoB:apache").
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// Plugin for the available package
+	//
+	// The plugin used to interact with this available package.
+	// This field should be omitted when the request is in the context of a specific plugin.
+	Plugin *v1alpha1.Plugin `protobuf:"bytes,3,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// Available package repository
+	//
+	// The repository from which the package is requested, set by the core
+	// server from a repository-qualified identifier, for the plugins in which
+	// a package identifier can be published by several repositories.
+	Repository *PackageRepositoryReference `protobuf:"bytes,4,opt,name=repository,proto3" json:"repository,omitempty"`
+}
+
+func (x *AvailablePackageReference) Reset() {
+	*x = AvailablePackageReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailablePackageReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailablePackageReference) ProtoMessage() {}
+
+func (x *AvailablePackageReference) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailablePackageReference.ProtoReflect.Descriptor instead.
+func (*AvailablePackageReference) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AvailablePackageReference) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *AvailablePackageReference) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *AvailablePackageReference) GetPlugin() *v1alpha1.Plugin {
+	if x != nil {
+		return x.Plugin
+	}
+	return nil
+}
+
+func (x *AvailablePackageReference) GetRepository() *PackageRepositoryReference {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+// Maintainer
+//
+// Maintainers for the package.
+type Maintainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maintainer name
+	//
+	// A maintainer name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Maintainer email
+	//
+	// A maintainer email
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *Maintainer) Reset() {
+	*x = Maintainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Maintainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Maintainer) ProtoMessage() {}
+
+func (x *Maintainer) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Maintainer.ProtoReflect.Descriptor instead.
+func (*Maintainer) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Maintainer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Maintainer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// FilterOptions
+//
+// FilterOptions available when requesting summaries
+type FilterOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Text query
+	//
+	// Text query for the request. The matching packages are ordered by
+	// relevance: first the ones named as the query, then the ones whose name
+	// starts with it or contains it, and then the ones whose description
+	// contains it.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Categories
+	//
+	// Collection of categories for the request
+	Categories []string `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Repositories
+	//
+	// Collection of repositories where the packages belong to
+	Repositories []string `protobuf:"bytes,3,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	// Package version
+	//
+	// Package version for the request
+	PkgVersion string `protobuf:"bytes,4,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
+	// App version
+	//
+	// Packaged app version for the request
+	AppVersion string `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// Exclude deprecated
+	//
+	// Exclude the packages deprecated as a whole from the results
+	ExcludeDeprecated bool `protobuf:"varint,6,opt,name=exclude_deprecated,json=excludeDeprecated,proto3" json:"exclude_deprecated,omitempty"`
+	// Maintainer
+	//
+	// Only include the packages with a maintainer whose name or email
+	// contains it, case-insensitively (eg. the domain of a vendor)
+	Maintainer string `protobuf:"bytes,7,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
+}
+
+func (x *FilterOptions) Reset() {
+	*x = FilterOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterOptions) ProtoMessage() {}
+
+func (x *FilterOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterOptions.ProtoReflect.Descriptor instead.
+func (*FilterOptions) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FilterOptions) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *FilterOptions) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *FilterOptions) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+func (x *FilterOptions) GetPkgVersion() string {
+	if x != nil {
+		return x.PkgVersion
+	}
+	return ""
+}
+
+func (x *FilterOptions) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *FilterOptions) GetExcludeDeprecated() bool {
+	if x != nil {
+		return x.ExcludeDeprecated
+	}
+	return false
+}
+
+func (x *FilterOptions) GetMaintainer() string {
+	if x != nil {
+		return x.Maintainer
+	}
+	return ""
+}
+
+// PaginationOptions
+//
+// The PaginationOptions based on the example proto at:
+// https://cloud.google.com/apis/design/design_patterns#list_pagination
+// just encapsulated in a message so it can be reused on different request messages.
+type PaginationOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Page token
+	//
+	// The client uses this field to request a specific page of the list results.
+	PageToken string `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Page size
+	//
+	// Clients use this field to specify the maximum number of results to be
+	// returned by the server. The server may further constrain the maximum number
+	// of results returned in a single page. If the page_size is 0, the server
+	// will decide the number of results to be returned.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *PaginationOptions) Reset() {
+	*x = PaginationOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginationOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationOptions) ProtoMessage() {}
+
+func (x *PaginationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationOptions.ProtoReflect.Descriptor instead.
+func (*PaginationOptions) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PaginationOptions) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *PaginationOptions) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// InstalledPackageReference
+//
+// An InstalledPackageReference has the minimum information required to uniquely
+// identify an installed package.
+type InstalledPackageReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Installed package context
+	//
+	// The context (cluster/namespace) for the package.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// The fully qualified identifier for the installed package
+	// (ie. a unique name for the context).
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// The plugin used to identify and interact with the installed package.
+	// This field can be omitted when the request is in the context of a specific plugin.
+	Plugin *v1alpha1.Plugin `protobuf:"bytes,3,opt,name=plugin,proto3" json:"plugin,omitempty"`
+}
+
+func (x *InstalledPackageReference) Reset() {
+	*x = InstalledPackageReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledPackageReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledPackageReference) ProtoMessage() {}
+
+func (x *InstalledPackageReference) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledPackageReference.ProtoReflect.Descriptor instead.
+func (*InstalledPackageReference) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *InstalledPackageReference) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *InstalledPackageReference) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *InstalledPackageReference) GetPlugin() *v1alpha1.Plugin {
+	if x != nil {
+		return x.Plugin
+	}
+	return nil
+}
+
+// VersionReference
+//
+// A VersionReference defines a version or constraint limiting matching versions.
+// The reason it is a separate message is so that in the future we can add other
+// fields as necessary (such as something similar to Carvel's `prereleases` option
+// to its versionSelection).
+type VersionReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version
+	//
+	// The format of the version constraint depends on the backend. For example,
+	// for a flux v2 and Carvel it’s a semver expression, such as ">=10.3 < 10.4"
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *VersionReference) Reset() {
+	*x = VersionReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionReference) ProtoMessage() {}
+
+func (x *VersionReference) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionReference.ProtoReflect.Descriptor instead.
+func (*VersionReference) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *VersionReference) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// InstalledPackageStatus
+//
+// An InstalledPackageStatus reports on the current status of the installation.
+type InstalledPackageStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ready
+	//
+	// An indication of whether the installation is ready or not
+	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	// Reason
+	//
+	// An enum indicating the reason for the current status.
+	Reason InstalledPackageStatus_StatusReason `protobuf:"varint,2,opt,name=reason,proto3,enum=kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus_StatusReason" json:"reason,omitempty"`
+	// UserReason
+	//
+	// Optional text to return for user context, which may be plugin specific.
+	UserReason string `protobuf:"bytes,3,opt,name=user_reason,json=userReason,proto3" json:"user_reason,omitempty"`
+	// Conditions
+	//
+	// The conditions of the underlying resource of the installed package, for
+	// troubleshooting a failed or pending installation. Only returned with the
+	// installed package detail, by the plugins whose resources have conditions.
+	Conditions []*InstalledPackageCondition `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+}
+
+func (x *InstalledPackageStatus) Reset() {
+	*x = InstalledPackageStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledPackageStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledPackageStatus) ProtoMessage() {}
+
+func (x *InstalledPackageStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledPackageStatus.ProtoReflect.Descriptor instead.
+func (*InstalledPackageStatus) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InstalledPackageStatus) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *InstalledPackageStatus) GetReason() InstalledPackageStatus_StatusReason {
+	if x != nil {
+		return x.Reason
+	}
+	return InstalledPackageStatus_STATUS_REASON_UNSPECIFIED
+}
+
+func (x *InstalledPackageStatus) GetUserReason() string {
+	if x != nil {
+		return x.UserReason
+	}
+	return ""
+}
+
+func (x *InstalledPackageStatus) GetConditions() []*InstalledPackageCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// InstalledPackageCondition
+//
+// A condition of the underlying resource of an installed package, such as
+// the Ready condition of a Flux HelmRelease.
+type InstalledPackageCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type
+	//
+	// The type of the condition (eg. "Ready")
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Status
+	//
+	// The status of the condition, one of "True", "False" or "Unknown"
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Reason
+	//
+	// The machine-readable reason of the last transition of the condition
+	// (eg. "InstallFailed")
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Message
+	//
+	// The human-readable details of the last transition of the condition
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Last transition time
+	//
+	// The RFC 3339 timestamp (eg. "2021-09-01T10:00:00Z") of the last
+	// transition of the condition
+	LastTransitionTime string `protobuf:"bytes,5,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
+}
+
+func (x *InstalledPackageCondition) Reset() {
+	*x = InstalledPackageCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledPackageCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledPackageCondition) ProtoMessage() {}
+
+func (x *InstalledPackageCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledPackageCondition.ProtoReflect.Descriptor instead.
+func (*InstalledPackageCondition) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *InstalledPackageCondition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InstalledPackageCondition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InstalledPackageCondition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InstalledPackageCondition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InstalledPackageCondition) GetLastTransitionTime() string {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return ""
+}
+
+// WorkloadStatus
+//
+// A WorkloadStatus rolls up the status of the workloads (deployments,
+// stateful sets and daemon sets) of an installed package, so that users can
+// check that its pods are actually running, not only that it was installed.
+type WorkloadStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Workloads
+	//
+	// The number of workloads of the installed package.
+	Workloads int32 `protobuf:"varint,1,opt,name=workloads,proto3" json:"workloads,omitempty"`
+	// Ready workloads
+	//
+	// The number of workloads with all their desired replicas ready.
+	ReadyWorkloads int32 `protobuf:"varint,2,opt,name=ready_workloads,json=readyWorkloads,proto3" json:"ready_workloads,omitempty"`
+	// Desired replicas
+	//
+	// The total number of replicas desired by the workloads.
+	DesiredReplicas int32 `protobuf:"varint,3,opt,name=desired_replicas,json=desiredReplicas,proto3" json:"desired_replicas,omitempty"`
+	// Ready replicas
+	//
+	// The total number of ready replicas of the workloads.
+	ReadyReplicas int32 `protobuf:"varint,4,opt,name=ready_replicas,json=readyReplicas,proto3" json:"ready_replicas,omitempty"`
+	// Progress percentage
+	//
+	// An estimate, from 0 to 100, of the progress of the installed package
+	// towards being ready, derived by the core from the ready replicas, or the
+	// ready workloads for workloads without desired replicas. It is zero when
+	// the installed package has no workloads from which to derive it.
+	ProgressPercentage int32 `protobuf:"varint,5,opt,name=progress_percentage,json=progressPercentage,proto3" json:"progress_percentage,omitempty"`
+}
+
+func (x *WorkloadStatus) Reset() {
+	*x = WorkloadStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadStatus) ProtoMessage() {}
+
+func (x *WorkloadStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadStatus.ProtoReflect.Descriptor instead.
+func (*WorkloadStatus) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *WorkloadStatus) GetWorkloads() int32 {
+	if x != nil {
+		return x.Workloads
+	}
+	return 0
+}
+
+func (x *WorkloadStatus) GetReadyWorkloads() int32 {
+	if x != nil {
+		return x.ReadyWorkloads
+	}
+	return 0
+}
+
+func (x *WorkloadStatus) GetDesiredReplicas() int32 {
+	if x != nil {
+		return x.DesiredReplicas
+	}
+	return 0
+}
+
+func (x *WorkloadStatus) GetReadyReplicas() int32 {
+	if x != nil {
+		return x.ReadyReplicas
+	}
+	return 0
+}
+
+func (x *WorkloadStatus) GetProgressPercentage() int32 {
+	if x != nil {
+		return x.ProgressPercentage
+	}
+	return 0
+}
+
+// ReconciliationOptions
+//
+// ReconciliationOptions enable specifying standard fields for backends that continuously
+// reconcile a package install as new matching versions are released. Most of the naming
+// is from the flux HelmReleaseSpec though it maps directly to equivalent fields on Carvel's
+// InstalledPackage.
+type ReconciliationOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reconciliation Interval
+	//
+	// The interval with which the package is checked for reconciliation (in seconds)
+	Interval int32 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Suspend
+	//
+	// Whether reconciliation should be suspended until otherwise enabled.
+	Suspend bool `protobuf:"varint,2,opt,name=suspend,proto3" json:"suspend,omitempty"`
+	// ServiceAccountName
+	//
+	// A name for a service account in the same namespace which should be used
+	// to perform the reconciliation.
+	ServiceAccountName string `protobuf:"bytes,3,opt,name=service_account_name,json=serviceAccountName,proto3" json:"service_account_name,omitempty"`
+}
+
+func (x *ReconciliationOptions) Reset() {
+	*x = ReconciliationOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconciliationOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationOptions) ProtoMessage() {}
+
+func (x *ReconciliationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationOptions.ProtoReflect.Descriptor instead.
+func (*ReconciliationOptions) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReconciliationOptions) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *ReconciliationOptions) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+func (x *ReconciliationOptions) GetServiceAccountName() string {
+	if x != nil {
+		return x.ServiceAccountName
+	}
+	return ""
+}
+
+// Package AppVersion
+//
+// PackageAppVersion conveys both the package version and the packaged app version.
+type PackageAppVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Package version
+	//
+	// Version of the package itself
+	PkgVersion string `protobuf:"bytes,1,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
+	// Application version
+	//
+	// Version of the packaged application
+	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// Relation
+	//
+	// Whether the package version is older than, the same as or newer than the
+	// installed version requested with the versions. Unspecified when no
+	// installed version was requested or when either version is not a valid
+	// semantic version.
+	Relation PackageAppVersion_VersionRelation `protobuf:"varint,3,opt,name=relation,proto3,enum=kubeappsapis.core.packages.v1alpha1.PackageAppVersion_VersionRelation" json:"relation,omitempty"`
+}
+
+func (x *PackageAppVersion) Reset() {
+	*x = PackageAppVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageAppVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageAppVersion) ProtoMessage() {}
+
+func (x *PackageAppVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageAppVersion.ProtoReflect.Descriptor instead.
+func (*PackageAppVersion) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PackageAppVersion) GetPkgVersion() string {
+	if x != nil {
+		return x.PkgVersion
+	}
+	return ""
+}
+
+func (x *PackageAppVersion) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *PackageAppVersion) GetRelation() PackageAppVersion_VersionRelation {
+	if x != nil {
+		return x.Relation
+	}
+	return PackageAppVersion_VERSION_RELATION_UNSPECIFIED
+}
+
+// Package Version Group
+//
+// PackageVersionGroup summarizes the versions of a package sharing the same
+// major and minor versions.
+type PackageVersionGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Minor version
+	//
+	// The major.minor version shared by the versions of the group (eg. "10.3")
+	MinorVersion string `protobuf:"bytes,1,opt,name=minor_version,json=minorVersion,proto3" json:"minor_version,omitempty"`
+	// Latest version
+	//
+	// The version of the group with the newest patch
+	Latest *PackageAppVersion `protobuf:"bytes,2,opt,name=latest,proto3" json:"latest,omitempty"`
+	// Version count
+	//
+	// The number of versions in the group
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PackageVersionGroup) Reset() {
+	*x = PackageVersionGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageVersionGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageVersionGroup) ProtoMessage() {}
+
+func (x *PackageVersionGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageVersionGroup.ProtoReflect.Descriptor instead.
+func (*PackageVersionGroup) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PackageVersionGroup) GetMinorVersion() string {
+	if x != nil {
+		return x.MinorVersion
+	}
+	return ""
+}
+
+func (x *PackageVersionGroup) GetLatest() *PackageAppVersion {
+	if x != nil {
+		return x.Latest
+	}
+	return nil
+}
+
+func (x *PackageVersionGroup) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Package Dependency
+//
+// PackageDependency conveys the information of a package required by another
+// package, such as a subchart of a Helm chart.
+type PackageDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Dependency name
+	//
+	// The name of the required package
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Dependency version
+	//
+	// The version (or version constraint) of the required package
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Dependency repository
+	//
+	// The repository from which the required package is fetched
+	Repository string `protobuf:"bytes,3,opt,name=repository,proto3" json:"repository,omitempty"`
+	// Dependency condition
+	//
+	// The condition which enables or disables the required package, if any
+	Condition string `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	// Dependencies
+	//
+	// The transitive dependencies of the required package
+	Dependencies []*PackageDependency `protobuf:"bytes,5,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (x *PackageDependency) Reset() {
+	*x = PackageDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageDependency) ProtoMessage() {}
+
+func (x *PackageDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageDependency.ProtoReflect.Descriptor instead.
+func (*PackageDependency) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PackageDependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackageDependency) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PackageDependency) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *PackageDependency) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *PackageDependency) GetDependencies() []*PackageDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+// Package Provenance
+//
+// PackageProvenance conveys whether a package is signed, by whom and
+// whether the signature could be verified.
+type PackageProvenance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed
+	//
+	// Whether the package is signed
+	Signed bool `protobuf:"varint,1,opt,name=signed,proto3" json:"signed,omitempty"`
+	// Signer
+	//
+	// The identity of the signer of the package, if any
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Verification status
+	//
+	// An enum indicating the result of verifying the package signature.
+	VerificationStatus PackageProvenance_VerificationStatus `protobuf:"varint,3,opt,name=verification_status,json=verificationStatus,proto3,enum=kubeappsapis.core.packages.v1alpha1.PackageProvenance_VerificationStatus" json:"verification_status,omitempty"`
+}
+
+func (x *PackageProvenance) Reset() {
+	*x = PackageProvenance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageProvenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageProvenance) ProtoMessage() {}
+
+func (x *PackageProvenance) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageProvenance.ProtoReflect.Descriptor instead.
+func (*PackageProvenance) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PackageProvenance) GetSigned() bool {
+	if x != nil {
+		return x.Signed
+	}
+	return false
+}
+
+func (x *PackageProvenance) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *PackageProvenance) GetVerificationStatus() PackageProvenance_VerificationStatus {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return PackageProvenance_VERIFICATION_STATUS_UNSPECIFIED
+}
+
+// Package Repository Reference
+//
+// A PackageRepositoryReference identifies the repository from which a
+// package is available.
+type PackageRepositoryReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository name
+	//
+	// The name of the repository
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Repository namespace
+	//
+	// The namespace in which the repository is defined, if any
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Repository type
+	//
+	// The plugin-specific type of the repository (eg. "helm" or "oci")
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Repository URL
+	//
+	// The url of the repository, to link to the source of the package
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *PackageRepositoryReference) Reset() {
+	*x = PackageRepositoryReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageRepositoryReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageRepositoryReference) ProtoMessage() {}
+
+func (x *PackageRepositoryReference) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageRepositoryReference.ProtoReflect.Descriptor instead.
+func (*PackageRepositoryReference) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PackageRepositoryReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackageRepositoryReference) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PackageRepositoryReference) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PackageRepositoryReference) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// Catalog Stats
+//
+// CatalogStats provides an overview of the available and installed packages,
+// aggregated across the plugins.
+type CatalogStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Available package count
+	//
+	// The total number of available packages
+	AvailablePackageCount int32 `protobuf:"varint,1,opt,name=available_package_count,json=availablePackageCount,proto3" json:"available_package_count,omitempty"`
+	// Installed package count
+	//
+	// The total number of installed packages, including the packages managed
+	// outside Kubeapps
+	InstalledPackageCount int32 `protobuf:"varint,2,opt,name=installed_package_count,json=installedPackageCount,proto3" json:"installed_package_count,omitempty"`
+	// Plugin stats
+	//
+	// The number of available and installed packages of each plugin
+	PluginStats []*PluginCatalogStats `protobuf:"bytes,3,rep,name=plugin_stats,json=pluginStats,proto3" json:"plugin_stats,omitempty"`
+	// Category stats
+	//
+	// The number of available packages of each category, in the same order
+	// as the categories of the available package summaries
+	CategoryStats []*CategoryCatalogStats `protobuf:"bytes,4,rep,name=category_stats,json=categoryStats,proto3" json:"category_stats,omitempty"`
+}
+
+func (x *CatalogStats) Reset() {
+	*x = CatalogStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogStats) ProtoMessage() {}
+
+func (x *CatalogStats) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogStats.ProtoReflect.Descriptor instead.
+func (*CatalogStats) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CatalogStats) GetAvailablePackageCount() int32 {
+	if x != nil {
+		return x.AvailablePackageCount
+	}
+	return 0
+}
+
+func (x *CatalogStats) GetInstalledPackageCount() int32 {
+	if x != nil {
+		return x.InstalledPackageCount
+	}
+	return 0
+}
+
+func (x *CatalogStats) GetPluginStats() []*PluginCatalogStats {
+	if x != nil {
+		return x.PluginStats
+	}
+	return nil
+}
+
+func (x *CatalogStats) GetCategoryStats() []*CategoryCatalogStats {
+	if x != nil {
+		return x.CategoryStats
+	}
+	return nil
+}
+
+// Plugin Catalog Stats
+//
+// PluginCatalogStats provides the number of packages of a plugin.
+type PluginCatalogStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Plugin
+	//
+	// The plugin to which the counts refer
+	Plugin *v1alpha1.Plugin `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// Available package count
+	//
+	// The number of available packages of the plugin
+	AvailablePackageCount int32 `protobuf:"varint,2,opt,name=available_package_count,json=availablePackageCount,proto3" json:"available_package_count,omitempty"`
+	// Installed package count
+	//
+	// The number of installed packages of the plugin
+	InstalledPackageCount int32 `protobuf:"varint,3,opt,name=installed_package_count,json=installedPackageCount,proto3" json:"installed_package_count,omitempty"`
+}
+
+func (x *PluginCatalogStats) Reset() {
+	*x = PluginCatalogStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginCatalogStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginCatalogStats) ProtoMessage() {}
+
+func (x *PluginCatalogStats) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginCatalogStats.ProtoReflect.Descriptor instead.
+func (*PluginCatalogStats) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PluginCatalogStats) GetPlugin() *v1alpha1.Plugin {
+	if x != nil {
+		return x.Plugin
+	}
+	return nil
+}
+
+func (x *PluginCatalogStats) GetAvailablePackageCount() int32 {
+	if x != nil {
+		return x.AvailablePackageCount
+	}
+	return 0
+}
+
+func (x *PluginCatalogStats) GetInstalledPackageCount() int32 {
+	if x != nil {
+		return x.InstalledPackageCount
+	}
+	return 0
+}
+
+// Category Catalog Stats
+//
+// CategoryCatalogStats provides the number of available packages of a category.
+type CategoryCatalogStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Category
+	//
+	// The name of the category
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// Available package count
+	//
+	// The number of available packages of the category
+	AvailablePackageCount int32 `protobuf:"varint,2,opt,name=available_package_count,json=availablePackageCount,proto3" json:"available_package_count,omitempty"`
+}
+
+func (x *CategoryCatalogStats) Reset() {
+	*x = CategoryCatalogStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryCatalogStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryCatalogStats) ProtoMessage() {}
+
+func (x *CategoryCatalogStats) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryCatalogStats.ProtoReflect.Descriptor instead.
+func (*CategoryCatalogStats) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CategoryCatalogStats) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryCatalogStats) GetAvailablePackageCount() int32 {
+	if x != nil {
+		return x.AvailablePackageCount
+	}
+	return 0
+}
+
+// Catalog Index
+//
+// CatalogIndex provides a minimal entry for each available package of the
+// catalog, suitable for filtering the catalog on the client side.
+type CatalogIndex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Entries
+	//
+	// The entries of the available packages, in the order of the available
+	// package summaries
+	Entries []*CatalogIndexEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *CatalogIndex) Reset() {
+	*x = CatalogIndex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogIndex) ProtoMessage() {}
+
+func (x *CatalogIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogIndex.ProtoReflect.Descriptor instead.
+func (*CatalogIndex) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CatalogIndex) GetEntries() []*CatalogIndexEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Catalog Index Entry
+//
+// CatalogIndexEntry provides the searchable fields of an available package.
+type CatalogIndexEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier
+	//
+	// The identifier of the available package
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// Plugin
+	//
+	// The name of the plugin managing the available package
+	Plugin string `protobuf:"bytes,2,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// Name
+	//
+	// The name of the available package
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Display name
+	//
+	// The name of the available package used for display
+	DisplayName string `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Package version
+	//
+	// The latest version of the available package
+	PkgVersion string `protobuf:"bytes,5,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
+	// App version
+	//
+	// The version of the application packaged by the latest version
+	AppVersion string `protobuf:"bytes,6,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// Categories
+	//
+	// The categories of the available package
+	Categories []string `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Repository
+	//
+	// The name of the repository publishing the available package, if any
+	Repository string `protobuf:"bytes,8,opt,name=repository,proto3" json:"repository,omitempty"`
+	// Package versions
+	//
+	// All the versions of the available package, starting with the latest,
+	// excluding the pre-releases
+	PkgVersions []string `protobuf:"bytes,9,rep,name=pkg_versions,json=pkgVersions,proto3" json:"pkg_versions,omitempty"`
+}
+
+func (x *CatalogIndexEntry) Reset() {
+	*x = CatalogIndexEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogIndexEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogIndexEntry) ProtoMessage() {}
+
+func (x *CatalogIndexEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogIndexEntry.ProtoReflect.Descriptor instead.
+func (*CatalogIndexEntry) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CatalogIndexEntry) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *CatalogIndexEntry) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *CatalogIndexEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogIndexEntry) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CatalogIndexEntry) GetPkgVersion() string {
+	if x != nil {
+		return x.PkgVersion
+	}
+	return ""
+}
+
+func (x *CatalogIndexEntry) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *CatalogIndexEntry) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CatalogIndexEntry) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *CatalogIndexEntry) GetPkgVersions() []string {
+	if x != nil {
+		return x.PkgVersions
+	}
+	return nil
+}
+
+// Localized Description
+//
+// LocalizedDescription provides the descriptions of a package in a given language.
+type LocalizedDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Language
+	//
+	// The language tag of the descriptions (eg. "fr" or "pt-BR")
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	// Short description
+	//
+	// The short description of the package in the language
+	ShortDescription string `protobuf:"bytes,2,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
+	// Long description
+	//
+	// The long description of the package in the language, only used
+	// for the available package detail
+	LongDescription string `protobuf:"bytes,3,opt,name=long_description,json=longDescription,proto3" json:"long_description,omitempty"`
+}
+
+func (x *LocalizedDescription) Reset() {
+	*x = LocalizedDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalizedDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalizedDescription) ProtoMessage() {}
+
+func (x *LocalizedDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalizedDescription.ProtoReflect.Descriptor instead.
+func (*LocalizedDescription) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *LocalizedDescription) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *LocalizedDescription) GetShortDescription() string {
+	if x != nil {
+		return x.ShortDescription
+	}
+	return ""
+}
+
+func (x *LocalizedDescription) GetLongDescription() string {
+	if x != nil {
+		return x.LongDescription
+	}
+	return ""
+}
+
+// Delete Installed Package Result
+//
+// DeleteInstalledPackageResult provides the result of the deletion of an
+// installed package as part of a batch.
+type DeleteInstalledPackageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reference of the installed package to be deleted.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// Status code
+	//
+	// The gRPC status code of the deletion (0 when deleted).
+	StatusCode int32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Error message
+	//
+	// The error message of a failed deletion, empty when deleted.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *DeleteInstalledPackageResult) Reset() {
+	*x = DeleteInstalledPackageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteInstalledPackageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInstalledPackageResult) ProtoMessage() {}
+
+func (x *DeleteInstalledPackageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInstalledPackageResult.ProtoReflect.Descriptor instead.
+func (*DeleteInstalledPackageResult) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteInstalledPackageResult) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+func (x *DeleteInstalledPackageResult) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *DeleteInstalledPackageResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// Get Installed Package Detail Result
+//
+// GetInstalledPackageDetailResult provides the detail of an installed package,
+// or the error getting it, as part of a batch.
+type GetInstalledPackageDetailResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reference of the requested installed package.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// Installed package detail
+	//
+	// The detail of the installed package, unset when it could not be got.
+	InstalledPackageDetail *InstalledPackageDetail `protobuf:"bytes,2,opt,name=installed_package_detail,json=installedPackageDetail,proto3" json:"installed_package_detail,omitempty"`
+	// Status code
+	//
+	// The gRPC status code of getting the detail (0 when got).
+	StatusCode int32 `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Error message
+	//
+	// The error message when the detail could not be got, empty otherwise.
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *GetInstalledPackageDetailResult) Reset() {
+	*x = GetInstalledPackageDetailResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageDetailResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageDetailResult) ProtoMessage() {}
+
+func (x *GetInstalledPackageDetailResult) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageDetailResult.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageDetailResult) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetInstalledPackageDetailResult) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+func (x *GetInstalledPackageDetailResult) GetInstalledPackageDetail() *InstalledPackageDetail {
+	if x != nil {
+		return x.InstalledPackageDetail
+	}
+	return nil
+}
+
+func (x *GetInstalledPackageDetailResult) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetInstalledPackageDetailResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// Resource Drift
+//
+// ResourceDrift reports how a resource of an installed package differs from
+// the one currently in the cluster.
+type ResourceDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The API version of the resource (eg. apps/v1).
+	ApiVersion string `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// The kind of the resource (eg. Deployment).
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The name of the resource.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The namespace of the resource, empty for cluster-scoped resources.
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Missing
+	//
+	// Whether the resource is missing from the cluster, in which case no
+	// fields are reported.
+	Missing bool `protobuf:"varint,5,opt,name=missing,proto3" json:"missing,omitempty"`
+	// Fields
+	//
+	// The fields of the resource set by the installed package with a
+	// different value in the cluster.
+	Fields []*FieldDrift `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *ResourceDrift) Reset() {
+	*x = ResourceDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceDrift) ProtoMessage() {}
+
+func (x *ResourceDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceDrift.ProtoReflect.Descriptor instead.
+func (*ResourceDrift) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ResourceDrift) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ResourceDrift) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ResourceDrift) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceDrift) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResourceDrift) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+func (x *ResourceDrift) GetFields() []*FieldDrift {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Rendered Resource
+//
+// RenderedResource identifies a resource rendered from the templates of a
+// package.
+type RenderedResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The API version of the resource (eg. apps/v1).
+	ApiVersion string `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// The kind of the resource (eg. Deployment).
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The name of the resource.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The namespace of the resource, empty for cluster-scoped resources.
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *RenderedResource) Reset() {
+	*x = RenderedResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderedResource) ProtoMessage() {}
+
+func (x *RenderedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderedResource.ProtoReflect.Descriptor instead.
+func (*RenderedResource) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RenderedResource) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *RenderedResource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RenderedResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenderedResource) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// Field Drift
+//
+// FieldDrift reports a field of a resource set by an installed package with
+// a different value in the cluster.
+type FieldDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path of the field (eg. spec.replicas).
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The value of the field set by the installed package, as JSON.
+	Expected string `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	// The value of the field in the cluster, as JSON, empty when unset.
+	Actual string `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+}
+
+func (x *FieldDrift) Reset() {
+	*x = FieldDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDrift) ProtoMessage() {}
+
+func (x *FieldDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDrift.ProtoReflect.Descriptor instead.
+func (*FieldDrift) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FieldDrift) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FieldDrift) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *FieldDrift) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+// PackageActivity
+//
+// An operation, such as an install or an update, on an installed package.
+type PackageActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Installed package reference
+	//
+	// A reference uniquely identifying the installed package
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// Name
+	//
+	// The name given to the installed package by the user
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Operation
+	//
+	// The operation done on the installed package
+	Operation PackageActivity_Operation `protobuf:"varint,3,opt,name=operation,proto3,enum=kubeappsapis.core.packages.v1alpha1.PackageActivity_Operation" json:"operation,omitempty"`
+	// Package version
+	//
+	// The version of the package installed by the operation
+	PkgVersionReference *PackageAppVersion `protobuf:"bytes,4,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// Time
+	//
+	// The RFC 3339 timestamp (eg. "2021-09-01T10:00:00Z") of the operation
+	Time string `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	// Description
+	//
+	// An optional description of the operation, which may be plugin specific
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *PackageActivity) Reset() {
+	*x = PackageActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageActivity) ProtoMessage() {}
+
+func (x *PackageActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageActivity.ProtoReflect.Descriptor instead.
+func (*PackageActivity) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PackageActivity) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+func (x *PackageActivity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackageActivity) GetOperation() PackageActivity_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return PackageActivity_OPERATION_UNSPECIFIED
+}
+
+func (x *PackageActivity) GetPkgVersionReference() *PackageAppVersion {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
+}
+
+func (x *PackageActivity) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *PackageActivity) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// PackageChangelogEntry
+//
+// The human-readable changes introduced by a version of a package, as
+// shipped with the package (eg. the chart annotations or CHANGELOG file).
+type PackageChangelogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Package version
+	//
+	// The version of the package introducing the changes
+	PkgVersion string `protobuf:"bytes,1,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
+	// Changes
+	//
+	// The description of each change, in the order given by the package
+	Changes []string `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *PackageChangelogEntry) Reset() {
+	*x = PackageChangelogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageChangelogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageChangelogEntry) ProtoMessage() {}
+
+func (x *PackageChangelogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageChangelogEntry.ProtoReflect.Descriptor instead.
+func (*PackageChangelogEntry) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PackageChangelogEntry) GetPkgVersion() string {
+	if x != nil {
+		return x.PkgVersion
+	}
+	return ""
+}
+
+func (x *PackageChangelogEntry) GetChanges() []string {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+var File_kubeappsapis_core_packages_v1alpha1_packages_proto protoreflect.FileDescriptor
+
+var file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDesc = []byte{
+	0x0a, 0x32, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x23, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x30, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
+	0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x04, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x65, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25, 0x0a,
+	0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22,
+	0xb6, 0x02, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72,
+	0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a,
+	0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xd5, 0x02, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x2d,
+	0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x26, 0x47,
+	0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbe, 0x01, 0x0a, 0x27, 0x47,
+	0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b,
+	0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe9, 0x01, 0x0a, 0x23,
+	0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x5f, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x50, 0x6b, 0x67,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xea, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x6b, 0x67,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x66, 0x72, 0x6f, 0x6d, 0x50, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x0e, 0x74, 0x6f, 0x5f, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x50, 0x6b, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe5, 0x02, 0x0a, 0x1d, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b,
+	0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xd4, 0x01, 0x0a,
+	0x23, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x65, 0x0a, 0x12,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
 	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
 	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
 	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6f, 0x6e, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
 	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
 	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74,
 	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
@@ -2605,40 +7200,119 @@ var file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDesc = []byte{
 	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e,
 	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
 	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0xf2, 0x03, 0x0a,
-	0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72,
-	0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
-	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x66, 0x12, 0x53, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62,
-	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x69, 0x0a, 0x15, 0x70,
-	0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x36, 0x0a, 0x17,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x74, 0x0a, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x96, 0x01,
+	0x0a, 0x20, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0xa4, 0x07, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x53, 0x0a, 0x0e,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x66, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x6b, 0x75, 0x62,
 	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
 	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x71,
-	0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x75, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x53, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a,
+	0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x03,
+	0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e,
 	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
 	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f,
-	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x89, 0x03, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x66, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9e, 0x02, 0x0a, 0x25, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
 	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
@@ -2654,307 +7328,594 @@ var file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDesc = []byte{
 	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70,
 	0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
 	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x16, 0x72, 0x65,
-	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72,
+	0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x66, 0x22, 0xce, 0x01, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0x93, 0x01, 0x0a, 0x1d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62,
 	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
 	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x93, 0x01,
-	0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22,
+	0xbb, 0x01, 0x0a, 0x23, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x74, 0x0a, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x60, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0xc9, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x65, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x60, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x46, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xc9, 0x02, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7c, 0x0a, 0x1b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x19, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x0f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x0d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9a,
+	0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x18, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x16, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x39, 0x0a, 0x21, 0x47,
+	0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0xf0, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
+	0x0a, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5f, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x27, 0x47, 0x65,
+	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x13, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x22, 0x51, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x11,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x0a, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xcc, 0x01, 0x0a, 0x24,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x1b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x19, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x21, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x75, 0x0a, 0x18, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x89, 0x01, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x22, 0x94, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0x94, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0x3c,
+	0x0a, 0x26, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x8e, 0x01, 0x0a,
+	0x20, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x69, 0x66, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x64, 0x72, 0x69, 0x66, 0x74, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
 	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
 	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x66, 0x22, 0xec, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x1b,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69,
+	0x66, 0x74, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xb6, 0x01,
+	0x0a, 0x2a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x24, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x41, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x71, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x63, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x0c, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22,
+	0x99, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
 	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
-	0x19, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
-	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x18, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7b, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6e,
+	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x63, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61,
+	0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x63, 0x61, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x6e,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd8,
+	0x05, 0x0a, 0x17, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62,
 	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
 	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x16, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22,
-	0x8f, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x70, 0x0a, 0x16, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xaf, 0x08, 0x0a, 0x16, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x6d,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x6d,
+	0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x6f, 0x6e, 0x67, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x64, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12,
+	0x51, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x56, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x70, 0x0a, 0x16, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
 	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0xcc, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x1b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x19, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x9a, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x18, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
-	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x94, 0x01,
-	0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x66, 0x22, 0x94, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x75, 0x62, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6b, 0x75, 0x62, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd4, 0x07, 0x0a, 0x17,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61,
 	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
 	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
 	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73,
 	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66,
 	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0x20, 0x0a, 0x1e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x03,
-	0x0a, 0x17, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72,
-	0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
-	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69,
+	0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69,
+	0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6b, 0x67, 0x5f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x6b, 0x67, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6e, 0x0a,
+	0x17, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a,
+	0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x5b, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x72,
+	0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61,
 	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x65, 0x66, 0x22, 0xf3, 0x09, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x72, 0x0a,
+	0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x66, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x5f, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65,
 	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
 	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
 	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
-	0x0a, 0x11, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf1, 0x05, 0x0a, 0x16,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
-	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x50,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x68,
-	0x6f, 0x6d, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68,
-	0x6f, 0x6d, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72,
-	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x6f, 0x6e,
-	0x67, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x64, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18,
-	0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c,
-	0x73, 0x12, 0x51, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73,
-	0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
-	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x69,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22,
-	0x83, 0x06, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x72, 0x0a, 0x15, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62,
-	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5f,
-	0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x6e, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x71, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x36, 0x0a, 0x17, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
 	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6b,
-	0x67, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6b, 0x67, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x6e, 0x0a, 0x17, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65,
-	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x91, 0x08, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x6e, 0x0a, 0x17,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x0e,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0d, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x5c, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
 	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x66, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
-	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b,
-	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x71, 0x0a, 0x16, 0x72,
-	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x1c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75,
 	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
 	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
-	0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x53,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b,
-	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x15, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x72, 0x65, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x1a, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x41, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x19,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62,
 	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
 	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12,
-	0x6e, 0x0a, 0x17, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69,
-	0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70,
-	0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x5d, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
-	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39,
-	0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0c, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x41, 0x0a, 0x07, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xc7, 0x01, 0x0a,
-	0x19, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75,
-	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x36, 0x0a, 0x0a, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x61,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x5f, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x36, 0x0a, 0x0a, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x61,
 	0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xab,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xfa,
 	0x01, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
@@ -2965,226 +7926,577 @@ var file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDesc = []byte{
 	0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
 	0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x11,
-	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xc7, 0x01,
-	0x0a, 0x19, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b,
+	0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x4f, 0x0a, 0x11, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xc7, 0x01, 0x0a,
+	0x19, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x2c, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb2, 0x03, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x60, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b,
 	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
 	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
-	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52,
-	0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x2c, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0x02, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x60, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
-	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41,
-	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c,
-	0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12,
-	0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
-	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x22, 0x7f, 0x0a, 0x15, 0x52, 0x65,
-	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x11, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c,
+	0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19,
+	0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x22, 0xab, 0x01, 0x0a, 0x19, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x65, 0x73,
+	0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x12, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x22, 0x7f, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc5, 0x02, 0x0a, 0x11, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a,
+	0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x89, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x45, 0x52, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x4c, 0x44, 0x45,
+	0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x10,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x52, 0x10, 0x03, 0x22, 0xa0, 0x01,
+	0x0a, 0x13, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69,
+	0x6e, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x06, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xdb, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0xbc,
+	0x02, 0x0a, 0x11, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x12, 0x7a, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x49, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x7b, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x56,
+	0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a,
+	0x1a, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x74, 0x0a,
+	0x1a, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x22, 0xbc, 0x02, 0x0a, 0x0c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0c, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x60, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x12, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x36, 0x0a,
+	0x17, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6a, 0x0a,
+	0x14, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x0c, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x50, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x11,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
 	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
 	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x32, 0xa8, 0x16, 0x0a, 0x0f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xe6, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x6e, 0x67, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6c, 0x6f, 0x6e, 0x67, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xd8, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd2, 0x02,
+	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x75, 0x0a, 0x18, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
 	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12,
-	0xac, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x45, 0x2e,
-	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
-	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x01, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0xf8, 0x01, 0x12, 0xf5, 0x01, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
-	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e,
-	0x73, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72,
-	0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x12, 0xbb,
-	0x03, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x52, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x47, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x79,
+	0x0a, 0x10, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x54, 0x0a, 0x0a, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x22,
+	0x9d, 0x04, 0x0a, 0x0f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6a, 0x0a, 0x15, 0x70, 0x6b, 0x67,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x09,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04, 0x22,
+	0x52, 0x0a, 0x15, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x32, 0xfb, 0x43, 0x0a, 0x0f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xe6, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0xac, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x45,
 	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
 	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
 	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
-	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x88, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x81, 0x02, 0x12, 0xfe, 0x01, 0x2f, 0x63,
-	0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x61, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72,
-	0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d,
-	0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xe6, 0x01, 0x0a,
-	0x1c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x48, 0x2e,
-	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
-	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x72,
-	0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0xac, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x12, 0x45, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
-	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x46, 0x2e, 0x6b, 0x75, 0x62,
-	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xff, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0xf8, 0x01, 0x12, 0xf5, 0x01, 0x2f,
-	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f,
-	0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
-	0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x7d, 0x12, 0xd7, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
-	0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
-	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e,
-	0x22, 0x29, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xa6,
-	0x03, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65,
-	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e,
-	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x82, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0xfb, 0x01, 0x1a, 0xf5, 0x01, 0x2f,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0xf8, 0x01, 0x12, 0xf5, 0x01, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x12,
+	0xbb, 0x03, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x47, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x88, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x81, 0x02, 0x12, 0xfe, 0x01, 0x2f,
 	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
 	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f,
-	0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
 	0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70,
+	0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70,
 	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
 	0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63,
+	0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63,
 	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63,
 	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa3, 0x03, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x69, 0x65, 0x72, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xcb, 0x03,
+	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x4b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4c,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x02, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x85, 0x02, 0x12, 0x82, 0x02, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0xcf, 0x03, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x4c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4d,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x02,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x86, 0x02, 0x12, 0x83, 0x02, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0xbf, 0x03,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x12, 0x48,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x89, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x82, 0x02, 0x12, 0xff, 0x01,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x12,
+	0xc3, 0x03, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x44, 0x69, 0x66,
+	0x66, 0x12, 0x49, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4a, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x83, 0x02, 0x12, 0x80, 0x02, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d,
+	0x2f, 0x63, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x64, 0x69, 0x66, 0x66, 0x12, 0xad, 0x03, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x02, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x82, 0x02, 0x22, 0xfc, 0x01, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x72, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0xe6, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
 	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0xf8, 0x01, 0x2a, 0xf5, 0x01, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0xac,
+	0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x45, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0xf8, 0x01, 0x12, 0xf5, 0x01, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61,
 	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
 	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
 	0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
@@ -3199,13 +8511,332 @@ var file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDesc = []byte{
 	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e,
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74,
 	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
-	0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x42, 0x4b, 0x5a,
-	0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65,
-	0x61, 0x70, 0x70, 0x73, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6d,
-	0x64, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2d, 0x61, 0x70, 0x69, 0x73, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x12, 0xfa, 0x01,
+	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x4b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4c,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x36, 0x22, 0x31, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xb3, 0x03, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x45, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0xff,
+	0x01, 0x12, 0xfc, 0x01, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f,
+	0x63, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0xd7, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x42, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x29, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xa6, 0x03, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82,
+	0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0xfb, 0x01, 0x1a, 0xf5, 0x01, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d,
+	0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d,
+	0x3a, 0x01, 0x2a, 0x12, 0xca, 0x03, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x4a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x4b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x55, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x8e, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x87, 0x02, 0x22, 0x81, 0x02, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x7d, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x69, 0x66, 0x66, 0x3a, 0x01, 0x2a,
+	0x12, 0xaf, 0x03, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x44, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x72, 0x69,
+	0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x02, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0xfe, 0x01, 0x12, 0xfb, 0x01, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x64, 0x72, 0x69,
+	0x66, 0x74, 0x12, 0xd7, 0x03, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4e, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4f, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x02, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x88, 0x02, 0x12, 0x85, 0x02, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0xaa, 0x03, 0x0a,
+	0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x86, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0xff, 0x01, 0x12, 0xfc, 0x01, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f,
+	0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xa3, 0x03, 0x0a, 0x16, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0xf8, 0x01, 0x2a, 0xf5, 0x01, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x66, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x12,
+	0xf0, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x22, 0x30, 0x2f,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a,
+	0x01, 0x2a, 0x12, 0xba, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0xbc, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x93,
+	0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x76, 0x12, 0x74,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x63, 0x2f, 0x7b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x7d, 0x12, 0xba, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x32, 0xcf, 0x03, 0x0a, 0x15, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xb5, 0x03, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x12, 0x45, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x64, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0xff, 0x01, 0x12, 0xfc, 0x01, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d,
+	0x2f, 0x63, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x6e, 0x73, 0x2f, 0x7b, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x6d,
+	0x65, 0x30, 0x01, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x2d, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3220,114 +8851,266 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP() []byt
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescData
 }
 
-var file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_kubeappsapis_core_packages_v1alpha1_packages_proto_goTypes = []interface{}{
-	(InstalledPackageStatus_StatusReason)(0),     // 0: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.StatusReason
-	(*GetAvailablePackageSummariesRequest)(nil),  // 1: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest
-	(*GetAvailablePackageDetailRequest)(nil),     // 2: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest
-	(*GetAvailablePackageVersionsRequest)(nil),   // 3: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest
-	(*GetInstalledPackageSummariesRequest)(nil),  // 4: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest
-	(*GetInstalledPackageDetailRequest)(nil),     // 5: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest
-	(*CreateInstalledPackageRequest)(nil),        // 6: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest
-	(*UpdateInstalledPackageRequest)(nil),        // 7: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest
-	(*DeleteInstalledPackageRequest)(nil),        // 8: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest
-	(*GetAvailablePackageSummariesResponse)(nil), // 9: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse
-	(*GetAvailablePackageDetailResponse)(nil),    // 10: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse
-	(*GetAvailablePackageVersionsResponse)(nil),  // 11: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse
-	(*GetInstalledPackageSummariesResponse)(nil), // 12: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse
-	(*GetInstalledPackageDetailResponse)(nil),    // 13: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse
-	(*CreateInstalledPackageResponse)(nil),       // 14: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse
-	(*UpdateInstalledPackageResponse)(nil),       // 15: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse
-	(*DeleteInstalledPackageResponse)(nil),       // 16: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResponse
-	(*AvailablePackageSummary)(nil),              // 17: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary
-	(*AvailablePackageDetail)(nil),               // 18: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail
-	(*InstalledPackageSummary)(nil),              // 19: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary
-	(*InstalledPackageDetail)(nil),               // 20: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail
-	(*Context)(nil),                              // 21: kubeappsapis.core.packages.v1alpha1.Context
-	(*AvailablePackageReference)(nil),            // 22: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	(*Maintainer)(nil),                           // 23: kubeappsapis.core.packages.v1alpha1.Maintainer
-	(*FilterOptions)(nil),                        // 24: kubeappsapis.core.packages.v1alpha1.FilterOptions
-	(*PaginationOptions)(nil),                    // 25: kubeappsapis.core.packages.v1alpha1.PaginationOptions
-	(*InstalledPackageReference)(nil),            // 26: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	(*VersionReference)(nil),                     // 27: kubeappsapis.core.packages.v1alpha1.VersionReference
-	(*InstalledPackageStatus)(nil),               // 28: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
-	(*ReconciliationOptions)(nil),                // 29: kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
-	(*PackageAppVersion)(nil),                    // 30: kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	(*anypb.Any)(nil),                            // 31: google.protobuf.Any
-	(*v1alpha1.Plugin)(nil),                      // 32: kubeappsapis.core.plugins.v1alpha1.Plugin
+	(InstalledPackageStatus_StatusReason)(0),           // 0: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.StatusReason
+	(PackageAppVersion_VersionRelation)(0),             // 1: kubeappsapis.core.packages.v1alpha1.PackageAppVersion.VersionRelation
+	(PackageProvenance_VerificationStatus)(0),          // 2: kubeappsapis.core.packages.v1alpha1.PackageProvenance.VerificationStatus
+	(PackageActivity_Operation)(0),                     // 3: kubeappsapis.core.packages.v1alpha1.PackageActivity.Operation
+	(*GetAvailablePackageSummariesRequest)(nil),        // 4: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest
+	(*GetAvailablePackageDetailRequest)(nil),           // 5: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest
+	(*GetAvailablePackageReadmeRequest)(nil),           // 6: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageReadmeRequest
+	(*GetAvailablePackageVersionsRequest)(nil),         // 7: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest
+	(*GetAvailablePackageDependenciesRequest)(nil),     // 8: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDependenciesRequest
+	(*GetAvailablePackageDefaultValuesRequest)(nil),    // 9: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDefaultValuesRequest
+	(*GetAvailablePackageChangelogRequest)(nil),        // 10: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageChangelogRequest
+	(*GetAvailablePackageValuesDiffRequest)(nil),       // 11: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageValuesDiffRequest
+	(*RenderAvailablePackageRequest)(nil),              // 12: kubeappsapis.core.packages.v1alpha1.RenderAvailablePackageRequest
+	(*GetInstalledPackageSummariesRequest)(nil),        // 13: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest
+	(*GetInstalledPackageDetailRequest)(nil),           // 14: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest
+	(*GetInstalledPackageDetailsBatchRequest)(nil),     // 15: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailsBatchRequest
+	(*GetInstalledPackageValuesRequest)(nil),           // 16: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageValuesRequest
+	(*CreateInstalledPackageRequest)(nil),              // 17: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest
+	(*UpdateInstalledPackageRequest)(nil),              // 18: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest
+	(*GetInstalledPackageUpgradeDiffRequest)(nil),      // 19: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpgradeDiffRequest
+	(*GetInstalledPackageDriftRequest)(nil),            // 20: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDriftRequest
+	(*GetInstalledPackageUpdateAvailableRequest)(nil),  // 21: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpdateAvailableRequest
+	(*ExportInstalledPackageRequest)(nil),              // 22: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackageRequest
+	(*DeleteInstalledPackageRequest)(nil),              // 23: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest
+	(*DeleteInstalledPackagesBatchRequest)(nil),        // 24: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackagesBatchRequest
+	(*GetCatalogStatsRequest)(nil),                     // 25: kubeappsapis.core.packages.v1alpha1.GetCatalogStatsRequest
+	(*GetRecentActivityRequest)(nil),                   // 26: kubeappsapis.core.packages.v1alpha1.GetRecentActivityRequest
+	(*GetUserPermissionsRequest)(nil),                  // 27: kubeappsapis.core.packages.v1alpha1.GetUserPermissionsRequest
+	(*GetCatalogIndexRequest)(nil),                     // 28: kubeappsapis.core.packages.v1alpha1.GetCatalogIndexRequest
+	(*GetAvailablePackageSummariesResponse)(nil),       // 29: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse
+	(*CategoryCount)(nil),                              // 30: kubeappsapis.core.packages.v1alpha1.CategoryCount
+	(*GetAvailablePackageDetailResponse)(nil),          // 31: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse
+	(*GetAvailablePackageReadmeResponse)(nil),          // 32: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageReadmeResponse
+	(*GetAvailablePackageVersionsResponse)(nil),        // 33: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse
+	(*GetAvailablePackageDependenciesResponse)(nil),    // 34: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDependenciesResponse
+	(*GetAvailablePackageDefaultValuesResponse)(nil),   // 35: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDefaultValuesResponse
+	(*GetAvailablePackageChangelogResponse)(nil),       // 36: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageChangelogResponse
+	(*GetAvailablePackageValuesDiffResponse)(nil),      // 37: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageValuesDiffResponse
+	(*RenderAvailablePackageResponse)(nil),             // 38: kubeappsapis.core.packages.v1alpha1.RenderAvailablePackageResponse
+	(*GetInstalledPackageSummariesResponse)(nil),       // 39: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse
+	(*GetInstalledPackageDetailResponse)(nil),          // 40: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse
+	(*GetInstalledPackageDetailsBatchResponse)(nil),    // 41: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailsBatchResponse
+	(*GetInstalledPackageValuesResponse)(nil),          // 42: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageValuesResponse
+	(*CreateInstalledPackageResponse)(nil),             // 43: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse
+	(*UpdateInstalledPackageResponse)(nil),             // 44: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse
+	(*GetInstalledPackageUpgradeDiffResponse)(nil),     // 45: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpgradeDiffResponse
+	(*GetInstalledPackageDriftResponse)(nil),           // 46: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDriftResponse
+	(*GetInstalledPackageUpdateAvailableResponse)(nil), // 47: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpdateAvailableResponse
+	(*ExportInstalledPackageResponse)(nil),             // 48: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackageResponse
+	(*DeleteInstalledPackageResponse)(nil),             // 49: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResponse
+	(*DeleteInstalledPackagesBatchResponse)(nil),       // 50: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackagesBatchResponse
+	(*GetCatalogStatsResponse)(nil),                    // 51: kubeappsapis.core.packages.v1alpha1.GetCatalogStatsResponse
+	(*GetRecentActivityResponse)(nil),                  // 52: kubeappsapis.core.packages.v1alpha1.GetRecentActivityResponse
+	(*GetUserPermissionsResponse)(nil),                 // 53: kubeappsapis.core.packages.v1alpha1.GetUserPermissionsResponse
+	(*GetCatalogIndexResponse)(nil),                    // 54: kubeappsapis.core.packages.v1alpha1.GetCatalogIndexResponse
+	(*AvailablePackageSummary)(nil),                    // 55: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary
+	(*AvailablePackageDetail)(nil),                     // 56: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail
+	(*InstalledPackageSummary)(nil),                    // 57: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary
+	(*InstalledPackageDetail)(nil),                     // 58: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail
+	(*Context)(nil),                                    // 59: kubeappsapis.core.packages.v1alpha1.Context
+	(*AvailablePackageReference)(nil),                  // 60: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	(*Maintainer)(nil),                                 // 61: kubeappsapis.core.packages.v1alpha1.Maintainer
+	(*FilterOptions)(nil),                              // 62: kubeappsapis.core.packages.v1alpha1.FilterOptions
+	(*PaginationOptions)(nil),                          // 63: kubeappsapis.core.packages.v1alpha1.PaginationOptions
+	(*InstalledPackageReference)(nil),                  // 64: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	(*VersionReference)(nil),                           // 65: kubeappsapis.core.packages.v1alpha1.VersionReference
+	(*InstalledPackageStatus)(nil),                     // 66: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
+	(*InstalledPackageCondition)(nil),                  // 67: kubeappsapis.core.packages.v1alpha1.InstalledPackageCondition
+	(*WorkloadStatus)(nil),                             // 68: kubeappsapis.core.packages.v1alpha1.WorkloadStatus
+	(*ReconciliationOptions)(nil),                      // 69: kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
+	(*PackageAppVersion)(nil),                          // 70: kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	(*PackageVersionGroup)(nil),                        // 71: kubeappsapis.core.packages.v1alpha1.PackageVersionGroup
+	(*PackageDependency)(nil),                          // 72: kubeappsapis.core.packages.v1alpha1.PackageDependency
+	(*PackageProvenance)(nil),                          // 73: kubeappsapis.core.packages.v1alpha1.PackageProvenance
+	(*PackageRepositoryReference)(nil),                 // 74: kubeappsapis.core.packages.v1alpha1.PackageRepositoryReference
+	(*CatalogStats)(nil),                               // 75: kubeappsapis.core.packages.v1alpha1.CatalogStats
+	(*PluginCatalogStats)(nil),                         // 76: kubeappsapis.core.packages.v1alpha1.PluginCatalogStats
+	(*CategoryCatalogStats)(nil),                       // 77: kubeappsapis.core.packages.v1alpha1.CategoryCatalogStats
+	(*CatalogIndex)(nil),                               // 78: kubeappsapis.core.packages.v1alpha1.CatalogIndex
+	(*CatalogIndexEntry)(nil),                          // 79: kubeappsapis.core.packages.v1alpha1.CatalogIndexEntry
+	(*LocalizedDescription)(nil),                       // 80: kubeappsapis.core.packages.v1alpha1.LocalizedDescription
+	(*DeleteInstalledPackageResult)(nil),               // 81: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResult
+	(*GetInstalledPackageDetailResult)(nil),            // 82: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResult
+	(*ResourceDrift)(nil),                              // 83: kubeappsapis.core.packages.v1alpha1.ResourceDrift
+	(*RenderedResource)(nil),                           // 84: kubeappsapis.core.packages.v1alpha1.RenderedResource
+	(*FieldDrift)(nil),                                 // 85: kubeappsapis.core.packages.v1alpha1.FieldDrift
+	(*PackageActivity)(nil),                            // 86: kubeappsapis.core.packages.v1alpha1.PackageActivity
+	(*PackageChangelogEntry)(nil),                      // 87: kubeappsapis.core.packages.v1alpha1.PackageChangelogEntry
+	nil,                                                // 88: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.LabelsEntry
+	nil,                                                // 89: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.AnnotationsEntry
+	(*v1alpha1.Plugin)(nil),                            // 90: kubeappsapis.core.plugins.v1alpha1.Plugin
+	(*anypb.Any)(nil),                                  // 91: google.protobuf.Any
 }
 var file_kubeappsapis_core_packages_v1alpha1_packages_proto_depIdxs = []int32{
-	21, // 0: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
-	24, // 1: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.filter_options:type_name -> kubeappsapis.core.packages.v1alpha1.FilterOptions
-	25, // 2: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.pagination_options:type_name -> kubeappsapis.core.packages.v1alpha1.PaginationOptions
-	22, // 3: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	22, // 4: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	21, // 5: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
-	25, // 6: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.pagination_options:type_name -> kubeappsapis.core.packages.v1alpha1.PaginationOptions
-	26, // 7: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	22, // 8: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	21, // 9: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.target_context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
-	27, // 10: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
-	29, // 11: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
-	26, // 12: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	27, // 13: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
-	29, // 14: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
-	26, // 15: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	17, // 16: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse.available_package_summaries:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary
-	18, // 17: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse.available_package_detail:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail
-	30, // 18: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse.package_app_versions:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	19, // 19: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse.installed_package_summaries:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary
-	20, // 20: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse.installed_package_detail:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail
-	26, // 21: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	26, // 22: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	22, // 23: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	30, // 24: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	22, // 25: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	30, // 26: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	23, // 27: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.maintainers:type_name -> kubeappsapis.core.packages.v1alpha1.Maintainer
-	31, // 28: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.custom_detail:type_name -> google.protobuf.Any
-	26, // 29: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	27, // 30: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
-	30, // 31: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.current_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	30, // 32: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.latest_matching_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	30, // 33: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	28, // 34: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.status:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
-	26, // 35: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	27, // 36: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
-	30, // 37: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.current_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	29, // 38: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
-	28, // 39: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.status:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
-	22, // 40: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	30, // 41: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.latest_matching_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	30, // 42: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	31, // 43: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.custom_detail:type_name -> google.protobuf.Any
-	21, // 44: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
-	32, // 45: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
-	21, // 46: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
-	32, // 47: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
-	0,  // 48: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.reason:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.StatusReason
-	1,  // 49: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageSummaries:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest
-	2,  // 50: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDetail:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest
-	3,  // 51: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageVersions:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest
-	4,  // 52: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageSummaries:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest
-	5,  // 53: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDetail:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest
-	6,  // 54: kubeappsapis.core.packages.v1alpha1.PackagesService.CreateInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest
-	7,  // 55: kubeappsapis.core.packages.v1alpha1.PackagesService.UpdateInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest
-	8,  // 56: kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest
-	9,  // 57: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageSummaries:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse
-	10, // 58: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDetail:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse
-	11, // 59: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageVersions:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse
-	12, // 60: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageSummaries:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse
-	13, // 61: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDetail:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse
-	14, // 62: kubeappsapis.core.packages.v1alpha1.PackagesService.CreateInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse
-	15, // 63: kubeappsapis.core.packages.v1alpha1.PackagesService.UpdateInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse
-	16, // 64: kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResponse
-	57, // [57:65] is the sub-list for method output_type
-	49, // [49:57] is the sub-list for method input_type
-	49, // [49:49] is the sub-list for extension type_name
-	49, // [49:49] is the sub-list for extension extendee
-	0,  // [0:49] is the sub-list for field type_name
+	59,  // 0: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	62,  // 1: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.filter_options:type_name -> kubeappsapis.core.packages.v1alpha1.FilterOptions
+	63,  // 2: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.pagination_options:type_name -> kubeappsapis.core.packages.v1alpha1.PaginationOptions
+	60,  // 3: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	60,  // 4: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageReadmeRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	60,  // 5: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	60,  // 6: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDependenciesRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	60,  // 7: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDefaultValuesRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	60,  // 8: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageChangelogRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	60,  // 9: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageValuesDiffRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	60,  // 10: kubeappsapis.core.packages.v1alpha1.RenderAvailablePackageRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	59,  // 11: kubeappsapis.core.packages.v1alpha1.RenderAvailablePackageRequest.target_context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	59,  // 12: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	63,  // 13: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.pagination_options:type_name -> kubeappsapis.core.packages.v1alpha1.PaginationOptions
+	64,  // 14: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	64,  // 15: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailsBatchRequest.installed_package_refs:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	64,  // 16: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageValuesRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	60,  // 17: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	59,  // 18: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.target_context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	65,  // 19: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	69,  // 20: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
+	88,  // 21: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.labels:type_name -> kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.LabelsEntry
+	89,  // 22: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.annotations:type_name -> kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.AnnotationsEntry
+	64,  // 23: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	65,  // 24: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	69,  // 25: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
+	64,  // 26: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpgradeDiffRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	65,  // 27: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpgradeDiffRequest.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	64,  // 28: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDriftRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	64,  // 29: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpdateAvailableRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	64,  // 30: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackageRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	64,  // 31: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	64,  // 32: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackagesBatchRequest.installed_package_refs:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	59,  // 33: kubeappsapis.core.packages.v1alpha1.GetCatalogStatsRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	59,  // 34: kubeappsapis.core.packages.v1alpha1.GetRecentActivityRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	63,  // 35: kubeappsapis.core.packages.v1alpha1.GetRecentActivityRequest.pagination_options:type_name -> kubeappsapis.core.packages.v1alpha1.PaginationOptions
+	59,  // 36: kubeappsapis.core.packages.v1alpha1.GetUserPermissionsRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	90,  // 37: kubeappsapis.core.packages.v1alpha1.GetUserPermissionsRequest.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
+	59,  // 38: kubeappsapis.core.packages.v1alpha1.GetCatalogIndexRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	55,  // 39: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse.available_package_summaries:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary
+	30,  // 40: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse.category_counts:type_name -> kubeappsapis.core.packages.v1alpha1.CategoryCount
+	56,  // 41: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse.available_package_detail:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail
+	70,  // 42: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse.package_app_versions:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	71,  // 43: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse.version_groups:type_name -> kubeappsapis.core.packages.v1alpha1.PackageVersionGroup
+	72,  // 44: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDependenciesResponse.package_dependencies:type_name -> kubeappsapis.core.packages.v1alpha1.PackageDependency
+	87,  // 45: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageChangelogResponse.changelog_entries:type_name -> kubeappsapis.core.packages.v1alpha1.PackageChangelogEntry
+	84,  // 46: kubeappsapis.core.packages.v1alpha1.RenderAvailablePackageResponse.apply_order:type_name -> kubeappsapis.core.packages.v1alpha1.RenderedResource
+	57,  // 47: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse.installed_package_summaries:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary
+	58,  // 48: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse.installed_package_detail:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail
+	82,  // 49: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailsBatchResponse.results:type_name -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResult
+	64,  // 50: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	64,  // 51: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	83,  // 52: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDriftResponse.resources:type_name -> kubeappsapis.core.packages.v1alpha1.ResourceDrift
+	70,  // 53: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpdateAvailableResponse.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	81,  // 54: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackagesBatchResponse.results:type_name -> kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResult
+	75,  // 55: kubeappsapis.core.packages.v1alpha1.GetCatalogStatsResponse.catalog_stats:type_name -> kubeappsapis.core.packages.v1alpha1.CatalogStats
+	86,  // 56: kubeappsapis.core.packages.v1alpha1.GetRecentActivityResponse.activities:type_name -> kubeappsapis.core.packages.v1alpha1.PackageActivity
+	60,  // 57: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	70,  // 58: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	74,  // 59: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary.repository:type_name -> kubeappsapis.core.packages.v1alpha1.PackageRepositoryReference
+	80,  // 60: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary.localized_descriptions:type_name -> kubeappsapis.core.packages.v1alpha1.LocalizedDescription
+	60,  // 61: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	70,  // 62: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	61,  // 63: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.maintainers:type_name -> kubeappsapis.core.packages.v1alpha1.Maintainer
+	91,  // 64: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.custom_detail:type_name -> google.protobuf.Any
+	73,  // 65: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.provenance:type_name -> kubeappsapis.core.packages.v1alpha1.PackageProvenance
+	80,  // 66: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.localized_descriptions:type_name -> kubeappsapis.core.packages.v1alpha1.LocalizedDescription
+	64,  // 67: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	65,  // 68: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	70,  // 69: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.current_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	70,  // 70: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.latest_matching_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	70,  // 71: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	66,  // 72: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.status:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
+	90,  // 73: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.conflicting_plugins:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
+	60,  // 74: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	64,  // 75: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	65,  // 76: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	70,  // 77: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.current_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	69,  // 78: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
+	66,  // 79: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.status:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
+	60,  // 80: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	70,  // 81: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.latest_matching_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	70,  // 82: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	91,  // 83: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.custom_detail:type_name -> google.protobuf.Any
+	68,  // 84: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.workload_status:type_name -> kubeappsapis.core.packages.v1alpha1.WorkloadStatus
+	74,  // 85: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.available_package_repository:type_name -> kubeappsapis.core.packages.v1alpha1.PackageRepositoryReference
+	59,  // 86: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	90,  // 87: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
+	74,  // 88: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference.repository:type_name -> kubeappsapis.core.packages.v1alpha1.PackageRepositoryReference
+	59,  // 89: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	90,  // 90: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
+	0,   // 91: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.reason:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.StatusReason
+	67,  // 92: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.conditions:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageCondition
+	1,   // 93: kubeappsapis.core.packages.v1alpha1.PackageAppVersion.relation:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion.VersionRelation
+	70,  // 94: kubeappsapis.core.packages.v1alpha1.PackageVersionGroup.latest:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	72,  // 95: kubeappsapis.core.packages.v1alpha1.PackageDependency.dependencies:type_name -> kubeappsapis.core.packages.v1alpha1.PackageDependency
+	2,   // 96: kubeappsapis.core.packages.v1alpha1.PackageProvenance.verification_status:type_name -> kubeappsapis.core.packages.v1alpha1.PackageProvenance.VerificationStatus
+	76,  // 97: kubeappsapis.core.packages.v1alpha1.CatalogStats.plugin_stats:type_name -> kubeappsapis.core.packages.v1alpha1.PluginCatalogStats
+	77,  // 98: kubeappsapis.core.packages.v1alpha1.CatalogStats.category_stats:type_name -> kubeappsapis.core.packages.v1alpha1.CategoryCatalogStats
+	90,  // 99: kubeappsapis.core.packages.v1alpha1.PluginCatalogStats.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
+	79,  // 100: kubeappsapis.core.packages.v1alpha1.CatalogIndex.entries:type_name -> kubeappsapis.core.packages.v1alpha1.CatalogIndexEntry
+	64,  // 101: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResult.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	64,  // 102: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResult.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	58,  // 103: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResult.installed_package_detail:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail
+	85,  // 104: kubeappsapis.core.packages.v1alpha1.ResourceDrift.fields:type_name -> kubeappsapis.core.packages.v1alpha1.FieldDrift
+	64,  // 105: kubeappsapis.core.packages.v1alpha1.PackageActivity.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	3,   // 106: kubeappsapis.core.packages.v1alpha1.PackageActivity.operation:type_name -> kubeappsapis.core.packages.v1alpha1.PackageActivity.Operation
+	70,  // 107: kubeappsapis.core.packages.v1alpha1.PackageActivity.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	4,   // 108: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageSummaries:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest
+	5,   // 109: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDetail:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest
+	7,   // 110: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageVersions:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest
+	8,   // 111: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDependencies:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDependenciesRequest
+	9,   // 112: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDefaultValues:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDefaultValuesRequest
+	10,  // 113: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageChangelog:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageChangelogRequest
+	11,  // 114: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageValuesDiff:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageValuesDiffRequest
+	12,  // 115: kubeappsapis.core.packages.v1alpha1.PackagesService.RenderAvailablePackage:input_type -> kubeappsapis.core.packages.v1alpha1.RenderAvailablePackageRequest
+	13,  // 116: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageSummaries:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest
+	14,  // 117: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDetail:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest
+	15,  // 118: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDetailsBatch:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailsBatchRequest
+	16,  // 119: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageValues:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageValuesRequest
+	17,  // 120: kubeappsapis.core.packages.v1alpha1.PackagesService.CreateInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest
+	18,  // 121: kubeappsapis.core.packages.v1alpha1.PackagesService.UpdateInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest
+	19,  // 122: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageUpgradeDiff:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpgradeDiffRequest
+	20,  // 123: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDrift:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDriftRequest
+	21,  // 124: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageUpdateAvailable:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpdateAvailableRequest
+	22,  // 125: kubeappsapis.core.packages.v1alpha1.PackagesService.ExportInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.ExportInstalledPackageRequest
+	23,  // 126: kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest
+	24,  // 127: kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackagesBatch:input_type -> kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackagesBatchRequest
+	25,  // 128: kubeappsapis.core.packages.v1alpha1.PackagesService.GetCatalogStats:input_type -> kubeappsapis.core.packages.v1alpha1.GetCatalogStatsRequest
+	26,  // 129: kubeappsapis.core.packages.v1alpha1.PackagesService.GetRecentActivity:input_type -> kubeappsapis.core.packages.v1alpha1.GetRecentActivityRequest
+	27,  // 130: kubeappsapis.core.packages.v1alpha1.PackagesService.GetUserPermissions:input_type -> kubeappsapis.core.packages.v1alpha1.GetUserPermissionsRequest
+	28,  // 131: kubeappsapis.core.packages.v1alpha1.PackagesService.GetCatalogIndex:input_type -> kubeappsapis.core.packages.v1alpha1.GetCatalogIndexRequest
+	6,   // 132: kubeappsapis.core.packages.v1alpha1.PackagesReadmeService.GetAvailablePackageReadme:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageReadmeRequest
+	29,  // 133: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageSummaries:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse
+	31,  // 134: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDetail:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse
+	33,  // 135: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageVersions:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse
+	34,  // 136: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDependencies:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDependenciesResponse
+	35,  // 137: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDefaultValues:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDefaultValuesResponse
+	36,  // 138: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageChangelog:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageChangelogResponse
+	37,  // 139: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageValuesDiff:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageValuesDiffResponse
+	38,  // 140: kubeappsapis.core.packages.v1alpha1.PackagesService.RenderAvailablePackage:output_type -> kubeappsapis.core.packages.v1alpha1.RenderAvailablePackageResponse
+	39,  // 141: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageSummaries:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse
+	40,  // 142: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDetail:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse
+	41,  // 143: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDetailsBatch:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailsBatchResponse
+	42,  // 144: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageValues:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageValuesResponse
+	43,  // 145: kubeappsapis.core.packages.v1alpha1.PackagesService.CreateInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse
+	44,  // 146: kubeappsapis.core.packages.v1alpha1.PackagesService.UpdateInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse
+	45,  // 147: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageUpgradeDiff:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpgradeDiffResponse
+	46,  // 148: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDrift:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDriftResponse
+	47,  // 149: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageUpdateAvailable:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageUpdateAvailableResponse
+	48,  // 150: kubeappsapis.core.packages.v1alpha1.PackagesService.ExportInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.ExportInstalledPackageResponse
+	49,  // 151: kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResponse
+	50,  // 152: kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackagesBatch:output_type -> kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackagesBatchResponse
+	51,  // 153: kubeappsapis.core.packages.v1alpha1.PackagesService.GetCatalogStats:output_type -> kubeappsapis.core.packages.v1alpha1.GetCatalogStatsResponse
+	52,  // 154: kubeappsapis.core.packages.v1alpha1.PackagesService.GetRecentActivity:output_type -> kubeappsapis.core.packages.v1alpha1.GetRecentActivityResponse
+	53,  // 155: kubeappsapis.core.packages.v1alpha1.PackagesService.GetUserPermissions:output_type -> kubeappsapis.core.packages.v1alpha1.GetUserPermissionsResponse
+	54,  // 156: kubeappsapis.core.packages.v1alpha1.PackagesService.GetCatalogIndex:output_type -> kubeappsapis.core.packages.v1alpha1.GetCatalogIndexResponse
+	32,  // 157: kubeappsapis.core.packages.v1alpha1.PackagesReadmeService.GetAvailablePackageReadme:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageReadmeResponse
+	133, // [133:158] is the sub-list for method output_type
+	108, // [108:133] is the sub-list for method input_type
+	108, // [108:108] is the sub-list for extension type_name
+	108, // [108:108] is the sub-list for extension extendee
+	0,   // [0:108] is the sub-list for field type_name
 }
 
 func init() { file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() }
@@ -3348,8 +9131,416 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAvailablePackageDetailRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageDetailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageReadmeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageDependenciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageDefaultValuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageChangelogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageValuesDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderAvailablePackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageSummariesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageDetailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageDetailsBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageValuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInstalledPackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInstalledPackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageUpgradeDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageDriftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageUpdateAvailableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportInstalledPackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInstalledPackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInstalledPackagesBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCatalogStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentActivityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserPermissionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCatalogIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageSummariesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CategoryCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageDetailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageReadmeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageVersionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageDependenciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageDefaultValuesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageChangelogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageValuesDiffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderAvailablePackageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageSummariesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3360,8 +9551,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAvailablePackageVersionsRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageDetailResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3372,8 +9563,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInstalledPackageSummariesRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageDetailsBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3384,8 +9575,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInstalledPackageDetailRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3396,8 +9587,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInstalledPackageRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInstalledPackageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3408,8 +9599,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateInstalledPackageRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInstalledPackageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3420,8 +9611,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInstalledPackageRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageUpgradeDiffResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3432,8 +9623,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAvailablePackageSummariesResponse); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageDriftResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3444,8 +9635,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAvailablePackageDetailResponse); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageUpdateAvailableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3456,8 +9647,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAvailablePackageVersionsResponse); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportInstalledPackageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3468,8 +9659,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInstalledPackageSummariesResponse); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInstalledPackageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3480,8 +9671,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInstalledPackageDetailResponse); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInstalledPackagesBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3492,8 +9683,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInstalledPackageResponse); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCatalogStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3504,8 +9695,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateInstalledPackageResponse); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentActivityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3516,8 +9707,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInstalledPackageResponse); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserPermissionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3528,7 +9719,19 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCatalogIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AvailablePackageSummary); i {
 			case 0:
 				return &v.state
@@ -3540,7 +9743,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AvailablePackageDetail); i {
 			case 0:
 				return &v.state
@@ -3552,7 +9755,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstalledPackageSummary); i {
 			case 0:
 				return &v.state
@@ -3564,7 +9767,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstalledPackageDetail); i {
 			case 0:
 				return &v.state
@@ -3576,7 +9779,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Context); i {
 			case 0:
 				return &v.state
@@ -3588,7 +9791,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AvailablePackageReference); i {
 			case 0:
 				return &v.state
@@ -3600,7 +9803,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Maintainer); i {
 			case 0:
 				return &v.state
@@ -3612,7 +9815,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FilterOptions); i {
 			case 0:
 				return &v.state
@@ -3624,7 +9827,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaginationOptions); i {
 			case 0:
 				return &v.state
@@ -3636,7 +9839,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstalledPackageReference); i {
 			case 0:
 				return &v.state
@@ -3648,7 +9851,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VersionReference); i {
 			case 0:
 				return &v.state
@@ -3660,7 +9863,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstalledPackageStatus); i {
 			case 0:
 				return &v.state
@@ -3672,7 +9875,31 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstalledPackageCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkloadStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReconciliationOptions); i {
 			case 0:
 				return &v.state
@@ -3684,7 +9911,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PackageAppVersion); i {
 			case 0:
 				return &v.state
@@ -3696,16 +9923,220 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageVersionGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageDependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageProvenance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageRepositoryReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginCatalogStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CategoryCatalogStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogIndexEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalizedDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInstalledPackageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageDetailResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderedResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageChangelogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   30,
+			NumEnums:      4,
+			NumMessages:   86,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_kubeappsapis_core_packages_v1alpha1_packages_proto_goTypes,
 		DependencyIndexes: file_kubeappsapis_core_packages_v1alpha1_packages_proto_depIdxs,
@@ -21,11 +21,27 @@ type PackagesServiceClient interface {
 	GetAvailablePackageSummaries(ctx context.Context, in *GetAvailablePackageSummariesRequest, opts ...grpc.CallOption) (*GetAvailablePackageSummariesResponse, error)
 	GetAvailablePackageDetail(ctx context.Context, in *GetAvailablePackageDetailRequest, opts ...grpc.CallOption) (*GetAvailablePackageDetailResponse, error)
 	GetAvailablePackageVersions(ctx context.Context, in *GetAvailablePackageVersionsRequest, opts ...grpc.CallOption) (*GetAvailablePackageVersionsResponse, error)
+	GetAvailablePackageDependencies(ctx context.Context, in *GetAvailablePackageDependenciesRequest, opts ...grpc.CallOption) (*GetAvailablePackageDependenciesResponse, error)
+	GetAvailablePackageDefaultValues(ctx context.Context, in *GetAvailablePackageDefaultValuesRequest, opts ...grpc.CallOption) (*GetAvailablePackageDefaultValuesResponse, error)
+	GetAvailablePackageChangelog(ctx context.Context, in *GetAvailablePackageChangelogRequest, opts ...grpc.CallOption) (*GetAvailablePackageChangelogResponse, error)
+	GetAvailablePackageValuesDiff(ctx context.Context, in *GetAvailablePackageValuesDiffRequest, opts ...grpc.CallOption) (*GetAvailablePackageValuesDiffResponse, error)
+	RenderAvailablePackage(ctx context.Context, in *RenderAvailablePackageRequest, opts ...grpc.CallOption) (*RenderAvailablePackageResponse, error)
 	GetInstalledPackageSummaries(ctx context.Context, in *GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*GetInstalledPackageSummariesResponse, error)
 	GetInstalledPackageDetail(ctx context.Context, in *GetInstalledPackageDetailRequest, opts ...grpc.CallOption) (*GetInstalledPackageDetailResponse, error)
+	GetInstalledPackageDetailsBatch(ctx context.Context, in *GetInstalledPackageDetailsBatchRequest, opts ...grpc.CallOption) (*GetInstalledPackageDetailsBatchResponse, error)
+	GetInstalledPackageValues(ctx context.Context, in *GetInstalledPackageValuesRequest, opts ...grpc.CallOption) (*GetInstalledPackageValuesResponse, error)
 	CreateInstalledPackage(ctx context.Context, in *CreateInstalledPackageRequest, opts ...grpc.CallOption) (*CreateInstalledPackageResponse, error)
 	UpdateInstalledPackage(ctx context.Context, in *UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*UpdateInstalledPackageResponse, error)
+	GetInstalledPackageUpgradeDiff(ctx context.Context, in *GetInstalledPackageUpgradeDiffRequest, opts ...grpc.CallOption) (*GetInstalledPackageUpgradeDiffResponse, error)
+	GetInstalledPackageDrift(ctx context.Context, in *GetInstalledPackageDriftRequest, opts ...grpc.CallOption) (*GetInstalledPackageDriftResponse, error)
+	GetInstalledPackageUpdateAvailable(ctx context.Context, in *GetInstalledPackageUpdateAvailableRequest, opts ...grpc.CallOption) (*GetInstalledPackageUpdateAvailableResponse, error)
+	ExportInstalledPackage(ctx context.Context, in *ExportInstalledPackageRequest, opts ...grpc.CallOption) (*ExportInstalledPackageResponse, error)
 	DeleteInstalledPackage(ctx context.Context, in *DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*DeleteInstalledPackageResponse, error)
+	DeleteInstalledPackagesBatch(ctx context.Context, in *DeleteInstalledPackagesBatchRequest, opts ...grpc.CallOption) (*DeleteInstalledPackagesBatchResponse, error)
+	GetCatalogStats(ctx context.Context, in *GetCatalogStatsRequest, opts ...grpc.CallOption) (*GetCatalogStatsResponse, error)
+	GetRecentActivity(ctx context.Context, in *GetRecentActivityRequest, opts ...grpc.CallOption) (*GetRecentActivityResponse, error)
+	GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*GetUserPermissionsResponse, error)
+	GetCatalogIndex(ctx context.Context, in *GetCatalogIndexRequest, opts ...grpc.CallOption) (*GetCatalogIndexResponse, error)
 }
 
 type packagesServiceClient struct {
@@ -63,6 +79,51 @@ func (c *packagesServiceClient) GetAvailablePackageVersions(ctx context.Context,
 	return out, nil
 }
 
+func (c *packagesServiceClient) GetAvailablePackageDependencies(ctx context.Context, in *GetAvailablePackageDependenciesRequest, opts ...grpc.CallOption) (*GetAvailablePackageDependenciesResponse, error) {
+	out := new(GetAvailablePackageDependenciesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageDependencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetAvailablePackageDefaultValues(ctx context.Context, in *GetAvailablePackageDefaultValuesRequest, opts ...grpc.CallOption) (*GetAvailablePackageDefaultValuesResponse, error) {
+	out := new(GetAvailablePackageDefaultValuesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageDefaultValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetAvailablePackageChangelog(ctx context.Context, in *GetAvailablePackageChangelogRequest, opts ...grpc.CallOption) (*GetAvailablePackageChangelogResponse, error) {
+	out := new(GetAvailablePackageChangelogResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageChangelog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetAvailablePackageValuesDiff(ctx context.Context, in *GetAvailablePackageValuesDiffRequest, opts ...grpc.CallOption) (*GetAvailablePackageValuesDiffResponse, error) {
+	out := new(GetAvailablePackageValuesDiffResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageValuesDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) RenderAvailablePackage(ctx context.Context, in *RenderAvailablePackageRequest, opts ...grpc.CallOption) (*RenderAvailablePackageResponse, error) {
+	out := new(RenderAvailablePackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/RenderAvailablePackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *packagesServiceClient) GetInstalledPackageSummaries(ctx context.Context, in *GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*GetInstalledPackageSummariesResponse, error) {
 	out := new(GetInstalledPackageSummariesResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageSummaries", in, out, opts...)
@@ -81,6 +142,24 @@ func (c *packagesServiceClient) GetInstalledPackageDetail(ctx context.Context, i
 	return out, nil
 }
 
+func (c *packagesServiceClient) GetInstalledPackageDetailsBatch(ctx context.Context, in *GetInstalledPackageDetailsBatchRequest, opts ...grpc.CallOption) (*GetInstalledPackageDetailsBatchResponse, error) {
+	out := new(GetInstalledPackageDetailsBatchResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetailsBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetInstalledPackageValues(ctx context.Context, in *GetInstalledPackageValuesRequest, opts ...grpc.CallOption) (*GetInstalledPackageValuesResponse, error) {
+	out := new(GetInstalledPackageValuesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *packagesServiceClient) CreateInstalledPackage(ctx context.Context, in *CreateInstalledPackageRequest, opts ...grpc.CallOption) (*CreateInstalledPackageResponse, error) {
 	out := new(CreateInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage", in, out, opts...)
@@ -99,6 +178,42 @@ func (c *packagesServiceClient) UpdateInstalledPackage(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *packagesServiceClient) GetInstalledPackageUpgradeDiff(ctx context.Context, in *GetInstalledPackageUpgradeDiffRequest, opts ...grpc.CallOption) (*GetInstalledPackageUpgradeDiffResponse, error) {
+	out := new(GetInstalledPackageUpgradeDiffResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageUpgradeDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetInstalledPackageDrift(ctx context.Context, in *GetInstalledPackageDriftRequest, opts ...grpc.CallOption) (*GetInstalledPackageDriftResponse, error) {
+	out := new(GetInstalledPackageDriftResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetInstalledPackageUpdateAvailable(ctx context.Context, in *GetInstalledPackageUpdateAvailableRequest, opts ...grpc.CallOption) (*GetInstalledPackageUpdateAvailableResponse, error) {
+	out := new(GetInstalledPackageUpdateAvailableResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageUpdateAvailable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) ExportInstalledPackage(ctx context.Context, in *ExportInstalledPackageRequest, opts ...grpc.CallOption) (*ExportInstalledPackageResponse, error) {
+	out := new(ExportInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/ExportInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *packagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*DeleteInstalledPackageResponse, error) {
 	out := new(DeleteInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage", in, out, opts...)
@@ -108,6 +223,51 @@ func (c *packagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *packagesServiceClient) DeleteInstalledPackagesBatch(ctx context.Context, in *DeleteInstalledPackagesBatchRequest, opts ...grpc.CallOption) (*DeleteInstalledPackagesBatchResponse, error) {
+	out := new(DeleteInstalledPackagesBatchResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackagesBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetCatalogStats(ctx context.Context, in *GetCatalogStatsRequest, opts ...grpc.CallOption) (*GetCatalogStatsResponse, error) {
+	out := new(GetCatalogStatsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetCatalogStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetRecentActivity(ctx context.Context, in *GetRecentActivityRequest, opts ...grpc.CallOption) (*GetRecentActivityResponse, error) {
+	out := new(GetRecentActivityResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetRecentActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*GetUserPermissionsResponse, error) {
+	out := new(GetUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetUserPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetCatalogIndex(ctx context.Context, in *GetCatalogIndexRequest, opts ...grpc.CallOption) (*GetCatalogIndexResponse, error) {
+	out := new(GetCatalogIndexResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetCatalogIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PackagesServiceServer is the server API for PackagesService service.
 // All implementations should embed UnimplementedPackagesServiceServer
 // for forward compatibility
@@ -115,11 +275,27 @@ type PackagesServiceServer interface {
 	GetAvailablePackageSummaries(context.Context, *GetAvailablePackageSummariesRequest) (*GetAvailablePackageSummariesResponse, error)
 	GetAvailablePackageDetail(context.Context, *GetAvailablePackageDetailRequest) (*GetAvailablePackageDetailResponse, error)
 	GetAvailablePackageVersions(context.Context, *GetAvailablePackageVersionsRequest) (*GetAvailablePackageVersionsResponse, error)
+	GetAvailablePackageDependencies(context.Context, *GetAvailablePackageDependenciesRequest) (*GetAvailablePackageDependenciesResponse, error)
+	GetAvailablePackageDefaultValues(context.Context, *GetAvailablePackageDefaultValuesRequest) (*GetAvailablePackageDefaultValuesResponse, error)
+	GetAvailablePackageChangelog(context.Context, *GetAvailablePackageChangelogRequest) (*GetAvailablePackageChangelogResponse, error)
+	GetAvailablePackageValuesDiff(context.Context, *GetAvailablePackageValuesDiffRequest) (*GetAvailablePackageValuesDiffResponse, error)
+	RenderAvailablePackage(context.Context, *RenderAvailablePackageRequest) (*RenderAvailablePackageResponse, error)
 	GetInstalledPackageSummaries(context.Context, *GetInstalledPackageSummariesRequest) (*GetInstalledPackageSummariesResponse, error)
 	GetInstalledPackageDetail(context.Context, *GetInstalledPackageDetailRequest) (*GetInstalledPackageDetailResponse, error)
+	GetInstalledPackageDetailsBatch(context.Context, *GetInstalledPackageDetailsBatchRequest) (*GetInstalledPackageDetailsBatchResponse, error)
+	GetInstalledPackageValues(context.Context, *GetInstalledPackageValuesRequest) (*GetInstalledPackageValuesResponse, error)
 	CreateInstalledPackage(context.Context, *CreateInstalledPackageRequest) (*CreateInstalledPackageResponse, error)
 	UpdateInstalledPackage(context.Context, *UpdateInstalledPackageRequest) (*UpdateInstalledPackageResponse, error)
+	GetInstalledPackageUpgradeDiff(context.Context, *GetInstalledPackageUpgradeDiffRequest) (*GetInstalledPackageUpgradeDiffResponse, error)
+	GetInstalledPackageDrift(context.Context, *GetInstalledPackageDriftRequest) (*GetInstalledPackageDriftResponse, error)
+	GetInstalledPackageUpdateAvailable(context.Context, *GetInstalledPackageUpdateAvailableRequest) (*GetInstalledPackageUpdateAvailableResponse, error)
+	ExportInstalledPackage(context.Context, *ExportInstalledPackageRequest) (*ExportInstalledPackageResponse, error)
 	DeleteInstalledPackage(context.Context, *DeleteInstalledPackageRequest) (*DeleteInstalledPackageResponse, error)
+	DeleteInstalledPackagesBatch(context.Context, *DeleteInstalledPackagesBatchRequest) (*DeleteInstalledPackagesBatchResponse, error)
+	GetCatalogStats(context.Context, *GetCatalogStatsRequest) (*GetCatalogStatsResponse, error)
+	GetRecentActivity(context.Context, *GetRecentActivityRequest) (*GetRecentActivityResponse, error)
+	GetUserPermissions(context.Context, *GetUserPermissionsRequest) (*GetUserPermissionsResponse, error)
+	GetCatalogIndex(context.Context, *GetCatalogIndexRequest) (*GetCatalogIndexResponse, error)
 }
 
 // UnimplementedPackagesServiceServer should be embedded to have forward compatible implementations.
@@ -135,21 +311,69 @@ func (UnimplementedPackagesServiceServer) GetAvailablePackageDetail(context.Cont
 func (UnimplementedPackagesServiceServer) GetAvailablePackageVersions(context.Context, *GetAvailablePackageVersionsRequest) (*GetAvailablePackageVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageVersions not implemented")
 }
+func (UnimplementedPackagesServiceServer) GetAvailablePackageDependencies(context.Context, *GetAvailablePackageDependenciesRequest) (*GetAvailablePackageDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageDependencies not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetAvailablePackageDefaultValues(context.Context, *GetAvailablePackageDefaultValuesRequest) (*GetAvailablePackageDefaultValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageDefaultValues not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetAvailablePackageChangelog(context.Context, *GetAvailablePackageChangelogRequest) (*GetAvailablePackageChangelogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageChangelog not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetAvailablePackageValuesDiff(context.Context, *GetAvailablePackageValuesDiffRequest) (*GetAvailablePackageValuesDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageValuesDiff not implemented")
+}
+func (UnimplementedPackagesServiceServer) RenderAvailablePackage(context.Context, *RenderAvailablePackageRequest) (*RenderAvailablePackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderAvailablePackage not implemented")
+}
 func (UnimplementedPackagesServiceServer) GetInstalledPackageSummaries(context.Context, *GetInstalledPackageSummariesRequest) (*GetInstalledPackageSummariesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageSummaries not implemented")
 }
 func (UnimplementedPackagesServiceServer) GetInstalledPackageDetail(context.Context, *GetInstalledPackageDetailRequest) (*GetInstalledPackageDetailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDetail not implemented")
 }
+func (UnimplementedPackagesServiceServer) GetInstalledPackageDetailsBatch(context.Context, *GetInstalledPackageDetailsBatchRequest) (*GetInstalledPackageDetailsBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDetailsBatch not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetInstalledPackageValues(context.Context, *GetInstalledPackageValuesRequest) (*GetInstalledPackageValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageValues not implemented")
+}
 func (UnimplementedPackagesServiceServer) CreateInstalledPackage(context.Context, *CreateInstalledPackageRequest) (*CreateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInstalledPackage not implemented")
 }
 func (UnimplementedPackagesServiceServer) UpdateInstalledPackage(context.Context, *UpdateInstalledPackageRequest) (*UpdateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
 }
+func (UnimplementedPackagesServiceServer) GetInstalledPackageUpgradeDiff(context.Context, *GetInstalledPackageUpgradeDiffRequest) (*GetInstalledPackageUpgradeDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageUpgradeDiff not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetInstalledPackageDrift(context.Context, *GetInstalledPackageDriftRequest) (*GetInstalledPackageDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDrift not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetInstalledPackageUpdateAvailable(context.Context, *GetInstalledPackageUpdateAvailableRequest) (*GetInstalledPackageUpdateAvailableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageUpdateAvailable not implemented")
+}
+func (UnimplementedPackagesServiceServer) ExportInstalledPackage(context.Context, *ExportInstalledPackageRequest) (*ExportInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportInstalledPackage not implemented")
+}
 func (UnimplementedPackagesServiceServer) DeleteInstalledPackage(context.Context, *DeleteInstalledPackageRequest) (*DeleteInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
 }
+func (UnimplementedPackagesServiceServer) DeleteInstalledPackagesBatch(context.Context, *DeleteInstalledPackagesBatchRequest) (*DeleteInstalledPackagesBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackagesBatch not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetCatalogStats(context.Context, *GetCatalogStatsRequest) (*GetCatalogStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogStats not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetRecentActivity(context.Context, *GetRecentActivityRequest) (*GetRecentActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentActivity not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetUserPermissions(context.Context, *GetUserPermissionsRequest) (*GetUserPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPermissions not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetCatalogIndex(context.Context, *GetCatalogIndexRequest) (*GetCatalogIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogIndex not implemented")
+}
 
 // UnsafePackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PackagesServiceServer will
@@ -216,6 +440,96 @@ func _PackagesService_GetAvailablePackageVersions_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackagesService_GetAvailablePackageDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailablePackageDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetAvailablePackageDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageDependencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetAvailablePackageDependencies(ctx, req.(*GetAvailablePackageDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetAvailablePackageDefaultValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailablePackageDefaultValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetAvailablePackageDefaultValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageDefaultValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetAvailablePackageDefaultValues(ctx, req.(*GetAvailablePackageDefaultValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetAvailablePackageChangelog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailablePackageChangelogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetAvailablePackageChangelog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageChangelog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetAvailablePackageChangelog(ctx, req.(*GetAvailablePackageChangelogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetAvailablePackageValuesDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailablePackageValuesDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetAvailablePackageValuesDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageValuesDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetAvailablePackageValuesDiff(ctx, req.(*GetAvailablePackageValuesDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_RenderAvailablePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderAvailablePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).RenderAvailablePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/RenderAvailablePackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).RenderAvailablePackage(ctx, req.(*RenderAvailablePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PackagesService_GetInstalledPackageSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInstalledPackageSummariesRequest)
 	if err := dec(in); err != nil {
@@ -252,6 +566,42 @@ func _PackagesService_GetInstalledPackageDetail_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackagesService_GetInstalledPackageDetailsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageDetailsBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetInstalledPackageDetailsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetailsBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetInstalledPackageDetailsBatch(ctx, req.(*GetInstalledPackageDetailsBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetInstalledPackageValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetInstalledPackageValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetInstalledPackageValues(ctx, req.(*GetInstalledPackageValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PackagesService_CreateInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -288,6 +638,78 @@ func _PackagesService_UpdateInstalledPackage_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackagesService_GetInstalledPackageUpgradeDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageUpgradeDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetInstalledPackageUpgradeDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageUpgradeDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetInstalledPackageUpgradeDiff(ctx, req.(*GetInstalledPackageUpgradeDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetInstalledPackageDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetInstalledPackageDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetInstalledPackageDrift(ctx, req.(*GetInstalledPackageDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetInstalledPackageUpdateAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageUpdateAvailableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetInstalledPackageUpdateAvailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageUpdateAvailable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetInstalledPackageUpdateAvailable(ctx, req.(*GetInstalledPackageUpdateAvailableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_ExportInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).ExportInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/ExportInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).ExportInstalledPackage(ctx, req.(*ExportInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -306,6 +728,96 @@ func _PackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackagesService_DeleteInstalledPackagesBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInstalledPackagesBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).DeleteInstalledPackagesBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackagesBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).DeleteInstalledPackagesBatch(ctx, req.(*DeleteInstalledPackagesBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetCatalogStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetCatalogStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetCatalogStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetCatalogStats(ctx, req.(*GetCatalogStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetRecentActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetRecentActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetRecentActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetRecentActivity(ctx, req.(*GetRecentActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetUserPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetUserPermissions(ctx, req.(*GetUserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetCatalogIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetCatalogIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetCatalogIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetCatalogIndex(ctx, req.(*GetCatalogIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PackagesService_ServiceDesc is the grpc.ServiceDesc for PackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -325,6 +837,26 @@ var PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailablePackageVersions",
 			Handler:    _PackagesService_GetAvailablePackageVersions_Handler,
 		},
+		{
+			MethodName: "GetAvailablePackageDependencies",
+			Handler:    _PackagesService_GetAvailablePackageDependencies_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageDefaultValues",
+			Handler:    _PackagesService_GetAvailablePackageDefaultValues_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageChangelog",
+			Handler:    _PackagesService_GetAvailablePackageChangelog_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageValuesDiff",
+			Handler:    _PackagesService_GetAvailablePackageValuesDiff_Handler,
+		},
+		{
+			MethodName: "RenderAvailablePackage",
+			Handler:    _PackagesService_RenderAvailablePackage_Handler,
+		},
 		{
 			MethodName: "GetInstalledPackageSummaries",
 			Handler:    _PackagesService_GetInstalledPackageSummaries_Handler,
@@ -333,6 +865,14 @@ var PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstalledPackageDetail",
 			Handler:    _PackagesService_GetInstalledPackageDetail_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageDetailsBatch",
+			Handler:    _PackagesService_GetInstalledPackageDetailsBatch_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageValues",
+			Handler:    _PackagesService_GetInstalledPackageValues_Handler,
+		},
 		{
 			MethodName: "CreateInstalledPackage",
 			Handler:    _PackagesService_CreateInstalledPackage_Handler,
@@ -341,11 +881,158 @@ var PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstalledPackage",
 			Handler:    _PackagesService_UpdateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageUpgradeDiff",
+			Handler:    _PackagesService_GetInstalledPackageUpgradeDiff_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageDrift",
+			Handler:    _PackagesService_GetInstalledPackageDrift_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageUpdateAvailable",
+			Handler:    _PackagesService_GetInstalledPackageUpdateAvailable_Handler,
+		},
+		{
+			MethodName: "ExportInstalledPackage",
+			Handler:    _PackagesService_ExportInstalledPackage_Handler,
+		},
 		{
 			MethodName: "DeleteInstalledPackage",
 			Handler:    _PackagesService_DeleteInstalledPackage_Handler,
 		},
+		{
+			MethodName: "DeleteInstalledPackagesBatch",
+			Handler:    _PackagesService_DeleteInstalledPackagesBatch_Handler,
+		},
+		{
+			MethodName: "GetCatalogStats",
+			Handler:    _PackagesService_GetCatalogStats_Handler,
+		},
+		{
+			MethodName: "GetRecentActivity",
+			Handler:    _PackagesService_GetRecentActivity_Handler,
+		},
+		{
+			MethodName: "GetUserPermissions",
+			Handler:    _PackagesService_GetUserPermissions_Handler,
+		},
+		{
+			MethodName: "GetCatalogIndex",
+			Handler:    _PackagesService_GetCatalogIndex_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/core/packages/v1alpha1/packages.proto",
 }
+
+// PackagesReadmeServiceClient is the client API for PackagesReadmeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PackagesReadmeServiceClient interface {
+	GetAvailablePackageReadme(ctx context.Context, in *GetAvailablePackageReadmeRequest, opts ...grpc.CallOption) (PackagesReadmeService_GetAvailablePackageReadmeClient, error)
+}
+
+type packagesReadmeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPackagesReadmeServiceClient(cc grpc.ClientConnInterface) PackagesReadmeServiceClient {
+	return &packagesReadmeServiceClient{cc}
+}
+
+func (c *packagesReadmeServiceClient) GetAvailablePackageReadme(ctx context.Context, in *GetAvailablePackageReadmeRequest, opts ...grpc.CallOption) (PackagesReadmeService_GetAvailablePackageReadmeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PackagesReadmeService_ServiceDesc.Streams[0], "/kubeappsapis.core.packages.v1alpha1.PackagesReadmeService/GetAvailablePackageReadme", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &packagesReadmeServiceGetAvailablePackageReadmeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PackagesReadmeService_GetAvailablePackageReadmeClient interface {
+	Recv() (*GetAvailablePackageReadmeResponse, error)
+	grpc.ClientStream
+}
+
+type packagesReadmeServiceGetAvailablePackageReadmeClient struct {
+	grpc.ClientStream
+}
+
+func (x *packagesReadmeServiceGetAvailablePackageReadmeClient) Recv() (*GetAvailablePackageReadmeResponse, error) {
+	m := new(GetAvailablePackageReadmeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PackagesReadmeServiceServer is the server API for PackagesReadmeService service.
+// All implementations should embed UnimplementedPackagesReadmeServiceServer
+// for forward compatibility
+type PackagesReadmeServiceServer interface {
+	GetAvailablePackageReadme(*GetAvailablePackageReadmeRequest, PackagesReadmeService_GetAvailablePackageReadmeServer) error
+}
+
+// UnimplementedPackagesReadmeServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedPackagesReadmeServiceServer struct {
+}
+
+func (UnimplementedPackagesReadmeServiceServer) GetAvailablePackageReadme(*GetAvailablePackageReadmeRequest, PackagesReadmeService_GetAvailablePackageReadmeServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAvailablePackageReadme not implemented")
+}
+
+// UnsafePackagesReadmeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PackagesReadmeServiceServer will
+// result in compilation errors.
+type UnsafePackagesReadmeServiceServer interface {
+	mustEmbedUnimplementedPackagesReadmeServiceServer()
+}
+
+func RegisterPackagesReadmeServiceServer(s grpc.ServiceRegistrar, srv PackagesReadmeServiceServer) {
+	s.RegisterService(&PackagesReadmeService_ServiceDesc, srv)
+}
+
+func _PackagesReadmeService_GetAvailablePackageReadme_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAvailablePackageReadmeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PackagesReadmeServiceServer).GetAvailablePackageReadme(m, &packagesReadmeServiceGetAvailablePackageReadmeServer{stream})
+}
+
+type PackagesReadmeService_GetAvailablePackageReadmeServer interface {
+	Send(*GetAvailablePackageReadmeResponse) error
+	grpc.ServerStream
+}
+
+type packagesReadmeServiceGetAvailablePackageReadmeServer struct {
+	grpc.ServerStream
+}
+
+func (x *packagesReadmeServiceGetAvailablePackageReadmeServer) Send(m *GetAvailablePackageReadmeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// PackagesReadmeService_ServiceDesc is the grpc.ServiceDesc for PackagesReadmeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PackagesReadmeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kubeappsapis.core.packages.v1alpha1.PackagesReadmeService",
+	HandlerType: (*PackagesReadmeServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetAvailablePackageReadme",
+			Handler:       _PackagesReadmeService_GetAvailablePackageReadme_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "kubeappsapis/core/packages/v1alpha1/packages.proto",
+}
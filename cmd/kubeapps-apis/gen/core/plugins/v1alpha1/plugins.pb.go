@@ -29,6 +29,12 @@ type GetConfiguredPluginsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Group by service
+	//
+	// Whether to also return the plugins grouped by the core service they
+	// implement, such as the packages service.
+	GroupByService bool `protobuf:"varint,1,opt,name=group_by_service,json=groupByService,proto3" json:"group_by_service,omitempty"`
 }
 
 func (x *GetConfiguredPluginsRequest) Reset() {
@@ -63,6 +69,13 @@ func (*GetConfiguredPluginsRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetConfiguredPluginsRequest) GetGroupByService() bool {
+	if x != nil {
+		return x.GroupByService
+	}
+	return false
+}
+
 // GetConfiguredPluginsResponse
 //
 // Response for GetConfiguredPlugins
@@ -75,6 +88,12 @@ type GetConfiguredPluginsResponse struct {
 	//
 	// List of Plugin
 	Plugins []*Plugin `protobuf:"bytes,1,rep,name=plugins,proto3" json:"plugins,omitempty"`
+	// Service plugins
+	//
+	// The plugins grouped by the core service they implement, only returned
+	// when requested with group_by_service. Plugins implementing no core
+	// service are only included in the plugins list.
+	ServicePlugins []*ServicePlugins `protobuf:"bytes,2,rep,name=service_plugins,json=servicePlugins,proto3" json:"service_plugins,omitempty"`
 }
 
 func (x *GetConfiguredPluginsResponse) Reset() {
@@ -116,6 +135,274 @@ func (x *GetConfiguredPluginsResponse) GetPlugins() []*Plugin {
 	return nil
 }
 
+func (x *GetConfiguredPluginsResponse) GetServicePlugins() []*ServicePlugins {
+	if x != nil {
+		return x.ServicePlugins
+	}
+	return nil
+}
+
+// GetConfiguredClustersRequest
+//
+// Request for GetConfiguredClusters
+type GetConfiguredClustersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Check access
+	//
+	// Whether to also check, with the credentials of the user, that each
+	// cluster can be accessed.
+	CheckAccess bool `protobuf:"varint,1,opt,name=check_access,json=checkAccess,proto3" json:"check_access,omitempty"`
+}
+
+func (x *GetConfiguredClustersRequest) Reset() {
+	*x = GetConfiguredClustersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfiguredClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfiguredClustersRequest) ProtoMessage() {}
+
+func (x *GetConfiguredClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfiguredClustersRequest.ProtoReflect.Descriptor instead.
+func (*GetConfiguredClustersRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetConfiguredClustersRequest) GetCheckAccess() bool {
+	if x != nil {
+		return x.CheckAccess
+	}
+	return false
+}
+
+// GetConfiguredClustersResponse
+//
+// Response for GetConfiguredClusters
+type GetConfiguredClustersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Clusters
+	//
+	// The configured clusters, ordered by name
+	Clusters []*ConfiguredCluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+}
+
+func (x *GetConfiguredClustersResponse) Reset() {
+	*x = GetConfiguredClustersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfiguredClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfiguredClustersResponse) ProtoMessage() {}
+
+func (x *GetConfiguredClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfiguredClustersResponse.ProtoReflect.Descriptor instead.
+func (*GetConfiguredClustersResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetConfiguredClustersResponse) GetClusters() []*ConfiguredCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+// ConfiguredCluster
+//
+// A cluster of the clusters configuration, without its credentials.
+type ConfiguredCluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cluster name
+	//
+	// The name of the cluster, as used in the package contexts.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// API service URL
+	//
+	// The URL of the API server of the cluster, without any user information.
+	// Empty for the cluster on which Kubeapps is installed when it is accessed
+	// from within the cluster.
+	ApiServiceUrl string `protobuf:"bytes,2,opt,name=api_service_url,json=apiServiceUrl,proto3" json:"api_service_url,omitempty"`
+	// Is Kubeapps cluster
+	//
+	// Whether Kubeapps is installed on this cluster.
+	IsKubeappsCluster bool `protobuf:"varint,3,opt,name=is_kubeapps_cluster,json=isKubeappsCluster,proto3" json:"is_kubeapps_cluster,omitempty"`
+	// Accessible
+	//
+	// Whether the API server of the cluster could be reached and accepted the
+	// credentials of the user. Only set when requested with check_access.
+	Accessible bool `protobuf:"varint,4,opt,name=accessible,proto3" json:"accessible,omitempty"`
+}
+
+func (x *ConfiguredCluster) Reset() {
+	*x = ConfiguredCluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfiguredCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfiguredCluster) ProtoMessage() {}
+
+func (x *ConfiguredCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfiguredCluster.ProtoReflect.Descriptor instead.
+func (*ConfiguredCluster) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConfiguredCluster) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfiguredCluster) GetApiServiceUrl() string {
+	if x != nil {
+		return x.ApiServiceUrl
+	}
+	return ""
+}
+
+func (x *ConfiguredCluster) GetIsKubeappsCluster() bool {
+	if x != nil {
+		return x.IsKubeappsCluster
+	}
+	return false
+}
+
+func (x *ConfiguredCluster) GetAccessible() bool {
+	if x != nil {
+		return x.Accessible
+	}
+	return false
+}
+
+// ServicePlugins
+//
+// The plugins implementing a core service.
+type ServicePlugins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Service
+	//
+	// The fully qualified name of the core service, such as
+	// `kubeappsapis.core.packages.v1alpha1.PackagesService`.
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// Plugins
+	//
+	// The plugins implementing the service
+	Plugins []*Plugin `protobuf:"bytes,2,rep,name=plugins,proto3" json:"plugins,omitempty"`
+}
+
+func (x *ServicePlugins) Reset() {
+	*x = ServicePlugins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicePlugins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePlugins) ProtoMessage() {}
+
+func (x *ServicePlugins) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePlugins.ProtoReflect.Descriptor instead.
+func (*ServicePlugins) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ServicePlugins) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ServicePlugins) GetPlugins() []*Plugin {
+	if x != nil {
+		return x.Plugins
+	}
+	return nil
+}
+
 // Plugin
 //
 // A plugin can implement multiple services and multiple versions of a service.
@@ -137,7 +424,7 @@ type Plugin struct {
 func (x *Plugin) Reset() {
 	*x = Plugin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[2]
+		mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -150,7 +437,7 @@ func (x *Plugin) String() string {
 func (*Plugin) ProtoMessage() {}
 
 func (x *Plugin) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[2]
+	mi := &file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -163,7 +450,7 @@ func (x *Plugin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Plugin.ProtoReflect.Descriptor instead.
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDescGZIP(), []int{2}
+	return file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Plugin) GetName() string {
@@ -193,47 +480,103 @@ var file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
 	0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x75, 0x72, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
-	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x52, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x32,
-	0x4a, 0x7b, 0x22, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6b, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x22, 0x2c, 0x20, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x7d, 0x5d, 0x7d, 0x22, 0x78, 0x0a, 0x06, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x3a, 0x40, 0x92, 0x41, 0x3d, 0x32, 0x3b, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x3a, 0x20, 0x22, 0x6b, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x22, 0x2c, 0x20, 0x22,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x22, 0x7d, 0x32, 0xdf, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xcc, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x73, 0x12, 0x3f, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x92, 0x02,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x07, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x12, 0x5b, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x32, 0x4a, 0x7b, 0x22, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6b,
+	0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x7d,
+	0x5d, 0x7d, 0x22, 0x41, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x3a, 0x59, 0x92, 0x41, 0x56, 0x32,
+	0x54, 0x7b, 0x22, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x7b,
+	0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x22, 0x2c, 0x20, 0x22, 0x69, 0x73, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x5f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x3a, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20,
+	0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x3a, 0x20, 0x74, 0x72,
+	0x75, 0x65, 0x7d, 0x5d, 0x7d, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x61, 0x70, 0x69, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x73, 0x4b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x70, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x52, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x78, 0x0a, 0x06, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x3a, 0x40, 0x92, 0x41, 0x3d, 0x32, 0x3b, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x6b, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x22, 0x7d, 0x32, 0xb2, 0x03, 0x0a, 0x0e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xcc, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12,
+	0x3f, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x40, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x2d, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0xd0, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x40, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x41, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
 	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x40, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63,
+	0x75, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x63,
 	0x6f, 0x72, 0x65, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c,
 	0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x2d,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x6b,
-	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x6b, 0x75, 0x62, 0x65,
-	0x61, 0x70, 0x70, 0x73, 0x2d, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f,
-	0x72, 0x65, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2f,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x61, 0x70, 0x70, 0x73, 0x2d, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -248,21 +591,30 @@ func file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDescGZIP() []byte
 	return file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDescData
 }
 
-var file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_goTypes = []interface{}{
-	(*GetConfiguredPluginsRequest)(nil),  // 0: kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsRequest
-	(*GetConfiguredPluginsResponse)(nil), // 1: kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsResponse
-	(*Plugin)(nil),                       // 2: kubeappsapis.core.plugins.v1alpha1.Plugin
+	(*GetConfiguredPluginsRequest)(nil),   // 0: kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsRequest
+	(*GetConfiguredPluginsResponse)(nil),  // 1: kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsResponse
+	(*GetConfiguredClustersRequest)(nil),  // 2: kubeappsapis.core.plugins.v1alpha1.GetConfiguredClustersRequest
+	(*GetConfiguredClustersResponse)(nil), // 3: kubeappsapis.core.plugins.v1alpha1.GetConfiguredClustersResponse
+	(*ConfiguredCluster)(nil),             // 4: kubeappsapis.core.plugins.v1alpha1.ConfiguredCluster
+	(*ServicePlugins)(nil),                // 5: kubeappsapis.core.plugins.v1alpha1.ServicePlugins
+	(*Plugin)(nil),                        // 6: kubeappsapis.core.plugins.v1alpha1.Plugin
 }
 var file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_depIdxs = []int32{
-	2, // 0: kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsResponse.plugins:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
-	0, // 1: kubeappsapis.core.plugins.v1alpha1.PluginsService.GetConfiguredPlugins:input_type -> kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsRequest
-	1, // 2: kubeappsapis.core.plugins.v1alpha1.PluginsService.GetConfiguredPlugins:output_type -> kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsResponse.plugins:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
+	5, // 1: kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsResponse.service_plugins:type_name -> kubeappsapis.core.plugins.v1alpha1.ServicePlugins
+	4, // 2: kubeappsapis.core.plugins.v1alpha1.GetConfiguredClustersResponse.clusters:type_name -> kubeappsapis.core.plugins.v1alpha1.ConfiguredCluster
+	6, // 3: kubeappsapis.core.plugins.v1alpha1.ServicePlugins.plugins:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
+	0, // 4: kubeappsapis.core.plugins.v1alpha1.PluginsService.GetConfiguredPlugins:input_type -> kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsRequest
+	2, // 5: kubeappsapis.core.plugins.v1alpha1.PluginsService.GetConfiguredClusters:input_type -> kubeappsapis.core.plugins.v1alpha1.GetConfiguredClustersRequest
+	1, // 6: kubeappsapis.core.plugins.v1alpha1.PluginsService.GetConfiguredPlugins:output_type -> kubeappsapis.core.plugins.v1alpha1.GetConfiguredPluginsResponse
+	3, // 7: kubeappsapis.core.plugins.v1alpha1.PluginsService.GetConfiguredClusters:output_type -> kubeappsapis.core.plugins.v1alpha1.GetConfiguredClustersResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_init() }
@@ -296,6 +648,54 @@ func file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_init() {
 			}
 		}
 		file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfiguredClustersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfiguredClustersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfiguredCluster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServicePlugins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Plugin); i {
 			case 0:
 				return &v.state
@@ -314,7 +714,7 @@ func file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kubeappsapis_core_plugins_v1alpha1_plugins_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
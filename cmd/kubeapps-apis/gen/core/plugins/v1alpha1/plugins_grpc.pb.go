@@ -20,6 +20,9 @@ const _ = grpc.SupportPackageIsVersion7
 type PluginsServiceClient interface {
 	// GetConfiguredPlugins returns a map of short and longnames for the configured plugins.
 	GetConfiguredPlugins(ctx context.Context, in *GetConfiguredPluginsRequest, opts ...grpc.CallOption) (*GetConfiguredPluginsResponse, error)
+	// GetConfiguredClusters returns the configured clusters and, when requested,
+	// whether the user can access each of them.
+	GetConfiguredClusters(ctx context.Context, in *GetConfiguredClustersRequest, opts ...grpc.CallOption) (*GetConfiguredClustersResponse, error)
 }
 
 type pluginsServiceClient struct {
@@ -39,12 +42,24 @@ func (c *pluginsServiceClient) GetConfiguredPlugins(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *pluginsServiceClient) GetConfiguredClusters(ctx context.Context, in *GetConfiguredClustersRequest, opts ...grpc.CallOption) (*GetConfiguredClustersResponse, error) {
+	out := new(GetConfiguredClustersResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.plugins.v1alpha1.PluginsService/GetConfiguredClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PluginsServiceServer is the server API for PluginsService service.
 // All implementations should embed UnimplementedPluginsServiceServer
 // for forward compatibility
 type PluginsServiceServer interface {
 	// GetConfiguredPlugins returns a map of short and longnames for the configured plugins.
 	GetConfiguredPlugins(context.Context, *GetConfiguredPluginsRequest) (*GetConfiguredPluginsResponse, error)
+	// GetConfiguredClusters returns the configured clusters and, when requested,
+	// whether the user can access each of them.
+	GetConfiguredClusters(context.Context, *GetConfiguredClustersRequest) (*GetConfiguredClustersResponse, error)
 }
 
 // UnimplementedPluginsServiceServer should be embedded to have forward compatible implementations.
@@ -54,6 +69,9 @@ type UnimplementedPluginsServiceServer struct {
 func (UnimplementedPluginsServiceServer) GetConfiguredPlugins(context.Context, *GetConfiguredPluginsRequest) (*GetConfiguredPluginsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguredPlugins not implemented")
 }
+func (UnimplementedPluginsServiceServer) GetConfiguredClusters(context.Context, *GetConfiguredClustersRequest) (*GetConfiguredClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguredClusters not implemented")
+}
 
 // UnsafePluginsServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PluginsServiceServer will
@@ -84,6 +102,24 @@ func _PluginsService_GetConfiguredPlugins_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PluginsService_GetConfiguredClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfiguredClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginsServiceServer).GetConfiguredClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.plugins.v1alpha1.PluginsService/GetConfiguredClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginsServiceServer).GetConfiguredClusters(ctx, req.(*GetConfiguredClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PluginsService_ServiceDesc is the grpc.ServiceDesc for PluginsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -95,6 +131,10 @@ var PluginsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfiguredPlugins",
 			Handler:    _PluginsService_GetConfiguredPlugins_Handler,
 		},
+		{
+			MethodName: "GetConfiguredClusters",
+			Handler:    _PluginsService_GetConfiguredClusters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/core/plugins/v1alpha1/plugins.proto",
@@ -25,18 +25,40 @@ type FluxV2PackagesServiceClient interface {
 	GetAvailablePackageDetail(ctx context.Context, in *v1alpha1.GetAvailablePackageDetailRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDetailResponse, error)
 	// GetAvailablePackageVersions returns the package versions managed by the 'fluxv2' plugin
 	GetAvailablePackageVersions(ctx context.Context, in *v1alpha1.GetAvailablePackageVersionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageVersionsResponse, error)
+	// GetAvailablePackageDependencies returns the dependencies of a package managed by the 'fluxv2' plugin
+	GetAvailablePackageDependencies(ctx context.Context, in *v1alpha1.GetAvailablePackageDependenciesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDependenciesResponse, error)
+	// GetAvailablePackageDefaultValues returns the default values of an available package managed by the 'fluxv2' plugin
+	GetAvailablePackageDefaultValues(ctx context.Context, in *v1alpha1.GetAvailablePackageDefaultValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error)
+	// GetAvailablePackageChangelog returns the changelog between two versions of an available package managed by the 'fluxv2' plugin
+	GetAvailablePackageChangelog(ctx context.Context, in *v1alpha1.GetAvailablePackageChangelogRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageChangelogResponse, error)
+	// GetAvailablePackageValuesDiff returns the differences between the default values of two versions of an available package managed by the 'fluxv2' plugin
+	GetAvailablePackageValuesDiff(ctx context.Context, in *v1alpha1.GetAvailablePackageValuesDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error)
+	// RenderAvailablePackage renders the templates of an available package managed by the 'fluxv2' plugin with the given values
+	RenderAvailablePackage(ctx context.Context, in *v1alpha1.RenderAvailablePackageRequest, opts ...grpc.CallOption) (*v1alpha1.RenderAvailablePackageResponse, error)
 	// GetPackageRepositories returns the repositories managed by the 'fluxv2' plugin
 	GetPackageRepositories(ctx context.Context, in *GetPackageRepositoriesRequest, opts ...grpc.CallOption) (*GetPackageRepositoriesResponse, error)
 	// GetInstalledPackageSummaries returns the installed packages managed by the 'fluxv2' plugin
 	GetInstalledPackageSummaries(ctx context.Context, in *v1alpha1.GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageSummariesResponse, error)
 	// GetInstalledPackageDetail returns the requested installed package managed by the 'fluxv2' plugin
 	GetInstalledPackageDetail(ctx context.Context, in *v1alpha1.GetInstalledPackageDetailRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDetailResponse, error)
+	// GetInstalledPackageValues returns the values currently in effect for an installed package managed by the 'fluxv2' plugin
+	GetInstalledPackageValues(ctx context.Context, in *v1alpha1.GetInstalledPackageValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageValuesResponse, error)
 	// CreateInstalledPackage creates an installed package based on the request.
 	CreateInstalledPackage(ctx context.Context, in *v1alpha1.CreateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstalledPackageResponse, error)
 	// UpdateInstalledPackage updates an installed package based on the request.
 	UpdateInstalledPackage(ctx context.Context, in *v1alpha1.UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// GetInstalledPackageUpgradeDiff returns the diff of the manifests of an upgrade of an installed package managed by the 'fluxv2' plugin
+	GetInstalledPackageUpgradeDiff(ctx context.Context, in *v1alpha1.GetInstalledPackageUpgradeDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error)
+	// GetInstalledPackageDrift returns how an installed package managed by the 'fluxv2' plugin differs from the current state of the cluster
+	GetInstalledPackageDrift(ctx context.Context, in *v1alpha1.GetInstalledPackageDriftRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDriftResponse, error)
+	// ExportInstalledPackage returns a declarative manifest of an installed package managed by the 'fluxv2' plugin
+	ExportInstalledPackage(ctx context.Context, in *v1alpha1.ExportInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.ExportInstalledPackageResponse, error)
 	// DeleteInstalledPackage deletes an installed package based on the request.
 	DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error)
+	// GetRecentActivity returns the recent operations on the installed packages managed by the 'fluxv2' plugin
+	GetRecentActivity(ctx context.Context, in *v1alpha1.GetRecentActivityRequest, opts ...grpc.CallOption) (*v1alpha1.GetRecentActivityResponse, error)
+	// GetUserPermissions returns the operations the user can perform on the installed packages managed by the 'fluxv2' plugin
+	GetUserPermissions(ctx context.Context, in *v1alpha1.GetUserPermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetUserPermissionsResponse, error)
 }
 
 type fluxV2PackagesServiceClient struct {
@@ -74,6 +96,51 @@ func (c *fluxV2PackagesServiceClient) GetAvailablePackageVersions(ctx context.Co
 	return out, nil
 }
 
+func (c *fluxV2PackagesServiceClient) GetAvailablePackageDependencies(ctx context.Context, in *v1alpha1.GetAvailablePackageDependenciesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDependenciesResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageDependenciesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetAvailablePackageDependencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) GetAvailablePackageDefaultValues(ctx context.Context, in *v1alpha1.GetAvailablePackageDefaultValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageDefaultValuesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetAvailablePackageDefaultValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) GetAvailablePackageChangelog(ctx context.Context, in *v1alpha1.GetAvailablePackageChangelogRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageChangelogResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageChangelogResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetAvailablePackageChangelog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) GetAvailablePackageValuesDiff(ctx context.Context, in *v1alpha1.GetAvailablePackageValuesDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageValuesDiffResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetAvailablePackageValuesDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) RenderAvailablePackage(ctx context.Context, in *v1alpha1.RenderAvailablePackageRequest, opts ...grpc.CallOption) (*v1alpha1.RenderAvailablePackageResponse, error) {
+	out := new(v1alpha1.RenderAvailablePackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/RenderAvailablePackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fluxV2PackagesServiceClient) GetPackageRepositories(ctx context.Context, in *GetPackageRepositoriesRequest, opts ...grpc.CallOption) (*GetPackageRepositoriesResponse, error) {
 	out := new(GetPackageRepositoriesResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetPackageRepositories", in, out, opts...)
@@ -101,6 +168,15 @@ func (c *fluxV2PackagesServiceClient) GetInstalledPackageDetail(ctx context.Cont
 	return out, nil
 }
 
+func (c *fluxV2PackagesServiceClient) GetInstalledPackageValues(ctx context.Context, in *v1alpha1.GetInstalledPackageValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageValuesResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageValuesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fluxV2PackagesServiceClient) CreateInstalledPackage(ctx context.Context, in *v1alpha1.CreateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstalledPackageResponse, error) {
 	out := new(v1alpha1.CreateInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/CreateInstalledPackage", in, out, opts...)
@@ -119,6 +195,33 @@ func (c *fluxV2PackagesServiceClient) UpdateInstalledPackage(ctx context.Context
 	return out, nil
 }
 
+func (c *fluxV2PackagesServiceClient) GetInstalledPackageUpgradeDiff(ctx context.Context, in *v1alpha1.GetInstalledPackageUpgradeDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageUpgradeDiffResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageUpgradeDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) GetInstalledPackageDrift(ctx context.Context, in *v1alpha1.GetInstalledPackageDriftRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDriftResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageDriftResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) ExportInstalledPackage(ctx context.Context, in *v1alpha1.ExportInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.ExportInstalledPackageResponse, error) {
+	out := new(v1alpha1.ExportInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/ExportInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fluxV2PackagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error) {
 	out := new(v1alpha1.DeleteInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/DeleteInstalledPackage", in, out, opts...)
@@ -128,6 +231,24 @@ func (c *fluxV2PackagesServiceClient) DeleteInstalledPackage(ctx context.Context
 	return out, nil
 }
 
+func (c *fluxV2PackagesServiceClient) GetRecentActivity(ctx context.Context, in *v1alpha1.GetRecentActivityRequest, opts ...grpc.CallOption) (*v1alpha1.GetRecentActivityResponse, error) {
+	out := new(v1alpha1.GetRecentActivityResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetRecentActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) GetUserPermissions(ctx context.Context, in *v1alpha1.GetUserPermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetUserPermissionsResponse, error) {
+	out := new(v1alpha1.GetUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetUserPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FluxV2PackagesServiceServer is the server API for FluxV2PackagesService service.
 // All implementations should embed UnimplementedFluxV2PackagesServiceServer
 // for forward compatibility
@@ -138,18 +259,40 @@ type FluxV2PackagesServiceServer interface {
 	GetAvailablePackageDetail(context.Context, *v1alpha1.GetAvailablePackageDetailRequest) (*v1alpha1.GetAvailablePackageDetailResponse, error)
 	// GetAvailablePackageVersions returns the package versions managed by the 'fluxv2' plugin
 	GetAvailablePackageVersions(context.Context, *v1alpha1.GetAvailablePackageVersionsRequest) (*v1alpha1.GetAvailablePackageVersionsResponse, error)
+	// GetAvailablePackageDependencies returns the dependencies of a package managed by the 'fluxv2' plugin
+	GetAvailablePackageDependencies(context.Context, *v1alpha1.GetAvailablePackageDependenciesRequest) (*v1alpha1.GetAvailablePackageDependenciesResponse, error)
+	// GetAvailablePackageDefaultValues returns the default values of an available package managed by the 'fluxv2' plugin
+	GetAvailablePackageDefaultValues(context.Context, *v1alpha1.GetAvailablePackageDefaultValuesRequest) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error)
+	// GetAvailablePackageChangelog returns the changelog between two versions of an available package managed by the 'fluxv2' plugin
+	GetAvailablePackageChangelog(context.Context, *v1alpha1.GetAvailablePackageChangelogRequest) (*v1alpha1.GetAvailablePackageChangelogResponse, error)
+	// GetAvailablePackageValuesDiff returns the differences between the default values of two versions of an available package managed by the 'fluxv2' plugin
+	GetAvailablePackageValuesDiff(context.Context, *v1alpha1.GetAvailablePackageValuesDiffRequest) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error)
+	// RenderAvailablePackage renders the templates of an available package managed by the 'fluxv2' plugin with the given values
+	RenderAvailablePackage(context.Context, *v1alpha1.RenderAvailablePackageRequest) (*v1alpha1.RenderAvailablePackageResponse, error)
 	// GetPackageRepositories returns the repositories managed by the 'fluxv2' plugin
 	GetPackageRepositories(context.Context, *GetPackageRepositoriesRequest) (*GetPackageRepositoriesResponse, error)
 	// GetInstalledPackageSummaries returns the installed packages managed by the 'fluxv2' plugin
 	GetInstalledPackageSummaries(context.Context, *v1alpha1.GetInstalledPackageSummariesRequest) (*v1alpha1.GetInstalledPackageSummariesResponse, error)
 	// GetInstalledPackageDetail returns the requested installed package managed by the 'fluxv2' plugin
 	GetInstalledPackageDetail(context.Context, *v1alpha1.GetInstalledPackageDetailRequest) (*v1alpha1.GetInstalledPackageDetailResponse, error)
+	// GetInstalledPackageValues returns the values currently in effect for an installed package managed by the 'fluxv2' plugin
+	GetInstalledPackageValues(context.Context, *v1alpha1.GetInstalledPackageValuesRequest) (*v1alpha1.GetInstalledPackageValuesResponse, error)
 	// CreateInstalledPackage creates an installed package based on the request.
 	CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error)
 	// UpdateInstalledPackage updates an installed package based on the request.
 	UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// GetInstalledPackageUpgradeDiff returns the diff of the manifests of an upgrade of an installed package managed by the 'fluxv2' plugin
+	GetInstalledPackageUpgradeDiff(context.Context, *v1alpha1.GetInstalledPackageUpgradeDiffRequest) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error)
+	// GetInstalledPackageDrift returns how an installed package managed by the 'fluxv2' plugin differs from the current state of the cluster
+	GetInstalledPackageDrift(context.Context, *v1alpha1.GetInstalledPackageDriftRequest) (*v1alpha1.GetInstalledPackageDriftResponse, error)
+	// ExportInstalledPackage returns a declarative manifest of an installed package managed by the 'fluxv2' plugin
+	ExportInstalledPackage(context.Context, *v1alpha1.ExportInstalledPackageRequest) (*v1alpha1.ExportInstalledPackageResponse, error)
 	// DeleteInstalledPackage deletes an installed package based on the request.
 	DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error)
+	// GetRecentActivity returns the recent operations on the installed packages managed by the 'fluxv2' plugin
+	GetRecentActivity(context.Context, *v1alpha1.GetRecentActivityRequest) (*v1alpha1.GetRecentActivityResponse, error)
+	// GetUserPermissions returns the operations the user can perform on the installed packages managed by the 'fluxv2' plugin
+	GetUserPermissions(context.Context, *v1alpha1.GetUserPermissionsRequest) (*v1alpha1.GetUserPermissionsResponse, error)
 }
 
 // UnimplementedFluxV2PackagesServiceServer should be embedded to have forward compatible implementations.
@@ -165,6 +308,21 @@ func (UnimplementedFluxV2PackagesServiceServer) GetAvailablePackageDetail(contex
 func (UnimplementedFluxV2PackagesServiceServer) GetAvailablePackageVersions(context.Context, *v1alpha1.GetAvailablePackageVersionsRequest) (*v1alpha1.GetAvailablePackageVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageVersions not implemented")
 }
+func (UnimplementedFluxV2PackagesServiceServer) GetAvailablePackageDependencies(context.Context, *v1alpha1.GetAvailablePackageDependenciesRequest) (*v1alpha1.GetAvailablePackageDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageDependencies not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) GetAvailablePackageDefaultValues(context.Context, *v1alpha1.GetAvailablePackageDefaultValuesRequest) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageDefaultValues not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) GetAvailablePackageChangelog(context.Context, *v1alpha1.GetAvailablePackageChangelogRequest) (*v1alpha1.GetAvailablePackageChangelogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageChangelog not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) GetAvailablePackageValuesDiff(context.Context, *v1alpha1.GetAvailablePackageValuesDiffRequest) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageValuesDiff not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) RenderAvailablePackage(context.Context, *v1alpha1.RenderAvailablePackageRequest) (*v1alpha1.RenderAvailablePackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderAvailablePackage not implemented")
+}
 func (UnimplementedFluxV2PackagesServiceServer) GetPackageRepositories(context.Context, *GetPackageRepositoriesRequest) (*GetPackageRepositoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPackageRepositories not implemented")
 }
@@ -174,15 +332,33 @@ func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageSummaries(con
 func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageDetail(context.Context, *v1alpha1.GetInstalledPackageDetailRequest) (*v1alpha1.GetInstalledPackageDetailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDetail not implemented")
 }
+func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageValues(context.Context, *v1alpha1.GetInstalledPackageValuesRequest) (*v1alpha1.GetInstalledPackageValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageValues not implemented")
+}
 func (UnimplementedFluxV2PackagesServiceServer) CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInstalledPackage not implemented")
 }
 func (UnimplementedFluxV2PackagesServiceServer) UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
 }
+func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageUpgradeDiff(context.Context, *v1alpha1.GetInstalledPackageUpgradeDiffRequest) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageUpgradeDiff not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageDrift(context.Context, *v1alpha1.GetInstalledPackageDriftRequest) (*v1alpha1.GetInstalledPackageDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDrift not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) ExportInstalledPackage(context.Context, *v1alpha1.ExportInstalledPackageRequest) (*v1alpha1.ExportInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportInstalledPackage not implemented")
+}
 func (UnimplementedFluxV2PackagesServiceServer) DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
 }
+func (UnimplementedFluxV2PackagesServiceServer) GetRecentActivity(context.Context, *v1alpha1.GetRecentActivityRequest) (*v1alpha1.GetRecentActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentActivity not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) GetUserPermissions(context.Context, *v1alpha1.GetUserPermissionsRequest) (*v1alpha1.GetUserPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPermissions not implemented")
+}
 
 // UnsafeFluxV2PackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to FluxV2PackagesServiceServer will
@@ -249,6 +425,96 @@ func _FluxV2PackagesService_GetAvailablePackageVersions_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2PackagesService_GetAvailablePackageDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetAvailablePackageDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetAvailablePackageDependencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetAvailablePackageDependencies(ctx, req.(*v1alpha1.GetAvailablePackageDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_GetAvailablePackageDefaultValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageDefaultValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetAvailablePackageDefaultValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetAvailablePackageDefaultValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetAvailablePackageDefaultValues(ctx, req.(*v1alpha1.GetAvailablePackageDefaultValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_GetAvailablePackageChangelog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageChangelogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetAvailablePackageChangelog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetAvailablePackageChangelog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetAvailablePackageChangelog(ctx, req.(*v1alpha1.GetAvailablePackageChangelogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_GetAvailablePackageValuesDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageValuesDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetAvailablePackageValuesDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetAvailablePackageValuesDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetAvailablePackageValuesDiff(ctx, req.(*v1alpha1.GetAvailablePackageValuesDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_RenderAvailablePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.RenderAvailablePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).RenderAvailablePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/RenderAvailablePackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).RenderAvailablePackage(ctx, req.(*v1alpha1.RenderAvailablePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FluxV2PackagesService_GetPackageRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPackageRepositoriesRequest)
 	if err := dec(in); err != nil {
@@ -303,6 +569,24 @@ func _FluxV2PackagesService_GetInstalledPackageDetail_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2PackagesService_GetInstalledPackageValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageValues(ctx, req.(*v1alpha1.GetInstalledPackageValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FluxV2PackagesService_CreateInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.CreateInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -339,6 +623,60 @@ func _FluxV2PackagesService_UpdateInstalledPackage_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2PackagesService_GetInstalledPackageUpgradeDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageUpgradeDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageUpgradeDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageUpgradeDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageUpgradeDiff(ctx, req.(*v1alpha1.GetInstalledPackageUpgradeDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_GetInstalledPackageDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageDrift(ctx, req.(*v1alpha1.GetInstalledPackageDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_ExportInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ExportInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).ExportInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/ExportInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).ExportInstalledPackage(ctx, req.(*v1alpha1.ExportInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FluxV2PackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.DeleteInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -357,6 +695,42 @@ func _FluxV2PackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2PackagesService_GetRecentActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetRecentActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetRecentActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetRecentActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetRecentActivity(ctx, req.(*v1alpha1.GetRecentActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_GetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetUserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetUserPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetUserPermissions(ctx, req.(*v1alpha1.GetUserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FluxV2PackagesService_ServiceDesc is the grpc.ServiceDesc for FluxV2PackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -376,6 +750,26 @@ var FluxV2PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailablePackageVersions",
 			Handler:    _FluxV2PackagesService_GetAvailablePackageVersions_Handler,
 		},
+		{
+			MethodName: "GetAvailablePackageDependencies",
+			Handler:    _FluxV2PackagesService_GetAvailablePackageDependencies_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageDefaultValues",
+			Handler:    _FluxV2PackagesService_GetAvailablePackageDefaultValues_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageChangelog",
+			Handler:    _FluxV2PackagesService_GetAvailablePackageChangelog_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageValuesDiff",
+			Handler:    _FluxV2PackagesService_GetAvailablePackageValuesDiff_Handler,
+		},
+		{
+			MethodName: "RenderAvailablePackage",
+			Handler:    _FluxV2PackagesService_RenderAvailablePackage_Handler,
+		},
 		{
 			MethodName: "GetPackageRepositories",
 			Handler:    _FluxV2PackagesService_GetPackageRepositories_Handler,
@@ -388,6 +782,10 @@ var FluxV2PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstalledPackageDetail",
 			Handler:    _FluxV2PackagesService_GetInstalledPackageDetail_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageValues",
+			Handler:    _FluxV2PackagesService_GetInstalledPackageValues_Handler,
+		},
 		{
 			MethodName: "CreateInstalledPackage",
 			Handler:    _FluxV2PackagesService_CreateInstalledPackage_Handler,
@@ -396,10 +794,30 @@ var FluxV2PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstalledPackage",
 			Handler:    _FluxV2PackagesService_UpdateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageUpgradeDiff",
+			Handler:    _FluxV2PackagesService_GetInstalledPackageUpgradeDiff_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageDrift",
+			Handler:    _FluxV2PackagesService_GetInstalledPackageDrift_Handler,
+		},
+		{
+			MethodName: "ExportInstalledPackage",
+			Handler:    _FluxV2PackagesService_ExportInstalledPackage_Handler,
+		},
 		{
 			MethodName: "DeleteInstalledPackage",
 			Handler:    _FluxV2PackagesService_DeleteInstalledPackage_Handler,
 		},
+		{
+			MethodName: "GetRecentActivity",
+			Handler:    _FluxV2PackagesService_GetRecentActivity_Handler,
+		},
+		{
+			MethodName: "GetUserPermissions",
+			Handler:    _FluxV2PackagesService_GetUserPermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/fluxv2/packages/v1alpha1/fluxv2.proto",
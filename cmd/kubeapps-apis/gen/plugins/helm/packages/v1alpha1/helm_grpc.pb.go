@@ -25,16 +25,38 @@ type HelmPackagesServiceClient interface {
 	GetAvailablePackageDetail(ctx context.Context, in *v1alpha1.GetAvailablePackageDetailRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDetailResponse, error)
 	// GetAvailablePackageVersions returns the package versions managed by the 'helm' plugin
 	GetAvailablePackageVersions(ctx context.Context, in *v1alpha1.GetAvailablePackageVersionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageVersionsResponse, error)
+	// GetAvailablePackageDependencies returns the dependencies of a package managed by the 'helm' plugin
+	GetAvailablePackageDependencies(ctx context.Context, in *v1alpha1.GetAvailablePackageDependenciesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDependenciesResponse, error)
+	// GetAvailablePackageDefaultValues returns the default values of an available package managed by the 'helm' plugin
+	GetAvailablePackageDefaultValues(ctx context.Context, in *v1alpha1.GetAvailablePackageDefaultValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error)
+	// GetAvailablePackageChangelog returns the changelog between two versions of an available package managed by the 'helm' plugin
+	GetAvailablePackageChangelog(ctx context.Context, in *v1alpha1.GetAvailablePackageChangelogRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageChangelogResponse, error)
+	// GetAvailablePackageValuesDiff returns the differences between the default values of two versions of an available package managed by the 'helm' plugin
+	GetAvailablePackageValuesDiff(ctx context.Context, in *v1alpha1.GetAvailablePackageValuesDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error)
+	// RenderAvailablePackage renders the templates of an available package managed by the 'helm' plugin with the given values
+	RenderAvailablePackage(ctx context.Context, in *v1alpha1.RenderAvailablePackageRequest, opts ...grpc.CallOption) (*v1alpha1.RenderAvailablePackageResponse, error)
 	// GetInstalledPackageSummaries returns the installed packages managed by the 'helm' plugin
 	GetInstalledPackageSummaries(ctx context.Context, in *v1alpha1.GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageSummariesResponse, error)
 	// GetInstalledPackageDetail returns the requested installed package managed by the 'helm' plugin
 	GetInstalledPackageDetail(ctx context.Context, in *v1alpha1.GetInstalledPackageDetailRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDetailResponse, error)
+	// GetInstalledPackageValues returns the values currently in effect for an installed package managed by the 'helm' plugin
+	GetInstalledPackageValues(ctx context.Context, in *v1alpha1.GetInstalledPackageValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageValuesResponse, error)
 	// CreateInstalledPackage creates an installed package based on the request.
 	CreateInstalledPackage(ctx context.Context, in *v1alpha1.CreateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstalledPackageResponse, error)
 	// UpdateInstalledPackage updates an installed package based on the request.
 	UpdateInstalledPackage(ctx context.Context, in *v1alpha1.UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// GetInstalledPackageUpgradeDiff returns the diff of the manifests of an upgrade of an installed package managed by the 'helm' plugin
+	GetInstalledPackageUpgradeDiff(ctx context.Context, in *v1alpha1.GetInstalledPackageUpgradeDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error)
+	// GetInstalledPackageDrift returns how an installed package managed by the 'helm' plugin differs from the current state of the cluster
+	GetInstalledPackageDrift(ctx context.Context, in *v1alpha1.GetInstalledPackageDriftRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDriftResponse, error)
+	// ExportInstalledPackage returns a declarative manifest of an installed package managed by the 'helm' plugin
+	ExportInstalledPackage(ctx context.Context, in *v1alpha1.ExportInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.ExportInstalledPackageResponse, error)
 	// DeleteInstalledPackage deletes an installed package based on the request.
 	DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error)
+	// GetRecentActivity returns the recent operations on the installed packages managed by the 'helm' plugin
+	GetRecentActivity(ctx context.Context, in *v1alpha1.GetRecentActivityRequest, opts ...grpc.CallOption) (*v1alpha1.GetRecentActivityResponse, error)
+	// GetUserPermissions returns the operations the user can perform on the installed packages managed by the 'helm' plugin
+	GetUserPermissions(ctx context.Context, in *v1alpha1.GetUserPermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetUserPermissionsResponse, error)
 	// RollbackInstalledPackage updates an installed package based on the request.
 	RollbackInstalledPackage(ctx context.Context, in *RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*RollbackInstalledPackageResponse, error)
 }
@@ -74,6 +96,51 @@ func (c *helmPackagesServiceClient) GetAvailablePackageVersions(ctx context.Cont
 	return out, nil
 }
 
+func (c *helmPackagesServiceClient) GetAvailablePackageDependencies(ctx context.Context, in *v1alpha1.GetAvailablePackageDependenciesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDependenciesResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageDependenciesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageDependencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetAvailablePackageDefaultValues(ctx context.Context, in *v1alpha1.GetAvailablePackageDefaultValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageDefaultValuesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageDefaultValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetAvailablePackageChangelog(ctx context.Context, in *v1alpha1.GetAvailablePackageChangelogRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageChangelogResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageChangelogResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageChangelog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetAvailablePackageValuesDiff(ctx context.Context, in *v1alpha1.GetAvailablePackageValuesDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageValuesDiffResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageValuesDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) RenderAvailablePackage(ctx context.Context, in *v1alpha1.RenderAvailablePackageRequest, opts ...grpc.CallOption) (*v1alpha1.RenderAvailablePackageResponse, error) {
+	out := new(v1alpha1.RenderAvailablePackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RenderAvailablePackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *helmPackagesServiceClient) GetInstalledPackageSummaries(ctx context.Context, in *v1alpha1.GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageSummariesResponse, error) {
 	out := new(v1alpha1.GetInstalledPackageSummariesResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageSummaries", in, out, opts...)
@@ -92,6 +159,15 @@ func (c *helmPackagesServiceClient) GetInstalledPackageDetail(ctx context.Contex
 	return out, nil
 }
 
+func (c *helmPackagesServiceClient) GetInstalledPackageValues(ctx context.Context, in *v1alpha1.GetInstalledPackageValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageValuesResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageValuesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *helmPackagesServiceClient) CreateInstalledPackage(ctx context.Context, in *v1alpha1.CreateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstalledPackageResponse, error) {
 	out := new(v1alpha1.CreateInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/CreateInstalledPackage", in, out, opts...)
@@ -110,6 +186,33 @@ func (c *helmPackagesServiceClient) UpdateInstalledPackage(ctx context.Context,
 	return out, nil
 }
 
+func (c *helmPackagesServiceClient) GetInstalledPackageUpgradeDiff(ctx context.Context, in *v1alpha1.GetInstalledPackageUpgradeDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageUpgradeDiffResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageUpgradeDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetInstalledPackageDrift(ctx context.Context, in *v1alpha1.GetInstalledPackageDriftRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDriftResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageDriftResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) ExportInstalledPackage(ctx context.Context, in *v1alpha1.ExportInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.ExportInstalledPackageResponse, error) {
+	out := new(v1alpha1.ExportInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/ExportInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *helmPackagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error) {
 	out := new(v1alpha1.DeleteInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/DeleteInstalledPackage", in, out, opts...)
@@ -119,6 +222,24 @@ func (c *helmPackagesServiceClient) DeleteInstalledPackage(ctx context.Context,
 	return out, nil
 }
 
+func (c *helmPackagesServiceClient) GetRecentActivity(ctx context.Context, in *v1alpha1.GetRecentActivityRequest, opts ...grpc.CallOption) (*v1alpha1.GetRecentActivityResponse, error) {
+	out := new(v1alpha1.GetRecentActivityResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetRecentActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetUserPermissions(ctx context.Context, in *v1alpha1.GetUserPermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetUserPermissionsResponse, error) {
+	out := new(v1alpha1.GetUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetUserPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *helmPackagesServiceClient) RollbackInstalledPackage(ctx context.Context, in *RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*RollbackInstalledPackageResponse, error) {
 	out := new(RollbackInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RollbackInstalledPackage", in, out, opts...)
@@ -138,16 +259,38 @@ type HelmPackagesServiceServer interface {
 	GetAvailablePackageDetail(context.Context, *v1alpha1.GetAvailablePackageDetailRequest) (*v1alpha1.GetAvailablePackageDetailResponse, error)
 	// GetAvailablePackageVersions returns the package versions managed by the 'helm' plugin
 	GetAvailablePackageVersions(context.Context, *v1alpha1.GetAvailablePackageVersionsRequest) (*v1alpha1.GetAvailablePackageVersionsResponse, error)
+	// GetAvailablePackageDependencies returns the dependencies of a package managed by the 'helm' plugin
+	GetAvailablePackageDependencies(context.Context, *v1alpha1.GetAvailablePackageDependenciesRequest) (*v1alpha1.GetAvailablePackageDependenciesResponse, error)
+	// GetAvailablePackageDefaultValues returns the default values of an available package managed by the 'helm' plugin
+	GetAvailablePackageDefaultValues(context.Context, *v1alpha1.GetAvailablePackageDefaultValuesRequest) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error)
+	// GetAvailablePackageChangelog returns the changelog between two versions of an available package managed by the 'helm' plugin
+	GetAvailablePackageChangelog(context.Context, *v1alpha1.GetAvailablePackageChangelogRequest) (*v1alpha1.GetAvailablePackageChangelogResponse, error)
+	// GetAvailablePackageValuesDiff returns the differences between the default values of two versions of an available package managed by the 'helm' plugin
+	GetAvailablePackageValuesDiff(context.Context, *v1alpha1.GetAvailablePackageValuesDiffRequest) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error)
+	// RenderAvailablePackage renders the templates of an available package managed by the 'helm' plugin with the given values
+	RenderAvailablePackage(context.Context, *v1alpha1.RenderAvailablePackageRequest) (*v1alpha1.RenderAvailablePackageResponse, error)
 	// GetInstalledPackageSummaries returns the installed packages managed by the 'helm' plugin
 	GetInstalledPackageSummaries(context.Context, *v1alpha1.GetInstalledPackageSummariesRequest) (*v1alpha1.GetInstalledPackageSummariesResponse, error)
 	// GetInstalledPackageDetail returns the requested installed package managed by the 'helm' plugin
 	GetInstalledPackageDetail(context.Context, *v1alpha1.GetInstalledPackageDetailRequest) (*v1alpha1.GetInstalledPackageDetailResponse, error)
+	// GetInstalledPackageValues returns the values currently in effect for an installed package managed by the 'helm' plugin
+	GetInstalledPackageValues(context.Context, *v1alpha1.GetInstalledPackageValuesRequest) (*v1alpha1.GetInstalledPackageValuesResponse, error)
 	// CreateInstalledPackage creates an installed package based on the request.
 	CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error)
 	// UpdateInstalledPackage updates an installed package based on the request.
 	UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// GetInstalledPackageUpgradeDiff returns the diff of the manifests of an upgrade of an installed package managed by the 'helm' plugin
+	GetInstalledPackageUpgradeDiff(context.Context, *v1alpha1.GetInstalledPackageUpgradeDiffRequest) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error)
+	// GetInstalledPackageDrift returns how an installed package managed by the 'helm' plugin differs from the current state of the cluster
+	GetInstalledPackageDrift(context.Context, *v1alpha1.GetInstalledPackageDriftRequest) (*v1alpha1.GetInstalledPackageDriftResponse, error)
+	// ExportInstalledPackage returns a declarative manifest of an installed package managed by the 'helm' plugin
+	ExportInstalledPackage(context.Context, *v1alpha1.ExportInstalledPackageRequest) (*v1alpha1.ExportInstalledPackageResponse, error)
 	// DeleteInstalledPackage deletes an installed package based on the request.
 	DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error)
+	// GetRecentActivity returns the recent operations on the installed packages managed by the 'helm' plugin
+	GetRecentActivity(context.Context, *v1alpha1.GetRecentActivityRequest) (*v1alpha1.GetRecentActivityResponse, error)
+	// GetUserPermissions returns the operations the user can perform on the installed packages managed by the 'helm' plugin
+	GetUserPermissions(context.Context, *v1alpha1.GetUserPermissionsRequest) (*v1alpha1.GetUserPermissionsResponse, error)
 	// RollbackInstalledPackage updates an installed package based on the request.
 	RollbackInstalledPackage(context.Context, *RollbackInstalledPackageRequest) (*RollbackInstalledPackageResponse, error)
 }
@@ -165,21 +308,54 @@ func (UnimplementedHelmPackagesServiceServer) GetAvailablePackageDetail(context.
 func (UnimplementedHelmPackagesServiceServer) GetAvailablePackageVersions(context.Context, *v1alpha1.GetAvailablePackageVersionsRequest) (*v1alpha1.GetAvailablePackageVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageVersions not implemented")
 }
+func (UnimplementedHelmPackagesServiceServer) GetAvailablePackageDependencies(context.Context, *v1alpha1.GetAvailablePackageDependenciesRequest) (*v1alpha1.GetAvailablePackageDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageDependencies not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetAvailablePackageDefaultValues(context.Context, *v1alpha1.GetAvailablePackageDefaultValuesRequest) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageDefaultValues not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetAvailablePackageChangelog(context.Context, *v1alpha1.GetAvailablePackageChangelogRequest) (*v1alpha1.GetAvailablePackageChangelogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageChangelog not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetAvailablePackageValuesDiff(context.Context, *v1alpha1.GetAvailablePackageValuesDiffRequest) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageValuesDiff not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) RenderAvailablePackage(context.Context, *v1alpha1.RenderAvailablePackageRequest) (*v1alpha1.RenderAvailablePackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderAvailablePackage not implemented")
+}
 func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageSummaries(context.Context, *v1alpha1.GetInstalledPackageSummariesRequest) (*v1alpha1.GetInstalledPackageSummariesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageSummaries not implemented")
 }
 func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageDetail(context.Context, *v1alpha1.GetInstalledPackageDetailRequest) (*v1alpha1.GetInstalledPackageDetailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDetail not implemented")
 }
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageValues(context.Context, *v1alpha1.GetInstalledPackageValuesRequest) (*v1alpha1.GetInstalledPackageValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageValues not implemented")
+}
 func (UnimplementedHelmPackagesServiceServer) CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInstalledPackage not implemented")
 }
 func (UnimplementedHelmPackagesServiceServer) UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
 }
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageUpgradeDiff(context.Context, *v1alpha1.GetInstalledPackageUpgradeDiffRequest) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageUpgradeDiff not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageDrift(context.Context, *v1alpha1.GetInstalledPackageDriftRequest) (*v1alpha1.GetInstalledPackageDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDrift not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) ExportInstalledPackage(context.Context, *v1alpha1.ExportInstalledPackageRequest) (*v1alpha1.ExportInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportInstalledPackage not implemented")
+}
 func (UnimplementedHelmPackagesServiceServer) DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
 }
+func (UnimplementedHelmPackagesServiceServer) GetRecentActivity(context.Context, *v1alpha1.GetRecentActivityRequest) (*v1alpha1.GetRecentActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentActivity not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetUserPermissions(context.Context, *v1alpha1.GetUserPermissionsRequest) (*v1alpha1.GetUserPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPermissions not implemented")
+}
 func (UnimplementedHelmPackagesServiceServer) RollbackInstalledPackage(context.Context, *RollbackInstalledPackageRequest) (*RollbackInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RollbackInstalledPackage not implemented")
 }
@@ -249,6 +425,96 @@ func _HelmPackagesService_GetAvailablePackageVersions_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmPackagesService_GetAvailablePackageDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetAvailablePackageDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageDependencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetAvailablePackageDependencies(ctx, req.(*v1alpha1.GetAvailablePackageDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetAvailablePackageDefaultValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageDefaultValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetAvailablePackageDefaultValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageDefaultValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetAvailablePackageDefaultValues(ctx, req.(*v1alpha1.GetAvailablePackageDefaultValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetAvailablePackageChangelog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageChangelogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetAvailablePackageChangelog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageChangelog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetAvailablePackageChangelog(ctx, req.(*v1alpha1.GetAvailablePackageChangelogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetAvailablePackageValuesDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageValuesDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetAvailablePackageValuesDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageValuesDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetAvailablePackageValuesDiff(ctx, req.(*v1alpha1.GetAvailablePackageValuesDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_RenderAvailablePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.RenderAvailablePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).RenderAvailablePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RenderAvailablePackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).RenderAvailablePackage(ctx, req.(*v1alpha1.RenderAvailablePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HelmPackagesService_GetInstalledPackageSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.GetInstalledPackageSummariesRequest)
 	if err := dec(in); err != nil {
@@ -285,6 +551,24 @@ func _HelmPackagesService_GetInstalledPackageDetail_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmPackagesService_GetInstalledPackageValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageValues(ctx, req.(*v1alpha1.GetInstalledPackageValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HelmPackagesService_CreateInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.CreateInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -321,6 +605,60 @@ func _HelmPackagesService_UpdateInstalledPackage_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmPackagesService_GetInstalledPackageUpgradeDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageUpgradeDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageUpgradeDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageUpgradeDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageUpgradeDiff(ctx, req.(*v1alpha1.GetInstalledPackageUpgradeDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetInstalledPackageDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageDrift(ctx, req.(*v1alpha1.GetInstalledPackageDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_ExportInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ExportInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).ExportInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/ExportInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).ExportInstalledPackage(ctx, req.(*v1alpha1.ExportInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HelmPackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.DeleteInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -339,6 +677,42 @@ func _HelmPackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmPackagesService_GetRecentActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetRecentActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetRecentActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetRecentActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetRecentActivity(ctx, req.(*v1alpha1.GetRecentActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetUserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetUserPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetUserPermissions(ctx, req.(*v1alpha1.GetUserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HelmPackagesService_RollbackInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RollbackInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +750,26 @@ var HelmPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailablePackageVersions",
 			Handler:    _HelmPackagesService_GetAvailablePackageVersions_Handler,
 		},
+		{
+			MethodName: "GetAvailablePackageDependencies",
+			Handler:    _HelmPackagesService_GetAvailablePackageDependencies_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageDefaultValues",
+			Handler:    _HelmPackagesService_GetAvailablePackageDefaultValues_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageChangelog",
+			Handler:    _HelmPackagesService_GetAvailablePackageChangelog_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageValuesDiff",
+			Handler:    _HelmPackagesService_GetAvailablePackageValuesDiff_Handler,
+		},
+		{
+			MethodName: "RenderAvailablePackage",
+			Handler:    _HelmPackagesService_RenderAvailablePackage_Handler,
+		},
 		{
 			MethodName: "GetInstalledPackageSummaries",
 			Handler:    _HelmPackagesService_GetInstalledPackageSummaries_Handler,
@@ -384,6 +778,10 @@ var HelmPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstalledPackageDetail",
 			Handler:    _HelmPackagesService_GetInstalledPackageDetail_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageValues",
+			Handler:    _HelmPackagesService_GetInstalledPackageValues_Handler,
+		},
 		{
 			MethodName: "CreateInstalledPackage",
 			Handler:    _HelmPackagesService_CreateInstalledPackage_Handler,
@@ -392,10 +790,30 @@ var HelmPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstalledPackage",
 			Handler:    _HelmPackagesService_UpdateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageUpgradeDiff",
+			Handler:    _HelmPackagesService_GetInstalledPackageUpgradeDiff_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageDrift",
+			Handler:    _HelmPackagesService_GetInstalledPackageDrift_Handler,
+		},
+		{
+			MethodName: "ExportInstalledPackage",
+			Handler:    _HelmPackagesService_ExportInstalledPackage_Handler,
+		},
 		{
 			MethodName: "DeleteInstalledPackage",
 			Handler:    _HelmPackagesService_DeleteInstalledPackage_Handler,
 		},
+		{
+			MethodName: "GetRecentActivity",
+			Handler:    _HelmPackagesService_GetRecentActivity_Handler,
+		},
+		{
+			MethodName: "GetUserPermissions",
+			Handler:    _HelmPackagesService_GetUserPermissions_Handler,
+		},
 		{
 			MethodName: "RollbackInstalledPackage",
 			Handler:    _HelmPackagesService_RollbackInstalledPackage_Handler,
@@ -27,16 +27,38 @@ type KappControllerPackagesServiceClient interface {
 	GetPackageRepositories(ctx context.Context, in *GetPackageRepositoriesRequest, opts ...grpc.CallOption) (*GetPackageRepositoriesResponse, error)
 	// GetAvailablePackageVersions returns the package versions managed by the 'kapp_controller' plugin
 	GetAvailablePackageVersions(ctx context.Context, in *v1alpha1.GetAvailablePackageVersionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageVersionsResponse, error)
+	// GetAvailablePackageDependencies returns the dependencies of a package managed by the 'kapp_controller' plugin
+	GetAvailablePackageDependencies(ctx context.Context, in *v1alpha1.GetAvailablePackageDependenciesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDependenciesResponse, error)
+	// GetAvailablePackageDefaultValues returns the default values of an available package managed by the 'kapp_controller' plugin
+	GetAvailablePackageDefaultValues(ctx context.Context, in *v1alpha1.GetAvailablePackageDefaultValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error)
+	// GetAvailablePackageChangelog returns the changelog between two versions of an available package managed by the 'kapp_controller' plugin
+	GetAvailablePackageChangelog(ctx context.Context, in *v1alpha1.GetAvailablePackageChangelogRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageChangelogResponse, error)
+	// GetAvailablePackageValuesDiff returns the differences between the default values of two versions of an available package managed by the 'kapp_controller' plugin
+	GetAvailablePackageValuesDiff(ctx context.Context, in *v1alpha1.GetAvailablePackageValuesDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error)
+	// RenderAvailablePackage renders the templates of an available package managed by the 'kapp_controller' plugin with the given values
+	RenderAvailablePackage(ctx context.Context, in *v1alpha1.RenderAvailablePackageRequest, opts ...grpc.CallOption) (*v1alpha1.RenderAvailablePackageResponse, error)
 	// GetInstalledPackageSummaries returns the installed packages managed by the 'kapp_controller' plugin
 	GetInstalledPackageSummaries(ctx context.Context, in *v1alpha1.GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageSummariesResponse, error)
 	// GetInstalledPackageDetail returns the requested installed package managed by the 'kapp_controller' plugin
 	GetInstalledPackageDetail(ctx context.Context, in *v1alpha1.GetInstalledPackageDetailRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDetailResponse, error)
+	// GetInstalledPackageValues returns the values currently in effect for an installed package managed by the 'kapp_controller' plugin
+	GetInstalledPackageValues(ctx context.Context, in *v1alpha1.GetInstalledPackageValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageValuesResponse, error)
 	// CreateInstalledPackage creates an installed package based on the request.
 	CreateInstalledPackage(ctx context.Context, in *v1alpha1.CreateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstalledPackageResponse, error)
 	// UpdateInstalledPackage updates an installed package based on the request.
 	UpdateInstalledPackage(ctx context.Context, in *v1alpha1.UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// GetInstalledPackageUpgradeDiff returns the diff of the manifests of an upgrade of an installed package managed by the 'kapp_controller' plugin
+	GetInstalledPackageUpgradeDiff(ctx context.Context, in *v1alpha1.GetInstalledPackageUpgradeDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error)
+	// GetInstalledPackageDrift returns how an installed package managed by the 'kapp_controller' plugin differs from the current state of the cluster
+	GetInstalledPackageDrift(ctx context.Context, in *v1alpha1.GetInstalledPackageDriftRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDriftResponse, error)
+	// ExportInstalledPackage returns a declarative manifest of an installed package managed by the 'kapp_controller' plugin
+	ExportInstalledPackage(ctx context.Context, in *v1alpha1.ExportInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.ExportInstalledPackageResponse, error)
 	// DeleteInstalledPackage deletes an installed package based on the request.
 	DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error)
+	// GetRecentActivity returns the recent operations on the installed packages managed by the 'kapp_controller' plugin
+	GetRecentActivity(ctx context.Context, in *v1alpha1.GetRecentActivityRequest, opts ...grpc.CallOption) (*v1alpha1.GetRecentActivityResponse, error)
+	// GetUserPermissions returns the operations the user can perform on the installed packages managed by the 'kapp_controller' plugin
+	GetUserPermissions(ctx context.Context, in *v1alpha1.GetUserPermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetUserPermissionsResponse, error)
 }
 
 type kappControllerPackagesServiceClient struct {
@@ -83,6 +105,51 @@ func (c *kappControllerPackagesServiceClient) GetAvailablePackageVersions(ctx co
 	return out, nil
 }
 
+func (c *kappControllerPackagesServiceClient) GetAvailablePackageDependencies(ctx context.Context, in *v1alpha1.GetAvailablePackageDependenciesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDependenciesResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageDependenciesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetAvailablePackageDependencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kappControllerPackagesServiceClient) GetAvailablePackageDefaultValues(ctx context.Context, in *v1alpha1.GetAvailablePackageDefaultValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageDefaultValuesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetAvailablePackageDefaultValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kappControllerPackagesServiceClient) GetAvailablePackageChangelog(ctx context.Context, in *v1alpha1.GetAvailablePackageChangelogRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageChangelogResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageChangelogResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetAvailablePackageChangelog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kappControllerPackagesServiceClient) GetAvailablePackageValuesDiff(ctx context.Context, in *v1alpha1.GetAvailablePackageValuesDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error) {
+	out := new(v1alpha1.GetAvailablePackageValuesDiffResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetAvailablePackageValuesDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kappControllerPackagesServiceClient) RenderAvailablePackage(ctx context.Context, in *v1alpha1.RenderAvailablePackageRequest, opts ...grpc.CallOption) (*v1alpha1.RenderAvailablePackageResponse, error) {
+	out := new(v1alpha1.RenderAvailablePackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/RenderAvailablePackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kappControllerPackagesServiceClient) GetInstalledPackageSummaries(ctx context.Context, in *v1alpha1.GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageSummariesResponse, error) {
 	out := new(v1alpha1.GetInstalledPackageSummariesResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetInstalledPackageSummaries", in, out, opts...)
@@ -101,6 +168,15 @@ func (c *kappControllerPackagesServiceClient) GetInstalledPackageDetail(ctx cont
 	return out, nil
 }
 
+func (c *kappControllerPackagesServiceClient) GetInstalledPackageValues(ctx context.Context, in *v1alpha1.GetInstalledPackageValuesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageValuesResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageValuesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetInstalledPackageValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kappControllerPackagesServiceClient) CreateInstalledPackage(ctx context.Context, in *v1alpha1.CreateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstalledPackageResponse, error) {
 	out := new(v1alpha1.CreateInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/CreateInstalledPackage", in, out, opts...)
@@ -119,6 +195,33 @@ func (c *kappControllerPackagesServiceClient) UpdateInstalledPackage(ctx context
 	return out, nil
 }
 
+func (c *kappControllerPackagesServiceClient) GetInstalledPackageUpgradeDiff(ctx context.Context, in *v1alpha1.GetInstalledPackageUpgradeDiffRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageUpgradeDiffResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetInstalledPackageUpgradeDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kappControllerPackagesServiceClient) GetInstalledPackageDrift(ctx context.Context, in *v1alpha1.GetInstalledPackageDriftRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDriftResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageDriftResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetInstalledPackageDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kappControllerPackagesServiceClient) ExportInstalledPackage(ctx context.Context, in *v1alpha1.ExportInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.ExportInstalledPackageResponse, error) {
+	out := new(v1alpha1.ExportInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/ExportInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kappControllerPackagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error) {
 	out := new(v1alpha1.DeleteInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/DeleteInstalledPackage", in, out, opts...)
@@ -128,6 +231,24 @@ func (c *kappControllerPackagesServiceClient) DeleteInstalledPackage(ctx context
 	return out, nil
 }
 
+func (c *kappControllerPackagesServiceClient) GetRecentActivity(ctx context.Context, in *v1alpha1.GetRecentActivityRequest, opts ...grpc.CallOption) (*v1alpha1.GetRecentActivityResponse, error) {
+	out := new(v1alpha1.GetRecentActivityResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetRecentActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kappControllerPackagesServiceClient) GetUserPermissions(ctx context.Context, in *v1alpha1.GetUserPermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetUserPermissionsResponse, error) {
+	out := new(v1alpha1.GetUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetUserPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KappControllerPackagesServiceServer is the server API for KappControllerPackagesService service.
 // All implementations should embed UnimplementedKappControllerPackagesServiceServer
 // for forward compatibility
@@ -140,16 +261,38 @@ type KappControllerPackagesServiceServer interface {
 	GetPackageRepositories(context.Context, *GetPackageRepositoriesRequest) (*GetPackageRepositoriesResponse, error)
 	// GetAvailablePackageVersions returns the package versions managed by the 'kapp_controller' plugin
 	GetAvailablePackageVersions(context.Context, *v1alpha1.GetAvailablePackageVersionsRequest) (*v1alpha1.GetAvailablePackageVersionsResponse, error)
+	// GetAvailablePackageDependencies returns the dependencies of a package managed by the 'kapp_controller' plugin
+	GetAvailablePackageDependencies(context.Context, *v1alpha1.GetAvailablePackageDependenciesRequest) (*v1alpha1.GetAvailablePackageDependenciesResponse, error)
+	// GetAvailablePackageDefaultValues returns the default values of an available package managed by the 'kapp_controller' plugin
+	GetAvailablePackageDefaultValues(context.Context, *v1alpha1.GetAvailablePackageDefaultValuesRequest) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error)
+	// GetAvailablePackageChangelog returns the changelog between two versions of an available package managed by the 'kapp_controller' plugin
+	GetAvailablePackageChangelog(context.Context, *v1alpha1.GetAvailablePackageChangelogRequest) (*v1alpha1.GetAvailablePackageChangelogResponse, error)
+	// GetAvailablePackageValuesDiff returns the differences between the default values of two versions of an available package managed by the 'kapp_controller' plugin
+	GetAvailablePackageValuesDiff(context.Context, *v1alpha1.GetAvailablePackageValuesDiffRequest) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error)
+	// RenderAvailablePackage renders the templates of an available package managed by the 'kapp_controller' plugin with the given values
+	RenderAvailablePackage(context.Context, *v1alpha1.RenderAvailablePackageRequest) (*v1alpha1.RenderAvailablePackageResponse, error)
 	// GetInstalledPackageSummaries returns the installed packages managed by the 'kapp_controller' plugin
 	GetInstalledPackageSummaries(context.Context, *v1alpha1.GetInstalledPackageSummariesRequest) (*v1alpha1.GetInstalledPackageSummariesResponse, error)
 	// GetInstalledPackageDetail returns the requested installed package managed by the 'kapp_controller' plugin
 	GetInstalledPackageDetail(context.Context, *v1alpha1.GetInstalledPackageDetailRequest) (*v1alpha1.GetInstalledPackageDetailResponse, error)
+	// GetInstalledPackageValues returns the values currently in effect for an installed package managed by the 'kapp_controller' plugin
+	GetInstalledPackageValues(context.Context, *v1alpha1.GetInstalledPackageValuesRequest) (*v1alpha1.GetInstalledPackageValuesResponse, error)
 	// CreateInstalledPackage creates an installed package based on the request.
 	CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error)
 	// UpdateInstalledPackage updates an installed package based on the request.
 	UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// GetInstalledPackageUpgradeDiff returns the diff of the manifests of an upgrade of an installed package managed by the 'kapp_controller' plugin
+	GetInstalledPackageUpgradeDiff(context.Context, *v1alpha1.GetInstalledPackageUpgradeDiffRequest) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error)
+	// GetInstalledPackageDrift returns how an installed package managed by the 'kapp_controller' plugin differs from the current state of the cluster
+	GetInstalledPackageDrift(context.Context, *v1alpha1.GetInstalledPackageDriftRequest) (*v1alpha1.GetInstalledPackageDriftResponse, error)
+	// ExportInstalledPackage returns a declarative manifest of an installed package managed by the 'kapp_controller' plugin
+	ExportInstalledPackage(context.Context, *v1alpha1.ExportInstalledPackageRequest) (*v1alpha1.ExportInstalledPackageResponse, error)
 	// DeleteInstalledPackage deletes an installed package based on the request.
 	DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error)
+	// GetRecentActivity returns the recent operations on the installed packages managed by the 'kapp_controller' plugin
+	GetRecentActivity(context.Context, *v1alpha1.GetRecentActivityRequest) (*v1alpha1.GetRecentActivityResponse, error)
+	// GetUserPermissions returns the operations the user can perform on the installed packages managed by the 'kapp_controller' plugin
+	GetUserPermissions(context.Context, *v1alpha1.GetUserPermissionsRequest) (*v1alpha1.GetUserPermissionsResponse, error)
 }
 
 // UnimplementedKappControllerPackagesServiceServer should be embedded to have forward compatible implementations.
@@ -168,21 +311,54 @@ func (UnimplementedKappControllerPackagesServiceServer) GetPackageRepositories(c
 func (UnimplementedKappControllerPackagesServiceServer) GetAvailablePackageVersions(context.Context, *v1alpha1.GetAvailablePackageVersionsRequest) (*v1alpha1.GetAvailablePackageVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageVersions not implemented")
 }
+func (UnimplementedKappControllerPackagesServiceServer) GetAvailablePackageDependencies(context.Context, *v1alpha1.GetAvailablePackageDependenciesRequest) (*v1alpha1.GetAvailablePackageDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageDependencies not implemented")
+}
+func (UnimplementedKappControllerPackagesServiceServer) GetAvailablePackageDefaultValues(context.Context, *v1alpha1.GetAvailablePackageDefaultValuesRequest) (*v1alpha1.GetAvailablePackageDefaultValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageDefaultValues not implemented")
+}
+func (UnimplementedKappControllerPackagesServiceServer) GetAvailablePackageChangelog(context.Context, *v1alpha1.GetAvailablePackageChangelogRequest) (*v1alpha1.GetAvailablePackageChangelogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageChangelog not implemented")
+}
+func (UnimplementedKappControllerPackagesServiceServer) GetAvailablePackageValuesDiff(context.Context, *v1alpha1.GetAvailablePackageValuesDiffRequest) (*v1alpha1.GetAvailablePackageValuesDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailablePackageValuesDiff not implemented")
+}
+func (UnimplementedKappControllerPackagesServiceServer) RenderAvailablePackage(context.Context, *v1alpha1.RenderAvailablePackageRequest) (*v1alpha1.RenderAvailablePackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderAvailablePackage not implemented")
+}
 func (UnimplementedKappControllerPackagesServiceServer) GetInstalledPackageSummaries(context.Context, *v1alpha1.GetInstalledPackageSummariesRequest) (*v1alpha1.GetInstalledPackageSummariesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageSummaries not implemented")
 }
 func (UnimplementedKappControllerPackagesServiceServer) GetInstalledPackageDetail(context.Context, *v1alpha1.GetInstalledPackageDetailRequest) (*v1alpha1.GetInstalledPackageDetailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDetail not implemented")
 }
+func (UnimplementedKappControllerPackagesServiceServer) GetInstalledPackageValues(context.Context, *v1alpha1.GetInstalledPackageValuesRequest) (*v1alpha1.GetInstalledPackageValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageValues not implemented")
+}
 func (UnimplementedKappControllerPackagesServiceServer) CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInstalledPackage not implemented")
 }
 func (UnimplementedKappControllerPackagesServiceServer) UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
 }
+func (UnimplementedKappControllerPackagesServiceServer) GetInstalledPackageUpgradeDiff(context.Context, *v1alpha1.GetInstalledPackageUpgradeDiffRequest) (*v1alpha1.GetInstalledPackageUpgradeDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageUpgradeDiff not implemented")
+}
+func (UnimplementedKappControllerPackagesServiceServer) GetInstalledPackageDrift(context.Context, *v1alpha1.GetInstalledPackageDriftRequest) (*v1alpha1.GetInstalledPackageDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDrift not implemented")
+}
+func (UnimplementedKappControllerPackagesServiceServer) ExportInstalledPackage(context.Context, *v1alpha1.ExportInstalledPackageRequest) (*v1alpha1.ExportInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportInstalledPackage not implemented")
+}
 func (UnimplementedKappControllerPackagesServiceServer) DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
 }
+func (UnimplementedKappControllerPackagesServiceServer) GetRecentActivity(context.Context, *v1alpha1.GetRecentActivityRequest) (*v1alpha1.GetRecentActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentActivity not implemented")
+}
+func (UnimplementedKappControllerPackagesServiceServer) GetUserPermissions(context.Context, *v1alpha1.GetUserPermissionsRequest) (*v1alpha1.GetUserPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPermissions not implemented")
+}
 
 // UnsafeKappControllerPackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to KappControllerPackagesServiceServer will
@@ -267,6 +443,96 @@ func _KappControllerPackagesService_GetAvailablePackageVersions_Handler(srv inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KappControllerPackagesService_GetAvailablePackageDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).GetAvailablePackageDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetAvailablePackageDependencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).GetAvailablePackageDependencies(ctx, req.(*v1alpha1.GetAvailablePackageDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KappControllerPackagesService_GetAvailablePackageDefaultValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageDefaultValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).GetAvailablePackageDefaultValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetAvailablePackageDefaultValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).GetAvailablePackageDefaultValues(ctx, req.(*v1alpha1.GetAvailablePackageDefaultValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KappControllerPackagesService_GetAvailablePackageChangelog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageChangelogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).GetAvailablePackageChangelog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetAvailablePackageChangelog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).GetAvailablePackageChangelog(ctx, req.(*v1alpha1.GetAvailablePackageChangelogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KappControllerPackagesService_GetAvailablePackageValuesDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetAvailablePackageValuesDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).GetAvailablePackageValuesDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetAvailablePackageValuesDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).GetAvailablePackageValuesDiff(ctx, req.(*v1alpha1.GetAvailablePackageValuesDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KappControllerPackagesService_RenderAvailablePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.RenderAvailablePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).RenderAvailablePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/RenderAvailablePackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).RenderAvailablePackage(ctx, req.(*v1alpha1.RenderAvailablePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KappControllerPackagesService_GetInstalledPackageSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.GetInstalledPackageSummariesRequest)
 	if err := dec(in); err != nil {
@@ -303,6 +569,24 @@ func _KappControllerPackagesService_GetInstalledPackageDetail_Handler(srv interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KappControllerPackagesService_GetInstalledPackageValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).GetInstalledPackageValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetInstalledPackageValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).GetInstalledPackageValues(ctx, req.(*v1alpha1.GetInstalledPackageValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KappControllerPackagesService_CreateInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.CreateInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -339,6 +623,60 @@ func _KappControllerPackagesService_UpdateInstalledPackage_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KappControllerPackagesService_GetInstalledPackageUpgradeDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageUpgradeDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).GetInstalledPackageUpgradeDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetInstalledPackageUpgradeDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).GetInstalledPackageUpgradeDiff(ctx, req.(*v1alpha1.GetInstalledPackageUpgradeDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KappControllerPackagesService_GetInstalledPackageDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).GetInstalledPackageDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetInstalledPackageDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).GetInstalledPackageDrift(ctx, req.(*v1alpha1.GetInstalledPackageDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KappControllerPackagesService_ExportInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ExportInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).ExportInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/ExportInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).ExportInstalledPackage(ctx, req.(*v1alpha1.ExportInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KappControllerPackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.DeleteInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -357,6 +695,42 @@ func _KappControllerPackagesService_DeleteInstalledPackage_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KappControllerPackagesService_GetRecentActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetRecentActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).GetRecentActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetRecentActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).GetRecentActivity(ctx, req.(*v1alpha1.GetRecentActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KappControllerPackagesService_GetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetUserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).GetUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetUserPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).GetUserPermissions(ctx, req.(*v1alpha1.GetUserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KappControllerPackagesService_ServiceDesc is the grpc.ServiceDesc for KappControllerPackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -380,6 +754,26 @@ var KappControllerPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailablePackageVersions",
 			Handler:    _KappControllerPackagesService_GetAvailablePackageVersions_Handler,
 		},
+		{
+			MethodName: "GetAvailablePackageDependencies",
+			Handler:    _KappControllerPackagesService_GetAvailablePackageDependencies_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageDefaultValues",
+			Handler:    _KappControllerPackagesService_GetAvailablePackageDefaultValues_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageChangelog",
+			Handler:    _KappControllerPackagesService_GetAvailablePackageChangelog_Handler,
+		},
+		{
+			MethodName: "GetAvailablePackageValuesDiff",
+			Handler:    _KappControllerPackagesService_GetAvailablePackageValuesDiff_Handler,
+		},
+		{
+			MethodName: "RenderAvailablePackage",
+			Handler:    _KappControllerPackagesService_RenderAvailablePackage_Handler,
+		},
 		{
 			MethodName: "GetInstalledPackageSummaries",
 			Handler:    _KappControllerPackagesService_GetInstalledPackageSummaries_Handler,
@@ -388,6 +782,10 @@ var KappControllerPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstalledPackageDetail",
 			Handler:    _KappControllerPackagesService_GetInstalledPackageDetail_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageValues",
+			Handler:    _KappControllerPackagesService_GetInstalledPackageValues_Handler,
+		},
 		{
 			MethodName: "CreateInstalledPackage",
 			Handler:    _KappControllerPackagesService_CreateInstalledPackage_Handler,
@@ -396,10 +794,30 @@ var KappControllerPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstalledPackage",
 			Handler:    _KappControllerPackagesService_UpdateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageUpgradeDiff",
+			Handler:    _KappControllerPackagesService_GetInstalledPackageUpgradeDiff_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageDrift",
+			Handler:    _KappControllerPackagesService_GetInstalledPackageDrift_Handler,
+		},
+		{
+			MethodName: "ExportInstalledPackage",
+			Handler:    _KappControllerPackagesService_ExportInstalledPackage_Handler,
+		},
 		{
 			MethodName: "DeleteInstalledPackage",
 			Handler:    _KappControllerPackagesService_DeleteInstalledPackage_Handler,
 		},
+		{
+			MethodName: "GetRecentActivity",
+			Handler:    _KappControllerPackagesService_GetRecentActivity_Handler,
+		},
+		{
+			MethodName: "GetUserPermissions",
+			Handler:    _KappControllerPackagesService_GetUserPermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/kapp_controller/packages/v1alpha1/kapp_controller.proto",
@@ -14,6 +14,7 @@ package plugin_test
 
 import (
 	"context"
+	"time"
 
 	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
@@ -30,6 +31,9 @@ type TestPackagingPluginServer struct {
 	InstalledPackageSummaries []*corev1.InstalledPackageSummary
 	InstalledPackageDetail    *corev1.InstalledPackageDetail
 	PackageAppVersions        []*corev1.PackageAppVersion
+	PackageDependencies       []*corev1.PackageDependency
+	DefaultValues             map[string]string
+	ModifiedTimes             map[string]time.Time
 	Categories                []string
 	NextPageToken             string
 	Status                    codes.Code
@@ -46,8 +50,21 @@ func (s TestPackagingPluginServer) GetAvailablePackageSummaries(ctx context.Cont
 	if s.Status != codes.OK {
 		return nil, status.Errorf(s.Status, "Non-OK response")
 	}
+	availablePackageSummaries := s.AvailablePackageSummaries
+	if s.ModifiedTimes != nil && request.GetModifiedSince() != "" {
+		modifiedSince, err := time.Parse(time.RFC3339, request.GetModifiedSince())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid modified since: %v", err)
+		}
+		availablePackageSummaries = []*corev1.AvailablePackageSummary{}
+		for _, summary := range s.AvailablePackageSummaries {
+			if s.ModifiedTimes[summary.GetAvailablePackageRef().GetIdentifier()].After(modifiedSince) {
+				availablePackageSummaries = append(availablePackageSummaries, summary)
+			}
+		}
+	}
 	return &packages.GetAvailablePackageSummariesResponse{
-		AvailablePackageSummaries: s.AvailablePackageSummaries,
+		AvailablePackageSummaries: availablePackageSummaries,
 		Categories:                s.Categories,
 		NextPageToken:             s.NextPageToken,
 	}, nil
@@ -94,6 +111,39 @@ func (s TestPackagingPluginServer) GetAvailablePackageVersions(ctx context.Conte
 	}, nil
 }
 
+// GetAvailablePackageDependencies returns the package dependencies based on the request.
+func (s TestPackagingPluginServer) GetAvailablePackageDependencies(ctx context.Context, request *packages.GetAvailablePackageDependenciesRequest) (*packages.GetAvailablePackageDependenciesResponse, error) {
+	if s.Status != codes.OK {
+		return nil, status.Errorf(s.Status, "Non-OK response")
+	}
+	return &packages.GetAvailablePackageDependenciesResponse{
+		PackageDependencies: s.PackageDependencies,
+	}, nil
+}
+
+// GetAvailablePackageDefaultValues returns the default values of the
+// requested version, the first of the package versions by default, or
+// Unimplemented when no default values are set.
+func (s TestPackagingPluginServer) GetAvailablePackageDefaultValues(ctx context.Context, request *packages.GetAvailablePackageDefaultValuesRequest) (*packages.GetAvailablePackageDefaultValuesResponse, error) {
+	if s.Status != codes.OK {
+		return nil, status.Errorf(s.Status, "Non-OK response")
+	}
+	if s.DefaultValues == nil {
+		return s.UnimplementedPackagesServiceServer.GetAvailablePackageDefaultValues(ctx, request)
+	}
+	version := request.GetPkgVersion()
+	if version == "" && len(s.PackageAppVersions) > 0 {
+		version = s.PackageAppVersions[0].GetPkgVersion()
+	}
+	defaultValues, ok := s.DefaultValues[version]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Version %q not found", version)
+	}
+	return &packages.GetAvailablePackageDefaultValuesResponse{
+		DefaultValues: defaultValues,
+	}, nil
+}
+
 func (s TestPackagingPluginServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
 	if s.Status != codes.OK {
 		return nil, status.Errorf(s.Status, "Non-OK response")
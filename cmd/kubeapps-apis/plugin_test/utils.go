@@ -24,6 +24,8 @@ const (
 	DefaultIconURL          = "https://example.com/package.svg"
 	DefaultHomeURL          = "https://example.com/home"
 	DefaultRepoURL          = "https://example.com/repo"
+	DefaultRepoName         = "repo-1"
+	DefaultRepoType         = "helm"
 	DefaultDescription      = "description"
 	DefaultId               = "repo-1/package-id"
 	DefaultNamespace        = "my-namespace-1"
@@ -39,6 +41,7 @@ const (
 	DefaultValues           = "key: value"
 	DefaultMaintainerName   = "me"
 	DefaultMaintainerEmail  = "me@example.com"
+	DefaultSigner           = "Kubeapps Maintainers <signer@example.com>"
 )
 
 var defaultInstalledPackageStatus = &corev1.InstalledPackageStatus{
@@ -63,6 +66,7 @@ func MakeAvailablePackageSummary(name string, plugin *plugins.Plugin) *corev1.Av
 			Identifier: DefaultId,
 			Plugin:     plugin,
 		},
+		Repository: MakePackageRepositoryReference(DefaultRepoName, DefaultNamespace),
 	}
 }
 
@@ -120,6 +124,12 @@ func MakeAvailablePackageDetail(name string, plugin *plugins.Plugin) *corev1.Ava
 	}
 }
 
+func MakeSignedAvailablePackageDetail(name string, plugin *plugins.Plugin) *corev1.AvailablePackageDetail {
+	detail := MakeAvailablePackageDetail(name, plugin)
+	detail.Provenance = MakePackageProvenance(DefaultSigner, corev1.PackageProvenance_VERIFICATION_STATUS_VERIFIED)
+	return detail
+}
+
 func MakeInstalledPackageDetail(name string, plugin *plugins.Plugin) *corev1.InstalledPackageDetail {
 	return &corev1.InstalledPackageDetail{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
@@ -162,3 +172,30 @@ func MakePackageAppVersion(appVersion, pkgVersion string) *corev1.PackageAppVers
 		PkgVersion: pkgVersion,
 	}
 }
+
+func MakePackageDependency(name, version, repository, condition string, dependencies ...*corev1.PackageDependency) *corev1.PackageDependency {
+	return &corev1.PackageDependency{
+		Name:         name,
+		Version:      version,
+		Repository:   repository,
+		Condition:    condition,
+		Dependencies: dependencies,
+	}
+}
+
+func MakePackageProvenance(signer string, verificationStatus corev1.PackageProvenance_VerificationStatus) *corev1.PackageProvenance {
+	return &corev1.PackageProvenance{
+		Signed:             true,
+		Signer:             signer,
+		VerificationStatus: verificationStatus,
+	}
+}
+
+func MakePackageRepositoryReference(name, namespace string) *corev1.PackageRepositoryReference {
+	return &corev1.PackageRepositoryReference{
+		Name:      name,
+		Namespace: namespace,
+		Type:      DefaultRepoType,
+		Url:       DefaultRepoURL,
+	}
+}
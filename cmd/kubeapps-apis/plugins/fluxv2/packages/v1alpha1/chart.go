@@ -383,6 +383,18 @@ func passesFilter(chart models.Chart, filters *corev1.FilterOptions) bool {
 			ok = pkgVersion == chart.ChartVersions[0].Version
 		}
 	}
+	if ok {
+		if maintainer := filters.GetMaintainer(); len(maintainer) > 0 {
+			ok = false
+			maintainer = strings.ToLower(maintainer)
+			for _, m := range chart.Maintainers {
+				if strings.Contains(strings.ToLower(m.Name), maintainer) || strings.Contains(strings.ToLower(m.Email), maintainer) {
+					ok = true
+					break
+				}
+			}
+		}
+	}
 	if ok {
 		if query := filters.GetQuery(); len(query) > 0 {
 			if strings.Contains(chart.Name, query) {
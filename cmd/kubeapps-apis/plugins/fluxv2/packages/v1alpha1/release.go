@@ -186,6 +186,10 @@ func (s *Server) installedPkgSummaryFromRelease(unstructuredRelease map[string]i
 		}
 	}
 
+	// the reference is only informative here, so a release missing the required fields
+	// is still listed, just without it
+	availablePackageRef, _ := installedPackageAvailablePackageRefFromUnstructured(unstructuredRelease)
+
 	return &corev1.InstalledPackageSummary{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
@@ -194,6 +198,7 @@ func (s *Server) installedPkgSummaryFromRelease(unstructuredRelease map[string]i
 			Identifier: name.Name,
 			Plugin:     GetPluginDetail(),
 		},
+		AvailablePackageRef: availablePackageRef,
 		Name:                name.Name,
 		PkgVersionReference: pkgVersion,
 		CurrentVersion:      pkgDetail.GetVersion(),
@@ -268,6 +273,10 @@ func (s *Server) installedPackageDetail(ctx context.Context, name types.Namespac
 		log.Warningf("Failed to get helm release due to %v", err)
 	}
 
+	// the details include all the conditions of the HelmRelease, for troubleshooting
+	pkgStatus := installedPackageStatusFromUnstructured(obj)
+	pkgStatus.Conditions = installedPackageConditionsFromUnstructured(obj)
+
 	return &corev1.InstalledPackageDetail{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
@@ -286,7 +295,7 @@ func (s *Server) installedPackageDetail(ctx context.Context, name types.Namespac
 		ReconciliationOptions: installedPackageReconciliationOptionsFromUnstructured(obj),
 		AvailablePackageRef:   availablePackageRef,
 		PostInstallationNotes: postInstallNotes,
-		Status:                installedPackageStatusFromUnstructured(obj),
+		Status:                pkgStatus,
 	}, nil
 }
 
@@ -324,7 +333,7 @@ func (s *Server) helmReleaseFromUnstructured(ctx context.Context, name types.Nam
 	return release, nil
 }
 
-func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePackageReference, targetName types.NamespacedName, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string) (*corev1.InstalledPackageReference, error) {
+func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePackageReference, targetName types.NamespacedName, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string, labels, annotations map[string]string, installTimeout string) (*corev1.InstalledPackageReference, error) {
 	// HACK: just for now assume HelmRelease CRD will live in the kubeapps namespace
 	kubeappsNamespace := os.Getenv("POD_NAMESPACE")
 	if kubeappsNamespace == "" {
@@ -365,6 +374,22 @@ func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePac
 	if err != nil {
 		return nil, err
 	}
+	// Tag the HelmRelease with the requested labels and annotations, if any.
+	if len(labels) > 0 {
+		fluxHelmRelease.SetLabels(labels)
+	}
+	if len(annotations) > 0 {
+		fluxHelmRelease.SetAnnotations(annotations)
+	}
+	// The timeout of the flux operations, such as the install, when requested.
+	if installTimeout != "" {
+		if _, err := time.ParseDuration(installTimeout); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid install timeout %q: %v", installTimeout, err)
+		}
+		if err := unstructured.SetNestedField(fluxHelmRelease.Object, installTimeout, "spec", "timeout"); err != nil {
+			return nil, err
+		}
+	}
 	newRelease, err := resourceIfc.Create(ctx, fluxHelmRelease, metav1.CreateOptions{})
 	if err != nil {
 		return nil, err
@@ -482,6 +507,43 @@ func (s *Server) deleteRelease(ctx context.Context, packageRef *corev1.Installed
 	return nil
 }
 
+// exportRelease returns the YAML manifest of the HelmRelease of an installed
+// package, without the status and the metadata managed by the cluster, so
+// that it can be committed to git and reconciled from there.
+func (s *Server) exportRelease(ctx context.Context, packageRef *corev1.InstalledPackageReference) (string, error) {
+	name := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: packageRef.Identifier}
+	unstructuredRelease, err := s.getReleaseInCluster(ctx, name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", status.Errorf(codes.NotFound, "%q", err)
+		}
+		return "", status.Errorf(codes.Internal, "%q", err)
+	}
+
+	exported := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	exported.SetAPIVersion(unstructuredRelease.GetAPIVersion())
+	exported.SetKind(unstructuredRelease.GetKind())
+	exported.SetName(unstructuredRelease.GetName())
+	exported.SetNamespace(unstructuredRelease.GetNamespace())
+	exported.SetLabels(unstructuredRelease.GetLabels())
+	annotations := unstructuredRelease.GetAnnotations()
+	delete(annotations, apiv1.LastAppliedConfigAnnotation)
+	if len(annotations) > 0 {
+		exported.SetAnnotations(annotations)
+	}
+	if spec, found, err := unstructured.NestedMap(unstructuredRelease.Object, "spec"); err != nil {
+		return "", status.Errorf(codes.Internal, "Unable to read the spec of the Helm release %q due to: %v", name, err)
+	} else if found {
+		exported.Object["spec"] = spec
+	}
+
+	manifest, err := yaml.Marshal(exported.Object)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "Unable to marshal the Helm release %q due to: %v", name, err)
+	}
+	return string(manifest), nil
+}
+
 // returns 3 things:
 // - ready:  whether the HelmRelease object is in a ready state
 // - reason: one of SUCCESS/FAILURE/PENDING/UNSPECIFIED,
@@ -555,6 +617,36 @@ func installedPackageStatusFromUnstructured(unstructuredRelease map[string]inter
 	}
 }
 
+// installedPackageConditionsFromUnstructured returns the status conditions of
+// a HelmRelease, in the order flux lists them
+func installedPackageConditionsFromUnstructured(unstructuredRelease map[string]interface{}) []*corev1.InstalledPackageCondition {
+	conditions, found, err := unstructured.NestedSlice(unstructuredRelease, "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+	installedPackageConditions := []*corev1.InstalledPackageCondition{}
+	for _, conditionUnstructured := range conditions {
+		conditionAsMap, ok := conditionUnstructured.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		field := func(name string) string {
+			if value, ok := conditionAsMap[name]; ok {
+				return fmt.Sprintf("%v", value)
+			}
+			return ""
+		}
+		installedPackageConditions = append(installedPackageConditions, &corev1.InstalledPackageCondition{
+			Type:               field("type"),
+			Status:             field("status"),
+			Reason:             field("reason"),
+			Message:            field("message"),
+			LastTransitionTime: field("lastTransitionTime"),
+		})
+	}
+	return installedPackageConditions
+}
+
 func installedPackageReconciliationOptionsFromUnstructured(unstructuredRelease map[string]interface{}) *corev1.ReconciliationOptions {
 	reconciliationOptions := &corev1.ReconciliationOptions{}
 	if intervalString, found, err := unstructured.NestedString(unstructuredRelease, "spec", "interval"); found && err == nil {
@@ -419,6 +419,27 @@ func waitUntilInstallCompletes(t *testing.T, fluxPluginClient fluxplugin.FluxV2P
 	if actualResp == nil {
 		t.Fatalf("Timed out waiting for task to complete")
 	}
+
+	// the conditions of a live release (e.g. timestamps, messages) can't be
+	// predicted, so only check the Ready condition agrees with the status
+	// before dropping them from the compared response
+	expectedReady := "True"
+	if expectInstallFailure {
+		expectedReady = "False"
+	}
+	readyCondition := false
+	for _, condition := range actualResp.InstalledPackageDetail.Status.Conditions {
+		if condition.Type == "Ready" {
+			readyCondition = true
+			if got, want := condition.Status, expectedReady; got != want {
+				t.Errorf("got: Ready condition %q, want: %q", got, want)
+			}
+		}
+	}
+	if !readyCondition {
+		t.Errorf("got: no Ready condition, want: one in %+v", actualResp.InstalledPackageDetail.Status.Conditions)
+	}
+	actualResp.InstalledPackageDetail.Status.Conditions = nil
 	return actualResp
 }
 
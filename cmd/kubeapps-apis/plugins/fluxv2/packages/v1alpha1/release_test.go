@@ -36,6 +36,7 @@ import (
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	apiv1 "k8s.io/api/core/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apiextfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -296,6 +297,7 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 				corev1.GetInstalledPackageSummariesResponse{},
 				corev1.InstalledPackageSummary{},
 				corev1.InstalledPackageReference{},
+				corev1.AvailablePackageReference{},
 				corev1.Context{},
 				corev1.VersionReference{},
 				corev1.InstalledPackageStatus{},
@@ -530,6 +532,62 @@ func TestCreateInstalledPackage(t *testing.T) {
 			expectedResponse:   create_installed_package_resp_my_podinfo,
 			expectedRelease:    flux_helm_release_values,
 		},
+		{
+			name: "create package (labels and annotations)",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: availableRef("podinfo/podinfo", "namespace-1"),
+				Name:                "my-podinfo",
+				TargetContext: &corev1.Context{
+					Namespace: "test",
+				},
+				Labels:      map[string]string{"team": "team-a"},
+				Annotations: map[string]string{"example.com/cost-center": "cc-42"},
+			},
+			existingObjs: testSpecCreateInstalledPackage{
+				repoName:      "podinfo",
+				repoNamespace: "namespace-1",
+				repoIndex:     "testdata/podinfo-index.yaml",
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse:   create_installed_package_resp_my_podinfo,
+			expectedRelease:    flux_helm_release_labels_annotations,
+		},
+		{
+			name: "create package (install timeout)",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: availableRef("podinfo/podinfo", "namespace-1"),
+				Name:                "my-podinfo",
+				TargetContext: &corev1.Context{
+					Namespace: "test",
+				},
+				InstallTimeout: "5m",
+			},
+			existingObjs: testSpecCreateInstalledPackage{
+				repoName:      "podinfo",
+				repoNamespace: "namespace-1",
+				repoIndex:     "testdata/podinfo-index.yaml",
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse:   create_installed_package_resp_my_podinfo,
+			expectedRelease:    flux_helm_release_install_timeout,
+		},
+		{
+			name: "create package (malformed install timeout)",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: availableRef("podinfo/podinfo", "namespace-1"),
+				Name:                "my-podinfo",
+				TargetContext: &corev1.Context{
+					Namespace: "test",
+				},
+				InstallTimeout: "five minutes",
+			},
+			existingObjs: testSpecCreateInstalledPackage{
+				repoName:      "podinfo",
+				repoNamespace: "namespace-1",
+				repoIndex:     "testdata/podinfo-index.yaml",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
 	}
 
 	// currently needed for CreateInstalledPackage func
@@ -779,6 +837,110 @@ func TestDeleteInstalledPackage(t *testing.T) {
 	}
 }
 
+func TestExportInstalledPackage(t *testing.T) {
+	releaseSpec := map[string]interface{}{
+		"chart": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"chart":   "redis",
+				"version": "14.4.0",
+				"sourceRef": map[string]interface{}{
+					"name":      "bitnami-1",
+					"kind":      fluxHelmRepository,
+					"namespace": "default",
+				},
+			},
+		},
+		"interval": "1m",
+		"values": map[string]interface{}{
+			"replica": map[string]interface{}{
+				"replicaCount": int64(1),
+			},
+		},
+	}
+	releaseStatus := map[string]interface{}{
+		"lastAppliedRevision": "14.4.0",
+	}
+	helmRelease := newRelease("my-redis", "test", releaseSpec, releaseStatus)
+	helmRelease.SetAnnotations(map[string]string{
+		apiv1.LastAppliedConfigAnnotation: "{}",
+		"team":                            "cache",
+	})
+
+	testCases := []struct {
+		name               string
+		request            *corev1.ExportInstalledPackageRequest
+		expectedStatusCode codes.Code
+		expectedManifest   string
+	}{
+		{
+			name: "exports the helm release without its status",
+			request: &corev1.ExportInstalledPackageRequest{
+				InstalledPackageRef: installedRef("my-redis", "test"),
+			},
+			expectedStatusCode: codes.OK,
+			expectedManifest: `apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  annotations:
+    team: cache
+  name: my-redis
+  namespace: test
+spec:
+  chart:
+    spec:
+      chart: redis
+      sourceRef:
+        kind: HelmRepository
+        name: bitnami-1
+        namespace: default
+      version: 14.4.0
+  interval: 1m
+  values:
+    replica:
+      replicaCount: 1
+`,
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &corev1.ExportInstalledPackageRequest{
+				InstalledPackageRef: installedRef("not-a-valid-identifier", "test"),
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid argument without a namespace",
+			request: &corev1.ExportInstalledPackageRequest{
+				InstalledPackageRef: installedRef("my-redis", ""),
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, _, _, err := newServerWithChartsAndReleases(nil, helmRelease.DeepCopy())
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			response, err := s.ExportInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// We don't need to check anything else for non-OK codes.
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			if got, want := response.GetManifest(), tc.expectedManifest; got != want {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
 func newRuntimeObjects(t *testing.T, existingK8sObjs []testSpecGetInstalledPackages) (runtimeObjs []runtime.Object, cleanup func()) {
 	httpServers := []*httptest.Server{}
 	cleanup = func() {
@@ -870,6 +1032,7 @@ func compareActualVsExpectedGetInstalledPackageDetailResponse(t *testing.T, actu
 		corev1.Context{},
 		corev1.VersionReference{},
 		corev1.InstalledPackageStatus{},
+		corev1.InstalledPackageCondition{},
 		corev1.PackageAppVersion{},
 		plugins.Plugin{},
 		corev1.ReconciliationOptions{},
@@ -1025,10 +1188,33 @@ var (
 		UserReason: "ReconciliationSucceeded: Release reconciliation succeeded",
 	}
 
+	statusInstalledWithConditions = &corev1.InstalledPackageStatus{
+		Ready:      true,
+		Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+		UserReason: "ReconciliationSucceeded: Release reconciliation succeeded",
+		Conditions: []*corev1.InstalledPackageCondition{
+			{
+				Type:               "Ready",
+				Status:             "True",
+				Reason:             "ReconciliationSucceeded",
+				Message:            "Release reconciliation succeeded",
+				LastTransitionTime: "2021-08-11T08:46:03Z",
+			},
+			{
+				Type:               "Released",
+				Status:             "True",
+				Reason:             "InstallSucceeded",
+				Message:            "Helm install succeeded",
+				LastTransitionTime: "2021-08-11T08:46:03Z",
+			},
+		},
+	}
+
 	my_redis_ref = installedRef("my-redis", "namespace-1")
 
 	redis_summary_installed = &corev1.InstalledPackageSummary{
 		InstalledPackageRef: my_redis_ref,
+		AvailablePackageRef: availableRef("bitnami-1/redis", "default"),
 		Name:                "my-redis",
 		IconUrl:             "https://bitnami.com/assets/stacks/redis/img/redis-stack-220x234.png",
 		PkgVersionReference: &corev1.VersionReference{
@@ -1049,6 +1235,7 @@ var (
 
 	redis_summary_failed = &corev1.InstalledPackageSummary{
 		InstalledPackageRef: my_redis_ref,
+		AvailablePackageRef: availableRef("bitnami-1/redis", "default"),
 		Name:                "my-redis",
 		IconUrl:             "https://bitnami.com/assets/stacks/redis/img/redis-stack-220x234.png",
 		PkgVersionReference: &corev1.VersionReference{
@@ -1073,6 +1260,7 @@ var (
 
 	redis_summary_pending = &corev1.InstalledPackageSummary{
 		InstalledPackageRef: my_redis_ref,
+		AvailablePackageRef: availableRef("bitnami-1/redis", "default"),
 		Name:                "my-redis",
 		IconUrl:             "https://bitnami.com/assets/stacks/redis/img/redis-stack-220x234.png",
 		PkgVersionReference: &corev1.VersionReference{
@@ -1097,6 +1285,7 @@ var (
 
 	redis_summary_pending_2 = &corev1.InstalledPackageSummary{
 		InstalledPackageRef: my_redis_ref,
+		AvailablePackageRef: availableRef("bitnami-1/redis", "default"),
 		Name:                "my-redis",
 		IconUrl:             "https://bitnami.com/assets/stacks/redis/img/redis-stack-220x234.png",
 		PkgVersionReference: &corev1.VersionReference{
@@ -1121,6 +1310,7 @@ var (
 
 	airflow_summary_installed = &corev1.InstalledPackageSummary{
 		InstalledPackageRef: installedRef("my-airflow", "namespace-2"),
+		AvailablePackageRef: availableRef("bitnami-2/airflow", "default"),
 		Name:                "my-airflow",
 		IconUrl:             "https://bitnami.com/assets/stacks/airflow/img/airflow-stack-110x117.png",
 		PkgVersionReference: &corev1.VersionReference{
@@ -1141,6 +1331,7 @@ var (
 
 	redis_summary_latest = &corev1.InstalledPackageSummary{
 		InstalledPackageRef: my_redis_ref,
+		AvailablePackageRef: availableRef("bitnami-1/redis", "default"),
 		Name:                "my-redis",
 		IconUrl:             "https://bitnami.com/assets/stacks/redis/img/redis-stack-220x234.png",
 		PkgVersionReference: &corev1.VersionReference{
@@ -1161,6 +1352,7 @@ var (
 
 	airflow_summary_semver = &corev1.InstalledPackageSummary{
 		InstalledPackageRef: installedRef("my-airflow", "namespace-2"),
+		AvailablePackageRef: availableRef("bitnami-2/airflow", "default"),
 		Name:                "my-airflow",
 		IconUrl:             "https://bitnami.com/assets/stacks/airflow/img/airflow-stack-110x117.png",
 		PkgVersionReference: &corev1.VersionReference{
@@ -1476,6 +1668,22 @@ var (
 			Ready:      false,
 			Reason:     corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
 			UserReason: "InstallFailed: install retries exhausted",
+			Conditions: []*corev1.InstalledPackageCondition{
+				{
+					Type:               "Ready",
+					Status:             "False",
+					Reason:             "InstallFailed",
+					Message:            "install retries exhausted",
+					LastTransitionTime: "2021-09-06T10:24:34Z",
+				},
+				{
+					Type:               "Released",
+					Status:             "False",
+					Reason:             "InstallFailed",
+					Message:            "Helm install failed: unable to build kubernetes objects from release manifest: error validating \"\": error validating data: ValidationError(Deployment.spec.replicas): invalid type for io.k8s.api.apps.v1.DeploymentSpec.replicas: got \"string\", expected \"integer\"",
+					LastTransitionTime: "2021-09-06T10:24:34Z",
+				},
+			},
 		},
 		AvailablePackageRef:   availableRef("bitnami-1/redis", "default"),
 		PostInstallationNotes: "some notes",
@@ -1498,6 +1706,15 @@ var (
 			Ready:      false,
 			Reason:     corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
 			UserReason: "Progressing: reconciliation in progress",
+			Conditions: []*corev1.InstalledPackageCondition{
+				{
+					Type:               "Ready",
+					Status:             "Unknown",
+					Reason:             "Progressing",
+					Message:            "reconciliation in progress",
+					LastTransitionTime: "2021-08-11T08:46:03Z",
+				},
+			},
 		},
 		AvailablePackageRef:   availableRef("bitnami-1/redis", "default"),
 		PostInstallationNotes: "some notes",
@@ -1516,7 +1733,7 @@ var (
 		ReconciliationOptions: &corev1.ReconciliationOptions{
 			Interval: 60,
 		},
-		Status:                statusInstalled,
+		Status:                statusInstalledWithConditions,
 		AvailablePackageRef:   availableRef("bitnami-1/redis", "default"),
 		PostInstallationNotes: "some notes",
 	}
@@ -1536,7 +1753,7 @@ var (
 			Suspend:            true,
 			ServiceAccountName: "foo",
 		},
-		Status:                statusInstalled,
+		Status:                statusInstalledWithConditions,
 		ValuesApplied:         "{\"replica\":[{\"configuration\":\"xyz\",\"replicaCount\":\"1\"}]}",
 		AvailablePackageRef:   availableRef("bitnami-1/redis", "default"),
 		PostInstallationNotes: "some notes",
@@ -1652,6 +1869,65 @@ var (
 		},
 	}
 
+	flux_helm_release_labels_annotations = map[string]interface{}{
+		"apiVersion": "helm.toolkit.fluxcd.io/v2beta1",
+		"kind":       "HelmRelease",
+		"metadata": map[string]interface{}{
+			"name":      "my-podinfo",
+			"namespace": "kubeapps",
+			"labels": map[string]interface{}{
+				"team": "team-a",
+			},
+			"annotations": map[string]interface{}{
+				"example.com/cost-center": "cc-42",
+			},
+		},
+		"spec": map[string]interface{}{
+			"chart": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"chart": "podinfo",
+					"sourceRef": map[string]interface{}{
+						"kind":      "HelmRepository",
+						"name":      "podinfo",
+						"namespace": "namespace-1",
+					},
+				},
+			},
+			"install": map[string]interface{}{
+				"createNamespace": true,
+			},
+			"interval":        "1m",
+			"targetNamespace": "test",
+		},
+	}
+
+	flux_helm_release_install_timeout = map[string]interface{}{
+		"apiVersion": "helm.toolkit.fluxcd.io/v2beta1",
+		"kind":       "HelmRelease",
+		"metadata": map[string]interface{}{
+			"name":      "my-podinfo",
+			"namespace": "kubeapps",
+		},
+		"spec": map[string]interface{}{
+			"chart": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"chart": "podinfo",
+					"sourceRef": map[string]interface{}{
+						"kind":      "HelmRepository",
+						"name":      "podinfo",
+						"namespace": "namespace-1",
+					},
+				},
+			},
+			"install": map[string]interface{}{
+				"createNamespace": true,
+			},
+			"interval":        "1m",
+			"targetNamespace": "test",
+			"timeout":         "5m",
+		},
+	}
+
 	create_installed_package_resp_my_podinfo = &corev1.CreateInstalledPackageResponse{
 		InstalledPackageRef: installedRef("my-podinfo", "kubeapps"),
 	}
@@ -308,6 +308,46 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{},
 			},
 		},
+		{
+			name: "uses a filter based on existing maintainer email, case-insensitively",
+			repos: []testSpecGetAvailablePackageSummaries{
+				{
+					name:      "index-with-categories-1",
+					namespace: "default",
+					url:       "https://example.repo.com/charts",
+					index:     "testdata/index-with-categories.yaml",
+				},
+			},
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{Namespace: "blah"},
+				FilterOptions: &corev1.FilterOptions{
+					Maintainer: "BITNAMI.COM",
+				},
+			},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: index_with_categories_summaries,
+			},
+		},
+		{
+			name: "uses a filter based on non-existing maintainer",
+			repos: []testSpecGetAvailablePackageSummaries{
+				{
+					name:      "index-with-categories-1",
+					namespace: "default",
+					url:       "https://example.repo.com/charts",
+					index:     "testdata/index-with-categories.yaml",
+				},
+			},
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{Namespace: "blah"},
+				FilterOptions: &corev1.FilterOptions{
+					Maintainer: "globex",
+				},
+			},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{},
+			},
+		},
 		{
 			name: "uses a filter based on existing query text (chart name)",
 			repos: []testSpecGetAvailablePackageSummaries{
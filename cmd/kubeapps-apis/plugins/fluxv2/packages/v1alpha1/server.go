@@ -34,8 +34,9 @@ import (
 	log "k8s.io/klog/v2"
 )
 
-// Compile-time statement to ensure this service implementation satisfies the core packaging API
-var _ corev1.PackagesServiceServer = (*Server)(nil)
+// Compile-time statement to ensure this service implementation satisfies the part of the core
+// packaging API implemented by the plugins
+var _ server.PackagesPluginServer = (*Server)(nil)
 
 type clientGetter func(context.Context) (dynamic.Interface, apiext.Interface, error)
 type helmActionConfigGetter func(ctx context.Context, namespace string) (*action.Configuration, error)
@@ -190,6 +191,7 @@ func (s *Server) GetPackageRepositories(ctx context.Context, request *v1alpha1.G
 // state. For the fluxv2 plugin, the request context namespace (the target
 // namespace) is not relevant since charts from a repository in any namespace
 //  accessible to the user are available to be installed in the target namespace.
+// The request ModifiedSince is currently ignored, all the packages being returned.
 func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *corev1.GetAvailablePackageSummariesRequest) (*corev1.GetAvailablePackageSummariesResponse, error) {
 	log.Infof("+fluxv2 GetAvailablePackageSummaries(request: [%v])", request)
 
@@ -399,6 +401,31 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 	}, nil
 }
 
+// ExportInstalledPackage returns the HelmRelease of an installed package as a
+// YAML manifest, to be committed to git.
+func (s *Server) ExportInstalledPackage(ctx context.Context, request *corev1.ExportInstalledPackageRequest) (*corev1.ExportInstalledPackageResponse, error) {
+	log.Infof("+fluxv2 ExportInstalledPackage [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+
+	packageRef := request.InstalledPackageRef
+	// flux CRDs require a namespace, cluster-wide resources are not supported
+	if packageRef.Context == nil || len(packageRef.Context.Namespace) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "InstalledPackageReference is missing required 'namespace' field")
+	}
+
+	manifest, err := s.exportRelease(ctx, packageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ExportInstalledPackageResponse{
+		Manifest: manifest,
+	}, nil
+}
+
 // CreateInstalledPackage creates an installed package based on the request.
 func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.CreateInstalledPackageRequest) (*corev1.CreateInstalledPackageResponse, error) {
 	log.Infof("+fluxv2 CreateInstalledPackage [%v]", request)
@@ -427,7 +454,10 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 		name,
 		request.PkgVersionReference,
 		request.ReconciliationOptions,
-		request.Values); err != nil {
+		request.Values,
+		request.Labels,
+		request.Annotations,
+		request.InstallTimeout); err != nil {
 		return nil, err
 	} else {
 		return &corev1.CreateInstalledPackageResponse{
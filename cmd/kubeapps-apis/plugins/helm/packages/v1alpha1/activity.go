@@ -0,0 +1,143 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/release"
+	log "k8s.io/klog/v2"
+)
+
+// GetRecentActivity returns an activity for each revision stored in the
+// history of the Helm releases in the requested namespace, or in all the
+// namespaces if none is given, most recent first. When paginated, only the
+// requested page of activities is returned, its page token being the number
+// of activities before it, so that the core server can merge the pages with
+// the ones of the other plugins.
+func (s *Server) GetRecentActivity(ctx context.Context, request *corev1.GetRecentActivityRequest) (*corev1.GetRecentActivityResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
+	log.Infof("+helm GetRecentActivity %s", contextMsg)
+
+	offset, err := pageOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to intepret page token %q: %v", request.GetPaginationOptions().GetPageToken(), err)
+	}
+
+	actionConfig, err := s.actionConfigGetter(ctx, request.GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+	// The storage can't be queried by time, so all the revisions are listed
+	// but only the ones of the requested page are returned.
+	releases, err := actionConfig.Releases.ListReleases()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to list the Helm releases: %v", err)
+	}
+
+	cluster := request.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	activities := make([]*corev1.PackageActivity, 0, len(releases))
+	versions := make(map[*corev1.PackageActivity]int, len(releases))
+	for _, rel := range releases {
+		if namespace := request.GetContext().GetNamespace(); namespace != "" && rel.Namespace != namespace {
+			continue
+		}
+		activity := activityFromRelease(rel, cluster)
+		versions[activity] = rel.Version
+		activities = append(activities, activity)
+	}
+
+	// Order by time, most recent first, then by namespace, name and the most
+	// recent revision first so that the pages are stable across calls.
+	sort.SliceStable(activities, func(i, j int) bool {
+		a, b := activities[i], activities[j]
+		if a.GetTime() != b.GetTime() {
+			return a.GetTime() > b.GetTime()
+		}
+		if na, nb := a.GetInstalledPackageRef().GetContext().GetNamespace(), b.GetInstalledPackageRef().GetContext().GetNamespace(); na != nb {
+			return na < nb
+		}
+		if a.GetName() != b.GetName() {
+			return a.GetName() < b.GetName()
+		}
+		return versions[a] > versions[b]
+	})
+
+	response := &corev1.GetRecentActivityResponse{
+		Activities: []*corev1.PackageActivity{},
+	}
+	if offset < len(activities) {
+		activities = activities[offset:]
+		if pageSize := int(request.GetPaginationOptions().GetPageSize()); pageSize > 0 && pageSize < len(activities) {
+			activities = activities[:pageSize]
+			response.NextPageToken = fmt.Sprintf("%d", offset+pageSize)
+		}
+		response.Activities = activities
+	}
+	return response, nil
+}
+
+// activityFromRelease returns the activity which created the given revision
+// of a release. Helm does not record the operation itself, so it is inferred
+// from the status, description and version of the revision.
+func activityFromRelease(rel *release.Release, cluster string) *corev1.PackageActivity {
+	activity := &corev1.PackageActivity{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   cluster,
+				Namespace: rel.Namespace,
+			},
+			Identifier: rel.Name,
+		},
+		Name: rel.Name,
+	}
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		activity.PkgVersionReference = &corev1.PackageAppVersion{
+			PkgVersion: rel.Chart.Metadata.Version,
+			AppVersion: rel.Chart.Metadata.AppVersion,
+		}
+	}
+	if rel.Info == nil {
+		return activity
+	}
+	activity.Description = rel.Info.Description
+
+	at := rel.Info.LastDeployed.Time
+	switch {
+	case rel.Info.Status == release.StatusUninstalled || rel.Info.Status == release.StatusUninstalling:
+		activity.Operation = corev1.PackageActivity_OPERATION_DELETE
+		if !rel.Info.Deleted.IsZero() {
+			at = rel.Info.Deleted.Time
+		}
+	case strings.HasPrefix(rel.Info.Description, "Rollback"):
+		activity.Operation = corev1.PackageActivity_OPERATION_ROLLBACK
+	case rel.Version <= 1:
+		activity.Operation = corev1.PackageActivity_OPERATION_INSTALL
+	default:
+		activity.Operation = corev1.PackageActivity_OPERATION_UPDATE
+	}
+	if !at.IsZero() {
+		activity.Time = at.UTC().Format(time.RFC3339)
+	}
+	return activity
+}
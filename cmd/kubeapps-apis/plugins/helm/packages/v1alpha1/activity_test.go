@@ -0,0 +1,183 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestGetRecentActivity(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2022, time.January, d, 10, 0, 0, 0, time.UTC)
+	}
+	existingReleases := []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			version:      1,
+			chartVersion: "1.18.2",
+			status:       release.StatusSuperseded,
+			description:  "Install complete",
+			lastDeployed: day(1),
+		},
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			version:      2,
+			chartVersion: "1.18.3",
+			status:       release.StatusSuperseded,
+			description:  "Upgrade complete",
+			lastDeployed: day(2),
+		},
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			version:      3,
+			chartVersion: "1.18.2",
+			status:       release.StatusDeployed,
+			description:  "Rollback to 1",
+			lastDeployed: day(3),
+		},
+		{
+			name:         "my-redis",
+			namespace:    "other-ns",
+			version:      1,
+			chartVersion: "15.0.0",
+			status:       release.StatusUninstalled,
+			description:  "Uninstallation complete",
+			lastDeployed: day(4),
+		},
+	}
+	activity := func(namespace, name, version string, operation corev1.PackageActivity_Operation, description string, at time.Time) *corev1.PackageActivity {
+		return &corev1.PackageActivity{
+			InstalledPackageRef: &corev1.InstalledPackageReference{
+				Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: namespace},
+				Identifier: name,
+			},
+			Name:                name,
+			Operation:           operation,
+			PkgVersionReference: &corev1.PackageAppVersion{PkgVersion: version, AppVersion: DefaultAppVersion},
+			Time:                at.Format(time.RFC3339),
+			Description:         description,
+		}
+	}
+
+	testCases := []struct {
+		name                  string
+		request               *corev1.GetRecentActivityRequest
+		expectedStatusCode    codes.Code
+		expectedActivities    []*corev1.PackageActivity
+		expectedNextPageToken string
+	}{
+		{
+			name: "returns an activity for each revision of the releases in all namespaces",
+			request: &corev1.GetRecentActivityRequest{
+				Context: &corev1.Context{},
+			},
+			expectedStatusCode: codes.OK,
+			expectedActivities: []*corev1.PackageActivity{
+				activity("other-ns", "my-redis", "15.0.0", corev1.PackageActivity_OPERATION_DELETE, "Uninstallation complete", day(4)),
+				activity("default", "my-apache", "1.18.2", corev1.PackageActivity_OPERATION_ROLLBACK, "Rollback to 1", day(3)),
+				activity("default", "my-apache", "1.18.3", corev1.PackageActivity_OPERATION_UPDATE, "Upgrade complete", day(2)),
+				activity("default", "my-apache", "1.18.2", corev1.PackageActivity_OPERATION_INSTALL, "Install complete", day(1)),
+			},
+		},
+		{
+			name: "returns only the activity of the requested namespace",
+			request: &corev1.GetRecentActivityRequest{
+				Context: &corev1.Context{Namespace: "other-ns"},
+			},
+			expectedStatusCode: codes.OK,
+			expectedActivities: []*corev1.PackageActivity{
+				activity("other-ns", "my-redis", "15.0.0", corev1.PackageActivity_OPERATION_DELETE, "Uninstallation complete", day(4)),
+			},
+		},
+		{
+			name: "returns the first page of the activities, most recent first",
+			request: &corev1.GetRecentActivityRequest{
+				Context:           &corev1.Context{},
+				PaginationOptions: &corev1.PaginationOptions{PageSize: 2},
+			},
+			expectedStatusCode: codes.OK,
+			expectedActivities: []*corev1.PackageActivity{
+				activity("other-ns", "my-redis", "15.0.0", corev1.PackageActivity_OPERATION_DELETE, "Uninstallation complete", day(4)),
+				activity("default", "my-apache", "1.18.2", corev1.PackageActivity_OPERATION_ROLLBACK, "Rollback to 1", day(3)),
+			},
+			expectedNextPageToken: "2",
+		},
+		{
+			name: "returns the last page of the activities without a next page token",
+			request: &corev1.GetRecentActivityRequest{
+				Context:           &corev1.Context{},
+				PaginationOptions: &corev1.PaginationOptions{PageSize: 2, PageToken: "2"},
+			},
+			expectedStatusCode: codes.OK,
+			expectedActivities: []*corev1.PackageActivity{
+				activity("default", "my-apache", "1.18.3", corev1.PackageActivity_OPERATION_UPDATE, "Upgrade complete", day(2)),
+				activity("default", "my-apache", "1.18.2", corev1.PackageActivity_OPERATION_INSTALL, "Install complete", day(1)),
+			},
+		},
+		{
+			name: "returns no activities beyond the last page",
+			request: &corev1.GetRecentActivityRequest{
+				Context:           &corev1.Context{},
+				PaginationOptions: &corev1.PaginationOptions{PageSize: 2, PageToken: "4"},
+			},
+			expectedStatusCode: codes.OK,
+			expectedActivities: []*corev1.PackageActivity{},
+		},
+		{
+			name: "returns invalid argument for an invalid page token",
+			request: &corev1.GetRecentActivityRequest{
+				Context:           &corev1.Context{},
+				PaginationOptions: &corev1.PaginationOptions{PageSize: 2, PageToken: "not-a-number"},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, tc.request.GetContext().GetNamespace(), existingReleases)
+			server, _, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+
+			response, err := server.GetRecentActivity(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			opts := cmpopts.IgnoreUnexported(corev1.PackageActivity{}, corev1.InstalledPackageReference{}, corev1.Context{}, corev1.PackageAppVersion{})
+			if got, want := response.GetActivities(), tc.expectedActivities; !cmp.Equal(got, want, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+			if got, want := response.GetNextPageToken(), tc.expectedNextPageToken; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
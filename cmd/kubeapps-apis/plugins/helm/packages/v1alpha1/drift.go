@@ -0,0 +1,202 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	log "k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// GetInstalledPackageDrift returns how the resources of the release manifest
+// differ from the ones currently in the cluster. Only the fields set in the
+// manifest are compared, since the other ones are defaulted or managed by the
+// cluster.
+func (s *Server) GetInstalledPackageDrift(ctx context.Context, request *corev1.GetInstalledPackageDriftRequest) (*corev1.GetInstalledPackageDriftResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+helm GetInstalledPackageDrift %s", contextMsg)
+
+	namespace := request.GetInstalledPackageRef().GetContext().GetNamespace()
+	identifier := request.GetInstalledPackageRef().GetIdentifier()
+
+	actionConfig, err := s.actionConfigGetter(ctx, request.GetInstalledPackageRef().GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+	release, err := action.NewGet(actionConfig).Run(identifier)
+	if err != nil {
+		if err == driver.ErrReleaseNotFound {
+			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", identifier, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to run Helm get action: %v", err)
+	}
+
+	cluster := request.GetInstalledPackageRef().GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	typedClient, dynamicClient, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	groupResources, err := restmapper.GetAPIGroupResources(typedClient.Discovery())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to discover the resources of the cluster: %v", err)
+	}
+
+	resources, err := releaseDrift(ctx, dynamicClient, restmapper.NewDiscoveryRESTMapper(groupResources), release)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to compare the release with the cluster: %v", err)
+	}
+	return &corev1.GetInstalledPackageDriftResponse{
+		Drifted:   len(resources) > 0,
+		Resources: resources,
+	}, nil
+}
+
+// releaseDrift returns the resources of the release manifest, in the manifest
+// order, which are missing from the cluster or have fields with a different
+// value in the cluster.
+func releaseDrift(ctx context.Context, client dynamic.Interface, mapper meta.RESTMapper, r *release.Release) ([]*corev1.ResourceDrift, error) {
+	manifests := releaseutil.SplitManifests(r.Manifest)
+	keys := make([]string, 0, len(manifests))
+	for key := range manifests {
+		keys = append(keys, key)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+
+	drifts := []*corev1.ResourceDrift{}
+	for _, key := range keys {
+		expected := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(manifests[key]), &expected); err != nil {
+			return nil, err
+		}
+		if len(expected) == 0 {
+			continue
+		}
+		resource := &unstructured.Unstructured{Object: expected}
+		drift := &corev1.ResourceDrift{
+			ApiVersion: resource.GetAPIVersion(),
+			Kind:       resource.GetKind(),
+			Name:       resource.GetName(),
+		}
+
+		gvk := resource.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			// The kind is no longer served, eg. when its CRD was deleted.
+			if meta.IsNoMatchError(err) {
+				drift.Missing = true
+				drifts = append(drifts, drift)
+				continue
+			}
+			return nil, err
+		}
+		var resourceClient dynamic.ResourceInterface = client.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			drift.Namespace = resource.GetNamespace()
+			if drift.Namespace == "" {
+				drift.Namespace = r.Namespace
+			}
+			resourceClient = client.Resource(mapping.Resource).Namespace(drift.Namespace)
+		}
+
+		live, err := resourceClient.Get(ctx, resource.GetName(), metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				drift.Missing = true
+				drifts = append(drifts, drift)
+				continue
+			}
+			return nil, err
+		}
+		drift.Fields = fieldDrifts("", expected, live.Object)
+		if len(drift.Fields) > 0 {
+			drifts = append(drifts, drift)
+		}
+	}
+	return drifts, nil
+}
+
+// fieldDrifts compares the fields set in the expected value with the actual
+// one, recursing into the maps and the lists of the same length, and returns
+// the fields with a different value.
+func fieldDrifts(path string, expected, actual interface{}) []*corev1.FieldDrift {
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		if actualValue, ok := actual.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(expectedValue))
+			for key := range expectedValue {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			drifts := []*corev1.FieldDrift{}
+			for _, key := range keys {
+				fieldPath := key
+				if path != "" {
+					fieldPath = path + "." + key
+				}
+				drifts = append(drifts, fieldDrifts(fieldPath, expectedValue[key], actualValue[key])...)
+			}
+			return drifts
+		}
+	case []interface{}:
+		if actualValue, ok := actual.([]interface{}); ok && len(actualValue) == len(expectedValue) {
+			drifts := []*corev1.FieldDrift{}
+			for i := range expectedValue {
+				drifts = append(drifts, fieldDrifts(fmt.Sprintf("%s[%d]", path, i), expectedValue[i], actualValue[i])...)
+			}
+			return drifts
+		}
+	default:
+		// The numbers are compared as JSON, since those of the manifest are
+		// unmarshalled as floats while those of the cluster are integers.
+		if jsonValue(expected) == jsonValue(actual) {
+			return nil
+		}
+	}
+	return []*corev1.FieldDrift{{
+		Path:     path,
+		Expected: jsonValue(expected),
+		Actual:   jsonValue(actual),
+	}}
+}
+
+// jsonValue returns the value as JSON, or an empty string when unset.
+func jsonValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
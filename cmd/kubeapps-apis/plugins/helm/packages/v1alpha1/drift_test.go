@@ -0,0 +1,238 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	typfake "k8s.io/client-go/kubernetes/fake"
+)
+
+const driftReleaseManifest = `
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-apache-config
+data:
+  httpd.conf: Listen 8080
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-apache
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - name: apache
+        image: bitnami/apache:2.4.48
+`
+
+// liveDeployment returns the deployment of the release as in the cluster,
+// with the fields defaulted or managed by the cluster.
+func liveDeployment(replicas int64, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":            "my-apache",
+			"namespace":       "default",
+			"resourceVersion": "1234",
+		},
+		"spec": map[string]interface{}{
+			"replicas":             replicas,
+			"revisionHistoryLimit": int64(10),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":                   "apache",
+							"image":                  image,
+							"terminationMessagePath": "/dev/termination-log",
+						},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{
+			"readyReplicas": replicas,
+		},
+	}}
+}
+
+func liveConfigMap() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "my-apache-config",
+			"namespace": "default",
+		},
+		"data": map[string]interface{}{
+			"httpd.conf": "Listen 8080",
+		},
+	}}
+}
+
+func TestGetInstalledPackageDrift(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		existingObjects    []runtime.Object
+		expectedResponse   *corev1.GetInstalledPackageDriftResponse
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "returns no drift when the cluster matches the release",
+			existingReleases: []releaseStub{
+				{
+					name:      "my-apache",
+					namespace: "default",
+					status:    release.StatusDeployed,
+					manifest:  driftReleaseManifest,
+				},
+			},
+			existingObjects: []runtime.Object{
+				liveConfigMap(),
+				liveDeployment(3, "bitnami/apache:2.4.48"),
+			},
+			expectedResponse: &corev1.GetInstalledPackageDriftResponse{
+				Drifted:   false,
+				Resources: []*corev1.ResourceDrift{},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns the drifted fields and missing resources",
+			existingReleases: []releaseStub{
+				{
+					name:      "my-apache",
+					namespace: "default",
+					status:    release.StatusDeployed,
+					manifest:  driftReleaseManifest,
+				},
+			},
+			existingObjects: []runtime.Object{
+				liveDeployment(1, "bitnami/apache:2.4.49"),
+			},
+			expectedResponse: &corev1.GetInstalledPackageDriftResponse{
+				Drifted: true,
+				Resources: []*corev1.ResourceDrift{
+					{
+						ApiVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "my-apache-config",
+						Namespace:  "default",
+						Missing:    true,
+					},
+					{
+						ApiVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-apache",
+						Namespace:  "default",
+						Fields: []*corev1.FieldDrift{
+							{
+								Path:     "spec.replicas",
+								Expected: "3",
+								Actual:   "1",
+							},
+							{
+								Path:     "spec.template.spec.containers[0].image",
+								Expected: `"bitnami/apache:2.4.48"`,
+								Actual:   `"bitnami/apache:2.4.49"`,
+							},
+						},
+					},
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "returns not found if the installed package doesn't exist",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, "default", tc.existingReleases)
+			server, _, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+
+			clientSet := typfake.NewSimpleClientset()
+			clientSet.Resources = []*metav1.APIResourceList{
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{
+						{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+					},
+				},
+				{
+					GroupVersion: "apps/v1",
+					APIResources: []metav1.APIResource{
+						{Name: "deployments", Kind: "Deployment", Namespaced: true},
+					},
+				},
+			}
+			dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+				runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Version: "v1", Resource: "configmaps"}:                 "ConfigMapList",
+					{Group: "apps", Version: "v1", Resource: "deployments"}: "DeploymentList",
+				},
+				tc.existingObjects...,
+			)
+			server.clientGetter = func(context.Context, string) (kubernetes.Interface, dynamic.Interface, error) {
+				return clientSet, dynamicClient, nil
+			}
+
+			response, err := server.GetInstalledPackageDrift(context.Background(), &corev1.GetInstalledPackageDriftRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			})
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			opts := cmpopts.IgnoreUnexported(corev1.GetInstalledPackageDriftResponse{}, corev1.ResourceDrift{}, corev1.FieldDrift{})
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
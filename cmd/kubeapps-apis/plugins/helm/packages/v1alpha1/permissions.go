@@ -0,0 +1,81 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	log "k8s.io/klog/v2"
+)
+
+// GetUserPermissions returns the operations the user can perform on the Helm
+// releases of the requested namespace. As Helm stores the releases as
+// secrets, a release can be installed by a user allowed to create the
+// secrets, updated by a user also allowed to update them and deleted by a
+// user allowed to delete them.
+func (s *Server) GetUserPermissions(ctx context.Context, request *corev1.GetUserPermissionsRequest) (*corev1.GetUserPermissionsResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
+	log.Infof("+helm GetUserPermissions %s", contextMsg)
+
+	namespace := request.GetContext().GetNamespace()
+	if namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "A namespace is required to check the permissions of the user")
+	}
+	cluster := request.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	client, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := map[string]bool{}
+	for _, verb := range []string{"create", "update", "delete"} {
+		allowed[verb], err = canAccessReleaseSecrets(ctx, client, namespace, verb)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &corev1.GetUserPermissionsResponse{
+		CanInstall: allowed["create"],
+		CanUpdate:  allowed["create"] && allowed["update"],
+		CanDelete:  allowed["delete"],
+	}, nil
+}
+
+// canAccessReleaseSecrets returns whether the user is allowed to perform the
+// verb on the secrets storing the Helm releases of the namespace.
+func canAccessReleaseSecrets(ctx context.Context, client kubernetes.Interface, namespace, verb string) (bool, error) {
+	res, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:     "",
+				Resource:  "secrets",
+				Verb:      verb,
+				Namespace: namespace,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "Unable to check if the user can %s the releases: %v", verb, err)
+	}
+	return res.Status.Allowed, nil
+}
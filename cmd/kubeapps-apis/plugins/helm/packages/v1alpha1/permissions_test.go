@@ -0,0 +1,112 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestGetUserPermissions(t *testing.T) {
+	testCases := []struct {
+		name             string
+		namespace        string
+		allowedVerbs     []string
+		statusCode       codes.Code
+		expectedResponse *corev1.GetUserPermissionsResponse
+	}{
+		{
+			name:             "it returns all the permissions for a user allowed to manage the secrets",
+			namespace:        "default",
+			allowedVerbs:     []string{"create", "update", "delete"},
+			statusCode:       codes.OK,
+			expectedResponse: &corev1.GetUserPermissionsResponse{CanInstall: true, CanUpdate: true, CanDelete: true},
+		},
+		{
+			name:             "it does not allow to update without being allowed to create the secrets",
+			namespace:        "default",
+			allowedVerbs:     []string{"update", "delete"},
+			statusCode:       codes.OK,
+			expectedResponse: &corev1.GetUserPermissionsResponse{CanDelete: true},
+		},
+		{
+			name:             "it only allows to install for a user allowed to create the secrets",
+			namespace:        "default",
+			allowedVerbs:     []string{"create"},
+			statusCode:       codes.OK,
+			expectedResponse: &corev1.GetUserPermissionsResponse{CanInstall: true},
+		},
+		{
+			name:             "it returns no permissions for a user not allowed to manage the secrets",
+			namespace:        "default",
+			statusCode:       codes.OK,
+			expectedResponse: &corev1.GetUserPermissionsResponse{},
+		},
+		{
+			name:       "it returns invalid argument without namespace",
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientSet := typfake.NewSimpleClientset()
+			clientSet.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				allowed := false
+				for _, verb := range tc.allowedVerbs {
+					if review.Spec.ResourceAttributes.Verb == verb && review.Spec.ResourceAttributes.Resource == "secrets" {
+						allowed = true
+					}
+				}
+				return true, &authorizationv1.SelfSubjectAccessReview{
+					Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+				}, nil
+			})
+			server := &Server{
+				clientGetter: func(context.Context, string) (kubernetes.Interface, dynamic.Interface, error) {
+					return clientSet, nil, nil
+				},
+				globalPackagingCluster: globalPackagingCluster,
+			}
+
+			response, err := server.GetUserPermissions(context.Background(), &corev1.GetUserPermissionsRequest{
+				Context: &corev1.Context{Namespace: tc.namespace},
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+			opts := cmpopts.IgnoreUnexported(corev1.GetUserPermissionsResponse{})
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
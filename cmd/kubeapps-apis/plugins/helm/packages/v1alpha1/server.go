@@ -21,6 +21,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/kubeapps/common/datastore"
@@ -39,24 +40,29 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/kube"
 	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corek8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	log "k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
 )
 
 type clientGetter func(context.Context, string) (kubernetes.Interface, dynamic.Interface, error)
 type helmActionConfigGetter func(ctx context.Context, pkgContext *corev1.Context) (*action.Configuration, error)
 
-// Compile-time statement to ensure this service implementation satisfies the core packaging API
-var _ corev1.PackagesServiceServer = (*Server)(nil)
+// Compile-time statement to ensure this service implementation satisfies the part of the core
+// packaging API implemented by the plugins
+var _ server.PackagesPluginServer = (*Server)(nil)
 
 const (
 	MajorVersionsInSummary = 3
@@ -212,6 +218,14 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 		cq.Repos = request.FilterOptions.Repositories
 		cq.Version = request.FilterOptions.PkgVersion
 		cq.AppVersion = request.FilterOptions.AppVersion
+		cq.Maintainer = request.FilterOptions.Maintainer
+	}
+	if request.GetModifiedSince() != "" {
+		modifiedSince, err := time.Parse(time.RFC3339, request.GetModifiedSince())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid modified since %q: %v", request.GetModifiedSince(), err)
+		}
+		cq.ModifiedSince = modifiedSince
 	}
 
 	pageSize := request.GetPaginationOptions().GetPageSize()
@@ -305,6 +319,12 @@ func AvailablePackageSummaryFromChart(chart *models.Chart) (*corev1.AvailablePac
 		Plugin:     GetPluginDetail(),
 	}
 	pkg.AvailablePackageRef.Context = &corev1.Context{Namespace: chart.Repo.Namespace}
+	pkg.Repository = &corev1.PackageRepositoryReference{
+		Name:      chart.Repo.Name,
+		Namespace: chart.Repo.Namespace,
+		Type:      chart.Repo.Type,
+		Url:       chart.Repo.URL,
+	}
 
 	if chart.ChartVersions != nil || len(chart.ChartVersions) != 0 {
 		pkg.LatestVersion = &corev1.PackageAppVersion{
@@ -390,6 +410,15 @@ func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.
 		return nil, status.Errorf(codes.Internal, "Unable to parse chart to an availablePackageDetail: %v", err)
 	}
 
+	if targetCluster := request.GetTargetCluster(); targetCluster != "" && availablePackageDetail.KubeVersion != "" {
+		clusterVersion, compatible, err := s.isKubeVersionCompatible(ctx, targetCluster, availablePackageDetail.KubeVersion)
+		if err != nil {
+			log.Warningf("Unable to check the Kubernetes version of cluster %q: %v", targetCluster, err)
+		} else if !compatible {
+			server.AddWarning(ctx, "The chart %q requires a Kubernetes version %s while the cluster %q runs %s", chart.ID, availablePackageDetail.KubeVersion, targetCluster, clusterVersion)
+		}
+	}
+
 	return &corev1.GetAvailablePackageDetailResponse{
 		AvailablePackageDetail: availablePackageDetail,
 	}, nil
@@ -520,6 +549,7 @@ func AvailablePackageDetailFromChart(chart *models.Chart, chartFiles *models.Cha
 			PkgVersion: chart.ChartVersions[0].Version,
 			AppVersion: chart.ChartVersions[0].AppVersion,
 		}
+		pkg.KubeVersion = chart.ChartVersions[0].KubeVersion
 		pkg.Readme = chartFiles.Readme
 		pkg.DefaultValues = chartFiles.Values
 		pkg.ValuesSchema = chartFiles.Schema
@@ -527,6 +557,20 @@ func AvailablePackageDetailFromChart(chart *models.Chart, chartFiles *models.Cha
 	return pkg, nil
 }
 
+// isKubeVersionCompatible returns the Kubernetes version of the given cluster
+// and whether it satisfies the kubeVersion constraint of a chart.
+func (s *Server) isKubeVersionCompatible(ctx context.Context, cluster, kubeVersion string) (string, bool, error) {
+	client, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return "", false, err
+	}
+	serverVersion, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return "", false, status.Errorf(codes.Internal, "Unable to get the Kubernetes version of the cluster: %v", err)
+	}
+	return serverVersion.GitVersion, chartutil.IsCompatibleRange(kubeVersion, serverVersion.GitVersion), nil
+}
+
 // hasAccessToNamespace returns an error if the client does not have read access to a given namespace
 func (s *Server) hasAccessToNamespace(ctx context.Context, cluster, namespace string) error {
 	// If checking the global namespace, allow access always
@@ -647,6 +691,18 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 		}
 		// TODO(agamez): deal with multiple matches, perhaps returning []AvailablePackageRef ?
 		// Example: global + namespaced repo including an overlapping subset of packages.
+		if len(charts) > 0 {
+			installedPkgSummaries[i].AvailablePackageRef = &corev1.AvailablePackageReference{
+				Identifier: charts[0].ID,
+				Plugin:     GetPluginDetail(),
+			}
+			if charts[0].Repo != nil {
+				installedPkgSummaries[i].AvailablePackageRef.Context = &corev1.Context{
+					Namespace: charts[0].Repo.Namespace,
+					Cluster:   s.globalPackagingCluster,
+				}
+			}
+		}
 		if len(charts) > 0 && len(charts[0].ChartVersions) > 0 {
 			installedPkgSummaries[i].LatestVersion = &corev1.PackageAppVersion{
 				PkgVersion: charts[0].ChartVersions[0].Version,
@@ -770,6 +826,12 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 				Namespace: charts[0].Repo.Namespace,
 				Cluster:   s.globalPackagingCluster,
 			}
+			installedPkgDetail.AvailablePackageRepository = &corev1.PackageRepositoryReference{
+				Name:      charts[0].Repo.Name,
+				Namespace: charts[0].Repo.Namespace,
+				Type:      charts[0].Repo.Type,
+				Url:       charts[0].Repo.URL,
+			}
 		}
 		if len(charts[0].ChartVersions) > 0 {
 			cv := charts[0].ChartVersions[0]
@@ -780,11 +842,88 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 		}
 	}
 
+	if request.GetIncludeWorkloadStatus() {
+		cluster := request.GetInstalledPackageRef().GetContext().GetCluster()
+		if cluster == "" {
+			cluster = s.globalPackagingCluster
+		}
+		typedClient, _, err := s.GetClients(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+		installedPkgDetail.WorkloadStatus, err = workloadStatusForRelease(ctx, typedClient, release)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to get the workload status of the release: %v", err)
+		}
+	}
+
 	return &corev1.GetInstalledPackageDetailResponse{
 		InstalledPackageDetail: installedPkgDetail,
 	}, nil
 }
 
+// workloadStatusForRelease rolls up the desired and ready replicas of the
+// deployments, stateful sets and daemon sets of the release manifest. A
+// workload which is not found is counted as not ready.
+func workloadStatusForRelease(ctx context.Context, client kubernetes.Interface, r *release.Release) (*corev1.WorkloadStatus, error) {
+	workloadStatus := &corev1.WorkloadStatus{}
+	for _, manifest := range releaseutil.SplitManifests(r.Manifest) {
+		var resource struct {
+			metav1.TypeMeta   `json:",inline"`
+			metav1.ObjectMeta `json:"metadata"`
+		}
+		if err := yaml.Unmarshal([]byte(manifest), &resource); err != nil {
+			return nil, err
+		}
+		namespace := resource.Namespace
+		if namespace == "" {
+			namespace = r.Namespace
+		}
+
+		var desired, ready int32
+		var err error
+		switch resource.Kind {
+		case "Deployment":
+			deployment, getErr := client.AppsV1().Deployments(namespace).Get(ctx, resource.Name, metav1.GetOptions{})
+			if err = getErr; err == nil {
+				desired, ready = 1, deployment.Status.ReadyReplicas
+				if deployment.Spec.Replicas != nil {
+					desired = *deployment.Spec.Replicas
+				}
+			}
+		case "StatefulSet":
+			statefulSet, getErr := client.AppsV1().StatefulSets(namespace).Get(ctx, resource.Name, metav1.GetOptions{})
+			if err = getErr; err == nil {
+				desired, ready = 1, statefulSet.Status.ReadyReplicas
+				if statefulSet.Spec.Replicas != nil {
+					desired = *statefulSet.Spec.Replicas
+				}
+			}
+		case "DaemonSet":
+			daemonSet, getErr := client.AppsV1().DaemonSets(namespace).Get(ctx, resource.Name, metav1.GetOptions{})
+			if err = getErr; err == nil {
+				desired, ready = daemonSet.Status.DesiredNumberScheduled, daemonSet.Status.NumberReady
+			}
+		default:
+			continue
+		}
+
+		workloadStatus.Workloads++
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		workloadStatus.DesiredReplicas += desired
+		workloadStatus.ReadyReplicas += ready
+		if ready >= desired {
+			workloadStatus.ReadyWorkloads++
+		}
+	}
+	return workloadStatus, nil
+}
+
 func installedPkgDetailFromRelease(r *release.Release, ref *corev1.InstalledPackageReference) (*corev1.InstalledPackageDetail, error) {
 	customDetailHelm, err := anypb.New(&helmv1.InstalledPackageDetailCustomDataHelm{
 		ReleaseRevision: int32(r.Version),
@@ -822,11 +961,146 @@ func splitChartIdentifier(chartID string) (repoName, chartName string, err error
 	return chartIDParts[0], chartIDParts[1], nil
 }
 
+// GetInstalledPackageValues returns the values supplied by the user for the
+// current revision of a release, as YAML, without the chart defaults.
+func (s *Server) GetInstalledPackageValues(ctx context.Context, request *corev1.GetInstalledPackageValuesRequest) (*corev1.GetInstalledPackageValuesResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+helm GetInstalledPackageValues %s", contextMsg)
+
+	namespace := request.GetInstalledPackageRef().GetContext().GetNamespace()
+	identifier := request.GetInstalledPackageRef().GetIdentifier()
+
+	actionConfig, err := s.actionConfigGetter(ctx, request.GetInstalledPackageRef().GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+	values, err := action.NewGetValues(actionConfig).Run(identifier)
+	if err != nil {
+		if err == driver.ErrReleaseNotFound {
+			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", identifier, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get Helm release values: %v", err)
+	}
+	if len(values) == 0 {
+		return &corev1.GetInstalledPackageValuesResponse{}, nil
+	}
+	valuesYaml, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to marshal Helm release values: %v", err)
+	}
+	return &corev1.GetInstalledPackageValuesResponse{
+		Values: string(valuesYaml),
+	}, nil
+}
+
+// RenderAvailablePackage renders the templates of an available package with
+// the given values, without touching the cluster.
+func (s *Server) RenderAvailablePackage(ctx context.Context, request *corev1.RenderAvailablePackageRequest) (*corev1.RenderAvailablePackageResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
+	log.Infof("+helm RenderAvailablePackage %s", contextMsg)
+
+	typedClient, _, err := s.GetClients(ctx, s.globalPackagingCluster)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create kubernetes clientset: %v", err)
+	}
+	repoName, chartName, err := splitChartIdentifier(request.GetAvailablePackageRef().GetIdentifier())
+	if err != nil {
+		return nil, err
+	}
+	chartDetails := &chartutils.Details{
+		AppRepositoryResourceName:      repoName,
+		AppRepositoryResourceNamespace: request.GetAvailablePackageRef().GetContext().GetNamespace(),
+		ChartName:                      chartName,
+		Version:                        request.GetPkgVersion(),
+	}
+	ch, registrySecrets, err := s.fetchChartWithRegistrySecrets(ctx, chartDetails, typedClient)
+	if err != nil {
+		return nil, err
+	}
+
+	name := request.GetName()
+	if name == "" {
+		name = chartName
+	}
+	manifest, err := agent.RenderRelease(name, request.GetTargetContext().GetNamespace(), request.GetValues(), ch, registrySecrets)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to render the chart %q: %v", request.GetAvailablePackageRef().GetIdentifier(), err)
+	}
+	response := &corev1.RenderAvailablePackageResponse{
+		Manifest: manifest,
+	}
+	if request.GetIncludeApplyOrder() {
+		response.ApplyOrder, err = renderedApplyOrder(manifest, request.GetTargetContext().GetNamespace())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to order the resources rendered from the chart %q: %v", request.GetAvailablePackageRef().GetIdentifier(), err)
+		}
+	}
+	return response, nil
+}
+
+// clusterScopedKinds are the kinds, sorted by Helm when installing, which are
+// not namespaced.
+var clusterScopedKinds = map[string]bool{
+	"Namespace":                true,
+	"PodSecurityPolicy":        true,
+	"StorageClass":             true,
+	"PersistentVolume":         true,
+	"CustomResourceDefinition": true,
+	"ClusterRole":              true,
+	"ClusterRoleList":          true,
+	"ClusterRoleBinding":       true,
+	"ClusterRoleBindingList":   true,
+	"APIService":               true,
+}
+
+// renderedApplyOrder returns the resources of the rendered manifest in the
+// order in which Helm applies them when installing the release. The hooks
+// are run separately, so they are skipped.
+func renderedApplyOrder(manifest, namespace string) ([]*corev1.RenderedResource, error) {
+	_, manifests, err := releaseutil.SortManifests(map[string]string{"manifest": manifest}, nil, releaseutil.InstallOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []*corev1.RenderedResource{}
+	for _, m := range manifests {
+		if m.Head == nil || m.Head.Kind == "" {
+			continue
+		}
+		var resource struct {
+			metav1.ObjectMeta `json:"metadata"`
+		}
+		if err := yaml.Unmarshal([]byte(m.Content), &resource); err != nil {
+			return nil, err
+		}
+		resourceNamespace := resource.Namespace
+		if resourceNamespace == "" && !clusterScopedKinds[m.Head.Kind] {
+			resourceNamespace = namespace
+		}
+		resources = append(resources, &corev1.RenderedResource{
+			ApiVersion: m.Head.Version,
+			Kind:       m.Head.Kind,
+			Name:       resource.Name,
+			Namespace:  resourceNamespace,
+		})
+	}
+	return resources, nil
+}
+
 // CreateInstalledPackage creates an installed package.
 func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.CreateInstalledPackageRequest) (*corev1.CreateInstalledPackageResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetTargetContext().GetCluster(), request.GetTargetContext().GetNamespace())
 	log.Infof("+helm CreateInstalledPackage %s", contextMsg)
 
+	var timeout time.Duration
+	if request.GetInstallTimeout() != "" {
+		var err error
+		timeout, err = time.ParseDuration(request.GetInstallTimeout())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid install timeout %q: %v", request.GetInstallTimeout(), err)
+		}
+	}
+
 	typedClient, _, err := s.GetClients(ctx, s.globalPackagingCluster)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to create kubernetes clientset: %v", err)
@@ -851,7 +1125,7 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
 	}
 
-	release, err := agent.CreateRelease(actionConfig, request.GetName(), request.GetTargetContext().GetNamespace(), request.GetValues(), ch, registrySecrets)
+	release, err := agent.CreateRelease(actionConfig, request.GetName(), request.GetTargetContext().GetNamespace(), request.GetValues(), ch, registrySecrets, timeout)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to create helm release %q in the namespace %q: %v", request.GetName(), request.GetTargetContext().GetNamespace(), err)
 	}
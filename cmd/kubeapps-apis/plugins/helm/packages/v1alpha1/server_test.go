@@ -21,6 +21,7 @@ import (
 	"net/url"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/go-cmp/cmp"
@@ -44,9 +45,14 @@ import (
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	helmtime "helm.sh/helm/v3/pkg/time"
+	appsv1 "k8s.io/api/apps/v1"
 	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sversion "k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
 	dynfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
@@ -343,6 +349,7 @@ func TestAvailablePackageSummaryFromChart(t *testing.T) {
 					Identifier: "foo/bar",
 					Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 				},
+				Repository: &corev1.PackageRepositoryReference{Name: "bar", Namespace: "my-ns"},
 			},
 			statusCode: codes.OK,
 		},
@@ -375,6 +382,7 @@ func TestAvailablePackageSummaryFromChart(t *testing.T) {
 					Identifier: "foo/bar",
 					Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 				},
+				Repository: &corev1.PackageRepositoryReference{Name: "bar", Namespace: "my-ns"},
 			},
 			statusCode: codes.OK,
 		},
@@ -399,7 +407,7 @@ func TestAvailablePackageSummaryFromChart(t *testing.T) {
 			}
 
 			if tc.statusCode == codes.OK {
-				opt1 := cmpopts.IgnoreUnexported(corev1.AvailablePackageDetail{}, corev1.AvailablePackageSummary{}, corev1.AvailablePackageReference{}, corev1.Context{}, plugins.Plugin{}, corev1.Maintainer{}, corev1.PackageAppVersion{})
+				opt1 := cmpopts.IgnoreUnexported(corev1.AvailablePackageDetail{}, corev1.AvailablePackageSummary{}, corev1.AvailablePackageReference{}, corev1.Context{}, plugins.Plugin{}, corev1.Maintainer{}, corev1.PackageAppVersion{}, corev1.PackageRepositoryReference{})
 				if got, want := availablePackageSummary, tc.expected; !cmp.Equal(got, want, opt1) {
 					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1))
 				}
@@ -439,6 +447,15 @@ func makeChart(chart_name, repo_name, repo_url, namespace string, chart_versions
 	return ch
 }
 
+// withKubeVersion sets the Kubernetes version constraint of all the versions
+// of a chart.
+func withKubeVersion(chart *models.Chart, kubeVersion string) *models.Chart {
+	for i := range chart.ChartVersions {
+		chart.ChartVersions[i].KubeVersion = kubeVersion
+	}
+	return chart
+}
+
 // makeChartRowsJSON returns a slice of paginated JSON chart info data.
 func makeChartRowsJSON(t *testing.T, charts []*models.Chart, pageToken string, pageSize int) []string {
 	// Simulate the pagination by reducing the rows of JSON based on the offset and limit.
@@ -555,6 +572,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-1",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-1"},
 					},
 					{
 						Name:        "chart-2",
@@ -571,6 +589,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-2",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-2"},
 					},
 					{
 						Name:        "chart-3-global",
@@ -587,6 +606,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-3-global",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: globalPackagingNamespace, Url: "http://chart-3"},
 					},
 				},
 				Categories: []string{"cat1"},
@@ -623,6 +643,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-1",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-1"},
 					},
 					{
 						Name:        "chart-2",
@@ -639,6 +660,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-2",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-2"},
 					},
 				},
 				Categories: []string{"cat1"},
@@ -676,6 +698,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-1",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-1"},
 					},
 					{
 						Name:        "chart-2",
@@ -692,6 +715,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-2",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-2"},
 					},
 				},
 				Categories: []string{"cat1"},
@@ -769,6 +793,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-2",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-2"},
 					},
 				},
 				NextPageToken: "3",
@@ -813,6 +838,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-3",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-3"},
 					},
 				},
 				NextPageToken: "",
@@ -834,6 +860,18 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 			},
 			statusCode: codes.InvalidArgument,
 		},
+		{
+			name:       "it returns an invalid argument error if the modified since is invalid",
+			authorized: true,
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				ModifiedSince: "yesterday",
+			},
+			statusCode: codes.InvalidArgument,
+		},
 		{
 			name:       "it returns the proper chart categories",
 			authorized: true,
@@ -866,6 +904,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-1",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-1"},
 					},
 					{
 						Name:        "chart-2",
@@ -882,6 +921,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-2",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-2"},
 					},
 					{
 						Name:        "chart-3",
@@ -898,6 +938,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-3",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						Repository: &corev1.PackageRepositoryReference{Name: "repo-1", Namespace: "my-ns", Url: "http://chart-3"},
 					},
 				},
 				Categories: []string{"bar", "foo"},
@@ -963,7 +1004,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 			}
 
 			if tc.statusCode == codes.OK {
-				opt1 := cmpopts.IgnoreUnexported(corev1.GetAvailablePackageSummariesResponse{}, corev1.AvailablePackageSummary{}, corev1.AvailablePackageReference{}, corev1.Context{}, plugins.Plugin{}, corev1.PackageAppVersion{})
+				opt1 := cmpopts.IgnoreUnexported(corev1.GetAvailablePackageSummariesResponse{}, corev1.AvailablePackageSummary{}, corev1.AvailablePackageReference{}, corev1.Context{}, plugins.Plugin{}, corev1.PackageAppVersion{}, corev1.PackageRepositoryReference{})
 				if got, want := availablePackageSummaries, tc.expectedResponse; !cmp.Equal(got, want, opt1) {
 					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1))
 				}
@@ -1018,6 +1059,40 @@ func TestAvailablePackageDetailFromChart(t *testing.T) {
 			},
 			statusCode: codes.OK,
 		},
+		{
+			name:  "it returns the Kubernetes version constraint of the chart",
+			chart: withKubeVersion(makeChart("foo", "repo-1", "http://foo", "my-ns", []string{"3.0.0"}, DefaultChartCategory), ">=1.19.0-0"),
+			chartFiles: &models.ChartFiles{
+				Readme: "chart readme",
+				Values: "chart values",
+				Schema: "chart schema",
+			},
+			expected: &corev1.AvailablePackageDetail{
+				Name:             "foo",
+				DisplayName:      "foo",
+				RepoUrl:          "http://foo",
+				HomeUrl:          DefaultChartHomeURL,
+				IconUrl:          DefaultChartIconURL,
+				Categories:       []string{DefaultChartCategory},
+				ShortDescription: DefaultChartDescription,
+				Version: &corev1.PackageAppVersion{
+					PkgVersion: "3.0.0",
+					AppVersion: DefaultAppVersion,
+				},
+				Readme:        "chart readme",
+				DefaultValues: "chart values",
+				ValuesSchema:  "chart schema",
+				SourceUrls:    []string{"http://source-1"},
+				Maintainers:   []*corev1.Maintainer{{Name: "me", Email: "me@me.me"}},
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: "my-ns"},
+					Identifier: "repo-1/foo",
+					Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+				},
+				KubeVersion: ">=1.19.0-0",
+			},
+			statusCode: codes.OK,
+		},
 		{
 			name:       "it returns internal error if empty chart",
 			chart:      &models.Chart{},
@@ -1254,6 +1329,58 @@ func TestGetAvailablePackageDetail(t *testing.T) {
 	}
 }
 
+func TestIsKubeVersionCompatible(t *testing.T) {
+	testCases := []struct {
+		name               string
+		kubeVersion        string
+		clusterVersion     string
+		expectedCompatible bool
+	}{
+		{
+			name:               "it returns true if the cluster version satisfies the constraint",
+			kubeVersion:        ">=1.19.0-0",
+			clusterVersion:     "v1.21.3",
+			expectedCompatible: true,
+		},
+		{
+			name:               "it returns false if the cluster version is older than required",
+			kubeVersion:        ">=1.19.0-0",
+			clusterVersion:     "v1.18.9",
+			expectedCompatible: false,
+		},
+		{
+			name:               "it returns false if the cluster version is newer than supported",
+			kubeVersion:        ">=1.16.0-0 <1.20.0-0",
+			clusterVersion:     "v1.22.0",
+			expectedCompatible: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientSet := typfake.NewSimpleClientset()
+			clientSet.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &k8sversion.Info{GitVersion: tc.clusterVersion}
+			server := &Server{
+				clientGetter: func(context.Context, string) (kubernetes.Interface, dynamic.Interface, error) {
+					return clientSet, nil, nil
+				},
+			}
+
+			clusterVersion, compatible, err := server.isKubeVersionCompatible(context.Background(), "target-cluster", tc.kubeVersion)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := clusterVersion, tc.clusterVersion; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := compatible, tc.expectedCompatible; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
 func TestGetAvailablePackageVersions(t *testing.T) {
 	testCases := []struct {
 		name               string
@@ -1591,6 +1718,11 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 						LatestVersion: &corev1.PackageAppVersion{
 							PkgVersion: "1.2.3",
 						},
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: globalPackagingNamespace},
+							Identifier: "bitnami/my-release-1",
+							Plugin:     GetPluginDetail(),
+						},
 						Status: &corev1.InstalledPackageStatus{
 							Ready:      true,
 							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
@@ -1618,6 +1750,11 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 						LatestVersion: &corev1.PackageAppVersion{
 							PkgVersion: "4.5.6",
 						},
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: globalPackagingNamespace},
+							Identifier: "bitnami/my-release-3",
+							Plugin:     GetPluginDetail(),
+						},
 						Status: &corev1.InstalledPackageStatus{
 							Ready:      true,
 							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
@@ -1679,6 +1816,11 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 						LatestVersion: &corev1.PackageAppVersion{
 							PkgVersion: "1.2.3",
 						},
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: globalPackagingNamespace},
+							Identifier: "bitnami/my-release-1",
+							Plugin:     GetPluginDetail(),
+						},
 						Status: &corev1.InstalledPackageStatus{
 							Ready:      true,
 							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
@@ -1706,6 +1848,11 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 						LatestVersion: &corev1.PackageAppVersion{
 							PkgVersion: "3.4.5",
 						},
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: globalPackagingNamespace},
+							Identifier: "bitnami/my-release-2",
+							Plugin:     GetPluginDetail(),
+						},
 						Status: &corev1.InstalledPackageStatus{
 							Ready:      true,
 							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
@@ -1733,6 +1880,11 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 						LatestVersion: &corev1.PackageAppVersion{
 							PkgVersion: "4.5.6",
 						},
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: globalPackagingNamespace},
+							Identifier: "bitnami/my-release-3",
+							Plugin:     GetPluginDetail(),
+						},
 						Status: &corev1.InstalledPackageStatus{
 							Ready:      true,
 							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
@@ -1797,6 +1949,11 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 						LatestVersion: &corev1.PackageAppVersion{
 							PkgVersion: "1.2.3",
 						},
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: globalPackagingNamespace},
+							Identifier: "bitnami/my-release-1",
+							Plugin:     GetPluginDetail(),
+						},
 						Status: &corev1.InstalledPackageStatus{
 							Ready:      true,
 							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
@@ -1824,6 +1981,11 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 						LatestVersion: &corev1.PackageAppVersion{
 							PkgVersion: "3.4.5",
 						},
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: globalPackagingNamespace},
+							Identifier: "bitnami/my-release-2",
+							Plugin:     GetPluginDetail(),
+						},
 						Status: &corev1.InstalledPackageStatus{
 							Ready:      true,
 							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
@@ -1890,6 +2052,11 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 						LatestVersion: &corev1.PackageAppVersion{
 							PkgVersion: "4.5.6",
 						},
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: globalPackagingNamespace},
+							Identifier: "bitnami/my-release-3",
+							Plugin:     GetPluginDetail(),
+						},
 						Status: &corev1.InstalledPackageStatus{
 							Ready:      true,
 							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
@@ -1938,6 +2105,11 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 						LatestVersion: &corev1.PackageAppVersion{
 							PkgVersion: "1.2.5",
 						},
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: globalPackagingNamespace},
+							Identifier: "bitnami/my-release-1",
+							Plugin:     GetPluginDetail(),
+						},
 						Status: &corev1.InstalledPackageStatus{
 							Ready:      true,
 							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
@@ -1971,7 +2143,7 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 				return
 			}
 
-			opts := cmpopts.IgnoreUnexported(corev1.GetInstalledPackageSummariesResponse{}, corev1.InstalledPackageSummary{}, corev1.InstalledPackageReference{}, corev1.Context{}, corev1.VersionReference{}, corev1.InstalledPackageStatus{}, corev1.PackageAppVersion{})
+			opts := cmpopts.IgnoreUnexported(corev1.GetInstalledPackageSummariesResponse{}, corev1.InstalledPackageSummary{}, corev1.InstalledPackageReference{}, corev1.AvailablePackageReference{}, corev1.Context{}, corev1.VersionReference{}, corev1.InstalledPackageStatus{}, corev1.PackageAppVersion{}, plugins.Plugin{})
 			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, opts) {
 				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
 			}
@@ -1998,9 +2170,33 @@ func TestGetInstalledPackageDetail(t *testing.T) {
 		releaseValues    = "{\"value\":\"new\"}"
 		releaseNotes     = "some notes"
 	)
+	const releaseManifest = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-release-1-web
+---
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: my-release-1-db
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: my-release-1-agent
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-release-1-web
+`
+	replicas := func(r int32) *int32 { return &r }
 	testCases := []struct {
 		name               string
 		existingReleases   []releaseStub
+		existingWorkloads  []runtime.Object
 		request            *corev1.GetInstalledPackageDetailRequest
 		expectedResponse   *corev1.GetInstalledPackageDetailResponse
 		expectedStatusCode codes.Code
@@ -2074,6 +2270,156 @@ func TestGetInstalledPackageDetail(t *testing.T) {
 						Identifier: "myrepo/" + releaseName,
 						Plugin:     GetPluginDetail(),
 					},
+					AvailablePackageRepository: &corev1.PackageRepositoryReference{
+						Name:      "myrepo",
+						Namespace: releaseNamespace,
+						Url:       "https://example.com/charts",
+					},
+					CustomDetail: customDetailRevision2,
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns an installed package detail with the status of partially-ready workloads",
+			existingReleases: []releaseStub{
+				{
+					name:           releaseName,
+					namespace:      releaseNamespace,
+					chartVersion:   releaseVersion,
+					chartNamespace: releaseNamespace,
+					values:         releaseValues,
+					notes:          releaseNotes,
+					status:         release.StatusDeployed,
+					version:        2,
+					manifest:       releaseManifest,
+				},
+			},
+			existingWorkloads: []runtime.Object{
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-release-1-web", Namespace: releaseNamespace},
+					Spec:       appsv1.DeploymentSpec{Replicas: replicas(3)},
+					Status:     appsv1.DeploymentStatus{ReadyReplicas: 1},
+				},
+				&appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-release-1-db", Namespace: releaseNamespace},
+					Spec:       appsv1.StatefulSetSpec{Replicas: replicas(1)},
+					Status:     appsv1.StatefulSetStatus{ReadyReplicas: 1},
+				},
+				&appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-release-1-agent", Namespace: releaseNamespace},
+					Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, NumberReady: 2},
+				},
+			},
+			request: &corev1.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: releaseNamespace,
+						Cluster:   globalPackagingCluster,
+					},
+					Identifier: releaseName,
+				},
+				IncludeWorkloadStatus: true,
+			},
+			expectedResponse: &corev1.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &corev1.InstalledPackageDetail{
+					InstalledPackageRef: &corev1.InstalledPackageReference{
+						Context: &corev1.Context{
+							Namespace: releaseNamespace,
+							Cluster:   globalPackagingCluster,
+						},
+						Identifier: releaseName,
+					},
+					PkgVersionReference: &corev1.VersionReference{
+						Version: releaseVersion,
+					},
+					Name: releaseName,
+					CurrentVersion: &corev1.PackageAppVersion{
+						PkgVersion: releaseVersion,
+						AppVersion: DefaultAppVersion,
+					},
+					LatestVersion: &corev1.PackageAppVersion{
+						PkgVersion: releaseVersion,
+						AppVersion: DefaultAppVersion,
+					},
+					ValuesApplied:         releaseValues,
+					PostInstallationNotes: releaseNotes,
+					Status: &corev1.InstalledPackageStatus{
+						Ready:      true,
+						Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+						UserReason: "deployed",
+					},
+					AvailablePackageRef: &corev1.AvailablePackageReference{
+						Context: &corev1.Context{
+							Namespace: releaseNamespace,
+							Cluster:   globalPackagingCluster,
+						},
+						Identifier: "myrepo/" + releaseName,
+						Plugin:     GetPluginDetail(),
+					},
+					AvailablePackageRepository: &corev1.PackageRepositoryReference{
+						Name:      "myrepo",
+						Namespace: releaseNamespace,
+						Url:       "https://example.com/charts",
+					},
+					CustomDetail: customDetailRevision2,
+					WorkloadStatus: &corev1.WorkloadStatus{
+						Workloads:       3,
+						ReadyWorkloads:  2,
+						DesiredReplicas: 6,
+						ReadyReplicas:   4,
+					},
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns an installed package detail without a source when no available package matches",
+			existingReleases: []releaseStub{
+				{
+					name:           releaseName,
+					namespace:      releaseNamespace,
+					chartVersion:   releaseVersion,
+					chartNamespace: releaseNamespace,
+					values:         releaseValues,
+					notes:          releaseNotes,
+					status:         release.StatusDeployed,
+					version:        2,
+				},
+			},
+			request: &corev1.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: releaseNamespace,
+						Cluster:   globalPackagingCluster,
+					},
+					Identifier: releaseName,
+				},
+			},
+			expectedResponse: &corev1.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &corev1.InstalledPackageDetail{
+					InstalledPackageRef: &corev1.InstalledPackageReference{
+						Context: &corev1.Context{
+							Namespace: releaseNamespace,
+							Cluster:   globalPackagingCluster,
+						},
+						Identifier: releaseName,
+					},
+					PkgVersionReference: &corev1.VersionReference{
+						Version: releaseVersion,
+					},
+					Name: releaseName,
+					CurrentVersion: &corev1.PackageAppVersion{
+						PkgVersion: releaseVersion,
+						AppVersion: DefaultAppVersion,
+					},
+					ValuesApplied:         releaseValues,
+					PostInstallationNotes: releaseNotes,
+					Status: &corev1.InstalledPackageStatus{
+						Ready:      true,
+						Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+						UserReason: "deployed",
+					},
 					CustomDetail: customDetailRevision2,
 				},
 			},
@@ -2099,6 +2445,12 @@ func TestGetInstalledPackageDetail(t *testing.T) {
 			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases)
 			server, mock, cleanup := makeServer(t, authorized, actionConfig)
 			defer cleanup()
+			if tc.existingWorkloads != nil {
+				clientSet := typfake.NewSimpleClientset(tc.existingWorkloads...)
+				server.clientGetter = func(context.Context, string) (kubernetes.Interface, dynamic.Interface, error) {
+					return clientSet, nil, nil
+				}
+			}
 
 			if tc.expectedStatusCode == codes.OK {
 				populateAssetDBWithDetail(t, mock, tc.expectedResponse.InstalledPackageDetail)
@@ -2115,7 +2467,7 @@ func TestGetInstalledPackageDetail(t *testing.T) {
 				return
 			}
 
-			opts := cmpopts.IgnoreUnexported(corev1.GetInstalledPackageDetailResponse{}, corev1.InstalledPackageDetail{}, corev1.InstalledPackageReference{}, corev1.Context{}, corev1.VersionReference{}, corev1.InstalledPackageStatus{}, corev1.AvailablePackageReference{}, plugins.Plugin{}, corev1.PackageAppVersion{}, anypb.Any{})
+			opts := cmpopts.IgnoreUnexported(corev1.GetInstalledPackageDetailResponse{}, corev1.InstalledPackageDetail{}, corev1.InstalledPackageReference{}, corev1.Context{}, corev1.VersionReference{}, corev1.InstalledPackageStatus{}, corev1.AvailablePackageReference{}, corev1.PackageRepositoryReference{}, plugins.Plugin{}, corev1.PackageAppVersion{}, anypb.Any{}, corev1.WorkloadStatus{})
 			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, opts) {
 				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
 			}
@@ -2179,9 +2531,12 @@ func releaseForStub(t *testing.T, r releaseStub) *release.Release {
 		Namespace: r.namespace,
 		Version:   r.version,
 		Info: &release.Info{
-			Status: r.status,
-			Notes:  r.notes,
+			Status:       r.status,
+			Notes:        r.notes,
+			Description:  r.description,
+			LastDeployed: helmtime.Time{Time: r.lastDeployed},
 		},
+		Manifest: r.manifest,
 		Chart: &chart.Chart{
 			Metadata: &chart.Metadata{
 				Version:    r.chartVersion,
@@ -2226,7 +2581,9 @@ func chartAssetForReleaseStub(rel *releaseStub) *models.Chart {
 		Name: rel.name,
 		ID:   rel.chartID,
 		Repo: &models.Repo{
+			Name:      rel.chartRepoName,
 			Namespace: rel.chartNamespace,
+			URL:       rel.chartRepoURL,
 		},
 		ChartVersions: chartVersions,
 	}
@@ -2238,11 +2595,13 @@ func populateAssetDBWithSummaries(t *testing.T, mock sqlmock.Sqlmock, pkgs []*co
 	rels := []releaseStub{}
 	for _, pkg := range pkgs {
 		rels = append(rels, releaseStub{
-			name:          pkg.Name,
-			namespace:     pkg.GetInstalledPackageRef().GetContext().GetNamespace(),
-			chartVersion:  pkg.CurrentVersion.PkgVersion,
-			latestVersion: pkg.LatestVersion.PkgVersion,
-			version:       DefaultReleaseRevision,
+			name:           pkg.Name,
+			namespace:      pkg.GetInstalledPackageRef().GetContext().GetNamespace(),
+			chartVersion:   pkg.CurrentVersion.PkgVersion,
+			chartID:        pkg.GetAvailablePackageRef().GetIdentifier(),
+			chartNamespace: pkg.GetAvailablePackageRef().GetContext().GetNamespace(),
+			latestVersion:  pkg.LatestVersion.PkgVersion,
+			version:        DefaultReleaseRevision,
 		})
 	}
 	populateAssetDB(t, mock, rels)
@@ -2250,13 +2609,21 @@ func populateAssetDBWithSummaries(t *testing.T, mock sqlmock.Sqlmock, pkgs []*co
 
 func populateAssetDBWithDetail(t *testing.T, mock sqlmock.Sqlmock, pkg *corev1.InstalledPackageDetail) {
 	// The code currently executes one query per release in the paginated
-	// results and should receive a single row response.
+	// results and should receive a single row response, or none when the
+	// available package can't be determined.
+	if pkg.GetAvailablePackageRef() == nil {
+		mock.ExpectQuery("SELECT info FROM").
+			WillReturnRows(sqlmock.NewRows([]string{"info"}))
+		return
+	}
 	rel := releaseStub{
 		name:           pkg.Name,
 		namespace:      pkg.GetInstalledPackageRef().GetContext().GetNamespace(),
 		chartVersion:   pkg.GetCurrentVersion().GetPkgVersion(),
 		chartID:        pkg.GetAvailablePackageRef().GetIdentifier(),
 		chartNamespace: pkg.GetAvailablePackageRef().GetContext().GetNamespace(),
+		chartRepoName:  pkg.GetAvailablePackageRepository().GetName(),
+		chartRepoURL:   pkg.GetAvailablePackageRepository().GetUrl(),
 		version:        DefaultReleaseRevision,
 	}
 	populateAssetDB(t, mock, []releaseStub{rel})
@@ -2284,8 +2651,13 @@ type releaseStub struct {
 	chartVersion   string
 	chartID        string
 	chartNamespace string
+	chartRepoName  string
+	chartRepoURL   string
 	latestVersion  string
 	values         string
 	notes          string
 	status         release.Status
+	manifest       string
+	description    string
+	lastDeployed   time.Time
 }
@@ -0,0 +1,96 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestGetInstalledPackageValues(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		expectedResponse   *corev1.GetInstalledPackageValuesResponse
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "returns the values supplied for the release as yaml",
+			existingReleases: []releaseStub{
+				{
+					name:      "my-apache",
+					namespace: "default",
+					status:    release.StatusDeployed,
+					values:    `{"replicaCount": 2, "service": {"type": "ClusterIP"}}`,
+				},
+			},
+			expectedResponse: &corev1.GetInstalledPackageValuesResponse{
+				Values: "replicaCount: 2\nservice:\n  type: ClusterIP\n",
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns empty values when none were supplied",
+			existingReleases: []releaseStub{
+				{
+					name:      "my-apache",
+					namespace: "default",
+					status:    release.StatusDeployed,
+				},
+			},
+			expectedResponse:   &corev1.GetInstalledPackageValuesResponse{},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "returns not found if the installed package doesn't exist",
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, "default", tc.existingReleases)
+			server, _, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+
+			response, err := server.GetInstalledPackageValues(context.Background(), &corev1.GetInstalledPackageValuesRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			})
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			opts := cmpopts.IgnoreUnexported(corev1.GetInstalledPackageValuesResponse{})
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
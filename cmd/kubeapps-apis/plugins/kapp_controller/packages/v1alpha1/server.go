@@ -57,8 +57,9 @@ const (
 	globalPackagingNamespace = "kapp-controller-packaging-global"
 )
 
-// Compile-time statement to ensure this service implementation satisfies the core packaging API
-var _ corev1.PackagesServiceServer = (*Server)(nil)
+// Compile-time statement to ensure this service implementation satisfies the part of the core
+// packaging API implemented by the plugins
+var _ server.PackagesPluginServer = (*Server)(nil)
 
 // Server implements the kapp-controller packages v1alpha1 interface.
 type Server struct {
@@ -106,6 +107,7 @@ func (s *Server) getDynamicClient(ctx context.Context) (dynamic.Interface, error
 }
 
 // GetAvailablePackageSummaries returns the available packages based on the request.
+// The request ModifiedSince is currently ignored, all the packages being returned.
 func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *corev1.GetAvailablePackageSummariesRequest) (*corev1.GetAvailablePackageSummariesResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
 	log.Infof("+kapp_controller GetAvailablePackageSummaries %s", contextMsg)
@@ -116,6 +118,9 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 	if cluster != "" {
 		return nil, status.Errorf(codes.Unimplemented, "Not supported yet: request.Context.Cluster: [%v]", request.Context.Cluster)
 	}
+	if maintainer := request.GetFilterOptions().GetMaintainer(); maintainer != "" {
+		return nil, status.Errorf(codes.Unimplemented, "Not supported yet: request.FilterOptions.Maintainer: [%v]", maintainer)
+	}
 
 	client, err := s.getDynamicClient(ctx)
 	if err != nil {
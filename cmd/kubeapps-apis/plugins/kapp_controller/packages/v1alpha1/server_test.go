@@ -34,9 +34,10 @@ import (
 func TestGetClient(t *testing.T) {
 
 	testCases := []struct {
-		name         string
-		clientGetter clientGetter
-		statusCode   codes.Code
+		name          string
+		clientGetter  clientGetter
+		filterOptions *corev1.FilterOptions
+		statusCode    codes.Code
 	}{
 		{
 			name:         "returns internal error status when no getter configured",
@@ -86,9 +87,10 @@ func TestGetClient(t *testing.T) {
 
 func TestGetAvailablePackagesStatus(t *testing.T) {
 	testCases := []struct {
-		name         string
-		clientGetter clientGetter
-		statusCode   codes.Code
+		name          string
+		clientGetter  clientGetter
+		filterOptions *corev1.FilterOptions
+		statusCode    codes.Code
 	}{
 		{
 			name: "returns an internal error status if response does not contain packageRef.refName",
@@ -139,13 +141,26 @@ func TestGetAvailablePackagesStatus(t *testing.T) {
 			},
 			statusCode: codes.OK,
 		},
+		{
+			name: "returns an unimplemented error status if filtering by maintainer",
+			clientGetter: func(context.Context) (dynamic.Interface, error) {
+				return dynfake.NewSimpleDynamicClientWithCustomListKinds(
+					runtime.NewScheme(),
+					map[schema.GroupVersionResource]string{
+						{Group: packagingGroup, Version: packageVersion, Resource: packagesResource}: "PackageList",
+					},
+				), nil
+			},
+			filterOptions: &corev1.FilterOptions{Maintainer: "someone"},
+			statusCode:    codes.Unimplemented,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			s := Server{clientGetter: tc.clientGetter}
 
-			_, err := s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{Context: &corev1.Context{}})
+			_, err := s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{Context: &corev1.Context{}, FilterOptions: tc.filterOptions})
 
 			if err == nil && tc.statusCode != codes.OK {
 				t.Fatalf("got: nil, want: error")
@@ -0,0 +1,79 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// anonymousReadMethodPrefix is the prefix of the methods, of the core or of
+// the plugin services, reading the catalog of the available packages.
+const anonymousReadMethodPrefix = "GetAvailablePackage"
+
+// isAnonymousReadMethod returns whether the full grpc method (eg.
+// "/package.Service/GetAvailablePackageSummaries") is a catalog read, the
+// only calls allowed without a token when anonymous reads are allowed.
+func isAnonymousReadMethod(fullMethod string) bool {
+	return strings.HasPrefix(path.Base(fullMethod), anonymousReadMethodPrefix)
+}
+
+// installedCountRequest is implemented by the catalog reads which can
+// include the number of times each package is installed.
+type installedCountRequest interface {
+	GetIncludeInstalledCount() bool
+}
+
+// anonymousReadsInterceptor rejects the calls without a token, other than the
+// catalog reads, when anonymous reads are allowed, since the requests without
+// a token are then made with the in-cluster config rather than delegated to
+// the RBAC. The catalog reads including the installed counts are rejected
+// too, as counting them lists the installed packages of every namespace.
+func anonymousReadsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := checkAnonymousRead(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	if r, ok := req.(installedCountRequest); ok && r.GetIncludeInstalledCount() {
+		if token, _ := extractToken(ctx); token == "" {
+			return nil, status.Errorf(codes.Unauthenticated, "an authorization token is required to include the installed counts")
+		}
+	}
+	return handler(ctx, req)
+}
+
+// anonymousReadsStreamInterceptor rejects the streaming calls without a
+// token, other than the catalog reads, as for the unary calls.
+func anonymousReadsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := checkAnonymousRead(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// checkAnonymousRead returns an Unauthenticated error if the call has an
+// invalid token, or no token while not being a catalog read.
+func checkAnonymousRead(ctx context.Context, fullMethod string) error {
+	token, err := extractToken(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid authorization metadata: %v", err)
+	}
+	if token == "" && !isAnonymousReadMethod(fullMethod) {
+		return status.Errorf(codes.Unauthenticated, "an authorization token is required for %q", fullMethod)
+	}
+	return nil
+}
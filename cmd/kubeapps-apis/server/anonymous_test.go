@@ -0,0 +1,232 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/kubeapps/kubeapps/pkg/kube"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/rest"
+)
+
+func TestAnonymousReadsInterceptor(t *testing.T) {
+	testCases := []struct {
+		name          string
+		fullMethod    string
+		authorization string
+		request       interface{}
+		expectedCode  codes.Code
+	}{
+		{
+			name:         "it allows an anonymous read",
+			fullMethod:   "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageSummaries",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it allows an anonymous catalog read of a plugin service",
+			fullMethod:   "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageDetail",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it rejects an anonymous mutate",
+			fullMethod:   "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "it rejects an anonymous read of the installed packages",
+			fullMethod:   "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageSummaries",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "it rejects an anonymous call to a method which is not a catalog read",
+			fullMethod:   "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RollbackInstalledPackage",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "it rejects an anonymous read including the installed counts",
+			fullMethod:   "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageSummaries",
+			request:      &corev1.GetAvailablePackageSummariesRequest{IncludeInstalledCount: true},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:          "it allows a read including the installed counts with a token",
+			fullMethod:    "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageSummaries",
+			authorization: "Bearer abc",
+			request:       &corev1.GetAvailablePackageSummariesRequest{IncludeInstalledCount: true},
+			expectedCode:  codes.OK,
+		},
+		{
+			name:          "it allows a read with a token",
+			fullMethod:    "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageSummaries",
+			authorization: "Bearer abc",
+			expectedCode:  codes.OK,
+		},
+		{
+			name:          "it allows a mutate with a token",
+			fullMethod:    "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage",
+			authorization: "Bearer abc",
+			expectedCode:  codes.OK,
+		},
+		{
+			name:          "it rejects a mutate with a malformed token",
+			fullMethod:    "/kubeappsapis.core.packages.v1alpha1.PackagesService/UpdateInstalledPackage",
+			authorization: "abc",
+			expectedCode:  codes.Unauthenticated,
+		},
+		{
+			name:          "it rejects a catalog read with a malformed token",
+			fullMethod:    "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageDetail",
+			authorization: "abc",
+			expectedCode:  codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tc.authorization))
+			}
+			handlerCalled := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCalled = true
+				return nil, nil
+			}
+
+			_, err := anonymousReadsInterceptor(ctx, tc.request, &grpc.UnaryServerInfo{FullMethod: tc.fullMethod}, handler)
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := handlerCalled, tc.expectedCode == codes.OK; got != want {
+				t.Errorf("got handler called: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAnonymousReadsStreamInterceptor(t *testing.T) {
+	testCases := []struct {
+		name         string
+		fullMethod   string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "it allows an anonymous readme stream",
+			fullMethod:   "/kubeappsapis.core.packages.v1alpha1.PackagesReadmeService/GetAvailablePackageReadme",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it rejects an anonymous stream which is not a catalog read",
+			fullMethod:   "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/WatchInstalledPackage",
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := anonymousReadsStreamInterceptor(nil, &testServerStream{}, &grpc.StreamServerInfo{FullMethod: tc.fullMethod}, func(srv interface{}, stream grpc.ServerStream) error {
+				return nil
+			})
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+		})
+	}
+}
+
+func TestCreateConfigGetterAnonymousReads(t *testing.T) {
+	inClusterConfig := &rest.Config{
+		Host:        "http://example.com/default/",
+		BearerToken: "service-account-token",
+	}
+	clustersConfig := kube.ClustersConfig{
+		KubeappsClusterName: "default",
+		Clusters: map[string]kube.ClusterConfig{
+			"default": {
+				Name:              "default",
+				IsKubeappsCluster: true,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		allowAnonymousReads bool
+		fullMethod          string
+		authorization       string
+		expectedToken       string
+	}{
+		{
+			name:                "it uses the in-cluster config for a catalog read without a token when anonymous reads are allowed",
+			allowAnonymousReads: true,
+			fullMethod:          "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageSummaries",
+			expectedToken:       "service-account-token",
+		},
+		{
+			name:                "it uses the user token when anonymous reads are allowed",
+			allowAnonymousReads: true,
+			fullMethod:          "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageSummaries",
+			authorization:       "Bearer abc",
+			expectedToken:       "abc",
+		},
+		{
+			name:                "it delegates the other calls without a token to the RBAC when anonymous reads are allowed",
+			allowAnonymousReads: true,
+			fullMethod:          "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageSummaries",
+			expectedToken:       "",
+		},
+		{
+			name:                "it delegates the requests without a call to the RBAC when anonymous reads are allowed",
+			allowAnonymousReads: true,
+			expectedToken:       "",
+		},
+		{
+			name:          "it delegates the requests without a token to the RBAC by default",
+			fullMethod:    "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageSummaries",
+			expectedToken: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.fullMethod != "" {
+				ctx = grpc.NewContextWithServerTransportStream(ctx, &testServerTransportStream{method: tc.fullMethod})
+			}
+			if tc.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tc.authorization))
+			}
+			configGetter, err := createConfigGetterWithParams(inClusterConfig, ServeOptions{AllowAnonymousReads: tc.allowAnonymousReads}, clustersConfig)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			restConfig, err := configGetter(ctx, "")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := restConfig.BearerToken, tc.expectedToken; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
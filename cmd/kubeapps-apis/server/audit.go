@@ -0,0 +1,202 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	// auditLogStdout is the audit log path used to write the records to stdout.
+	auditLogStdout = "stdout"
+	// anonymousUser is the user recorded for requests without a token.
+	anonymousUser = "anonymous"
+	// unknownUser is the user recorded for requests with a token which does
+	// not include a user identity.
+	unknownUser = "unknown"
+)
+
+// auditedMethodPrefixes are the prefixes of the (mutating) methods for which
+// an audit record is written.
+var auditedMethodPrefixes = []string{"Create", "Update", "Delete", "Rollback"}
+
+// auditRecord is the structured record written for each mutating call.
+type auditRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	User      string    `json:"user"`
+	Method    string    `json:"method"`
+	Target    string    `json:"target,omitempty"`
+	Code      string    `json:"code"`
+}
+
+// auditLogger writes an audit record, as a JSON line, for each mutating call.
+type auditLogger struct {
+	mu     sync.Mutex
+	writer io.Writer
+	now    func() time.Time
+
+	// file is the audit log file, synced and closed on shutdown, or nil
+	// when writing the records to stdout.
+	file *os.File
+}
+
+// newAuditLogger returns an audit logger writing the records to stdout or
+// appending them to the file at the given path.
+func newAuditLogger(auditLogPath string) (*auditLogger, error) {
+	if auditLogPath == auditLogStdout {
+		return &auditLogger{writer: os.Stdout, now: time.Now}, nil
+	}
+	f, err := os.OpenFile(auditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the audit log %q: %w", auditLogPath, err)
+	}
+	return &auditLogger{writer: f, now: time.Now, file: f}, nil
+}
+
+// close syncs and closes the audit log file, if any, so that no record is
+// lost on shutdown.
+func (a *auditLogger) close() error {
+	if a.file == nil {
+		return nil
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := a.file.Sync(); err != nil {
+		a.file.Close()
+		return fmt.Errorf("unable to sync the audit log: %w", err)
+	}
+	return a.file.Close()
+}
+
+// unaryInterceptor records the result of the mutating calls, reads being excluded.
+func (a *auditLogger) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !isAuditedMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	resp, err := handler(ctx, req)
+
+	record := auditRecord{
+		Timestamp: a.now().UTC(),
+		User:      userFromContext(ctx),
+		Method:    info.FullMethod,
+		Target:    auditTarget(req, resp),
+		Code:      status.Code(err).String(),
+	}
+	if writeErr := a.write(record); writeErr != nil {
+		log.Errorf("Unable to write the audit record %+v: %v", record, writeErr)
+	}
+	return resp, err
+}
+
+func (a *auditLogger) write(record auditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, err = a.writer.Write(append(line, '\n'))
+	return err
+}
+
+// isAuditedMethod returns whether the full grpc method (eg.
+// "/package.Service/CreateInstalledPackage") is a mutating one.
+func isAuditedMethod(fullMethod string) bool {
+	method := path.Base(fullMethod)
+	for _, prefix := range auditedMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// userFromContext returns the identity of the user from the token of the
+// request, without verifying it (which is done by the cluster). Only the
+// user claims of a JWT are used, the token itself is never recorded.
+func userFromContext(ctx context.Context) string {
+	token, err := extractToken(ctx)
+	if err != nil {
+		return unknownUser
+	}
+	if token == "" {
+		return anonymousUser
+	}
+
+	claims := struct {
+		Email string `json:"email"`
+		Sub   string `json:"sub"`
+	}{}
+	if err = decodeTokenClaims(token, &claims); err != nil {
+		return unknownUser
+	}
+	if claims.Email != "" {
+		return claims.Email
+	}
+	if claims.Sub != "" {
+		return claims.Sub
+	}
+	return unknownUser
+}
+
+// auditTarget returns a description of the installed package targeted by
+// a call, taken from the request or, when being created, from the response.
+func auditTarget(req, resp interface{}) string {
+	type installedPackageRefGetter interface {
+		GetInstalledPackageRef() *packages.InstalledPackageReference
+	}
+
+	var ref *packages.InstalledPackageReference
+	if r, ok := req.(installedPackageRefGetter); ok {
+		ref = r.GetInstalledPackageRef()
+	}
+	if r, ok := resp.(installedPackageRefGetter); ok && ref == nil {
+		ref = r.GetInstalledPackageRef()
+	}
+	if ref != nil {
+		return formatAuditTarget(ref.GetContext(), ref.GetIdentifier(), ref.GetPlugin().GetName())
+	}
+
+	// A failed creation has no installed package reference yet.
+	if r, ok := req.(*packages.CreateInstalledPackageRequest); ok {
+		return formatAuditTarget(r.GetTargetContext(), r.GetName(), r.GetAvailablePackageRef().GetPlugin().GetName())
+	}
+
+	// A batch deletion targets all the requested installed packages.
+	if r, ok := req.(*packages.DeleteInstalledPackagesBatchRequest); ok {
+		targets := []string{}
+		for _, ref := range r.GetInstalledPackageRefs() {
+			targets = append(targets, formatAuditTarget(ref.GetContext(), ref.GetIdentifier(), ref.GetPlugin().GetName()))
+		}
+		return strings.Join(targets, ", ")
+	}
+	return ""
+}
+
+func formatAuditTarget(pkgContext *packages.Context, identifier, pluginName string) string {
+	return fmt.Sprintf("%s/%s/%s (plugin %s)", pkgContext.GetCluster(), pkgContext.GetNamespace(), identifier, pluginName)
+}
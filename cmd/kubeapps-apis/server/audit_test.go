@@ -0,0 +1,244 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// makeTestToken returns an unsigned JWT with the given claims.
+func makeTestToken(t *testing.T, claims map[string]string) string {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	now := time.Date(2021, time.September, 1, 10, 0, 0, 0, time.UTC)
+	plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+	installedPackageRef := &corev1.InstalledPackageReference{
+		Context:    &corev1.Context{Cluster: "default", Namespace: "ns-1"},
+		Identifier: "my-release",
+		Plugin:     plugin,
+	}
+
+	testCases := []struct {
+		name           string
+		method         string
+		token          string
+		request        interface{}
+		handler        grpc.UnaryHandler
+		statusCode     codes.Code
+		expectedRecord *auditRecord
+	}{
+		{
+			name:   "it records a successful creation",
+			method: "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage",
+			token:  makeTestToken(t, map[string]string{"email": "user@example.com", "sub": "1234"}),
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{Plugin: plugin},
+				TargetContext:       &corev1.Context{Cluster: "default", Namespace: "ns-1"},
+				Name:                "my-release",
+			},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &corev1.CreateInstalledPackageResponse{InstalledPackageRef: installedPackageRef}, nil
+			},
+			statusCode: codes.OK,
+			expectedRecord: &auditRecord{
+				Timestamp: now,
+				User:      "user@example.com",
+				Method:    "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage",
+				Target:    "default/ns-1/my-release (plugin plugin-1)",
+				Code:      "OK",
+			},
+		},
+		{
+			name:   "it records a failed deletion",
+			method: "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage",
+			token:  makeTestToken(t, map[string]string{"sub": "system:serviceaccount:kubeapps:default"}),
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return (*corev1.DeleteInstalledPackageResponse)(nil), status.Errorf(codes.PermissionDenied, "forbidden")
+			},
+			statusCode: codes.PermissionDenied,
+			expectedRecord: &auditRecord{
+				Timestamp: now,
+				User:      "system:serviceaccount:kubeapps:default",
+				Method:    "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage",
+				Target:    "default/ns-1/my-release (plugin plugin-1)",
+				Code:      "PermissionDenied",
+			},
+		},
+		{
+			name:   "it records a failed creation of an anonymous user",
+			method: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/CreateInstalledPackage",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{Plugin: plugin},
+				TargetContext:       &corev1.Context{Cluster: "default", Namespace: "ns-1"},
+				Name:                "my-release",
+			},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return (*corev1.CreateInstalledPackageResponse)(nil), status.Errorf(codes.AlreadyExists, "exists")
+			},
+			statusCode: codes.AlreadyExists,
+			expectedRecord: &auditRecord{
+				Timestamp: now,
+				User:      "anonymous",
+				Method:    "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/CreateInstalledPackage",
+				Target:    "default/ns-1/my-release (plugin plugin-1)",
+				Code:      "AlreadyExists",
+			},
+		},
+		{
+			name:   "it records all the targets of a batch deletion",
+			method: "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackagesBatch",
+			token:  makeTestToken(t, map[string]string{"email": "user@example.com"}),
+			request: &corev1.DeleteInstalledPackagesBatchRequest{
+				InstalledPackageRefs: []*corev1.InstalledPackageReference{
+					installedPackageRef,
+					{
+						Context:    &corev1.Context{Cluster: "default", Namespace: "ns-2"},
+						Identifier: "other-release",
+						Plugin:     plugin,
+					},
+				},
+			},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &corev1.DeleteInstalledPackagesBatchResponse{}, nil
+			},
+			statusCode: codes.OK,
+			expectedRecord: &auditRecord{
+				Timestamp: now,
+				User:      "user@example.com",
+				Method:    "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackagesBatch",
+				Target:    "default/ns-1/my-release (plugin plugin-1), default/ns-2/other-release (plugin plugin-1)",
+				Code:      "OK",
+			},
+		},
+		{
+			name:   "it does not record reads",
+			method: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail",
+			request: &corev1.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &corev1.GetInstalledPackageDetailResponse{}, nil
+			},
+			statusCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := &bytes.Buffer{}
+			auditLog := &auditLogger{writer: sink, now: func() time.Time { return now }}
+
+			ctx := context.Background()
+			if tc.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+					"authorization": "Bearer " + tc.token,
+				}))
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			_, err := auditLog.unaryInterceptor(ctx, tc.request, info, tc.handler)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.expectedRecord == nil {
+				if got := sink.String(); got != "" {
+					t.Errorf("got: %q, want: no audit record", got)
+				}
+				return
+			}
+
+			record := &auditRecord{}
+			if err := json.Unmarshal(sink.Bytes(), record); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := record, tc.expectedRecord; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if tc.token != "" && bytes.Contains(sink.Bytes(), []byte(tc.token)) {
+				t.Errorf("the audit record includes the token: %s", sink.String())
+			}
+		})
+	}
+}
+
+func TestAuditLoggerClose(t *testing.T) {
+	auditLogPath := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := newAuditLogger(auditLogPath)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	record := auditRecord{
+		Timestamp: time.Date(2021, time.September, 1, 10, 0, 0, 0, time.UTC),
+		User:      "user-1",
+		Method:    "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage",
+		Code:      codes.OK.String(),
+	}
+	if err := auditLog.write(record); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if err := auditLog.close(); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	content, err := os.ReadFile(auditLogPath)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var got auditRecord
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !cmp.Equal(record, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(record, got))
+	}
+	// The records are no longer written once closed.
+	if err := auditLog.write(record); err == nil {
+		t.Errorf("got: nil, want: error")
+	}
+}
+
+func TestAuditLoggerCloseStdout(t *testing.T) {
+	auditLog, err := newAuditLogger(auditLogStdout)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := auditLog.close(); err != nil {
+		t.Errorf("got: %+v, want: nil", err)
+	}
+}
@@ -0,0 +1,264 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/wait"
+	log "k8s.io/klog/v2"
+)
+
+// maxVersionsCacheEntries is the number of cached version listings above
+// which new listings are no longer cached until the expired ones are evicted.
+const maxVersionsCacheEntries = 1000
+
+// versionsCacheRefreshJitter is the maximum factor by which the interval
+// between two background refreshes is randomly extended, so that the
+// refreshes of the replicas and plugins do not hit the clusters together.
+const versionsCacheRefreshJitter = 0.1
+
+// versionsCacheRequests counts the lookups of the version listings cache by
+// plugin and result (hit or miss), from which the hit rate can be computed.
+var versionsCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "kubeapps_apis",
+	Name:      "versions_cache_requests_total",
+	Help:      "The number of GetAvailablePackageVersions calls looked up in the cache, by plugin and result (hit or miss).",
+}, []string{"plugin", "result"})
+
+// anonymousTokenHash is the token hash of the listings fetched without a
+// token.
+var anonymousTokenHash = fmt.Sprintf("%x", sha256.Sum256([]byte("")))
+
+// versionsCacheKey identifies a cached version listing. Both the request and
+// the user token are hashed, so that users only get the listings fetched with
+// their own credentials and no token is kept in memory.
+type versionsCacheKey struct {
+	plugin      string
+	requestHash string
+	tokenHash   string
+}
+
+type versionsCacheEntry struct {
+	response *packages.GetAvailablePackageVersionsResponse
+	expires  time.Time
+
+	// hits is the number of times the entry was returned since fetched, so
+	// that only the popular listings are refreshed in the background.
+	hits int
+	// request is the request with which the listing was fetched, only kept
+	// for the anonymous listings when the background refresh is enabled, to
+	// fetch it again.
+	request *packages.GetAvailablePackageVersionsRequest
+}
+
+// versionsCachingPackagesServer wraps the packages server implementation of a
+// plugin so that the GetAvailablePackageVersions responses, which rarely
+// change but are requested often, are cached for the given TTL. When a
+// refresh interval is set, the popular anonymous listings about to expire are
+// fetched again in the background, so that they are not missed. The
+// background refreshes are made with the token of the token source, such as
+// the service account of the server, with which the anonymous reads are made
+// anyway. The listings fetched with the token of a user are never refreshed,
+// so that users only get the listings fetched with their own credentials.
+type versionsCachingPackagesServer struct {
+	PackagesPluginServer
+
+	plugin *plugins.Plugin
+	ttl    time.Duration
+	now    func() time.Time
+
+	// refreshInterval is the interval between the background refreshes,
+	// disabled when zero, and refreshConcurrency the maximum number of
+	// listings fetched concurrently by a refresh.
+	refreshInterval    time.Duration
+	refreshConcurrency int
+	// tokenSource provides the token of the background refreshes.
+	tokenSource TokenSource
+
+	mutex   sync.Mutex
+	entries map[versionsCacheKey]versionsCacheEntry
+}
+
+func newVersionsCachingPackagesServer(plugin *plugins.Plugin, server PackagesPluginServer, ttl time.Duration, refreshInterval time.Duration, refreshConcurrency int, tokenSource TokenSource) *versionsCachingPackagesServer {
+	if refreshConcurrency < 1 {
+		refreshConcurrency = 1
+	}
+	return &versionsCachingPackagesServer{
+		PackagesPluginServer: server,
+		plugin:               plugin,
+		ttl:                  ttl,
+		now:                  time.Now,
+		refreshInterval:      refreshInterval,
+		refreshConcurrency:   refreshConcurrency,
+		tokenSource:          tokenSource,
+		entries:              map[versionsCacheKey]versionsCacheEntry{},
+	}
+}
+
+// cacheKey returns the key of the version listing for the request made with
+// the user token of the context.
+func (s *versionsCachingPackagesServer) cacheKey(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (versionsCacheKey, error) {
+	requestBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return versionsCacheKey{}, err
+	}
+	token := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationMetadataKey); len(values) > 0 {
+			token = values[0]
+		}
+	}
+	return versionsCacheKey{
+		plugin:      s.plugin.GetName(),
+		requestHash: fmt.Sprintf("%x", sha256.Sum256(requestBytes)),
+		tokenHash:   fmt.Sprintf("%x", sha256.Sum256([]byte(token))),
+	}, nil
+}
+
+func (s *versionsCachingPackagesServer) get(key versionsCacheKey) (*packages.GetAvailablePackageVersionsResponse, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	entry, ok := s.entries[key]
+	if !ok || !s.now().Before(entry.expires) {
+		return nil, false
+	}
+	entry.hits++
+	s.entries[key] = entry
+	return entry.response, true
+}
+
+func (s *versionsCachingPackagesServer) set(key versionsCacheKey, request *packages.GetAvailablePackageVersionsRequest, response *packages.GetAvailablePackageVersionsResponse) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.now()
+	if len(s.entries) >= maxVersionsCacheEntries {
+		for k, entry := range s.entries {
+			if !now.Before(entry.expires) {
+				delete(s.entries, k)
+			}
+		}
+		if len(s.entries) >= maxVersionsCacheEntries {
+			return
+		}
+	}
+	entry := versionsCacheEntry{
+		response: response,
+		expires:  now.Add(s.ttl),
+	}
+	if s.refreshInterval > 0 && key.tokenHash == anonymousTokenHash {
+		entry.request = request
+	}
+	s.entries[key] = entry
+}
+
+func (s *versionsCachingPackagesServer) GetAvailablePackageVersions(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error) {
+	key, err := s.cacheKey(ctx, request)
+	if err != nil {
+		return s.PackagesPluginServer.GetAvailablePackageVersions(ctx, request)
+	}
+
+	if response, ok := s.get(key); ok {
+		versionsCacheRequests.WithLabelValues(s.plugin.GetName(), "hit").Inc()
+		return proto.Clone(response).(*packages.GetAvailablePackageVersionsResponse), nil
+	}
+	versionsCacheRequests.WithLabelValues(s.plugin.GetName(), "miss").Inc()
+
+	response, err := s.PackagesPluginServer.GetAvailablePackageVersions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	// Cache a copy, as the caller may modify the response.
+	s.set(key, proto.Clone(request).(*packages.GetAvailablePackageVersionsRequest), proto.Clone(response).(*packages.GetAvailablePackageVersionsResponse))
+	return response, nil
+}
+
+// startRefresh refreshes the cached listings in the background, every
+// refresh interval extended by a random jitter, until the context is done.
+func (s *versionsCachingPackagesServer) startRefresh(ctx context.Context) {
+	if s.refreshInterval <= 0 {
+		return
+	}
+	go wait.JitterUntilWithContext(ctx, s.refresh, s.refreshInterval, versionsCacheRefreshJitter, true)
+}
+
+// refresh fetches again the anonymous listings which were returned at least
+// once since fetched and expire before the next refresh, so that they are replaced
+// before the users miss them. Failed refreshes are logged and the listing
+// then expires as usual.
+func (s *versionsCachingPackagesServer) refresh(ctx context.Context) {
+	now := s.now()
+	horizon := now.Add(time.Duration(float64(s.refreshInterval) * (1 + versionsCacheRefreshJitter)))
+
+	s.mutex.Lock()
+	keys := []versionsCacheKey{}
+	for key, entry := range s.entries {
+		if entry.hits > 0 && entry.request != nil && now.Before(entry.expires) && !horizon.Before(entry.expires) {
+			keys = append(keys, key)
+		}
+	}
+	s.mutex.Unlock()
+
+	semaphore := make(chan struct{}, s.refreshConcurrency)
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(key versionsCacheKey) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			s.refreshEntry(ctx, key)
+		}(key)
+	}
+	wg.Wait()
+}
+
+func (s *versionsCachingPackagesServer) refreshEntry(ctx context.Context, key versionsCacheKey) {
+	s.mutex.Lock()
+	entry, ok := s.entries[key]
+	s.mutex.Unlock()
+	if !ok {
+		return
+	}
+
+	token, err := s.tokenSource.Token()
+	if err != nil {
+		log.Errorf("Unable to get the token to refresh the cached versions from the plugin %s: %v", s.plugin.GetName(), err)
+		return
+	}
+	md := metadata.Pairs(authorizationMetadataKey, "Bearer "+token)
+	response, err := s.PackagesPluginServer.GetAvailablePackageVersions(metadata.NewIncomingContext(ctx, md), entry.request)
+	if err != nil {
+		log.Errorf("Unable to refresh the cached versions of %q from the plugin %s: %v", entry.request.GetAvailablePackageRef().GetIdentifier(), s.plugin.GetName(), err)
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	entry.response = response
+	entry.expires = s.now().Add(s.ttl)
+	entry.hits = 0
+	s.entries[key] = entry
+}
@@ -0,0 +1,282 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc/metadata"
+)
+
+// countingPackagingPluginServer is a test plugin counting the calls made to
+// GetAvailablePackageVersions and recording their authorization, if asked.
+type countingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	calls          *int
+	authorizations *[]string
+}
+
+func (s countingPackagingPluginServer) GetAvailablePackageVersions(ctx context.Context, request *corev1.GetAvailablePackageVersionsRequest) (*corev1.GetAvailablePackageVersionsResponse, error) {
+	*s.calls++
+	if s.authorizations != nil {
+		authorization := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("authorization")) > 0 {
+			authorization = md.Get("authorization")[0]
+		}
+		*s.authorizations = append(*s.authorizations, authorization)
+	}
+	return s.TestPackagingPluginServer.GetAvailablePackageVersions(ctx, request)
+}
+
+// versionsCacheCall is a GetAvailablePackageVersions call made with a token,
+// if any, after the given delay since the first call.
+type versionsCacheCall struct {
+	identifier string
+	token      string
+	after      time.Duration
+}
+
+func TestVersionsCachingPackagesServer(t *testing.T) {
+	testCases := []struct {
+		name           string
+		calls          []versionsCacheCall
+		expectedCalls  int
+		expectedHits   float64
+		expectedMisses float64
+	}{
+		{
+			name: "it returns the cached versions for the same package and token",
+			calls: []versionsCacheCall{
+				{identifier: "pkg-1", token: "token-1"},
+				{identifier: "pkg-1", token: "token-1", after: 10 * time.Second},
+			},
+			expectedCalls:  1,
+			expectedHits:   1,
+			expectedMisses: 1,
+		},
+		{
+			name: "it calls the plugin for a different package",
+			calls: []versionsCacheCall{
+				{identifier: "pkg-1", token: "token-1"},
+				{identifier: "pkg-2", token: "token-1"},
+			},
+			expectedCalls:  2,
+			expectedHits:   0,
+			expectedMisses: 2,
+		},
+		{
+			name: "it does not share the cached versions between tokens",
+			calls: []versionsCacheCall{
+				{identifier: "pkg-1", token: "token-1"},
+				{identifier: "pkg-1", token: "token-2"},
+				{identifier: "pkg-1", token: "token-1"},
+			},
+			expectedCalls:  2,
+			expectedHits:   1,
+			expectedMisses: 2,
+		},
+		{
+			name: "it calls the plugin once the cached versions expire",
+			calls: []versionsCacheCall{
+				{identifier: "pkg-1", token: "token-1"},
+				{identifier: "pkg-1", token: "token-1", after: time.Minute},
+			},
+			expectedCalls:  2,
+			expectedHits:   0,
+			expectedMisses: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Use a plugin name for each test case so that the metrics are
+			// not shared between them.
+			plugin := &plugins.Plugin{Name: tc.name, Version: "v1alpha1"}
+			calls := 0
+			server := newVersionsCachingPackagesServer(plugin, countingPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+				calls:                     &calls,
+			}, time.Minute, 0, 0, nil)
+			start := time.Date(2021, time.September, 1, 10, 0, 0, 0, time.UTC)
+
+			for _, call := range tc.calls {
+				server.now = func() time.Time { return start.Add(call.after) }
+				ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+					"authorization": "Bearer " + call.token,
+				}))
+				_, err := server.GetAvailablePackageVersions(ctx, &corev1.GetAvailablePackageVersionsRequest{
+					AvailablePackageRef: &corev1.AvailablePackageReference{
+						Identifier: call.identifier,
+						Plugin:     plugin,
+					},
+				})
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+
+			if got, want := calls, tc.expectedCalls; got != want {
+				t.Errorf("got: %d calls, want: %d calls", got, want)
+			}
+			if got, want := testutil.ToFloat64(versionsCacheRequests.WithLabelValues(plugin.Name, "hit")), tc.expectedHits; got != want {
+				t.Errorf("got: %v hits, want: %v hits", got, want)
+			}
+			if got, want := testutil.ToFloat64(versionsCacheRequests.WithLabelValues(plugin.Name, "miss")), tc.expectedMisses; got != want {
+				t.Errorf("got: %v misses, want: %v misses", got, want)
+			}
+		})
+	}
+}
+
+func TestVersionsCachingPackagesServerRefresh(t *testing.T) {
+	testCases := []struct {
+		name string
+		// calls are made before the refresh, which happens after refreshAt.
+		calls         []versionsCacheCall
+		refreshAt     time.Duration
+		expectedCalls int
+		// expectedHit is whether a last call, made once the listing first
+		// cached has expired, is a cache hit.
+		expectedHit bool
+		// tokenSourceErr fails the token source of the refresh.
+		tokenSourceErr error
+		// expectedAuthorizations are the authorizations of the calls made
+		// to the plugin.
+		expectedAuthorizations []string
+	}{
+		{
+			name: "it refreshes an anonymous listing requested again before it expires",
+			calls: []versionsCacheCall{
+				{identifier: "pkg-1"},
+				{identifier: "pkg-1", after: 10 * time.Second},
+			},
+			refreshAt:              50 * time.Second,
+			expectedCalls:          2,
+			expectedHit:            true,
+			expectedAuthorizations: []string{"", "Bearer service-account-token"},
+		},
+		{
+			name: "it does not refresh a listing fetched with the token of a user",
+			calls: []versionsCacheCall{
+				{identifier: "pkg-1", token: "token-1"},
+				{identifier: "pkg-1", token: "token-1", after: 10 * time.Second},
+			},
+			refreshAt:              50 * time.Second,
+			expectedCalls:          2,
+			expectedHit:            false,
+			expectedAuthorizations: []string{"Bearer token-1", "Bearer token-1"},
+		},
+		{
+			name: "it does not refresh a listing without the token of the token source",
+			calls: []versionsCacheCall{
+				{identifier: "pkg-1"},
+				{identifier: "pkg-1", after: 10 * time.Second},
+			},
+			refreshAt:              50 * time.Second,
+			tokenSourceErr:         errors.New("unable to read the token file"),
+			expectedCalls:          2,
+			expectedHit:            false,
+			expectedAuthorizations: []string{"", ""},
+		},
+		{
+			name: "it does not refresh a listing which was not requested again",
+			calls: []versionsCacheCall{
+				{identifier: "pkg-1"},
+			},
+			refreshAt:     50 * time.Second,
+			expectedCalls: 2,
+			expectedHit:   false,
+		},
+		{
+			name: "it does not refresh a listing expiring after the next refresh",
+			calls: []versionsCacheCall{
+				{identifier: "pkg-1"},
+				{identifier: "pkg-1", after: 10 * time.Second},
+			},
+			refreshAt:     20 * time.Second,
+			expectedCalls: 2,
+			expectedHit:   false,
+		},
+		{
+			name: "it does not refresh an expired listing",
+			calls: []versionsCacheCall{
+				{identifier: "pkg-1"},
+				{identifier: "pkg-1", after: 10 * time.Second},
+			},
+			refreshAt:     70 * time.Second,
+			expectedCalls: 2,
+			expectedHit:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "refresh " + tc.name, Version: "v1alpha1"}
+			calls, authorizations := 0, []string{}
+			tokenSource := staticTokenSource{token: "service-account-token", err: tc.tokenSourceErr}
+			server := newVersionsCachingPackagesServer(plugin, countingPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+				calls:                     &calls,
+				authorizations:            &authorizations,
+			}, time.Minute, 20*time.Second, 2, tokenSource)
+			start := time.Date(2021, time.September, 1, 10, 0, 0, 0, time.UTC)
+
+			getVersions := func(call versionsCacheCall) {
+				server.now = func() time.Time { return start.Add(call.after) }
+				ctx := context.Background()
+				if call.token != "" {
+					ctx = metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+						"authorization": "Bearer " + call.token,
+					}))
+				}
+				_, err := server.GetAvailablePackageVersions(ctx, &corev1.GetAvailablePackageVersionsRequest{
+					AvailablePackageRef: &corev1.AvailablePackageReference{
+						Identifier: call.identifier,
+						Plugin:     plugin,
+					},
+				})
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+
+			for _, call := range tc.calls {
+				getVersions(call)
+			}
+			server.now = func() time.Time { return start.Add(tc.refreshAt) }
+			server.refresh(context.Background())
+			getVersions(versionsCacheCall{identifier: "pkg-1", token: tc.calls[0].token, after: 90 * time.Second})
+
+			if got, want := calls, tc.expectedCalls; got != want {
+				t.Errorf("got: %d calls, want: %d calls", got, want)
+			}
+			if got, want := testutil.ToFloat64(versionsCacheRequests.WithLabelValues(plugin.Name, "hit")) > float64(len(tc.calls)-1), tc.expectedHit; got != want {
+				t.Errorf("got hit: %t, want hit: %t", got, want)
+			}
+			if tc.expectedAuthorizations != nil {
+				if got, want := authorizations, tc.expectedAuthorizations; !cmp.Equal(want, got) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// PluginEndpoints are the addresses of the backends serving the calls of a
+// plugin in a highly available deployment, the reads being served by the
+// Read backend and the mutations by the Write one. The calls are served by
+// the plugin loaded in-process when the corresponding address is blank.
+type PluginEndpoints struct {
+	Read  string
+	Write string
+}
+
+// ParsePluginEndpoints parses the endpoints specified as
+// "<plugin-name>:<read|write>=<address>" (eg.
+// "helm.packages:read=helm-read:50051") into the endpoints of each plugin.
+func ParsePluginEndpoints(specs []string) (map[string]PluginEndpoints, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	pluginEndpoints := map[string]PluginEndpoints{}
+	for _, spec := range specs {
+		pluginName, endpoint := "", ""
+		if i := strings.Index(spec, ":"); i > 0 {
+			pluginName, endpoint = spec[:i], spec[i+1:]
+		}
+		kind, address := "", ""
+		if i := strings.Index(endpoint, "="); i > 0 {
+			kind, address = endpoint[:i], endpoint[i+1:]
+		}
+		endpoints := pluginEndpoints[pluginName]
+		switch {
+		case pluginName == "" || address == "":
+			return nil, fmt.Errorf("invalid plugin endpoint %q, expected <plugin-name>:<read|write>=<address>", spec)
+		case kind == "read":
+			endpoints.Read = address
+		case kind == "write":
+			endpoints.Write = address
+		default:
+			return nil, fmt.Errorf("invalid plugin endpoint %q, expected <plugin-name>:<read|write>=<address>", spec)
+		}
+		pluginEndpoints[pluginName] = endpoints
+	}
+	return pluginEndpoints, nil
+}
+
+// PluginEndpointsTLS is the TLS configuration of the connections to the
+// plugin endpoints. The connections use TLS, verifying the endpoints with the
+// system CAs by default, unless Insecure.
+type PluginEndpointsTLS struct {
+	// CAFile is the file of the PEM encoded CAs verifying the endpoints,
+	// rather than the system CAs.
+	CAFile string
+	// CertFile and KeyFile are the files of the PEM encoded client
+	// certificate and key authenticating to the endpoints, if any.
+	CertFile string
+	KeyFile  string
+	// Insecure uses plaintext connections, without TLS.
+	Insecure bool
+}
+
+// pluginEndpointsDialOption returns the dial option securing the connections
+// to the plugin endpoints with the TLS configuration.
+func pluginEndpointsDialOption(endpointsTLS PluginEndpointsTLS) (grpc.DialOption, error) {
+	if endpointsTLS.Insecure {
+		return grpc.WithInsecure(), nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if endpointsTLS.CAFile != "" {
+		caCerts, err := ioutil.ReadFile(endpointsTLS.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the plugin endpoints CA file: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCerts) {
+			return nil, fmt.Errorf("no PEM encoded certificate in the plugin endpoints CA file %q", endpointsTLS.CAFile)
+		}
+	}
+	if (endpointsTLS.CertFile == "") != (endpointsTLS.KeyFile == "") {
+		return nil, fmt.Errorf("both the client certificate and key files are required for the plugin endpoints")
+	}
+	if endpointsTLS.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(endpointsTLS.CertFile, endpointsTLS.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the plugin endpoints client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+// newPluginEndpointsServer returns the packages server routing the reads of a
+// plugin to its read endpoint and the mutations to its write endpoint, using
+// the in-process server of the plugin for an endpoint which is not
+// configured. The connections are established lazily, on the first call.
+func newPluginEndpointsServer(local PackagesPluginServer, endpoints PluginEndpoints, dialOptions ...grpc.DialOption) (PackagesPluginServer, error) {
+	endpointServer := func(address string) (PackagesPluginServer, error) {
+		if address == "" {
+			return local, nil
+		}
+		conn, err := grpc.Dial(address, dialOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to dial the plugin endpoint %q: %w", address, err)
+		}
+		return &remotePackagesServer{client: packages.NewPackagesServiceClient(conn)}, nil
+	}
+	read, err := endpointServer(endpoints.Read)
+	if err != nil {
+		return nil, err
+	}
+	write, err := endpointServer(endpoints.Write)
+	if err != nil {
+		return nil, err
+	}
+	return &readWriteSplitPackagesServer{PackagesPluginServer: read, write: write}, nil
+}
+
+// readWriteSplitPackagesServer serves the reads with the embedded packages
+// server and the mutations with the write one.
+type readWriteSplitPackagesServer struct {
+	PackagesPluginServer
+
+	write PackagesPluginServer
+}
+
+func (s *readWriteSplitPackagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
+	return s.write.CreateInstalledPackage(ctx, request)
+}
+
+func (s *readWriteSplitPackagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
+	return s.write.UpdateInstalledPackage(ctx, request)
+}
+
+func (s *readWriteSplitPackagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
+	return s.write.DeleteInstalledPackage(ctx, request)
+}
+
+// remotePackagesServer serves the calls with a plugin backend, the outgoing
+// metadata of the calls, such as the authorization, being sent to it.
+type remotePackagesServer struct {
+	packages.UnimplementedPackagesServiceServer
+
+	client packages.PackagesServiceClient
+}
+
+func (s *remotePackagesServer) GetAvailablePackageSummaries(ctx context.Context, request *packages.GetAvailablePackageSummariesRequest) (*packages.GetAvailablePackageSummariesResponse, error) {
+	return s.client.GetAvailablePackageSummaries(ctx, request)
+}
+
+func (s *remotePackagesServer) GetAvailablePackageDetail(ctx context.Context, request *packages.GetAvailablePackageDetailRequest) (*packages.GetAvailablePackageDetailResponse, error) {
+	return s.client.GetAvailablePackageDetail(ctx, request)
+}
+
+func (s *remotePackagesServer) GetAvailablePackageVersions(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error) {
+	return s.client.GetAvailablePackageVersions(ctx, request)
+}
+
+func (s *remotePackagesServer) GetAvailablePackageDependencies(ctx context.Context, request *packages.GetAvailablePackageDependenciesRequest) (*packages.GetAvailablePackageDependenciesResponse, error) {
+	return s.client.GetAvailablePackageDependencies(ctx, request)
+}
+
+func (s *remotePackagesServer) GetAvailablePackageDefaultValues(ctx context.Context, request *packages.GetAvailablePackageDefaultValuesRequest) (*packages.GetAvailablePackageDefaultValuesResponse, error) {
+	return s.client.GetAvailablePackageDefaultValues(ctx, request)
+}
+
+func (s *remotePackagesServer) GetAvailablePackageChangelog(ctx context.Context, request *packages.GetAvailablePackageChangelogRequest) (*packages.GetAvailablePackageChangelogResponse, error) {
+	return s.client.GetAvailablePackageChangelog(ctx, request)
+}
+
+func (s *remotePackagesServer) GetAvailablePackageValuesDiff(ctx context.Context, request *packages.GetAvailablePackageValuesDiffRequest) (*packages.GetAvailablePackageValuesDiffResponse, error) {
+	return s.client.GetAvailablePackageValuesDiff(ctx, request)
+}
+
+func (s *remotePackagesServer) RenderAvailablePackage(ctx context.Context, request *packages.RenderAvailablePackageRequest) (*packages.RenderAvailablePackageResponse, error) {
+	return s.client.RenderAvailablePackage(ctx, request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error) {
+	return s.client.GetInstalledPackageSummaries(ctx, request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageDetail(ctx context.Context, request *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error) {
+	return s.client.GetInstalledPackageDetail(ctx, request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageValues(ctx context.Context, request *packages.GetInstalledPackageValuesRequest) (*packages.GetInstalledPackageValuesResponse, error) {
+	return s.client.GetInstalledPackageValues(ctx, request)
+}
+
+func (s *remotePackagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
+	return s.client.CreateInstalledPackage(ctx, request)
+}
+
+func (s *remotePackagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
+	return s.client.UpdateInstalledPackage(ctx, request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageUpgradeDiff(ctx context.Context, request *packages.GetInstalledPackageUpgradeDiffRequest) (*packages.GetInstalledPackageUpgradeDiffResponse, error) {
+	return s.client.GetInstalledPackageUpgradeDiff(ctx, request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageDrift(ctx context.Context, request *packages.GetInstalledPackageDriftRequest) (*packages.GetInstalledPackageDriftResponse, error) {
+	return s.client.GetInstalledPackageDrift(ctx, request)
+}
+
+func (s *remotePackagesServer) ExportInstalledPackage(ctx context.Context, request *packages.ExportInstalledPackageRequest) (*packages.ExportInstalledPackageResponse, error) {
+	return s.client.ExportInstalledPackage(ctx, request)
+}
+
+func (s *remotePackagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
+	return s.client.DeleteInstalledPackage(ctx, request)
+}
+
+func (s *remotePackagesServer) GetRecentActivity(ctx context.Context, request *packages.GetRecentActivityRequest) (*packages.GetRecentActivityResponse, error) {
+	return s.client.GetRecentActivity(ctx, request)
+}
+
+func (s *remotePackagesServer) GetUserPermissions(ctx context.Context, request *packages.GetUserPermissionsRequest) (*packages.GetUserPermissionsResponse, error) {
+	return s.client.GetUserPermissions(ctx, request)
+}
@@ -0,0 +1,265 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// backendPackagingPluginServer is a test plugin backend identifying itself
+// in its responses.
+type backendPackagingPluginServer struct {
+	corev1.UnimplementedPackagesServiceServer
+
+	name string
+}
+
+func (s backendPackagingPluginServer) GetInstalledPackageDetail(ctx context.Context, request *corev1.GetInstalledPackageDetailRequest) (*corev1.GetInstalledPackageDetailResponse, error) {
+	return &corev1.GetInstalledPackageDetailResponse{
+		InstalledPackageDetail: &corev1.InstalledPackageDetail{Name: s.name},
+	}, nil
+}
+
+func (s backendPackagingPluginServer) CreateInstalledPackage(ctx context.Context, request *corev1.CreateInstalledPackageRequest) (*corev1.CreateInstalledPackageResponse, error) {
+	return &corev1.CreateInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{Identifier: s.name},
+	}, nil
+}
+
+// startBackends serves a test plugin backend for each address, in memory,
+// returning the option dialing them.
+func startBackends(t *testing.T, addresses ...string) grpc.DialOption {
+	listeners := map[string]*bufconn.Listener{}
+	for _, address := range addresses {
+		listener := bufconn.Listen(1024 * 1024)
+		grpcServer := grpc.NewServer()
+		corev1.RegisterPackagesServiceServer(grpcServer, backendPackagingPluginServer{name: address})
+		go grpcServer.Serve(listener)
+		t.Cleanup(grpcServer.Stop)
+		listeners[address] = listener
+	}
+	return grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+		return listeners[address].Dial()
+	})
+}
+
+func TestPluginEndpointsServer(t *testing.T) {
+	testCases := []struct {
+		name          string
+		endpoints     PluginEndpoints
+		expectedRead  string
+		expectedWrite string
+	}{
+		{
+			name:          "it routes the reads to the read endpoint and the writes to the write endpoint",
+			endpoints:     PluginEndpoints{Read: "read-backend", Write: "write-backend"},
+			expectedRead:  "read-backend",
+			expectedWrite: "write-backend",
+		},
+		{
+			name:          "it routes the writes to the in-process plugin without write endpoint",
+			endpoints:     PluginEndpoints{Read: "read-backend"},
+			expectedRead:  "read-backend",
+			expectedWrite: "local",
+		},
+		{
+			name:          "it routes the reads to the in-process plugin without read endpoint",
+			endpoints:     PluginEndpoints{Write: "write-backend"},
+			expectedRead:  "local",
+			expectedWrite: "write-backend",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dialer := startBackends(t, "read-backend", "write-backend")
+			server, err := newPluginEndpointsServer(backendPackagingPluginServer{name: "local"}, tc.endpoints, dialer, grpc.WithInsecure())
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			detail, err := server.GetInstalledPackageDetail(context.Background(), &corev1.GetInstalledPackageDetailRequest{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := detail.GetInstalledPackageDetail().GetName(), tc.expectedRead; got != want {
+				t.Errorf("got read served by: %q, want: %q", got, want)
+			}
+
+			created, err := server.CreateInstalledPackage(context.Background(), &corev1.CreateInstalledPackageRequest{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := created.GetInstalledPackageRef().GetIdentifier(), tc.expectedWrite; got != want {
+				t.Errorf("got write served by: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParsePluginEndpoints(t *testing.T) {
+	testCases := []struct {
+		name              string
+		specs             []string
+		expectedEndpoints map[string]PluginEndpoints
+		expectError       bool
+	}{
+		{
+			name:  "it parses the endpoints of several plugins",
+			specs: []string{"helm.packages:read=helm-read:50051", "helm.packages:write=helm-write:50051", "fluxv2.packages:read=flux-read:50051"},
+			expectedEndpoints: map[string]PluginEndpoints{
+				"helm.packages":   {Read: "helm-read:50051", Write: "helm-write:50051"},
+				"fluxv2.packages": {Read: "flux-read:50051"},
+			},
+		},
+		{
+			name: "it returns no endpoints when none are specified",
+		},
+		{
+			name:        "it rejects an unknown kind of endpoint",
+			specs:       []string{"helm.packages:admin=helm-admin:50051"},
+			expectError: true,
+		},
+		{
+			name:        "it rejects an endpoint without address",
+			specs:       []string{"helm.packages:read="},
+			expectError: true,
+		},
+		{
+			name:        "it rejects an endpoint without plugin",
+			specs:       []string{"read=helm-read:50051"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			endpoints, err := ParsePluginEndpoints(tc.specs)
+			if got, want := err != nil, tc.expectError; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+			if got, want := endpoints, tc.expectedEndpoints; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+// writeTestCertificate writes a self-signed PEM encoded certificate and its
+// key in the directory, returning their files.
+func writeTestCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kubeapps-apis"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return certFile, keyFile
+}
+
+func TestPluginEndpointsDialOption(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCertificate(t, dir)
+	notPEMFile := filepath.Join(dir, "not-pem")
+	if err := ioutil.WriteFile(notPEMFile, []byte("not a certificate"), 0600); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	testCases := []struct {
+		name         string
+		endpointsTLS PluginEndpointsTLS
+		expectError  bool
+	}{
+		{
+			name: "it uses TLS with the system CAs by default",
+		},
+		{
+			name:         "it uses TLS with the configured CA and client certificate",
+			endpointsTLS: PluginEndpointsTLS{CAFile: certFile, CertFile: certFile, KeyFile: keyFile},
+		},
+		{
+			name:         "it uses plaintext connections when insecure",
+			endpointsTLS: PluginEndpointsTLS{Insecure: true},
+		},
+		{
+			name:         "it fails with a missing CA file",
+			endpointsTLS: PluginEndpointsTLS{CAFile: filepath.Join(dir, "missing.crt")},
+			expectError:  true,
+		},
+		{
+			name:         "it fails with a CA file without certificate",
+			endpointsTLS: PluginEndpointsTLS{CAFile: notPEMFile},
+			expectError:  true,
+		},
+		{
+			name:         "it fails with a client certificate without key",
+			endpointsTLS: PluginEndpointsTLS{CertFile: certFile},
+			expectError:  true,
+		},
+		{
+			name:         "it fails with an invalid client key",
+			endpointsTLS: PluginEndpointsTLS{CertFile: certFile, KeyFile: notPEMFile},
+			expectError:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dialOption, err := pluginEndpointsDialOption(tc.endpointsTLS)
+			if got, want := err != nil, tc.expectError; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+			if !tc.expectError && dialOption == nil {
+				t.Errorf("got no dial option")
+			}
+		})
+	}
+}
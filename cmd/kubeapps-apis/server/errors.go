@@ -0,0 +1,110 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	// ClientErrorVerbosityMinimal only returns a generic message with a
+	// correlation id to the clients, the actual error being logged.
+	ClientErrorVerbosityMinimal = "minimal"
+	// ClientErrorVerbosityDetailed returns the full error to the clients.
+	ClientErrorVerbosityDetailed = "detailed"
+)
+
+// clientErrorMinimizer replaces the message of the errors returned to the
+// clients with a generic one, so that no implementation details leak. The
+// status code is preserved and the error is logged with a correlation id,
+// included in the returned message, to match both when debugging. The
+// messages of the errors caused by the request itself, such as an invalid
+// argument or a missing package, are kept since the client needs them to
+// fix the request.
+type clientErrorMinimizer struct {
+	newCorrelationID func() (string, error)
+}
+
+func newClientErrorMinimizer() *clientErrorMinimizer {
+	return &clientErrorMinimizer{newCorrelationID: randomCorrelationID}
+}
+
+// randomCorrelationID returns a random 16 hex characters identifier.
+func randomCorrelationID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// clientErrorCodes are the codes of the errors whose message is returned
+// as is to the clients, since it describes their request.
+var clientErrorCodes = map[codes.Code]bool{
+	codes.InvalidArgument: true,
+	codes.NotFound:        true,
+	codes.AlreadyExists:   true,
+}
+
+// unaryInterceptor minimizes the error, if any, of the unary calls.
+func (m *clientErrorMinimizer) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, m.minimize(info.FullMethod, err)
+}
+
+// streamInterceptor minimizes the error, if any, of the streaming calls.
+func (m *clientErrorMinimizer) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return m.minimize(info.FullMethod, handler(srv, ss))
+}
+
+// minimize returns the error with a generic message, unless it is caused by
+// the request itself, logging the original error with a correlation id.
+func (m *clientErrorMinimizer) minimize(fullMethod string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	code := status.Code(err)
+	if clientErrorCodes[code] {
+		return err
+	}
+	correlationID, idErr := m.newCorrelationID()
+	if idErr != nil {
+		log.Errorf("Unable to generate a correlation id: %v", idErr)
+		correlationID = "unknown"
+	}
+	log.Errorf("Error with correlation id %s in %q: %v", correlationID, fullMethod, err)
+	return status.Errorf(code, "The request failed with code %s (correlation id: %s)", code, correlationID)
+}
+
+// clientErrorInterceptors returns the unary and stream interceptors required
+// for the given client error verbosity.
+func clientErrorInterceptors(verbosity string) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor, error) {
+	switch verbosity {
+	case ClientErrorVerbosityDetailed, "":
+		return nil, nil, nil
+	case ClientErrorVerbosityMinimal:
+		minimizer := newClientErrorMinimizer()
+		return []grpc.UnaryServerInterceptor{minimizer.unaryInterceptor}, []grpc.StreamServerInterceptor{minimizer.streamInterceptor}, nil
+	default:
+		return nil, nil, fmt.Errorf("invalid client error verbosity %q, expected %q or %q", verbosity, ClientErrorVerbosityMinimal, ClientErrorVerbosityDetailed)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientErrorInterceptors(t *testing.T) {
+	handlerErr := status.Errorf(codes.Internal, "Unable to fetch the chart bitnami/apache from the database: dial tcp 10.0.0.12:5432: connect: connection refused")
+	notFoundErr := status.Errorf(codes.NotFound, "Unable get the GetAvailablePackageDetail from the plugin helm.packages: chart \"bitnami/apache\" not found in the database")
+
+	testCases := []struct {
+		name            string
+		verbosity       string
+		handlerErr      error
+		expectedErr     bool
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:            "it returns the full error with the detailed verbosity",
+			verbosity:       ClientErrorVerbosityDetailed,
+			handlerErr:      handlerErr,
+			expectedCode:    codes.Internal,
+			expectedMessage: status.Convert(handlerErr).Message(),
+		},
+		{
+			name:            "it returns the full error by default",
+			verbosity:       "",
+			handlerErr:      handlerErr,
+			expectedCode:    codes.Internal,
+			expectedMessage: status.Convert(handlerErr).Message(),
+		},
+		{
+			name:            "it returns a generic message with a correlation id with the minimal verbosity",
+			verbosity:       ClientErrorVerbosityMinimal,
+			handlerErr:      handlerErr,
+			expectedCode:    codes.Internal,
+			expectedMessage: "The request failed with code Internal (correlation id: 0123456789abcdef)",
+		},
+		{
+			name:            "it returns the full error of the invalid requests with the minimal verbosity",
+			verbosity:       ClientErrorVerbosityMinimal,
+			handlerErr:      status.Errorf(codes.InvalidArgument, "unable to parse the values: yaml: line 1: did not find expected key"),
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "unable to parse the values: yaml: line 1: did not find expected key",
+		},
+		{
+			name:            "it returns the full not found error with the minimal verbosity",
+			verbosity:       ClientErrorVerbosityMinimal,
+			handlerErr:      notFoundErr,
+			expectedCode:    codes.NotFound,
+			expectedMessage: status.Convert(notFoundErr).Message(),
+		},
+		{
+			name:            "it returns the full already exists error with the minimal verbosity",
+			verbosity:       ClientErrorVerbosityMinimal,
+			handlerErr:      status.Errorf(codes.AlreadyExists, "release \"apache\" already exists"),
+			expectedCode:    codes.AlreadyExists,
+			expectedMessage: "release \"apache\" already exists",
+		},
+		{
+			name:         "it does not modify a successful call with the minimal verbosity",
+			verbosity:    ClientErrorVerbosityMinimal,
+			handlerErr:   nil,
+			expectedCode: codes.OK,
+		},
+		{
+			name:        "it rejects an unknown verbosity",
+			verbosity:   "verbose",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			interceptors, _, err := clientErrorInterceptors(tc.verbosity)
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+			if tc.expectedErr {
+				return
+			}
+
+			// Use a fixed correlation id for the minimal verbosity.
+			if tc.verbosity == ClientErrorVerbosityMinimal {
+				minimizer := &clientErrorMinimizer{newCorrelationID: func() (string, error) { return "0123456789abcdef", nil }}
+				interceptors = []grpc.UnaryServerInterceptor{minimizer.unaryInterceptor}
+			}
+
+			var handler grpc.UnaryHandler = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, tc.handlerErr
+			}
+			for i := len(interceptors) - 1; i >= 0; i-- {
+				interceptor, next := interceptors[i], handler
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					return interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, next)
+				}
+			}
+			_, err = handler(context.Background(), nil)
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := status.Convert(err).Message(), tc.expectedMessage; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestClientErrorStreamInterceptor(t *testing.T) {
+	minimizer := &clientErrorMinimizer{newCorrelationID: func() (string, error) { return "0123456789abcdef", nil }}
+
+	testCases := []struct {
+		name            string
+		handlerErr      error
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:            "it minimizes the error of a stream",
+			handlerErr:      status.Errorf(codes.Unavailable, "unable to reach the repository https://charts.bitnami.com/bitnami"),
+			expectedCode:    codes.Unavailable,
+			expectedMessage: "The request failed with code Unavailable (correlation id: 0123456789abcdef)",
+		},
+		{
+			name:            "it returns the full not found error of a stream",
+			handlerErr:      status.Errorf(codes.NotFound, "readme of bitnami/apache not found"),
+			expectedCode:    codes.NotFound,
+			expectedMessage: "readme of bitnami/apache not found",
+		},
+		{
+			name:         "it does not modify a successful stream",
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := minimizer.streamInterceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}, func(srv interface{}, stream grpc.ServerStream) error {
+				return tc.handlerErr
+			})
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := status.Convert(err).Message(), tc.expectedMessage; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
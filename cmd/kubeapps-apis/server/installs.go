@@ -0,0 +1,87 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// installMethods are the methods, of the core or plugin services, creating or
+// updating an installed package, which are limited cluster-wide.
+var installMethods = map[string]bool{
+	"CreateInstalledPackage": true,
+	"UpdateInstalledPackage": true,
+}
+
+// installLimiter limits the number of concurrent install operations across
+// all plugins, so that too many simultaneous installs do not overwhelm the
+// API server of the clusters. The read calls are unaffected.
+type installLimiter struct {
+	semaphore chan struct{}
+
+	// queueTimeout is the duration an install operation waits for another
+	// one to complete when saturated, before being rejected. The operation
+	// is rejected immediately when zero.
+	queueTimeout time.Duration
+}
+
+func newInstallLimiter(maxConcurrentInstalls int, queueTimeout time.Duration) *installLimiter {
+	return &installLimiter{
+		semaphore:    make(chan struct{}, maxConcurrentInstalls),
+		queueTimeout: queueTimeout,
+	}
+}
+
+// acquire takes a slot of the semaphore, waiting up to the queue timeout, and
+// returns a ResourceExhausted error if none is released in time.
+func (l *installLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.semaphore <- struct{}{}:
+		return nil
+	default:
+	}
+	if l.queueTimeout > 0 {
+		timer := time.NewTimer(l.queueTimeout)
+		defer timer.Stop()
+		select {
+		case l.semaphore <- struct{}{}:
+			return nil
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+	return status.Errorf(codes.ResourceExhausted, "too many concurrent install operations (limit %d), retry later", cap(l.semaphore))
+}
+
+func (l *installLimiter) release() {
+	<-l.semaphore
+}
+
+// unaryInterceptor limits the concurrent install operations.
+func (l *installLimiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !installMethods[path.Base(info.FullMethod)] {
+		return handler(ctx, req)
+	}
+	if err := l.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer l.release()
+	return handler(ctx, req)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInstallLimiter(t *testing.T) {
+	testCases := []struct {
+		name         string
+		fullMethod   string
+		queueTimeout time.Duration
+		// releaseAfter is the delay after which the install saturating the
+		// limiter completes.
+		releaseAfter time.Duration
+		expectedCode codes.Code
+	}{
+		{
+			name:         "it rejects an install when saturated",
+			fullMethod:   "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage",
+			releaseAfter: time.Second,
+			expectedCode: codes.ResourceExhausted,
+		},
+		{
+			name:         "it rejects an update when saturated",
+			fullMethod:   "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/UpdateInstalledPackage",
+			releaseAfter: time.Second,
+			expectedCode: codes.ResourceExhausted,
+		},
+		{
+			name:         "it does not limit the reads",
+			fullMethod:   "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail",
+			releaseAfter: time.Second,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it does not limit the deletes",
+			fullMethod:   "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage",
+			releaseAfter: time.Second,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it queues an install until a slot is released",
+			fullMethod:   "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage",
+			queueTimeout: 10 * time.Second,
+			releaseAfter: 10 * time.Millisecond,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it rejects a queued install once the queue timeout expires",
+			fullMethod:   "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage",
+			queueTimeout: 10 * time.Millisecond,
+			releaseAfter: 10 * time.Second,
+			expectedCode: codes.ResourceExhausted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			limiter := newInstallLimiter(1, tc.queueTimeout)
+
+			// Saturate the limiter with an install completing after the
+			// given delay, or at the end of the test.
+			started, release, done := make(chan struct{}), make(chan struct{}), make(chan struct{})
+			go func() {
+				defer close(done)
+				limiter.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+					close(started)
+					select {
+					case <-release:
+					case <-time.After(tc.releaseAfter):
+					}
+					return nil, nil
+				})
+			}()
+			defer func() {
+				close(release)
+				<-done
+			}()
+			<-started
+
+			handlerCalled := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCalled = true
+				return nil, nil
+			}
+			_, err := limiter.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: tc.fullMethod}, handler)
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := handlerCalled, tc.expectedCode == codes.OK; got != want {
+				t.Errorf("got handler called: %t, want: %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"strings"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/metadata"
+)
+
+// authorizationMetadataKey is the request metadata key of the user token,
+// which is always forwarded to the plugins.
+const authorizationMetadataKey = "authorization"
+
+// metadataForwardingPackagesServer wraps the packages server implementation of
+// a plugin so that only the authorization and the allowlisted keys of the
+// request metadata reach the plugin, both as incoming and outgoing metadata.
+type metadataForwardingPackagesServer struct {
+	PackagesPluginServer
+
+	forwardKeys []string
+}
+
+func newMetadataForwardingPackagesServer(server PackagesPluginServer, forwardKeys []string) *metadataForwardingPackagesServer {
+	// Metadata keys are always lowercased.
+	keys := []string{authorizationMetadataKey}
+	for _, key := range forwardKeys {
+		keys = append(keys, strings.ToLower(key))
+	}
+	return &metadataForwardingPackagesServer{
+		PackagesPluginServer: server,
+		forwardKeys:          keys,
+	}
+}
+
+// forwardContext returns a context with the incoming metadata restricted to
+// the forwarded keys, which are also set as outgoing metadata so that they
+// propagate to the calls made by the plugin.
+func (s *metadataForwardingPackagesServer) forwardContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwarded := metadata.MD{}
+	for _, key := range s.forwardKeys {
+		if values := md.Get(key); len(values) > 0 {
+			forwarded.Set(key, values...)
+		}
+	}
+	ctx = metadata.NewIncomingContext(ctx, forwarded)
+	return metadata.NewOutgoingContext(ctx, forwarded.Copy())
+}
+
+func (s *metadataForwardingPackagesServer) GetAvailablePackageSummaries(ctx context.Context, request *packages.GetAvailablePackageSummariesRequest) (*packages.GetAvailablePackageSummariesResponse, error) {
+	return s.PackagesPluginServer.GetAvailablePackageSummaries(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetAvailablePackageDetail(ctx context.Context, request *packages.GetAvailablePackageDetailRequest) (*packages.GetAvailablePackageDetailResponse, error) {
+	return s.PackagesPluginServer.GetAvailablePackageDetail(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetAvailablePackageVersions(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error) {
+	return s.PackagesPluginServer.GetAvailablePackageVersions(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetAvailablePackageDependencies(ctx context.Context, request *packages.GetAvailablePackageDependenciesRequest) (*packages.GetAvailablePackageDependenciesResponse, error) {
+	return s.PackagesPluginServer.GetAvailablePackageDependencies(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetAvailablePackageDefaultValues(ctx context.Context, request *packages.GetAvailablePackageDefaultValuesRequest) (*packages.GetAvailablePackageDefaultValuesResponse, error) {
+	return s.PackagesPluginServer.GetAvailablePackageDefaultValues(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetAvailablePackageChangelog(ctx context.Context, request *packages.GetAvailablePackageChangelogRequest) (*packages.GetAvailablePackageChangelogResponse, error) {
+	return s.PackagesPluginServer.GetAvailablePackageChangelog(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetAvailablePackageValuesDiff(ctx context.Context, request *packages.GetAvailablePackageValuesDiffRequest) (*packages.GetAvailablePackageValuesDiffResponse, error) {
+	return s.PackagesPluginServer.GetAvailablePackageValuesDiff(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) RenderAvailablePackage(ctx context.Context, request *packages.RenderAvailablePackageRequest) (*packages.RenderAvailablePackageResponse, error) {
+	return s.PackagesPluginServer.RenderAvailablePackage(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error) {
+	return s.PackagesPluginServer.GetInstalledPackageSummaries(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetInstalledPackageDetail(ctx context.Context, request *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error) {
+	return s.PackagesPluginServer.GetInstalledPackageDetail(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetInstalledPackageValues(ctx context.Context, request *packages.GetInstalledPackageValuesRequest) (*packages.GetInstalledPackageValuesResponse, error) {
+	return s.PackagesPluginServer.GetInstalledPackageValues(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
+	return s.PackagesPluginServer.CreateInstalledPackage(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
+	return s.PackagesPluginServer.UpdateInstalledPackage(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetInstalledPackageUpgradeDiff(ctx context.Context, request *packages.GetInstalledPackageUpgradeDiffRequest) (*packages.GetInstalledPackageUpgradeDiffResponse, error) {
+	return s.PackagesPluginServer.GetInstalledPackageUpgradeDiff(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetInstalledPackageDrift(ctx context.Context, request *packages.GetInstalledPackageDriftRequest) (*packages.GetInstalledPackageDriftResponse, error) {
+	return s.PackagesPluginServer.GetInstalledPackageDrift(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) ExportInstalledPackage(ctx context.Context, request *packages.ExportInstalledPackageRequest) (*packages.ExportInstalledPackageResponse, error) {
+	return s.PackagesPluginServer.ExportInstalledPackage(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
+	return s.PackagesPluginServer.DeleteInstalledPackage(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetRecentActivity(ctx context.Context, request *packages.GetRecentActivityRequest) (*packages.GetRecentActivityResponse, error) {
+	return s.PackagesPluginServer.GetRecentActivity(s.forwardContext(ctx), request)
+}
+
+func (s *metadataForwardingPackagesServer) GetUserPermissions(ctx context.Context, request *packages.GetUserPermissionsRequest) (*packages.GetUserPermissionsResponse, error) {
+	return s.PackagesPluginServer.GetUserPermissions(s.forwardContext(ctx), request)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataRecordingPackagingPluginServer is a test plugin recording the
+// incoming and outgoing metadata with which it is called.
+type metadataRecordingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	incoming *metadata.MD
+	outgoing *metadata.MD
+}
+
+func (s metadataRecordingPackagingPluginServer) GetInstalledPackageSummaries(ctx context.Context, request *corev1.GetInstalledPackageSummariesRequest) (*corev1.GetInstalledPackageSummariesResponse, error) {
+	*s.incoming, _ = metadata.FromIncomingContext(ctx)
+	*s.outgoing, _ = metadata.FromOutgoingContext(ctx)
+	return s.TestPackagingPluginServer.GetInstalledPackageSummaries(ctx, request)
+}
+
+func TestMetadataForwardingPackagesServer(t *testing.T) {
+	requestMetadata := metadata.New(map[string]string{
+		"authorization": "Bearer abc",
+		"x-request-id":  "1234",
+		"traceparent":   "00-abc-def-01",
+		"cookie":        "session=secret",
+	})
+
+	testCases := []struct {
+		name             string
+		forwardKeys      []string
+		expectedMetadata metadata.MD
+	}{
+		{
+			name:        "it only forwards the authorization by default",
+			forwardKeys: nil,
+			expectedMetadata: metadata.MD{
+				"authorization": []string{"Bearer abc"},
+			},
+		},
+		{
+			name:        "it forwards the allowlisted keys",
+			forwardKeys: []string{"X-Request-Id", "traceparent", "x-missing"},
+			expectedMetadata: metadata.MD{
+				"authorization": []string{"Bearer abc"},
+				"x-request-id":  []string{"1234"},
+				"traceparent":   []string{"00-abc-def-01"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+			incoming, outgoing := metadata.MD{}, metadata.MD{}
+			server := newMetadataForwardingPackagesServer(metadataRecordingPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+				incoming:                  &incoming,
+				outgoing:                  &outgoing,
+			}, tc.forwardKeys)
+
+			ctx := metadata.NewIncomingContext(context.Background(), requestMetadata)
+			_, err := server.GetInstalledPackageSummaries(ctx, &corev1.GetInstalledPackageSummariesRequest{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := incoming, tc.expectedMetadata; !cmp.Equal(want, got) {
+				t.Errorf("incoming metadata mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if got, want := outgoing, tc.expectedMetadata; !cmp.Equal(want, got) {
+				t.Errorf("outgoing metadata mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// metricsNamespaceRegexp matches the valid prefixes of a prometheus metric
+// name.
+var metricsNamespaceRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+// checkMetricsNamespace checks that the namespace, if any, can prefix the
+// exported metric names.
+func checkMetricsNamespace(namespace string) error {
+	if namespace != "" && !metricsNamespaceRegexp.MatchString(namespace) {
+		return fmt.Errorf("invalid metrics namespace %q, expected letters, digits, underscores or colons not starting with a digit", namespace)
+	}
+	return nil
+}
+
+// metricsHandler returns the handler exposing the registered prometheus
+// metrics. When a namespace is given, it prefixes the name of every exposed
+// metric, so that they do not collide with the metrics of other services
+// scraped into a shared prometheus.
+func metricsHandler(namespace string) http.Handler {
+	if namespace == "" {
+		return promhttp.Handler()
+	}
+	return promhttp.InstrumentMetricHandler(
+		prometheus.DefaultRegisterer,
+		promhttp.HandlerFor(prefixedGatherer(namespace+"_", prometheus.DefaultGatherer), promhttp.HandlerOpts{}),
+	)
+}
+
+// prefixedGatherer prefixes the names of the metric families gathered by the
+// given gatherer, which are built afresh for each gathering.
+func prefixedGatherer(prefix string, gatherer prometheus.Gatherer) prometheus.Gatherer {
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		families, err := gatherer.Gather()
+		for _, family := range families {
+			name := prefix + family.GetName()
+			family.Name = &name
+		}
+		return families, err
+	})
+}
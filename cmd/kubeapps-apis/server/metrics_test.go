@@ -0,0 +1,83 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	// Ensure the versions cache metric has a series to expose.
+	versionsCacheRequests.WithLabelValues("metrics-plugin", "hit").Inc()
+
+	testCases := []struct {
+		name           string
+		namespace      string
+		expectedPrefix string
+	}{
+		{
+			name:           "it exposes the metric names without prefix by default",
+			namespace:      "",
+			expectedPrefix: "kubeapps_apis_versions_cache_requests_total",
+		},
+		{
+			name:           "it prefixes all the exposed metric names with the namespace",
+			namespace:      "myteam",
+			expectedPrefix: "myteam_kubeapps_apis_versions_cache_requests_total",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			metricsHandler(tc.namespace).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+			if got, want := recorder.Code, http.StatusOK; got != want {
+				t.Fatalf("got: %d, want: %d", got, want)
+			}
+
+			body := recorder.Body.String()
+			if !strings.Contains(body, "\n"+tc.expectedPrefix+"{") {
+				t.Errorf("got no metric %q in:\n%s", tc.expectedPrefix, body)
+			}
+
+			if tc.namespace == "" {
+				return
+			}
+			for _, line := range strings.Split(body, "\n") {
+				if line == "" || strings.HasPrefix(line, "#") {
+					continue
+				}
+				if !strings.HasPrefix(line, tc.namespace+"_") {
+					t.Errorf("got a metric without the prefix %q: %q", tc.namespace+"_", line)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckMetricsNamespace(t *testing.T) {
+	for _, namespace := range []string{"", "myteam", "my_team:kubeapps"} {
+		if err := checkMetricsNamespace(namespace); err != nil {
+			t.Errorf("got error for the namespace %q: %+v", namespace, err)
+		}
+	}
+	for _, namespace := range []string{"my-team", "1team", "my team"} {
+		if err := checkMetricsNamespace(namespace); err == nil {
+			t.Errorf("got no error for the namespace %q", namespace)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog/v2"
+)
+
+// nilResponsePackagesServer wraps the packages server implementation of a
+// plugin so that a read returning neither a response nor an error, as a buggy
+// plugin might, returns an empty response, logging a warning, or an Internal
+// error when configured, rather than a nil response which the aggregation of
+// the plugin responses would dereference. A mutation returning a nil response
+// always fails, as it can't be reported as applied.
+type nilResponsePackagesServer struct {
+	PackagesPluginServer
+
+	plugin     *plugins.Plugin
+	nilIsError bool
+}
+
+func newNilResponsePackagesServer(plugin *plugins.Plugin, server PackagesPluginServer, nilIsError bool) *nilResponsePackagesServer {
+	return &nilResponsePackagesServer{
+		PackagesPluginServer: server,
+		plugin:               plugin,
+		nilIsError:           nilIsError,
+	}
+}
+
+// nilResponseError returns the error of a nil response of the method, if nil
+// responses are errors, or logs a warning otherwise.
+func (s *nilResponsePackagesServer) nilResponseError(method string) error {
+	if s.nilIsError {
+		return status.Errorf(codes.Internal, "The plugin %v returned no %s response", s.plugin.GetName(), method)
+	}
+	log.Warningf("The plugin %q returned no %s response, using an empty response instead", s.plugin.GetName(), method)
+	return nil
+}
+
+// nilMutationResponseError returns the error of a nil response of the
+// mutating method, whether or not nil responses are errors.
+func (s *nilResponsePackagesServer) nilMutationResponseError(method string) error {
+	return status.Errorf(codes.Internal, "The plugin %v returned no %s response", s.plugin.GetName(), method)
+}
+
+func (s *nilResponsePackagesServer) GetAvailablePackageSummaries(ctx context.Context, request *packages.GetAvailablePackageSummariesRequest) (*packages.GetAvailablePackageSummariesResponse, error) {
+	response, err := s.PackagesPluginServer.GetAvailablePackageSummaries(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetAvailablePackageSummaries"); err != nil {
+		return nil, err
+	}
+	return &packages.GetAvailablePackageSummariesResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetAvailablePackageDetail(ctx context.Context, request *packages.GetAvailablePackageDetailRequest) (*packages.GetAvailablePackageDetailResponse, error) {
+	response, err := s.PackagesPluginServer.GetAvailablePackageDetail(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetAvailablePackageDetail"); err != nil {
+		return nil, err
+	}
+	return &packages.GetAvailablePackageDetailResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetAvailablePackageVersions(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error) {
+	response, err := s.PackagesPluginServer.GetAvailablePackageVersions(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetAvailablePackageVersions"); err != nil {
+		return nil, err
+	}
+	return &packages.GetAvailablePackageVersionsResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetAvailablePackageDependencies(ctx context.Context, request *packages.GetAvailablePackageDependenciesRequest) (*packages.GetAvailablePackageDependenciesResponse, error) {
+	response, err := s.PackagesPluginServer.GetAvailablePackageDependencies(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetAvailablePackageDependencies"); err != nil {
+		return nil, err
+	}
+	return &packages.GetAvailablePackageDependenciesResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetAvailablePackageDefaultValues(ctx context.Context, request *packages.GetAvailablePackageDefaultValuesRequest) (*packages.GetAvailablePackageDefaultValuesResponse, error) {
+	response, err := s.PackagesPluginServer.GetAvailablePackageDefaultValues(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetAvailablePackageDefaultValues"); err != nil {
+		return nil, err
+	}
+	return &packages.GetAvailablePackageDefaultValuesResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetAvailablePackageChangelog(ctx context.Context, request *packages.GetAvailablePackageChangelogRequest) (*packages.GetAvailablePackageChangelogResponse, error) {
+	response, err := s.PackagesPluginServer.GetAvailablePackageChangelog(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetAvailablePackageChangelog"); err != nil {
+		return nil, err
+	}
+	return &packages.GetAvailablePackageChangelogResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetAvailablePackageValuesDiff(ctx context.Context, request *packages.GetAvailablePackageValuesDiffRequest) (*packages.GetAvailablePackageValuesDiffResponse, error) {
+	response, err := s.PackagesPluginServer.GetAvailablePackageValuesDiff(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetAvailablePackageValuesDiff"); err != nil {
+		return nil, err
+	}
+	return &packages.GetAvailablePackageValuesDiffResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) RenderAvailablePackage(ctx context.Context, request *packages.RenderAvailablePackageRequest) (*packages.RenderAvailablePackageResponse, error) {
+	response, err := s.PackagesPluginServer.RenderAvailablePackage(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("RenderAvailablePackage"); err != nil {
+		return nil, err
+	}
+	return &packages.RenderAvailablePackageResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error) {
+	response, err := s.PackagesPluginServer.GetInstalledPackageSummaries(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetInstalledPackageSummaries"); err != nil {
+		return nil, err
+	}
+	return &packages.GetInstalledPackageSummariesResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetInstalledPackageDetail(ctx context.Context, request *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error) {
+	response, err := s.PackagesPluginServer.GetInstalledPackageDetail(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetInstalledPackageDetail"); err != nil {
+		return nil, err
+	}
+	return &packages.GetInstalledPackageDetailResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetInstalledPackageValues(ctx context.Context, request *packages.GetInstalledPackageValuesRequest) (*packages.GetInstalledPackageValuesResponse, error) {
+	response, err := s.PackagesPluginServer.GetInstalledPackageValues(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetInstalledPackageValues"); err != nil {
+		return nil, err
+	}
+	return &packages.GetInstalledPackageValuesResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
+	response, err := s.PackagesPluginServer.CreateInstalledPackage(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	return nil, s.nilMutationResponseError("CreateInstalledPackage")
+}
+
+func (s *nilResponsePackagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
+	response, err := s.PackagesPluginServer.UpdateInstalledPackage(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	return nil, s.nilMutationResponseError("UpdateInstalledPackage")
+}
+
+func (s *nilResponsePackagesServer) GetInstalledPackageUpgradeDiff(ctx context.Context, request *packages.GetInstalledPackageUpgradeDiffRequest) (*packages.GetInstalledPackageUpgradeDiffResponse, error) {
+	response, err := s.PackagesPluginServer.GetInstalledPackageUpgradeDiff(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetInstalledPackageUpgradeDiff"); err != nil {
+		return nil, err
+	}
+	return &packages.GetInstalledPackageUpgradeDiffResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetInstalledPackageDrift(ctx context.Context, request *packages.GetInstalledPackageDriftRequest) (*packages.GetInstalledPackageDriftResponse, error) {
+	response, err := s.PackagesPluginServer.GetInstalledPackageDrift(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetInstalledPackageDrift"); err != nil {
+		return nil, err
+	}
+	return &packages.GetInstalledPackageDriftResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) ExportInstalledPackage(ctx context.Context, request *packages.ExportInstalledPackageRequest) (*packages.ExportInstalledPackageResponse, error) {
+	response, err := s.PackagesPluginServer.ExportInstalledPackage(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("ExportInstalledPackage"); err != nil {
+		return nil, err
+	}
+	return &packages.ExportInstalledPackageResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
+	response, err := s.PackagesPluginServer.DeleteInstalledPackage(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	return nil, s.nilMutationResponseError("DeleteInstalledPackage")
+}
+
+func (s *nilResponsePackagesServer) GetRecentActivity(ctx context.Context, request *packages.GetRecentActivityRequest) (*packages.GetRecentActivityResponse, error) {
+	response, err := s.PackagesPluginServer.GetRecentActivity(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetRecentActivity"); err != nil {
+		return nil, err
+	}
+	return &packages.GetRecentActivityResponse{}, nil
+}
+
+func (s *nilResponsePackagesServer) GetUserPermissions(ctx context.Context, request *packages.GetUserPermissionsRequest) (*packages.GetUserPermissionsResponse, error) {
+	response, err := s.PackagesPluginServer.GetUserPermissions(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+	if err := s.nilResponseError("GetUserPermissions"); err != nil {
+		return nil, err
+	}
+	return &packages.GetUserPermissionsResponse{}, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// nilResponsePackagingPluginServer is a buggy test plugin returning neither a
+// response nor an error.
+type nilResponsePackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+}
+
+func (s nilResponsePackagingPluginServer) GetAvailablePackageSummaries(ctx context.Context, request *corev1.GetAvailablePackageSummariesRequest) (*corev1.GetAvailablePackageSummariesResponse, error) {
+	return nil, nil
+}
+
+func (s nilResponsePackagingPluginServer) GetInstalledPackageSummaries(ctx context.Context, request *corev1.GetInstalledPackageSummariesRequest) (*corev1.GetInstalledPackageSummariesResponse, error) {
+	return nil, nil
+}
+
+func (s nilResponsePackagingPluginServer) DeleteInstalledPackage(ctx context.Context, request *corev1.DeleteInstalledPackageRequest) (*corev1.DeleteInstalledPackageResponse, error) {
+	return nil, nil
+}
+
+func TestNilPluginResponses(t *testing.T) {
+	nilPlugin := &plugins.Plugin{Name: "nil-plugin", Version: "v1alpha1"}
+	configuredPlugins := []*pkgsPluginWithServer{
+		mockedPackagingPlugin1,
+		{
+			plugin: nilPlugin,
+			server: nilResponsePackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: nilPlugin},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                      string
+		nilIsError                bool
+		statusCode                codes.Code
+		expectedAvailablePackages []*corev1.AvailablePackageSummary
+		expectedInstalledPackages []*corev1.InstalledPackageSummary
+	}{
+		{
+			name:       "it treats a nil response as an empty one by default",
+			nilIsError: false,
+			statusCode: codes.OK,
+			expectedAvailablePackages: []*corev1.AvailablePackageSummary{
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+			},
+			expectedInstalledPackages: []*corev1.InstalledPackageSummary{
+				plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+				plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+			},
+		},
+		{
+			name:       "it fails with an internal error for a nil response when configured",
+			nilIsError: true,
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer(configuredPlugins, ServeOptions{NilPluginResponsesAsErrors: tc.nilIsError}, nil)
+			pkgContext := &corev1.Context{Cluster: "", Namespace: globalPackagingNamespace}
+
+			availableResponse, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{Context: pkgContext})
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			installedResponse, err := server.GetInstalledPackageSummaries(context.Background(), &corev1.GetInstalledPackageSummariesRequest{Context: pkgContext})
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// A mutation returning a nil response fails, whatever the configuration.
+			_, err = server.DeleteInstalledPackage(context.Background(), &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    pkgContext,
+					Identifier: "pkg-1",
+					Plugin:     nilPlugin,
+				},
+			})
+			if got, want := status.Code(err), codes.Internal; got != want {
+				t.Errorf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := availableResponse.GetAvailablePackageSummaries(), tc.expectedAvailablePackages; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+				if got, want := installedResponse.GetInstalledPackageSummaries(), tc.expectedInstalledPackages; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
@@ -13,18 +13,93 @@ limitations under the License.
 package server
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math"
+	"reflect"
+	"runtime/debug"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
 
+	"github.com/Masterminds/semver"
 	. "github.com/ahmetb/go-linq/v3"
 	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/version"
 	log "k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
 )
 
+const (
+	// etagMetadataKey is the trailer metadata key of the ETag computed for
+	// the GetAvailablePackageSummaries responses.
+	etagMetadataKey = "etag"
+	// notModifiedMetadataKey is the trailer metadata key signaling that the
+	// response is empty since it did not change since the If-None-Match ETag.
+	notModifiedMetadataKey = "not-modified"
+	// debugTimingsMetadataKey is the request metadata key with which clients
+	// request the plugin timings, either via gRPC or the gateway (as the
+	// Grpc-Metadata-Debug-Timings header).
+	debugTimingsMetadataKey = "debug-timings"
+	// pluginTimingsMetadataKey is the trailer metadata key listing how long
+	// each plugin call took, with a "<plugin>=<duration>" value per plugin.
+	pluginTimingsMetadataKey = "plugin-timings"
+	// latestPkgVersion is the pseudo-version which can be requested to get
+	// the newest version of a package.
+	latestPkgVersion = "latest"
+	// repositoryQualifierSeparator separates the repository from the package
+	// identifier in the repository-qualified identifiers (eg. "ns-1/repo-a:pkg-1").
+	repositoryQualifierSeparator = ":"
+	// maxMaintainerFilterPackages is the number of available packages of a
+	// plugin not supporting the maintainer filter above which they are not
+	// filtered by the core, since it gets the details of each package.
+	maxMaintainerFilterPackages = 100
+	// maxCatalogIndexConcurrency is the maximum number of available packages
+	// whose versions are listed concurrently when building the catalog index.
+	maxCatalogIndexConcurrency = 10
+)
+
+const (
+	// EmptyNamespacePolicyReject rejects the mutating requests with a blank
+	// namespace, rather than using the default namespace of the cluster.
+	EmptyNamespacePolicyReject = "reject"
+	// EmptyNamespacePolicyDefault uses the default namespace of the cluster
+	// for the mutating requests with a blank namespace, rejecting them when
+	// the cluster has no default namespace.
+	EmptyNamespacePolicyDefault = "default"
+)
+
+const (
+	// NamespaceDefaultingOrderClusterFirst resolves a blank cluster to the
+	// default target cluster before resolving a blank namespace to the
+	// default namespace of that cluster.
+	NamespaceDefaultingOrderClusterFirst = "cluster-first"
+	// NamespaceDefaultingOrderNamespaceFirst resolves a blank namespace to
+	// the default namespace of the (possibly blank) cluster first, so that
+	// the namespace cluster mapping applies to the defaulted namespace too.
+	NamespaceDefaultingOrderNamespaceFirst = "namespace-first"
+)
+
+// ifNoneMatchMetadataKeys are the request metadata keys in which clients can
+// send the ETag of a previous response, either via gRPC or the gateway.
+var ifNoneMatchMetadataKeys = []string{"if-none-match", "grpcgateway-if-none-match"}
+
 // packagesServer implements the API defined in proto/kubeappsapis/core/packages/v1alpha1/packages.proto
 type packagesServer struct {
 	packages.UnimplementedPackagesServiceServer
@@ -32,11 +107,140 @@ type packagesServer struct {
 	// plugins is a slice of all registered plugins which satisfy the core.packages.v1alpha1
 	// interface.
 	plugins []*pkgsPluginWithServer
+
+	// namespaceClusterMapping maps namespace prefixes to the cluster used for
+	// requests which include a namespace but no cluster.
+	namespaceClusterMapping map[string]string
+
+	// clusterDefaultNamespaces maps cluster names to the namespace used for
+	// requests to the cluster which include no namespace. The blank cluster
+	// name maps to the default namespace of the default target cluster.
+	clusterDefaultNamespaces map[string]string
+
+	// defaultTargetCluster is the cluster used for requests which include no
+	// cluster, when none is mapped to their namespace. The cluster is left
+	// blank when empty, so that the plugins use the Kubeapps cluster.
+	defaultTargetCluster string
+
+	// categoryOrder lists the categories pinned, in order, to the front of
+	// the merged categories.
+	categoryOrder []string
+
+	// mergeConflictingInstalledPackages returns a single summary for an
+	// installed package reported by several plugins, the one of the first
+	// configured plugin, listing the other plugins as conflicting.
+	mergeConflictingInstalledPackages bool
+
+	// pluginOrder lists the plugins, in order, whose available packages come
+	// first among the ones with the same name.
+	pluginOrder []string
+
+	// maxValuesSize is the maximum size, in bytes, of the values of the
+	// create and update requests (0 for no limit).
+	maxValuesSize int
+
+	// pluginVersionFallback routes the read requests for a plugin version
+	// which is not loaded to the nearest loaded version of the plugin,
+	// rather than failing.
+	pluginVersionFallback bool
+
+	// qualifyAmbiguousIdentifiers qualifies, with their repository, the
+	// identifiers of the available packages published by several
+	// repositories of the same plugin.
+	qualifyAmbiguousIdentifiers bool
+
+	// emptyNamespacePolicy is how the mutating requests with a blank
+	// namespace are handled, see the EmptyNamespacePolicy constants.
+	emptyNamespacePolicy string
+
+	// batchConcurrency is the maximum number of references of a batch
+	// request processed concurrently, the rest being queued (0 for no
+	// limit).
+	batchConcurrency int
+
+	// namespaceDefaultingOrder is the order in which a blank cluster and a
+	// blank namespace are defaulted, see the NamespaceDefaultingOrder
+	// constants.
+	namespaceDefaultingOrder string
+
+	// checkClusterPolicies, if set, checks the rendered resources of a
+	// package against the policies of the cluster in which they would be
+	// installed.
+	checkClusterPolicies clusterPolicyChecker
+
+	// defaultInstallTimeout is the install timeout forwarded to the plugins
+	// with the create requests not specifying one (0 for none).
+	defaultInstallTimeout time.Duration
+
+	// pluginCallTimeout and pluginCallTimeouts are the timeouts of the plugin
+	// calls, globally and per plugin name, which the install timeouts can't
+	// exceed.
+	pluginCallTimeout  time.Duration
+	pluginCallTimeouts map[string]time.Duration
 }
 
-func NewPackagesServer(plugins []*pkgsPluginWithServer) *packagesServer {
+// NewPackagesServer returns the core packages server wrapping the given
+// plugins as configured by the serve options.
+func NewPackagesServer(plugins []*pkgsPluginWithServer, serveOpts ServeOptions, clusterDefaultNamespaces map[string]string) *packagesServer {
+	// Replace the nil responses of the plugins, only forward the allowlisted
+	// request metadata to the plugins, retry the plugin calls failing with
+	// transient errors, within the timeout of the plugin, and cache the
+	// version listings, if configured.
+	// Only the anonymous listings are refreshed, which are fetched with the
+	// service account of the server only when anonymous reads are allowed.
+	var refreshTokenSource TokenSource
+	refreshInterval := serveOpts.VersionsCacheRefreshInterval
+	if !serveOpts.AllowAnonymousReads {
+		refreshInterval = 0
+	}
+	if serveOpts.VersionsCacheTTL > 0 && refreshInterval > 0 {
+		refreshTokenSource = NewServiceAccountTokenSource()
+	}
+	wrappedPlugins := []*pkgsPluginWithServer{}
+	for _, p := range plugins {
+		var server PackagesPluginServer = newNilResponsePackagesServer(p.plugin, p.server, serveOpts.NilPluginResponsesAsErrors)
+		server = newMetadataForwardingPackagesServer(server, serveOpts.ForwardMetadataKeys)
+		if serveOpts.PluginCallRetries > 0 || serveOpts.RetryFailedInstalls {
+			server = newRetryingPackagesServer(p.plugin, server, serveOpts.PluginCallRetries, serveOpts.RetryFailedInstalls)
+		}
+		if timeout := timeoutForPlugin(p.plugin.GetName(), serveOpts.PluginCallTimeout, serveOpts.PluginCallTimeouts); timeout > 0 {
+			server = newTimeoutPackagesServer(server, timeout)
+		}
+		if serveOpts.VersionsCacheTTL > 0 {
+			server = newVersionsCachingPackagesServer(p.plugin, server, serveOpts.VersionsCacheTTL, refreshInterval, serveOpts.VersionsCacheRefreshConcurrency, refreshTokenSource)
+		}
+		wrappedPlugins = append(wrappedPlugins, &pkgsPluginWithServer{
+			plugin: p.plugin,
+			server: server,
+		})
+	}
 	return &packagesServer{
-		plugins: plugins,
+		plugins:                           wrappedPlugins,
+		namespaceClusterMapping:           serveOpts.NamespaceClusterMapping,
+		clusterDefaultNamespaces:          clusterDefaultNamespaces,
+		defaultTargetCluster:              serveOpts.DefaultTargetCluster,
+		categoryOrder:                     serveOpts.CategoryOrder,
+		pluginOrder:                       serveOpts.PluginOrder,
+		mergeConflictingInstalledPackages: serveOpts.MergeConflictingInstalledPackages,
+		maxValuesSize:                     serveOpts.MaxValuesSize,
+		pluginVersionFallback:             serveOpts.PluginVersionFallback,
+		qualifyAmbiguousIdentifiers:       serveOpts.QualifyAmbiguousIdentifiers,
+		emptyNamespacePolicy:              serveOpts.EmptyNamespacePolicy,
+		batchConcurrency:                  serveOpts.BatchConcurrency,
+		namespaceDefaultingOrder:          serveOpts.NamespaceDefaultingOrder,
+		defaultInstallTimeout:             serveOpts.DefaultInstallTimeout,
+		pluginCallTimeout:                 serveOpts.PluginCallTimeout,
+		pluginCallTimeouts:                serveOpts.PluginCallTimeouts,
+	}
+}
+
+// startVersionsCacheRefresh starts the background refresh of the version
+// listings cached for each plugin, if enabled, until the context is done.
+func (s packagesServer) startVersionsCacheRefresh(ctx context.Context) {
+	for _, p := range s.plugins {
+		if cachingServer, ok := p.server.(*versionsCachingPackagesServer); ok {
+			cachingServer.startRefresh(ctx)
+		}
 	}
 }
 
@@ -45,6 +249,25 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
 	log.Infof("+core GetAvailablePackageSummaries %s", contextMsg)
 
+	if err := s.routeToCluster(ctx, request.GetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetMaxDescriptionLength() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid max description length %d, it must not be negative", request.GetMaxDescriptionLength())
+	}
+	if request.GetModifiedSince() != "" {
+		if _, err := time.Parse(time.RFC3339, request.GetModifiedSince()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid modified since %q, it must be an RFC 3339 timestamp: %v", request.GetModifiedSince(), err)
+		}
+	}
+
+	for _, pluginName := range request.GetPluginNames() {
+		if !s.hasPluginNamed(pluginName) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid plugin name %q, no such plugin is configured", pluginName)
+		}
+	}
+
 	pageOffset, err := pageOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
 	pageSize := request.GetPaginationOptions().GetPageSize()
 	if err != nil {
@@ -53,6 +276,92 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 
 	// TODO(agamez): temporarily fetching all the results (size=0) and then paginate them
 	// ideally, paginate each plugin request and then aggregate results.
+	// The category counts and the ranking of the packages matching a query
+	// need all the packages, whatever the page.
+	query := request.GetFilterOptions().GetQuery()
+	maxPkgs := 0
+	if pageSize > 0 && !request.GetIncludeCategoryCounts() && query == "" {
+		maxPkgs = pageOffset*int(pageSize) + int(pageSize)
+	}
+	pkgs, categories, timings, err := s.fetchAvailablePackageSummaries(ctx, request, maxPkgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only count the packages of each category when requested, before
+	// paginating them.
+	var categoryCounts []*packages.CategoryCount
+	if request.GetIncludeCategoryCounts() {
+		categoryCounts = s.countCategories(pkgs)
+	}
+
+	// Return how long each plugin call took when requested, for the clients
+	// debugging the performance without a trace backend.
+	if debugTimingsRequested(ctx) {
+		if err := grpc.SetTrailer(ctx, metadata.MD{pluginTimingsMetadataKey: timings}); err != nil {
+			log.Warningf("Unable to set the plugin timings trailer: %v", err)
+		}
+	}
+
+	// Only return a next page token if the request was for pagination and
+	// the results are a full page.
+	// The packages matching a query are ordered by relevance first.
+	pkgs = s.sortAvailablePackageSummaries(pkgs)
+	if query != "" {
+		rankAvailablePackageSummaries(pkgs, query)
+	}
+	start, end, nextPageToken := pageBounds(len(pkgs), pageOffset, pageSize)
+	pkgs = pkgs[start:end]
+
+	// Only count the installed packages when requested, given the cost of
+	// listing them for every plugin.
+	if request.GetIncludeInstalledCount() {
+		if err := s.setInstalledCounts(ctx, request.GetContext(), pkgs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Use the descriptions in the preferred language of the user, if any.
+	if request.GetAcceptLanguage() != "" {
+		pkgs = localizeAvailablePackageSummaries(pkgs, preferredLanguages(request.GetAcceptLanguage()))
+	}
+
+	// Trim the (possibly localized) descriptions to the requested length, if any.
+	if request.GetMaxDescriptionLength() > 0 {
+		pkgs = truncateAvailablePackageSummaries(pkgs, int(request.GetMaxDescriptionLength()))
+	}
+
+	response := &packages.GetAvailablePackageSummariesResponse{
+		AvailablePackageSummaries: pkgs,
+		Categories:                categories,
+		CategoryCounts:            categoryCounts,
+		NextPageToken:             nextPageToken,
+	}
+
+	// Return the ETag of the response so that clients can avoid fetching
+	// the same catalog again, in which case an empty response is returned.
+	etag, err := computeETag(response)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to compute the ETag of the GetAvailablePackageSummaries response: %v", err)
+	}
+	trailer := metadata.Pairs(etagMetadataKey, etag)
+	if matchesETag(ctx, etag) {
+		trailer.Set(notModifiedMetadataKey, "true")
+		response = &packages.GetAvailablePackageSummariesResponse{}
+	}
+	if err = grpc.SetTrailer(ctx, trailer); err != nil {
+		log.Warningf("Unable to set the ETag trailer: %v", err)
+	}
+
+	return response, nil
+}
+
+// fetchAvailablePackageSummaries returns the available package summaries of
+// all the plugins, or of the plugins named in the request if any, without
+// pagination, together with their categories and how long each plugin call
+// took. The remaining plugins are not called once more than maxPkgs packages
+// are fetched, unless maxPkgs is 0.
+func (s packagesServer) fetchAvailablePackageSummaries(ctx context.Context, request *packages.GetAvailablePackageSummariesRequest, maxPkgs int) ([]*packages.AvailablePackageSummary, []string, []string, error) {
 	requestN := request
 	requestN.PaginationOptions = &packages.PaginationOptions{
 		PageToken: "0",
@@ -61,64 +370,131 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 
 	pkgs := []*packages.AvailablePackageSummary{}
 	categories := []string{}
+	timings := []string{}
+
+	pluginNames := map[string]bool{}
+	for _, name := range request.GetPluginNames() {
+		pluginNames[name] = true
+	}
 
 	// TODO: We can do these in parallel in separate go routines.
 	for _, p := range s.plugins {
-		log.Infof("Items now: %d/%d", len(pkgs), (pageOffset*int(pageSize) + int(pageSize)))
-		if pageSize == 0 || len(pkgs) <= (pageOffset*int(pageSize)+int(pageSize)) {
+		if len(pluginNames) > 0 && !pluginNames[p.plugin.GetName()] {
+			continue
+		}
+		log.Infof("Items now: %d/%d", len(pkgs), maxPkgs)
+		if maxPkgs == 0 || len(pkgs) <= maxPkgs {
 			log.Infof("Should enter")
 
+			start := time.Now()
 			response, err := p.server.GetAvailablePackageSummaries(ctx, requestN)
+			// The plugins not filtering by maintainer are called again
+			// without this filter, which is then applied here.
+			filterMaintainer := ""
+			if maintainer := request.GetFilterOptions().GetMaintainer(); maintainer != "" && status.Code(err) == codes.Unimplemented {
+				log.Infof("The plugin %v does not filter by maintainer, filtering its packages: %v", p.plugin.Name, err)
+				filterMaintainer = maintainer
+				requestUnfiltered := proto.Clone(requestN).(*packages.GetAvailablePackageSummariesRequest)
+				requestUnfiltered.FilterOptions.Maintainer = ""
+				response, err = p.server.GetAvailablePackageSummaries(ctx, requestUnfiltered)
+			}
+			timings = append(timings, fmt.Sprintf("%s=%s", p.plugin.Name, time.Since(start)))
 			if err != nil {
-				return nil, status.Errorf(status.Convert(err).Code(), "Invalid GetAvailablePackageSummaries response from the plugin %v: %v", p.plugin.Name, err)
+				return nil, nil, nil, status.Errorf(status.Convert(err).Code(), "Invalid GetAvailablePackageSummaries response from the plugin %v: %v", p.plugin.Name, err)
 			}
 
 			categories = append(categories, response.Categories...)
 
-			// Add the plugin for the pkgs
-			pluginPkgs := response.AvailablePackageSummaries
-			for _, r := range pluginPkgs {
+			summaries := response.AvailablePackageSummaries
+			if filterMaintainer != "" {
+				summaries, err = s.filterByMaintainer(ctx, p, summaries, filterMaintainer)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+			}
+			if s.qualifyAmbiguousIdentifiers {
+				summaries = qualifyAmbiguousIdentifiers(summaries)
+			}
+
+			// Add the plugin for the pkgs, excluding the deprecated ones when
+			// requested, as not all the plugins filter them.
+			for _, r := range summaries {
+				if r.GetDeprecated() && request.GetFilterOptions().GetExcludeDeprecated() {
+					continue
+				}
 				if r.AvailablePackageRef == nil {
 					r.AvailablePackageRef = &packages.AvailablePackageReference{}
 				}
 				r.AvailablePackageRef.Plugin = p.plugin
+				pkgs = append(pkgs, r)
 			}
-			pkgs = append(pkgs, pluginPkgs...)
 		}
 	}
-	// Delete duplicate categories and sort by name
-	From(categories).Distinct().OrderBy(func(i interface{}) interface{} { return i }).ToSlice(&categories)
+	// Delete duplicate categories and sort them, with the pinned categories first
+	return pkgs, s.sortCategories(categories), timings, nil
+}
 
-	// Only return a next page token if the request was for pagination and
-	// the results are a full page.
-	nextPageToken := ""
-	if pageSize > 0 {
-		// Using https://github.com/ahmetb/go-linq for simplicity
-		From(pkgs).
-			// Order by package name, regardless of the plugin
-			OrderBy(func(pkg interface{}) interface{} {
-				return pkg.(*packages.AvailablePackageSummary).Name + pkg.(*packages.AvailablePackageSummary).AvailablePackageRef.Plugin.Name
-			}).
-			Skip(pageOffset * int(pageSize)).
-			Take(int(pageSize)).
-			ToSlice(&pkgs)
-
-		if len(pkgs) == int(pageSize) {
-			nextPageToken = fmt.Sprintf("%d", pageOffset+1)
+// hasPluginNamed returns whether a plugin, whatever its version, is
+// configured with the name.
+func (s packagesServer) hasPluginNamed(name string) bool {
+	for _, p := range s.plugins {
+		if p.plugin.GetName() == name {
+			return true
 		}
-	} else {
-		From(pkgs).
-			// Order by package name, regardless of the plugin
-			OrderBy(func(pkg interface{}) interface{} {
-				return pkg.(*packages.AvailablePackageSummary).Name + pkg.(*packages.AvailablePackageSummary).AvailablePackageRef.Plugin.Name
-			}).ToSlice(&pkgs)
 	}
+	return false
+}
 
-	return &packages.GetAvailablePackageSummariesResponse{
-		AvailablePackageSummaries: pkgs,
-		Categories:                categories,
-		NextPageToken:             nextPageToken,
-	}, nil
+// filterByMaintainer returns the summaries of the packages of a plugin not
+// supporting the maintainer filter with a maintainer matching it. The
+// summaries not including the maintainers, they are taken from the details of
+// the packages, fetched concurrently. A package whose details cannot be
+// fetched is excluded. The plugins with more than maxMaintainerFilterPackages
+// packages are rejected as not supporting the filter, rather than getting
+// the details of each of their packages on every request.
+func (s packagesServer) filterByMaintainer(ctx context.Context, p *pkgsPluginWithServer, summaries []*packages.AvailablePackageSummary, maintainer string) ([]*packages.AvailablePackageSummary, error) {
+	if len(summaries) > maxMaintainerFilterPackages {
+		return nil, status.Errorf(codes.Unimplemented, "The plugin %v does not filter by maintainer and has too many packages (%d) to be filtered", p.plugin.Name, len(summaries))
+	}
+	matches := make([]bool, len(summaries))
+	err := s.processBatch(ctx, len(summaries), func(ctx context.Context, i int) {
+		pkgRef := &packages.AvailablePackageReference{}
+		if summaries[i].GetAvailablePackageRef() != nil {
+			pkgRef = proto.Clone(summaries[i].GetAvailablePackageRef()).(*packages.AvailablePackageReference)
+		}
+		pkgRef.Plugin = p.plugin
+		response, err := p.server.GetAvailablePackageDetail(ctx, &packages.GetAvailablePackageDetailRequest{
+			AvailablePackageRef: pkgRef,
+		})
+		if err != nil {
+			log.Warningf("Unable to get the maintainers of the package %q of the plugin %v: %v", pkgRef.GetIdentifier(), p.plugin.Name, err)
+			return
+		}
+		matches[i] = matchesMaintainer(response.GetAvailablePackageDetail().GetMaintainers(), maintainer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []*packages.AvailablePackageSummary{}
+	for i, summary := range summaries {
+		if matches[i] {
+			filtered = append(filtered, summary)
+		}
+	}
+	return filtered, nil
+}
+
+// matchesMaintainer returns whether the name or the email of one of the
+// maintainers contains the filter, case-insensitively.
+func matchesMaintainer(maintainers []*packages.Maintainer, filter string) bool {
+	filter = strings.ToLower(filter)
+	for _, m := range maintainers {
+		if strings.Contains(strings.ToLower(m.GetName()), filter) || strings.Contains(strings.ToLower(m.GetEmail()), filter) {
+			return true
+		}
+	}
+	return false
 }
 
 // GetAvailablePackageDetail returns the package details based on the request.
@@ -126,16 +502,33 @@ func (s packagesServer) GetAvailablePackageDetail(ctx context.Context, request *
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
 	log.Infof("+core GetAvailablePackageDetail %s", contextMsg)
 
+	if err := s.routeToCluster(ctx, request.GetAvailablePackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
 	if request.GetAvailablePackageRef().GetPlugin() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
-	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
+	pluginWithServer := s.getReadPluginWithServer(request.AvailablePackageRef.Plugin)
 	if pluginWithServer == nil {
 		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
 	}
 
+	var qualifiedIdentifier string
+	request.AvailablePackageRef, qualifiedIdentifier = s.unqualifyAvailablePackageRef(request.AvailablePackageRef)
+
+	// Resolve the "latest" pseudo-version to the newest concrete version, so
+	// that the response includes the version actually returned.
+	if request.GetPkgVersion() == latestPkgVersion {
+		version, err := resolveLatestPkgVersion(ctx, pluginWithServer, request.GetAvailablePackageRef(), request.GetIncludePrerelease())
+		if err != nil {
+			return nil, err
+		}
+		request.PkgVersion = version
+	}
+
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.GetAvailablePackageDetail(ctx, request)
 	if err != nil {
@@ -147,9 +540,21 @@ func (s packagesServer) GetAvailablePackageDetail(ctx context.Context, request *
 		return nil, status.Errorf(codes.Internal, "Invalid GetAvailablePackageDetail response from the plugin %v: %v", pluginWithServer.plugin.Name, err)
 	}
 
+	// Use the descriptions in the preferred language of the user, if any.
+	pkgDetail := response.AvailablePackageDetail
+	if request.GetAcceptLanguage() != "" {
+		pkgDetail = localizeAvailablePackageDetail(pkgDetail, preferredLanguages(request.GetAcceptLanguage()))
+	}
+
+	// Return the requested qualified identifier rather than the plugin one.
+	if qualifiedIdentifier != "" {
+		pkgDetail = proto.Clone(pkgDetail).(*packages.AvailablePackageDetail)
+		pkgDetail.AvailablePackageRef.Identifier = qualifiedIdentifier
+	}
+
 	// Build the response
 	return &packages.GetAvailablePackageDetailResponse{
-		AvailablePackageDetail: response.AvailablePackageDetail,
+		AvailablePackageDetail: pkgDetail,
 	}, nil
 }
 
@@ -158,50 +563,269 @@ func (s packagesServer) GetInstalledPackageSummaries(ctx context.Context, reques
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
 	log.Infof("+core GetInstalledPackageSummaries %s", contextMsg)
 
-	// Aggregate the response for each plugin
-	pkgs := []*packages.InstalledPackageSummary{}
+	if err := s.routeToCluster(ctx, request.GetContext()); err != nil {
+		return nil, err
+	}
+
+	pageSize := request.GetPaginationOptions().GetPageSize()
+	pageToken, err := parsePluginsPageToken(request.GetPaginationOptions().GetPageToken(), s.plugins)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to intepret page token %q: %v", request.GetPaginationOptions().GetPageToken(), err)
+	}
+
+	// Fetch the page of each plugin at its own position, without the
+	// summaries it already returned.
+	pluginPages := make([]installedPackagesPluginPage, len(s.plugins))
 	// TODO: We can do these in parallel in separate go routines.
-	for _, p := range s.plugins {
-		response, err := p.server.GetInstalledPackageSummaries(ctx, request)
+	for i, p := range s.plugins {
+		position, ok := pageToken[pluginPageTokenKey(p.plugin)]
+		if !ok {
+			continue
+		}
+		pluginRequest := proto.Clone(request).(*packages.GetInstalledPackageSummariesRequest)
+		pluginRequest.PaginationOptions = &packages.PaginationOptions{
+			PageToken: position.PageToken,
+			PageSize:  pageSize,
+		}
+		response, err := p.server.GetInstalledPackageSummaries(ctx, pluginRequest)
 		if err != nil {
 			return nil, status.Errorf(status.Convert(err).Code(), "Invalid GetInstalledPackageSummaries response from the plugin %v: %v", p.plugin.Name, err)
 		}
 
 		// Add the plugin for the pkgs
-		pluginPkgs := response.InstalledPackageSummaries
-		for _, r := range pluginPkgs {
+		for _, r := range response.InstalledPackageSummaries {
 			if r.InstalledPackageRef == nil {
 				r.InstalledPackageRef = &packages.InstalledPackageReference{}
 			}
 			r.InstalledPackageRef.Plugin = p.plugin
 		}
-		pkgs = append(pkgs, pluginPkgs...)
+		summaries := response.InstalledPackageSummaries
+		if position.Returned < len(summaries) {
+			summaries = summaries[position.Returned:]
+		} else {
+			summaries = nil
+		}
+		pluginPages[i] = installedPackagesPluginPage{
+			pluginPage: pluginPage{
+				position:      position,
+				size:          len(summaries),
+				nextPageToken: response.NextPageToken,
+			},
+			summaries: summaries,
+		}
 	}
 
+	pkgs := s.mergeInstalledPackagesPluginPages(pluginPages, pageSize)
+
+	// Order by package name, then namespace and finally plugin name so that
+	// the merged result is stable across calls, regardless of the order in
+	// which the plugins returned their results.
 	From(pkgs).
-		// Order by package name, regardless of the plugin
 		OrderBy(func(pkg interface{}) interface{} {
-			return pkg.(*packages.InstalledPackageSummary).Name + pkg.(*packages.InstalledPackageSummary).InstalledPackageRef.Plugin.Name
+			return pkg.(*packages.InstalledPackageSummary).Name
+		}).
+		ThenBy(func(pkg interface{}) interface{} {
+			return pkg.(*packages.InstalledPackageSummary).InstalledPackageRef.GetContext().GetNamespace()
+		}).
+		ThenBy(func(pkg interface{}) interface{} {
+			return pkg.(*packages.InstalledPackageSummary).InstalledPackageRef.Plugin.Name
 		}).
 		ToSlice(&pkgs)
 
+	// Without pagination, all the summaries are returned at once.
+	nextPageToken := ""
+	if pageSize > 0 {
+		progress := make([]pluginPage, len(pluginPages))
+		for i, page := range pluginPages {
+			progress[i] = page.pluginPage
+		}
+		nextPageToken, err = s.nextPluginsPageToken(progress)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to create the next page token: %v", err)
+		}
+	}
+
 	// Build the response
 	return &packages.GetInstalledPackageSummariesResponse{
 		InstalledPackageSummaries: pkgs,
+		NextPageToken:             nextPageToken,
 	}, nil
 }
 
+// pluginsPageToken is the position, in the pages of each plugin, of the next
+// page of the results merged from the plugins, keyed by pluginPageTokenKey,
+// which is encoded in the page tokens. The plugins without more results have
+// no position. The first page has the "" or "0" page token, as for the
+// available package summaries.
+type pluginsPageToken map[string]pluginPagePosition
+
+// pluginPagePosition is the page token of a page of a plugin together with
+// how many of its results were already returned.
+type pluginPagePosition struct {
+	PageToken string `json:"t,omitempty"`
+	Returned  int    `json:"r,omitempty"`
+}
+
+// pluginPage is a page of results of a plugin at a position, without the
+// results already returned, of which the first returned ones are counted.
+type pluginPage struct {
+	position      pluginPagePosition
+	size          int
+	returned      int
+	nextPageToken string
+}
+
+// installedPackagesPluginPage is a page of the installed package summaries
+// of a plugin.
+type installedPackagesPluginPage struct {
+	pluginPage
+	summaries []*packages.InstalledPackageSummary
+}
+
+// pluginPageTokenKey returns the key of a plugin in the page tokens.
+func pluginPageTokenKey(plugin *v1alpha1.Plugin) string {
+	return fmt.Sprintf("%s/%s", plugin.GetName(), plugin.GetVersion())
+}
+
+// parsePluginsPageToken returns the positions encoded in the page token, with
+// all the plugins at their first page for the first page.
+func parsePluginsPageToken(pageToken string, plugins []*pkgsPluginWithServer) (pluginsPageToken, error) {
+	positions := pluginsPageToken{}
+	if pageToken == "" || pageToken == "0" {
+		for _, p := range plugins {
+			positions[pluginPageTokenKey(p.plugin)] = pluginPagePosition{}
+		}
+		return positions, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(decoded, &positions); err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+// mergeInstalledPackagesPluginPages returns up to a page of the summaries of
+// the plugin pages, or all of them when the page size is not positive, taking
+// the first summary, by name, namespace and plugin name, of the pages in turn
+// so that every page is returned in order, and counting the summaries
+// returned from each page.
+// When configured, a single summary is kept for an installed package
+// reported by several plugins in the pages: the one of the first configured
+// plugin, listing the other plugins as conflicting. The summaries following a
+// full page are still taken while they report an installed package of the
+// page, so that its conflicts are not split across pages.
+func (s packagesServer) mergeInstalledPackagesPluginPages(pluginPages []installedPackagesPluginPage, pageSize int32) []*packages.InstalledPackageSummary {
+	pkgs := []*packages.InstalledPackageSummary{}
+	// The plugin of each summary kept for an installed package.
+	keptPlugins := map[installedPackageKey]int{}
+	keptIndexes := map[installedPackageKey]int{}
+	for {
+		next := -1
+		for i, page := range pluginPages {
+			if page.returned == page.size {
+				continue
+			}
+			if next == -1 || installedPackageSummaryLess(page.summaries[page.returned], pluginPages[next].summaries[pluginPages[next].returned]) {
+				next = i
+			}
+		}
+		if next == -1 {
+			break
+		}
+		pkg := pluginPages[next].summaries[pluginPages[next].returned]
+		if pageSize > 0 && len(pkgs) >= int(pageSize) {
+			key := installedPackageKeyFromRef(pkg.GetInstalledPackageRef())
+			if _, ok := keptIndexes[key]; !ok || key.identifier == "" {
+				break
+			}
+		}
+		pluginPages[next].returned++
+
+		if !s.mergeConflictingInstalledPackages {
+			pkgs = append(pkgs, pkg)
+			continue
+		}
+		// The summaries are copied since they may be reused by the plugins
+		// across calls.
+		pkg = proto.Clone(pkg).(*packages.InstalledPackageSummary)
+		pkg.ConflictingPlugins = nil
+		key := installedPackageKeyFromRef(pkg.GetInstalledPackageRef())
+		if j, ok := keptIndexes[key]; ok && key.identifier != "" {
+			kept := pkgs[j]
+			if next < keptPlugins[key] {
+				pkg.ConflictingPlugins = append(kept.ConflictingPlugins, kept.GetInstalledPackageRef().GetPlugin())
+				pkgs[j] = pkg
+				keptPlugins[key] = next
+			} else {
+				kept.ConflictingPlugins = append(kept.ConflictingPlugins, pkg.GetInstalledPackageRef().GetPlugin())
+			}
+			continue
+		}
+		keptIndexes[key] = len(pkgs)
+		keptPlugins[key] = next
+		pkgs = append(pkgs, pkg)
+	}
+	return pkgs
+}
+
+// installedPackageSummaryLess returns whether an installed package summary
+// comes before another one by name, then namespace and finally plugin name.
+func installedPackageSummaryLess(a, b *packages.InstalledPackageSummary) bool {
+	if a.GetName() != b.GetName() {
+		return a.GetName() < b.GetName()
+	}
+	if aNamespace, bNamespace := a.GetInstalledPackageRef().GetContext().GetNamespace(), b.GetInstalledPackageRef().GetContext().GetNamespace(); aNamespace != bNamespace {
+		return aNamespace < bNamespace
+	}
+	return a.GetInstalledPackageRef().GetPlugin().GetName() < b.GetInstalledPackageRef().GetPlugin().GetName()
+}
+
+// nextPluginsPageToken returns the page token of the positions following the
+// results returned from the pages of the configured plugins, moving to the
+// next page of a plugin once all the results of its page are returned. It is
+// empty once all the results of all the plugins are returned.
+func (s packagesServer) nextPluginsPageToken(pluginPages []pluginPage) (string, error) {
+	positions := pluginsPageToken{}
+	for i, page := range pluginPages {
+		key := pluginPageTokenKey(s.plugins[i].plugin)
+		switch {
+		case page.returned < page.size:
+			positions[key] = pluginPagePosition{
+				PageToken: page.position.PageToken,
+				Returned:  page.position.Returned + page.returned,
+			}
+		case page.nextPageToken != "":
+			positions[key] = pluginPagePosition{PageToken: page.nextPageToken}
+		}
+	}
+	if len(positions) == 0 {
+		return "", nil
+	}
+	encoded, err := json.Marshal(positions)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
 // GetInstalledPackageDetail returns the package versions based on the request.
 func (s packagesServer) GetInstalledPackageDetail(ctx context.Context, request *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
 	log.Infof("+core GetInstalledPackageDetail %s", contextMsg)
 
+	if err := s.routeToCluster(ctx, request.GetInstalledPackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
 	if request.GetInstalledPackageRef().GetPlugin() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
-	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
+	pluginWithServer := s.getReadPluginWithServer(request.InstalledPackageRef.Plugin)
 	if pluginWithServer == nil {
 		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.InstalledPackageRef.Plugin)
 	}
@@ -216,6 +840,9 @@ func (s packagesServer) GetInstalledPackageDetail(ctx context.Context, request *
 	if response.GetInstalledPackageDetail() == nil {
 		return nil, status.Errorf(codes.Internal, "Invalid GetInstalledPackageDetail response from the plugin %v: %v", pluginWithServer.plugin.Name, err)
 	}
+	if workloadStatus := response.InstalledPackageDetail.GetWorkloadStatus(); workloadStatus != nil {
+		workloadStatus.ProgressPercentage = workloadProgressPercentage(workloadStatus)
+	}
 
 	// Build the response
 	return &packages.GetInstalledPackageDetailResponse{
@@ -223,20 +850,105 @@ func (s packagesServer) GetInstalledPackageDetail(ctx context.Context, request *
 	}, nil
 }
 
+// workloadProgressPercentage estimates the progress of an installed package
+// towards being ready as its ready replicas over its desired replicas or,
+// when its workloads desire no replicas, as its ready workloads over its
+// workloads. It is zero when the package has no workloads.
+func workloadProgressPercentage(workloadStatus *packages.WorkloadStatus) int32 {
+	ready, total := workloadStatus.GetReadyReplicas(), workloadStatus.GetDesiredReplicas()
+	if total == 0 {
+		ready, total = workloadStatus.GetReadyWorkloads(), workloadStatus.GetWorkloads()
+	}
+	if total <= 0 {
+		return 0
+	}
+	if ready > total {
+		ready = total
+	}
+	return int32(int64(ready) * 100 / int64(total))
+}
+
+// GetInstalledPackageDetailsBatch returns the details of several installed
+// packages, each one got from its plugin concurrently. The failure to get a
+// detail is returned in its result rather than failing the whole batch.
+func (s packagesServer) GetInstalledPackageDetailsBatch(ctx context.Context, request *packages.GetInstalledPackageDetailsBatchRequest) (*packages.GetInstalledPackageDetailsBatchResponse, error) {
+	log.Infof("+core GetInstalledPackageDetailsBatch (count=%d)", len(request.GetInstalledPackageRefs()))
+
+	results := make([]*packages.GetInstalledPackageDetailResult, len(request.GetInstalledPackageRefs()))
+	newResult := func(i int, response *packages.GetInstalledPackageDetailResponse, err error) {
+		results[i] = &packages.GetInstalledPackageDetailResult{
+			InstalledPackageRef:    request.GetInstalledPackageRefs()[i],
+			InstalledPackageDetail: response.GetInstalledPackageDetail(),
+			StatusCode:             int32(status.Code(err)),
+		}
+		if err != nil {
+			results[i].ErrorMessage = status.Convert(err).Message()
+		}
+	}
+	err := s.processBatch(ctx, len(request.GetInstalledPackageRefs()), func(ctx context.Context, i int) {
+		response, err := s.GetInstalledPackageDetail(ctx, &packages.GetInstalledPackageDetailRequest{
+			InstalledPackageRef:   request.GetInstalledPackageRefs()[i],
+			IncludeWorkloadStatus: request.GetIncludeWorkloadStatus(),
+		})
+		newResult(i, response, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &packages.GetInstalledPackageDetailsBatchResponse{
+		Results: results,
+	}, nil
+}
+
+// GetInstalledPackageValues returns the values supplied by the user which are
+// currently in effect for an installed package, using the plugin managing it.
+func (s packagesServer) GetInstalledPackageValues(ctx context.Context, request *packages.GetInstalledPackageValuesRequest) (*packages.GetInstalledPackageValuesResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetInstalledPackageValues %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetInstalledPackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetInstalledPackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getReadPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetInstalledPackageValues(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to GetInstalledPackageValues using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
 // GetAvailablePackageVersions returns the package versions based on the request.
 func (s packagesServer) GetAvailablePackageVersions(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
 	log.Infof("+core GetAvailablePackageVersions %s", contextMsg)
 
+	if err := s.routeToCluster(ctx, request.GetAvailablePackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
 	if request.GetAvailablePackageRef().GetPlugin() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
-	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
+	pluginWithServer := s.getReadPluginWithServer(request.AvailablePackageRef.Plugin)
 	if pluginWithServer == nil {
 		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
 	}
+	request.AvailablePackageRef, _ = s.unqualifyAvailablePackageRef(request.AvailablePackageRef)
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.GetAvailablePackageVersions(ctx, request)
@@ -249,50 +961,377 @@ func (s packagesServer) GetAvailablePackageVersions(ctx context.Context, request
 		return nil, status.Errorf(codes.Internal, "Invalid GetAvailablePackageVersions response from the plugin %v: %v", pluginWithServer.plugin.Name, err)
 	}
 
-	// Build the response
+	// Build the response, without the pre-release versions unless requested
+	versions := response.PackageAppVersions
+	if !request.GetIncludePrerelease() {
+		versions = excludePrereleaseVersions(versions)
+	}
+	if request.GetInstalledVersion() != "" {
+		installedVersion, err := semver.NewVersion(request.GetInstalledVersion())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid installed version %q: %v", request.GetInstalledVersion(), err)
+		}
+		versions = relateVersions(versions, installedVersion)
+	}
+	var versionGroups []*packages.PackageVersionGroup
+	if request.GetGroupByMinor() {
+		versionGroups = groupVersionsByMinor(versions)
+	}
 	return &packages.GetAvailablePackageVersionsResponse{
-		PackageAppVersions: response.PackageAppVersions,
+		PackageAppVersions: versions,
+		VersionGroups:      versionGroups,
 	}, nil
 }
 
-// CreateInstalledPackage creates an installed package using configured plugins.
-func (s packagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
-	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetTargetContext().GetCluster(), request.GetTargetContext().GetNamespace())
-	log.Infof("+core CreateInstalledPackage %s", contextMsg)
+// GetAvailablePackageDependencies returns the dependency tree of a package based on the request.
+func (s packagesServer) GetAvailablePackageDependencies(ctx context.Context, request *packages.GetAvailablePackageDependenciesRequest) (*packages.GetAvailablePackageDependenciesResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetAvailablePackageDependencies %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetAvailablePackageRef().GetContext()); err != nil {
+		return nil, err
+	}
 
 	if request.GetAvailablePackageRef().GetPlugin() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
-	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
+	pluginWithServer := s.getReadPluginWithServer(request.AvailablePackageRef.Plugin)
 	if pluginWithServer == nil {
 		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
 	}
+	request.AvailablePackageRef, _ = s.unqualifyAvailablePackageRef(request.AvailablePackageRef)
 
-	// Get the response from the requested plugin
-	response, err := pluginWithServer.server.CreateInstalledPackage(ctx, request)
+	// Get the response from the requested plugin. Plugins without any
+	// dependency information are not required to implement this rpc, in
+	// which case an empty dependency tree is returned.
+	response, err := pluginWithServer.server.GetAvailablePackageDependencies(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to  CreateInstalledPackage using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+		if status.Code(err) == codes.Unimplemented {
+			return &packages.GetAvailablePackageDependenciesResponse{
+				PackageDependencies: []*packages.PackageDependency{},
+			}, nil
+		}
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable get the GetAvailablePackageDependencies from the plugin %v: %v", pluginWithServer.plugin.Name, err)
 	}
 
-	// Validate the plugin response
-	if response.InstalledPackageRef == nil {
-		return nil, status.Errorf(codes.Internal, "Invalid CreateInstalledPackage response from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	dependencies := response.GetPackageDependencies()
+	if dependencies == nil {
+		dependencies = []*packages.PackageDependency{}
 	}
 
-	return response, nil
+	// Build the response
+	return &packages.GetAvailablePackageDependenciesResponse{
+		PackageDependencies: dependencies,
+	}, nil
 }
 
-// UpdateInstalledPackage updates an installed package using configured plugins.
-func (s packagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
+// GetAvailablePackageDefaultValues returns the raw default values of an
+// available package version using the configured plugins. Plugins which
+// can't provide them return Unimplemented.
+func (s packagesServer) GetAvailablePackageDefaultValues(ctx context.Context, request *packages.GetAvailablePackageDefaultValuesRequest) (*packages.GetAvailablePackageDefaultValuesResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetAvailablePackageDefaultValues %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetAvailablePackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetAvailablePackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getReadPluginWithServer(request.AvailablePackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
+	}
+	request.AvailablePackageRef, _ = s.unqualifyAvailablePackageRef(request.AvailablePackageRef)
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetAvailablePackageDefaultValues(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable get the GetAvailablePackageDefaultValues from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// GetAvailablePackageChangelog returns the changes of an available package
+// between two of its versions, using the plugin managing it.
+func (s packagesServer) GetAvailablePackageChangelog(ctx context.Context, request *packages.GetAvailablePackageChangelogRequest) (*packages.GetAvailablePackageChangelogResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetAvailablePackageChangelog %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetAvailablePackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetAvailablePackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getReadPluginWithServer(request.AvailablePackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
+	}
+	request.AvailablePackageRef, _ = s.unqualifyAvailablePackageRef(request.AvailablePackageRef)
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetAvailablePackageChangelog(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable get the GetAvailablePackageChangelog from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// GetAvailablePackageValuesDiff returns the keys of the default values added,
+// removed or changed between two versions of an available package, using the
+// plugin managing it. For the plugins not implementing it, the keys are
+// compared from the default values of both versions returned by the plugin.
+func (s packagesServer) GetAvailablePackageValuesDiff(ctx context.Context, request *packages.GetAvailablePackageValuesDiffRequest) (*packages.GetAvailablePackageValuesDiffResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetAvailablePackageValuesDiff %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetAvailablePackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetAvailablePackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+	}
+
+	if request.GetFromPkgVersion() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to compare the default values (missing FromPkgVersion)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getReadPluginWithServer(request.AvailablePackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
+	}
+	request.AvailablePackageRef, _ = s.unqualifyAvailablePackageRef(request.AvailablePackageRef)
+
+	// Get the response from the requested plugin, falling back to comparing
+	// the default values of both versions when it is not implemented.
+	response, err := pluginWithServer.server.GetAvailablePackageValuesDiff(ctx, request)
+	if status.Code(err) == codes.Unimplemented {
+		response, err = defaultValuesDiff(ctx, pluginWithServer, request)
+	}
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable get the GetAvailablePackageValuesDiff from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// defaultValuesDiff compares the default values of the two versions of an
+// available package returned by the plugin.
+func defaultValuesDiff(ctx context.Context, pluginWithServer *pkgsPluginWithServer, request *packages.GetAvailablePackageValuesDiffRequest) (*packages.GetAvailablePackageValuesDiffResponse, error) {
+	values := []map[string]interface{}{}
+	for _, pkgVersion := range []string{request.GetFromPkgVersion(), request.GetToPkgVersion()} {
+		response, err := pluginWithServer.server.GetAvailablePackageDefaultValues(ctx, &packages.GetAvailablePackageDefaultValuesRequest{
+			AvailablePackageRef: request.GetAvailablePackageRef(),
+			PkgVersion:          pkgVersion,
+		})
+		if err != nil {
+			return nil, err
+		}
+		versionValues := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(response.GetDefaultValues()), &versionValues); err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to parse the default values of the version %q: %v", pkgVersion, err)
+		}
+		flattened := map[string]interface{}{}
+		flattenValues("", versionValues, flattened)
+		values = append(values, flattened)
+	}
+
+	from, to := values[0], values[1]
+	response := &packages.GetAvailablePackageValuesDiffResponse{
+		AddedKeys:   []string{},
+		RemovedKeys: []string{},
+		ChangedKeys: []string{},
+	}
+	for key, fromValue := range from {
+		toValue, ok := to[key]
+		if !ok {
+			response.RemovedKeys = append(response.RemovedKeys, key)
+		} else if !reflect.DeepEqual(fromValue, toValue) {
+			response.ChangedKeys = append(response.ChangedKeys, key)
+		}
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			response.AddedKeys = append(response.AddedKeys, key)
+		}
+	}
+	sort.Strings(response.AddedKeys)
+	sort.Strings(response.RemovedKeys)
+	sort.Strings(response.ChangedKeys)
+	return response, nil
+}
+
+// flattenValues adds the leaf values, including the lists and the empty maps,
+// of the values to the flattened values, keyed by their dot-separated path.
+func flattenValues(prefix string, values map[string]interface{}, flattened map[string]interface{}) {
+	for key, value := range values {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if nested, ok := value.(map[string]interface{}); ok && len(nested) > 0 {
+			flattenValues(key, nested, flattened)
+			continue
+		}
+		flattened[key] = value
+	}
+}
+
+// RenderAvailablePackage renders the templates of an available package with
+// the given values, without touching the cluster, using configured plugins.
+// The rendered resources are checked against the policies of the target
+// cluster, if configured.
+func (s packagesServer) RenderAvailablePackage(ctx context.Context, request *packages.RenderAvailablePackageRequest) (*packages.RenderAvailablePackageResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
+	log.Infof("+core RenderAvailablePackage %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetAvailablePackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetAvailablePackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+	}
+
+	if err := s.checkValuesSize(request.GetValues()); err != nil {
+		return nil, err
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getReadPluginWithServer(request.AvailablePackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
+	}
+	request.AvailablePackageRef, _ = s.unqualifyAvailablePackageRef(request.AvailablePackageRef)
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.RenderAvailablePackage(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to render the package with the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	if s.checkClusterPolicies != nil {
+		cluster := request.GetTargetContext().GetCluster()
+		if cluster == "" {
+			cluster = request.GetAvailablePackageRef().GetContext().GetCluster()
+		}
+		if err := s.checkClusterPolicies(ctx, cluster, response.GetManifest()); err != nil {
+			return nil, err
+		}
+	}
+
+	// The apply order is only returned when requested, whether or not the
+	// plugin computed it anyway.
+	if !request.GetIncludeApplyOrder() {
+		response.ApplyOrder = nil
+	}
+
+	return response, nil
+}
+
+// CreateInstalledPackage creates an installed package using configured plugins.
+func (s packagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetTargetContext().GetCluster(), request.GetTargetContext().GetNamespace())
+	log.Infof("+core CreateInstalledPackage %s", contextMsg)
+
+	if err := s.routeMutationToCluster(ctx, request.GetTargetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetAvailablePackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+	}
+
+	if err := s.checkValuesSize(request.GetValues()); err != nil {
+		return nil, err
+	}
+
+	if err := validateInstallMetadata(request.GetLabels(), request.GetAnnotations()); err != nil {
+		return nil, err
+	}
+
+	// Forward the reconcile interval, if any, as part of the reconciliation
+	// options which are only used by the plugins supporting them.
+	if request.GetReconcileInterval() != "" {
+		interval, err := parseReconcileInterval(request.GetReconcileInterval())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid reconcile interval %q: %v", request.GetReconcileInterval(), err)
+		}
+		if request.ReconciliationOptions == nil {
+			request.ReconciliationOptions = &packages.ReconciliationOptions{}
+		}
+		request.ReconciliationOptions.Interval = interval
+	}
+
+	// Forward the install timeout, defaulted to the one of the server, to
+	// the plugins supporting it.
+	// The install timeout can't exceed the timeout of the plugin call, which
+	// would otherwise be cancelled while the plugin is still installing.
+	if request.GetInstallTimeout() == "" && s.defaultInstallTimeout > 0 {
+		request.InstallTimeout = s.defaultInstallTimeout.String()
+	}
+	var installTimeout time.Duration
+	if request.GetInstallTimeout() != "" {
+		var err error
+		installTimeout, err = parseInstallTimeout(request.GetInstallTimeout())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid install timeout %q: %v", request.GetInstallTimeout(), err)
+		}
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
+	}
+	if callTimeout := timeoutForPlugin(pluginWithServer.plugin.GetName(), s.pluginCallTimeout, s.pluginCallTimeouts); callTimeout > 0 && installTimeout > callTimeout {
+		return nil, status.Errorf(codes.InvalidArgument, "The install timeout %q exceeds the call timeout %s of the plugin %v", request.GetInstallTimeout(), callTimeout, pluginWithServer.plugin.Name)
+	}
+	request.AvailablePackageRef, _ = s.unqualifyAvailablePackageRef(request.AvailablePackageRef)
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.CreateInstalledPackage(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to  CreateInstalledPackage using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Validate the plugin response
+	if response.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.Internal, "Invalid CreateInstalledPackage response from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// UpdateInstalledPackage updates an installed package using configured plugins.
+func (s packagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
 	log.Infof("+core UpdateInstalledPackage %s", contextMsg)
 
+	if err := s.routeMutationToCluster(ctx, request.GetInstalledPackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
 	if request.GetInstalledPackageRef().GetPlugin() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
 	}
 
+	if err := s.checkValuesSize(request.GetValues()); err != nil {
+		return nil, err
+	}
+
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
 	if pluginWithServer == nil {
@@ -313,11 +1352,151 @@ func (s packagesServer) UpdateInstalledPackage(ctx context.Context, request *pac
 	return response, nil
 }
 
+// GetInstalledPackageUpgradeDiff returns the diff of the manifests of an
+// update of an installed package, without updating it, using the configured
+// plugins. Plugins which can't render the manifests return Unimplemented.
+func (s packagesServer) GetInstalledPackageUpgradeDiff(ctx context.Context, request *packages.GetInstalledPackageUpgradeDiffRequest) (*packages.GetInstalledPackageUpgradeDiffResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetInstalledPackageUpgradeDiff %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetInstalledPackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetInstalledPackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	if err := s.checkValuesSize(request.GetValues()); err != nil {
+		return nil, err
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getReadPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetInstalledPackageUpgradeDiff(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to GetInstalledPackageUpgradeDiff using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// GetInstalledPackageDrift returns how an installed package differs from the
+// current state of the cluster, using the configured plugins. Plugins which
+// can't compare it with the cluster return Unimplemented.
+func (s packagesServer) GetInstalledPackageDrift(ctx context.Context, request *packages.GetInstalledPackageDriftRequest) (*packages.GetInstalledPackageDriftResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetInstalledPackageDrift %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetInstalledPackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetInstalledPackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getReadPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetInstalledPackageDrift(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to GetInstalledPackageDrift using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// GetInstalledPackageUpdateAvailable returns whether a version newer than the
+// installed one is available, together with the latest version, resolved from
+// the versions of the available package the installed package comes from.
+func (s packagesServer) GetInstalledPackageUpdateAvailable(ctx context.Context, request *packages.GetInstalledPackageUpdateAvailableRequest) (*packages.GetInstalledPackageUpdateAvailableResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetInstalledPackageUpdateAvailable %s", contextMsg)
+
+	detailResponse, err := s.GetInstalledPackageDetail(ctx, &packages.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: request.GetInstalledPackageRef(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	pkgDetail := detailResponse.GetInstalledPackageDetail()
+	if pkgDetail.GetAvailablePackageRef() == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Unable to resolve the available versions of the installed package %q (missing AvailablePackageRef)", request.GetInstalledPackageRef().GetIdentifier())
+	}
+	installedVersion, err := semver.NewVersion(pkgDetail.GetCurrentVersion().GetPkgVersion())
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Unable to compare the installed version %q of the package %q: %v", pkgDetail.GetCurrentVersion().GetPkgVersion(), request.GetInstalledPackageRef().GetIdentifier(), err)
+	}
+
+	versionsResponse, err := s.GetAvailablePackageVersions(ctx, &packages.GetAvailablePackageVersionsRequest{
+		AvailablePackageRef: pkgDetail.GetAvailablePackageRef(),
+		IncludePrerelease:   request.GetIncludePrerelease(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	latest := latestPkgAppVersion(versionsResponse.GetPackageAppVersions(), pkgDetail.GetAvailablePackageRef().GetIdentifier())
+	if latest == nil {
+		return nil, status.Errorf(codes.NotFound, "Unable to resolve the latest version of the package %q: no valid versions found", pkgDetail.GetAvailablePackageRef().GetIdentifier())
+	}
+	// The latest version was parsed successfully when resolving it.
+	latestVersion := semver.MustParse(latest.GetPkgVersion())
+
+	return &packages.GetInstalledPackageUpdateAvailableResponse{
+		UpdateAvailable: latestVersion.GreaterThan(installedVersion),
+		LatestVersion:   latest,
+	}, nil
+}
+
+// ExportInstalledPackage returns a declarative manifest of an installed
+// package, to migrate it to GitOps, using the configured plugins. Plugins
+// which can't export it return Unimplemented.
+func (s packagesServer) ExportInstalledPackage(ctx context.Context, request *packages.ExportInstalledPackageRequest) (*packages.ExportInstalledPackageResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+core ExportInstalledPackage %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetInstalledPackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetInstalledPackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getReadPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.ExportInstalledPackage(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to ExportInstalledPackage using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
 // DeleteInstalledPackage deletes an installed package using configured plugins.
 func (s packagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
 	log.Infof("+core DeleteInstalledPackage %s", contextMsg)
 
+	if err := s.routeMutationToCluster(ctx, request.GetInstalledPackageRef().GetContext()); err != nil {
+		return nil, err
+	}
+
 	if request.GetInstalledPackageRef().GetPlugin() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
 	}
@@ -337,23 +1516,1186 @@ func (s packagesServer) DeleteInstalledPackage(ctx context.Context, request *pac
 	return response, nil
 }
 
-// getPluginWithServer returns the *pkgsPluginWithServer from a given packagesServer
-// matching the plugin name
-func (s packagesServer) getPluginWithServer(plugin *v1alpha1.Plugin) *pkgsPluginWithServer {
-	for _, p := range s.plugins {
-		if plugin.Name == p.plugin.Name {
-			return p
+// DeleteInstalledPackagesBatch deletes several installed packages using the
+// configured plugins, either one after the other or concurrently. The result
+// of each deletion is returned rather than aborting the batch on a failure.
+func (s packagesServer) DeleteInstalledPackagesBatch(ctx context.Context, request *packages.DeleteInstalledPackagesBatchRequest) (*packages.DeleteInstalledPackagesBatchResponse, error) {
+	log.Infof("+core DeleteInstalledPackagesBatch (count=%d, concurrent=%t)", len(request.GetInstalledPackageRefs()), request.GetConcurrent())
+
+	results := make([]*packages.DeleteInstalledPackageResult, len(request.GetInstalledPackageRefs()))
+	newResult := func(i int, err error) {
+		results[i] = &packages.DeleteInstalledPackageResult{
+			InstalledPackageRef: request.GetInstalledPackageRefs()[i],
+			StatusCode:          int32(status.Code(err)),
+		}
+		if err != nil {
+			results[i].ErrorMessage = status.Convert(err).Message()
 		}
 	}
-	return nil
-}
+	deleteInstalledPackage := func(ctx context.Context, i int) {
+		_, err := s.DeleteInstalledPackage(ctx, &packages.DeleteInstalledPackageRequest{
+			InstalledPackageRef: request.GetInstalledPackageRefs()[i],
+		})
+		newResult(i, err)
+	}
 
-// pageOffsetFromPageToken converts a page token to an integer offset
-// representing the page of results.
-// TODO(mnelson): When aggregating results from different plugins, we'll
-// need to update the actual query in GetPaginatedChartListWithFilters to
-// use a row offset rather than a page offset (as not all rows may be consumed
-// for a specific plugin when combining).
+	if request.GetConcurrent() {
+		err := s.processBatch(ctx, len(request.GetInstalledPackageRefs()), deleteInstalledPackage)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// As when processed concurrently, the references following the
+		// cancellation of the request are not processed.
+		for i := range request.GetInstalledPackageRefs() {
+			if ctx.Err() != nil {
+				break
+			}
+			deleteInstalledPackage(ctx, i)
+		}
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	return &packages.DeleteInstalledPackagesBatchResponse{
+		Results: results,
+	}, nil
+}
+
+// processBatch calls process for each of the count references of a batch
+// request concurrently, at most batchConcurrency at a time if limited, as
+// processConcurrently does.
+func (s packagesServer) processBatch(ctx context.Context, count int, process func(ctx context.Context, i int)) error {
+	return processConcurrently(ctx, count, s.batchConcurrency, process)
+}
+
+// processConcurrently calls process for each of the count references
+// concurrently, at most concurrency at a time if limited (not when 0), and
+// waits for all of them. Each call gets a context canceled as soon as the
+// request is, so that the in-flight plugin calls return promptly, and the
+// references still queued are not processed. The returned error is the
+// cancellation of the request, if any, once all the calls have returned.
+// A panic of a call, such as of a failing plugin, is raised again once all
+// the calls have returned, so that it is handled as a panic of the request
+// rather than crashing the server.
+func processConcurrently(ctx context.Context, count int, concurrency int, process func(ctx context.Context, i int)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var semaphore chan struct{}
+	if concurrency > 0 {
+		semaphore = make(chan struct{}, concurrency)
+	}
+	var wg sync.WaitGroup
+	var panicOnce sync.Once
+	var panicValue interface{}
+	for i := 0; i < count; i++ {
+		if semaphore != nil {
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Errorf("Panic while processing a batch: %v\n%s", r, debug.Stack())
+					panicOnce.Do(func() {
+						panicValue = r
+						cancel()
+					})
+				}
+				if semaphore != nil {
+					<-semaphore
+				}
+				wg.Done()
+			}()
+			process(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+	if panicValue != nil {
+		panic(panicValue)
+	}
+
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return nil
+}
+
+// GetCatalogStats returns the number of available and installed packages, in
+// total, per plugin and per category, aggregated across the plugins.
+func (s packagesServer) GetCatalogStats(ctx context.Context, request *packages.GetCatalogStatsRequest) (*packages.GetCatalogStatsResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
+	log.Infof("+core GetCatalogStats %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetContext()); err != nil {
+		return nil, err
+	}
+
+	availablePkgs, categories, _, err := s.fetchAvailablePackageSummaries(ctx, &packages.GetAvailablePackageSummariesRequest{
+		Context: request.GetContext(),
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	installedResponse, err := s.GetInstalledPackageSummaries(ctx, &packages.GetInstalledPackageSummariesRequest{
+		Context: request.GetContext(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	installedPkgs := installedResponse.GetInstalledPackageSummaries()
+
+	stats := &packages.CatalogStats{
+		AvailablePackageCount: int32(len(availablePkgs)),
+		InstalledPackageCount: int32(len(installedPkgs)),
+		PluginStats:           []*packages.PluginCatalogStats{},
+		CategoryStats:         []*packages.CategoryCatalogStats{},
+	}
+
+	// The summaries reference the same plugin as the configured plugins.
+	pluginStats := map[*v1alpha1.Plugin]*packages.PluginCatalogStats{}
+	for _, p := range s.plugins {
+		pluginStats[p.plugin] = &packages.PluginCatalogStats{Plugin: p.plugin}
+		stats.PluginStats = append(stats.PluginStats, pluginStats[p.plugin])
+	}
+	categoryCounts := map[string]int32{}
+	for _, pkg := range availablePkgs {
+		if ps, ok := pluginStats[pkg.GetAvailablePackageRef().GetPlugin()]; ok {
+			ps.AvailablePackageCount++
+		}
+		for _, category := range pkg.GetCategories() {
+			categoryCounts[category]++
+		}
+	}
+	for _, pkg := range installedPkgs {
+		if ps, ok := pluginStats[pkg.GetInstalledPackageRef().GetPlugin()]; ok {
+			ps.InstalledPackageCount++
+		}
+	}
+	for _, category := range categories {
+		stats.CategoryStats = append(stats.CategoryStats, &packages.CategoryCatalogStats{
+			Category:              category,
+			AvailablePackageCount: categoryCounts[category],
+		})
+	}
+
+	return &packages.GetCatalogStatsResponse{
+		CatalogStats: stats,
+	}, nil
+}
+
+// GetRecentActivity returns the operations on the installed packages of all
+// the plugins tracking their history, most recent first. The plugins without
+// history are skipped.
+func (s packagesServer) GetRecentActivity(ctx context.Context, request *packages.GetRecentActivityRequest) (*packages.GetRecentActivityResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
+	log.Infof("+core GetRecentActivity %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetContext()); err != nil {
+		return nil, err
+	}
+
+	pageSize := request.GetPaginationOptions().GetPageSize()
+	pageToken, err := parsePluginsPageToken(request.GetPaginationOptions().GetPageToken(), s.plugins)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to intepret page token %q: %v", request.GetPaginationOptions().GetPageToken(), err)
+	}
+
+	// Fetch the page of each plugin at its own position, without the
+	// activities it already returned.
+	pluginPages := make([]activitiesPluginPage, len(s.plugins))
+	for i, p := range s.plugins {
+		position, ok := pageToken[pluginPageTokenKey(p.plugin)]
+		if !ok {
+			continue
+		}
+		pluginRequest := proto.Clone(request).(*packages.GetRecentActivityRequest)
+		pluginRequest.PaginationOptions = &packages.PaginationOptions{
+			PageToken: position.PageToken,
+			PageSize:  pageSize,
+		}
+		response, err := p.server.GetRecentActivity(ctx, pluginRequest)
+		if status.Code(err) == codes.Unimplemented {
+			continue
+		}
+		if err != nil {
+			return nil, status.Errorf(status.Convert(err).Code(), "Invalid GetRecentActivity response from the plugin %v: %v", p.plugin.Name, err)
+		}
+		for _, a := range response.GetActivities() {
+			if a.InstalledPackageRef == nil {
+				a.InstalledPackageRef = &packages.InstalledPackageReference{}
+			}
+			a.InstalledPackageRef.Plugin = p.plugin
+		}
+		activities := response.GetActivities()
+		if position.Returned < len(activities) {
+			activities = activities[position.Returned:]
+		} else {
+			activities = nil
+		}
+		pluginPages[i] = activitiesPluginPage{
+			pluginPage: pluginPage{
+				position:      position,
+				size:          len(activities),
+				nextPageToken: response.NextPageToken,
+			},
+			activities: activities,
+		}
+	}
+
+	activities := mergeActivitiesPluginPages(pluginPages, pageSize)
+	sortActivities(activities)
+
+	// Without pagination, all the activities are returned at once.
+	nextPageToken := ""
+	if pageSize > 0 {
+		progress := make([]pluginPage, len(pluginPages))
+		for i, page := range pluginPages {
+			progress[i] = page.pluginPage
+		}
+		nextPageToken, err = s.nextPluginsPageToken(progress)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to create the next page token: %v", err)
+		}
+	}
+
+	return &packages.GetRecentActivityResponse{
+		Activities:    activities,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// activitiesPluginPage is a page of the activities of a plugin.
+type activitiesPluginPage struct {
+	pluginPage
+	activities []*packages.PackageActivity
+}
+
+// mergeActivitiesPluginPages returns up to a page of the activities of the
+// plugin pages, or all of them when the page size is not positive, taking the
+// first activity, in the order of sortActivities, of the pages in turn so
+// that every page is returned in order, and counting the activities returned
+// from each page.
+func mergeActivitiesPluginPages(pluginPages []activitiesPluginPage, pageSize int32) []*packages.PackageActivity {
+	activities := []*packages.PackageActivity{}
+	for pageSize <= 0 || len(activities) < int(pageSize) {
+		next := -1
+		for i, page := range pluginPages {
+			if page.returned == page.size {
+				continue
+			}
+			if next == -1 || activityLess(page.activities[page.returned], pluginPages[next].activities[pluginPages[next].returned]) {
+				next = i
+			}
+		}
+		if next == -1 {
+			break
+		}
+		activities = append(activities, pluginPages[next].activities[pluginPages[next].returned])
+		pluginPages[next].returned++
+	}
+	return activities
+}
+
+// sortActivities orders the activities by time, most recent first, the ones
+// without a valid time last. The activities at the same time are ordered by
+// plugin name, namespace and installed package name so that the order is
+// stable across calls.
+func sortActivities(activities []*packages.PackageActivity) {
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activityLess(activities[i], activities[j])
+	})
+}
+
+// activityLess returns whether an activity comes before another one in the
+// order of sortActivities.
+func activityLess(a, b *packages.PackageActivity) bool {
+	ta, _ := time.Parse(time.RFC3339, a.GetTime())
+	tb, _ := time.Parse(time.RFC3339, b.GetTime())
+	if !ta.Equal(tb) {
+		return ta.After(tb)
+	}
+	if pa, pb := a.GetInstalledPackageRef().GetPlugin().GetName(), b.GetInstalledPackageRef().GetPlugin().GetName(); pa != pb {
+		return pa < pb
+	}
+	if na, nb := a.GetInstalledPackageRef().GetContext().GetNamespace(), b.GetInstalledPackageRef().GetContext().GetNamespace(); na != nb {
+		return na < nb
+	}
+	return a.GetName() < b.GetName()
+}
+
+// GetUserPermissions returns the operations the user can perform on the
+// installed packages of a plugin in the requested context, so that clients
+// only offer the allowed ones.
+func (s packagesServer) GetUserPermissions(ctx context.Context, request *packages.GetUserPermissionsRequest) (*packages.GetUserPermissionsResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
+	log.Infof("+core GetUserPermissions %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetContext()); err != nil {
+		return nil, err
+	}
+
+	if request.GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getReadPluginWithServer(request.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetUserPermissions(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable get the GetUserPermissions from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// GetCatalogIndex returns a minimal entry for each available package of all
+// the plugins, as the gzip-compressed JSON encoding of a CatalogIndex, so that
+// clients can search the whole catalog themselves.
+func (s packagesServer) GetCatalogIndex(ctx context.Context, request *packages.GetCatalogIndexRequest) (*packages.GetCatalogIndexResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
+	log.Infof("+core GetCatalogIndex %s", contextMsg)
+
+	if err := s.routeToCluster(ctx, request.GetContext()); err != nil {
+		return nil, err
+	}
+
+	availablePkgs, _, _, err := s.fetchAvailablePackageSummaries(ctx, &packages.GetAvailablePackageSummariesRequest{
+		Context: request.GetContext(),
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	index := &packages.CatalogIndex{
+		Entries: make([]*packages.CatalogIndexEntry, len(availablePkgs)),
+	}
+	for i, pkg := range availablePkgs {
+		index.Entries[i] = &packages.CatalogIndexEntry{
+			Identifier:  pkg.GetAvailablePackageRef().GetIdentifier(),
+			Plugin:      pkg.GetAvailablePackageRef().GetPlugin().GetName(),
+			Name:        pkg.GetName(),
+			DisplayName: pkg.GetDisplayName(),
+			PkgVersion:  pkg.GetLatestVersion().GetPkgVersion(),
+			AppVersion:  pkg.GetLatestVersion().GetAppVersion(),
+			Categories:  pkg.GetCategories(),
+			Repository:  pkg.GetRepository().GetName(),
+		}
+	}
+
+	// The versions of each package are listed concurrently, as for the batch
+	// requests but at most maxCatalogIndexConcurrency at a time, as the index
+	// lists the versions of the whole catalog. A package whose versions
+	// cannot be listed only gets its latest version, with a warning.
+	concurrency := s.batchConcurrency
+	if concurrency <= 0 || concurrency > maxCatalogIndexConcurrency {
+		concurrency = maxCatalogIndexConcurrency
+	}
+	err = processConcurrently(ctx, len(availablePkgs), concurrency, func(ctx context.Context, i int) {
+		index.Entries[i].PkgVersions = s.catalogIndexVersions(ctx, availablePkgs[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	compressed, err := compressCatalogIndex(index)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to encode the catalog index: %v", err)
+	}
+
+	return &packages.GetCatalogIndexResponse{
+		Index:        compressed,
+		PackageCount: int32(len(index.Entries)),
+	}, nil
+}
+
+// catalogIndexVersions returns the versions of an available package,
+// starting with the latest, or only its latest version when the versions
+// cannot be listed. The pre-releases are excluded, as they are by default
+// when listing the versions.
+func (s packagesServer) catalogIndexVersions(ctx context.Context, pkg *packages.AvailablePackageSummary) []string {
+	response, err := s.GetAvailablePackageVersions(ctx, &packages.GetAvailablePackageVersionsRequest{
+		AvailablePackageRef: pkg.GetAvailablePackageRef(),
+	})
+	if err != nil {
+		AddWarning(ctx, "Unable to list the versions of the available package %q of the plugin %q: %v", pkg.GetAvailablePackageRef().GetIdentifier(), pkg.GetAvailablePackageRef().GetPlugin().GetName(), err)
+		return []string{pkg.GetLatestVersion().GetPkgVersion()}
+	}
+	versions := make([]string, len(response.GetPackageAppVersions()))
+	for i, version := range response.GetPackageAppVersions() {
+		versions[i] = version.GetPkgVersion()
+	}
+	return versions
+}
+
+// compressCatalogIndex returns the gzip-compressed JSON encoding of the index,
+// with the field names of the JSON gateway responses.
+func compressCatalogIndex(index *packages.CatalogIndex) ([]byte, error) {
+	encoded, err := protojson.Marshal(index)
+	if err != nil {
+		return nil, err
+	}
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(encoded); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return compressed.Bytes(), nil
+}
+
+// routeToCluster sets the cluster of a context which includes a namespace but
+// no cluster, using the cluster mapped to the longest matching namespace prefix.
+// The default target cluster, if any, is then used for a context still
+// without a cluster, otherwise the cluster is left blank so that the Kubeapps
+// cluster is used. A context without a namespace gets the default namespace
+// of its cluster, if configured: by default once its cluster is resolved,
+// before routing it with the namespace-first defaulting order. The routed
+// context is then checked against the namespaces of the tenant of the call,
+// if any.
+func (s packagesServer) routeToCluster(ctx context.Context, pkgContext *packages.Context) error {
+	if pkgContext == nil {
+		return checkTenantNamespace(ctx, pkgContext)
+	}
+	namespaceFirst := s.namespaceDefaultingOrder == NamespaceDefaultingOrderNamespaceFirst
+	if namespaceFirst && pkgContext.Namespace == "" {
+		pkgContext.Namespace = s.clusterDefaultNamespaces[pkgContext.Cluster]
+	}
+	if pkgContext.Namespace != "" && pkgContext.Cluster == "" {
+		pkgContext.Cluster, _ = mappedCluster(s.namespaceClusterMapping, pkgContext.Namespace)
+	}
+	if pkgContext.Cluster == "" {
+		pkgContext.Cluster = s.defaultTargetCluster
+	}
+	if pkgContext.Namespace == "" {
+		// the blank cluster name maps to the default namespace of the
+		// default target cluster
+		pkgContext.Namespace = s.clusterDefaultNamespaces[pkgContext.Cluster]
+	}
+	return checkTenantNamespace(ctx, pkgContext)
+}
+
+// mappedCluster returns the cluster to which the namespace is mapped by the
+// longest of its prefixes in the namespace cluster mapping, if any.
+func mappedCluster(namespaceClusterMapping map[string]string, namespace string) (string, bool) {
+	matchedPrefix, matchedCluster := "", ""
+	for prefix, cluster := range namespaceClusterMapping {
+		if strings.HasPrefix(namespace, prefix) && len(prefix) > len(matchedPrefix) {
+			matchedPrefix, matchedCluster = prefix, cluster
+		}
+	}
+	return matchedCluster, matchedPrefix != ""
+}
+
+// routeMutationToCluster routes the context of a mutating request as
+// routeToCluster, applying the empty namespace policy to a blank namespace.
+func (s packagesServer) routeMutationToCluster(ctx context.Context, pkgContext *packages.Context) error {
+	if pkgContext.GetNamespace() == "" && s.emptyNamespacePolicy == EmptyNamespacePolicyReject {
+		return status.Errorf(codes.InvalidArgument, "A namespace is required for the mutating operations")
+	}
+	if err := s.routeToCluster(ctx, pkgContext); err != nil {
+		return err
+	}
+	if pkgContext.GetNamespace() == "" && s.emptyNamespacePolicy == EmptyNamespacePolicyDefault {
+		return status.Errorf(codes.InvalidArgument, "A namespace is required, no default namespace being configured for the cluster %q", pkgContext.GetCluster())
+	}
+	return nil
+}
+
+// checkNamespaceDefaultingOrder checks that the order is one of the
+// NamespaceDefaultingOrder constants, a blank order being cluster first.
+func checkNamespaceDefaultingOrder(order string) error {
+	switch order {
+	case NamespaceDefaultingOrderClusterFirst, NamespaceDefaultingOrderNamespaceFirst, "":
+		return nil
+	default:
+		return fmt.Errorf("invalid namespace defaulting order %q, expected %q or %q", order, NamespaceDefaultingOrderClusterFirst, NamespaceDefaultingOrderNamespaceFirst)
+	}
+}
+
+// checkEmptyNamespacePolicy checks that the policy is one of the
+// EmptyNamespacePolicy constants, a blank policy forwarding the blank
+// namespaces without a default namespace to the plugins.
+func checkEmptyNamespacePolicy(policy string) error {
+	switch policy {
+	case EmptyNamespacePolicyReject, EmptyNamespacePolicyDefault, "":
+		return nil
+	default:
+		return fmt.Errorf("invalid empty namespace policy %q, expected %q or %q", policy, EmptyNamespacePolicyReject, EmptyNamespacePolicyDefault)
+	}
+}
+
+// resolveLatestPkgVersion returns the newest version, using semver ordering,
+// of the available versions of a package in the given plugin, a stable one
+// unless the pre-release versions are included.
+func resolveLatestPkgVersion(ctx context.Context, pluginWithServer *pkgsPluginWithServer, pkgRef *packages.AvailablePackageReference, includePrerelease bool) (string, error) {
+	response, err := pluginWithServer.server.GetAvailablePackageVersions(ctx, &packages.GetAvailablePackageVersionsRequest{
+		AvailablePackageRef: pkgRef,
+		IncludePrerelease:   includePrerelease,
+	})
+	if err != nil {
+		return "", status.Errorf(status.Convert(err).Code(), "Unable to resolve the latest version from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	versions := response.GetPackageAppVersions()
+	if !includePrerelease {
+		versions = excludePrereleaseVersions(versions)
+	}
+	latest := latestPkgAppVersion(versions, pkgRef.GetIdentifier())
+	if latest == nil {
+		return "", status.Errorf(codes.NotFound, "Unable to resolve the latest version of the package %q: no valid versions found", pkgRef.GetIdentifier())
+	}
+	return latest.GetPkgVersion(), nil
+}
+
+// latestPkgAppVersion returns the version with the greatest package version,
+// or nil if there is none. The versions which are not valid semantic versions
+// are ignored.
+func latestPkgAppVersion(versions []*packages.PackageAppVersion, identifier string) *packages.PackageAppVersion {
+	var latest *packages.PackageAppVersion
+	var latestVersion *semver.Version
+	for _, v := range versions {
+		version, err := semver.NewVersion(v.GetPkgVersion())
+		if err != nil {
+			log.Warningf("Ignoring the invalid version %q of the package %q: %v", v.GetPkgVersion(), identifier, err)
+			continue
+		}
+		if latestVersion == nil || version.GreaterThan(latestVersion) {
+			latest, latestVersion = v, version
+		}
+	}
+	return latest
+}
+
+// excludePrereleaseVersions returns the versions which are not pre-release
+// semantic versions (eg. "2.0.0-beta.1"). The versions which are not valid
+// semantic versions are kept, their pre-release status being unknown.
+func excludePrereleaseVersions(versions []*packages.PackageAppVersion) []*packages.PackageAppVersion {
+	stable := []*packages.PackageAppVersion{}
+	for _, v := range versions {
+		if version, err := semver.NewVersion(v.GetPkgVersion()); err == nil && version.Prerelease() != "" {
+			continue
+		}
+		stable = append(stable, v)
+	}
+	return stable
+}
+
+// relateVersions returns copies of the versions annotated with their relation
+// to the installed version, the versions which are not valid semantic
+// versions being left unspecified. The versions are copied as they can be
+// shared with the versions cache.
+func relateVersions(versions []*packages.PackageAppVersion, installedVersion *semver.Version) []*packages.PackageAppVersion {
+	related := make([]*packages.PackageAppVersion, 0, len(versions))
+	for _, v := range versions {
+		relatedVersion := proto.Clone(v).(*packages.PackageAppVersion)
+		if version, err := semver.NewVersion(v.GetPkgVersion()); err == nil {
+			switch version.Compare(installedVersion) {
+			case -1:
+				relatedVersion.Relation = packages.PackageAppVersion_VERSION_RELATION_OLDER
+			case 0:
+				relatedVersion.Relation = packages.PackageAppVersion_VERSION_RELATION_CURRENT
+			default:
+				relatedVersion.Relation = packages.PackageAppVersion_VERSION_RELATION_NEWER
+			}
+		}
+		related = append(related, relatedVersion)
+	}
+	return related
+}
+
+// groupVersionsByMinor groups the versions by major.minor, newest first, with
+// the newest patch version of each group. The versions which are not valid
+// semantic versions are ignored.
+func groupVersionsByMinor(versions []*packages.PackageAppVersion) []*packages.PackageVersionGroup {
+	groups := map[string]*packages.PackageVersionGroup{}
+	latestVersions := map[string]*semver.Version{}
+	for _, v := range versions {
+		version, err := semver.NewVersion(v.GetPkgVersion())
+		if err != nil {
+			log.Warningf("Not grouping the invalid version %q: %v", v.GetPkgVersion(), err)
+			continue
+		}
+		minorVersion := fmt.Sprintf("%d.%d", version.Major(), version.Minor())
+		group, ok := groups[minorVersion]
+		if !ok {
+			group = &packages.PackageVersionGroup{MinorVersion: minorVersion}
+			groups[minorVersion] = group
+		}
+		group.Count++
+		if latest := latestVersions[minorVersion]; latest == nil || version.GreaterThan(latest) {
+			latestVersions[minorVersion] = version
+			group.Latest = v
+		}
+	}
+
+	result := make([]*packages.PackageVersionGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return latestVersions[result[i].MinorVersion].GreaterThan(latestVersions[result[j].MinorVersion])
+	})
+	return result
+}
+
+// validateInstallMetadata returns an InvalidArgument error when the labels or
+// annotations requested for an install are not valid Kubernetes ones, so that
+// all the plugins applying them reject the same requests.
+func validateInstallMetadata(labels, annotations map[string]string) error {
+	for key, value := range labels {
+		errs := append(validation.IsQualifiedName(key), validation.IsValidLabelValue(value)...)
+		if len(errs) > 0 {
+			return status.Errorf(codes.InvalidArgument, "Invalid label %q=%q: %s", key, value, strings.Join(errs, "; "))
+		}
+	}
+	for key := range annotations {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return status.Errorf(codes.InvalidArgument, "Invalid annotation %q: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// checkValuesSize returns an InvalidArgument error when the values of a
+// create or update request exceed the configured maximum size, so that the
+// plugins are not sent arbitrarily large values.
+func (s packagesServer) checkValuesSize(values string) error {
+	if s.maxValuesSize > 0 && len(values) > s.maxValuesSize {
+		return status.Errorf(codes.InvalidArgument, "The values (%d bytes) exceed the maximum values size of %d bytes", len(values), s.maxValuesSize)
+	}
+	return nil
+}
+
+// parseReconcileInterval returns the number of seconds of a reconcile interval
+// duration (eg. "10m"), which must be positive and a whole number of seconds.
+func parseReconcileInterval(reconcileInterval string) (int32, error) {
+	duration, err := time.ParseDuration(reconcileInterval)
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("the interval must be positive")
+	}
+	if duration%time.Second != 0 {
+		return 0, fmt.Errorf("the interval must be a whole number of seconds")
+	}
+	if duration.Seconds() > math.MaxInt32 {
+		return 0, fmt.Errorf("the interval is too long")
+	}
+	return int32(duration.Seconds()), nil
+}
+
+// parseInstallTimeout returns the duration of an install timeout (eg. "5m"),
+// which must be positive.
+func parseInstallTimeout(installTimeout string) (time.Duration, error) {
+	duration, err := time.ParseDuration(installTimeout)
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("the timeout must be positive")
+	}
+	return duration, nil
+}
+
+// installedPackageKey identifies an installed package regardless of the
+// plugin reporting it.
+type installedPackageKey struct {
+	cluster    string
+	namespace  string
+	identifier string
+}
+
+func installedPackageKeyFromRef(ref *packages.InstalledPackageReference) installedPackageKey {
+	return installedPackageKey{
+		cluster:    ref.GetContext().GetCluster(),
+		namespace:  ref.GetContext().GetNamespace(),
+		identifier: ref.GetIdentifier(),
+	}
+}
+
+// installedCountKey identifies the available package of a plugin to which
+// installed packages are matched.
+type installedCountKey struct {
+	plugin     string
+	identifier string
+}
+
+// setInstalledCounts sets the number of times each available package is
+// installed in the routed context of the request, matching the available
+// package reference of the installed packages of the same plugin. Installed
+// packages without such a reference and plugins which can't list installed
+// packages are skipped.
+func (s packagesServer) setInstalledCounts(ctx context.Context, pkgContext *packages.Context, pkgs []*packages.AvailablePackageSummary) error {
+	installedCounts := map[installedCountKey]int32{}
+	for _, p := range s.plugins {
+		response, err := p.server.GetInstalledPackageSummaries(ctx, &packages.GetInstalledPackageSummariesRequest{
+			Context: pkgContext,
+		})
+		if status.Code(err) == codes.Unimplemented {
+			continue
+		}
+		if err != nil {
+			return status.Errorf(status.Convert(err).Code(), "Unable to count the installed packages from the plugin %v: %v", p.plugin.Name, err)
+		}
+		for _, installedPkg := range response.GetInstalledPackageSummaries() {
+			identifier := installedPkg.GetAvailablePackageRef().GetIdentifier()
+			if identifier == "" {
+				continue
+			}
+			installedCounts[installedCountKey{plugin: p.plugin.Name, identifier: identifier}]++
+		}
+	}
+
+	for _, pkg := range pkgs {
+		pkg.InstalledCount = installedCounts[installedCountKey{
+			plugin:     pkg.GetAvailablePackageRef().GetPlugin().GetName(),
+			identifier: pkg.GetAvailablePackageRef().GetIdentifier(),
+		}]
+	}
+	return nil
+}
+
+// sortAvailablePackageSummaries orders the summaries by package name,
+// regardless of the plugin. The summaries with the same name, such as the
+// same identifier returned by several plugins, are ordered by plugin: first
+// the plugins in the configured plugin order, then the rest by plugin name,
+// and finally by plugin version and identifier so that the order is total.
+func (s packagesServer) sortAvailablePackageSummaries(pkgs []*packages.AvailablePackageSummary) []*packages.AvailablePackageSummary {
+	sorted := []*packages.AvailablePackageSummary{}
+	From(pkgs).
+		OrderBy(func(pkg interface{}) interface{} {
+			return pkg.(*packages.AvailablePackageSummary).Name
+		}).
+		ThenBy(func(pkg interface{}) interface{} {
+			pluginName := pkg.(*packages.AvailablePackageSummary).GetAvailablePackageRef().GetPlugin().GetName()
+			for i, pinned := range s.pluginOrder {
+				if pluginName == pinned {
+					return i
+				}
+			}
+			return len(s.pluginOrder)
+		}).
+		ThenBy(func(pkg interface{}) interface{} {
+			return pkg.(*packages.AvailablePackageSummary).GetAvailablePackageRef().GetPlugin().GetName()
+		}).
+		ThenBy(func(pkg interface{}) interface{} {
+			return pkg.(*packages.AvailablePackageSummary).GetAvailablePackageRef().GetPlugin().GetVersion()
+		}).
+		ThenBy(func(pkg interface{}) interface{} {
+			return pkg.(*packages.AvailablePackageSummary).GetAvailablePackageRef().GetIdentifier()
+		}).
+		ToSlice(&sorted)
+	return sorted
+}
+
+// rankAvailablePackageSummaries orders the summaries, in place, by their
+// relevance for the query: first the packages named as the query, then the
+// ones whose name starts with it, contains it, and finally the ones whose
+// description contains it. The comparisons ignore the case. The packages
+// with the same relevance, such as the ones matched by the plugin on other
+// fields, keep their order.
+func rankAvailablePackageSummaries(pkgs []*packages.AvailablePackageSummary, query string) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	relevance := func(pkg *packages.AvailablePackageSummary) int {
+		name := strings.ToLower(pkg.GetName())
+		switch {
+		case name == query:
+			return 4
+		case strings.HasPrefix(name, query):
+			return 3
+		case strings.Contains(name, query):
+			return 2
+		case strings.Contains(strings.ToLower(pkg.GetShortDescription()), query):
+			return 1
+		default:
+			return 0
+		}
+	}
+	scores := make(map[*packages.AvailablePackageSummary]int, len(pkgs))
+	for _, pkg := range pkgs {
+		scores[pkg] = relevance(pkg)
+	}
+	sort.SliceStable(pkgs, func(i, j int) bool {
+		return scores[pkgs[i]] > scores[pkgs[j]]
+	})
+}
+
+// sortCategories returns the distinct categories, starting with the ones
+// pinned in the configured category order followed by the rest sorted by name.
+func (s packagesServer) sortCategories(categories []string) []string {
+	sorted := []string{}
+	From(categories).
+		Distinct().
+		OrderBy(func(category interface{}) interface{} {
+			for i, pinned := range s.categoryOrder {
+				if category.(string) == pinned {
+					return i
+				}
+			}
+			return len(s.categoryOrder)
+		}).
+		ThenBy(func(category interface{}) interface{} { return category }).
+		ToSlice(&sorted)
+	return sorted
+}
+
+// countCategories returns the number of packages in each of their categories,
+// ordered as the merged categories. A category listed several times by a
+// package is counted once, while the blank categories are ignored.
+func (s packagesServer) countCategories(pkgs []*packages.AvailablePackageSummary) []*packages.CategoryCount {
+	counts := map[string]int32{}
+	for _, pkg := range pkgs {
+		counted := map[string]bool{}
+		for _, category := range pkg.GetCategories() {
+			if category == "" || counted[category] {
+				continue
+			}
+			counted[category] = true
+			counts[category]++
+		}
+	}
+
+	names := []string{}
+	for name := range counts {
+		names = append(names, name)
+	}
+	categoryCounts := []*packages.CategoryCount{}
+	for _, name := range s.sortCategories(names) {
+		categoryCounts = append(categoryCounts, &packages.CategoryCount{
+			Name:  name,
+			Count: counts[name],
+		})
+	}
+	return categoryCounts
+}
+
+// preferredLanguages returns the language tags of an Accept-Language value
+// ordered by decreasing quality. The wildcard and the tags with a zero quality
+// are skipped, as the default descriptions are used when nothing matches.
+func preferredLanguages(acceptLanguage string) []string {
+	type weightedLanguage struct {
+		tag     string
+		quality float64
+	}
+	weightedLanguages := []weightedLanguage{}
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		params := strings.Split(part, ";")
+		tag := strings.TrimSpace(params[0])
+		quality := 1.0
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err != nil {
+					q = 0
+				}
+				quality = q
+			}
+		}
+		if tag == "" || tag == "*" || quality <= 0 {
+			continue
+		}
+		weightedLanguages = append(weightedLanguages, weightedLanguage{tag: tag, quality: quality})
+	}
+	sort.SliceStable(weightedLanguages, func(i, j int) bool {
+		return weightedLanguages[i].quality > weightedLanguages[j].quality
+	})
+
+	languages := []string{}
+	for _, l := range weightedLanguages {
+		languages = append(languages, l.tag)
+	}
+	return languages
+}
+
+// baseLanguage returns the primary subtag of a language tag, eg. "fr" for "fr-CA".
+func baseLanguage(tag string) string {
+	return strings.SplitN(tag, "-", 2)[0]
+}
+
+// matchLocalizedDescription returns the localized description best matching
+// the preferred languages. For each language in order, a description with the
+// same tag is preferred to one with the same base language (eg. "fr" for
+// "fr-CA"). It returns nil when no description matches.
+func matchLocalizedDescription(descriptions []*packages.LocalizedDescription, languages []string) *packages.LocalizedDescription {
+	for _, language := range languages {
+		for _, d := range descriptions {
+			if strings.EqualFold(d.GetLanguage(), language) {
+				return d
+			}
+		}
+		for _, d := range descriptions {
+			if strings.EqualFold(baseLanguage(d.GetLanguage()), baseLanguage(language)) {
+				return d
+			}
+		}
+	}
+	return nil
+}
+
+// localizeAvailablePackageSummaries returns the summaries with the short
+// description replaced by the one in the best matching language. The summaries
+// returned by the plugins are copied rather than modified.
+func localizeAvailablePackageSummaries(pkgs []*packages.AvailablePackageSummary, languages []string) []*packages.AvailablePackageSummary {
+	localizedPkgs := make([]*packages.AvailablePackageSummary, len(pkgs))
+	for i, pkg := range pkgs {
+		localizedPkgs[i] = pkg
+		description := matchLocalizedDescription(pkg.GetLocalizedDescriptions(), languages)
+		if description.GetShortDescription() == "" {
+			continue
+		}
+		localizedPkgs[i] = proto.Clone(pkg).(*packages.AvailablePackageSummary)
+		localizedPkgs[i].ShortDescription = description.GetShortDescription()
+	}
+	return localizedPkgs
+}
+
+// qualifyAmbiguousIdentifiers returns the summaries of a plugin with the
+// identifiers published by several repositories qualified with their
+// repository, so that each identifier is unique. The summaries returned by the
+// plugin are copied rather than modified.
+func qualifyAmbiguousIdentifiers(pkgs []*packages.AvailablePackageSummary) []*packages.AvailablePackageSummary {
+	repositories := map[string]map[string]bool{}
+	for _, pkg := range pkgs {
+		identifier := pkg.GetAvailablePackageRef().GetIdentifier()
+		if repositories[identifier] == nil {
+			repositories[identifier] = map[string]bool{}
+		}
+		repositories[identifier][repositoryQualifier(pkg.GetRepository())] = true
+	}
+
+	qualifiedPkgs := make([]*packages.AvailablePackageSummary, len(pkgs))
+	for i, pkg := range pkgs {
+		qualifiedPkgs[i] = pkg
+		identifier := pkg.GetAvailablePackageRef().GetIdentifier()
+		if len(repositories[identifier]) < 2 || pkg.GetRepository().GetName() == "" {
+			continue
+		}
+		qualifiedPkgs[i] = proto.Clone(pkg).(*packages.AvailablePackageSummary)
+		qualifiedPkgs[i].AvailablePackageRef.Identifier = repositoryQualifier(pkg.GetRepository()) + repositoryQualifierSeparator + identifier
+	}
+	return qualifiedPkgs
+}
+
+// repositoryQualifier returns the qualifier of the identifiers of the
+// packages of a repository, "<namespace>/<name>" or just the name without
+// namespace.
+func repositoryQualifier(repository *packages.PackageRepositoryReference) string {
+	if repository.GetNamespace() == "" {
+		return repository.GetName()
+	}
+	return repository.GetNamespace() + "/" + repository.GetName()
+}
+
+// unqualifyAvailablePackageRef returns the reference to request from the
+// plugin for an available package reference with a repository-qualified
+// identifier, when they are enabled: a copy with the unqualified identifier,
+// the plugin only knowing this one, and the repository, together with the
+// qualified identifier. Otherwise the reference is returned as is, without
+// qualified identifier.
+func (s packagesServer) unqualifyAvailablePackageRef(pkgRef *packages.AvailablePackageReference) (*packages.AvailablePackageReference, string) {
+	if !s.qualifyAmbiguousIdentifiers {
+		return pkgRef, ""
+	}
+	repository, identifier, ok := parseQualifiedIdentifier(pkgRef.GetIdentifier())
+	if !ok {
+		return pkgRef, ""
+	}
+	unqualifiedRef := proto.Clone(pkgRef).(*packages.AvailablePackageReference)
+	unqualifiedRef.Identifier = identifier
+	unqualifiedRef.Repository = repository
+	return unqualifiedRef, pkgRef.GetIdentifier()
+}
+
+// parseQualifiedIdentifier returns the repository and the unqualified
+// identifier of a repository-qualified identifier, or false if the identifier
+// is not qualified.
+func parseQualifiedIdentifier(qualifiedIdentifier string) (*packages.PackageRepositoryReference, string, bool) {
+	parts := strings.SplitN(qualifiedIdentifier, repositoryQualifierSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, "", false
+	}
+	repository := &packages.PackageRepositoryReference{Name: parts[0]}
+	if namespaceAndName := strings.SplitN(parts[0], "/", 2); len(namespaceAndName) == 2 {
+		repository.Namespace, repository.Name = namespaceAndName[0], namespaceAndName[1]
+	}
+	return repository, parts[1], true
+}
+
+// descriptionEllipsis is appended to the descriptions which are truncated.
+const descriptionEllipsis = "…"
+
+// truncateAvailablePackageSummaries returns the summaries with the short
+// descriptions longer than maxLength characters truncated, the ellipsis
+// included. The summaries returned by the plugins are copied rather than
+// modified.
+func truncateAvailablePackageSummaries(pkgs []*packages.AvailablePackageSummary, maxLength int) []*packages.AvailablePackageSummary {
+	truncatedPkgs := make([]*packages.AvailablePackageSummary, len(pkgs))
+	for i, pkg := range pkgs {
+		truncatedPkgs[i] = pkg
+		description := []rune(pkg.GetShortDescription())
+		if len(description) <= maxLength {
+			continue
+		}
+		truncatedPkgs[i] = proto.Clone(pkg).(*packages.AvailablePackageSummary)
+		truncatedPkgs[i].ShortDescription = truncateDescription(description, maxLength)
+	}
+	return truncatedPkgs
+}
+
+// truncateDescription returns the first characters of the description
+// followed by an ellipsis, so that the result is at most maxLength characters
+// long. Trailing spaces are removed before the ellipsis.
+func truncateDescription(description []rune, maxLength int) string {
+	ellipsis := []rune(descriptionEllipsis)
+	if maxLength <= len(ellipsis) {
+		return string(description[:maxLength])
+	}
+	return strings.TrimRightFunc(string(description[:maxLength-len(ellipsis)]), unicode.IsSpace) + descriptionEllipsis
+}
+
+// localizeAvailablePackageDetail returns the detail with the descriptions
+// replaced by the ones in the best matching language, keeping the default
+// description for each one which is not localized.
+func localizeAvailablePackageDetail(pkgDetail *packages.AvailablePackageDetail, languages []string) *packages.AvailablePackageDetail {
+	description := matchLocalizedDescription(pkgDetail.GetLocalizedDescriptions(), languages)
+	if description == nil {
+		return pkgDetail
+	}
+	localizedPkgDetail := proto.Clone(pkgDetail).(*packages.AvailablePackageDetail)
+	if description.GetShortDescription() != "" {
+		localizedPkgDetail.ShortDescription = description.GetShortDescription()
+	}
+	if description.GetLongDescription() != "" {
+		localizedPkgDetail.LongDescription = description.GetLongDescription()
+	}
+	return localizedPkgDetail
+}
+
+// computeETag returns a (quoted) ETag computed as the hash of the
+// deterministically serialized message.
+func computeETag(message proto.Message) (string, error) {
+	serialized, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%q", fmt.Sprintf("%x", sha256.Sum256(serialized))), nil
+}
+
+// matchesETag returns whether the request metadata includes an If-None-Match
+// value matching the given ETag.
+func matchesETag(ctx context.Context, etag string) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, key := range ifNoneMatchMetadataKeys {
+		for _, value := range md.Get(key) {
+			for _, candidate := range strings.Split(value, ",") {
+				if strings.TrimSpace(candidate) == etag {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// debugTimingsRequested returns whether the request metadata asks for the
+// plugin timings to be returned in a trailer.
+func debugTimingsRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, value := range md.Get(debugTimingsMetadataKey) {
+		if requested, err := strconv.ParseBool(value); err == nil && requested {
+			return true
+		}
+	}
+	return false
+}
+
+// getPluginWithServer returns the *pkgsPluginWithServer from a given packagesServer
+// matching the plugin name
+func (s packagesServer) getPluginWithServer(plugin *v1alpha1.Plugin) *pkgsPluginWithServer {
+	for _, p := range s.plugins {
+		if plugin.GetName() == p.plugin.Name {
+			return p
+		}
+	}
+	return nil
+}
+
+// getReadPluginWithServer returns the *pkgsPluginWithServer for a read
+// request, matching the plugin name by default. When the version fallback is
+// configured, the requested version of the plugin is used if loaded or, if
+// not, the nearest loaded version of the plugin.
+func (s packagesServer) getReadPluginWithServer(plugin *v1alpha1.Plugin) *pkgsPluginWithServer {
+	if !s.pluginVersionFallback {
+		return s.getPluginWithServer(plugin)
+	}
+	for _, p := range s.plugins {
+		if plugin.GetName() == p.plugin.Name && plugin.GetVersion() == p.plugin.Version {
+			return p
+		}
+	}
+	pluginWithServer := nearestPluginVersion(plugin, s.plugins)
+	if pluginWithServer != nil {
+		log.Infof("The plugin %s %s is not loaded, using the version %s instead", plugin.GetName(), plugin.GetVersion(), pluginWithServer.plugin.Version)
+	}
+	return pluginWithServer
+}
+
+// nearestPluginVersion returns the plugin with the same name as the requested
+// one and the nearest version, in the Kubernetes versions order (eg. v1alpha1,
+// v1beta1, v1). The nearest newer version is preferred over an older one.
+func nearestPluginVersion(plugin *v1alpha1.Plugin, pluginsWithServer []*pkgsPluginWithServer) *pkgsPluginWithServer {
+	var newer, older *pkgsPluginWithServer
+	for _, p := range pluginsWithServer {
+		if p.plugin.Name != plugin.GetName() {
+			continue
+		}
+		switch cmp := version.CompareKubeAwareVersionStrings(p.plugin.Version, plugin.GetVersion()); {
+		case cmp > 0:
+			if newer == nil || version.CompareKubeAwareVersionStrings(p.plugin.Version, newer.plugin.Version) < 0 {
+				newer = p
+			}
+		case cmp < 0:
+			if older == nil || version.CompareKubeAwareVersionStrings(p.plugin.Version, older.plugin.Version) > 0 {
+				older = p
+			}
+		}
+	}
+	if newer != nil {
+		return newer
+	}
+	return older
+}
+
+// pageOffsetFromPageToken converts a page token to an integer offset
+// representing the page of results.
+// TODO(mnelson): When aggregating results from different plugins, we'll
+// need to update the actual query in GetPaginatedChartListWithFilters to
+// use a row offset rather than a page offset (as not all rows may be consumed
+// for a specific plugin when combining).
 func pageOffsetFromPageToken(pageToken string) (int, error) {
 	if pageToken == "" {
 		return 0, nil
@@ -365,3 +2707,27 @@ func pageOffsetFromPageToken(pageToken string) (int, error) {
 
 	return int(offset), nil
 }
+
+// pageBounds returns the bounds, within the given number of results, of the
+// page at the offset, together with the token of the next page. All the
+// results are returned without a next page token when the page size is not
+// positive. Otherwise a page beyond the end is empty, and a page size larger
+// than the remaining results returns all of them without a next page token.
+// A full page always has a next page token, even when it is the last one.
+func pageBounds(total, pageOffset int, pageSize int32) (start, end int, nextPageToken string) {
+	if pageSize <= 0 {
+		return 0, total, ""
+	}
+	start = pageOffset * int(pageSize)
+	if start > total {
+		start = total
+	}
+	end = start + int(pageSize)
+	if end > total {
+		end = total
+	}
+	if end-start == int(pageSize) {
+		nextPageToken = fmt.Sprintf("%d", pageOffset+1)
+	}
+	return start, end, nextPageToken
+}
@@ -13,16 +13,27 @@ limitations under the License.
 package server
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -31,26 +42,66 @@ const (
 
 var mockedPackagingPlugin1 = makeDefaultTestPackagingPlugin("mock1")
 var mockedPackagingPlugin2 = makeDefaultTestPackagingPlugin("mock2")
+var mockedNamespacedPackagingPlugin1 = makeNamespacedInstalledPackagesTestPackagingPlugin("mock1")
+var mockedNamespacedPackagingPlugin2 = makeNamespacedInstalledPackagesTestPackagingPlugin("mock2")
+var mockedConflictingPackagingPlugin = makeConflictingInstalledPackagesTestPackagingPlugin("conflicting-plugin")
+var mockedUnorderedVersionsPackagingPlugin = makeVersionsTestPackagingPlugin("versions-plugin", "1.0.0", "10.0.0", "not-semver", "9.0.0")
+var mockedPrereleaseVersionsPackagingPlugin = makeVersionsTestPackagingPlugin("prerelease-plugin", "2.0.0-beta.1", "1.1.0", "not-semver", "1.2.0-rc.1", "1.0.0")
+var mockedSignedPackagingPlugin = makeSignedTestPackagingPlugin("signed-plugin")
+var mockedLocalizedPackagingPlugin = makeLocalizedTestPackagingPlugin("localized-plugin")
+var mockedDeprecatedPackagingPlugin = makeDeprecatedTestPackagingPlugin("deprecated-plugin")
+var mockedModifiedTimesPackagingPlugin = makeModifiedTimesTestPackagingPlugin("modified-times-plugin")
 var mockedNotFoundPackagingPlugin = makeOnlyStatusTestPackagingPlugin("bad-plugin", codes.NotFound)
+var mockedUnimplementedPackagingPlugin = makeOnlyStatusTestPackagingPlugin("unimplemented-plugin", codes.Unimplemented)
 
 var ignoreUnexportedOpts = cmpopts.IgnoreUnexported(
 	corev1.AvailablePackageDetail{},
 	corev1.AvailablePackageReference{},
 	corev1.AvailablePackageSummary{},
+	corev1.CatalogStats{},
+	corev1.CategoryCatalogStats{},
+	corev1.CategoryCount{},
 	corev1.Context{},
+	corev1.FieldDrift{},
+	corev1.GetAvailablePackageChangelogResponse{},
+	corev1.GetAvailablePackageDefaultValuesResponse{},
+	corev1.GetAvailablePackageDependenciesResponse{},
 	corev1.GetAvailablePackageDetailResponse{},
+	corev1.GetAvailablePackageValuesDiffResponse{},
 	corev1.GetAvailablePackageSummariesResponse{},
 	corev1.GetAvailablePackageVersionsResponse{},
+	corev1.GetCatalogStatsResponse{},
 	corev1.GetInstalledPackageDetailResponse{},
+	corev1.GetInstalledPackageDriftResponse{},
 	corev1.GetInstalledPackageSummariesResponse{},
+	corev1.GetInstalledPackageUpdateAvailableResponse{},
+	corev1.GetInstalledPackageUpgradeDiffResponse{},
+	corev1.GetInstalledPackageValuesResponse{},
+	corev1.GetRecentActivityResponse{},
+	corev1.GetUserPermissionsResponse{},
+	corev1.GetCatalogIndexResponse{},
+	corev1.CatalogIndex{},
+	corev1.CatalogIndexEntry{},
 	corev1.CreateInstalledPackageResponse{},
+	corev1.ExportInstalledPackageResponse{},
 	corev1.UpdateInstalledPackageResponse{},
 	corev1.InstalledPackageDetail{},
 	corev1.InstalledPackageReference{},
 	corev1.InstalledPackageStatus{},
 	corev1.InstalledPackageSummary{},
+	corev1.LocalizedDescription{},
 	corev1.Maintainer{},
+	corev1.PackageActivity{},
 	corev1.PackageAppVersion{},
+	corev1.PackageChangelogEntry{},
+	corev1.PackageDependency{},
+	corev1.PackageProvenance{},
+	corev1.PackageRepositoryReference{},
+	corev1.PackageVersionGroup{},
+	corev1.PluginCatalogStats{},
+	corev1.RenderAvailablePackageResponse{},
+	corev1.RenderedResource{},
+	corev1.ResourceDrift{},
 	corev1.VersionReference{},
 	plugins.Plugin{},
 )
@@ -73,6 +124,16 @@ func makeDefaultTestPackagingPlugin(pluginName string) *pkgsPluginWithServer {
 		plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultPkgUpdateVersion),
 		plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultPkgVersion),
 	}
+	packagingPluginServer.PackageDependencies = []*corev1.PackageDependency{
+		plugin_test.MakePackageDependency("dep-1", "1.x.x", plugin_test.DefaultRepoURL, "dep-1.enabled",
+			plugin_test.MakePackageDependency("dep-1-1", "~2.0.0", plugin_test.DefaultRepoURL, ""),
+		),
+		plugin_test.MakePackageDependency("dep-2", "3.0.0", plugin_test.DefaultRepoURL, ""),
+	}
+	packagingPluginServer.DefaultValues = map[string]string{
+		plugin_test.DefaultPkgUpdateVersion: "replicaCount: 2\n",
+		plugin_test.DefaultPkgVersion:       "replicaCount: 1\n",
+	}
 	packagingPluginServer.NextPageToken = "1"
 	packagingPluginServer.Categories = []string{plugin_test.DefaultCategory}
 
@@ -82,6 +143,188 @@ func makeDefaultTestPackagingPlugin(pluginName string) *pkgsPluginWithServer {
 	}
 }
 
+// makeNamespacedInstalledPackagesTestPackagingPlugin returns a test plugin
+// reporting the same installed package names in different namespaces.
+func makeNamespacedInstalledPackagesTestPackagingPlugin(pluginName string) *pkgsPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+
+	packagingPluginServer.InstalledPackageSummaries = []*corev1.InstalledPackageSummary{
+		makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", pluginDetails),
+		makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", pluginDetails),
+		makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", pluginDetails),
+	}
+
+	return &pkgsPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
+// makeConflictingInstalledPackagesTestPackagingPlugin returns a test plugin
+// reporting an installed package also reported by the default test plugins.
+func makeConflictingInstalledPackagesTestPackagingPlugin(pluginName string) *pkgsPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+
+	packagingPluginServer.InstalledPackageSummaries = []*corev1.InstalledPackageSummary{
+		plugin_test.MakeInstalledPackageSummary("pkg-3", pluginDetails),
+		plugin_test.MakeInstalledPackageSummary("pkg-1", pluginDetails),
+	}
+
+	return &pkgsPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
+func makeInstalledPackageSummaryWithConflicts(pkg *corev1.InstalledPackageSummary, conflictingPlugins ...*plugins.Plugin) *corev1.InstalledPackageSummary {
+	pkg.ConflictingPlugins = conflictingPlugins
+	return pkg
+}
+
+func makeInstalledPackageSummaryInNamespace(name, namespace string, plugin *plugins.Plugin) *corev1.InstalledPackageSummary {
+	pkg := plugin_test.MakeInstalledPackageSummary(name, plugin)
+	pkg.InstalledPackageRef.Context.Namespace = namespace
+	return pkg
+}
+
+// makeVersionsTestPackagingPlugin returns a test plugin with an available
+// package with the given versions, in the given order.
+func makeVersionsTestPackagingPlugin(pluginName string, pkgVersions ...string) *pkgsPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+
+	packagingPluginServer.AvailablePackageDetail = plugin_test.MakeAvailablePackageDetail("pkg-1", pluginDetails)
+	for _, pkgVersion := range pkgVersions {
+		packagingPluginServer.PackageAppVersions = append(packagingPluginServer.PackageAppVersions, plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, pkgVersion))
+	}
+
+	return &pkgsPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
+// versionedDetailPackagingPluginServer is a test plugin returning its
+// available package detail for the requested version, if any.
+type versionedDetailPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+}
+
+func (s versionedDetailPackagingPluginServer) GetAvailablePackageDetail(ctx context.Context, request *corev1.GetAvailablePackageDetailRequest) (*corev1.GetAvailablePackageDetailResponse, error) {
+	response, err := s.TestPackagingPluginServer.GetAvailablePackageDetail(ctx, request)
+	if err != nil || request.GetPkgVersion() == "" || response.GetAvailablePackageDetail() == nil {
+		return response, err
+	}
+	availablePackageDetail := proto.Clone(response.GetAvailablePackageDetail()).(*corev1.AvailablePackageDetail)
+	availablePackageDetail.Version = &corev1.PackageAppVersion{
+		PkgVersion: request.GetPkgVersion(),
+		AppVersion: availablePackageDetail.GetVersion().GetAppVersion(),
+	}
+	return &corev1.GetAvailablePackageDetailResponse{
+		AvailablePackageDetail: availablePackageDetail,
+	}, nil
+}
+
+// withVersionedDetail returns the given test plugin answering its available
+// package detail for the requested version.
+func withVersionedDetail(pluginWithServer *pkgsPluginWithServer) *pkgsPluginWithServer {
+	return &pkgsPluginWithServer{
+		plugin: pluginWithServer.plugin,
+		server: versionedDetailPackagingPluginServer{
+			TestPackagingPluginServer: *pluginWithServer.server.(*plugin_test.TestPackagingPluginServer),
+		},
+	}
+}
+
+func makeAvailablePackageDetailWithPkgVersion(name, pkgVersion string, plugin *plugins.Plugin) *corev1.AvailablePackageDetail {
+	pkg := plugin_test.MakeAvailablePackageDetail(name, plugin)
+	pkg.Version.PkgVersion = pkgVersion
+	return pkg
+}
+
+// makeSignedTestPackagingPlugin returns a test plugin reporting provenance
+// information for its available package detail.
+func makeSignedTestPackagingPlugin(pluginName string) *pkgsPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+
+	packagingPluginServer.AvailablePackageDetail = plugin_test.MakeSignedAvailablePackageDetail("pkg-1", pluginDetails)
+
+	return &pkgsPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
+// makeLocalizedTestPackagingPlugin returns a test plugin reporting French
+// descriptions for its available package summary and detail.
+func makeLocalizedTestPackagingPlugin(pluginName string) *pkgsPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+
+	localizedDescriptions := []*corev1.LocalizedDescription{
+		{
+			Language:         "fr",
+			ShortDescription: "description courte",
+			LongDescription:  "description longue",
+		},
+	}
+	summary := plugin_test.MakeAvailablePackageSummary("pkg-1", pluginDetails)
+	summary.LocalizedDescriptions = localizedDescriptions
+	packagingPluginServer.AvailablePackageSummaries = []*corev1.AvailablePackageSummary{summary}
+	packagingPluginServer.AvailablePackageDetail = plugin_test.MakeAvailablePackageDetail("pkg-1", pluginDetails)
+	packagingPluginServer.AvailablePackageDetail.LongDescription = "long description"
+	packagingPluginServer.AvailablePackageDetail.LocalizedDescriptions = localizedDescriptions
+
+	return &pkgsPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
+// makeDeprecatedTestPackagingPlugin returns a test plugin reporting two
+// available packages, the first of which is deprecated as a whole.
+func makeDeprecatedTestPackagingPlugin(pluginName string) *pkgsPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+
+	deprecatedSummary := plugin_test.MakeAvailablePackageSummary("pkg-1", pluginDetails)
+	deprecatedSummary.Deprecated = true
+	deprecatedSummary.DeprecationMessage = "pkg-1 is no longer maintained, use pkg-2 instead"
+	packagingPluginServer.AvailablePackageSummaries = []*corev1.AvailablePackageSummary{
+		deprecatedSummary,
+		plugin_test.MakeAvailablePackageSummary("pkg-2", pluginDetails),
+	}
+
+	return &pkgsPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
+func makeModifiedTimesTestPackagingPlugin(pluginName string) *pkgsPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+
+	packagingPluginServer.AvailablePackageSummaries = []*corev1.AvailablePackageSummary{}
+	for _, name := range []string{"pkg-1", "pkg-2"} {
+		summary := plugin_test.MakeAvailablePackageSummary(name, pluginDetails)
+		summary.AvailablePackageRef.Identifier = name
+		packagingPluginServer.AvailablePackageSummaries = append(packagingPluginServer.AvailablePackageSummaries, summary)
+	}
+	packagingPluginServer.ModifiedTimes = map[string]time.Time{
+		"pkg-1": time.Date(2021, time.September, 1, 10, 0, 0, 0, time.UTC),
+		"pkg-2": time.Date(2021, time.September, 10, 10, 0, 0, 0, time.UTC),
+	}
+
+	return &pkgsPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
 func makeOnlyStatusTestPackagingPlugin(pluginName string, statusCode codes.Code) *pkgsPluginWithServer {
 	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
 	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
@@ -242,6 +485,55 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 			},
 			statusCode: codes.OK,
 		},
+		{
+			name: "it should return all the packages without a next page token when the PageSize is larger than the total",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				PaginationOptions: &corev1.PaginationOptions{PageToken: "0", PageSize: 5},
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+					plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				},
+				Categories:    []string{"cat-1"},
+				NextPageToken: "",
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return the remaining packages without a next page token when the PageSize is larger than them",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				PaginationOptions: &corev1.PaginationOptions{PageToken: "1", PageSize: 3},
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				},
+				Categories:    []string{"cat-1"},
+				NextPageToken: "",
+			},
+			statusCode: codes.OK,
+		},
 		{
 			name: "it should fail when calling the core GetAvailablePackageSummaries operation when the package is not present in a plugin",
 			configuredPlugins: []*pkgsPluginWithServer{
@@ -283,144 +575,4568 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 	}
 }
 
-func TestGetAvailablePackageDetail(t *testing.T) {
+func TestGetAvailablePackageSummariesPluginNames(t *testing.T) {
 	testCases := []struct {
-		name              string
-		configuredPlugins []*pkgsPluginWithServer
-		statusCode        codes.Code
-		request           *corev1.GetAvailablePackageDetailRequest
-		expectedResponse  *corev1.GetAvailablePackageDetailResponse
+		name             string
+		pluginNames      []string
+		statusCode       codes.Code
+		expectedPackages []*corev1.AvailablePackageSummary
 	}{
 		{
-			name: "it should successfully call the core GetAvailablePackageDetail operation",
-			configuredPlugins: []*pkgsPluginWithServer{
-				mockedPackagingPlugin1,
-				mockedPackagingPlugin2,
-			},
-			request: &corev1.GetAvailablePackageDetailRequest{
-				AvailablePackageRef: &corev1.AvailablePackageReference{
-					Context: &corev1.Context{
-						Cluster:   "",
-						Namespace: globalPackagingNamespace,
-					},
-					Identifier: "pkg-1",
-					Plugin:     mockedPackagingPlugin1.plugin,
-				},
-				PkgVersion: "",
-			},
-
-			expectedResponse: &corev1.GetAvailablePackageDetailResponse{
-				AvailablePackageDetail: plugin_test.MakeAvailablePackageDetail("pkg-1", mockedPackagingPlugin1.plugin),
+			name:        "it only queries the requested plugin",
+			pluginNames: []string{"mock2"},
+			statusCode:  codes.OK,
+			expectedPackages: []*corev1.AvailablePackageSummary{
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
 			},
-			statusCode: codes.OK,
 		},
 		{
-			name: "it should fail when calling the core GetAvailablePackageDetail operation when the package is not present in a plugin",
-			configuredPlugins: []*pkgsPluginWithServer{
-				mockedPackagingPlugin1,
-				mockedNotFoundPackagingPlugin,
-			},
-			request: &corev1.GetAvailablePackageDetailRequest{
-				AvailablePackageRef: &corev1.AvailablePackageReference{
-					Context: &corev1.Context{
-						Cluster:   "",
-						Namespace: globalPackagingNamespace,
-					},
-					Identifier: "pkg-1",
-					Plugin:     mockedNotFoundPackagingPlugin.plugin,
-				},
-				PkgVersion: "",
+			name:        "it queries all the requested plugins",
+			pluginNames: []string{"mock1", "mock2"},
+			statusCode:  codes.OK,
+			expectedPackages: []*corev1.AvailablePackageSummary{
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
 			},
-
-			expectedResponse: &corev1.GetAvailablePackageDetailResponse{},
-			statusCode:       codes.NotFound,
+		},
+		{
+			name:        "it rejects the name of a plugin which is not configured",
+			pluginNames: []string{"mock2", "other-plugin"},
+			statusCode:  codes.InvalidArgument,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			// The failing plugin is never queried with a plugin filter.
 			server := &packagesServer{
-				plugins: tc.configuredPlugins,
+				plugins: []*pkgsPluginWithServer{mockedPackagingPlugin1, mockedPackagingPlugin2, mockedNotFoundPackagingPlugin},
 			}
-			availablePackageDetail, err := server.GetAvailablePackageDetail(context.Background(), tc.request)
+
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				PluginNames: tc.pluginNames,
+			})
 
 			if got, want := status.Code(err), tc.statusCode; got != want {
 				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
 			}
+			if tc.statusCode != codes.OK {
+				return
+			}
 
-			if tc.statusCode == codes.OK {
-				if got, want := availablePackageDetail, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
-					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
-				}
+			if got, want := response.GetAvailablePackageSummaries(), tc.expectedPackages; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
 			}
 		})
 	}
 }
 
-func TestGetInstalledPackageSummaries(t *testing.T) {
+func TestGetAvailablePackageSummariesPluginOrder(t *testing.T) {
 	testCases := []struct {
-		name              string
-		configuredPlugins []*pkgsPluginWithServer
-		statusCode        codes.Code
-		request           *corev1.GetInstalledPackageSummariesRequest
-		expectedResponse  *corev1.GetInstalledPackageSummariesResponse
+		name             string
+		pluginOrder      []string
+		pageSize         int32
+		pageToken        string
+		expectedPackages []*corev1.AvailablePackageSummary
 	}{
 		{
-			name: "it should successfully call the core GetInstalledPackageSummaries operation",
-			configuredPlugins: []*pkgsPluginWithServer{
-				mockedPackagingPlugin1,
-				mockedPackagingPlugin2,
-			},
-			request: &corev1.GetInstalledPackageSummariesRequest{
-				Context: &corev1.Context{
-					Cluster:   "",
-					Namespace: globalPackagingNamespace,
-				},
-			},
-
-			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
-				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
-					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
-					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
-					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
-					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
-				},
+			name:        "it orders the packages with the same identifier by plugin name without a plugin order",
+			pluginOrder: nil,
+			expectedPackages: []*corev1.AvailablePackageSummary{
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
 			},
-			statusCode: codes.OK,
 		},
 		{
-			name: "it should fail when calling the core GetInstalledPackageSummaries operation when the package is not present in a plugin",
-			configuredPlugins: []*pkgsPluginWithServer{
-				mockedPackagingPlugin1,
-				mockedNotFoundPackagingPlugin,
+			name:        "it orders the packages with the same identifier by the plugin order",
+			pluginOrder: []string{"mock2", "mock1"},
+			expectedPackages: []*corev1.AvailablePackageSummary{
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
 			},
-			request: &corev1.GetInstalledPackageSummariesRequest{
-				Context: &corev1.Context{
-					Cluster:   "",
-					Namespace: globalPackagingNamespace,
-				},
+		},
+		{
+			name:        "it orders the plugins missing from the plugin order after the listed ones",
+			pluginOrder: []string{"mock2", "other-plugin"},
+			expectedPackages: []*corev1.AvailablePackageSummary{
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
 			},
-
-			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
-				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{},
+		},
+		{
+			name:        "it paginates the packages in the plugin order",
+			pluginOrder: []string{"mock2"},
+			pageSize:    1,
+			pageToken:   "1",
+			expectedPackages: []*corev1.AvailablePackageSummary{
+				plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
 			},
-			statusCode: codes.NotFound,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			// The plugins are registered in the reverse order of their names
+			// so that the order does not depend on the registration.
 			server := &packagesServer{
-				plugins: tc.configuredPlugins,
-			}
-			installedPackageSummaries, err := server.GetInstalledPackageSummaries(context.Background(), tc.request)
-
-			if got, want := status.Code(err), tc.statusCode; got != want {
-				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+				plugins:     []*pkgsPluginWithServer{mockedPackagingPlugin2, mockedPackagingPlugin1},
+				pluginOrder: tc.pluginOrder,
 			}
-
+			request := &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			}
+			if tc.pageSize > 0 {
+				request.PaginationOptions = &corev1.PaginationOptions{PageToken: tc.pageToken, PageSize: tc.pageSize}
+			}
+
+			response, err := server.GetAvailablePackageSummaries(context.Background(), request)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := response.GetAvailablePackageSummaries(), tc.expectedPackages; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+		})
+	}
+}
+
+// namespacedInstalledPackagingPluginServer is a test plugin only returning
+// the installed packages in the namespace of the request, if any.
+type namespacedInstalledPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+}
+
+func (s namespacedInstalledPackagingPluginServer) GetInstalledPackageSummaries(ctx context.Context, request *corev1.GetInstalledPackageSummariesRequest) (*corev1.GetInstalledPackageSummariesResponse, error) {
+	installedPkgs := []*corev1.InstalledPackageSummary{}
+	for _, pkg := range s.InstalledPackageSummaries {
+		namespace := request.GetContext().GetNamespace()
+		if namespace == "" || pkg.GetInstalledPackageRef().GetContext().GetNamespace() == namespace {
+			installedPkgs = append(installedPkgs, pkg)
+		}
+	}
+	return &corev1.GetInstalledPackageSummariesResponse{
+		InstalledPackageSummaries: installedPkgs,
+	}, nil
+}
+
+func TestGetAvailablePackageSummariesInstalledCount(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		namespace              string
+		includeInstalledCount  bool
+		expectedInstalledCount map[string]int32
+	}{
+		{
+			name:                   "it should not count the installed packages by default",
+			includeInstalledCount:  false,
+			expectedInstalledCount: map[string]int32{"pkg-1": 0, "pkg-2": 0, "pkg-3": 0},
+		},
+		{
+			name:                   "it should count the installed packages matching each available package when requested",
+			includeInstalledCount:  true,
+			expectedInstalledCount: map[string]int32{"pkg-1": 2, "pkg-2": 1, "pkg-3": 0},
+		},
+		{
+			name:                   "it should only count the installed packages in the requested namespace",
+			namespace:              "ns-1",
+			includeInstalledCount:  true,
+			expectedInstalledCount: map[string]int32{"pkg-1": 1, "pkg-2": 1, "pkg-3": 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pluginDetails := &plugins.Plugin{Name: "mock1", Version: "v1alpha1"}
+			makeAvailable := func(name string) *corev1.AvailablePackageSummary {
+				pkg := plugin_test.MakeAvailablePackageSummary(name, pluginDetails)
+				pkg.AvailablePackageRef.Identifier = "repo-1/" + name
+				return pkg
+			}
+			makeInstalled := func(name, namespace, identifier string) *corev1.InstalledPackageSummary {
+				pkg := makeInstalledPackageSummaryInNamespace(name, namespace, pluginDetails)
+				if identifier != "" {
+					pkg.AvailablePackageRef = &corev1.AvailablePackageReference{
+						Context:    &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+						Identifier: identifier,
+						Plugin:     pluginDetails,
+					}
+				}
+				return pkg
+			}
+			packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+			packagingPluginServer.AvailablePackageSummaries = []*corev1.AvailablePackageSummary{
+				makeAvailable("pkg-1"),
+				makeAvailable("pkg-2"),
+				makeAvailable("pkg-3"),
+			}
+			packagingPluginServer.InstalledPackageSummaries = []*corev1.InstalledPackageSummary{
+				makeInstalled("pkg-1", "ns-1", "repo-1/pkg-1"),
+				makeInstalled("my-release", "ns-2", "repo-1/pkg-1"),
+				makeInstalled("pkg-2", "ns-1", "repo-1/pkg-2"),
+				// same display name as pkg-3, but installed from another repository
+				makeInstalled("pkg-3", "ns-1", "repo-2/pkg-3"),
+				// no reference to the available package it was installed from
+				makeInstalled("pkg-2", "ns-2", ""),
+			}
+
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: pluginDetails,
+						server: namespacedInstalledPackagingPluginServer{
+							TestPackagingPluginServer: *packagingPluginServer,
+						},
+					},
+				},
+			}
+
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: tc.namespace,
+				},
+				IncludeInstalledCount: tc.includeInstalledCount,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			installedCounts := map[string]int32{}
+			for _, pkg := range response.GetAvailablePackageSummaries() {
+				installedCounts[pkg.GetName()] = pkg.GetInstalledCount()
+			}
+			if got, want := installedCounts, tc.expectedInstalledCount; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageSummariesCategoryCounts(t *testing.T) {
+	makeSummary := func(name string, plugin *plugins.Plugin, categories ...string) *corev1.AvailablePackageSummary {
+		summary := plugin_test.MakeAvailablePackageSummary(name, plugin)
+		summary.Categories = categories
+		return summary
+	}
+	databasePlugin := &plugins.Plugin{Name: "database-plugin", Version: "v1alpha1"}
+	analyticsPlugin := &plugins.Plugin{Name: "analytics-plugin", Version: "v1alpha1"}
+	categoriesPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: databasePlugin,
+			server: &plugin_test.TestPackagingPluginServer{
+				Plugin: databasePlugin,
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					makeSummary("pkg-1", databasePlugin, "Database"),
+					makeSummary("pkg-2", databasePlugin, "Database", "Analytics", "Database"),
+				},
+				Categories: []string{"Database", "Analytics"},
+			},
+		},
+		{
+			plugin: analyticsPlugin,
+			server: &plugin_test.TestPackagingPluginServer{
+				Plugin: analyticsPlugin,
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					makeSummary("pkg-3", analyticsPlugin, "Analytics"),
+					makeSummary("pkg-4", analyticsPlugin, ""),
+				},
+				Categories: []string{"Analytics"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                   string
+		configuredPlugins      []*pkgsPluginWithServer
+		includeCategoryCounts  bool
+		paginationOptions      *corev1.PaginationOptions
+		expectedCategoryCounts []*corev1.CategoryCount
+		expectedPackages       int
+	}{
+		{
+			name:                   "it should not count the categories by default",
+			configuredPlugins:      []*pkgsPluginWithServer{mockedPackagingPlugin1, mockedPackagingPlugin2},
+			includeCategoryCounts:  false,
+			expectedCategoryCounts: nil,
+			expectedPackages:       4,
+		},
+		{
+			name:                  "it should count the packages of both plugins in each category when requested",
+			configuredPlugins:     []*pkgsPluginWithServer{mockedPackagingPlugin1, mockedPackagingPlugin2},
+			includeCategoryCounts: true,
+			expectedCategoryCounts: []*corev1.CategoryCount{
+				{Name: "cat-1", Count: 4},
+			},
+			expectedPackages: 4,
+		},
+		{
+			name:                  "it should count the packages of all the pages when paginating",
+			configuredPlugins:     []*pkgsPluginWithServer{mockedPackagingPlugin1, mockedPackagingPlugin2},
+			includeCategoryCounts: true,
+			paginationOptions:     &corev1.PaginationOptions{PageToken: "0", PageSize: 1},
+			expectedCategoryCounts: []*corev1.CategoryCount{
+				{Name: "cat-1", Count: 4},
+			},
+			expectedPackages: 1,
+		},
+		{
+			name:                  "it should count each package once per category, ignoring the blank ones",
+			configuredPlugins:     categoriesPlugins,
+			includeCategoryCounts: true,
+			expectedCategoryCounts: []*corev1.CategoryCount{
+				{Name: "Analytics", Count: 2},
+				{Name: "Database", Count: 2},
+			},
+			expectedPackages: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: tc.configuredPlugins,
+			}
+
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				PaginationOptions:     tc.paginationOptions,
+				IncludeCategoryCounts: tc.includeCategoryCounts,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := response.GetCategoryCounts(), tc.expectedCategoryCounts; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+			if got, want := len(response.GetAvailablePackageSummaries()), tc.expectedPackages; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageSummariesIsDeterministic(t *testing.T) {
+	plugin := &plugins.Plugin{Name: "categories-plugin", Version: "v1alpha1"}
+	summaries := []*corev1.AvailablePackageSummary{}
+	for i, categories := range [][]string{{"Storage", "Database"}, {"Analytics"}, {"Networking", "Security", "Analytics"}, {"Monitoring", "Logging"}, {"Database", "CI/CD"}} {
+		summary := plugin_test.MakeAvailablePackageSummary(fmt.Sprintf("pkg-%d", i), plugin)
+		summary.Categories = categories
+		summaries = append(summaries, summary)
+	}
+	server := &packagesServer{
+		plugins: []*pkgsPluginWithServer{
+			{
+				plugin: plugin,
+				server: &plugin_test.TestPackagingPluginServer{
+					Plugin:                    plugin,
+					AvailablePackageSummaries: summaries,
+					Categories:                []string{"Storage", "Database", "Analytics", "Networking", "Security", "Monitoring", "Logging", "CI/CD"},
+				},
+			},
+		},
+	}
+	request := &corev1.GetAvailablePackageSummariesRequest{
+		Context:               &corev1.Context{Namespace: globalPackagingNamespace},
+		IncludeCategoryCounts: true,
+	}
+
+	// The category counts are computed from a map, so repeat the request
+	// enough times for a map-ordered result to differ.
+	var expected []byte
+	for i := 0; i < 20; i++ {
+		response, err := server.GetAvailablePackageSummaries(context.Background(), request)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		serialized, err := proto.Marshal(response)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if expected == nil {
+			expected = serialized
+		} else if !bytes.Equal(serialized, expected) {
+			t.Fatalf("response %d differs from the first one:\n%s", i, response)
+		}
+	}
+}
+
+func TestGetAvailablePackageSummariesRanking(t *testing.T) {
+	summary := func(name, description string, plugin *plugins.Plugin) *corev1.AvailablePackageSummary {
+		pkg := plugin_test.MakeAvailablePackageSummary(name, plugin)
+		pkg.AvailablePackageRef.Identifier = name
+		if description != "" {
+			pkg.ShortDescription = description
+		}
+		return pkg
+	}
+	pluginA := &plugins.Plugin{Name: "plugin-a", Version: "v1alpha1"}
+	pluginB := &plugins.Plugin{Name: "plugin-b", Version: "v1alpha1"}
+	server := &packagesServer{
+		plugins: []*pkgsPluginWithServer{
+			{
+				plugin: pluginA,
+				server: &plugin_test.TestPackagingPluginServer{
+					Plugin: pluginA,
+					AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+						summary("cache", "A cache compatible with Redis", pluginA),
+						summary("redis-cluster", "", pluginA),
+						// Matched by the plugin on a field unknown to the core.
+						summary("zookeeper", "", pluginA),
+					},
+				},
+			},
+			{
+				plugin: pluginB,
+				server: &plugin_test.TestPackagingPluginServer{
+					Plugin: pluginB,
+					AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+						summary("apache", "", pluginB),
+						summary("bitnami-redis", "", pluginB),
+						summary("redis", "", pluginB),
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		query             string
+		paginationOptions *corev1.PaginationOptions
+		expectedNames     []string
+	}{
+		{
+			name:          "it orders the packages by name without a query",
+			expectedNames: []string{"apache", "bitnami-redis", "cache", "redis", "redis-cluster", "zookeeper"},
+		},
+		{
+			name:          "it ranks the exact name, then the name prefix, name and description matches, ignoring the case",
+			query:         "Redis",
+			expectedNames: []string{"redis", "redis-cluster", "bitnami-redis", "cache", "apache", "zookeeper"},
+		},
+		{
+			name:              "it ranks the packages of all the plugins before paginating them",
+			query:             "redis",
+			paginationOptions: &corev1.PaginationOptions{PageSize: 2},
+			expectedNames:     []string{"redis", "redis-cluster"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context:           &corev1.Context{Namespace: globalPackagingNamespace},
+				FilterOptions:     &corev1.FilterOptions{Query: tc.query},
+				PaginationOptions: tc.paginationOptions,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			names := []string{}
+			for _, pkg := range response.GetAvailablePackageSummaries() {
+				names = append(names, pkg.GetName())
+			}
+			if got, want := names, tc.expectedNames; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageSummariesRepository(t *testing.T) {
+	repositories := map[string]*corev1.PackageRepositoryReference{
+		"mock1": plugin_test.MakePackageRepositoryReference("repo-a", "ns-1"),
+		"mock2": plugin_test.MakePackageRepositoryReference("repo-b", globalPackagingNamespace),
+	}
+
+	configuredPlugins := []*pkgsPluginWithServer{}
+	for _, pluginName := range []string{"mock1", "mock2"} {
+		pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+		summary := plugin_test.MakeAvailablePackageSummary("pkg-1", pluginDetails)
+		summary.Repository = repositories[pluginName]
+		packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+		packagingPluginServer.AvailablePackageSummaries = []*corev1.AvailablePackageSummary{summary}
+		configuredPlugins = append(configuredPlugins, &pkgsPluginWithServer{
+			plugin: pluginDetails,
+			server: packagingPluginServer,
+		})
+	}
+
+	server := &packagesServer{
+		plugins: configuredPlugins,
+	}
+
+	response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+		Context: &corev1.Context{
+			Cluster:   "",
+			Namespace: globalPackagingNamespace,
+		},
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	gotRepositories := map[string]*corev1.PackageRepositoryReference{}
+	for _, pkg := range response.GetAvailablePackageSummaries() {
+		gotRepositories[pkg.GetAvailablePackageRef().GetPlugin().GetName()] = pkg.GetRepository()
+	}
+	if got, want := gotRepositories, repositories; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+	}
+}
+
+// repositoriesPackagingPluginServer is a test plugin in which several
+// repositories publish a package with the same identifier, the package
+// detail being returned for the requested repository, if any.
+type repositoriesPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	// details maps the identifiers, followed by "@<repository name>" when
+	// requested for a repository, to the package details.
+	details map[string]*corev1.AvailablePackageDetail
+
+	// requestedRefs records the available package references of the
+	// requests other than GetAvailablePackageDetail, if set.
+	requestedRefs *[]*corev1.AvailablePackageReference
+}
+
+func (s repositoriesPackagingPluginServer) recordRequestedRef(pkgRef *corev1.AvailablePackageReference) {
+	if s.requestedRefs != nil {
+		*s.requestedRefs = append(*s.requestedRefs, pkgRef)
+	}
+}
+
+func (s repositoriesPackagingPluginServer) GetAvailablePackageVersions(ctx context.Context, request *corev1.GetAvailablePackageVersionsRequest) (*corev1.GetAvailablePackageVersionsResponse, error) {
+	s.recordRequestedRef(request.GetAvailablePackageRef())
+	return &corev1.GetAvailablePackageVersionsResponse{
+		PackageAppVersions: []*corev1.PackageAppVersion{},
+	}, nil
+}
+
+func (s repositoriesPackagingPluginServer) GetAvailablePackageDependencies(ctx context.Context, request *corev1.GetAvailablePackageDependenciesRequest) (*corev1.GetAvailablePackageDependenciesResponse, error) {
+	s.recordRequestedRef(request.GetAvailablePackageRef())
+	return &corev1.GetAvailablePackageDependenciesResponse{
+		PackageDependencies: []*corev1.PackageDependency{},
+	}, nil
+}
+
+func (s repositoriesPackagingPluginServer) GetAvailablePackageDefaultValues(ctx context.Context, request *corev1.GetAvailablePackageDefaultValuesRequest) (*corev1.GetAvailablePackageDefaultValuesResponse, error) {
+	s.recordRequestedRef(request.GetAvailablePackageRef())
+	return &corev1.GetAvailablePackageDefaultValuesResponse{}, nil
+}
+
+func (s repositoriesPackagingPluginServer) GetAvailablePackageChangelog(ctx context.Context, request *corev1.GetAvailablePackageChangelogRequest) (*corev1.GetAvailablePackageChangelogResponse, error) {
+	s.recordRequestedRef(request.GetAvailablePackageRef())
+	return &corev1.GetAvailablePackageChangelogResponse{}, nil
+}
+
+func (s repositoriesPackagingPluginServer) GetAvailablePackageValuesDiff(ctx context.Context, request *corev1.GetAvailablePackageValuesDiffRequest) (*corev1.GetAvailablePackageValuesDiffResponse, error) {
+	s.recordRequestedRef(request.GetAvailablePackageRef())
+	return &corev1.GetAvailablePackageValuesDiffResponse{}, nil
+}
+
+func (s repositoriesPackagingPluginServer) RenderAvailablePackage(ctx context.Context, request *corev1.RenderAvailablePackageRequest) (*corev1.RenderAvailablePackageResponse, error) {
+	s.recordRequestedRef(request.GetAvailablePackageRef())
+	return &corev1.RenderAvailablePackageResponse{}, nil
+}
+
+func (s repositoriesPackagingPluginServer) CreateInstalledPackage(ctx context.Context, request *corev1.CreateInstalledPackageRequest) (*corev1.CreateInstalledPackageResponse, error) {
+	s.recordRequestedRef(request.GetAvailablePackageRef())
+	return &corev1.CreateInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    request.GetTargetContext(),
+			Identifier: request.GetName(),
+			Plugin:     request.GetAvailablePackageRef().GetPlugin(),
+		},
+	}, nil
+}
+
+func (s repositoriesPackagingPluginServer) GetAvailablePackageDetail(ctx context.Context, request *corev1.GetAvailablePackageDetailRequest) (*corev1.GetAvailablePackageDetailResponse, error) {
+	key := request.GetAvailablePackageRef().GetIdentifier()
+	if request.GetAvailablePackageRef().GetRepository() != nil {
+		key = key + "@" + request.GetAvailablePackageRef().GetRepository().GetName()
+	}
+	detail, ok := s.details[key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Package %q not found", key)
+	}
+	return &corev1.GetAvailablePackageDetailResponse{
+		AvailablePackageDetail: detail,
+	}, nil
+}
+
+// makeRepositoriesTestPackagingPlugin returns a test plugin in which the
+// repositories "repo-a" and "repo-b" both publish "pkg-1", while "pkg-2" is
+// only published by "repo-a".
+func makeRepositoriesTestPackagingPlugin(pluginName string) *pkgsPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	summary := func(identifier, repoName string) *corev1.AvailablePackageSummary {
+		summary := plugin_test.MakeAvailablePackageSummary(identifier, pluginDetails)
+		summary.AvailablePackageRef.Identifier = identifier
+		summary.Repository = plugin_test.MakePackageRepositoryReference(repoName, "ns-1")
+		return summary
+	}
+	detail := func(identifier, displayName string) *corev1.AvailablePackageDetail {
+		detail := plugin_test.MakeAvailablePackageDetail(identifier, pluginDetails)
+		detail.AvailablePackageRef.Identifier = identifier
+		detail.DisplayName = displayName
+		return detail
+	}
+
+	packagingPluginServer := &repositoriesPackagingPluginServer{
+		TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: pluginDetails},
+		details: map[string]*corev1.AvailablePackageDetail{
+			"pkg-1@repo-a": detail("pkg-1", "pkg-1 from repo-a"),
+			"pkg-1@repo-b": detail("pkg-1", "pkg-1 from repo-b"),
+			"pkg-2":        detail("pkg-2", "pkg-2 from repo-a"),
+		},
+	}
+	packagingPluginServer.AvailablePackageSummaries = []*corev1.AvailablePackageSummary{
+		summary("pkg-1", "repo-a"),
+		summary("pkg-1", "repo-b"),
+		summary("pkg-2", "repo-a"),
+	}
+
+	return &pkgsPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
+func TestGetAvailablePackageSummariesQualifyAmbiguousIdentifiers(t *testing.T) {
+	testCases := []struct {
+		name                        string
+		qualifyAmbiguousIdentifiers bool
+		expectedIdentifiers         []string
+	}{
+		{
+			name:                "it returns the identifiers of the plugin by default",
+			expectedIdentifiers: []string{"pkg-1", "pkg-1", "pkg-2", plugin_test.DefaultId, plugin_test.DefaultId},
+		},
+		{
+			name:                        "it qualifies the identifiers published by several repositories of a plugin",
+			qualifyAmbiguousIdentifiers: true,
+			expectedIdentifiers:         []string{"ns-1/repo-a:pkg-1", "ns-1/repo-b:pkg-1", "pkg-2", plugin_test.DefaultId, plugin_test.DefaultId},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					makeRepositoriesTestPackagingPlugin("repositories-plugin"),
+					mockedPackagingPlugin1,
+				},
+				qualifyAmbiguousIdentifiers: tc.qualifyAmbiguousIdentifiers,
+			}
+
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			gotIdentifiers := []string{}
+			for _, pkg := range response.GetAvailablePackageSummaries() {
+				gotIdentifiers = append(gotIdentifiers, pkg.GetAvailablePackageRef().GetIdentifier())
+			}
+			opts := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+			if got, want := gotIdentifiers, tc.expectedIdentifiers; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageDetailQualifiedIdentifier(t *testing.T) {
+	testCases := []struct {
+		name                        string
+		qualifyAmbiguousIdentifiers bool
+		identifier                  string
+		statusCode                  codes.Code
+		expectedDisplayName         string
+	}{
+		{
+			name:                        "it returns the package of the repository of a qualified identifier",
+			qualifyAmbiguousIdentifiers: true,
+			identifier:                  "ns-1/repo-b:pkg-1",
+			statusCode:                  codes.OK,
+			expectedDisplayName:         "pkg-1 from repo-b",
+		},
+		{
+			name:                        "it returns the package of an unqualified identifier",
+			qualifyAmbiguousIdentifiers: true,
+			identifier:                  "pkg-2",
+			statusCode:                  codes.OK,
+			expectedDisplayName:         "pkg-2 from repo-a",
+		},
+		{
+			name:       "it does not interpret the qualified identifiers by default",
+			identifier: "ns-1/repo-b:pkg-1",
+			statusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repositoriesPlugin := makeRepositoriesTestPackagingPlugin("repositories-plugin")
+			server := &packagesServer{
+				plugins:                     []*pkgsPluginWithServer{repositoriesPlugin},
+				qualifyAmbiguousIdentifiers: tc.qualifyAmbiguousIdentifiers,
+			}
+
+			response, err := server.GetAvailablePackageDetail(context.Background(), &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Cluster: "", Namespace: "ns-1"},
+					Identifier: tc.identifier,
+					Plugin:     repositoriesPlugin.plugin,
+				},
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+
+			if got, want := response.GetAvailablePackageDetail().GetDisplayName(), tc.expectedDisplayName; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			// The requested identifier is returned, qualified or not.
+			if got, want := response.GetAvailablePackageDetail().GetAvailablePackageRef().GetIdentifier(), tc.identifier; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAvailablePackageRequestsQualifiedIdentifier(t *testing.T) {
+	testCases := []struct {
+		name                        string
+		qualifyAmbiguousIdentifiers bool
+		identifier                  string
+		expectedRef                 *corev1.AvailablePackageReference
+	}{
+		{
+			name:                        "it requests the unqualified identifier and the repository of a qualified identifier",
+			qualifyAmbiguousIdentifiers: true,
+			identifier:                  "ns-1/repo-b:pkg-1",
+			expectedRef: &corev1.AvailablePackageReference{
+				Identifier: "pkg-1",
+				Repository: &corev1.PackageRepositoryReference{Name: "repo-b", Namespace: "ns-1"},
+			},
+		},
+		{
+			name:                        "it requests an unqualified identifier as is",
+			qualifyAmbiguousIdentifiers: true,
+			identifier:                  "pkg-2",
+			expectedRef: &corev1.AvailablePackageReference{
+				Identifier: "pkg-2",
+			},
+		},
+		{
+			name:       "it does not interpret the qualified identifiers by default",
+			identifier: "ns-1/repo-b:pkg-1",
+			expectedRef: &corev1.AvailablePackageReference{
+				Identifier: "ns-1/repo-b:pkg-1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requestedRefs := []*corev1.AvailablePackageReference{}
+			repositoriesPlugin := makeRepositoriesTestPackagingPlugin("repositories-plugin")
+			repositoriesPlugin.server.(*repositoriesPackagingPluginServer).requestedRefs = &requestedRefs
+			server := &packagesServer{
+				plugins:                     []*pkgsPluginWithServer{repositoriesPlugin},
+				qualifyAmbiguousIdentifiers: tc.qualifyAmbiguousIdentifiers,
+			}
+			ctx := context.Background()
+			targetContext := &corev1.Context{Cluster: "", Namespace: "ns-1"}
+			pkgRef := func() *corev1.AvailablePackageReference {
+				return &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Cluster: "", Namespace: "ns-1"},
+					Identifier: tc.identifier,
+					Plugin:     repositoriesPlugin.plugin,
+				}
+			}
+
+			requests := map[string]func() error{
+				"GetAvailablePackageVersions": func() error {
+					_, err := server.GetAvailablePackageVersions(ctx, &corev1.GetAvailablePackageVersionsRequest{AvailablePackageRef: pkgRef()})
+					return err
+				},
+				"GetAvailablePackageDependencies": func() error {
+					_, err := server.GetAvailablePackageDependencies(ctx, &corev1.GetAvailablePackageDependenciesRequest{AvailablePackageRef: pkgRef()})
+					return err
+				},
+				"GetAvailablePackageDefaultValues": func() error {
+					_, err := server.GetAvailablePackageDefaultValues(ctx, &corev1.GetAvailablePackageDefaultValuesRequest{AvailablePackageRef: pkgRef()})
+					return err
+				},
+				"GetAvailablePackageChangelog": func() error {
+					_, err := server.GetAvailablePackageChangelog(ctx, &corev1.GetAvailablePackageChangelogRequest{AvailablePackageRef: pkgRef()})
+					return err
+				},
+				"GetAvailablePackageValuesDiff": func() error {
+					_, err := server.GetAvailablePackageValuesDiff(ctx, &corev1.GetAvailablePackageValuesDiffRequest{AvailablePackageRef: pkgRef(), FromPkgVersion: "1.0.0"})
+					return err
+				},
+				"RenderAvailablePackage": func() error {
+					_, err := server.RenderAvailablePackage(ctx, &corev1.RenderAvailablePackageRequest{AvailablePackageRef: pkgRef(), TargetContext: targetContext})
+					return err
+				},
+				"CreateInstalledPackage": func() error {
+					_, err := server.CreateInstalledPackage(ctx, &corev1.CreateInstalledPackageRequest{AvailablePackageRef: pkgRef(), TargetContext: targetContext, Name: "my-pkg"})
+					return err
+				},
+			}
+
+			for method, request := range requests {
+				requestedRefs = requestedRefs[:0]
+				if err := request(); err != nil {
+					t.Fatalf("%s: %+v", method, err)
+				}
+				if got, want := len(requestedRefs), 1; got != want {
+					t.Fatalf("%s: got: %d requests, want: %d", method, got, want)
+				}
+				opts := cmpopts.IgnoreFields(corev1.AvailablePackageReference{}, "Context", "Plugin")
+				if got, want := requestedRefs[0], tc.expectedRef; !cmp.Equal(want, got, opts, ignoreUnexportedOpts) {
+					t.Errorf("%s: mismatch (-want +got):\n%s", method, cmp.Diff(want, got, opts, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageSummariesLocalized(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		acceptLanguage           string
+		expectedShortDescription string
+	}{
+		{
+			name:                     "it returns the default description when no language is requested",
+			acceptLanguage:           "",
+			expectedShortDescription: plugin_test.DefaultDescription,
+		},
+		{
+			name:                     "it returns the description in the requested language",
+			acceptLanguage:           "fr",
+			expectedShortDescription: "description courte",
+		},
+		{
+			name:                     "it returns the description in the base language of the requested one",
+			acceptLanguage:           "fr-CA,en;q=0.8",
+			expectedShortDescription: "description courte",
+		},
+		{
+			name:                     "it returns the description in the preferred language by quality",
+			acceptLanguage:           "de;q=0.9,fr;q=0.5,*;q=0.1",
+			expectedShortDescription: "description courte",
+		},
+		{
+			name:                     "it falls back to the default description when the language is unavailable",
+			acceptLanguage:           "de-DE,de;q=0.9",
+			expectedShortDescription: plugin_test.DefaultDescription,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{mockedLocalizedPackagingPlugin},
+			}
+
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				AcceptLanguage: tc.acceptLanguage,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := len(response.GetAvailablePackageSummaries()), 1; got != want {
+				t.Fatalf("got: %d summaries, want: %d summaries", got, want)
+			}
+			if got, want := response.GetAvailablePackageSummaries()[0].GetShortDescription(), tc.expectedShortDescription; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageSummariesMaxDescriptionLength(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		acceptLanguage           string
+		maxDescriptionLength     int32
+		statusCode               codes.Code
+		expectedShortDescription string
+	}{
+		{
+			name:                     "it returns the description intact without a max length",
+			statusCode:               codes.OK,
+			expectedShortDescription: plugin_test.DefaultDescription,
+		},
+		{
+			name:                     "it returns the description intact when of the max length",
+			maxDescriptionLength:     int32(len(plugin_test.DefaultDescription)),
+			statusCode:               codes.OK,
+			expectedShortDescription: plugin_test.DefaultDescription,
+		},
+		{
+			name:                     "it truncates the description longer than the max length with an ellipsis",
+			maxDescriptionLength:     8,
+			statusCode:               codes.OK,
+			expectedShortDescription: "descrip…",
+		},
+		{
+			name:                     "it truncates the localized description, without trailing spaces",
+			acceptLanguage:           "fr",
+			maxDescriptionLength:     13,
+			statusCode:               codes.OK,
+			expectedShortDescription: "description…",
+		},
+		{
+			name:                     "it truncates the description without an ellipsis when the max length is too short",
+			maxDescriptionLength:     1,
+			statusCode:               codes.OK,
+			expectedShortDescription: "d",
+		},
+		{
+			name:                 "it rejects a negative max length",
+			maxDescriptionLength: -1,
+			statusCode:           codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{mockedLocalizedPackagingPlugin},
+			}
+
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				AcceptLanguage:       tc.acceptLanguage,
+				MaxDescriptionLength: tc.maxDescriptionLength,
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+
+			if got, want := len(response.GetAvailablePackageSummaries()), 1; got != want {
+				t.Fatalf("got: %d summaries, want: %d summaries", got, want)
+			}
+			if got, want := response.GetAvailablePackageSummaries()[0].GetShortDescription(), tc.expectedShortDescription; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			// The summaries of the plugin are not modified.
+			pluginSummaries := mockedLocalizedPackagingPlugin.server.(*plugin_test.TestPackagingPluginServer).AvailablePackageSummaries
+			if got, want := pluginSummaries[0].GetShortDescription(), plugin_test.DefaultDescription; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageSummariesExcludeDeprecated(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		excludeDeprecated         bool
+		expectedPackageNames      []string
+		expectedDeprecatedPackage string
+	}{
+		{
+			name:                      "it returns the deprecated packages by default",
+			excludeDeprecated:         false,
+			expectedPackageNames:      []string{"pkg-1", "pkg-2"},
+			expectedDeprecatedPackage: "pkg-1",
+		},
+		{
+			name:                 "it excludes the deprecated packages when requested",
+			excludeDeprecated:    true,
+			expectedPackageNames: []string{"pkg-2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{mockedDeprecatedPackagingPlugin},
+			}
+
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				FilterOptions: &corev1.FilterOptions{
+					ExcludeDeprecated: tc.excludeDeprecated,
+				},
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			packageNames := []string{}
+			deprecatedPackage := ""
+			for _, pkg := range response.GetAvailablePackageSummaries() {
+				packageNames = append(packageNames, pkg.GetName())
+				if pkg.GetDeprecated() {
+					deprecatedPackage = pkg.GetName()
+					if pkg.GetDeprecationMessage() == "" {
+						t.Errorf("got: empty deprecation message for %q, want: a message", pkg.GetName())
+					}
+				}
+			}
+			if got, want := packageNames, tc.expectedPackageNames; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if got, want := deprecatedPackage, tc.expectedDeprecatedPackage; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+// maintainersPackagingPluginServer is a test plugin not supporting the
+// maintainer filter and returning the details of its available packages with
+// the configured maintainers.
+type maintainersPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	maintainers map[string][]*corev1.Maintainer
+}
+
+func (s maintainersPackagingPluginServer) GetAvailablePackageSummaries(ctx context.Context, request *corev1.GetAvailablePackageSummariesRequest) (*corev1.GetAvailablePackageSummariesResponse, error) {
+	if request.GetFilterOptions().GetMaintainer() != "" {
+		return nil, status.Errorf(codes.Unimplemented, "Not supported yet: request.FilterOptions.Maintainer")
+	}
+	return s.TestPackagingPluginServer.GetAvailablePackageSummaries(ctx, request)
+}
+
+// filteringMaintainersPackagingPluginServer is a test plugin filtering its
+// available packages by maintainer itself, without returning their details.
+type filteringMaintainersPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	maintainers map[string]string
+}
+
+func (s filteringMaintainersPackagingPluginServer) GetAvailablePackageSummaries(ctx context.Context, request *corev1.GetAvailablePackageSummariesRequest) (*corev1.GetAvailablePackageSummariesResponse, error) {
+	summaries := []*corev1.AvailablePackageSummary{}
+	for _, summary := range s.AvailablePackageSummaries {
+		if strings.Contains(s.maintainers[summary.GetName()], request.GetFilterOptions().GetMaintainer()) {
+			summaries = append(summaries, summary)
+		}
+	}
+	return &corev1.GetAvailablePackageSummariesResponse{AvailablePackageSummaries: summaries}, nil
+}
+
+func (s maintainersPackagingPluginServer) GetAvailablePackageDetail(ctx context.Context, request *corev1.GetAvailablePackageDetailRequest) (*corev1.GetAvailablePackageDetailResponse, error) {
+	maintainers, ok := s.maintainers[request.GetAvailablePackageRef().GetIdentifier()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "package %q not found", request.GetAvailablePackageRef().GetIdentifier())
+	}
+	detail := plugin_test.MakeAvailablePackageDetail(request.GetAvailablePackageRef().GetIdentifier(), s.Plugin)
+	detail.Maintainers = maintainers
+	return &corev1.GetAvailablePackageDetailResponse{AvailablePackageDetail: detail}, nil
+}
+
+func TestGetAvailablePackageSummariesMaintainer(t *testing.T) {
+	maintainersPlugin := &plugins.Plugin{Name: "maintainers-plugin", Version: "v1alpha1"}
+	summaries := []*corev1.AvailablePackageSummary{}
+	for _, name := range []string{"pkg-1", "pkg-2", "pkg-3"} {
+		summary := plugin_test.MakeAvailablePackageSummary(name, maintainersPlugin)
+		summary.AvailablePackageRef.Identifier = name
+		summaries = append(summaries, summary)
+	}
+	filteringPlugin := &plugins.Plugin{Name: "filtering-plugin", Version: "v1alpha1"}
+	server := &packagesServer{
+		plugins: []*pkgsPluginWithServer{
+			{
+				plugin: filteringPlugin,
+				server: filteringMaintainersPackagingPluginServer{
+					TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{
+						Plugin: filteringPlugin,
+						AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+							plugin_test.MakeAvailablePackageSummary("pkg-4", filteringPlugin),
+						},
+					},
+					maintainers: map[string]string{"pkg-4": "jane@acme.example"},
+				},
+			},
+			{
+				plugin: maintainersPlugin,
+				server: maintainersPackagingPluginServer{
+					TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{
+						Plugin:                    maintainersPlugin,
+						AvailablePackageSummaries: summaries,
+					},
+					// The details of pkg-3 cannot be fetched.
+					maintainers: map[string][]*corev1.Maintainer{
+						"pkg-1": {{Name: "Jane Doe", Email: "jane@acme.example"}},
+						"pkg-2": {{Name: "John Doe", Email: "john@other.example"}},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		maintainer           string
+		expectedPackageNames []string
+	}{
+		{
+			name:                 "it returns all the packages without maintainer filter",
+			expectedPackageNames: []string{"pkg-1", "pkg-2", "pkg-3", "pkg-4"},
+		},
+		{
+			name:                 "it returns the packages with a maintainer whose email matches, filtered by the plugins supporting it",
+			maintainer:           "acme.example",
+			expectedPackageNames: []string{"pkg-1", "pkg-4"},
+		},
+		{
+			name:                 "it returns the packages with a maintainer whose name matches, case-insensitively",
+			maintainer:           "john doe",
+			expectedPackageNames: []string{"pkg-2"},
+		},
+		{
+			name:                 "it returns no packages when no maintainer matches",
+			maintainer:           "globex",
+			expectedPackageNames: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				FilterOptions: &corev1.FilterOptions{
+					Maintainer: tc.maintainer,
+				},
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			packageNames := []string{}
+			for _, pkg := range response.GetAvailablePackageSummaries() {
+				packageNames = append(packageNames, pkg.GetName())
+			}
+			if got, want := packageNames, tc.expectedPackageNames; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageSummariesMaintainerTooManyPackages(t *testing.T) {
+	maintainersPlugin := &plugins.Plugin{Name: "maintainers-plugin", Version: "v1alpha1"}
+	summaries := []*corev1.AvailablePackageSummary{}
+	maintainers := map[string][]*corev1.Maintainer{}
+	for i := 0; i <= maxMaintainerFilterPackages; i++ {
+		name := fmt.Sprintf("pkg-%d", i)
+		summary := plugin_test.MakeAvailablePackageSummary(name, maintainersPlugin)
+		summary.AvailablePackageRef.Identifier = name
+		summaries = append(summaries, summary)
+		maintainers[name] = []*corev1.Maintainer{{Name: "Jane Doe", Email: "jane@acme.example"}}
+	}
+	server := &packagesServer{
+		plugins: []*pkgsPluginWithServer{
+			{
+				plugin: maintainersPlugin,
+				server: maintainersPackagingPluginServer{
+					TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{
+						Plugin:                    maintainersPlugin,
+						AvailablePackageSummaries: summaries,
+					},
+					maintainers: maintainers,
+				},
+			},
+		},
+	}
+
+	_, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+		Context: &corev1.Context{
+			Cluster:   "",
+			Namespace: globalPackagingNamespace,
+		},
+		FilterOptions: &corev1.FilterOptions{
+			Maintainer: "acme.example",
+		},
+	})
+
+	if got, want := status.Code(err), codes.Unimplemented; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
+
+func TestGetAvailablePackageSummariesModifiedSince(t *testing.T) {
+	untrackedPlugin := &plugins.Plugin{Name: "untracked-plugin", Version: "v1alpha1"}
+	configuredPlugins := []*pkgsPluginWithServer{
+		mockedModifiedTimesPackagingPlugin,
+		{
+			plugin: untrackedPlugin,
+			server: &plugin_test.TestPackagingPluginServer{
+				Plugin: untrackedPlugin,
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					plugin_test.MakeAvailablePackageSummary("pkg-3", untrackedPlugin),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		modifiedSince        string
+		statusCode           codes.Code
+		expectedPackageNames []string
+	}{
+		{
+			name:                 "it returns all the packages without a modified since",
+			statusCode:           codes.OK,
+			expectedPackageNames: []string{"pkg-1", "pkg-2", "pkg-3"},
+		},
+		{
+			name:                 "it returns the packages modified since the timestamp, and all those of plugins without change tracking",
+			modifiedSince:        "2021-09-05T00:00:00Z",
+			statusCode:           codes.OK,
+			expectedPackageNames: []string{"pkg-2", "pkg-3"},
+		},
+		{
+			name:                 "it returns no tracked package when none was modified since the timestamp",
+			modifiedSince:        "2021-09-10T10:00:00Z",
+			statusCode:           codes.OK,
+			expectedPackageNames: []string{"pkg-3"},
+		},
+		{
+			name:          "it rejects a modified since which is not an RFC 3339 timestamp",
+			modifiedSince: "2021-09-05",
+			statusCode:    codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: configuredPlugins,
+			}
+
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				ModifiedSince: tc.modifiedSince,
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+
+			packageNames := []string{}
+			for _, pkg := range response.GetAvailablePackageSummaries() {
+				packageNames = append(packageNames, pkg.GetName())
+			}
+			if got, want := packageNames, tc.expectedPackageNames; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+// testServerTransportStream is a grpc.ServerTransportStream recording the
+// trailer metadata set by the server.
+type testServerTransportStream struct {
+	method  string
+	trailer metadata.MD
+}
+
+func (s *testServerTransportStream) Method() string                  { return s.method }
+func (s *testServerTransportStream) SetHeader(md metadata.MD) error  { return nil }
+func (s *testServerTransportStream) SendHeader(md metadata.MD) error { return nil }
+func (s *testServerTransportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestGetAvailablePackageSummariesETag(t *testing.T) {
+	fullResponse := &corev1.GetAvailablePackageSummariesResponse{
+		AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+			plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+			plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+			plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+			plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+		},
+		Categories: []string{"cat-1"},
+	}
+	expectedETag, err := computeETag(fullResponse)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	testCases := []struct {
+		name                string
+		ifNoneMatch         map[string]string
+		expectedResponse    *corev1.GetAvailablePackageSummariesResponse
+		expectedNotModified bool
+	}{
+		{
+			name:             "it should return the full response and its ETag",
+			expectedResponse: fullResponse,
+		},
+		{
+			name:             "it should return the full response when the If-None-Match ETag does not match",
+			ifNoneMatch:      map[string]string{"if-none-match": `"outdated"`},
+			expectedResponse: fullResponse,
+		},
+		{
+			name:                "it should return an empty response when the If-None-Match ETag matches",
+			ifNoneMatch:         map[string]string{"if-none-match": expectedETag},
+			expectedResponse:    &corev1.GetAvailablePackageSummariesResponse{},
+			expectedNotModified: true,
+		},
+		{
+			name:                "it should return an empty response when one of the If-None-Match ETags sent through the gateway matches",
+			ifNoneMatch:         map[string]string{"grpcgateway-if-none-match": `"outdated", ` + expectedETag},
+			expectedResponse:    &corev1.GetAvailablePackageSummariesResponse{},
+			expectedNotModified: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					mockedPackagingPlugin1,
+					mockedPackagingPlugin2,
+				},
+			}
+			stream := &testServerTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			if tc.ifNoneMatch != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.New(tc.ifNoneMatch))
+			}
+
+			availablePackageSummaries, err := server.GetAvailablePackageSummaries(ctx, &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := availablePackageSummaries, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+			if got, want := stream.trailer.Get(etagMetadataKey), []string{expectedETag}; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if got, want := len(stream.trailer.Get(notModifiedMetadataKey)) > 0, tc.expectedNotModified; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageSummariesPluginTimings(t *testing.T) {
+	testCases := []struct {
+		name            string
+		metadata        map[string]string
+		expectedPlugins []string
+	}{
+		{
+			name: "it should not return the plugin timings by default",
+		},
+		{
+			name:     "it should not return the plugin timings when not requested",
+			metadata: map[string]string{debugTimingsMetadataKey: "false"},
+		},
+		{
+			name:            "it should return the timing of each plugin call when requested",
+			metadata:        map[string]string{debugTimingsMetadataKey: "true"},
+			expectedPlugins: []string{mockedPackagingPlugin1.plugin.Name, mockedPackagingPlugin2.plugin.Name},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					mockedPackagingPlugin1,
+					mockedPackagingPlugin2,
+				},
+			}
+			stream := &testServerTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			if tc.metadata != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.New(tc.metadata))
+			}
+
+			_, err := server.GetAvailablePackageSummaries(ctx, &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			timings := stream.trailer.Get(pluginTimingsMetadataKey)
+			gotPlugins := []string{}
+			for _, timing := range timings {
+				parts := strings.SplitN(timing, "=", 2)
+				if len(parts) != 2 {
+					t.Fatalf("got: %q, want: a <plugin>=<duration> timing", timing)
+				}
+				if _, err := time.ParseDuration(parts[1]); err != nil {
+					t.Errorf("got: %q, want: a valid duration: %+v", parts[1], err)
+				}
+				gotPlugins = append(gotPlugins, parts[0])
+			}
+			if got, want := gotPlugins, tc.expectedPlugins; !cmp.Equal(want, got, cmpopts.EquateEmpty()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, cmpopts.EquateEmpty()))
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageDetail(t *testing.T) {
+	testCases := []struct {
+		name              string
+		configuredPlugins []*pkgsPluginWithServer
+		statusCode        codes.Code
+		request           *corev1.GetAvailablePackageDetailRequest
+		expectedResponse  *corev1.GetAvailablePackageDetailResponse
+	}{
+		{
+			name: "it should successfully call the core GetAvailablePackageDetail operation",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedPackagingPlugin1.plugin,
+				},
+				PkgVersion: "",
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDetailResponse{
+				AvailablePackageDetail: plugin_test.MakeAvailablePackageDetail("pkg-1", mockedPackagingPlugin1.plugin),
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should resolve the latest pseudo-version to the newest version",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin2,
+				withVersionedDetail(mockedPackagingPlugin1),
+			},
+			request: &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedPackagingPlugin1.plugin,
+				},
+				PkgVersion: "latest",
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDetailResponse{
+				AvailablePackageDetail: makeAvailablePackageDetailWithPkgVersion("pkg-1", plugin_test.DefaultPkgUpdateVersion, mockedPackagingPlugin1.plugin),
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should resolve the latest pseudo-version using semver ordering",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin2,
+				withVersionedDetail(mockedUnorderedVersionsPackagingPlugin),
+			},
+			request: &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedUnorderedVersionsPackagingPlugin.plugin,
+				},
+				PkgVersion: "latest",
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDetailResponse{
+				AvailablePackageDetail: makeAvailablePackageDetailWithPkgVersion("pkg-1", "10.0.0", mockedUnorderedVersionsPackagingPlugin.plugin),
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should resolve the latest pseudo-version to the newest stable version by default",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin2,
+				withVersionedDetail(mockedPrereleaseVersionsPackagingPlugin),
+			},
+			request: &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedPrereleaseVersionsPackagingPlugin.plugin,
+				},
+				PkgVersion: "latest",
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDetailResponse{
+				AvailablePackageDetail: makeAvailablePackageDetailWithPkgVersion("pkg-1", "1.1.0", mockedPrereleaseVersionsPackagingPlugin.plugin),
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should resolve the latest pseudo-version to a pre-release version if included",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin2,
+				withVersionedDetail(mockedPrereleaseVersionsPackagingPlugin),
+			},
+			request: &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedPrereleaseVersionsPackagingPlugin.plugin,
+				},
+				PkgVersion:        "latest",
+				IncludePrerelease: true,
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDetailResponse{
+				AvailablePackageDetail: makeAvailablePackageDetailWithPkgVersion("pkg-1", "2.0.0-beta.1", mockedPrereleaseVersionsPackagingPlugin.plugin),
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail to resolve the latest pseudo-version without valid versions",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin2,
+				mockedNamespacedPackagingPlugin1,
+			},
+			request: &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedNamespacedPackagingPlugin1.plugin,
+				},
+				PkgVersion: "latest",
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDetailResponse{},
+			statusCode:       codes.NotFound,
+		},
+		{
+			name: "it should return the provenance of a signed package",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedSignedPackagingPlugin,
+			},
+			request: &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedSignedPackagingPlugin.plugin,
+				},
+				PkgVersion: "",
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDetailResponse{
+				AvailablePackageDetail: plugin_test.MakeSignedAvailablePackageDetail("pkg-1", mockedSignedPackagingPlugin.plugin),
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return an empty provenance for an unsigned package",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin2,
+				mockedSignedPackagingPlugin,
+			},
+			request: &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedPackagingPlugin2.plugin,
+				},
+				PkgVersion: "",
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDetailResponse{
+				AvailablePackageDetail: plugin_test.MakeAvailablePackageDetail("pkg-1", mockedPackagingPlugin2.plugin),
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail when calling the core GetAvailablePackageDetail operation when the package is not present in a plugin",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedNotFoundPackagingPlugin.plugin,
+				},
+				PkgVersion: "",
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDetailResponse{},
+			statusCode:       codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: tc.configuredPlugins,
+			}
+			availablePackageDetail, err := server.GetAvailablePackageDetail(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := availablePackageDetail, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageDetailLocalized(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		acceptLanguage           string
+		expectedShortDescription string
+		expectedLongDescription  string
+	}{
+		{
+			name:                     "it returns the descriptions in the requested language",
+			acceptLanguage:           "en;q=0.5,fr",
+			expectedShortDescription: "description courte",
+			expectedLongDescription:  "description longue",
+		},
+		{
+			name:                     "it falls back to the default descriptions when the language is unavailable",
+			acceptLanguage:           "es",
+			expectedShortDescription: plugin_test.DefaultDescription,
+			expectedLongDescription:  "long description",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{mockedLocalizedPackagingPlugin},
+			}
+
+			response, err := server.GetAvailablePackageDetail(context.Background(), &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedLocalizedPackagingPlugin.plugin,
+				},
+				AcceptLanguage: tc.acceptLanguage,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			pkgDetail := response.GetAvailablePackageDetail()
+			if got, want := pkgDetail.GetShortDescription(), tc.expectedShortDescription; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := pkgDetail.GetLongDescription(), tc.expectedLongDescription; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+// makePluginVersionTestPackagingPlugin returns a test plugin of the given
+// name and version, returning the detail of a single package.
+func makePluginVersionTestPackagingPlugin(pluginName, pluginVersion string) *pkgsPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: pluginVersion}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+	packagingPluginServer.AvailablePackageDetail = plugin_test.MakeAvailablePackageDetail("pkg-1", pluginDetails)
+
+	return &pkgsPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
+func TestGetAvailablePackageDetailPluginVersionFallback(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		pluginVersions        []string
+		requestedVersion      string
+		pluginVersionFallback bool
+		statusCode            codes.Code
+		expectedVersion       string
+	}{
+		{
+			name:             "it matches the plugin name only by default",
+			pluginVersions:   []string{"v1alpha1", "v1beta1"},
+			requestedVersion: "v1beta1",
+			statusCode:       codes.OK,
+			expectedVersion:  "v1alpha1",
+		},
+		{
+			name:             "it uses a loaded version for a plugin version which is not loaded by default",
+			pluginVersions:   []string{"v1alpha1", "v1beta1"},
+			requestedVersion: "v1alpha2",
+			statusCode:       codes.OK,
+			expectedVersion:  "v1alpha1",
+		},
+		{
+			name:             "it fails without any version of the plugin loaded by default",
+			pluginVersions:   []string{},
+			requestedVersion: "v1alpha1",
+			statusCode:       codes.Internal,
+		},
+		{
+			name:                  "it uses the requested plugin version when loaded with the fallback",
+			pluginVersions:        []string{"v1alpha1", "v1beta1"},
+			requestedVersion:      "v1alpha1",
+			pluginVersionFallback: true,
+			statusCode:            codes.OK,
+			expectedVersion:       "v1alpha1",
+		},
+		{
+			name:                  "it falls back to the nearest newer plugin version",
+			pluginVersions:        []string{"v1", "v1alpha1", "v1beta1"},
+			requestedVersion:      "v1alpha2",
+			pluginVersionFallback: true,
+			statusCode:            codes.OK,
+			expectedVersion:       "v1beta1",
+		},
+		{
+			name:                  "it falls back to the nearest older plugin version without a newer one",
+			pluginVersions:        []string{"v1alpha1", "v1beta1"},
+			requestedVersion:      "v1",
+			pluginVersionFallback: true,
+			statusCode:            codes.OK,
+			expectedVersion:       "v1beta1",
+		},
+		{
+			name:                  "it fails without any version of the plugin loaded with the fallback",
+			pluginVersions:        []string{},
+			requestedVersion:      "v1alpha1",
+			pluginVersionFallback: true,
+			statusCode:            codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configuredPlugins := []*pkgsPluginWithServer{mockedPackagingPlugin1}
+			for _, pluginVersion := range tc.pluginVersions {
+				configuredPlugins = append(configuredPlugins, makePluginVersionTestPackagingPlugin("versioned-plugin", pluginVersion))
+			}
+			server := &packagesServer{
+				plugins:               configuredPlugins,
+				pluginVersionFallback: tc.pluginVersionFallback,
+			}
+
+			response, err := server.GetAvailablePackageDetail(context.Background(), &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     &plugins.Plugin{Name: "versioned-plugin", Version: tc.requestedVersion},
+				},
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response.GetAvailablePackageDetail().GetAvailablePackageRef().GetPlugin().GetVersion(), tc.expectedVersion; got != want {
+					t.Errorf("got: %q, want: %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateInstalledPackageWithoutPluginVersionFallback(t *testing.T) {
+	server := &packagesServer{
+		plugins: []*pkgsPluginWithServer{
+			makePluginVersionTestPackagingPlugin("versioned-plugin", "v1alpha1"),
+		},
+		pluginVersionFallback: true,
+	}
+
+	// The fallback only applies to the read requests, the other requests
+	// being routed by the plugin name.
+	response, err := server.UpdateInstalledPackage(context.Background(), &corev1.UpdateInstalledPackageRequest{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+			Identifier: "installed-pkg-1",
+			Plugin:     &plugins.Plugin{Name: "versioned-plugin", Version: "v1beta1"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := response.GetInstalledPackageRef().GetPlugin().GetVersion(), "v1alpha1"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestGetInstalledPackageSummaries(t *testing.T) {
+	testCases := []struct {
+		name              string
+		configuredPlugins []*pkgsPluginWithServer
+		mergeConflicts    bool
+		statusCode        codes.Code
+		request           *corev1.GetInstalledPackageSummariesRequest
+		expectedResponse  *corev1.GetInstalledPackageSummariesResponse
+	}{
+		{
+			name: "it should successfully call the core GetInstalledPackageSummaries operation",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return the same ordering regardless of the order of the configured plugins",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin2,
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should order the installed packages by name, then namespace, then plugin",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedNamespacedPackagingPlugin2,
+				mockedNamespacedPackagingPlugin1,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: "",
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", mockedNamespacedPackagingPlugin1.plugin),
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", mockedNamespacedPackagingPlugin2.plugin),
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", mockedNamespacedPackagingPlugin1.plugin),
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", mockedNamespacedPackagingPlugin2.plugin),
+					makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", mockedNamespacedPackagingPlugin1.plugin),
+					makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", mockedNamespacedPackagingPlugin2.plugin),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return a single summary for an installed package reported by several plugins, flagging the conflict",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedConflictingPackagingPlugin,
+				mockedPackagingPlugin1,
+			},
+			mergeConflicts: true,
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					makeInstalledPackageSummaryWithConflicts(plugin_test.MakeInstalledPackageSummary("pkg-1", mockedConflictingPackagingPlugin.plugin), mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-3", mockedConflictingPackagingPlugin.plugin),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should keep the summary of the first configured plugin for an installed package reported by several plugins",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedConflictingPackagingPlugin,
+			},
+			mergeConflicts: true,
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					makeInstalledPackageSummaryWithConflicts(plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin), mockedConflictingPackagingPlugin.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-3", mockedConflictingPackagingPlugin.plugin),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return all the installed packages without a next page token when the PageSize is larger than the total",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedNamespacedPackagingPlugin1,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: "",
+				},
+				PaginationOptions: &corev1.PaginationOptions{PageToken: "0", PageSize: 5},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", mockedNamespacedPackagingPlugin1.plugin),
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", mockedNamespacedPackagingPlugin1.plugin),
+					makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", mockedNamespacedPackagingPlugin1.plugin),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail when calling the core GetInstalledPackageSummaries operation with an invalid page token",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedNamespacedPackagingPlugin1,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: "",
+				},
+				PaginationOptions: &corev1.PaginationOptions{PageToken: "not-a-page", PageSize: 1},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "it should fail when calling the core GetInstalledPackageSummaries operation when the package is not present in a plugin",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{},
+			},
+			statusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins:                           tc.configuredPlugins,
+				mergeConflictingInstalledPackages: tc.mergeConflicts,
+			}
+			installedPackageSummaries, err := server.GetInstalledPackageSummaries(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := installedPackageSummaries, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+// paginatedInstalledPackagingPluginServer is a test plugin paginating its
+// installed packages with opaque page tokens, recording the page tokens of
+// the requests.
+type paginatedInstalledPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	pageTokens *[]string
+}
+
+func (s paginatedInstalledPackagingPluginServer) GetInstalledPackageSummaries(ctx context.Context, request *corev1.GetInstalledPackageSummariesRequest) (*corev1.GetInstalledPackageSummariesResponse, error) {
+	pageToken := request.GetPaginationOptions().GetPageToken()
+	*s.pageTokens = append(*s.pageTokens, pageToken)
+	start := 0
+	if pageToken != "" {
+		if _, err := fmt.Sscanf(pageToken, "offset-%d", &start); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q", pageToken)
+		}
+	}
+	end := len(s.InstalledPackageSummaries)
+	nextPageToken := ""
+	if pageSize := int(request.GetPaginationOptions().GetPageSize()); pageSize > 0 && start+pageSize < end {
+		end = start + pageSize
+		nextPageToken = fmt.Sprintf("offset-%d", end)
+	}
+	return &corev1.GetInstalledPackageSummariesResponse{
+		InstalledPackageSummaries: s.InstalledPackageSummaries[start:end],
+		NextPageToken:             nextPageToken,
+	}, nil
+}
+
+func TestGetInstalledPackageSummariesPagination(t *testing.T) {
+	paginatedPlugin := &plugins.Plugin{Name: "paginated-plugin", Version: "v1alpha1"}
+	otherPaginatedPlugin := &plugins.Plugin{Name: "other-paginated-plugin", Version: "v1alpha1"}
+	makePaginatedPlugin := func(plugin *plugins.Plugin, pageTokens *[]string) *pkgsPluginWithServer {
+		return &pkgsPluginWithServer{
+			plugin: plugin,
+			server: paginatedInstalledPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{
+					Plugin: plugin,
+					InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+						makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", plugin),
+						makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", plugin),
+						makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", plugin),
+					},
+				},
+				pageTokens: pageTokens,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name                     string
+		otherPlugin              func() *pkgsPluginWithServer
+		mergeConflicts           bool
+		pageToken                string
+		pageSize                 int32
+		expectedPages            [][]*corev1.InstalledPackageSummary
+		expectedPluginPageTokens []string
+	}{
+		{
+			name:     "it forwards the page tokens of the plugin",
+			pageSize: 2,
+			expectedPages: [][]*corev1.InstalledPackageSummary{
+				{
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", paginatedPlugin),
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", paginatedPlugin),
+				},
+				{
+					makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", paginatedPlugin),
+				},
+			},
+			expectedPluginPageTokens: []string{"", "offset-2"},
+		},
+		{
+			name:      "it accepts the 0 page token as the first page",
+			pageToken: "0",
+			pageSize:  3,
+			expectedPages: [][]*corev1.InstalledPackageSummary{
+				{
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", paginatedPlugin),
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", paginatedPlugin),
+					makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", paginatedPlugin),
+				},
+			},
+			expectedPluginPageTokens: []string{""},
+		},
+		{
+			name:     "it returns all the installed packages without a next page token when the PageSize is larger than the total",
+			pageSize: 5,
+			expectedPages: [][]*corev1.InstalledPackageSummary{
+				{
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", paginatedPlugin),
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", paginatedPlugin),
+					makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", paginatedPlugin),
+				},
+			},
+			expectedPluginPageTokens: []string{""},
+		},
+		{
+			name: "it merges the pages of the plugins in order, requesting again the summaries of a plugin not yet returned",
+			otherPlugin: func() *pkgsPluginWithServer {
+				return makePaginatedPlugin(otherPaginatedPlugin, &[]string{})
+			},
+			pageSize: 2,
+			expectedPages: [][]*corev1.InstalledPackageSummary{
+				{
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", otherPaginatedPlugin),
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", paginatedPlugin),
+				},
+				{
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", otherPaginatedPlugin),
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", paginatedPlugin),
+				},
+				{
+					makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", otherPaginatedPlugin),
+					makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", paginatedPlugin),
+				},
+			},
+			expectedPluginPageTokens: []string{"", "", "offset-2"},
+		},
+		{
+			name: "it returns every installed package once, even for the plugins not returning them in order",
+			otherPlugin: func() *pkgsPluginWithServer {
+				return mockedNamespacedPackagingPlugin1
+			},
+			pageSize: 2,
+			expectedPages: [][]*corev1.InstalledPackageSummary{
+				{
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", paginatedPlugin),
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", paginatedPlugin),
+				},
+				{
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", mockedNamespacedPackagingPlugin1.plugin),
+					makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", mockedNamespacedPackagingPlugin1.plugin),
+				},
+				{
+					makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", mockedNamespacedPackagingPlugin1.plugin),
+					makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", paginatedPlugin),
+				},
+			},
+			expectedPluginPageTokens: []string{"", "offset-2", "offset-2"},
+		},
+		{
+			name: "it merges the conflicting summaries following a full page into the page",
+			otherPlugin: func() *pkgsPluginWithServer {
+				return makePaginatedPlugin(otherPaginatedPlugin, &[]string{})
+			},
+			mergeConflicts: true,
+			pageSize:       1,
+			expectedPages: [][]*corev1.InstalledPackageSummary{
+				{
+					makeInstalledPackageSummaryWithConflicts(makeInstalledPackageSummaryInNamespace("pkg-1", "ns-1", paginatedPlugin), otherPaginatedPlugin),
+				},
+				{
+					makeInstalledPackageSummaryWithConflicts(makeInstalledPackageSummaryInNamespace("pkg-1", "ns-2", paginatedPlugin), otherPaginatedPlugin),
+				},
+				{
+					makeInstalledPackageSummaryWithConflicts(makeInstalledPackageSummaryInNamespace("pkg-2", "ns-1", paginatedPlugin), otherPaginatedPlugin),
+				},
+			},
+			expectedPluginPageTokens: []string{"", "offset-1", "offset-2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pluginPageTokens := []string{}
+			configuredPlugins := []*pkgsPluginWithServer{makePaginatedPlugin(paginatedPlugin, &pluginPageTokens)}
+			if tc.otherPlugin != nil {
+				configuredPlugins = append(configuredPlugins, tc.otherPlugin())
+			}
+			server := &packagesServer{
+				plugins:                           configuredPlugins,
+				mergeConflictingInstalledPackages: tc.mergeConflicts,
+			}
+
+			pages := [][]*corev1.InstalledPackageSummary{}
+			pageToken := tc.pageToken
+			for {
+				response, err := server.GetInstalledPackageSummaries(context.Background(), &corev1.GetInstalledPackageSummariesRequest{
+					Context:           &corev1.Context{},
+					PaginationOptions: &corev1.PaginationOptions{PageToken: pageToken, PageSize: tc.pageSize},
+				})
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				pages = append(pages, response.InstalledPackageSummaries)
+				pageToken = response.NextPageToken
+				if pageToken == "" || len(pages) > len(tc.expectedPages) {
+					break
+				}
+			}
+
+			if got, want := pages, tc.expectedPages; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+			if got, want := pluginPageTokens, tc.expectedPluginPageTokens; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageDetail(t *testing.T) {
+	testCases := []struct {
+		name              string
+		configuredPlugins []*pkgsPluginWithServer
+		statusCode        codes.Code
+		request           *corev1.GetInstalledPackageDetailRequest
+		expectedResponse  *corev1.GetInstalledPackageDetailResponse
+	}{
+		{
+			name: "it should successfully call the core GetInstalledPackageDetail operation",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedPackagingPlugin1.plugin,
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: plugin_test.MakeInstalledPackageDetail("pkg-1", mockedPackagingPlugin1.plugin),
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail when calling the core GetInstalledPackageDetail operation when the package is not present in a plugin",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     mockedNotFoundPackagingPlugin.plugin,
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageDetailResponse{},
+			statusCode:       codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: tc.configuredPlugins,
+			}
+			installedPackageDetail, err := server.GetInstalledPackageDetail(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := installedPackageDetail, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageDetailProgressPercentage(t *testing.T) {
+	plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+
+	testCases := []struct {
+		name                       string
+		workloadStatus             *corev1.WorkloadStatus
+		expectedProgressPercentage int32
+	}{
+		{
+			name: "it derives the progress from the ready replicas",
+			workloadStatus: &corev1.WorkloadStatus{
+				Workloads:       2,
+				ReadyWorkloads:  1,
+				DesiredReplicas: 4,
+				ReadyReplicas:   3,
+			},
+			expectedProgressPercentage: 75,
+		},
+		{
+			name: "it derives the progress from the ready workloads without desired replicas",
+			workloadStatus: &corev1.WorkloadStatus{
+				Workloads:      3,
+				ReadyWorkloads: 1,
+			},
+			expectedProgressPercentage: 33,
+		},
+		{
+			name: "it caps the progress when there are more ready than desired replicas",
+			workloadStatus: &corev1.WorkloadStatus{
+				Workloads:       1,
+				ReadyWorkloads:  1,
+				DesiredReplicas: 2,
+				ReadyReplicas:   3,
+			},
+			expectedProgressPercentage: 100,
+		},
+		{
+			name:                       "it does not derive a progress without workloads",
+			workloadStatus:             &corev1.WorkloadStatus{},
+			expectedProgressPercentage: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: plugin,
+						server: plugin_test.TestPackagingPluginServer{
+							Plugin: plugin,
+							InstalledPackageDetail: &corev1.InstalledPackageDetail{
+								Name:           "installed-pkg-1",
+								WorkloadStatus: tc.workloadStatus,
+							},
+						},
+					},
+				},
+			}
+
+			response, err := server.GetInstalledPackageDetail(context.Background(), &corev1.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     plugin,
+				},
+				IncludeWorkloadStatus: true,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := response.GetInstalledPackageDetail().GetWorkloadStatus().GetProgressPercentage(), tc.expectedProgressPercentage; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageVersions(t *testing.T) {
+	testCases := []struct {
+		name              string
+		configuredPlugins []*pkgsPluginWithServer
+		statusCode        codes.Code
+		request           *corev1.GetAvailablePackageVersionsRequest
+		expectedResponse  *corev1.GetAvailablePackageVersionsResponse
+	}{
+		{
+			name: "it should successfully call the core GetAvailablePackageVersions operation",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetAvailablePackageVersionsRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+					Plugin:     mockedPackagingPlugin1.plugin,
+				},
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageVersionsResponse{
+				PackageAppVersions: []*corev1.PackageAppVersion{
+					plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultPkgUpdateVersion),
+					plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultPkgVersion),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail when calling the core GetAvailablePackageVersions operation when the package is not present in a plugin",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.GetAvailablePackageVersionsRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+					Plugin:     mockedNotFoundPackagingPlugin.plugin,
+				},
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageVersionsResponse{
+				PackageAppVersions: []*corev1.PackageAppVersion{},
+			},
+			statusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: tc.configuredPlugins,
+			}
+			AvailablePackageVersions, err := server.GetAvailablePackageVersions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := AvailablePackageVersions, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+// channelsPackagingPluginServer is a test plugin organizing the versions of
+// its packages into channels.
+type channelsPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	channelVersions map[string][]*corev1.PackageAppVersion
+}
+
+func (s channelsPackagingPluginServer) GetAvailablePackageVersions(ctx context.Context, request *corev1.GetAvailablePackageVersionsRequest) (*corev1.GetAvailablePackageVersionsResponse, error) {
+	if request.GetChannel() == "" {
+		return s.TestPackagingPluginServer.GetAvailablePackageVersions(ctx, request)
+	}
+	return &corev1.GetAvailablePackageVersionsResponse{
+		PackageAppVersions: append([]*corev1.PackageAppVersion{}, s.channelVersions[request.GetChannel()]...),
+	}, nil
+}
+
+func TestGetAvailablePackageVersionsChannel(t *testing.T) {
+	channelsPlugin := &plugins.Plugin{Name: "channels-plugin", Version: "v1alpha1"}
+	noChannelsPlugin := &plugins.Plugin{Name: "no-channels-plugin", Version: "v1alpha1"}
+	allVersions := []*corev1.PackageAppVersion{
+		plugin_test.MakePackageAppVersion("1.1.0-rc.1", "2.1.0"),
+		plugin_test.MakePackageAppVersion("1.0.0", "2.0.0"),
+		plugin_test.MakePackageAppVersion("0.9.0", "1.9.0"),
+	}
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: channelsPlugin,
+			server: channelsPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{
+					Plugin:             channelsPlugin,
+					PackageAppVersions: allVersions,
+				},
+				channelVersions: map[string][]*corev1.PackageAppVersion{
+					"stable": allVersions[1:],
+					"edge":   allVersions[:1],
+				},
+			},
+		},
+		{
+			plugin: noChannelsPlugin,
+			server: plugin_test.TestPackagingPluginServer{
+				Plugin:             noChannelsPlugin,
+				PackageAppVersions: allVersions,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		plugin           *plugins.Plugin
+		channel          string
+		expectedVersions []*corev1.PackageAppVersion
+	}{
+		{
+			name:             "it returns all the versions without a channel",
+			plugin:           channelsPlugin,
+			channel:          "",
+			expectedVersions: allVersions,
+		},
+		{
+			name:             "it returns the versions of the stable channel",
+			plugin:           channelsPlugin,
+			channel:          "stable",
+			expectedVersions: allVersions[1:],
+		},
+		{
+			name:             "it returns the versions of the edge channel",
+			plugin:           channelsPlugin,
+			channel:          "edge",
+			expectedVersions: allVersions[:1],
+		},
+		{
+			name:             "it returns all the versions of a plugin without channels",
+			plugin:           noChannelsPlugin,
+			channel:          "stable",
+			expectedVersions: allVersions,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: configuredPlugins,
+			}
+			response, err := server.GetAvailablePackageVersions(context.Background(), &corev1.GetAvailablePackageVersionsRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     tc.plugin,
+				},
+				Channel: tc.channel,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := response.GetPackageAppVersions(), tc.expectedVersions; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageVersionsGroupByMinor(t *testing.T) {
+	plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+	versions := []*corev1.PackageAppVersion{
+		plugin_test.MakePackageAppVersion("2.16.8", "10.3.19"),
+		plugin_test.MakePackageAppVersion("2.16.8", "10.3.18"),
+		plugin_test.MakePackageAppVersion("2.15.3", "10.2.6"),
+		plugin_test.MakePackageAppVersion("2.16.9", "10.3.20"),
+		plugin_test.MakePackageAppVersion("2.8.9", "9.5.4"),
+		plugin_test.MakePackageAppVersion("2.15.2", "10.2.5"),
+		plugin_test.MakePackageAppVersion("2.17.0", "10.4.0-rc.1"),
+		plugin_test.MakePackageAppVersion("", "not-a-version"),
+	}
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: plugin,
+			server: plugin_test.TestPackagingPluginServer{
+				Plugin:             plugin,
+				PackageAppVersions: versions,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		groupByMinor   bool
+		expectedGroups []*corev1.PackageVersionGroup
+	}{
+		{
+			name:         "it groups the versions by major.minor, newest first, with their newest patch",
+			groupByMinor: true,
+			expectedGroups: []*corev1.PackageVersionGroup{
+				{
+					MinorVersion: "10.4",
+					Latest:       versions[6],
+					Count:        1,
+				},
+				{
+					MinorVersion: "10.3",
+					Latest:       versions[3],
+					Count:        3,
+				},
+				{
+					MinorVersion: "10.2",
+					Latest:       versions[2],
+					Count:        2,
+				},
+				{
+					MinorVersion: "9.5",
+					Latest:       versions[4],
+					Count:        1,
+				},
+			},
+		},
+		{
+			name:           "it does not group the versions unless requested",
+			groupByMinor:   false,
+			expectedGroups: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: configuredPlugins,
+			}
+			response, err := server.GetAvailablePackageVersions(context.Background(), &corev1.GetAvailablePackageVersionsRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "pkg-1",
+					Plugin:     plugin,
+				},
+				GroupByMinor:      tc.groupByMinor,
+				IncludePrerelease: true,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := response.GetVersionGroups(), tc.expectedGroups; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+			if got, want := response.GetPackageAppVersions(), versions; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageVersionsPrerelease(t *testing.T) {
+	versions := mockedPrereleaseVersionsPackagingPlugin.server.(*plugin_test.TestPackagingPluginServer).PackageAppVersions
+
+	testCases := []struct {
+		name              string
+		includePrerelease bool
+		groupByMinor      bool
+		expectedVersions  []*corev1.PackageAppVersion
+		expectedGroups    []*corev1.PackageVersionGroup
+	}{
+		{
+			name:             "it excludes the pre-release versions by default, keeping the invalid ones",
+			expectedVersions: []*corev1.PackageAppVersion{versions[1], versions[2], versions[4]},
+		},
+		{
+			name:              "it returns the pre-release versions if included",
+			includePrerelease: true,
+			expectedVersions:  versions,
+		},
+		{
+			name:             "it groups only the stable versions by default",
+			groupByMinor:     true,
+			expectedVersions: []*corev1.PackageAppVersion{versions[1], versions[2], versions[4]},
+			expectedGroups: []*corev1.PackageVersionGroup{
+				{
+					MinorVersion: "1.1",
+					Latest:       versions[1],
+					Count:        1,
+				},
+				{
+					MinorVersion: "1.0",
+					Latest:       versions[4],
+					Count:        1,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{mockedPrereleaseVersionsPackagingPlugin},
+			}
+			response, err := server.GetAvailablePackageVersions(context.Background(), &corev1.GetAvailablePackageVersionsRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+					Identifier: "pkg-1",
+					Plugin:     mockedPrereleaseVersionsPackagingPlugin.plugin,
+				},
+				IncludePrerelease: tc.includePrerelease,
+				GroupByMinor:      tc.groupByMinor,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := response.GetPackageAppVersions(), tc.expectedVersions; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+			if got, want := response.GetVersionGroups(), tc.expectedGroups; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageVersionsInstalledVersion(t *testing.T) {
+	versions := mockedPrereleaseVersionsPackagingPlugin.server.(*plugin_test.TestPackagingPluginServer).PackageAppVersions
+	withRelation := func(v *corev1.PackageAppVersion, relation corev1.PackageAppVersion_VersionRelation) *corev1.PackageAppVersion {
+		return &corev1.PackageAppVersion{PkgVersion: v.PkgVersion, AppVersion: v.AppVersion, Relation: relation}
+	}
+
+	testCases := []struct {
+		name             string
+		installedVersion string
+		groupByMinor     bool
+		statusCode       codes.Code
+		expectedVersions []*corev1.PackageAppVersion
+		expectedGroups   []*corev1.PackageVersionGroup
+	}{
+		{
+			name:             "it does not annotate the versions without installed version",
+			statusCode:       codes.OK,
+			expectedVersions: []*corev1.PackageAppVersion{versions[1], versions[2], versions[4]},
+		},
+		{
+			name:             "it annotates the versions relative to the installed version",
+			installedVersion: "1.1.0",
+			statusCode:       codes.OK,
+			expectedVersions: []*corev1.PackageAppVersion{
+				withRelation(versions[1], corev1.PackageAppVersion_VERSION_RELATION_CURRENT),
+				withRelation(versions[2], corev1.PackageAppVersion_VERSION_RELATION_UNSPECIFIED),
+				withRelation(versions[4], corev1.PackageAppVersion_VERSION_RELATION_OLDER),
+			},
+		},
+		{
+			name:             "it annotates the versions relative to an installed version which is not listed",
+			installedVersion: "0.9.0",
+			statusCode:       codes.OK,
+			expectedVersions: []*corev1.PackageAppVersion{
+				withRelation(versions[1], corev1.PackageAppVersion_VERSION_RELATION_NEWER),
+				withRelation(versions[2], corev1.PackageAppVersion_VERSION_RELATION_UNSPECIFIED),
+				withRelation(versions[4], corev1.PackageAppVersion_VERSION_RELATION_NEWER),
+			},
+		},
+		{
+			name:             "it annotates the latest versions of the groups",
+			installedVersion: "1.0.0",
+			groupByMinor:     true,
+			statusCode:       codes.OK,
+			expectedVersions: []*corev1.PackageAppVersion{
+				withRelation(versions[1], corev1.PackageAppVersion_VERSION_RELATION_NEWER),
+				withRelation(versions[2], corev1.PackageAppVersion_VERSION_RELATION_UNSPECIFIED),
+				withRelation(versions[4], corev1.PackageAppVersion_VERSION_RELATION_CURRENT),
+			},
+			expectedGroups: []*corev1.PackageVersionGroup{
+				{
+					MinorVersion: "1.1",
+					Latest:       withRelation(versions[1], corev1.PackageAppVersion_VERSION_RELATION_NEWER),
+					Count:        1,
+				},
+				{
+					MinorVersion: "1.0",
+					Latest:       withRelation(versions[4], corev1.PackageAppVersion_VERSION_RELATION_CURRENT),
+					Count:        1,
+				},
+			},
+		},
+		{
+			name:             "it rejects an installed version which is not a semantic version",
+			installedVersion: "latest",
+			statusCode:       codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{mockedPrereleaseVersionsPackagingPlugin},
+			}
+			response, err := server.GetAvailablePackageVersions(context.Background(), &corev1.GetAvailablePackageVersionsRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+					Identifier: "pkg-1",
+					Plugin:     mockedPrereleaseVersionsPackagingPlugin.plugin,
+				},
+				InstalledVersion: tc.installedVersion,
+				GroupByMinor:     tc.groupByMinor,
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+
+			if got, want := response.GetPackageAppVersions(), tc.expectedVersions; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+			if got, want := response.GetVersionGroups(), tc.expectedGroups; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+			// The versions of the plugin are not modified.
+			for _, v := range versions {
+				if v.GetRelation() != corev1.PackageAppVersion_VERSION_RELATION_UNSPECIFIED {
+					t.Errorf("got: %+v, want: the versions of the plugin not annotated", v)
+				}
+			}
+		})
+	}
+}
+
+// valuesDiffPackagingPluginServer is a test plugin returning the configured
+// default values diff.
+type valuesDiffPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+	response *corev1.GetAvailablePackageValuesDiffResponse
+}
+
+func (s valuesDiffPackagingPluginServer) GetAvailablePackageValuesDiff(ctx context.Context, request *corev1.GetAvailablePackageValuesDiffRequest) (*corev1.GetAvailablePackageValuesDiffResponse, error) {
+	return s.response, nil
+}
+
+func TestGetAvailablePackageValuesDiff(t *testing.T) {
+	mockedValuesPlugin := makeVersionsTestPackagingPlugin("values-plugin", "2.0.0", "1.0.0")
+	mockedValuesPlugin.server.(*plugin_test.TestPackagingPluginServer).DefaultValues = map[string]string{
+		"1.0.0": "replicaCount: 1\nimage:\n  repository: app\n  tag: 1.0.0\nservice:\n  port: 80\nargs: [--verbose]\n",
+		"2.0.0": "replicaCount: 1\nimage:\n  repository: app\n  tag: 2.0.0\n  pullPolicy: IfNotPresent\nargs: [--verbose, --metrics]\n",
+	}
+	diffPlugin := &plugins.Plugin{Name: "diff-plugin", Version: "v1alpha1"}
+	mockedValuesDiffPlugin := &pkgsPluginWithServer{
+		plugin: diffPlugin,
+		server: valuesDiffPackagingPluginServer{
+			TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: diffPlugin},
+			response:                  &corev1.GetAvailablePackageValuesDiffResponse{ChangedKeys: []string{"image.tag"}},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		plugin           *pkgsPluginWithServer
+		fromPkgVersion   string
+		toPkgVersion     string
+		statusCode       codes.Code
+		expectedResponse *corev1.GetAvailablePackageValuesDiffResponse
+	}{
+		{
+			name:           "it compares the default values of two versions of the plugin",
+			plugin:         mockedValuesPlugin,
+			fromPkgVersion: "1.0.0",
+			toPkgVersion:   "2.0.0",
+			statusCode:     codes.OK,
+			expectedResponse: &corev1.GetAvailablePackageValuesDiffResponse{
+				AddedKeys:   []string{"image.pullPolicy"},
+				RemovedKeys: []string{"service.port"},
+				ChangedKeys: []string{"args", "image.tag"},
+			},
+		},
+		{
+			name:           "it compares the default values with the latest version by default",
+			plugin:         mockedValuesPlugin,
+			fromPkgVersion: "2.0.0",
+			statusCode:     codes.OK,
+			expectedResponse: &corev1.GetAvailablePackageValuesDiffResponse{
+				AddedKeys:   []string{},
+				RemovedKeys: []string{},
+				ChangedKeys: []string{},
+			},
+		},
+		{
+			name:             "it returns the diff of a plugin implementing it",
+			plugin:           mockedValuesDiffPlugin,
+			fromPkgVersion:   "1.0.0",
+			statusCode:       codes.OK,
+			expectedResponse: &corev1.GetAvailablePackageValuesDiffResponse{ChangedKeys: []string{"image.tag"}},
+		},
+		{
+			name:         "it fails without from version",
+			plugin:       mockedValuesPlugin,
+			toPkgVersion: "2.0.0",
+			statusCode:   codes.InvalidArgument,
+		},
+		{
+			name:           "it fails when a version is not present in the plugin",
+			plugin:         mockedValuesPlugin,
+			fromPkgVersion: "0.9.0",
+			statusCode:     codes.NotFound,
+		},
+		{
+			name:           "it fails when the plugin cannot provide the default values",
+			plugin:         makeVersionsTestPackagingPlugin("no-values-plugin", "1.0.0"),
+			fromPkgVersion: "1.0.0",
+			statusCode:     codes.Unimplemented,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{tc.plugin},
+			}
+			response, err := server.GetAvailablePackageValuesDiff(context.Background(), &corev1.GetAvailablePackageValuesDiffRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+					Identifier: "pkg-1",
+					Plugin:     tc.plugin.plugin,
+				},
+				FromPkgVersion: tc.fromPkgVersion,
+				ToPkgVersion:   tc.toPkgVersion,
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageDependencies(t *testing.T) {
+	testCases := []struct {
+		name              string
+		configuredPlugins []*pkgsPluginWithServer
+		statusCode        codes.Code
+		request           *corev1.GetAvailablePackageDependenciesRequest
+		expectedResponse  *corev1.GetAvailablePackageDependenciesResponse
+	}{
+		{
+			name: "it should return the dependency tree of a package with dependencies",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetAvailablePackageDependenciesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+					Plugin:     mockedPackagingPlugin1.plugin,
+				},
+				PkgVersion: plugin_test.DefaultPkgVersion,
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDependenciesResponse{
+				PackageDependencies: []*corev1.PackageDependency{
+					plugin_test.MakePackageDependency("dep-1", "1.x.x", plugin_test.DefaultRepoURL, "dep-1.enabled",
+						plugin_test.MakePackageDependency("dep-1-1", "~2.0.0", plugin_test.DefaultRepoURL, ""),
+					),
+					plugin_test.MakePackageDependency("dep-2", "3.0.0", plugin_test.DefaultRepoURL, ""),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return an empty dependency tree for a package without dependencies",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedNamespacedPackagingPlugin1,
+			},
+			request: &corev1.GetAvailablePackageDependenciesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+					Plugin:     mockedNamespacedPackagingPlugin1.plugin,
+				},
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDependenciesResponse{
+				PackageDependencies: []*corev1.PackageDependency{},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return an empty dependency tree when the plugin has no dependency information",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedUnimplementedPackagingPlugin,
+			},
+			request: &corev1.GetAvailablePackageDependenciesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+					Plugin:     mockedUnimplementedPackagingPlugin.plugin,
+				},
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageDependenciesResponse{
+				PackageDependencies: []*corev1.PackageDependency{},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail when calling the core GetAvailablePackageDependencies operation when the package is not present in a plugin",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.GetAvailablePackageDependenciesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+					Plugin:     mockedNotFoundPackagingPlugin.plugin,
+				},
+			},
+			statusCode: codes.NotFound,
+		},
+		{
+			name: "it should fail when the plugin is not included in the request",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.GetAvailablePackageDependenciesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: tc.configuredPlugins,
+			}
+			availablePackageDependencies, err := server.GetAvailablePackageDependencies(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := availablePackageDependencies, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageDefaultValues(t *testing.T) {
+	testCases := []struct {
+		name              string
+		configuredPlugins []*pkgsPluginWithServer
+		statusCode        codes.Code
+		request           *corev1.GetAvailablePackageDefaultValuesRequest
+		expectedResponse  *corev1.GetAvailablePackageDefaultValuesResponse
+	}{
+		{
+			name: "it should return the default values of the latest version by default",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetAvailablePackageDefaultValuesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+					Plugin:     mockedPackagingPlugin1.plugin,
+				},
+			},
+			expectedResponse: &corev1.GetAvailablePackageDefaultValuesResponse{
+				DefaultValues: "replicaCount: 2\n",
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return the default values of the requested version",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetAvailablePackageDefaultValuesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+					Plugin:     mockedPackagingPlugin2.plugin,
+				},
+				PkgVersion: plugin_test.DefaultPkgVersion,
+			},
+			expectedResponse: &corev1.GetAvailablePackageDefaultValuesResponse{
+				DefaultValues: "replicaCount: 1\n",
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail when the requested version is not present in the plugin",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.GetAvailablePackageDefaultValuesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+					Plugin:     mockedPackagingPlugin1.plugin,
+				},
+				PkgVersion: "3.0.0",
+			},
+			statusCode: codes.NotFound,
+		},
+		{
+			name: "it should return Unimplemented for a plugin without default values",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedUnimplementedPackagingPlugin,
+			},
+			request: &corev1.GetAvailablePackageDefaultValuesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+					Plugin:     mockedUnimplementedPackagingPlugin.plugin,
+				},
+			},
+			statusCode: codes.Unimplemented,
+		},
+		{
+			name: "it should fail without a plugin in the request",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.GetAvailablePackageDefaultValuesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Cluster:   "",
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "test",
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: tc.configuredPlugins,
+			}
+			defaultValues, err := server.GetAvailablePackageDefaultValues(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := defaultValues, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+// changelogPackagingPluginServer is a test plugin returning the entries of a
+// fixed changelog, ordered from the most recent version, between the
+// requested versions.
+type changelogPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	changelog []*corev1.PackageChangelogEntry
+}
+
+func (s changelogPackagingPluginServer) GetAvailablePackageChangelog(ctx context.Context, request *corev1.GetAvailablePackageChangelogRequest) (*corev1.GetAvailablePackageChangelogResponse, error) {
+	entries := []*corev1.PackageChangelogEntry{}
+	inRange := request.GetToPkgVersion() == ""
+	for _, entry := range s.changelog {
+		if entry.GetPkgVersion() == request.GetFromPkgVersion() {
+			break
+		}
+		if entry.GetPkgVersion() == request.GetToPkgVersion() {
+			inRange = true
+		}
+		if inRange {
+			entries = append(entries, entry)
+		}
+	}
+	return &corev1.GetAvailablePackageChangelogResponse{
+		ChangelogEntries: entries,
+	}, nil
+}
+
+func TestGetAvailablePackageChangelog(t *testing.T) {
+	changelogPlugin := &plugins.Plugin{Name: "changelog-plugin", Version: "v1alpha1"}
+	entry3 := &corev1.PackageChangelogEntry{PkgVersion: "3.0.0", Changes: []string{"Drop support for Kubernetes 1.19"}}
+	entry2 := &corev1.PackageChangelogEntry{PkgVersion: "2.0.0", Changes: []string{"Add metrics exporter", "Fix the probes"}}
+	entry1 := &corev1.PackageChangelogEntry{PkgVersion: "1.0.0", Changes: []string{"Initial release"}}
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: changelogPlugin,
+			server: changelogPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: changelogPlugin},
+				changelog:                 []*corev1.PackageChangelogEntry{entry3, entry2, entry1},
+			},
+		},
+		mockedPackagingPlugin1,
+	}
+
+	testCases := []struct {
+		name             string
+		plugin           *plugins.Plugin
+		fromPkgVersion   string
+		toPkgVersion     string
+		statusCode       codes.Code
+		expectedResponse *corev1.GetAvailablePackageChangelogResponse
+	}{
+		{
+			name:           "it returns the changelog between the installed version and the latest one by default",
+			plugin:         changelogPlugin,
+			fromPkgVersion: "1.0.0",
+			statusCode:     codes.OK,
+			expectedResponse: &corev1.GetAvailablePackageChangelogResponse{
+				ChangelogEntries: []*corev1.PackageChangelogEntry{entry3, entry2},
+			},
+		},
+		{
+			name:           "it returns the changelog between the installed version and the target one",
+			plugin:         changelogPlugin,
+			fromPkgVersion: "1.0.0",
+			toPkgVersion:   "2.0.0",
+			statusCode:     codes.OK,
+			expectedResponse: &corev1.GetAvailablePackageChangelogResponse{
+				ChangelogEntries: []*corev1.PackageChangelogEntry{entry2},
+			},
+		},
+		{
+			name:       "it returns unimplemented for a plugin without changelogs",
+			plugin:     mockedPackagingPlugin1.plugin,
+			statusCode: codes.Unimplemented,
+		},
+		{
+			name:       "it returns invalid argument if the plugin is not specified",
+			plugin:     nil,
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name:       "it returns internal error if unable to find the plugin",
+			plugin:     &plugins.Plugin{Name: "missing-plugin", Version: "v1alpha1"},
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: configuredPlugins,
+			}
+
+			response, err := server.GetAvailablePackageChangelog(context.Background(), &corev1.GetAvailablePackageChangelogRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: globalPackagingNamespace},
+					Identifier: "pkg-1",
+					Plugin:     tc.plugin,
+				},
+				FromPkgVersion: tc.fromPkgVersion,
+				ToPkgVersion:   tc.toPkgVersion,
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+// renderingPackagingPluginServer is a test plugin rendering the values as a
+// ConfigMap, failing to render values which are not a single key. It returns
+// the configured apply order, if any, whether requested or not.
+type renderingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+	applyOrder []*corev1.RenderedResource
+}
+
+func (s renderingPackagingPluginServer) RenderAvailablePackage(ctx context.Context, request *corev1.RenderAvailablePackageRequest) (*corev1.RenderAvailablePackageResponse, error) {
+	parts := strings.SplitN(request.GetValues(), ": ", 2)
+	if len(parts) != 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid values %q", request.GetValues())
+	}
+	return &corev1.RenderAvailablePackageResponse{
+		Manifest:   fmt.Sprintf("kind: ConfigMap\ndata:\n  %s: %s\n", parts[0], parts[1]),
+		ApplyOrder: s.applyOrder,
+	}, nil
+}
+
+func TestRenderAvailablePackage(t *testing.T) {
+	renderingPlugin := &plugins.Plugin{Name: "rendering-plugin", Version: "v1alpha1"}
+	orderingPlugin := &plugins.Plugin{Name: "ordering-plugin", Version: "v1alpha1"}
+	applyOrder := []*corev1.RenderedResource{
+		{ApiVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition", Name: "greetings.example.com"},
+		{ApiVersion: "v1", Kind: "Namespace", Name: "greetings"},
+		{ApiVersion: "v1", Kind: "ConfigMap", Name: "greeting", Namespace: "greetings"},
+	}
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: renderingPlugin,
+			server: renderingPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: renderingPlugin},
+			},
+		},
+		{
+			plugin: orderingPlugin,
+			server: renderingPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: orderingPlugin},
+				applyOrder:                applyOrder,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		plugin            *plugins.Plugin
+		values            string
+		includeApplyOrder bool
+		maxValuesSize     int
+		statusCode        codes.Code
+		expectedResponse  *corev1.RenderAvailablePackageResponse
+	}{
+		{
+			name:       "it returns the manifest rendered by the plugin",
+			plugin:     renderingPlugin,
+			values:     "greeting: hello",
+			statusCode: codes.OK,
+			expectedResponse: &corev1.RenderAvailablePackageResponse{
+				Manifest: "kind: ConfigMap\ndata:\n  greeting: hello\n",
+			},
+		},
+		{
+			name:              "it returns the apply order computed by a supporting plugin when requested",
+			plugin:            orderingPlugin,
+			values:            "greeting: hello",
+			includeApplyOrder: true,
+			statusCode:        codes.OK,
+			expectedResponse: &corev1.RenderAvailablePackageResponse{
+				Manifest:   "kind: ConfigMap\ndata:\n  greeting: hello\n",
+				ApplyOrder: applyOrder,
+			},
+		},
+		{
+			name:       "it does not return the apply order unless requested",
+			plugin:     orderingPlugin,
+			values:     "greeting: hello",
+			statusCode: codes.OK,
+			expectedResponse: &corev1.RenderAvailablePackageResponse{
+				Manifest: "kind: ConfigMap\ndata:\n  greeting: hello\n",
+			},
+		},
+		{
+			name:              "it returns no apply order for a plugin not supporting it",
+			plugin:            renderingPlugin,
+			values:            "greeting: hello",
+			includeApplyOrder: true,
+			statusCode:        codes.OK,
+			expectedResponse: &corev1.RenderAvailablePackageResponse{
+				Manifest: "kind: ConfigMap\ndata:\n  greeting: hello\n",
+			},
+		},
+		{
+			name:       "it returns the render error of the plugin for invalid values",
+			plugin:     renderingPlugin,
+			values:     "not values",
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name:          "it rejects values exceeding the maximum values size",
+			plugin:        renderingPlugin,
+			values:        "greeting: hello",
+			maxValuesSize: 5,
+			statusCode:    codes.InvalidArgument,
+		},
+		{
+			name:       "it returns invalid argument if the plugin is not specified",
+			plugin:     nil,
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name:       "it returns internal error if unable to find the plugin",
+			plugin:     &plugins.Plugin{Name: "missing-plugin", Version: "v1alpha1"},
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins:       configuredPlugins,
+				maxValuesSize: tc.maxValuesSize,
+			}
+
+			response, err := server.RenderAvailablePackage(context.Background(), &corev1.RenderAvailablePackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: globalPackagingNamespace},
+					Identifier: "pkg-1",
+					Plugin:     tc.plugin,
+				},
+				Values:            tc.values,
+				IncludeApplyOrder: tc.includeApplyOrder,
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+func TestCreateInstalledPackage(t *testing.T) {
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []*plugins.Plugin
+		statusCode        codes.Code
+		request           *corev1.CreateInstalledPackageRequest
+		expectedResponse  *corev1.CreateInstalledPackageResponse
+	}{
+		{
+			name: "installs the package using the correct plugin",
+			configuredPlugins: []*plugins.Plugin{
+				{Name: "plugin-1", Version: "v1alpha1"},
+				{Name: "plugin-1", Version: "v1alpha2"},
+			},
+			statusCode: codes.OK,
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "available-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+				TargetContext: &corev1.Context{
+					Cluster:   "default",
+					Namespace: "my-ns",
+				},
+				Name: "installed-pkg-1",
+			},
+			expectedResponse: &corev1.CreateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+			},
+		},
+		{
+			name:       "returns invalid argument if plugin not specified in request",
+			statusCode: codes.InvalidArgument,
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "available-pkg-1",
+				},
+				TargetContext: &corev1.Context{
+					Cluster:   "default",
+					Namespace: "my-ns",
+				},
+				Name: "installed-pkg-1",
+			},
+		},
+		{
+			name:       "returns internal error if unable to find the plugin",
+			statusCode: codes.Internal,
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "available-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+				TargetContext: &corev1.Context{
+					Cluster:   "default",
+					Namespace: "my-ns",
+				},
+				Name: "installed-pkg-1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configuredPluginServers := []*pkgsPluginWithServer{}
+			for _, p := range tc.configuredPlugins {
+				configuredPluginServers = append(configuredPluginServers, &pkgsPluginWithServer{
+					plugin: p,
+					server: plugin_test.TestPackagingPluginServer{Plugin: p},
+				})
+			}
+
+			server := &packagesServer{
+				plugins: configuredPluginServers,
+			}
+
+			installedPkgResponse, err := server.CreateInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := installedPkgResponse, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+// requestRecordingPackagingPluginServer is a test plugin recording the
+// CreateInstalledPackage request with which it is called.
+type requestRecordingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	request **corev1.CreateInstalledPackageRequest
+}
+
+func (s requestRecordingPackagingPluginServer) CreateInstalledPackage(ctx context.Context, request *corev1.CreateInstalledPackageRequest) (*corev1.CreateInstalledPackageResponse, error) {
+	*s.request = request
+	return s.TestPackagingPluginServer.CreateInstalledPackage(ctx, request)
+}
+
+func TestCreateInstalledPackageReconcileInterval(t *testing.T) {
+	testCases := []struct {
+		name                          string
+		reconcileInterval             string
+		reconciliationOptions         *corev1.ReconciliationOptions
+		statusCode                    codes.Code
+		expectedReconciliationOptions *corev1.ReconciliationOptions
+	}{
+		{
+			name:                          "it does not set the reconciliation options without a reconcile interval",
+			statusCode:                    codes.OK,
+			expectedReconciliationOptions: nil,
+		},
+		{
+			name:                          "it forwards the reconcile interval in seconds",
+			reconcileInterval:             "1h30m",
+			statusCode:                    codes.OK,
+			expectedReconciliationOptions: &corev1.ReconciliationOptions{Interval: 5400},
+		},
+		{
+			name:              "it overrides the interval of the reconciliation options",
+			reconcileInterval: "10m",
+			reconciliationOptions: &corev1.ReconciliationOptions{
+				Interval:           60,
+				ServiceAccountName: "my-sa",
+			},
+			statusCode: codes.OK,
+			expectedReconciliationOptions: &corev1.ReconciliationOptions{
+				Interval:           600,
+				ServiceAccountName: "my-sa",
+			},
+		},
+		{
+			name:              "it rejects a malformed reconcile interval",
+			reconcileInterval: "ten minutes",
+			statusCode:        codes.InvalidArgument,
+		},
+		{
+			name:              "it rejects a negative reconcile interval",
+			reconcileInterval: "-5m",
+			statusCode:        codes.InvalidArgument,
+		},
+		{
+			name:              "it rejects a reconcile interval which is not a whole number of seconds",
+			reconcileInterval: "1500ms",
+			statusCode:        codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+			var forwardedRequest *corev1.CreateInstalledPackageRequest
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: plugin,
+						server: requestRecordingPackagingPluginServer{
+							TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+							request:                   &forwardedRequest,
+						},
+					},
+				},
+			}
+
+			_, err := server.CreateInstalledPackage(context.Background(), &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "available-pkg-1",
+					Plugin:     plugin,
+				},
+				TargetContext:         &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+				Name:                  "installed-pkg-1",
+				ReconciliationOptions: tc.reconciliationOptions,
+				ReconcileInterval:     tc.reconcileInterval,
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				opts := cmpopts.IgnoreUnexported(corev1.ReconciliationOptions{})
+				if got, want := forwardedRequest.GetReconciliationOptions(), tc.expectedReconciliationOptions; !cmp.Equal(want, got, opts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+				}
+			} else if forwardedRequest != nil {
+				t.Errorf("got: %+v, want: the request not forwarded to the plugin", forwardedRequest)
+			}
+		})
+	}
+}
+
+func TestCreateInstalledPackageInstallTimeout(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		installTimeout         string
+		defaultInstallTimeout  time.Duration
+		pluginCallTimeout      time.Duration
+		pluginCallTimeouts     map[string]time.Duration
+		statusCode             codes.Code
+		expectedInstallTimeout string
+	}{
+		{
+			name:                   "it forwards no install timeout without default",
+			statusCode:             codes.OK,
+			expectedInstallTimeout: "",
+		},
+		{
+			name:                   "it forwards the requested install timeout",
+			installTimeout:         "5m",
+			statusCode:             codes.OK,
+			expectedInstallTimeout: "5m",
+		},
+		{
+			name:                   "it forwards the default install timeout when not requested",
+			defaultInstallTimeout:  10 * time.Minute,
+			statusCode:             codes.OK,
+			expectedInstallTimeout: "10m0s",
+		},
+		{
+			name:                   "it forwards the requested install timeout rather than the default one",
+			installTimeout:         "90s",
+			defaultInstallTimeout:  10 * time.Minute,
+			statusCode:             codes.OK,
+			expectedInstallTimeout: "90s",
+		},
+		{
+			name:                   "it forwards an install timeout within the call timeout of the plugin",
+			installTimeout:         "5m",
+			pluginCallTimeout:      10 * time.Minute,
+			statusCode:             codes.OK,
+			expectedInstallTimeout: "5m",
+		},
+		{
+			name:              "it rejects an install timeout exceeding the call timeout of the plugin",
+			installTimeout:    "15m",
+			pluginCallTimeout: 10 * time.Minute,
+			statusCode:        codes.InvalidArgument,
+		},
+		{
+			name:                  "it rejects a default install timeout exceeding the call timeout of the plugin",
+			defaultInstallTimeout: 10 * time.Minute,
+			pluginCallTimeouts:    map[string]time.Duration{"plugin-1": time.Minute},
+			statusCode:            codes.InvalidArgument,
+		},
+		{
+			name:           "it rejects a malformed install timeout",
+			installTimeout: "five minutes",
+			statusCode:     codes.InvalidArgument,
+		},
+		{
+			name:           "it rejects a negative install timeout",
+			installTimeout: "-5m",
+			statusCode:     codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+			var forwardedRequest *corev1.CreateInstalledPackageRequest
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: plugin,
+						server: requestRecordingPackagingPluginServer{
+							TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+							request:                   &forwardedRequest,
+						},
+					},
+				},
+				defaultInstallTimeout: tc.defaultInstallTimeout,
+				pluginCallTimeout:     tc.pluginCallTimeout,
+				pluginCallTimeouts:    tc.pluginCallTimeouts,
+			}
+
+			_, err := server.CreateInstalledPackage(context.Background(), &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "available-pkg-1",
+					Plugin:     plugin,
+				},
+				TargetContext:  &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+				Name:           "installed-pkg-1",
+				InstallTimeout: tc.installTimeout,
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := forwardedRequest.GetInstallTimeout(), tc.expectedInstallTimeout; got != want {
+					t.Errorf("got: %q, want: %q", got, want)
+				}
+			} else if forwardedRequest != nil {
+				t.Errorf("got: %+v, want: the request not forwarded to the plugin", forwardedRequest)
+			}
+		})
+	}
+}
+
+func TestCreateInstalledPackageLabelsAndAnnotations(t *testing.T) {
+	testCases := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		statusCode  codes.Code
+	}{
+		{
+			name:       "it forwards the request without labels nor annotations",
+			statusCode: codes.OK,
+		},
+		{
+			name:        "it forwards valid labels and annotations",
+			labels:      map[string]string{"team": "team-a", "example.com/tier": ""},
+			annotations: map[string]string{"example.com/description": "A free text description, with spaces."},
+			statusCode:  codes.OK,
+		},
+		{
+			name:       "it rejects an invalid label key",
+			labels:     map[string]string{"not a key": "team-a"},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name:       "it rejects an invalid label value",
+			labels:     map[string]string{"team": "team a"},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name:        "it rejects an invalid annotation key",
+			annotations: map[string]string{"-description": "A description"},
+			statusCode:  codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+			var forwardedRequest *corev1.CreateInstalledPackageRequest
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: plugin,
+						server: requestRecordingPackagingPluginServer{
+							TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+							request:                   &forwardedRequest,
+						},
+					},
+				},
+			}
+
+			_, err := server.CreateInstalledPackage(context.Background(), &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "available-pkg-1",
+					Plugin:     plugin,
+				},
+				TargetContext: &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+				Name:          "installed-pkg-1",
+				Labels:        tc.labels,
+				Annotations:   tc.annotations,
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := forwardedRequest.GetLabels(), tc.labels; !cmp.Equal(want, got, cmpopts.EquateEmpty()) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, cmpopts.EquateEmpty()))
+				}
+				if got, want := forwardedRequest.GetAnnotations(), tc.annotations; !cmp.Equal(want, got, cmpopts.EquateEmpty()) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, cmpopts.EquateEmpty()))
+				}
+			} else if forwardedRequest != nil {
+				t.Errorf("got: %+v, want: the request not forwarded to the plugin", forwardedRequest)
+			}
+		})
+	}
+}
+
+func TestMaxValuesSize(t *testing.T) {
+	plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+	installedPackageRef := &corev1.InstalledPackageReference{
+		Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+		Identifier: "installed-pkg-1",
+		Plugin:     plugin,
+	}
+
+	testCases := []struct {
+		name          string
+		maxValuesSize int
+		values        string
+		statusCode    codes.Code
+	}{
+		{
+			name:          "it accepts values just under the limit",
+			maxValuesSize: 10,
+			values:        strings.Repeat("a", 9),
+			statusCode:    codes.OK,
+		},
+		{
+			name:          "it accepts values of the limit size",
+			maxValuesSize: 10,
+			values:        strings.Repeat("a", 10),
+			statusCode:    codes.OK,
+		},
+		{
+			name:          "it rejects values just over the limit",
+			maxValuesSize: 10,
+			values:        strings.Repeat("a", 11),
+			statusCode:    codes.InvalidArgument,
+		},
+		{
+			name:          "it accepts any values without a limit",
+			maxValuesSize: 0,
+			values:        strings.Repeat("a", 1024),
+			statusCode:    codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: plugin,
+						server: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+					},
+				},
+				maxValuesSize: tc.maxValuesSize,
+			}
+
+			_, createErr := server.CreateInstalledPackage(context.Background(), &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "available-pkg-1",
+					Plugin:     plugin,
+				},
+				TargetContext: installedPackageRef.Context,
+				Name:          installedPackageRef.Identifier,
+				Values:        tc.values,
+			})
+			_, updateErr := server.UpdateInstalledPackage(context.Background(), &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: installedPackageRef,
+				Values:              tc.values,
+			})
+
+			for _, err := range []error{createErr, updateErr} {
+				if got, want := status.Code(err), tc.statusCode; got != want {
+					t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+				}
+				if tc.statusCode == codes.InvalidArgument && !strings.Contains(status.Convert(err).Message(), "maximum values size of 10 bytes") {
+					t.Errorf("got: %q, want: a message naming the limit", status.Convert(err).Message())
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateInstalledPackage(t *testing.T) {
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []*plugins.Plugin
+		statusCode        codes.Code
+		request           *corev1.UpdateInstalledPackageRequest
+		expectedResponse  *corev1.UpdateInstalledPackageResponse
+	}{
+		{
+			name: "updates the package using the correct plugin",
+			configuredPlugins: []*plugins.Plugin{
+				{Name: "plugin-1", Version: "v1alpha1"},
+				{Name: "plugin-1", Version: "v1alpha2"},
+			},
+			statusCode: codes.OK,
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+			},
+			expectedResponse: &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+			},
+		},
+		{
+			name:       "returns invalid argument if plugin not specified in request",
+			statusCode: codes.InvalidArgument,
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "available-pkg-1",
+				},
+			},
+		},
+		{
+			name:       "returns internal error if unable to find the plugin",
+			statusCode: codes.Internal,
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "available-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configuredPluginServers := []*pkgsPluginWithServer{}
+			for _, p := range tc.configuredPlugins {
+				configuredPluginServers = append(configuredPluginServers, &pkgsPluginWithServer{
+					plugin: p,
+					server: plugin_test.TestPackagingPluginServer{Plugin: p},
+				})
+			}
+
+			server := &packagesServer{
+				plugins: configuredPluginServers,
+			}
+
+			updatedPkgResponse, err := server.UpdateInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := updatedPkgResponse, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+// diffingPackagingPluginServer is a test plugin returning a fixed upgrade diff.
+type diffingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	diff string
+}
+
+func (s diffingPackagingPluginServer) GetInstalledPackageUpgradeDiff(ctx context.Context, request *corev1.GetInstalledPackageUpgradeDiffRequest) (*corev1.GetInstalledPackageUpgradeDiffResponse, error) {
+	return &corev1.GetInstalledPackageUpgradeDiffResponse{
+		Diff: s.diff,
+	}, nil
+}
+
+func TestGetInstalledPackageUpgradeDiff(t *testing.T) {
+	diffingPlugin := &plugins.Plugin{Name: "diffing-plugin", Version: "v1alpha1"}
+	noDiffPlugin := &plugins.Plugin{Name: "no-diff-plugin", Version: "v1alpha1"}
+	diff := `--- installed
++++ target
+@@ -1,3 +1,3 @@
+ kind: Deployment
+-image: nginx:1.20
++image: nginx:1.21
+`
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: diffingPlugin,
+			server: diffingPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: diffingPlugin},
+				diff:                      diff,
+			},
+		},
+		{
+			plugin: noDiffPlugin,
+			server: plugin_test.TestPackagingPluginServer{Plugin: noDiffPlugin},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		plugin           *plugins.Plugin
+		statusCode       codes.Code
+		expectedResponse *corev1.GetInstalledPackageUpgradeDiffResponse
+	}{
+		{
+			name:       "it returns the diff of the plugin of the installed package",
+			plugin:     diffingPlugin,
+			statusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageUpgradeDiffResponse{
+				Diff: diff,
+			},
+		},
+		{
+			name:       "it returns unimplemented for a plugin without diff support",
+			plugin:     noDiffPlugin,
+			statusCode: codes.Unimplemented,
+		},
+		{
+			name:       "it returns invalid argument if the plugin is not specified",
+			plugin:     nil,
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name:       "it returns internal error if unable to find the plugin",
+			plugin:     &plugins.Plugin{Name: "missing-plugin", Version: "v1alpha1"},
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: configuredPlugins,
+			}
+
+			response, err := server.GetInstalledPackageUpgradeDiff(context.Background(), &corev1.GetInstalledPackageUpgradeDiffRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     tc.plugin,
+				},
+				PkgVersionReference: &corev1.VersionReference{Version: "2.0.0"},
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+// driftingPackagingPluginServer is a test plugin returning a fixed drift.
+type driftingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	resources []*corev1.ResourceDrift
+}
+
+func (s driftingPackagingPluginServer) GetInstalledPackageDrift(ctx context.Context, request *corev1.GetInstalledPackageDriftRequest) (*corev1.GetInstalledPackageDriftResponse, error) {
+	return &corev1.GetInstalledPackageDriftResponse{
+		Drifted:   len(s.resources) > 0,
+		Resources: s.resources,
+	}, nil
+}
+
+func TestGetInstalledPackageDrift(t *testing.T) {
+	driftingPlugin := &plugins.Plugin{Name: "drifting-plugin", Version: "v1alpha1"}
+	noDriftPlugin := &plugins.Plugin{Name: "no-drift-plugin", Version: "v1alpha1"}
+	resources := []*corev1.ResourceDrift{
+		{
+			ApiVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "my-deployment",
+			Namespace:  "my-ns",
+			Fields: []*corev1.FieldDrift{
+				{Path: "spec.replicas", Expected: "3", Actual: "1"},
+			},
+		},
+	}
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: driftingPlugin,
+			server: driftingPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: driftingPlugin},
+				resources:                 resources,
+			},
+		},
+		{
+			plugin: noDriftPlugin,
+			server: plugin_test.TestPackagingPluginServer{Plugin: noDriftPlugin},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		plugin           *plugins.Plugin
+		statusCode       codes.Code
+		expectedResponse *corev1.GetInstalledPackageDriftResponse
+	}{
+		{
+			name:       "it returns the drift of the plugin of the installed package",
+			plugin:     driftingPlugin,
+			statusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageDriftResponse{
+				Drifted:   true,
+				Resources: resources,
+			},
+		},
+		{
+			name:       "it returns unimplemented for a plugin without drift support",
+			plugin:     noDriftPlugin,
+			statusCode: codes.Unimplemented,
+		},
+		{
+			name:       "it returns invalid argument if the plugin is not specified",
+			plugin:     nil,
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name:       "it returns internal error if unable to find the plugin",
+			plugin:     &plugins.Plugin{Name: "missing-plugin", Version: "v1alpha1"},
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: configuredPlugins,
+			}
+
+			response, err := server.GetInstalledPackageDrift(context.Background(), &corev1.GetInstalledPackageDriftRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     tc.plugin,
+				},
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+// makeUpdateTestPackagingPlugin returns a test plugin with the default
+// installed package detail and the given available package versions.
+func makeUpdateTestPackagingPlugin(pluginName string, pkgVersions ...string) *pkgsPluginWithServer {
+	pluginWithServer := makeVersionsTestPackagingPlugin(pluginName, pkgVersions...)
+	pluginWithServer.server.(*plugin_test.TestPackagingPluginServer).InstalledPackageDetail = plugin_test.MakeInstalledPackageDetail("pkg-1", pluginWithServer.plugin)
+	return pluginWithServer
+}
+
+func TestGetInstalledPackageUpdateAvailable(t *testing.T) {
+	upToDatePlugin := makeUpdateTestPackagingPlugin("up-to-date-plugin", "1.0.0", plugin_test.DefaultReleaseVersion, "not-semver")
+	noVersionsPlugin := makeUpdateTestPackagingPlugin("no-versions-plugin", "not-semver")
+	prereleasePlugin := makeUpdateTestPackagingPlugin("prerelease-plugin", plugin_test.DefaultReleaseVersion, "2.0.0-rc.1")
+
+	testCases := []struct {
+		name              string
+		plugin            *plugins.Plugin
+		includePrerelease bool
+		statusCode        codes.Code
+		expectedResponse  *corev1.GetInstalledPackageUpdateAvailableResponse
+	}{
+		{
+			name:       "it returns an update when a newer version is available",
+			plugin:     mockedPackagingPlugin1.plugin,
+			statusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageUpdateAvailableResponse{
+				UpdateAvailable: true,
+				LatestVersion:   plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultPkgUpdateVersion),
+			},
+		},
+		{
+			name:       "it returns no update when the installed version is the latest one",
+			plugin:     upToDatePlugin.plugin,
+			statusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageUpdateAvailableResponse{
+				UpdateAvailable: false,
+				LatestVersion:   plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultReleaseVersion),
+			},
+		},
+		{
+			name:       "it returns no update when only a pre-release version is newer",
+			plugin:     prereleasePlugin.plugin,
+			statusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageUpdateAvailableResponse{
+				UpdateAvailable: false,
+				LatestVersion:   plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultReleaseVersion),
+			},
+		},
+		{
+			name:              "it returns a pre-release update if included",
+			plugin:            prereleasePlugin.plugin,
+			includePrerelease: true,
+			statusCode:        codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageUpdateAvailableResponse{
+				UpdateAvailable: true,
+				LatestVersion:   plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, "2.0.0-rc.1"),
+			},
+		},
+		{
+			name:       "it returns not found when no valid version is available",
+			plugin:     noVersionsPlugin.plugin,
+			statusCode: codes.NotFound,
+		},
+		{
+			name:       "it returns the error of the plugin getting the installed package",
+			plugin:     mockedNotFoundPackagingPlugin.plugin,
+			statusCode: codes.NotFound,
+		},
+		{
+			name:       "it returns invalid argument without a plugin",
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					mockedPackagingPlugin1,
+					mockedNotFoundPackagingPlugin,
+					upToDatePlugin,
+					noVersionsPlugin,
+					prereleasePlugin,
+				},
+			}
+
+			response, err := server.GetInstalledPackageUpdateAvailable(context.Background(), &corev1.GetInstalledPackageUpdateAvailableRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     tc.plugin,
+				},
+				IncludePrerelease: tc.includePrerelease,
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+		})
+	}
+}
+
+// valuesPackagingPluginServer is a test plugin returning fixed applied values.
+type valuesPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	values string
+}
+
+func (s valuesPackagingPluginServer) GetInstalledPackageValues(ctx context.Context, request *corev1.GetInstalledPackageValuesRequest) (*corev1.GetInstalledPackageValuesResponse, error) {
+	return &corev1.GetInstalledPackageValuesResponse{
+		Values: s.values,
+	}, nil
+}
+
+func TestGetInstalledPackageValues(t *testing.T) {
+	valuesPlugin := &plugins.Plugin{Name: "values-plugin", Version: "v1alpha1"}
+	noValuesPlugin := &plugins.Plugin{Name: "no-values-plugin", Version: "v1alpha1"}
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: valuesPlugin,
+			server: valuesPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: valuesPlugin},
+				values:                    "replicaCount: 2\n",
+			},
+		},
+		{
+			plugin: noValuesPlugin,
+			server: plugin_test.TestPackagingPluginServer{Plugin: noValuesPlugin},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		plugin           *plugins.Plugin
+		statusCode       codes.Code
+		expectedResponse *corev1.GetInstalledPackageValuesResponse
+	}{
+		{
+			name:       "it returns the values of the plugin of the installed package",
+			plugin:     valuesPlugin,
+			statusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageValuesResponse{
+				Values: "replicaCount: 2\n",
+			},
+		},
+		{
+			name:       "it returns unimplemented for a plugin without values support",
+			plugin:     noValuesPlugin,
+			statusCode: codes.Unimplemented,
+		},
+		{
+			name:       "it returns invalid argument if the plugin is not specified",
+			plugin:     nil,
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name:       "it returns internal error if unable to find the plugin",
+			plugin:     &plugins.Plugin{Name: "missing-plugin", Version: "v1alpha1"},
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: configuredPlugins,
+			}
+
+			response, err := server.GetInstalledPackageValues(context.Background(), &corev1.GetInstalledPackageValuesRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     tc.plugin,
+				},
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
 			if tc.statusCode == codes.OK {
-				if got, want := installedPackageSummaries, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+// exportingPackagingPluginServer is a test plugin returning a fixed manifest.
+type exportingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	manifest string
+}
+
+func (s exportingPackagingPluginServer) ExportInstalledPackage(ctx context.Context, request *corev1.ExportInstalledPackageRequest) (*corev1.ExportInstalledPackageResponse, error) {
+	return &corev1.ExportInstalledPackageResponse{
+		Manifest: s.manifest,
+	}, nil
+}
+
+func TestExportInstalledPackage(t *testing.T) {
+	exportingPlugin := &plugins.Plugin{Name: "exporting-plugin", Version: "v1alpha1"}
+	noExportPlugin := &plugins.Plugin{Name: "no-export-plugin", Version: "v1alpha1"}
+	manifest := "apiVersion: helm.toolkit.fluxcd.io/v2beta1\nkind: HelmRelease\nmetadata:\n  name: installed-pkg-1\n  namespace: my-ns\n"
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: exportingPlugin,
+			server: exportingPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: exportingPlugin},
+				manifest:                  manifest,
+			},
+		},
+		{
+			plugin: noExportPlugin,
+			server: plugin_test.TestPackagingPluginServer{Plugin: noExportPlugin},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		plugin           *plugins.Plugin
+		statusCode       codes.Code
+		expectedResponse *corev1.ExportInstalledPackageResponse
+	}{
+		{
+			name:       "it returns the manifest exported by the plugin of the installed package",
+			plugin:     exportingPlugin,
+			statusCode: codes.OK,
+			expectedResponse: &corev1.ExportInstalledPackageResponse{
+				Manifest: manifest,
+			},
+		},
+		{
+			name:       "it returns unimplemented for a plugin without export support",
+			plugin:     noExportPlugin,
+			statusCode: codes.Unimplemented,
+		},
+		{
+			name:       "it returns invalid argument if the plugin is not specified",
+			plugin:     nil,
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name:       "it returns internal error if unable to find the plugin",
+			plugin:     &plugins.Plugin{Name: "missing-plugin", Version: "v1alpha1"},
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: configuredPlugins,
+			}
+
+			response, err := server.ExportInstalledPackage(context.Background(), &corev1.ExportInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     tc.plugin,
+				},
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
 					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
 				}
 			}
@@ -428,55 +5144,561 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 	}
 }
 
-func TestGetInstalledPackageDetail(t *testing.T) {
+func TestDeleteInstalledPackage(t *testing.T) {
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []*plugins.Plugin
+		statusCode        codes.Code
+		request           *corev1.DeleteInstalledPackageRequest
+	}{
+		{
+			name: "deletes the package",
+			configuredPlugins: []*plugins.Plugin{
+				{Name: "plugin-1", Version: "v1alpha1"},
+				{Name: "plugin-1", Version: "v1alpha2"},
+			},
+			statusCode: codes.OK,
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+			},
+		},
+		{
+			name:       "returns invalid argument if plugin not specified in request",
+			statusCode: codes.InvalidArgument,
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "available-pkg-1",
+				},
+			},
+		},
+		{
+			name:       "returns internal error if unable to find the plugin",
+			statusCode: codes.Internal,
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "available-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configuredPluginServers := []*pkgsPluginWithServer{}
+			for _, p := range tc.configuredPlugins {
+				configuredPluginServers = append(configuredPluginServers, &pkgsPluginWithServer{
+					plugin: p,
+					server: plugin_test.TestPackagingPluginServer{Plugin: p},
+				})
+			}
+
+			server := &packagesServer{
+				plugins: configuredPluginServers,
+			}
+
+			_, err := server.DeleteInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+		})
+	}
+}
+
+func TestDeleteInstalledPackagesBatch(t *testing.T) {
+	installedPackageRef := func(identifier string, plugin *plugins.Plugin) *corev1.InstalledPackageReference {
+		return &corev1.InstalledPackageReference{
+			Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+			Identifier: identifier,
+			Plugin:     plugin,
+		}
+	}
+	refs := []*corev1.InstalledPackageReference{
+		installedPackageRef("installed-pkg-1", mockedPackagingPlugin1.plugin),
+		installedPackageRef("installed-pkg-2", mockedNotFoundPackagingPlugin.plugin),
+		installedPackageRef("installed-pkg-3", &plugins.Plugin{Name: "unknown-plugin", Version: "v1alpha1"}),
+		installedPackageRef("installed-pkg-4", nil),
+		installedPackageRef("installed-pkg-5", mockedPackagingPlugin2.plugin),
+	}
+	expectedCodes := []codes.Code{codes.OK, codes.NotFound, codes.Internal, codes.InvalidArgument, codes.OK}
+
+	testCases := []struct {
+		name       string
+		concurrent bool
+	}{
+		{
+			name:       "it deletes each installed package in turn, returning each result",
+			concurrent: false,
+		},
+		{
+			name:       "it deletes the installed packages concurrently, returning each result",
+			concurrent: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					mockedPackagingPlugin1,
+					mockedNotFoundPackagingPlugin,
+					mockedPackagingPlugin2,
+				},
+			}
+
+			response, err := server.DeleteInstalledPackagesBatch(context.Background(), &corev1.DeleteInstalledPackagesBatchRequest{
+				InstalledPackageRefs: refs,
+				Concurrent:           tc.concurrent,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := len(response.GetResults()), len(refs); got != want {
+				t.Fatalf("got: %d results, want: %d results", got, want)
+			}
+			for i, result := range response.GetResults() {
+				if got, want := result.GetInstalledPackageRef().GetIdentifier(), refs[i].GetIdentifier(); got != want {
+					t.Errorf("got: %q, want: %q", got, want)
+				}
+				if got, want := codes.Code(result.GetStatusCode()), expectedCodes[i]; got != want {
+					t.Errorf("got: %+v, want: %+v for %q", got, want, refs[i].GetIdentifier())
+				}
+				if got, want := result.GetErrorMessage() != "", expectedCodes[i] != codes.OK; got != want {
+					t.Errorf("got error message: %q, want error message: %t", result.GetErrorMessage(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageDetailsBatch(t *testing.T) {
+	installedPackageRef := func(identifier string, plugin *plugins.Plugin) *corev1.InstalledPackageReference {
+		return &corev1.InstalledPackageReference{
+			Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+			Identifier: identifier,
+			Plugin:     plugin,
+		}
+	}
+	refs := []*corev1.InstalledPackageReference{
+		installedPackageRef("installed-pkg-1", mockedPackagingPlugin1.plugin),
+		installedPackageRef("installed-pkg-2", mockedNotFoundPackagingPlugin.plugin),
+		installedPackageRef("installed-pkg-3", &plugins.Plugin{Name: "unknown-plugin", Version: "v1alpha1"}),
+		installedPackageRef("installed-pkg-4", nil),
+		installedPackageRef("installed-pkg-5", mockedPackagingPlugin2.plugin),
+	}
+	expectedCodes := []codes.Code{codes.OK, codes.NotFound, codes.Internal, codes.InvalidArgument, codes.OK}
+	expectedDetails := []*corev1.InstalledPackageDetail{
+		plugin_test.MakeInstalledPackageDetail("pkg-1", mockedPackagingPlugin1.plugin),
+		nil,
+		nil,
+		nil,
+		plugin_test.MakeInstalledPackageDetail("pkg-1", mockedPackagingPlugin2.plugin),
+	}
+
+	server := &packagesServer{
+		plugins: []*pkgsPluginWithServer{
+			mockedPackagingPlugin1,
+			mockedNotFoundPackagingPlugin,
+			mockedPackagingPlugin2,
+		},
+	}
+
+	response, err := server.GetInstalledPackageDetailsBatch(context.Background(), &corev1.GetInstalledPackageDetailsBatchRequest{
+		InstalledPackageRefs: refs,
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if got, want := len(response.GetResults()), len(refs); got != want {
+		t.Fatalf("got: %d results, want: %d results", got, want)
+	}
+	for i, result := range response.GetResults() {
+		if got, want := result.GetInstalledPackageRef().GetIdentifier(), refs[i].GetIdentifier(); got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+		if got, want := codes.Code(result.GetStatusCode()), expectedCodes[i]; got != want {
+			t.Errorf("got: %+v, want: %+v for %q", got, want, refs[i].GetIdentifier())
+		}
+		if got, want := result.GetErrorMessage() != "", expectedCodes[i] != codes.OK; got != want {
+			t.Errorf("got error message: %q, want error message: %t", result.GetErrorMessage(), want)
+		}
+		if got, want := result.GetInstalledPackageDetail(), expectedDetails[i]; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+			t.Errorf("mismatch for %q (-want +got):\n%s", refs[i].GetIdentifier(), cmp.Diff(want, got, ignoreUnexportedOpts))
+		}
+	}
+}
+
+// concurrencyCountingPackagingPluginServer records the maximum number of its
+// installed package or version listing calls running at the same time.
+type concurrencyCountingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+	mutex         *sync.Mutex
+	running       *int
+	maxConcurrent *int
+}
+
+func (s concurrencyCountingPackagingPluginServer) count() {
+	s.mutex.Lock()
+	*s.running++
+	if *s.running > *s.maxConcurrent {
+		*s.maxConcurrent = *s.running
+	}
+	s.mutex.Unlock()
+
+	time.Sleep(50 * time.Millisecond)
+
+	s.mutex.Lock()
+	*s.running--
+	s.mutex.Unlock()
+}
+
+func (s concurrencyCountingPackagingPluginServer) GetInstalledPackageDetail(ctx context.Context, request *corev1.GetInstalledPackageDetailRequest) (*corev1.GetInstalledPackageDetailResponse, error) {
+	s.count()
+	return s.TestPackagingPluginServer.GetInstalledPackageDetail(ctx, request)
+}
+
+func (s concurrencyCountingPackagingPluginServer) DeleteInstalledPackage(ctx context.Context, request *corev1.DeleteInstalledPackageRequest) (*corev1.DeleteInstalledPackageResponse, error) {
+	s.count()
+	return s.TestPackagingPluginServer.DeleteInstalledPackage(ctx, request)
+}
+
+func (s concurrencyCountingPackagingPluginServer) GetAvailablePackageVersions(ctx context.Context, request *corev1.GetAvailablePackageVersionsRequest) (*corev1.GetAvailablePackageVersionsResponse, error) {
+	s.count()
+	return s.TestPackagingPluginServer.GetAvailablePackageVersions(ctx, request)
+}
+
+func TestBatchConcurrency(t *testing.T) {
+	testCases := []struct {
+		name             string
+		batchConcurrency int
+		// batch calls the batch rpc with the references.
+		batch                 func(s *packagesServer, refs []*corev1.InstalledPackageReference) error
+		expectedMaxConcurrent int
+	}{
+		{
+			name:             "it limits the concurrent details of a batch",
+			batchConcurrency: 2,
+			batch: func(s *packagesServer, refs []*corev1.InstalledPackageReference) error {
+				_, err := s.GetInstalledPackageDetailsBatch(context.Background(), &corev1.GetInstalledPackageDetailsBatchRequest{
+					InstalledPackageRefs: refs,
+				})
+				return err
+			},
+			expectedMaxConcurrent: 2,
+		},
+		{
+			name:             "it limits the concurrent deletions of a batch",
+			batchConcurrency: 3,
+			batch: func(s *packagesServer, refs []*corev1.InstalledPackageReference) error {
+				_, err := s.DeleteInstalledPackagesBatch(context.Background(), &corev1.DeleteInstalledPackagesBatchRequest{
+					InstalledPackageRefs: refs,
+					Concurrent:           true,
+				})
+				return err
+			},
+			expectedMaxConcurrent: 3,
+		},
+		{
+			name: "it does not limit the concurrency without a batch concurrency",
+			batch: func(s *packagesServer, refs []*corev1.InstalledPackageReference) error {
+				_, err := s.GetInstalledPackageDetailsBatch(context.Background(), &corev1.GetInstalledPackageDetailsBatchRequest{
+					InstalledPackageRefs: refs,
+				})
+				return err
+			},
+			expectedMaxConcurrent: 8,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "counting-plugin", Version: "v1alpha1"}
+			running, maxConcurrent := 0, 0
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: plugin,
+						server: concurrencyCountingPackagingPluginServer{
+							TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{
+								Plugin:                 plugin,
+								InstalledPackageDetail: plugin_test.MakeInstalledPackageDetail("pkg-1", plugin),
+							},
+							mutex:         &sync.Mutex{},
+							running:       &running,
+							maxConcurrent: &maxConcurrent,
+						},
+					},
+				},
+				batchConcurrency: tc.batchConcurrency,
+			}
+			refs := []*corev1.InstalledPackageReference{}
+			for i := 0; i < 8; i++ {
+				refs = append(refs, &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: fmt.Sprintf("installed-pkg-%d", i),
+					Plugin:     plugin,
+				})
+			}
+
+			if err := tc.batch(server, refs); err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if tc.batchConcurrency > 0 {
+				if got, limit := maxConcurrent, tc.batchConcurrency; got > limit {
+					t.Errorf("got: %d concurrent calls, want at most: %d", got, limit)
+				}
+			}
+			if got, want := maxConcurrent, tc.expectedMaxConcurrent; got != want {
+				t.Errorf("got: %d concurrent calls, want: %d", got, want)
+			}
+		})
+	}
+}
+
+// cancelObservingPackagingPluginServer blocks the installed package calls
+// for the "blocking-" identifiers until their context is canceled, recording
+// the calls started and the ones which returned after observing it.
+type cancelObservingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+	mutex    *sync.Mutex
+	started  chan string
+	canceled *int
+}
+
+func (s cancelObservingPackagingPluginServer) block(ctx context.Context, identifier string) error {
+	if !strings.HasPrefix(identifier, "blocking-") {
+		return nil
+	}
+	s.started <- identifier
+	<-ctx.Done()
+	s.mutex.Lock()
+	*s.canceled++
+	s.mutex.Unlock()
+	return status.FromContextError(ctx.Err()).Err()
+}
+
+func (s cancelObservingPackagingPluginServer) GetInstalledPackageDetail(ctx context.Context, request *corev1.GetInstalledPackageDetailRequest) (*corev1.GetInstalledPackageDetailResponse, error) {
+	if err := s.block(ctx, request.GetInstalledPackageRef().GetIdentifier()); err != nil {
+		return nil, err
+	}
+	return s.TestPackagingPluginServer.GetInstalledPackageDetail(ctx, request)
+}
+
+func (s cancelObservingPackagingPluginServer) DeleteInstalledPackage(ctx context.Context, request *corev1.DeleteInstalledPackageRequest) (*corev1.DeleteInstalledPackageResponse, error) {
+	if err := s.block(ctx, request.GetInstalledPackageRef().GetIdentifier()); err != nil {
+		return nil, err
+	}
+	return s.TestPackagingPluginServer.DeleteInstalledPackage(ctx, request)
+}
+
+func TestBatchCancellation(t *testing.T) {
+	testCases := []struct {
+		name             string
+		identifiers      []string
+		batchConcurrency int
+		// batch calls the batch rpc with the references, returning the
+		// status code of each result.
+		batch func(ctx context.Context, s *packagesServer, refs []*corev1.InstalledPackageReference) ([]codes.Code, error)
+		// expectedStarted is the number of blocking calls started before
+		// the cancellation, all of which must observe it.
+		expectedStarted int
+		expectedCode    codes.Code
+	}{
+		{
+			name:            "it cancels the in-flight details of a batch and discards the results",
+			identifiers:     []string{"fast-1", "blocking-1", "blocking-2", "blocking-3"},
+			batch:           getInstalledPackageDetailsBatchCodes,
+			expectedStarted: 3,
+			expectedCode:    codes.Canceled,
+		},
+		{
+			name:            "it cancels the in-flight deletions of a batch and discards the results",
+			identifiers:     []string{"blocking-1", "blocking-2", "fast-1"},
+			batch:           deleteInstalledPackagesBatchCodes(true),
+			expectedStarted: 2,
+			expectedCode:    codes.Canceled,
+		},
+		{
+			name:            "it stops the deletions of a batch in turn once canceled and discards the results",
+			identifiers:     []string{"blocking-1", "blocking-2", "fast-1"},
+			batch:           deleteInstalledPackagesBatchCodes(false),
+			expectedStarted: 1,
+			expectedCode:    codes.Canceled,
+		},
+		{
+			name:             "it does not process the references still queued when canceled",
+			identifiers:      []string{"blocking-1", "blocking-2", "blocking-3", "blocking-4", "fast-1"},
+			batchConcurrency: 2,
+			batch:            getInstalledPackageDetailsBatchCodes,
+			expectedStarted:  2,
+			expectedCode:     codes.Canceled,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "blocking-plugin", Version: "v1alpha1"}
+			canceled := 0
+			started := make(chan string, len(tc.identifiers))
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: plugin,
+						server: cancelObservingPackagingPluginServer{
+							TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{
+								Plugin:                 plugin,
+								InstalledPackageDetail: plugin_test.MakeInstalledPackageDetail("pkg-1", plugin),
+							},
+							mutex:    &sync.Mutex{},
+							started:  started,
+							canceled: &canceled,
+						},
+					},
+				},
+				batchConcurrency: tc.batchConcurrency,
+			}
+			refs := []*corev1.InstalledPackageReference{}
+			for _, identifier := range tc.identifiers {
+				refs = append(refs, &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: identifier,
+					Plugin:     plugin,
+				})
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			type batchResult struct {
+				codes []codes.Code
+				err   error
+			}
+			done := make(chan batchResult)
+			go func() {
+				codes, err := tc.batch(ctx, server, refs)
+				done <- batchResult{codes, err}
+			}()
+
+			// Cancel the request once the expected blocking calls are in flight.
+			for i := 0; i < tc.expectedStarted; i++ {
+				select {
+				case <-started:
+				case <-time.After(5 * time.Second):
+					t.Fatalf("got: %d blocking calls started, want: %d", i, tc.expectedStarted)
+				}
+			}
+			cancel()
+
+			var result batchResult
+			select {
+			case result = <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("the batch did not return after the cancellation")
+			}
+
+			if got, want := status.Code(result.err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, result.err)
+			}
+			if result.codes != nil {
+				t.Errorf("got: %+v results, want: none", result.codes)
+			}
+			// All the calls have returned, after observing the cancellation,
+			// and no queued reference was processed after it.
+			if got, want := canceled, tc.expectedStarted; got != want {
+				t.Errorf("got: %d calls observing the cancellation, want: %d", got, want)
+			}
+			if got := len(started); got != 0 {
+				t.Errorf("got: %d blocking calls started after the cancellation, want: 0", got)
+			}
+		})
+	}
+}
+
+func getInstalledPackageDetailsBatchCodes(ctx context.Context, s *packagesServer, refs []*corev1.InstalledPackageReference) ([]codes.Code, error) {
+	response, err := s.GetInstalledPackageDetailsBatch(ctx, &corev1.GetInstalledPackageDetailsBatchRequest{
+		InstalledPackageRefs: refs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resultCodes := []codes.Code{}
+	for _, result := range response.GetResults() {
+		resultCodes = append(resultCodes, codes.Code(result.GetStatusCode()))
+	}
+	return resultCodes, nil
+}
+
+func deleteInstalledPackagesBatchCodes(concurrent bool) func(ctx context.Context, s *packagesServer, refs []*corev1.InstalledPackageReference) ([]codes.Code, error) {
+	return func(ctx context.Context, s *packagesServer, refs []*corev1.InstalledPackageReference) ([]codes.Code, error) {
+		response, err := s.DeleteInstalledPackagesBatch(ctx, &corev1.DeleteInstalledPackagesBatchRequest{
+			InstalledPackageRefs: refs,
+			Concurrent:           concurrent,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resultCodes := []codes.Code{}
+		for _, result := range response.GetResults() {
+			resultCodes = append(resultCodes, codes.Code(result.GetStatusCode()))
+		}
+		return resultCodes, nil
+	}
+}
+
+func TestGetCatalogStats(t *testing.T) {
 	testCases := []struct {
 		name              string
 		configuredPlugins []*pkgsPluginWithServer
 		statusCode        codes.Code
-		request           *corev1.GetInstalledPackageDetailRequest
-		expectedResponse  *corev1.GetInstalledPackageDetailResponse
+		expectedResponse  *corev1.GetCatalogStatsResponse
 	}{
 		{
-			name: "it should successfully call the core GetInstalledPackageDetail operation",
+			name: "it should aggregate the stats of the packages of each plugin",
 			configuredPlugins: []*pkgsPluginWithServer{
 				mockedPackagingPlugin1,
 				mockedPackagingPlugin2,
 			},
-			request: &corev1.GetInstalledPackageDetailRequest{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Context: &corev1.Context{
-						Cluster:   "",
-						Namespace: globalPackagingNamespace,
+			statusCode: codes.OK,
+			expectedResponse: &corev1.GetCatalogStatsResponse{
+				CatalogStats: &corev1.CatalogStats{
+					AvailablePackageCount: 4,
+					InstalledPackageCount: 4,
+					PluginStats: []*corev1.PluginCatalogStats{
+						{
+							Plugin:                mockedPackagingPlugin1.plugin,
+							AvailablePackageCount: 2,
+							InstalledPackageCount: 2,
+						},
+						{
+							Plugin:                mockedPackagingPlugin2.plugin,
+							AvailablePackageCount: 2,
+							InstalledPackageCount: 2,
+						},
+					},
+					CategoryStats: []*corev1.CategoryCatalogStats{
+						{
+							Category:              plugin_test.DefaultCategory,
+							AvailablePackageCount: 4,
+						},
 					},
-					Identifier: "pkg-1",
-					Plugin:     mockedPackagingPlugin1.plugin,
 				},
 			},
-
-			expectedResponse: &corev1.GetInstalledPackageDetailResponse{
-				InstalledPackageDetail: plugin_test.MakeInstalledPackageDetail("pkg-1", mockedPackagingPlugin1.plugin),
-			},
-			statusCode: codes.OK,
 		},
 		{
-			name: "it should fail when calling the core GetInstalledPackageDetail operation when the package is not present in a plugin",
+			name: "it should fail when a plugin fails",
 			configuredPlugins: []*pkgsPluginWithServer{
 				mockedPackagingPlugin1,
 				mockedNotFoundPackagingPlugin,
 			},
-			request: &corev1.GetInstalledPackageDetailRequest{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Context: &corev1.Context{
-						Cluster:   "",
-						Namespace: globalPackagingNamespace,
-					},
-					Identifier: "pkg-1",
-					Plugin:     mockedNotFoundPackagingPlugin.plugin,
-				},
-			},
-
-			expectedResponse: &corev1.GetInstalledPackageDetailResponse{},
-			statusCode:       codes.NotFound,
+			statusCode: codes.NotFound,
 		},
 	}
 
@@ -485,14 +5707,19 @@ func TestGetInstalledPackageDetail(t *testing.T) {
 			server := &packagesServer{
 				plugins: tc.configuredPlugins,
 			}
-			installedPackageDetail, err := server.GetInstalledPackageDetail(context.Background(), tc.request)
+			response, err := server.GetCatalogStats(context.Background(), &corev1.GetCatalogStatsRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			})
 
 			if got, want := status.Code(err), tc.statusCode; got != want {
 				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
 			}
 
 			if tc.statusCode == codes.OK {
-				if got, want := installedPackageDetail, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
 					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
 				}
 			}
@@ -500,76 +5727,425 @@ func TestGetInstalledPackageDetail(t *testing.T) {
 	}
 }
 
-func TestGetAvailablePackageVersions(t *testing.T) {
+func TestGetCatalogIndex(t *testing.T) {
 	testCases := []struct {
 		name              string
 		configuredPlugins []*pkgsPluginWithServer
 		statusCode        codes.Code
-		request           *corev1.GetAvailablePackageVersionsRequest
-		expectedResponse  *corev1.GetAvailablePackageVersionsResponse
 	}{
 		{
-			name: "it should successfully call the core GetAvailablePackageVersions operation",
+			name: "it should index every available package of each plugin",
 			configuredPlugins: []*pkgsPluginWithServer{
 				mockedPackagingPlugin1,
 				mockedPackagingPlugin2,
 			},
-			request: &corev1.GetAvailablePackageVersionsRequest{
-				AvailablePackageRef: &corev1.AvailablePackageReference{
-					Context: &corev1.Context{
-						Cluster:   "",
-						Namespace: globalPackagingNamespace,
+			statusCode: codes.OK,
+		},
+		{
+			name:              "it should return an empty index without available packages",
+			configuredPlugins: []*pkgsPluginWithServer{},
+			statusCode:        codes.OK,
+		},
+		{
+			name: "it should fail when a plugin fails",
+			configuredPlugins: []*pkgsPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedNotFoundPackagingPlugin,
+			},
+			statusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				plugins: tc.configuredPlugins,
+			}
+			response, err := server.GetCatalogIndex(context.Background(), &corev1.GetCatalogIndexRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+
+			expectedEntries := []*corev1.CatalogIndexEntry{}
+			for _, p := range tc.configuredPlugins {
+				for _, pkg := range p.server.(*plugin_test.TestPackagingPluginServer).AvailablePackageSummaries {
+					expectedEntries = append(expectedEntries, &corev1.CatalogIndexEntry{
+						Identifier:  pkg.GetAvailablePackageRef().GetIdentifier(),
+						Plugin:      p.plugin.Name,
+						Name:        pkg.GetName(),
+						DisplayName: pkg.GetDisplayName(),
+						PkgVersion:  plugin_test.DefaultPkgVersion,
+						AppVersion:  plugin_test.DefaultAppVersion,
+						Categories:  []string{plugin_test.DefaultCategory},
+						Repository:  plugin_test.DefaultRepoName,
+						PkgVersions: []string{plugin_test.DefaultPkgUpdateVersion, plugin_test.DefaultPkgVersion},
+					})
+				}
+			}
+
+			reader, err := gzip.NewReader(bytes.NewReader(response.GetIndex()))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			encoded, err := ioutil.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			index := &corev1.CatalogIndex{}
+			if err := protojson.Unmarshal(encoded, index); err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := response.GetPackageCount(), int32(len(expectedEntries)); got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+			opts := cmp.Options{
+				ignoreUnexportedOpts,
+				cmpopts.EquateEmpty(),
+				cmpopts.SortSlices(func(a, b *corev1.CatalogIndexEntry) bool {
+					return a.Plugin+a.Name < b.Plugin+b.Name
+				}),
+			}
+			if got, want := index.GetEntries(), expectedEntries; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
+func TestGetCatalogIndexConcurrency(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		batchConcurrency      int
+		expectedMaxConcurrent int
+	}{
+		{
+			name:                  "it limits the concurrent version listings without a batch concurrency",
+			expectedMaxConcurrent: maxCatalogIndexConcurrency,
+		},
+		{
+			name:                  "it limits the concurrent version listings to a lower batch concurrency",
+			batchConcurrency:      3,
+			expectedMaxConcurrent: 3,
+		},
+		{
+			name:                  "it limits the concurrent version listings despite a higher batch concurrency",
+			batchConcurrency:      2 * maxCatalogIndexConcurrency,
+			expectedMaxConcurrent: maxCatalogIndexConcurrency,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "counting-plugin", Version: "v1alpha1"}
+			summaries := []*corev1.AvailablePackageSummary{}
+			for i := 0; i < 2*maxCatalogIndexConcurrency; i++ {
+				summaries = append(summaries, plugin_test.MakeAvailablePackageSummary(fmt.Sprintf("pkg-%d", i), plugin))
+			}
+			running, maxConcurrent := 0, 0
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: plugin,
+						server: concurrencyCountingPackagingPluginServer{
+							TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{
+								Plugin:                    plugin,
+								AvailablePackageSummaries: summaries,
+								PackageAppVersions:        []*corev1.PackageAppVersion{{PkgVersion: plugin_test.DefaultPkgVersion}},
+							},
+							mutex:         &sync.Mutex{},
+							running:       &running,
+							maxConcurrent: &maxConcurrent,
+						},
 					},
-					Identifier: "test",
-					Plugin:     mockedPackagingPlugin1.plugin,
 				},
-			},
+				batchConcurrency: tc.batchConcurrency,
+			}
 
-			expectedResponse: &corev1.GetAvailablePackageVersionsResponse{
-				PackageAppVersions: []*corev1.PackageAppVersion{
-					plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultPkgUpdateVersion),
-					plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultPkgVersion),
+			_, err := server.GetCatalogIndex(context.Background(), &corev1.GetCatalogIndexRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
 				},
-			},
-			statusCode: codes.OK,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := maxConcurrent, tc.expectedMaxConcurrent; got != want {
+				t.Errorf("got: %d concurrent calls, want: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestNamespaceClusterRouting(t *testing.T) {
+	namespaceClusterMapping := map[string]string{
+		"team-a-":        "cluster-a",
+		"team-a-special": "cluster-special",
+		"kubeapps-user-": "cluster-user",
+	}
+	clusterDefaultNamespaces := map[string]string{
+		"":          "kubeapps-user-ns",
+		"default":   "kubeapps-user-ns",
+		"cluster-b": "team-b-apps",
+	}
+
+	testCases := []struct {
+		name                     string
+		defaultTargetCluster     string
+		namespaceDefaultingOrder string
+		targetContext            *corev1.Context
+		expectedContext          *corev1.Context
+	}{
+		{
+			name:            "it routes a mapped namespace without cluster to the mapped cluster",
+			targetContext:   &corev1.Context{Namespace: "team-a-dev"},
+			expectedContext: &corev1.Context{Cluster: "cluster-a", Namespace: "team-a-dev"},
+		},
+		{
+			name:            "it uses the longest matching namespace prefix",
+			targetContext:   &corev1.Context{Namespace: "team-a-special-dev"},
+			expectedContext: &corev1.Context{Cluster: "cluster-special", Namespace: "team-a-special-dev"},
+		},
+		{
+			name:            "it leaves the cluster blank for an unmapped namespace",
+			targetContext:   &corev1.Context{Namespace: "team-b-dev"},
+			expectedContext: &corev1.Context{Namespace: "team-b-dev"},
+		},
+		{
+			name:            "it does not override an explicit cluster",
+			targetContext:   &corev1.Context{Cluster: "default", Namespace: "team-a-dev"},
+			expectedContext: &corev1.Context{Cluster: "default", Namespace: "team-a-dev"},
+		},
+		{
+			name:            "it uses the default namespace of the cluster when the namespace is blank",
+			targetContext:   &corev1.Context{Cluster: "cluster-b"},
+			expectedContext: &corev1.Context{Cluster: "cluster-b", Namespace: "team-b-apps"},
+		},
+		{
+			name:            "it uses the default namespace of the Kubeapps cluster when the cluster is also blank",
+			targetContext:   &corev1.Context{},
+			expectedContext: &corev1.Context{Namespace: "kubeapps-user-ns"},
+		},
+		{
+			name:            "it does not override an explicit namespace with the default namespace",
+			targetContext:   &corev1.Context{Cluster: "cluster-b", Namespace: "team-b-dev"},
+			expectedContext: &corev1.Context{Cluster: "cluster-b", Namespace: "team-b-dev"},
+		},
+		{
+			name:            "it leaves the namespace blank for a cluster without default namespace",
+			targetContext:   &corev1.Context{Cluster: "cluster-c"},
+			expectedContext: &corev1.Context{Cluster: "cluster-c"},
+		},
+		{
+			name:                 "it routes an unmapped namespace without cluster to the default target cluster",
+			defaultTargetCluster: "cluster-b",
+			targetContext:        &corev1.Context{Namespace: "team-b-dev"},
+			expectedContext:      &corev1.Context{Cluster: "cluster-b", Namespace: "team-b-dev"},
+		},
+		{
+			name:                 "it routes a mapped namespace without cluster to the mapped cluster rather than the default target cluster",
+			defaultTargetCluster: "cluster-b",
+			targetContext:        &corev1.Context{Namespace: "team-a-dev"},
+			expectedContext:      &corev1.Context{Cluster: "cluster-a", Namespace: "team-a-dev"},
+		},
+		{
+			name:                 "it routes a blank context to the default target cluster and its default namespace",
+			defaultTargetCluster: "cluster-b",
+			targetContext:        &corev1.Context{},
+			expectedContext:      &corev1.Context{Cluster: "cluster-b", Namespace: "team-b-apps"},
+		},
+		{
+			name:                 "it does not override an explicit cluster with the default target cluster",
+			defaultTargetCluster: "cluster-b",
+			targetContext:        &corev1.Context{Cluster: "default", Namespace: "team-b-dev"},
+			expectedContext:      &corev1.Context{Cluster: "default", Namespace: "team-b-dev"},
+		},
+		{
+			name:                     "cluster first: it resolves a blank cluster then uses its default namespace",
+			defaultTargetCluster:     "cluster-b",
+			namespaceDefaultingOrder: NamespaceDefaultingOrderClusterFirst,
+			targetContext:            &corev1.Context{},
+			expectedContext:          &corev1.Context{Cluster: "cluster-b", Namespace: "team-b-apps"},
+		},
+		{
+			name:                     "cluster first: it uses the default namespace of an explicit cluster",
+			defaultTargetCluster:     "cluster-b",
+			namespaceDefaultingOrder: NamespaceDefaultingOrderClusterFirst,
+			targetContext:            &corev1.Context{Cluster: "default"},
+			expectedContext:          &corev1.Context{Cluster: "default", Namespace: "kubeapps-user-ns"},
+		},
+		{
+			name:                     "cluster first: it routes an explicit namespace without cluster",
+			defaultTargetCluster:     "cluster-b",
+			namespaceDefaultingOrder: NamespaceDefaultingOrderClusterFirst,
+			targetContext:            &corev1.Context{Namespace: "team-a-dev"},
+			expectedContext:          &corev1.Context{Cluster: "cluster-a", Namespace: "team-a-dev"},
+		},
+		{
+			name:                     "cluster first: it does not change an explicit cluster and namespace",
+			defaultTargetCluster:     "cluster-b",
+			namespaceDefaultingOrder: NamespaceDefaultingOrderClusterFirst,
+			targetContext:            &corev1.Context{Cluster: "default", Namespace: "kubeapps-user-ns"},
+			expectedContext:          &corev1.Context{Cluster: "default", Namespace: "kubeapps-user-ns"},
+		},
+		{
+			name:                     "namespace first: it routes the default namespace of a blank cluster",
+			defaultTargetCluster:     "cluster-b",
+			namespaceDefaultingOrder: NamespaceDefaultingOrderNamespaceFirst,
+			targetContext:            &corev1.Context{},
+			expectedContext:          &corev1.Context{Cluster: "cluster-user", Namespace: "kubeapps-user-ns"},
+		},
+		{
+			name:                     "namespace first: it uses the default namespace of an explicit cluster",
+			defaultTargetCluster:     "cluster-b",
+			namespaceDefaultingOrder: NamespaceDefaultingOrderNamespaceFirst,
+			targetContext:            &corev1.Context{Cluster: "default"},
+			expectedContext:          &corev1.Context{Cluster: "default", Namespace: "kubeapps-user-ns"},
+		},
+		{
+			name:                     "namespace first: it routes an explicit namespace without cluster",
+			defaultTargetCluster:     "cluster-b",
+			namespaceDefaultingOrder: NamespaceDefaultingOrderNamespaceFirst,
+			targetContext:            &corev1.Context{Namespace: "team-b-dev"},
+			expectedContext:          &corev1.Context{Cluster: "cluster-b", Namespace: "team-b-dev"},
+		},
+		{
+			name:                     "namespace first: it does not change an explicit cluster and namespace",
+			defaultTargetCluster:     "cluster-b",
+			namespaceDefaultingOrder: NamespaceDefaultingOrderNamespaceFirst,
+			targetContext:            &corev1.Context{Cluster: "default", Namespace: "kubeapps-user-ns"},
+			expectedContext:          &corev1.Context{Cluster: "default", Namespace: "kubeapps-user-ns"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+			server := NewPackagesServer([]*pkgsPluginWithServer{
+				{
+					plugin: plugin,
+					server: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+				},
+			}, ServeOptions{
+				NamespaceClusterMapping:  namespaceClusterMapping,
+				DefaultTargetCluster:     tc.defaultTargetCluster,
+				NamespaceDefaultingOrder: tc.namespaceDefaultingOrder,
+			}, clusterDefaultNamespaces)
+
+			installedPkgResponse, err := server.CreateInstalledPackage(context.Background(), &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "available-pkg-1",
+					Plugin:     plugin,
+				},
+				TargetContext: tc.targetContext,
+				Name:          "installed-pkg-1",
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := installedPkgResponse.GetInstalledPackageRef().GetContext(), tc.expectedContext; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+		})
+	}
+}
+
+func TestEmptyNamespacePolicy(t *testing.T) {
+	clusterDefaultNamespaces := map[string]string{
+		"cluster-b": "team-b-apps",
+	}
+
+	testCases := []struct {
+		name            string
+		policy          string
+		targetContext   *corev1.Context
+		statusCode      codes.Code
+		expectedContext *corev1.Context
+	}{
+		{
+			name:          "it rejects a blank namespace with the reject policy",
+			policy:        EmptyNamespacePolicyReject,
+			targetContext: &corev1.Context{Cluster: "cluster-c"},
+			statusCode:    codes.InvalidArgument,
+		},
+		{
+			name:          "it rejects a blank namespace with the reject policy even if the cluster has a default namespace",
+			policy:        EmptyNamespacePolicyReject,
+			targetContext: &corev1.Context{Cluster: "cluster-b"},
+			statusCode:    codes.InvalidArgument,
+		},
+		{
+			name:          "it rejects a missing target context with the reject policy",
+			policy:        EmptyNamespacePolicyReject,
+			targetContext: nil,
+			statusCode:    codes.InvalidArgument,
+		},
+		{
+			name:            "it accepts an explicit namespace with the reject policy",
+			policy:          EmptyNamespacePolicyReject,
+			targetContext:   &corev1.Context{Cluster: "cluster-b", Namespace: "team-b-dev"},
+			statusCode:      codes.OK,
+			expectedContext: &corev1.Context{Cluster: "cluster-b", Namespace: "team-b-dev"},
+		},
+		{
+			name:            "it falls back to the default namespace of the cluster with the default policy",
+			policy:          EmptyNamespacePolicyDefault,
+			targetContext:   &corev1.Context{Cluster: "cluster-b"},
+			statusCode:      codes.OK,
+			expectedContext: &corev1.Context{Cluster: "cluster-b", Namespace: "team-b-apps"},
 		},
 		{
-			name: "it should fail when calling the core GetAvailablePackageVersions operation when the package is not present in a plugin",
-			configuredPlugins: []*pkgsPluginWithServer{
-				mockedPackagingPlugin1,
-				mockedNotFoundPackagingPlugin,
-			},
-			request: &corev1.GetAvailablePackageVersionsRequest{
-				AvailablePackageRef: &corev1.AvailablePackageReference{
-					Context: &corev1.Context{
-						Cluster:   "",
-						Namespace: globalPackagingNamespace,
-					},
-					Identifier: "test",
-					Plugin:     mockedNotFoundPackagingPlugin.plugin,
-				},
-			},
-
-			expectedResponse: &corev1.GetAvailablePackageVersionsResponse{
-				PackageAppVersions: []*corev1.PackageAppVersion{},
-			},
-			statusCode: codes.NotFound,
+			name:          "it rejects a blank namespace with the default policy if the cluster has no default namespace",
+			policy:        EmptyNamespacePolicyDefault,
+			targetContext: &corev1.Context{Cluster: "cluster-c"},
+			statusCode:    codes.InvalidArgument,
+		},
+		{
+			name:            "it forwards a blank namespace without a policy if the cluster has no default namespace",
+			policy:          "",
+			targetContext:   &corev1.Context{Cluster: "cluster-c"},
+			statusCode:      codes.OK,
+			expectedContext: &corev1.Context{Cluster: "cluster-c"},
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			server := &packagesServer{
-				plugins: tc.configuredPlugins,
-			}
-			AvailablePackageVersions, err := server.GetAvailablePackageVersions(context.Background(), tc.request)
+			plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+			server := NewPackagesServer([]*pkgsPluginWithServer{
+				{
+					plugin: plugin,
+					server: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+				},
+			}, ServeOptions{EmptyNamespacePolicy: tc.policy}, clusterDefaultNamespaces)
+
+			installedPkgResponse, err := server.CreateInstalledPackage(context.Background(), &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "available-pkg-1",
+					Plugin:     plugin,
+				},
+				TargetContext: tc.targetContext,
+				Name:          "installed-pkg-1",
+			})
 
 			if got, want := status.Code(err), tc.statusCode; got != want {
 				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
 			}
 
 			if tc.statusCode == codes.OK {
-				if got, want := AvailablePackageVersions, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				if got, want := installedPkgResponse.GetInstalledPackageRef().GetContext(), tc.expectedContext; !cmp.Equal(got, want, ignoreUnexportedOpts) {
 					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
 				}
 			}
@@ -577,245 +6153,360 @@ func TestGetAvailablePackageVersions(t *testing.T) {
 	}
 }
 
-func TestCreateInstalledPackage(t *testing.T) {
+func TestCheckEmptyNamespacePolicy(t *testing.T) {
+	for _, policy := range []string{EmptyNamespacePolicyReject, EmptyNamespacePolicyDefault, ""} {
+		if err := checkEmptyNamespacePolicy(policy); err != nil {
+			t.Errorf("got error for the policy %q: %+v", policy, err)
+		}
+	}
+	if err := checkEmptyNamespacePolicy("fallback"); err == nil {
+		t.Errorf("got no error for an unknown policy")
+	}
+}
+
+func TestCheckNamespaceDefaultingOrder(t *testing.T) {
+	for _, order := range []string{NamespaceDefaultingOrderClusterFirst, NamespaceDefaultingOrderNamespaceFirst, ""} {
+		if err := checkNamespaceDefaultingOrder(order); err != nil {
+			t.Errorf("got error for the order %q: %+v", order, err)
+		}
+	}
+	if err := checkNamespaceDefaultingOrder("namespace-last"); err == nil {
+		t.Errorf("got no error for an unknown order")
+	}
+}
 
+func TestSortCategories(t *testing.T) {
 	testCases := []struct {
-		name              string
-		configuredPlugins []*plugins.Plugin
-		statusCode        codes.Code
-		request           *corev1.CreateInstalledPackageRequest
-		expectedResponse  *corev1.CreateInstalledPackageResponse
+		name               string
+		categoryOrder      []string
+		categories         []string
+		expectedCategories []string
 	}{
 		{
-			name: "installs the package using the correct plugin",
-			configuredPlugins: []*plugins.Plugin{
-				{Name: "plugin-1", Version: "v1alpha1"},
-				{Name: "plugin-1", Version: "v1alpha2"},
-			},
-			statusCode: codes.OK,
-			request: &corev1.CreateInstalledPackageRequest{
-				AvailablePackageRef: &corev1.AvailablePackageReference{
-					Identifier: "available-pkg-1",
-					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
-				},
-				TargetContext: &corev1.Context{
-					Cluster:   "default",
-					Namespace: "my-ns",
-				},
-				Name: "installed-pkg-1",
-			},
-			expectedResponse: &corev1.CreateInstalledPackageResponse{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
-					Identifier: "installed-pkg-1",
-					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
-				},
-			},
+			name:               "it sorts the categories alphabetically without a category order",
+			categories:         []string{"Database", "Analytics", "Security", "Analytics"},
+			expectedCategories: []string{"Analytics", "Database", "Security"},
 		},
 		{
-			name:       "returns invalid argument if plugin not specified in request",
-			statusCode: codes.InvalidArgument,
-			request: &corev1.CreateInstalledPackageRequest{
-				AvailablePackageRef: &corev1.AvailablePackageReference{
-					Identifier: "available-pkg-1",
-				},
-				TargetContext: &corev1.Context{
-					Cluster:   "default",
-					Namespace: "my-ns",
-				},
-				Name: "installed-pkg-1",
-			},
+			name:               "it pins the categories in the given order",
+			categoryOrder:      []string{"Security", "Database"},
+			categories:         []string{"Database", "Analytics", "Security", "Analytics"},
+			expectedCategories: []string{"Security", "Database", "Analytics"},
 		},
 		{
-			name:       "returns internal error if unable to find the plugin",
-			statusCode: codes.Internal,
-			request: &corev1.CreateInstalledPackageRequest{
-				AvailablePackageRef: &corev1.AvailablePackageReference{
-					Identifier: "available-pkg-1",
-					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
-				},
-				TargetContext: &corev1.Context{
-					Cluster:   "default",
-					Namespace: "my-ns",
-				},
-				Name: "installed-pkg-1",
-			},
+			name:               "it sorts the categories which are not pinned alphabetically after the pinned ones",
+			categoryOrder:      []string{"Database"},
+			categories:         []string{"Security", "Networking", "Analytics", "Database"},
+			expectedCategories: []string{"Database", "Analytics", "Networking", "Security"},
+		},
+		{
+			name:               "it ignores the pinned categories which are not present",
+			categoryOrder:      []string{"Monitoring", "Database"},
+			categories:         []string{"Analytics", "Database"},
+			expectedCategories: []string{"Database", "Analytics"},
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			configuredPluginServers := []*pkgsPluginWithServer{}
-			for _, p := range tc.configuredPlugins {
-				configuredPluginServers = append(configuredPluginServers, &pkgsPluginWithServer{
-					plugin: p,
-					server: plugin_test.TestPackagingPluginServer{Plugin: p},
-				})
-			}
+			server := NewPackagesServer(nil, ServeOptions{CategoryOrder: tc.categoryOrder}, nil)
 
-			server := &packagesServer{
-				plugins: configuredPluginServers,
+			if got, want := server.sortCategories(tc.categories), tc.expectedCategories; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
 			}
+		})
+	}
+}
 
-			installedPkgResponse, err := server.CreateInstalledPackage(context.Background(), tc.request)
+// activityPackagingPluginServer is a test plugin returning a fixed history,
+// paginated with opaque page tokens when configured, recording the page
+// tokens of the requests.
+type activityPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
 
-			if got, want := status.Code(err), tc.statusCode; got != want {
-				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
-			}
+	activities []*corev1.PackageActivity
+	paginated  bool
+	pageTokens *[]string
+}
 
-			if tc.statusCode == codes.OK {
-				if got, want := installedPkgResponse, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
-					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
-				}
+func (s activityPackagingPluginServer) GetRecentActivity(ctx context.Context, request *corev1.GetRecentActivityRequest) (*corev1.GetRecentActivityResponse, error) {
+	pageToken := request.GetPaginationOptions().GetPageToken()
+	if s.pageTokens != nil {
+		*s.pageTokens = append(*s.pageTokens, pageToken)
+	}
+	start, end := 0, len(s.activities)
+	nextPageToken := ""
+	if s.paginated {
+		if pageToken != "" {
+			if _, err := fmt.Sscanf(pageToken, "offset-%d", &start); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q", pageToken)
 			}
-		})
+		}
+		if pageSize := int(request.GetPaginationOptions().GetPageSize()); pageSize > 0 && start+pageSize < end {
+			end = start + pageSize
+			nextPageToken = fmt.Sprintf("offset-%d", end)
+		}
 	}
+	activities := make([]*corev1.PackageActivity, 0, end-start)
+	for _, a := range s.activities[start:end] {
+		activities = append(activities, proto.Clone(a).(*corev1.PackageActivity))
+	}
+	return &corev1.GetRecentActivityResponse{
+		Activities:    activities,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
-func TestUpdateInstalledPackage(t *testing.T) {
+func makeActivity(plugin *plugins.Plugin, namespace, name string, operation corev1.PackageActivity_Operation, time string) *corev1.PackageActivity {
+	return &corev1.PackageActivity{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    &corev1.Context{Cluster: "default", Namespace: namespace},
+			Identifier: name,
+			Plugin:     plugin,
+		},
+		Name:      name,
+		Operation: operation,
+		Time:      time,
+	}
+}
+
+func TestGetRecentActivity(t *testing.T) {
+	helmPlugin := &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"}
+	fluxPlugin := &plugins.Plugin{Name: "fluxv2.packages", Version: "v1alpha1"}
+	noHistoryPlugin := &plugins.Plugin{Name: "no-history.packages", Version: "v1alpha1"}
+
+	helmInstall := makeActivity(helmPlugin, "ns-1", "wordpress", corev1.PackageActivity_OPERATION_INSTALL, "2022-01-01T10:00:00Z")
+	helmUpdate := makeActivity(helmPlugin, "ns-1", "wordpress", corev1.PackageActivity_OPERATION_UPDATE, "2022-01-03T10:00:00Z")
+	helmDelete := makeActivity(helmPlugin, "ns-2", "redis", corev1.PackageActivity_OPERATION_DELETE, "2022-01-04T10:00:00Z")
+	fluxInstall := makeActivity(fluxPlugin, "ns-1", "podinfo", corev1.PackageActivity_OPERATION_INSTALL, "2022-01-02T10:00:00Z")
+	fluxRollback := makeActivity(fluxPlugin, "ns-1", "podinfo", corev1.PackageActivity_OPERATION_ROLLBACK, "2022-01-04T10:00:00Z")
+	fluxUnknownTime := makeActivity(fluxPlugin, "ns-1", "nginx", corev1.PackageActivity_OPERATION_INSTALL, "")
 
 	testCases := []struct {
-		name              string
-		configuredPlugins []*plugins.Plugin
-		statusCode        codes.Code
-		request           *corev1.UpdateInstalledPackageRequest
-		expectedResponse  *corev1.UpdateInstalledPackageResponse
+		name                   string
+		pageToken              string
+		pageSize               int32
+		statusCode             codes.Code
+		expectedPages          [][]*corev1.PackageActivity
+		expectedHelmPageTokens []string
 	}{
 		{
-			name: "updates the package using the correct plugin",
-			configuredPlugins: []*plugins.Plugin{
-				{Name: "plugin-1", Version: "v1alpha1"},
-				{Name: "plugin-1", Version: "v1alpha2"},
-			},
+			name:       "it merges the activity of the plugins into a single feed, most recent first",
 			statusCode: codes.OK,
-			request: &corev1.UpdateInstalledPackageRequest{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
-					Identifier: "installed-pkg-1",
-					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
-				},
-			},
-			expectedResponse: &corev1.UpdateInstalledPackageResponse{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
-					Identifier: "installed-pkg-1",
-					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+			expectedPages: [][]*corev1.PackageActivity{
+				{
+					fluxRollback,
+					helmDelete,
+					helmUpdate,
+					fluxInstall,
+					helmInstall,
+					fluxUnknownTime,
 				},
 			},
+			expectedHelmPageTokens: []string{""},
 		},
 		{
-			name:       "returns invalid argument if plugin not specified in request",
-			statusCode: codes.InvalidArgument,
-			request: &corev1.UpdateInstalledPackageRequest{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Identifier: "available-pkg-1",
+			name:       "it returns the feed in pages",
+			pageSize:   4,
+			statusCode: codes.OK,
+			expectedPages: [][]*corev1.PackageActivity{
+				{
+					fluxRollback,
+					helmDelete,
+					helmUpdate,
+					fluxInstall,
+				},
+				{
+					helmInstall,
+					fluxUnknownTime,
 				},
 			},
+			expectedHelmPageTokens: []string{"", ""},
 		},
 		{
-			name:       "returns internal error if unable to find the plugin",
-			statusCode: codes.Internal,
-			request: &corev1.UpdateInstalledPackageRequest{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Identifier: "available-pkg-1",
-					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+			name:       "it forwards the page tokens of the plugins, requesting again the activities of a plugin not yet returned",
+			pageSize:   2,
+			statusCode: codes.OK,
+			expectedPages: [][]*corev1.PackageActivity{
+				{
+					fluxRollback,
+					helmDelete,
+				},
+				{
+					helmUpdate,
+					fluxInstall,
+				},
+				{
+					helmInstall,
+					fluxUnknownTime,
 				},
 			},
+			expectedHelmPageTokens: []string{"", "", "offset-2"},
+		},
+		{
+			name:       "it returns invalid argument for an invalid page token",
+			pageToken:  "not-a-page-token",
+			pageSize:   4,
+			statusCode: codes.InvalidArgument,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			configuredPluginServers := []*pkgsPluginWithServer{}
-			for _, p := range tc.configuredPlugins {
-				configuredPluginServers = append(configuredPluginServers, &pkgsPluginWithServer{
-					plugin: p,
-					server: plugin_test.TestPackagingPluginServer{Plugin: p},
-				})
-			}
-
+			helmPageTokens := []string{}
 			server := &packagesServer{
-				plugins: configuredPluginServers,
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: helmPlugin,
+						server: activityPackagingPluginServer{
+							TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: helmPlugin},
+							activities:                []*corev1.PackageActivity{helmDelete, helmUpdate, helmInstall},
+							paginated:                 true,
+							pageTokens:                &helmPageTokens,
+						},
+					},
+					{
+						plugin: fluxPlugin,
+						server: activityPackagingPluginServer{
+							TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: fluxPlugin},
+							activities:                []*corev1.PackageActivity{fluxRollback, fluxInstall, fluxUnknownTime},
+						},
+					},
+					{
+						plugin: noHistoryPlugin,
+						server: plugin_test.TestPackagingPluginServer{Plugin: noHistoryPlugin},
+					},
+				},
 			}
 
-			updatedPkgResponse, err := server.UpdateInstalledPackage(context.Background(), tc.request)
+			pages := [][]*corev1.PackageActivity{}
+			pageToken := tc.pageToken
+			for {
+				response, err := server.GetRecentActivity(context.Background(), &corev1.GetRecentActivityRequest{
+					Context:           &corev1.Context{Cluster: "default"},
+					PaginationOptions: &corev1.PaginationOptions{PageToken: pageToken, PageSize: tc.pageSize},
+				})
 
-			if got, want := status.Code(err), tc.statusCode; got != want {
-				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+				if got, want := status.Code(err), tc.statusCode; got != want {
+					t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+				}
+				if tc.statusCode != codes.OK {
+					return
+				}
+				pages = append(pages, response.GetActivities())
+				pageToken = response.GetNextPageToken()
+				if pageToken == "" || len(pages) > len(tc.expectedPages) {
+					break
+				}
 			}
 
-			if tc.statusCode == codes.OK {
-				if got, want := updatedPkgResponse, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
-					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
-				}
+			if got, want := pages, tc.expectedPages; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+			if got, want := helmPageTokens, tc.expectedHelmPageTokens; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
 			}
 		})
 	}
 }
 
-func TestDeleteInstalledPackage(t *testing.T) {
+// permissionsPackagingPluginServer is a test plugin granting the configured
+// permissions in each namespace.
+type permissionsPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
 
-	testCases := []struct {
-		name              string
-		configuredPlugins []*plugins.Plugin
-		statusCode        codes.Code
-		request           *corev1.DeleteInstalledPackageRequest
-	}{
+	permissions map[string]*corev1.GetUserPermissionsResponse
+}
+
+func (s permissionsPackagingPluginServer) GetUserPermissions(ctx context.Context, request *corev1.GetUserPermissionsRequest) (*corev1.GetUserPermissionsResponse, error) {
+	if permissions, ok := s.permissions[request.GetContext().GetNamespace()]; ok {
+		return permissions, nil
+	}
+	return &corev1.GetUserPermissionsResponse{}, nil
+}
+
+func TestGetUserPermissions(t *testing.T) {
+	permissionsPlugin := &plugins.Plugin{Name: "permissions-plugin", Version: "v1alpha1"}
+	configuredPlugins := []*pkgsPluginWithServer{
 		{
-			name: "deletes the package",
-			configuredPlugins: []*plugins.Plugin{
-				{Name: "plugin-1", Version: "v1alpha1"},
-				{Name: "plugin-1", Version: "v1alpha2"},
-			},
-			statusCode: codes.OK,
-			request: &corev1.DeleteInstalledPackageRequest{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
-					Identifier: "installed-pkg-1",
-					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+			plugin: permissionsPlugin,
+			server: permissionsPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: permissionsPlugin},
+				permissions: map[string]*corev1.GetUserPermissionsResponse{
+					"team-a": {CanInstall: true, CanUpdate: true, CanDelete: true},
+					"shared": {CanUpdate: true},
 				},
 			},
 		},
+		mockedPackagingPlugin1,
+	}
+
+	testCases := []struct {
+		name             string
+		plugin           *plugins.Plugin
+		namespace        string
+		statusCode       codes.Code
+		expectedResponse *corev1.GetUserPermissionsResponse
+	}{
 		{
-			name:       "returns invalid argument if plugin not specified in request",
+			name:             "it returns all the permissions granted by the plugin",
+			plugin:           permissionsPlugin,
+			namespace:        "team-a",
+			statusCode:       codes.OK,
+			expectedResponse: &corev1.GetUserPermissionsResponse{CanInstall: true, CanUpdate: true, CanDelete: true},
+		},
+		{
+			name:             "it returns a subset of the permissions granted by the plugin",
+			plugin:           permissionsPlugin,
+			namespace:        "shared",
+			statusCode:       codes.OK,
+			expectedResponse: &corev1.GetUserPermissionsResponse{CanUpdate: true},
+		},
+		{
+			name:             "it returns no permission in a namespace without any granted",
+			plugin:           permissionsPlugin,
+			namespace:        "team-b",
+			statusCode:       codes.OK,
+			expectedResponse: &corev1.GetUserPermissionsResponse{},
+		},
+		{
+			name:       "it returns unimplemented for a plugin without permissions",
+			plugin:     mockedPackagingPlugin1.plugin,
+			namespace:  "team-a",
+			statusCode: codes.Unimplemented,
+		},
+		{
+			name:       "it returns invalid argument if the plugin is not specified",
+			plugin:     nil,
 			statusCode: codes.InvalidArgument,
-			request: &corev1.DeleteInstalledPackageRequest{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Identifier: "available-pkg-1",
-				},
-			},
 		},
 		{
-			name:       "returns internal error if unable to find the plugin",
+			name:       "it returns internal error if unable to find the plugin",
+			plugin:     &plugins.Plugin{Name: "missing-plugin", Version: "v1alpha1"},
 			statusCode: codes.Internal,
-			request: &corev1.DeleteInstalledPackageRequest{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Identifier: "available-pkg-1",
-					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
-				},
-			},
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			configuredPluginServers := []*pkgsPluginWithServer{}
-			for _, p := range tc.configuredPlugins {
-				configuredPluginServers = append(configuredPluginServers, &pkgsPluginWithServer{
-					plugin: p,
-					server: plugin_test.TestPackagingPluginServer{Plugin: p},
-				})
-			}
-
 			server := &packagesServer{
-				plugins: configuredPluginServers,
+				plugins: configuredPlugins,
 			}
 
-			_, err := server.DeleteInstalledPackage(context.Background(), tc.request)
+			response, err := server.GetUserPermissions(context.Background(), &corev1.GetUserPermissionsRequest{
+				Context: &corev1.Context{Cluster: "default", Namespace: tc.namespace},
+				Plugin:  tc.plugin,
+			})
 
 			if got, want := status.Code(err), tc.statusCode; got != want {
 				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
 			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
 		})
 	}
 }
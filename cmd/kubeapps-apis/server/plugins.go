@@ -14,17 +14,23 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/fs"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
 	"plugin"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/Masterminds/semver"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
@@ -33,6 +39,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	log "k8s.io/klog/v2"
@@ -44,15 +53,66 @@ const (
 	gatewayRegisterFunction = "RegisterHTTPHandlerFromEndpoint"
 	pluginDetailFunction    = "GetPluginDetail"
 	clustersCAFilesPrefix   = "/etc/additional-clusters-cafiles"
+	// featureFlagsFunction is optional: only the plugins supporting feature
+	// flags export it.
+	featureFlagsFunction = "SetFeatureFlags"
 )
 
+const (
+	// DuplicatePluginPolicyReject fails the registration of the plugins when
+	// two of them have the same name and version.
+	DuplicatePluginPolicyReject = "reject"
+	// DuplicatePluginPolicyKeepFirst registers the first of the plugins with
+	// the same name and version, ignoring the others with a warning.
+	DuplicatePluginPolicyKeepFirst = "keep-first"
+)
+
+const (
+	// RegistrationTimeoutPolicyFail fails the registration of the plugins
+	// when one of them does not register within the registration timeout.
+	RegistrationTimeoutPolicyFail = "fail"
+	// RegistrationTimeoutPolicySkip ignores, with a warning, the plugins
+	// which do not register within the registration timeout, none of their
+	// services being served.
+	RegistrationTimeoutPolicySkip = "skip"
+)
+
+// errPluginRegistrationTimeout is returned when the registration function of
+// a plugin does not return within the registration timeout.
+var errPluginRegistrationTimeout = errors.New("plugin registration timed out")
+
 // KubernetesConfigGetter is a function type used by plugins to get a k8s config
 type KubernetesConfigGetter func(ctx context.Context, cluster string) (*rest.Config, error)
 
+// PackagesPluginServer is the part of the core packages API implemented by
+// the plugins. The rest of the core packages API, such as the aggregated
+// statistics, is computed by the core from these methods.
+type PackagesPluginServer interface {
+	GetAvailablePackageSummaries(context.Context, *packages.GetAvailablePackageSummariesRequest) (*packages.GetAvailablePackageSummariesResponse, error)
+	GetAvailablePackageDetail(context.Context, *packages.GetAvailablePackageDetailRequest) (*packages.GetAvailablePackageDetailResponse, error)
+	GetAvailablePackageVersions(context.Context, *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error)
+	GetAvailablePackageDependencies(context.Context, *packages.GetAvailablePackageDependenciesRequest) (*packages.GetAvailablePackageDependenciesResponse, error)
+	GetAvailablePackageDefaultValues(context.Context, *packages.GetAvailablePackageDefaultValuesRequest) (*packages.GetAvailablePackageDefaultValuesResponse, error)
+	GetAvailablePackageChangelog(context.Context, *packages.GetAvailablePackageChangelogRequest) (*packages.GetAvailablePackageChangelogResponse, error)
+	GetAvailablePackageValuesDiff(context.Context, *packages.GetAvailablePackageValuesDiffRequest) (*packages.GetAvailablePackageValuesDiffResponse, error)
+	RenderAvailablePackage(context.Context, *packages.RenderAvailablePackageRequest) (*packages.RenderAvailablePackageResponse, error)
+	GetInstalledPackageSummaries(context.Context, *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error)
+	GetInstalledPackageDetail(context.Context, *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error)
+	GetInstalledPackageValues(context.Context, *packages.GetInstalledPackageValuesRequest) (*packages.GetInstalledPackageValuesResponse, error)
+	CreateInstalledPackage(context.Context, *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error)
+	UpdateInstalledPackage(context.Context, *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error)
+	GetInstalledPackageUpgradeDiff(context.Context, *packages.GetInstalledPackageUpgradeDiffRequest) (*packages.GetInstalledPackageUpgradeDiffResponse, error)
+	GetInstalledPackageDrift(context.Context, *packages.GetInstalledPackageDriftRequest) (*packages.GetInstalledPackageDriftResponse, error)
+	ExportInstalledPackage(context.Context, *packages.ExportInstalledPackageRequest) (*packages.ExportInstalledPackageResponse, error)
+	DeleteInstalledPackage(context.Context, *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error)
+	GetRecentActivity(context.Context, *packages.GetRecentActivityRequest) (*packages.GetRecentActivityResponse, error)
+	GetUserPermissions(context.Context, *packages.GetUserPermissionsRequest) (*packages.GetUserPermissionsResponse, error)
+}
+
 // pkgsPluginWithServer stores the plugin detail together with its implementation.
 type pkgsPluginWithServer struct {
 	plugin *plugins.Plugin
-	server packages.PackagesServiceServer
+	server PackagesPluginServer
 }
 
 // coreServer implements the API defined in cmd/kubeapps-api-service/core/core.proto
@@ -70,6 +130,22 @@ type pluginsServer struct {
 
 	// The parsed config for clusters in a multi-cluster setup.
 	clustersConfig kube.ClustersConfig
+
+	// checkClusterAccess returns an error when the user cannot access the
+	// given cluster with their credentials.
+	checkClusterAccess func(ctx context.Context, cluster string) error
+
+	// checkClusterPolicies checks rendered resources against the policies
+	// of a cluster with the credentials of the user.
+	checkClusterPolicies clusterPolicyChecker
+
+	// pluginEndpoints maps plugin names to the backends serving their reads
+	// and mutations, rather than the plugins loaded in-process.
+	pluginEndpoints map[string]PluginEndpoints
+
+	// pluginEndpointsDialOption secures the connections to the plugin
+	// endpoints.
+	pluginEndpointsDialOption grpc.DialOption
 }
 
 func NewPluginsServer(serveOpts ServeOptions, registrar grpc.ServiceRegistrar, gwArgs gwHandlerArgs) (*pluginsServer, error) {
@@ -81,7 +157,27 @@ func NewPluginsServer(serveOpts ServeOptions, registrar grpc.ServiceRegistrar, g
 		log.Fatalf("failed to check for plugins: %v", err)
 	}
 
-	ps := &pluginsServer{}
+	pluginPaths, err = limitPluginPaths(pluginPaths, serveOpts.MaxPlugins, serveOpts.TruncatePlugins)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDuplicatePluginPolicy(serveOpts.DuplicatePluginPolicy); err != nil {
+		return nil, err
+	}
+
+	if err := checkRegistrationTimeoutPolicy(serveOpts.PluginRegistrationTimeoutPolicy); err != nil {
+		return nil, err
+	}
+
+	ps := &pluginsServer{
+		pluginEndpoints: serveOpts.PluginEndpoints,
+	}
+	if len(serveOpts.PluginEndpoints) > 0 {
+		if ps.pluginEndpointsDialOption, err = pluginEndpointsDialOption(serveOpts.PluginEndpointsTLS); err != nil {
+			return nil, err
+		}
+	}
 
 	// get the parsed kube.ClustersConfig from the serveOpts
 	clustersConfig, err := getClustersConfigFromServeOpts(serveOpts)
@@ -96,27 +192,170 @@ func NewPluginsServer(serveOpts ServeOptions, registrar grpc.ServiceRegistrar, g
 	}
 
 	sortPlugins(pluginDetails)
+	warnNonSemverPluginVersions(pluginDetails)
 
 	ps.plugins = pluginDetails
 
 	return ps, nil
 }
 
-// sortPlugins returns a consistently ordered slice.
+// sortPlugins returns a consistently ordered slice: by name and then by
+// version, the semantic versions first, in semver order, followed by the
+// other versions (such as v1alpha1) in lexical order.
 func sortPlugins(p []*plugins.Plugin) {
 	sort.Slice(p, func(i, j int) bool {
-		return p[i].Name < p[j].Name || (p[i].Name == p[j].Name && p[i].Version < p[j].Version)
+		if p[i].Name != p[j].Name {
+			return p[i].Name < p[j].Name
+		}
+		return lessPluginVersion(p[i].Version, p[j].Version)
 	})
 }
 
-// GetConfiguredPlugins returns details for each configured plugin.
+// lessPluginVersion reports whether a plugin version sorts before another:
+// a semantic version sorts before a non-conforming one, two semantic versions
+// are compared as such and two non-conforming ones, or two equal semantic
+// versions written differently (eg. v1 and 1.0.0), lexically.
+func lessPluginVersion(a, b string) bool {
+	semverA, errA := semver.NewVersion(a)
+	semverB, errB := semver.NewVersion(b)
+	switch {
+	case errA == nil && errB == nil && !semverA.Equal(semverB):
+		return semverA.LessThan(semverB)
+	case errA == nil && errB != nil:
+		return true
+	case errA != nil && errB == nil:
+		return false
+	default:
+		return a < b
+	}
+}
+
+// warnNonSemverPluginVersions logs a warning for each plugin whose version is
+// not a semantic version, as it is sorted lexically after the semantic ones.
+func warnNonSemverPluginVersions(p []*plugins.Plugin) {
+	for _, plugin := range p {
+		if _, err := semver.NewVersion(plugin.Version); err != nil {
+			log.Warningf("The version %q of the plugin %q is not a semantic version, it is sorted lexically after the semantic versions", plugin.Version, plugin.Name)
+		}
+	}
+}
+
+// GetConfiguredPlugins returns details for each configured plugin, also
+// grouped by the core service they implement when requested.
 func (s *pluginsServer) GetConfiguredPlugins(ctx context.Context, in *plugins.GetConfiguredPluginsRequest) (*plugins.GetConfiguredPluginsResponse, error) {
 	log.Infof("+core GetConfiguredPlugins")
-	return &plugins.GetConfiguredPluginsResponse{
+	response := &plugins.GetConfiguredPluginsResponse{
 		Plugins: s.plugins,
+	}
+	if in.GetGroupByService() {
+		response.ServicePlugins = s.servicePlugins()
+	}
+	return response, nil
+}
+
+// servicePlugins returns, for each core service implemented by at least one
+// plugin, the consistently ordered plugins implementing it.
+func (s *pluginsServer) servicePlugins() []*plugins.ServicePlugins {
+	servicePlugins := []*plugins.ServicePlugins{}
+
+	packagesPlugins := []*plugins.Plugin{}
+	for _, p := range s.packagesPlugins {
+		packagesPlugins = append(packagesPlugins, p.plugin)
+	}
+	if len(packagesPlugins) > 0 {
+		sortPlugins(packagesPlugins)
+		servicePlugins = append(servicePlugins, &plugins.ServicePlugins{
+			Service: packages.PackagesService_ServiceDesc.ServiceName,
+			Plugins: packagesPlugins,
+		})
+	}
+
+	return servicePlugins
+}
+
+// GetConfiguredClusters returns the configured clusters, ordered by name and
+// without their credentials, also checking whether the user can access each
+// of them when requested.
+func (s *pluginsServer) GetConfiguredClusters(ctx context.Context, in *plugins.GetConfiguredClustersRequest) (*plugins.GetConfiguredClustersResponse, error) {
+	log.Infof("+core GetConfiguredClusters")
+	if in.GetCheckAccess() && s.checkClusterAccess == nil {
+		return nil, status.Errorf(codes.Internal, "server not configured with a cluster access check")
+	}
+
+	names := make([]string, 0, len(s.clustersConfig.Clusters))
+	for name := range s.clustersConfig.Clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	clusters := make([]*plugins.ConfiguredCluster, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		clusters[i] = &plugins.ConfiguredCluster{
+			Name:              name,
+			ApiServiceUrl:     redactURLUserInfo(s.clustersConfig.Clusters[name].APIServiceURL),
+			IsKubeappsCluster: name == s.clustersConfig.KubeappsClusterName,
+		}
+		if !in.GetCheckAccess() {
+			continue
+		}
+		// The clusters are checked concurrently, as the unreachable ones
+		// only fail once their connection times out.
+		wg.Add(1)
+		go func(cluster *plugins.ConfiguredCluster) {
+			defer wg.Done()
+			if err := s.checkClusterAccess(ctx, cluster.Name); err != nil {
+				log.Infof("The cluster %q is not accessible: %v", cluster.Name, err)
+				return
+			}
+			cluster.Accessible = true
+		}(clusters[i])
+	}
+	wg.Wait()
+
+	return &plugins.GetConfiguredClustersResponse{
+		Clusters: clusters,
 	}, nil
 }
 
+// redactURLUserInfo returns the URL without the user information it may
+// include, such as basic auth credentials.
+func redactURLUserInfo(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+	u.User = nil
+	return u.String()
+}
+
+// newClusterAccessChecker returns a function checking that the user can access
+// a cluster, by asking its API server, with the credentials of the user,
+// whether they can list the namespaces. Only the request failing, for example
+// because the cluster is unreachable or the credentials were rejected, makes
+// the cluster inaccessible, whatever the answer.
+func newClusterAccessChecker(configGetter KubernetesConfigGetter) func(ctx context.Context, cluster string) error {
+	return func(ctx context.Context, cluster string) error {
+		config, err := configGetter(ctx, cluster)
+		if err != nil {
+			return err
+		}
+		client, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return err
+		}
+		_, err = client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Resource: "namespaces",
+					Verb:     "list",
+				},
+			},
+		}, metav1.CreateOptions{})
+		return err
+	}
+}
+
 // registerPlugins opens each plugin, looks up the register function and calls it with the registrar.
 func (s *pluginsServer) registerPlugins(pluginPaths []string, grpcReg grpc.ServiceRegistrar, gwArgs gwHandlerArgs, serveOpts ServeOptions) ([]*plugins.Plugin, error) {
 	pluginDetails := []*plugins.Plugin{}
@@ -125,6 +364,8 @@ func (s *pluginsServer) registerPlugins(pluginPaths []string, grpcReg grpc.Servi
 	if err != nil {
 		return nil, fmt.Errorf("unable to create a ClientGetter: %w", err)
 	}
+	s.checkClusterAccess = newClusterAccessChecker(configGetter)
+	s.checkClusterPolicies = newClusterPolicyChecker(clientsetGetter(configGetter))
 
 	for _, pluginPath := range pluginPaths {
 		p, err := plugin.Open(pluginPath)
@@ -135,14 +376,26 @@ func (s *pluginsServer) registerPlugins(pluginPaths []string, grpcReg grpc.Servi
 		var pluginDetail *plugins.Plugin
 		if pluginDetail, err = getPluginDetail(p, pluginPath); err != nil {
 			return nil, err
-		} else {
-			pluginDetails = append(pluginDetails, pluginDetail)
+		}
+		if skip, err := skipDuplicatePlugin(pluginDetail, pluginPath, pluginDetails, serveOpts.DuplicatePluginPolicy); err != nil {
+			return nil, err
+		} else if skip {
+			continue
 		}
 
-		if err = s.registerGRPC(p, pluginDetail, grpcReg, configGetter); err != nil {
+		if err = setFeatureFlags(p.Lookup, pluginDetail, serveOpts.PluginFeatureFlags); err != nil {
 			return nil, err
 		}
 
+		if err = s.registerGRPC(p.Lookup, pluginDetail, grpcReg, configGetter, serveOpts.PluginRegistrationTimeout); err != nil {
+			if errors.Is(err, errPluginRegistrationTimeout) && serveOpts.PluginRegistrationTimeoutPolicy == RegistrationTimeoutPolicySkip {
+				log.Warningf("Ignoring the plugin %q: %v", pluginPath, err)
+				continue
+			}
+			return nil, err
+		}
+		pluginDetails = append(pluginDetails, pluginDetail)
+
 		if err = registerHTTP(p, pluginDetail, gwArgs); err != nil {
 			return nil, err
 		}
@@ -153,8 +406,9 @@ func (s *pluginsServer) registerPlugins(pluginPaths []string, grpcReg grpc.Servi
 }
 
 // registerGRPC finds and calls the required function for registering the plugin for the GRPC server.
-func (s *pluginsServer) registerGRPC(p *plugin.Plugin, pluginDetail *plugins.Plugin, registrar grpc.ServiceRegistrar, clientGetter KubernetesConfigGetter) error {
-	grpcRegFn, err := p.Lookup(grpcRegisterFunction)
+// A positive timeout is the time given to the function to register the plugin.
+func (s *pluginsServer) registerGRPC(lookup func(string) (plugin.Symbol, error), pluginDetail *plugins.Plugin, registrar grpc.ServiceRegistrar, clientGetter KubernetesConfigGetter, timeout time.Duration) error {
+	grpcRegFn, err := lookup(grpcRegisterFunction)
 	if err != nil {
 		return fmt.Errorf("unable to lookup %q for %v: %w", grpcRegisterFunction, pluginDetail, err)
 	}
@@ -168,8 +422,12 @@ func (s *pluginsServer) registerGRPC(p *plugin.Plugin, pluginDetail *plugins.Plu
 		return fmt.Errorf("unable to use %q in plugin %v due to mismatched signature.\nwant: %T\ngot: %T", grpcRegisterFunction, pluginDetail, dummyFn, grpcRegFn)
 	}
 
-	server, err := grpcFn(registrar, clientGetter, s.clustersConfig)
-	if err != nil {
+	server, err := callWithRegistrationTimeout(func(registrar grpc.ServiceRegistrar) (interface{}, error) {
+		return grpcFn(registrar, clientGetter, s.clustersConfig)
+	}, registrar, timeout)
+	if errors.Is(err, errPluginRegistrationTimeout) {
+		return fmt.Errorf("plug-in %v did not register within %s: %w", pluginDetail, timeout, err)
+	} else if err != nil {
 		return fmt.Errorf("plug-in %q failed to register due to: %v", pluginDetail, err)
 	} else if server == nil {
 		return fmt.Errorf("registration for plug-in %v failed due to: %T returned nil when non-nil value was expected", pluginDetail, grpcFn)
@@ -186,22 +444,71 @@ func (s *pluginsServer) registerPluginsSatisfyingCoreAPIs(pluginSrv interface{},
 	// grpc-go itself does, see:
 	// https://github.com/grpc/grpc-go/blob/v1.38.0/server.go#L621
 	serverType := reflect.TypeOf(pluginSrv)
-	corePackagesType := reflect.TypeOf((*packages.PackagesServiceServer)(nil)).Elem()
+	corePackagesType := reflect.TypeOf((*PackagesPluginServer)(nil)).Elem()
 
 	if serverType.Implements(corePackagesType) {
-		pkgsSrv, ok := pluginSrv.(packages.PackagesServiceServer)
+		pkgsSrv, ok := pluginSrv.(PackagesPluginServer)
 		if !ok {
 			return fmt.Errorf("Unable to convert plugin %v to core PackagesServicesServer although it implements the same.", pluginDetail)
 		}
+		if endpoints, ok := s.pluginEndpoints[pluginDetail.GetName()]; ok {
+			var err error
+			if pkgsSrv, err = newPluginEndpointsServer(pkgsSrv, endpoints, s.pluginEndpointsDialOption); err != nil {
+				return fmt.Errorf("unable to use the endpoints configured for plugin %v: %w", pluginDetail, err)
+			}
+			log.Infof("Plugin %v is served by the endpoints %+v.", pluginDetail, endpoints)
+		}
 		s.packagesPlugins = append(s.packagesPlugins, &pkgsPluginWithServer{
 			plugin: pluginDetail,
 			server: pkgsSrv,
 		})
 		log.Infof("Plugin %v implements core.packages.v1alpha1. Registered for aggregation.", pluginDetail)
+		return nil
+	}
+
+	// A plugin implementing only some of the core packages methods would
+	// otherwise only fail when a missing method is called, so it is rejected.
+	missing := missingMethods(serverType, corePackagesType)
+	if len(missing) < corePackagesType.NumMethod() {
+		log.Errorf("Plugin %v partially implements core.packages.v1alpha1, missing the methods: %s", pluginDetail, strings.Join(missing, ", "))
+		return fmt.Errorf("plugin %v does not implement the methods %s of core.packages.v1alpha1", pluginDetail, strings.Join(missing, ", "))
 	}
 	return nil
 }
 
+// missingMethods returns the names of the methods of the interface type
+// which are not implemented, with the same signature, by the server type.
+func missingMethods(serverType, interfaceType reflect.Type) []string {
+	missing := []string{}
+	for i := 0; i < interfaceType.NumMethod(); i++ {
+		method := interfaceType.Method(i)
+		serverMethod, ok := serverType.MethodByName(method.Name)
+		if !ok || !sameSignature(serverMethod.Type, method.Type) {
+			missing = append(missing, method.Name)
+		}
+	}
+	return missing
+}
+
+// sameSignature returns whether the method type of a concrete type, whose
+// first argument is the receiver, matches the method type of an interface.
+func sameSignature(methodType, interfaceMethodType reflect.Type) bool {
+	if methodType.NumIn()-1 != interfaceMethodType.NumIn() || methodType.NumOut() != interfaceMethodType.NumOut() {
+		return false
+	}
+	for i := 0; i < interfaceMethodType.NumIn(); i++ {
+		if methodType.In(i+1) != interfaceMethodType.In(i) {
+			return false
+		}
+	}
+	for i := 0; i < interfaceMethodType.NumOut(); i++ {
+		if methodType.Out(i) != interfaceMethodType.Out(i) {
+			return false
+		}
+	}
+	return true
+}
+
 // getPluginDetail returns a core.plugins.Plugin as defined by the plugin itself.
 func getPluginDetail(p *plugin.Plugin, pluginPath string) (*plugins.Plugin, error) {
 	pluginDetailFn, err := p.Lookup(pluginDetailFunction)
@@ -220,6 +527,67 @@ func getPluginDetail(p *plugin.Plugin, pluginPath string) (*plugins.Plugin, erro
 	return fn(), nil
 }
 
+// setFeatureFlags passes the feature flags configured for the plugin, if any,
+// to its SetFeatureFlags function before it is registered. The flags are
+// ignored, with a warning, when the plugin doesn't support feature flags.
+func setFeatureFlags(lookup func(string) (plugin.Symbol, error), pluginDetail *plugins.Plugin, featureFlags map[string]map[string]bool) error {
+	flags := featureFlags[pluginDetail.GetName()]
+	if len(flags) == 0 {
+		return nil
+	}
+
+	featureFlagsFn, err := lookup(featureFlagsFunction)
+	if err != nil {
+		log.Warningf("Ignoring the feature flags configured for plugin %v, which doesn't export %q", pluginDetail, featureFlagsFunction)
+		return nil
+	}
+	type featureFlagsFunctionType = func(map[string]bool) error
+	fn, ok := featureFlagsFn.(featureFlagsFunctionType)
+	if !ok {
+		var dummyFn featureFlagsFunctionType = func(map[string]bool) error { return nil }
+		return fmt.Errorf("unable to use %q in plugin %v due to mismatched signature.\nwant: %T\ngot: %T", featureFlagsFunction, pluginDetail, dummyFn, featureFlagsFn)
+	}
+
+	// Pass a copy so that the plugin cannot modify the configured flags.
+	pluginFlags := make(map[string]bool, len(flags))
+	for name, enabled := range flags {
+		pluginFlags[name] = enabled
+	}
+	if err := fn(pluginFlags); err != nil {
+		return fmt.Errorf("plug-in %v failed to set the feature flags due to: %w", pluginDetail, err)
+	}
+	return nil
+}
+
+// ParsePluginFeatureFlags parses feature flags specified as
+// "<plugin-name>:<flag>=<bool>" (eg. "helm.packages:oci-charts=true") into
+// the feature flags of each plugin.
+func ParsePluginFeatureFlags(specs []string) (map[string]map[string]bool, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	featureFlags := map[string]map[string]bool{}
+	for _, spec := range specs {
+		pluginName, flag := "", ""
+		if i := strings.Index(spec, ":"); i > 0 {
+			pluginName, flag = spec[:i], spec[i+1:]
+		}
+		name, value := flag, "true"
+		if i := strings.Index(flag, "="); i >= 0 {
+			name, value = flag[:i], flag[i+1:]
+		}
+		enabled, err := strconv.ParseBool(value)
+		if pluginName == "" || name == "" || err != nil {
+			return nil, fmt.Errorf("invalid plugin feature flag %q, expected <plugin-name>:<flag>=<bool>", spec)
+		}
+		if featureFlags[pluginName] == nil {
+			featureFlags[pluginName] = map[string]bool{}
+		}
+		featureFlags[pluginName][name] = enabled
+	}
+	return featureFlags, nil
+}
+
 // registerHTTP finds and calls the required function for registering the plugin for the HTTP gateway server.
 func registerHTTP(p *plugin.Plugin, pluginDetail *plugins.Plugin, gwArgs gwHandlerArgs) error {
 	gwRegFn, err := p.Lookup(gatewayRegisterFunction)
@@ -268,6 +636,147 @@ func listSOFiles(fsys fs.FS, pluginDirs []string) ([]string, error) {
 	return matches, nil
 }
 
+// limitPluginPaths enforces the maximum number of plugins to be loaded,
+// returning an error when the limit is exceeded or, if truncate is set,
+// only the first maxPlugins paths.
+func limitPluginPaths(pluginPaths []string, maxPlugins int, truncate bool) ([]string, error) {
+	if maxPlugins <= 0 || len(pluginPaths) <= maxPlugins {
+		return pluginPaths, nil
+	}
+	if !truncate {
+		return nil, fmt.Errorf("found %d plugins which exceeds the maximum of %d plugins", len(pluginPaths), maxPlugins)
+	}
+	log.Warningf("Found %d plugins which exceeds the maximum of %d plugins. Ignoring the plugins: %v", len(pluginPaths), maxPlugins, pluginPaths[maxPlugins:])
+	return pluginPaths[:maxPlugins], nil
+}
+
+// checkDuplicatePluginPolicy checks that the policy, if any, is one of the
+// supported duplicate plugin policies.
+func checkDuplicatePluginPolicy(policy string) error {
+	switch policy {
+	case DuplicatePluginPolicyReject, DuplicatePluginPolicyKeepFirst, "":
+		return nil
+	default:
+		return fmt.Errorf("invalid duplicate plugin policy %q, expected %q or %q", policy, DuplicatePluginPolicyReject, DuplicatePluginPolicyKeepFirst)
+	}
+}
+
+// checkRegistrationTimeoutPolicy checks that the policy, if any, is one of
+// the supported registration timeout policies.
+func checkRegistrationTimeoutPolicy(policy string) error {
+	switch policy {
+	case RegistrationTimeoutPolicyFail, RegistrationTimeoutPolicySkip, "":
+		return nil
+	default:
+		return fmt.Errorf("invalid registration timeout policy %q, expected %q or %q", policy, RegistrationTimeoutPolicyFail, RegistrationTimeoutPolicySkip)
+	}
+}
+
+// callWithRegistrationTimeout calls the registration function of a plugin,
+// returning errPluginRegistrationTimeout when it does not return within the
+// timeout, if positive. The services of the plugin are only registered once
+// the function succeeds, so that a plugin failing or timing out, even when
+// skipped, doesn't leave some of its services served. When timing out, the
+// function is left running in the background and the services it registers
+// are ignored.
+func callWithRegistrationTimeout(register func(grpc.ServiceRegistrar) (interface{}, error), registrar grpc.ServiceRegistrar, timeout time.Duration) (interface{}, error) {
+	pending := &pendingRegistrar{}
+	if timeout <= 0 {
+		server, err := register(pending)
+		if err != nil {
+			pending.discard()
+			return nil, err
+		}
+		pending.commit(registrar)
+		return server, nil
+	}
+
+	type result struct {
+		server interface{}
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		server, err := register(pending)
+		done <- result{server: server, err: err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		if r.err != nil {
+			pending.discard()
+			return nil, r.err
+		}
+		pending.commit(registrar)
+		return r.server, nil
+	case <-timer.C:
+		pending.discard()
+		return nil, errPluginRegistrationTimeout
+	}
+}
+
+// pendingRegistrar holds the services registered by a plugin until its
+// registration either succeeds, the services being then registered, or fails,
+// the services registered so far and later being ignored.
+type pendingRegistrar struct {
+	mu        sync.Mutex
+	services  []pendingService
+	discarded bool
+}
+
+type pendingService struct {
+	desc *grpc.ServiceDesc
+	impl interface{}
+}
+
+func (r *pendingRegistrar) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.discarded {
+		log.Warningf("Ignoring the service %q registered after the registration failed", desc.ServiceName)
+		return
+	}
+	r.services = append(r.services, pendingService{desc: desc, impl: impl})
+}
+
+func (r *pendingRegistrar) commit(registrar grpc.ServiceRegistrar) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, service := range r.services {
+		registrar.RegisterService(service.desc, service.impl)
+	}
+	r.services = nil
+}
+
+func (r *pendingRegistrar) discard() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, service := range r.services {
+		log.Warningf("Ignoring the service %q of a plugin which failed to register", service.desc.ServiceName)
+	}
+	r.services = nil
+	r.discarded = true
+}
+
+// skipDuplicatePlugin returns whether the plugin is to be skipped because a
+// plugin with the same name and version is already registered, or an error
+// when the policy rejects the duplicates, which it does by default.
+func skipDuplicatePlugin(pluginDetail *plugins.Plugin, pluginPath string, registered []*plugins.Plugin, policy string) (bool, error) {
+	for _, p := range registered {
+		if p.GetName() != pluginDetail.GetName() || p.GetVersion() != pluginDetail.GetVersion() {
+			continue
+		}
+		if policy == DuplicatePluginPolicyKeepFirst {
+			log.Warningf("Ignoring the plugin %q: the plugin %s %s is already registered", pluginPath, pluginDetail.GetName(), pluginDetail.GetVersion())
+			return true, nil
+		}
+		return false, fmt.Errorf("the plugin %q duplicates the already registered plugin %s %s", pluginPath, pluginDetail.GetName(), pluginDetail.GetVersion())
+	}
+	return false, nil
+}
+
 // createConfigGetter returns a function closure for creating the k8s config to interact with the cluster.
 // The returned function utilizes the user credential present in the request context.
 // The plugins just have to call this function passing the context in order to retrieve the configured k8s client
@@ -303,6 +812,15 @@ func createConfigGetter(serveOpts ServeOptions, clustersConfig kube.ClustersConf
 // createClientGetter takes the required params and returns the closure fuction.
 // it's splitted for testing this fn separately
 func createConfigGetterWithParams(inClusterConfig *rest.Config, serveOpts ServeOptions, clustersConfig kube.ClustersConfig) (KubernetesConfigGetter, error) {
+	if serveOpts.UseKubeappsClusterAPIServiceURL {
+		// The configs of the other clusters replace the host and TLS config
+		// anyway, so only the Kubeapps cluster is affected.
+		var err error
+		inClusterConfig, err = kubeappsClusterAPIServiceConfig(inClusterConfig, clustersConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
 	// return the closure fuction that takes the context, but preserving the required scope,
 	// 'inClusterConfig' and 'config'
 	return func(ctx context.Context, cluster string) (*rest.Config, error) {
@@ -325,6 +843,11 @@ func createConfigGetterWithParams(inClusterConfig *rest.Config, serveOpts ServeO
 			// If using the priviledged servicceAccount, just use the default inClusterConfig
 			// instead of creating a user config with authentication
 			config = inClusterConfig
+		} else if method, _ := grpc.Method(ctx); token == "" && cluster == clustersConfig.KubeappsClusterName && serveOpts.AllowAnonymousReads && isAnonymousReadMethod(method) {
+			// Only the catalog reads without a token use the inClusterConfig,
+			// the other calls being rejected beforehand or delegated to the
+			// RBAC without a token.
+			config = inClusterConfig
 		} else {
 			config, err = kube.NewClusterConfig(inClusterConfig, token, cluster, clustersConfig)
 			if err != nil {
@@ -335,6 +858,23 @@ func createConfigGetterWithParams(inClusterConfig *rest.Config, serveOpts ServeO
 	}, nil
 }
 
+// kubeappsClusterAPIServiceConfig returns a copy of the in-cluster config
+// reaching the Kubeapps cluster through its configured APIServiceURL, with
+// its TLS config, rather than through the in-cluster address.
+func kubeappsClusterAPIServiceConfig(inClusterConfig *rest.Config, clustersConfig kube.ClustersConfig) (*rest.Config, error) {
+	clusterConfig := clustersConfig.Clusters[clustersConfig.KubeappsClusterName]
+	if clusterConfig.APIServiceURL == "" {
+		return nil, fmt.Errorf("the Kubeapps cluster %q has no apiServiceURL configured", clustersConfig.KubeappsClusterName)
+	}
+	config := rest.CopyConfig(inClusterConfig)
+	config.Host = clusterConfig.APIServiceURL
+	config.TLSClientConfig = rest.TLSClientConfig{Insecure: clusterConfig.Insecure}
+	if clusterConfig.CertificateAuthorityDataDecoded != "" {
+		config.TLSClientConfig.CAData = []byte(clusterConfig.CertificateAuthorityDataDecoded)
+	}
+	return config, nil
+}
+
 // extractToken returns the token passed through the gRPC request in the "authorization" metadata in the context
 // It is equivalent to the "Authorization" usual HTTP 1 header
 // For instance: authorization="Bearer abc" will return "abc"
@@ -359,6 +899,39 @@ func extractToken(ctx context.Context) (string, error) {
 	}
 }
 
+// clusterDefaultNamespaces returns the default namespaces configured for the
+// clusters, the blank cluster name being mapped to the default namespace of
+// the default target cluster, which is the Kubeapps cluster unless given.
+func clusterDefaultNamespaces(clustersConfig kube.ClustersConfig, defaultTargetCluster string) map[string]string {
+	if defaultTargetCluster == "" {
+		defaultTargetCluster = clustersConfig.KubeappsClusterName
+	}
+	defaultNamespaces := map[string]string{}
+	for name, clusterConfig := range clustersConfig.Clusters {
+		if clusterConfig.DefaultNamespace == "" {
+			continue
+		}
+		defaultNamespaces[name] = clusterConfig.DefaultNamespace
+		if name == defaultTargetCluster {
+			defaultNamespaces[""] = clusterConfig.DefaultNamespace
+		}
+	}
+	return defaultNamespaces
+}
+
+// checkDefaultTargetCluster checks that the default target cluster, if any,
+// is one of the configured clusters. It is not checked without a clusters
+// configuration, as when using a local kubeconfig.
+func checkDefaultTargetCluster(defaultTargetCluster string, clustersConfig kube.ClustersConfig) error {
+	if defaultTargetCluster == "" || len(clustersConfig.Clusters) == 0 {
+		return nil
+	}
+	if _, ok := clustersConfig.Clusters[defaultTargetCluster]; !ok {
+		return fmt.Errorf("the default target cluster %q is not one of the configured clusters", defaultTargetCluster)
+	}
+	return nil
+}
+
 // getClustersConfigFromServeOpts get the serveOptions and calls parseClusterConfig with the proper values
 // returning a kube.ClustersConfig
 func getClustersConfigFromServeOpts(serveOpts ServeOptions) (kube.ClustersConfig, error) {
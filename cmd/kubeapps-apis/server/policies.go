@@ -0,0 +1,140 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes"
+)
+
+// clusterPolicyChecker checks the resources rendered for a package against
+// the cluster in which they would be installed, failing with the policy
+// violations, such as a reference to a storage class missing from the cluster.
+type clusterPolicyChecker func(ctx context.Context, cluster string, manifest string) error
+
+// classReference is a reference of a rendered resource to a cluster-scoped
+// class, such as a storage class, which must exist in the cluster.
+type classReference struct {
+	classKind string
+	className string
+	kind      string
+	name      string
+}
+
+// newClusterPolicyChecker returns a checker getting, with the credentials of
+// the user, the storage and ingress classes referenced by the rendered
+// resources. The references which cannot be checked, for example because the
+// user cannot get the classes, are only reported as warnings.
+func newClusterPolicyChecker(clientGetter func(ctx context.Context, cluster string) (kubernetes.Interface, error)) clusterPolicyChecker {
+	return func(ctx context.Context, cluster string, manifest string) error {
+		references, err := manifestClassReferences(manifest)
+		if err != nil {
+			AddWarning(ctx, "Unable to check the cluster policies of the rendered manifest: %v", err)
+			return nil
+		}
+		if len(references) == 0 {
+			return nil
+		}
+		client, err := clientGetter(ctx, cluster)
+		if err != nil {
+			AddWarning(ctx, "Unable to check the cluster policies in the cluster %q: %v", cluster, err)
+			return nil
+		}
+
+		violations := []string{}
+		for _, reference := range references {
+			switch reference.classKind {
+			case "StorageClass":
+				_, err = client.StorageV1().StorageClasses().Get(ctx, reference.className, metav1.GetOptions{})
+			case "IngressClass":
+				_, err = client.NetworkingV1().IngressClasses().Get(ctx, reference.className, metav1.GetOptions{})
+			}
+			if k8serrors.IsNotFound(err) {
+				violations = append(violations, fmt.Sprintf("the %s %q referenced by the %s %q does not exist", reference.classKind, reference.className, reference.kind, reference.name))
+			} else if err != nil {
+				AddWarning(ctx, "Unable to check the %s %q referenced by the %s %q: %v", reference.classKind, reference.className, reference.kind, reference.name, err)
+			}
+		}
+		if len(violations) > 0 {
+			return status.Errorf(codes.FailedPrecondition, "The rendered resources violate the policies of the cluster %q: %s", cluster, strings.Join(violations, "; "))
+		}
+		return nil
+	}
+}
+
+// clientsetGetter returns a function creating the clientset of a cluster
+// with the config returned by the config getter.
+func clientsetGetter(configGetter KubernetesConfigGetter) func(ctx context.Context, cluster string) (kubernetes.Interface, error) {
+	return func(ctx context.Context, cluster string) (kubernetes.Interface, error) {
+		config, err := configGetter(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+		return kubernetes.NewForConfig(config)
+	}
+}
+
+// manifestClassReferences returns, in the manifest order, the storage
+// classes referenced by the PersistentVolumeClaims and the volume claim
+// templates of the StatefulSets and the ingress classes referenced by the
+// Ingresses. An empty class name, which disables the dynamic provisioning,
+// is not a reference.
+func manifestClassReferences(manifest string) ([]classReference, error) {
+	references := []classReference{}
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+	for {
+		resource := &unstructured.Unstructured{}
+		if err := decoder.Decode(&resource.Object); err != nil {
+			if err == io.EOF {
+				return references, nil
+			}
+			return nil, err
+		}
+		addReference := func(classKind, className string) {
+			if className != "" {
+				references = append(references, classReference{
+					classKind: classKind,
+					className: className,
+					kind:      resource.GetKind(),
+					name:      resource.GetName(),
+				})
+			}
+		}
+		switch resource.GetKind() {
+		case "PersistentVolumeClaim":
+			className, _, _ := unstructured.NestedString(resource.Object, "spec", "storageClassName")
+			addReference("StorageClass", className)
+		case "StatefulSet":
+			templates, _, _ := unstructured.NestedSlice(resource.Object, "spec", "volumeClaimTemplates")
+			for _, template := range templates {
+				if templateMap, ok := template.(map[string]interface{}); ok {
+					className, _, _ := unstructured.NestedString(templateMap, "spec", "storageClassName")
+					addReference("StorageClass", className)
+				}
+			}
+		case "Ingress":
+			className, _, _ := unstructured.NestedString(resource.Object, "spec", "ingressClassName")
+			addReference("IngressClass", className)
+		}
+	}
+}
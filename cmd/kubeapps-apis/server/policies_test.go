@@ -0,0 +1,249 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	networkingv1 "k8s.io/api/networking/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// manifestPackagingPluginServer is a test plugin rendering every package as
+// the configured manifest.
+type manifestPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+	manifest string
+}
+
+func (s manifestPackagingPluginServer) RenderAvailablePackage(ctx context.Context, request *corev1.RenderAvailablePackageRequest) (*corev1.RenderAvailablePackageResponse, error) {
+	return &corev1.RenderAvailablePackageResponse{Manifest: s.manifest}, nil
+}
+
+const (
+	pvcManifest = `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: data
+spec:
+  storageClassName: %s
+`
+	statefulSetManifest = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+spec:
+  volumeClaimTemplates:
+  - metadata:
+      name: data
+    spec:
+      storageClassName: %s
+`
+	ingressManifest = `
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: web
+spec:
+  ingressClassName: %s
+`
+)
+
+func TestClusterPolicyChecker(t *testing.T) {
+	testCases := []struct {
+		name              string
+		manifest          string
+		forbidden         bool
+		expectedCode      codes.Code
+		expectedMessages  []string
+		expectedWarnings  int
+		expectedClientGet bool
+	}{
+		{
+			name:              "it accepts references to existing classes",
+			manifest:          fmt.Sprintf(pvcManifest, "standard") + "---" + fmt.Sprintf(ingressManifest, "nginx"),
+			expectedCode:      codes.OK,
+			expectedClientGet: true,
+		},
+		{
+			name:              "it rejects a persistent volume claim referencing a missing storage class",
+			manifest:          fmt.Sprintf(pvcManifest, "fast-ssd"),
+			expectedCode:      codes.FailedPrecondition,
+			expectedMessages:  []string{`the StorageClass "fast-ssd" referenced by the PersistentVolumeClaim "data" does not exist`},
+			expectedClientGet: true,
+		},
+		{
+			name:         "it rejects all the references to missing classes",
+			manifest:     fmt.Sprintf(statefulSetManifest, "fast-ssd") + "---" + fmt.Sprintf(ingressManifest, "traefik"),
+			expectedCode: codes.FailedPrecondition,
+			expectedMessages: []string{
+				`the StorageClass "fast-ssd" referenced by the StatefulSet "db" does not exist`,
+				`the IngressClass "traefik" referenced by the Ingress "web" does not exist`,
+			},
+			expectedClientGet: true,
+		},
+		{
+			name:         "it ignores an empty storage class name",
+			manifest:     fmt.Sprintf(pvcManifest, `""`),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it does not get a client for a manifest without references",
+			manifest:     "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n",
+			expectedCode: codes.OK,
+		},
+		{
+			name:              "it only warns about the references which cannot be checked",
+			manifest:          fmt.Sprintf(pvcManifest, "fast-ssd"),
+			forbidden:         true,
+			expectedCode:      codes.OK,
+			expectedWarnings:  1,
+			expectedClientGet: true,
+		},
+		{
+			name:             "it only warns about an invalid manifest",
+			manifest:         "kind: [",
+			expectedCode:     codes.OK,
+			expectedWarnings: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientSet := fake.NewSimpleClientset(
+				&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "standard"}},
+				&networkingv1.IngressClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+			)
+			if tc.forbidden {
+				clientSet.PrependReactor("get", "storageclasses", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, k8serrors.NewForbidden(schema.GroupResource{Group: "storage.k8s.io", Resource: "storageclasses"}, "fast-ssd", nil)
+				})
+			}
+			clientGet := false
+			checker := newClusterPolicyChecker(func(ctx context.Context, cluster string) (kubernetes.Interface, error) {
+				clientGet = true
+				return clientSet, nil
+			})
+			w := &warnings{}
+			ctx := context.WithValue(context.Background(), warningsContextKey{}, w)
+
+			err := checker(ctx, "default", tc.manifest)
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			for _, message := range tc.expectedMessages {
+				if !strings.Contains(status.Convert(err).Message(), message) {
+					t.Errorf("got: %q, want it to contain: %q", status.Convert(err).Message(), message)
+				}
+			}
+			if got, want := len(w.list()), tc.expectedWarnings; got != want {
+				t.Errorf("got: %d warnings (%v), want: %d", got, w.list(), want)
+			}
+			if got, want := clientGet, tc.expectedClientGet; got != want {
+				t.Errorf("got client get: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRenderAvailablePackageClusterPolicies(t *testing.T) {
+	plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+	clientSet := fake.NewSimpleClientset(&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "standard"}})
+
+	testCases := []struct {
+		name            string
+		manifest        string
+		targetContext   *corev1.Context
+		checkPolicies   bool
+		expectedCode    codes.Code
+		expectedCluster string
+	}{
+		{
+			name:            "it renders a package referencing an existing storage class",
+			manifest:        fmt.Sprintf(pvcManifest, "standard"),
+			checkPolicies:   true,
+			expectedCode:    codes.OK,
+			expectedCluster: "default",
+		},
+		{
+			name:            "it fails to render a package referencing a storage class missing from the target cluster",
+			manifest:        fmt.Sprintf(pvcManifest, "fast-ssd"),
+			targetContext:   &corev1.Context{Cluster: "other", Namespace: "my-ns"},
+			checkPolicies:   true,
+			expectedCode:    codes.FailedPrecondition,
+			expectedCluster: "other",
+		},
+		{
+			name:         "it does not check the cluster policies unless configured",
+			manifest:     fmt.Sprintf(pvcManifest, "fast-ssd"),
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkedCluster := ""
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: plugin,
+						server: manifestPackagingPluginServer{
+							TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+							manifest:                  tc.manifest,
+						},
+					},
+				},
+			}
+			if tc.checkPolicies {
+				server.checkClusterPolicies = newClusterPolicyChecker(func(ctx context.Context, cluster string) (kubernetes.Interface, error) {
+					checkedCluster = cluster
+					return clientSet, nil
+				})
+			}
+
+			_, err := server.RenderAvailablePackage(context.Background(), &corev1.RenderAvailablePackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: globalPackagingNamespace},
+					Identifier: "pkg-1",
+					Plugin:     plugin,
+				},
+				TargetContext: tc.targetContext,
+			})
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := checkedCluster, tc.expectedCluster; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	log "k8s.io/klog/v2"
+)
+
+// readmeChunkSize is the maximum size, in bytes, of the README chunks
+// streamed to the clients, well under the default message size limits.
+const readmeChunkSize = 64 * 1024
+
+// packagesReadmeServer implements the API defined in proto/kubeappsapis/core/packages/v1alpha1/packages.proto
+// streaming the READMEs returned by the GetAvailablePackageDetail of the plugins.
+type packagesReadmeServer struct {
+	packages.UnimplementedPackagesReadmeServiceServer
+
+	// packagesServer is used to get the available package details, so that
+	// the requests are routed to the plugins as for GetAvailablePackageDetail.
+	packagesServer *packagesServer
+
+	// chunkSize is the maximum size, in bytes, of the streamed chunks.
+	chunkSize int
+}
+
+func NewPackagesReadmeServer(packagesServer *packagesServer) *packagesReadmeServer {
+	return &packagesReadmeServer{
+		packagesServer: packagesServer,
+		chunkSize:      readmeChunkSize,
+	}
+}
+
+// GetAvailablePackageReadme streams the README of the requested package in chunks.
+func (s packagesReadmeServer) GetAvailablePackageReadme(request *packages.GetAvailablePackageReadmeRequest, stream packages.PackagesReadmeService_GetAvailablePackageReadmeServer) error {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetAvailablePackageReadme %s", contextMsg)
+
+	response, err := s.packagesServer.GetAvailablePackageDetail(stream.Context(), &packages.GetAvailablePackageDetailRequest{
+		AvailablePackageRef: request.GetAvailablePackageRef(),
+		PkgVersion:          request.GetPkgVersion(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range splitReadme(response.GetAvailablePackageDetail().GetReadme(), s.chunkSize) {
+		if err := stream.Send(&packages.GetAvailablePackageReadmeResponse{Chunk: chunk}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitReadme splits the README in chunks of at most chunkSize bytes, without
+// splitting multi-byte characters, since each chunk must be valid UTF-8.
+func splitReadme(readme string, chunkSize int) []string {
+	chunks := []string{}
+	for len(readme) > chunkSize {
+		end := chunkSize
+		for end > 0 && !utf8.RuneStart(readme[end]) {
+			end--
+		}
+		// A chunk size smaller than a character still makes progress.
+		if end == 0 {
+			_, end = utf8.DecodeRuneInString(readme)
+		}
+		chunks = append(chunks, readme[:end])
+		readme = readme[end:]
+	}
+	if readme != "" {
+		chunks = append(chunks, readme)
+	}
+	return chunks
+}
@@ -0,0 +1,145 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testReadmeStream is a GetAvailablePackageReadme server stream recording
+// the sent chunks, with the context of the embedded stream, if any.
+type testReadmeStream struct {
+	grpc.ServerStream
+
+	chunks []string
+}
+
+func (s *testReadmeStream) Context() context.Context {
+	if s.ServerStream != nil {
+		return s.ServerStream.Context()
+	}
+	return context.Background()
+}
+
+func (s *testReadmeStream) Send(response *corev1.GetAvailablePackageReadmeResponse) error {
+	s.chunks = append(s.chunks, response.GetChunk())
+	return nil
+}
+
+func TestGetAvailablePackageReadme(t *testing.T) {
+	// A large README including multi-byte characters which don't align with
+	// the chunk size.
+	largeReadme := strings.Repeat("# Apache chart ✓\n\nThe Apache HTTP Server is open-source.\n", 3000)
+
+	testCases := []struct {
+		name           string
+		readme         string
+		chunkSize      int
+		request        *corev1.GetAvailablePackageReadmeRequest
+		expectedChunks int
+		statusCode     codes.Code
+	}{
+		{
+			name:           "it streams a large README in multiple chunks",
+			readme:         largeReadme,
+			chunkSize:      readmeChunkSize,
+			expectedChunks: (len(largeReadme) + readmeChunkSize - 1) / readmeChunkSize,
+		},
+		{
+			name:           "it does not split multi-byte characters",
+			readme:         "✓✓✓",
+			chunkSize:      4,
+			expectedChunks: 3,
+		},
+		{
+			name:           "it streams a small README in a single chunk",
+			readme:         "# Apache",
+			chunkSize:      readmeChunkSize,
+			expectedChunks: 1,
+		},
+		{
+			name:           "it streams no chunk for an empty README",
+			readme:         "",
+			chunkSize:      readmeChunkSize,
+			expectedChunks: 0,
+		},
+		{
+			name:       "it returns the error of the plugin",
+			readme:     "# Apache",
+			chunkSize:  readmeChunkSize,
+			request:    &corev1.GetAvailablePackageReadmeRequest{AvailablePackageRef: &corev1.AvailablePackageReference{Identifier: "pkg-1"}},
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pluginDetails := &plugins.Plugin{Name: "readme-plugin", Version: "v1alpha1"}
+			packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+			packagingPluginServer.AvailablePackageDetail = plugin_test.MakeAvailablePackageDetail("pkg-1", pluginDetails)
+			packagingPluginServer.AvailablePackageDetail.Readme = tc.readme
+
+			server := NewPackagesReadmeServer(&packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: pluginDetails,
+						server: packagingPluginServer,
+					},
+				},
+			})
+			server.chunkSize = tc.chunkSize
+
+			request := tc.request
+			if request == nil {
+				request = &corev1.GetAvailablePackageReadmeRequest{
+					AvailablePackageRef: &corev1.AvailablePackageReference{
+						Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+						Identifier: "pkg-1",
+						Plugin:     pluginDetails,
+					},
+				}
+			}
+			stream := &testReadmeStream{}
+			err := server.GetAvailablePackageReadme(request, stream)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+
+			if got, want := len(stream.chunks), tc.expectedChunks; got != want {
+				t.Errorf("got: %d chunks, want: %d chunks", got, want)
+			}
+			for _, chunk := range stream.chunks {
+				if len(chunk) > tc.chunkSize || !utf8.ValidString(chunk) {
+					t.Errorf("got: invalid chunk of %d bytes, want: valid UTF-8 of at most %d bytes", len(chunk), tc.chunkSize)
+				}
+			}
+			if got, want := strings.Join(stream.chunks, ""), tc.readme; got != want {
+				t.Errorf("got: README of %d bytes, want: README of %d bytes", len(got), len(want))
+			}
+		})
+	}
+}
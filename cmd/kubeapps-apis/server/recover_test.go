@@ -0,0 +1,176 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// panickingPackagingPluginServer is a test plugin panicking on every call
+// to GetAvailablePackageDetail, GetInstalledPackageSummaries and
+// GetInstalledPackageDetail.
+type panickingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+}
+
+func (s panickingPackagingPluginServer) GetAvailablePackageDetail(ctx context.Context, request *corev1.GetAvailablePackageDetailRequest) (*corev1.GetAvailablePackageDetailResponse, error) {
+	panic("unexpected nil detail")
+}
+
+func (s panickingPackagingPluginServer) GetInstalledPackageSummaries(ctx context.Context, request *corev1.GetInstalledPackageSummariesRequest) (*corev1.GetInstalledPackageSummariesResponse, error) {
+	panic("unexpected nil summaries")
+}
+
+func (s panickingPackagingPluginServer) GetInstalledPackageDetail(ctx context.Context, request *corev1.GetInstalledPackageDetailRequest) (*corev1.GetInstalledPackageDetailResponse, error) {
+	panic("unexpected nil installed detail")
+}
+
+func TestRecoverPanicsInterceptorPluginPanics(t *testing.T) {
+	panickingPluginDetails := &plugins.Plugin{Name: "panicking-plugin", Version: "v1alpha1"}
+	panickingPlugin := &pkgsPluginWithServer{
+		plugin: panickingPluginDetails,
+		server: panickingPackagingPluginServer{
+			plugin_test.TestPackagingPluginServer{Plugin: panickingPluginDetails},
+		},
+	}
+	server := &packagesServer{
+		plugins: []*pkgsPluginWithServer{mockedPackagingPlugin1, panickingPlugin},
+	}
+	call := func(handler grpc.UnaryHandler) (interface{}, error) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/Method"}
+		return recoverPanicsInterceptor(context.Background(), nil, info, handler)
+	}
+
+	// A panicking plugin results in an Internal error for the single call.
+	_, err := call(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return server.GetAvailablePackageDetail(ctx, &corev1.GetAvailablePackageDetailRequest{
+			AvailablePackageRef: &corev1.AvailablePackageReference{
+				Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+				Identifier: "pkg-1",
+				Plugin:     panickingPluginDetails,
+			},
+		})
+	})
+	if got, want := status.Code(err), codes.Internal; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+
+	_, err = call(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return server.GetInstalledPackageSummaries(ctx, &corev1.GetInstalledPackageSummariesRequest{
+			Context: &corev1.Context{Namespace: globalPackagingNamespace},
+		})
+	})
+	if got, want := status.Code(err), codes.Internal; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+
+	// A panic of a plugin called concurrently for a batch is also handled
+	// as a panic of the call.
+	_, err = call(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return server.GetInstalledPackageDetailsBatch(ctx, &corev1.GetInstalledPackageDetailsBatchRequest{
+			InstalledPackageRefs: []*corev1.InstalledPackageReference{
+				{Context: &corev1.Context{Namespace: globalPackagingNamespace}, Identifier: "pkg-1", Plugin: mockedPackagingPlugin1.plugin},
+				{Context: &corev1.Context{Namespace: globalPackagingNamespace}, Identifier: "pkg-1", Plugin: panickingPluginDetails},
+			},
+		})
+	})
+	if got, want := status.Code(err), codes.Internal; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+
+	// The server remains usable for the calls to other plugins.
+	response, err := call(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return server.GetAvailablePackageDetail(ctx, &corev1.GetAvailablePackageDetailRequest{
+			AvailablePackageRef: &corev1.AvailablePackageReference{
+				Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+				Identifier: "pkg-1",
+				Plugin:     mockedPackagingPlugin1.plugin,
+			},
+		})
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expectedResponse := &corev1.GetAvailablePackageDetailResponse{
+		AvailablePackageDetail: plugin_test.MakeAvailablePackageDetail("pkg-1", mockedPackagingPlugin1.plugin),
+	}
+	if got, want := response, expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+	}
+}
+
+func TestRecoverPanicsInterceptor(t *testing.T) {
+	testCases := []struct {
+		name             string
+		handler          grpc.UnaryHandler
+		expectedResponse interface{}
+		statusCode       codes.Code
+	}{
+		{
+			name: "it returns the response of a successful handler",
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			},
+			expectedResponse: "ok",
+			statusCode:       codes.OK,
+		},
+		{
+			name: "it returns an Internal error when the handler panics",
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				panic("boom")
+			},
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+			response, err := recoverPanicsInterceptor(context.Background(), nil, info, tc.handler)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := response, tc.expectedResponse; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestRecoverPanicsStreamInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}
+
+	err := recoverPanicsStreamInterceptor(nil, &testServerStream{}, info, func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	})
+	if got, want := status.Code(err), codes.OK; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+
+	err = recoverPanicsStreamInterceptor(nil, &testServerStream{}, info, func(srv interface{}, stream grpc.ServerStream) error {
+		panic("boom")
+	})
+	if got, want := status.Code(err), codes.Internal; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
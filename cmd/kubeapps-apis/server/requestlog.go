@@ -0,0 +1,58 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog/v2"
+)
+
+// requestLogger logs a line per call with its result and duration. To reduce
+// the log volume of high-traffic deployments, only one in every sampling
+// successful reads is logged, the mutations and the failed calls being
+// always logged.
+type requestLogger struct {
+	sampling uint64
+	// reads counts the successful reads, the first of every sampling ones
+	// being logged.
+	reads uint64
+	logf  func(format string, args ...interface{})
+	now   func() time.Time
+}
+
+func newRequestLogger(sampling int) *requestLogger {
+	return &requestLogger{
+		sampling: uint64(sampling),
+		logf:     log.Infof,
+		now:      time.Now,
+	}
+}
+
+func (l *requestLogger) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := l.now()
+	resp, err := handler(ctx, req)
+	if err == nil && !isAuditedMethod(info.FullMethod) {
+		if (atomic.AddUint64(&l.reads, 1)-1)%l.sampling != 0 {
+			return resp, err
+		}
+		l.logf("+request %s code=%s duration=%s sampling=1/%d", info.FullMethod, status.Code(err), l.now().Sub(start), l.sampling)
+		return resp, err
+	}
+	l.logf("+request %s code=%s duration=%s", info.FullMethod, status.Code(err), l.now().Sub(start))
+	return resp, err
+}
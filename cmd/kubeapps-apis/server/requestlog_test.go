@@ -0,0 +1,127 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestLogger(t *testing.T) {
+	const (
+		readMethod   = "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail"
+		mutateMethod = "/kubeappsapis.core.packages.v1alpha1.PackagesService/UpdateInstalledPackage"
+	)
+
+	type call struct {
+		fullMethod string
+		code       codes.Code
+	}
+	calls := func(count int, fullMethod string, code codes.Code) []call {
+		result := []call{}
+		for i := 0; i < count; i++ {
+			result = append(result, call{fullMethod: fullMethod, code: code})
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name            string
+		sampling        int
+		calls           []call
+		expectedMethods map[string]int
+	}{
+		{
+			name:            "it logs one in every sampling reads, starting with the first",
+			sampling:        3,
+			calls:           calls(10, readMethod, codes.OK),
+			expectedMethods: map[string]int{readMethod: 4},
+		},
+		{
+			name:            "it logs every read without sampling",
+			sampling:        1,
+			calls:           calls(5, readMethod, codes.OK),
+			expectedMethods: map[string]int{readMethod: 5},
+		},
+		{
+			name:            "it always logs the mutations",
+			sampling:        100,
+			calls:           append(calls(3, mutateMethod, codes.OK), calls(3, readMethod, codes.OK)...),
+			expectedMethods: map[string]int{mutateMethod: 3, readMethod: 1},
+		},
+		{
+			name:            "it always logs the failed reads, which are not counted as sampled",
+			sampling:        2,
+			calls:           append(calls(3, readMethod, codes.NotFound), calls(4, readMethod, codes.OK)...),
+			expectedMethods: map[string]int{readMethod: 5},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lines := []string{}
+			logger := newRequestLogger(tc.sampling)
+			logger.logf = func(format string, args ...interface{}) {
+				lines = append(lines, fmt.Sprintf(format, args...))
+			}
+			logger.now = func() time.Time { return time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC) }
+
+			for _, c := range tc.calls {
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					if c.code != codes.OK {
+						return nil, status.Errorf(c.code, "failed")
+					}
+					return "response", nil
+				}
+				logger.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: c.fullMethod}, handler)
+			}
+
+			loggedMethods := map[string]int{}
+			for _, line := range lines {
+				loggedMethods[strings.Fields(line)[1]]++
+			}
+			for method, expected := range tc.expectedMethods {
+				if got, want := loggedMethods[method], expected; got != want {
+					t.Errorf("got: %d lines logged for %q, want: %d, lines: %v", got, method, want, lines)
+				}
+			}
+		})
+	}
+}
+
+func TestRequestLoggerLine(t *testing.T) {
+	lines := []string{}
+	logger := newRequestLogger(1)
+	logger.logf = func(format string, args ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+	start := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	logger.now = func() time.Time { return now }
+
+	logger.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		now = start.Add(250 * time.Millisecond)
+		return nil, status.Errorf(codes.AlreadyExists, "exists")
+	})
+
+	if got, want := lines, []string{"+request /kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage code=AlreadyExists duration=250ms"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
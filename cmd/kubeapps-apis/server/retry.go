@@ -0,0 +1,278 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"time"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	// idempotencyKeyMetadataKey is the request metadata key with which clients
+	// can mark a mutating call as safe to be retried.
+	idempotencyKeyMetadataKey = "idempotency-key"
+	// defaultRetryBackoff is the delay before the first retry of a plugin call,
+	// doubled for each subsequent retry.
+	defaultRetryBackoff = 100 * time.Millisecond
+)
+
+// retryableCodes are the status codes of transient plugin failures, returned
+// before the call had any effect. Aborted is not included as it can be
+// returned by a call which was partially applied.
+var retryableCodes = map[codes.Code]bool{
+	codes.Unavailable:       true,
+	codes.ResourceExhausted: true,
+}
+
+// retryingPackagesServer wraps the packages server implementation of a plugin
+// so that calls failing with a transient error are retried. Reads are always
+// retried while mutating calls are only retried when the request carries an
+// idempotency key, to avoid for example duplicate installations.
+type retryingPackagesServer struct {
+	PackagesPluginServer
+
+	plugin  *plugins.Plugin
+	retries int
+	backoff time.Duration
+
+	// retryFailedInstalls retries once, even without an idempotency key,
+	// the creations and updates of installed packages failing with a
+	// transient error. The transient errors are returned before anything is
+	// applied and, as installed packages are named, a creation which was
+	// applied anyway fails rather than being duplicated.
+	retryFailedInstalls bool
+}
+
+func newRetryingPackagesServer(plugin *plugins.Plugin, server PackagesPluginServer, retries int, retryFailedInstalls bool) *retryingPackagesServer {
+	return &retryingPackagesServer{
+		PackagesPluginServer: server,
+		plugin:               plugin,
+		retries:              retries,
+		backoff:              defaultRetryBackoff,
+		retryFailedInstalls:  retryFailedInstalls,
+	}
+}
+
+// retry calls the plugin until it succeeds, fails with a non-transient error or
+// the retries are exhausted. Only idempotent calls, or calls with an idempotency
+// key, are retried.
+func (s *retryingPackagesServer) retry(ctx context.Context, idempotent bool, call func() error) error {
+	attempts := 1
+	if idempotent || hasIdempotencyKey(ctx) {
+		attempts += s.retries
+	}
+	return s.attempt(ctx, attempts, call)
+}
+
+// retryInstall calls the plugin to create or update an installed package,
+// retrying it as a mutating call and, if configured, at least once.
+func (s *retryingPackagesServer) retryInstall(ctx context.Context, call func() error) error {
+	attempts := 1
+	if hasIdempotencyKey(ctx) {
+		attempts += s.retries
+	}
+	if s.retryFailedInstalls && attempts < 2 {
+		attempts = 2
+	}
+	return s.attempt(ctx, attempts, call)
+}
+
+// attempt calls the plugin up to the given number of attempts, with an
+// exponential backoff, until it succeeds or fails with a non-transient error.
+func (s *retryingPackagesServer) attempt(ctx context.Context, attempts int, call func() error) error {
+	backoff := s.backoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = call()
+		if err == nil || !retryableCodes[status.Code(err)] || attempt >= attempts {
+			return err
+		}
+		log.Warningf("Retrying the call to the plugin %q (attempt %d of %d) after a transient error: %v", s.plugin.GetName(), attempt+1, attempts, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// hasIdempotencyKey returns whether the request metadata includes an
+// idempotency key.
+func hasIdempotencyKey(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(idempotencyKeyMetadataKey)
+	return len(values) > 0 && values[0] != ""
+}
+
+func (s *retryingPackagesServer) GetAvailablePackageSummaries(ctx context.Context, request *packages.GetAvailablePackageSummariesRequest) (response *packages.GetAvailablePackageSummariesResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetAvailablePackageSummaries(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetAvailablePackageDetail(ctx context.Context, request *packages.GetAvailablePackageDetailRequest) (response *packages.GetAvailablePackageDetailResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetAvailablePackageDetail(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetAvailablePackageVersions(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (response *packages.GetAvailablePackageVersionsResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetAvailablePackageVersions(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetAvailablePackageDependencies(ctx context.Context, request *packages.GetAvailablePackageDependenciesRequest) (response *packages.GetAvailablePackageDependenciesResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetAvailablePackageDependencies(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetAvailablePackageDefaultValues(ctx context.Context, request *packages.GetAvailablePackageDefaultValuesRequest) (response *packages.GetAvailablePackageDefaultValuesResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetAvailablePackageDefaultValues(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetAvailablePackageChangelog(ctx context.Context, request *packages.GetAvailablePackageChangelogRequest) (response *packages.GetAvailablePackageChangelogResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetAvailablePackageChangelog(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetAvailablePackageValuesDiff(ctx context.Context, request *packages.GetAvailablePackageValuesDiffRequest) (response *packages.GetAvailablePackageValuesDiffResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetAvailablePackageValuesDiff(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) RenderAvailablePackage(ctx context.Context, request *packages.RenderAvailablePackageRequest) (response *packages.RenderAvailablePackageResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.RenderAvailablePackage(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (response *packages.GetInstalledPackageSummariesResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetInstalledPackageSummaries(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetInstalledPackageDetail(ctx context.Context, request *packages.GetInstalledPackageDetailRequest) (response *packages.GetInstalledPackageDetailResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetInstalledPackageDetail(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetInstalledPackageValues(ctx context.Context, request *packages.GetInstalledPackageValuesRequest) (response *packages.GetInstalledPackageValuesResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetInstalledPackageValues(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (response *packages.CreateInstalledPackageResponse, err error) {
+	err = s.retryInstall(ctx, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.CreateInstalledPackage(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (response *packages.UpdateInstalledPackageResponse, err error) {
+	err = s.retryInstall(ctx, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.UpdateInstalledPackage(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetInstalledPackageUpgradeDiff(ctx context.Context, request *packages.GetInstalledPackageUpgradeDiffRequest) (response *packages.GetInstalledPackageUpgradeDiffResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetInstalledPackageUpgradeDiff(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetInstalledPackageDrift(ctx context.Context, request *packages.GetInstalledPackageDriftRequest) (response *packages.GetInstalledPackageDriftResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetInstalledPackageDrift(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) ExportInstalledPackage(ctx context.Context, request *packages.ExportInstalledPackageRequest) (response *packages.ExportInstalledPackageResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.ExportInstalledPackage(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (response *packages.DeleteInstalledPackageResponse, err error) {
+	err = s.retry(ctx, false, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.DeleteInstalledPackage(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetRecentActivity(ctx context.Context, request *packages.GetRecentActivityRequest) (response *packages.GetRecentActivityResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetRecentActivity(ctx, request)
+		return callErr
+	})
+	return response, err
+}
+
+func (s *retryingPackagesServer) GetUserPermissions(ctx context.Context, request *packages.GetUserPermissionsRequest) (response *packages.GetUserPermissionsResponse, err error) {
+	err = s.retry(ctx, true, func() (callErr error) {
+		response, callErr = s.PackagesPluginServer.GetUserPermissions(ctx, request)
+		return callErr
+	})
+	return response, err
+}
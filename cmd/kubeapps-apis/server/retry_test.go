@@ -0,0 +1,207 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// flakyPackagingPluginServer is a test plugin whose calls fail with the
+// given status code until the given number of failures is reached.
+type flakyPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	failures   int
+	statusCode codes.Code
+	calls      *int
+}
+
+func (s flakyPackagingPluginServer) fail() error {
+	*s.calls++
+	if *s.calls <= s.failures {
+		return status.Errorf(s.statusCode, "failure %d", *s.calls)
+	}
+	return nil
+}
+
+func (s flakyPackagingPluginServer) GetAvailablePackageSummaries(ctx context.Context, request *corev1.GetAvailablePackageSummariesRequest) (*corev1.GetAvailablePackageSummariesResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return s.TestPackagingPluginServer.GetAvailablePackageSummaries(ctx, request)
+}
+
+func (s flakyPackagingPluginServer) CreateInstalledPackage(ctx context.Context, request *corev1.CreateInstalledPackageRequest) (*corev1.CreateInstalledPackageResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return s.TestPackagingPluginServer.CreateInstalledPackage(ctx, request)
+}
+
+func (s flakyPackagingPluginServer) UpdateInstalledPackage(ctx context.Context, request *corev1.UpdateInstalledPackageRequest) (*corev1.UpdateInstalledPackageResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return s.TestPackagingPluginServer.UpdateInstalledPackage(ctx, request)
+}
+
+func TestRetryingPackagesServer(t *testing.T) {
+	testCases := []struct {
+		name                string
+		failures            int
+		statusCode          codes.Code
+		idempotencyKey      string
+		retryFailedInstalls bool
+		call                func(ctx context.Context, server *retryingPackagesServer) error
+		expectedCalls       int
+		expectedCode        codes.Code
+	}{
+		{
+			name:          "it retries a read failing with a transient error",
+			failures:      2,
+			statusCode:    codes.Unavailable,
+			call:          getAvailablePackageSummaries,
+			expectedCalls: 3,
+			expectedCode:  codes.OK,
+		},
+		{
+			name:          "it gives up on a read once the retries are exhausted",
+			failures:      5,
+			statusCode:    codes.Unavailable,
+			call:          getAvailablePackageSummaries,
+			expectedCalls: 3,
+			expectedCode:  codes.Unavailable,
+		},
+		{
+			name:          "it does not retry a read failing with a non-transient error",
+			failures:      1,
+			statusCode:    codes.NotFound,
+			call:          getAvailablePackageSummaries,
+			expectedCalls: 1,
+			expectedCode:  codes.NotFound,
+		},
+		{
+			name:          "it does not retry a create without an idempotency key",
+			failures:      1,
+			statusCode:    codes.Unavailable,
+			call:          createInstalledPackage,
+			expectedCalls: 1,
+			expectedCode:  codes.Unavailable,
+		},
+		{
+			name:           "it retries a create with an idempotency key",
+			failures:       1,
+			statusCode:     codes.Unavailable,
+			idempotencyKey: "a1b2c3",
+			call:           createInstalledPackage,
+			expectedCalls:  2,
+			expectedCode:   codes.OK,
+		},
+		{
+			name:                "it retries once a create failing with a transient error when retrying failed installs",
+			failures:            1,
+			statusCode:          codes.Unavailable,
+			retryFailedInstalls: true,
+			call:                createInstalledPackage,
+			expectedCalls:       2,
+			expectedCode:        codes.OK,
+		},
+		{
+			name:                "it gives up on a create failing permanently after a single retry when retrying failed installs",
+			failures:            5,
+			statusCode:          codes.Unavailable,
+			retryFailedInstalls: true,
+			call:                createInstalledPackage,
+			expectedCalls:       2,
+			expectedCode:        codes.Unavailable,
+		},
+		{
+			name:                "it does not retry a create failing with a non-transient error when retrying failed installs",
+			failures:            1,
+			statusCode:          codes.AlreadyExists,
+			retryFailedInstalls: true,
+			call:                createInstalledPackage,
+			expectedCalls:       1,
+			expectedCode:        codes.AlreadyExists,
+		},
+		{
+			name:                "it retries once an update failing with a transient error when retrying failed installs",
+			failures:            1,
+			statusCode:          codes.ResourceExhausted,
+			retryFailedInstalls: true,
+			call:                updateInstalledPackage,
+			expectedCalls:       2,
+			expectedCode:        codes.OK,
+		},
+		{
+			name:          "it does not retry an update without an idempotency key",
+			failures:      1,
+			statusCode:    codes.Unavailable,
+			call:          updateInstalledPackage,
+			expectedCalls: 1,
+			expectedCode:  codes.Unavailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "flaky-plugin", Version: "v1alpha1"}
+			calls := 0
+			server := newRetryingPackagesServer(plugin, flakyPackagingPluginServer{
+				TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+				failures:                  tc.failures,
+				statusCode:                tc.statusCode,
+				calls:                     &calls,
+			}, 2, tc.retryFailedInstalls)
+			server.backoff = 0
+
+			ctx := context.Background()
+			if tc.idempotencyKey != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+					idempotencyKeyMetadataKey: tc.idempotencyKey,
+				}))
+			}
+			err := tc.call(ctx, server)
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := calls, tc.expectedCalls; got != want {
+				t.Errorf("got: %d calls, want: %d calls", got, want)
+			}
+		})
+	}
+}
+
+func getAvailablePackageSummaries(ctx context.Context, server *retryingPackagesServer) error {
+	_, err := server.GetAvailablePackageSummaries(ctx, &corev1.GetAvailablePackageSummariesRequest{})
+	return err
+}
+
+func createInstalledPackage(ctx context.Context, server *retryingPackagesServer) error {
+	_, err := server.CreateInstalledPackage(ctx, &corev1.CreateInstalledPackageRequest{})
+	return err
+}
+
+func updateInstalledPackage(ctx context.Context, server *retryingPackagesServer) error {
+	_, err := server.UpdateInstalledPackage(ctx, &corev1.UpdateInstalledPackageRequest{})
+	return err
+}
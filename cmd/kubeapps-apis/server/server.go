@@ -21,6 +21,10 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/soheilhy/cmux"
@@ -29,16 +33,221 @@ import (
 	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 	log "k8s.io/klog/v2"
 )
 
+// shutdownTimeout is the time given to the in-flight http requests to complete
+// when shutting down the server.
+const shutdownTimeout = 20 * time.Second
+
 type ServeOptions struct {
 	Port               int
 	PluginDirs         []string
 	ClustersConfigPath string
 	PinnipedProxyURL   string
+	// CompressionLevel is the gzip level used when a client negotiates
+	// compressed responses (see compress/gzip for the valid values).
+	CompressionLevel int
+	// NamespaceClusterMapping maps namespace prefixes to cluster names, so
+	// that requests with a namespace but without a cluster are routed to the
+	// cluster to which the namespace logically belongs.
+	NamespaceClusterMapping map[string]string
+	// DefaultTargetCluster is the cluster targeted by the requests without a
+	// cluster nor a namespace mapped to a cluster, when it differs from the
+	// cluster on which Kubeapps is installed. The plugins requesting a blank
+	// cluster still get the Kubeapps cluster.
+	DefaultTargetCluster string
+	// CategoryOrder lists the categories pinned, in the given order, to the
+	// front of the merged categories. The rest are sorted alphabetically.
+	CategoryOrder []string
+	// PluginOrder lists the plugins, in the given order, whose available
+	// packages come first among the ones with the same name. The rest are
+	// ordered by plugin name.
+	PluginOrder []string
+	// MergeConflictingInstalledPackages returns a single summary for an
+	// installed package (same cluster, namespace and identifier) reported by
+	// several plugins: the one of the first configured plugin, listing the
+	// other plugins as conflicting. Disabled by default.
+	MergeConflictingInstalledPackages bool
+	// MaxPlugins is the maximum number of plugins which can be loaded
+	// (0 for no limit). When exceeded, the server refuses to start unless
+	// TruncatePlugins is set, in which case only the first MaxPlugins
+	// plugins are loaded.
+	MaxPlugins      int
+	TruncatePlugins bool
+	// DuplicatePluginPolicy is how the plugins with the same name and version
+	// as an already registered plugin are handled: DuplicatePluginPolicyReject,
+	// the default when blank, refuses to start while
+	// DuplicatePluginPolicyKeepFirst ignores them with a warning.
+	DuplicatePluginPolicy string
+	// PluginRegistrationTimeout is the time given to the registration
+	// function of each plugin to register the plugin, so that a plugin
+	// blocking on load cannot block the startup. Not limited when zero.
+	PluginRegistrationTimeout time.Duration
+	// PluginRegistrationTimeoutPolicy is how the plugins which do not
+	// register within the PluginRegistrationTimeout are handled:
+	// RegistrationTimeoutPolicyFail, the default when blank, refuses to start
+	// while RegistrationTimeoutPolicySkip ignores them with a warning.
+	PluginRegistrationTimeoutPolicy string
+	// PluginCallRetries is the number of times a plugin call failing with a
+	// transient error is retried. Mutating calls are only retried when the
+	// request includes an idempotency key.
+	PluginCallRetries int
+	// RetryFailedInstalls retries once, after a backoff, the creations and
+	// updates of installed packages failing with a transient plugin error,
+	// even when the request does not include an idempotency key.
+	RetryFailedInstalls bool
+	// PluginCallTimeout is the duration after which a plugin call, including
+	// its retries, is cancelled (0 for no timeout). PluginCallTimeouts
+	// overrides it per plugin name, for the inherently slower plugins.
+	PluginCallTimeout  time.Duration
+	PluginCallTimeouts map[string]time.Duration
+	// KeepaliveTime and KeepaliveTimeout configure the pings sent to idle
+	// clients and how long to wait for their acknowledgement, while
+	// KeepaliveMinTime and KeepalivePermitWithoutStream configure the
+	// enforcement policy for the pings sent by clients.
+	KeepaliveTime                time.Duration
+	KeepaliveTimeout             time.Duration
+	KeepaliveMinTime             time.Duration
+	KeepalivePermitWithoutStream bool
+	// AuditLogPath is the file to which an audit record is appended for each
+	// mutating call, or "stdout". Audit records are disabled when empty.
+	AuditLogPath string
+	// ForwardMetadataKeys lists the request metadata keys, in addition to the
+	// authorization, which are forwarded to the plugins (eg. correlation ids
+	// or trace headers). Any other request metadata is dropped.
+	ForwardMetadataKeys []string
+	// VersionsCacheTTL is the duration for which the package version listings
+	// returned by the plugins are cached, per user. Disabled when zero.
+	VersionsCacheTTL time.Duration
+	// VersionsCacheRefreshInterval is the interval, randomly extended by a
+	// jitter, between the background refreshes of the cached anonymous
+	// listings which were requested again and expire before the next
+	// refresh, made with the token of the service account of the server.
+	// Disabled when zero or when the anonymous reads are not allowed.
+	VersionsCacheRefreshInterval time.Duration
+	// VersionsCacheRefreshConcurrency is the maximum number of listings
+	// fetched concurrently by a background refresh.
+	VersionsCacheRefreshConcurrency int
+	// ClientErrorVerbosity is either ClientErrorVerbosityMinimal, to only
+	// return a generic message with a correlation id to the clients while
+	// logging the error, or ClientErrorVerbosityDetailed for the full error.
+	// The invalid argument, not found and already exists errors are always
+	// returned in full.
+	ClientErrorVerbosity string
+	// MaxValuesSize is the maximum size, in bytes, of the values of the
+	// requests creating or updating an installed package (0 for no limit).
+	MaxValuesSize int
+	// AllowAnonymousReads lets the catalog reads (the GetAvailablePackage*
+	// methods) without a token proceed with the in-cluster config, while the
+	// other calls without a token are rejected as unauthenticated.
+	AllowAnonymousReads bool
+	// PluginFeatureFlags maps plugin names to the feature flags passed to
+	// the plugin when registered, so that experimental behaviors can be
+	// toggled without a plugin-specific config file.
+	PluginFeatureFlags map[string]map[string]bool
+	// PluginEndpoints maps plugin names to the backends serving their calls
+	// in a highly available deployment, the reads being routed to the read
+	// endpoint and the mutations to the write endpoint.
+	PluginEndpoints map[string]PluginEndpoints
+	// PluginEndpointsTLS is the TLS configuration of the connections to the
+	// PluginEndpoints, which use TLS unless configured as insecure.
+	PluginEndpointsTLS PluginEndpointsTLS
+	// PluginVersionFallback routes the read requests to the requested
+	// version of the plugin, if loaded, or else to the nearest loaded
+	// version of the same plugin. By default, the requests are routed by the
+	// plugin name only.
+	PluginVersionFallback bool
+	// QualifyAmbiguousIdentifiers qualifies the identifiers of the available
+	// packages published by several repositories of the same plugin with
+	// their repository (eg. "ns-1/repo-a:pkg-1"), such identifiers being
+	// accepted by GetAvailablePackageDetail.
+	QualifyAmbiguousIdentifiers bool
+	// MaxConcurrentInstalls limits the number of create or update operations
+	// of installed packages running concurrently across all plugins (0 for
+	// no limit). Further operations are rejected as ResourceExhausted.
+	MaxConcurrentInstalls int
+	// InstallQueueTimeout is the duration an install operation waits for a
+	// slot when MaxConcurrentInstalls is reached, before being rejected. It
+	// is rejected immediately when zero.
+	InstallQueueTimeout time.Duration
+	// ValidateRequests rejects, as InvalidArgument, the requests violating
+	// the protoc-gen-validate rules of their message before they reach the
+	// handlers.
+	ValidateRequests bool
+	// EmptyNamespacePolicy is how the requests creating, updating or
+	// deleting an installed package with a blank namespace are handled:
+	// EmptyNamespacePolicyReject rejects them as InvalidArgument while
+	// EmptyNamespacePolicyDefault falls back to the default namespace of the
+	// cluster. When blank, they are forwarded to the plugins unless the
+	// cluster has a default namespace.
+	EmptyNamespacePolicy string
+	// NilPluginResponsesAsErrors fails, as Internal, the plugin reads
+	// returning neither a response nor an error, rather than using an empty
+	// response and logging a warning. Such mutations always fail.
+	NilPluginResponsesAsErrors bool
+	// MetricsNamespace prefixes, followed by an underscore, the names of all
+	// the exported prometheus metrics, so that they are namespaced when
+	// scraped into a shared prometheus. Not prefixed when empty.
+	MetricsNamespace string
+	// SlimResponses omits, from the contexts of the responses of the
+	// packages service, the cluster and namespace equal to the default target
+	// cluster and its default namespace, for single-cluster deployments. The
+	// cluster is kept for the namespaces mapped by NamespaceClusterMapping.
+	SlimResponses bool
+	// BatchConcurrency is the maximum number of references of a batch
+	// request, such as the details or deletion of several installed
+	// packages, processed concurrently. The rest are queued. Not limited
+	// when zero.
+	BatchConcurrency int
+	// NamespaceDefaultingOrder is the order in which a blank cluster and a
+	// blank namespace of a request are defaulted:
+	// NamespaceDefaultingOrderClusterFirst, the default, resolves the
+	// cluster before using its default namespace while
+	// NamespaceDefaultingOrderNamespaceFirst resolves the namespace first,
+	// routing the defaulted namespace with the namespace cluster mapping.
+	NamespaceDefaultingOrder string
+	// TenantMetadataKey is the request metadata key identifying the tenant
+	// of a call in a multi-tenant deployment, the contexts of the calls of
+	// a tenant being restricted to its TenantNamespaces. When set, the calls
+	// without the key are rejected. The tenant is not verified, so the key
+	// must be set by a trusted proxy replacing the one of the clients. The
+	// calls are not scoped when empty.
+	TenantMetadataKey string
+	// TenantNamespaces maps the tenants to the namespaces they can access,
+	// as <cluster>/<namespace>.
+	TenantNamespaces map[string][]string
+	// ValidateClusterPolicies checks the resources rendered when previewing
+	// an install against the target cluster, failing the preview when they
+	// reference storage or ingress classes missing from the cluster.
+	ValidateClusterPolicies bool
+	// RequestLogSampling enables the logging of a line per call, with its
+	// result and duration, logging only one in every RequestLogSampling
+	// successful reads while the mutations and the failed calls are always
+	// logged. Disabled when zero.
+	RequestLogSampling int
+	// ExpiredTokenPolicy is how the calls with an expired JWT are handled:
+	// ExpiredTokenPolicyReject rejects them as unauthenticated with a "token
+	// expired" error while ExpiredTokenPolicyForward, the default, forwards
+	// them to the plugins.
+	ExpiredTokenPolicy string
+	// ExpiredTokenLeeway is the duration after their expiry during which the
+	// tokens are still accepted when rejecting the expired tokens, to allow
+	// for the clock skew with the token issuer.
+	ExpiredTokenLeeway time.Duration
+	// DefaultInstallTimeout is the install timeout forwarded to the plugins
+	// with the requests creating an installed package which do not specify
+	// one, such as the helm --timeout bounding the Kubernetes operations of
+	// the install, without waiting for the release. None when zero.
+	DefaultInstallTimeout time.Duration
+	// UseKubeappsClusterAPIServiceURL reaches the Kubeapps cluster through
+	// its configured APIServiceURL, as the other clusters, rather than
+	// through the in-cluster config address.
+	UseKubeappsClusterAPIServiceURL bool
 	//temporary flags while this component in under heavy development
 	UnsafeUseDemoSA          bool
 	UnsafeLocalDevKubeconfig bool
@@ -47,16 +256,49 @@ type ServeOptions struct {
 // Serve is the root command that is run when no other sub-commands are present.
 // It runs the gRPC service, registering the configured plugins.
 func Serve(serveOpts ServeOptions) error {
+	// Set the level of the gzip compressor used when clients negotiate
+	// compressed responses.
+	if err := setGzipCompressionLevel(serveOpts.CompressionLevel); err != nil {
+		return err
+	}
+
 	// Create the grpc server and register the reflection server (for now, useful for discovery
 	// using grpcurl) or similar.
-	grpcSrv := grpc.NewServer()
+	var slimmer *responseSlimmer
+	if serveOpts.SlimResponses {
+		slimmer = &responseSlimmer{namespaceClusterMapping: serveOpts.NamespaceClusterMapping}
+	}
+	var isolation *tenantIsolation
+	if serveOpts.TenantMetadataKey != "" {
+		isolation = newTenantIsolation(serveOpts.TenantMetadataKey, serveOpts.TenantNamespaces)
+	}
+	var auditLog *auditLogger
+	if serveOpts.AuditLogPath != "" {
+		var err error
+		if auditLog, err = newAuditLogger(serveOpts.AuditLogPath); err != nil {
+			return err
+		}
+		defer func() {
+			if err := auditLog.close(); err != nil {
+				log.Errorf("Failed to close the audit log: %v", err)
+			}
+		}()
+	}
+	grpcSrvOpts, err := grpcServerOptions(serveOpts, slimmer, isolation, auditLog)
+	if err != nil {
+		return err
+	}
+	grpcSrv := grpc.NewServer(grpcSrvOpts...)
 	reflection.Register(grpcSrv)
 
 	// Create the http server, register our core service followed by any plugins.
 	listenAddr := fmt.Sprintf(":%d", serveOpts.Port)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	gw, err := gatewayMux()
+	if err := checkMetricsNamespace(serveOpts.MetricsNamespace); err != nil {
+		return err
+	}
+	gw, err := gatewayMux(serveOpts.MetricsNamespace)
 	if err != nil {
 		return fmt.Errorf("Failed to create gateway: %v", err)
 	}
@@ -80,12 +322,45 @@ func Serve(serveOpts ServeOptions) error {
 	}
 
 	// Create the core.packages server and register it for both grpc and http.
-	packages.RegisterPackagesServiceServer(grpcSrv, NewPackagesServer(pluginsServer.packagesPlugins))
+	if err := checkEmptyNamespacePolicy(serveOpts.EmptyNamespacePolicy); err != nil {
+		return err
+	}
+	if err := checkNamespaceDefaultingOrder(serveOpts.NamespaceDefaultingOrder); err != nil {
+		return err
+	}
+	if err := checkDefaultTargetCluster(serveOpts.DefaultTargetCluster, pluginsServer.clustersConfig); err != nil {
+		return err
+	}
+	defaultNamespaces := clusterDefaultNamespaces(pluginsServer.clustersConfig, serveOpts.DefaultTargetCluster)
+	if slimmer != nil {
+		defaultCluster := serveOpts.DefaultTargetCluster
+		if defaultCluster == "" {
+			defaultCluster = pluginsServer.clustersConfig.KubeappsClusterName
+		}
+		slimmer.setDefaults(defaultCluster, defaultNamespaces[""])
+	}
+	if isolation != nil {
+		isolation.setKubeappsCluster(pluginsServer.clustersConfig.KubeappsClusterName)
+	}
+	packagesServer := NewPackagesServer(pluginsServer.packagesPlugins, serveOpts, defaultNamespaces)
+	if serveOpts.ValidateClusterPolicies {
+		packagesServer.checkClusterPolicies = pluginsServer.checkClusterPolicies
+	}
+	packagesServer.startVersionsCacheRefresh(ctx)
+	packages.RegisterPackagesServiceServer(grpcSrv, packagesServer)
 	err = packages.RegisterPackagesServiceHandlerFromEndpoint(gwArgs.ctx, gwArgs.mux, gwArgs.addr, gwArgs.dialOptions)
 	if err != nil {
 		return fmt.Errorf("failed to register core.packages handler for gateway: %v", err)
 	}
 
+	// Create the core.packages readme server, streaming the READMEs of the
+	// packages server, and register it for both grpc and http.
+	packages.RegisterPackagesReadmeServiceServer(grpcSrv, NewPackagesReadmeServer(packagesServer))
+	err = packages.RegisterPackagesReadmeServiceHandlerFromEndpoint(gwArgs.ctx, gwArgs.mux, gwArgs.addr, gwArgs.dialOptions)
+	if err != nil {
+		return fmt.Errorf("failed to register core.packages readme handler for gateway: %v", err)
+	}
+
 	lis, err := net.Listen("tcp", listenAddr)
 	if err != nil {
 		return fmt.Errorf("failed to listen: %v", err)
@@ -116,21 +391,40 @@ func Serve(serveOpts ServeOptions) error {
 		}),
 	}
 
+	// Stop the servers on SIGINT or SIGTERM, draining the in-flight calls,
+	// so that their audit records are written before returning.
+	stopping, stopped := make(chan struct{}), make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		log.Infof("Shutting down the server on %v", sig)
+		close(stopping)
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer shutdownCancel()
+		if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+			log.Errorf("Failed to shut down the http server: %v", err)
+		}
+		grpcSrv.GracefulStop()
+		lis.Close()
+		close(stopped)
+	}()
+
 	go func() {
 		err := grpcSrv.Serve(grpcLis)
-		if err != nil {
+		if err != nil && !isClosed(stopping) {
 			log.Fatalf("failed to serve: %v", err)
 		}
 	}()
 	go func() {
 		err := grpcSrv.Serve(grpcwebLis)
-		if err != nil {
+		if err != nil && !isClosed(stopping) {
 			log.Fatalf("failed to serve: %v", err)
 		}
 	}()
 	go func() {
 		err := httpSrv.Serve(httpLis)
-		if err != nil {
+		if err != nil && !isClosed(stopping) {
 			log.Fatalf("failed to serve: %v", err)
 		}
 	}()
@@ -143,13 +437,24 @@ func Serve(serveOpts ServeOptions) error {
 	}
 
 	log.Infof("Starting server on :%d", serveOpts.Port)
-	if err := mux.Serve(); err != nil {
+	if err := mux.Serve(); err != nil && !isClosed(stopping) {
 		return fmt.Errorf("failed to serve: %v", err)
 	}
+	<-stopped
 
 	return nil
 }
 
+// isClosed returns whether the given channel is closed.
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
 // gwHandlerArgs is a helper struct just encapsulating all the args
 // required when registering an HTTP handler for the gateway.
 type gwHandlerArgs struct {
@@ -159,8 +464,9 @@ type gwHandlerArgs struct {
 	dialOptions []grpc.DialOption
 }
 
-// Create a gateway mux that does not emit unpopulated fields.
-func gatewayMux() (*runtime.ServeMux, error) {
+// Create a gateway mux that does not emit unpopulated fields and exposes the
+// metrics prefixed by the given namespace.
+func gatewayMux(metricsNamespace string) (*runtime.ServeMux, error) {
 	gwmux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
@@ -189,5 +495,101 @@ func gatewayMux() (*runtime.ServeMux, error) {
 		return nil, fmt.Errorf("failed to serve: %v", err)
 	}
 
+	// Expose the prometheus metrics, such as the hit rate of the versions cache.
+	metrics := metricsHandler(metricsNamespace)
+	err = gwmux.HandlePath(http.MethodGet, "/metrics", runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		metrics.ServeHTTP(w, r)
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to serve: %v", err)
+	}
+
 	return gwmux, nil
 }
+
+// setGzipCompressionLevel sets the level of the gzip compressor, which is
+// registered with grpc when importing the grpc/encoding/gzip package.
+func setGzipCompressionLevel(level int) error {
+	if err := gzip.SetLevel(level); err != nil {
+		return fmt.Errorf("invalid compression level %d: %w", level, err)
+	}
+	return nil
+}
+
+// grpcServerOptions returns the options for the grpc server: the configured
+// keepalive policy and the chain of interceptors, in order and when
+// configured, the audit of mutating calls, the request logging, the error
+// minimization, the anonymous reads, the rejection of the expired tokens, the
+// request validation, the tenant isolation, the install limiter and the
+// slimming of the responses, then always the warnings of the successful calls
+// and the conversion of panics into errors, so that a failing plugin does not
+// crash the server. The streaming calls, such as the README streams, go
+// through the stream versions of the same interceptors, without the audit,
+// the request logging, the install limiter and the slimming.
+func grpcServerOptions(serveOpts ServeOptions, slimmer *responseSlimmer, isolation *tenantIsolation, auditLog *auditLogger) ([]grpc.ServerOption, error) {
+	interceptors := []grpc.UnaryServerInterceptor{}
+	if auditLog != nil {
+		interceptors = append(interceptors, auditLog.unaryInterceptor)
+	}
+	if serveOpts.RequestLogSampling > 0 {
+		interceptors = append(interceptors, newRequestLogger(serveOpts.RequestLogSampling).unaryInterceptor)
+	}
+	// The errors are minimized, if configured, before being audited but
+	// after converting the panics into errors.
+	errorInterceptors, errorStreamInterceptors, err := clientErrorInterceptors(serveOpts.ClientErrorVerbosity)
+	if err != nil {
+		return nil, err
+	}
+	interceptors = append(interceptors, errorInterceptors...)
+	streamInterceptors := errorStreamInterceptors
+	if serveOpts.AllowAnonymousReads {
+		interceptors = append(interceptors, anonymousReadsInterceptor)
+		streamInterceptors = append(streamInterceptors, anonymousReadsStreamInterceptor)
+	}
+	if err := checkExpiredTokenPolicy(serveOpts.ExpiredTokenPolicy); err != nil {
+		return nil, err
+	}
+	if serveOpts.ExpiredTokenPolicy == ExpiredTokenPolicyReject {
+		checker := newTokenExpiryChecker(serveOpts.ExpiredTokenLeeway)
+		interceptors = append(interceptors, checker.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, checker.streamInterceptor)
+	}
+	if serveOpts.ValidateRequests {
+		interceptors = append(interceptors, validateRequestsInterceptor)
+		streamInterceptors = append(streamInterceptors, validateRequestsStreamInterceptor)
+	}
+	if isolation != nil {
+		interceptors = append(interceptors, isolation.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, isolation.streamInterceptor)
+	}
+	// The installs are only limited once authenticated and validated, so
+	// that rejected calls do not take a slot.
+	if serveOpts.MaxConcurrentInstalls > 0 {
+		interceptors = append(interceptors, newInstallLimiter(serveOpts.MaxConcurrentInstalls, serveOpts.InstallQueueTimeout).unaryInterceptor)
+	}
+	if slimmer != nil {
+		interceptors = append(interceptors, slimmer.unaryInterceptor)
+	}
+	interceptors = append(interceptors, warningsInterceptor, recoverPanicsInterceptor)
+	streamInterceptors = append(streamInterceptors, warningsStreamInterceptor, recoverPanicsStreamInterceptor)
+
+	keepaliveParams, keepalivePolicy := keepaliveOptions(serveOpts)
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.KeepaliveParams(keepaliveParams),
+		grpc.KeepaliveEnforcementPolicy(keepalivePolicy),
+	}, nil
+}
+
+// keepaliveOptions returns the keepalive parameters and enforcement policy
+// configured in the serve options.
+func keepaliveOptions(serveOpts ServeOptions) (keepalive.ServerParameters, keepalive.EnforcementPolicy) {
+	return keepalive.ServerParameters{
+		Time:    serveOpts.KeepaliveTime,
+		Timeout: serveOpts.KeepaliveTimeout,
+	}, keepalive.EnforcementPolicy{
+		MinTime:             serveOpts.KeepaliveMinTime,
+		PermitWithoutStream: serveOpts.KeepalivePermitWithoutStream,
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/encoding"
+	grpcgzip "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+)
+
+func TestSetGzipCompressionLevel(t *testing.T) {
+	testCases := []struct {
+		name        string
+		level       int
+		expectedErr bool
+	}{
+		{
+			name:  "it sets the default level of the compressor",
+			level: gzip.DefaultCompression,
+		},
+		{
+			name:  "it sets the best speed level of the compressor",
+			level: gzip.BestSpeed,
+		},
+		{
+			name:  "it sets the best compression level of the compressor",
+			level: gzip.BestCompression,
+		},
+		{
+			name:        "it returns an error for an invalid level",
+			level:       42,
+			expectedErr: true,
+		},
+	}
+
+	// Restore the default level once done so other tests are not affected.
+	defer setGzipCompressionLevel(gzip.DefaultCompression)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := setGzipCompressionLevel(tc.level)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("got: nil, want: error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			compressor := encoding.GetCompressor(grpcgzip.Name)
+			if compressor == nil {
+				t.Fatalf("got: nil, want: a registered %q compressor", grpcgzip.Name)
+			}
+
+			payload := strings.Repeat("a large catalog response ", 1000)
+			compressed := &bytes.Buffer{}
+			w, err := compressor.Compress(compressed)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if _, err = w.Write([]byte(payload)); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if err = w.Close(); err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			r, err := compressor.Decompress(compressed)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			decompressed, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := string(decompressed), payload; !cmp.Equal(want, got) {
+				t.Errorf("decompressed payload does not match the original (got %d bytes, want %d bytes)", len(got), len(want))
+			}
+		})
+	}
+}
+
+func TestKeepaliveOptions(t *testing.T) {
+	serveOpts := ServeOptions{
+		KeepaliveTime:                30 * time.Second,
+		KeepaliveTimeout:             10 * time.Second,
+		KeepaliveMinTime:             15 * time.Second,
+		KeepalivePermitWithoutStream: true,
+	}
+
+	params, policy := keepaliveOptions(serveOpts)
+
+	expectedParams := keepalive.ServerParameters{
+		Time:    30 * time.Second,
+		Timeout: 10 * time.Second,
+	}
+	if got, want := params, expectedParams; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	expectedPolicy := keepalive.EnforcementPolicy{
+		MinTime:             15 * time.Second,
+		PermitWithoutStream: true,
+	}
+	if got, want := policy, expectedPolicy; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// The keepalive options are applied together with the unary and stream
+	// interceptors.
+	grpcSrvOpts, err := grpcServerOptions(serveOpts, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := len(grpcSrvOpts), 4; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"strings"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// responseSlimmer omits, from the contexts of the responses of the packages
+// service, the cluster and namespace equal to the defaults, for the
+// single-cluster deployments where they are repeated in every reference. The
+// clients read a blank cluster as the default cluster and, with it, a blank
+// namespace as its default one.
+type responseSlimmer struct {
+	// defaultCluster is the cluster omitted from the contexts. Nothing is
+	// omitted when blank.
+	defaultCluster string
+
+	// defaultNamespace is the namespace omitted from the contexts of the
+	// default cluster. It is never omitted when blank.
+	defaultNamespace string
+
+	// namespaceClusterMapping maps namespace prefixes to the cluster used for
+	// the requests without a cluster. The cluster of the contexts with a
+	// mapped namespace is never omitted, as it wouldn't be read back as the
+	// default cluster.
+	namespaceClusterMapping map[string]string
+}
+
+// setDefaults sets the cluster and namespace omitted from the responses,
+// which are only known once the clusters configuration is loaded. It must be
+// called before serving.
+func (s *responseSlimmer) setDefaults(defaultCluster, defaultNamespace string) {
+	s.defaultCluster = defaultCluster
+	s.defaultNamespace = defaultNamespace
+}
+
+// unaryInterceptor slims the successful responses of the packages service.
+// The response is copied first, as the handler may have returned a message it
+// still references.
+func (s *responseSlimmer) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil || s.defaultCluster == "" || !strings.HasPrefix(info.FullMethod, "/"+packages.PackagesService_ServiceDesc.ServiceName+"/") {
+		return resp, err
+	}
+	msg, ok := resp.(proto.Message)
+	if !ok || msg == nil {
+		return resp, err
+	}
+	slimmed := proto.Clone(msg)
+	s.slim(slimmed.ProtoReflect())
+	return slimmed, nil
+}
+
+// slim walks the message, and the messages it contains, omitting the default
+// cluster and namespace from the contexts found.
+func (s *responseSlimmer) slim(m protoreflect.Message) {
+	if c, ok := m.Interface().(*packages.Context); ok {
+		if c.Cluster != s.defaultCluster {
+			return
+		}
+		if _, mapped := mappedCluster(s.namespaceClusterMapping, c.Namespace); mapped {
+			return
+		}
+		c.Cluster = ""
+		if s.defaultNamespace != "" && c.Namespace == s.defaultNamespace {
+			c.Namespace = ""
+		}
+		return
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				s.slim(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				s.slim(mv.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			s.slim(v.Message())
+		}
+		return true
+	})
+}
@@ -0,0 +1,214 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestResponseSlimmer(t *testing.T) {
+	installedRef := func(cluster, namespace string) *packages.InstalledPackageReference {
+		return &packages.InstalledPackageReference{
+			Context:    &packages.Context{Cluster: cluster, Namespace: namespace},
+			Identifier: "my-release",
+			Plugin:     &v1alpha1.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+		}
+	}
+
+	testCases := []struct {
+		name                    string
+		defaultCluster          string
+		defaultNamespace        string
+		namespaceClusterMapping map[string]string
+		fullMethod              string
+		response                proto.Message
+		expectedResponse        proto.Message
+	}{
+		{
+			name:             "it omits the cluster and namespace equal to the defaults",
+			defaultCluster:   "default",
+			defaultNamespace: "kubeapps",
+			response: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("default", "kubeapps"),
+				},
+			},
+			expectedResponse: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("", ""),
+				},
+			},
+		},
+		{
+			name:             "it preserves the namespace of the default cluster differing from the default",
+			defaultCluster:   "default",
+			defaultNamespace: "kubeapps",
+			response: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("default", "other-ns"),
+				},
+			},
+			expectedResponse: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("", "other-ns"),
+				},
+			},
+		},
+		{
+			name:             "it preserves the cluster and namespace of another cluster",
+			defaultCluster:   "default",
+			defaultNamespace: "kubeapps",
+			response: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("other", "kubeapps"),
+				},
+			},
+			expectedResponse: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("other", "kubeapps"),
+				},
+			},
+		},
+		{
+			name:           "it preserves the namespace when the default cluster has no default namespace",
+			defaultCluster: "default",
+			response: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("default", "kubeapps"),
+				},
+			},
+			expectedResponse: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("", "kubeapps"),
+				},
+			},
+		},
+		{
+			name:             "it slims the repeated contexts independently",
+			defaultCluster:   "default",
+			defaultNamespace: "kubeapps",
+			response: &packages.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*packages.InstalledPackageSummary{
+					{InstalledPackageRef: installedRef("default", "kubeapps")},
+					{InstalledPackageRef: installedRef("other", "kubeapps")},
+				},
+			},
+			expectedResponse: &packages.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*packages.InstalledPackageSummary{
+					{InstalledPackageRef: installedRef("", "")},
+					{InstalledPackageRef: installedRef("other", "kubeapps")},
+				},
+			},
+		},
+		{
+			name:                    "it preserves the cluster of a namespace mapped to a cluster",
+			defaultCluster:          "default",
+			defaultNamespace:        "kubeapps",
+			namespaceClusterMapping: map[string]string{"team-a-": "other"},
+			response: &packages.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*packages.InstalledPackageSummary{
+					{InstalledPackageRef: installedRef("default", "team-a-apps")},
+					{InstalledPackageRef: installedRef("default", "team-b-apps")},
+				},
+			},
+			expectedResponse: &packages.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*packages.InstalledPackageSummary{
+					{InstalledPackageRef: installedRef("default", "team-a-apps")},
+					{InstalledPackageRef: installedRef("", "team-b-apps")},
+				},
+			},
+		},
+		{
+			name:             "it does not slim the responses of the other services",
+			defaultCluster:   "default",
+			defaultNamespace: "kubeapps",
+			fullMethod:       "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageDetail",
+			response: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("default", "kubeapps"),
+				},
+			},
+			expectedResponse: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("default", "kubeapps"),
+				},
+			},
+		},
+		{
+			name:             "it does not slim anything without a default cluster",
+			defaultNamespace: "kubeapps",
+			response: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("default", "kubeapps"),
+				},
+			},
+			expectedResponse: &packages.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: &packages.InstalledPackageDetail{
+					InstalledPackageRef: installedRef("default", "kubeapps"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			slimmer := &responseSlimmer{namespaceClusterMapping: tc.namespaceClusterMapping}
+			slimmer.setDefaults(tc.defaultCluster, tc.defaultNamespace)
+			original := proto.Clone(tc.response)
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return tc.response, nil
+			}
+			fullMethod := tc.fullMethod
+			if fullMethod == "" {
+				fullMethod = "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail"
+			}
+
+			resp, err := slimmer.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := resp.(proto.Message), tc.expectedResponse; !proto.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, cmpopts.IgnoreUnexported(packages.GetInstalledPackageDetailResponse{}, packages.GetInstalledPackageSummariesResponse{}, packages.InstalledPackageDetail{}, packages.InstalledPackageSummary{}, packages.InstalledPackageReference{}, packages.Context{}, v1alpha1.Plugin{})))
+			}
+			// The response returned by the handler is left untouched.
+			if !proto.Equal(original, tc.response) {
+				t.Errorf("the handler response was modified")
+			}
+		})
+	}
+}
+
+func TestResponseSlimmerErrors(t *testing.T) {
+	slimmer := &responseSlimmer{}
+	slimmer.setDefaults("default", "kubeapps")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Errorf(codes.NotFound, "not found")
+	}
+
+	_, err := slimmer.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail"}, handler)
+
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+}
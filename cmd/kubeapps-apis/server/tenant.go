@@ -0,0 +1,261 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// tenantContextKey is the context key of the tenant of a call, set when
+// tenant isolation is configured.
+type tenantContextKey struct{}
+
+// tenant is a tenant of a multi-tenant deployment, whose calls are scoped to
+// its namespaces.
+type tenant struct {
+	name string
+	// namespaces are the namespaces of the tenant, keyed by
+	// <cluster>/<namespace>.
+	namespaces map[string]bool
+	// kubeappsCluster is the cluster of the contexts without cluster.
+	kubeappsCluster string
+}
+
+// checkNamespace rejects, as PermissionDenied, a namespace of a cluster which
+// is not one of the namespaces of the tenant. A blank namespace, spanning all
+// the namespaces, is rejected too.
+func (t *tenant) checkNamespace(cluster, namespace string) error {
+	if cluster == "" {
+		cluster = t.kubeappsCluster
+	}
+	if namespace == "" || !t.namespaces[cluster+"/"+namespace] {
+		return status.Errorf(codes.PermissionDenied, "The tenant %q can not access the namespace %q of the cluster %q", t.name, namespace, cluster)
+	}
+	return nil
+}
+
+// tenantIsolation extracts the tenant of the calls from the configured
+// metadata key, which the gateway maps from the Grpc-Metadata-<key> header,
+// so that the contexts of the calls are restricted to the namespaces of the
+// tenant. The calls without tenant metadata are rejected. The tenant is not
+// verified, so the header must be set by a trusted proxy in front of the
+// server, which replaces any tenant header sent by the clients.
+//
+// The contexts of the calls to the core packages services are checked once
+// routed by the core, with their defaulted cluster and namespace, while all
+// the contexts of the requests to the other services, such as the plugin and
+// repositories services, are checked by the interceptors.
+type tenantIsolation struct {
+	metadataKey string
+	namespaces  map[string][]string
+	// kubeappsCluster is the cluster of the contexts without cluster, only
+	// known once the clusters configuration is loaded.
+	kubeappsCluster string
+}
+
+func newTenantIsolation(metadataKey string, namespaces map[string][]string) *tenantIsolation {
+	return &tenantIsolation{
+		metadataKey: strings.ToLower(metadataKey),
+		namespaces:  namespaces,
+	}
+}
+
+// setKubeappsCluster sets the cluster of the contexts without cluster. It
+// must be called before serving.
+func (t *tenantIsolation) setKubeappsCluster(kubeappsCluster string) {
+	t.kubeappsCluster = kubeappsCluster
+}
+
+// unaryInterceptor sets the tenant of the call in its context and checks the
+// contexts of the request. A tenant without configured namespaces can not
+// reference any namespace.
+func (t *tenantIsolation) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	tenantCtx, err := t.tenantContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTenantRequest(tenantCtx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(tenantCtx, req)
+}
+
+// streamInterceptor sets the tenant of the streaming call in the context of
+// its stream and checks the contexts of the requests as they are received.
+func (t *tenantIsolation) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	tenantCtx, err := t.tenantContext(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &tenantServerStream{
+		ServerStream: ss,
+		ctx:          tenantCtx,
+		fullMethod:   info.FullMethod,
+	})
+}
+
+// tenantContext returns the context with the tenant of the call.
+func (t *tenantIsolation) tenantContext(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(t.metadataKey)
+	if len(values) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "missing tenant, the %q metadata is required", t.metadataKey)
+	}
+	if len(values) > 1 {
+		return nil, status.Errorf(codes.PermissionDenied, "ambiguous tenant, the %q metadata is set %d times", t.metadataKey, len(values))
+	}
+	callTenant := &tenant{name: values[0], namespaces: map[string]bool{}, kubeappsCluster: t.kubeappsCluster}
+	for _, namespace := range t.namespaces[callTenant.name] {
+		callTenant.namespaces[namespace] = true
+	}
+	return context.WithValue(ctx, tenantContextKey{}, callTenant), nil
+}
+
+// tenantServerStream is a server stream with the tenant of the call in its
+// context, checking the contexts of the received requests.
+type tenantServerStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	fullMethod string
+}
+
+func (s *tenantServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *tenantServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkTenantRequest(s.ctx, s.fullMethod, m)
+}
+
+// coreRoutedServices are the services whose contexts are routed, and then
+// checked against the namespaces of the tenant, by the core.
+var coreRoutedServices = []string{
+	packages.PackagesService_ServiceDesc.ServiceName,
+	packages.PackagesReadmeService_ServiceDesc.ServiceName,
+}
+
+// checkTenantRequest checks the contexts of a request to a service other than
+// the core packages services against the namespaces of the tenant of the
+// call. A blank or missing context is checked as the Kubeapps cluster and all
+// the namespaces, so is rejected.
+func checkTenantRequest(ctx context.Context, fullMethod string, req interface{}) error {
+	callTenant, ok := ctx.Value(tenantContextKey{}).(*tenant)
+	if !ok {
+		return nil
+	}
+	for _, service := range coreRoutedServices {
+		if strings.HasPrefix(fullMethod, "/"+service+"/") {
+			return nil
+		}
+	}
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	return checkTenantContexts(callTenant, msg.ProtoReflect())
+}
+
+// contextFullName is the full name of the context messages.
+var contextFullName = (&packages.Context{}).ProtoReflect().Descriptor().FullName()
+
+// checkTenantContexts checks the context fields of the message, and of the
+// messages it includes, against the namespaces of the tenant.
+func checkTenantContexts(callTenant *tenant, m protoreflect.Message) error {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		switch {
+		case field.IsMap():
+			if field.MapValue().Message() == nil {
+				continue
+			}
+			var err error
+			m.Get(field).Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+				err = checkTenantContexts(callTenant, value.Message())
+				return err == nil
+			})
+			if err != nil {
+				return err
+			}
+		case field.Message() == nil:
+			continue
+		case field.IsList():
+			list := m.Get(field).List()
+			for j := 0; j < list.Len(); j++ {
+				if err := checkTenantContexts(callTenant, list.Get(j).Message()); err != nil {
+					return err
+				}
+			}
+		case field.Message().FullName() == contextFullName:
+			// An unset context is read as a blank one.
+			pkgContext := m.Get(field).Message()
+			contextFields := pkgContext.Descriptor().Fields()
+			cluster := pkgContext.Get(contextFields.ByName("cluster")).String()
+			namespace := pkgContext.Get(contextFields.ByName("namespace")).String()
+			if err := callTenant.checkNamespace(cluster, namespace); err != nil {
+				return err
+			}
+		case m.Has(field):
+			if err := checkTenantContexts(callTenant, m.Get(field).Message()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkTenantNamespace rejects, as PermissionDenied, a routed context whose
+// namespace is not one of the namespaces of the tenant of the call, if any.
+func checkTenantNamespace(ctx context.Context, pkgContext *packages.Context) error {
+	callTenant, ok := ctx.Value(tenantContextKey{}).(*tenant)
+	if !ok {
+		return nil
+	}
+	return callTenant.checkNamespace(pkgContext.GetCluster(), pkgContext.GetNamespace())
+}
+
+// ParseTenantNamespaces parses the namespaces of the tenants specified as
+// <tenant>:<cluster>/<namespace> (eg. "tenant-a:default/team-a-dev"), a
+// tenant being listed once per namespace. The namespaces are returned keyed
+// as <cluster>/<namespace>.
+func ParseTenantNamespaces(specs []string) (map[string][]string, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	namespaces := map[string][]string{}
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid tenant namespace %q, expected <tenant>:<cluster>/<namespace>", spec)
+		}
+		clusterNamespace := strings.SplitN(parts[1], "/", 2)
+		if len(clusterNamespace) != 2 || clusterNamespace[0] == "" || clusterNamespace[1] == "" {
+			return nil, fmt.Errorf("invalid tenant namespace %q, expected <tenant>:<cluster>/<namespace>", spec)
+		}
+		namespaces[parts[0]] = append(namespaces[parts[0]], parts[1])
+	}
+	return namespaces, nil
+}
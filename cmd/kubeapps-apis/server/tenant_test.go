@@ -0,0 +1,366 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	fluxv2 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTenantIsolation(t *testing.T) {
+	plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+	server := &packagesServer{
+		plugins: []*pkgsPluginWithServer{
+			{
+				plugin: plugin,
+				server: plugin_test.TestPackagingPluginServer{
+					Plugin:                 plugin,
+					InstalledPackageDetail: &corev1.InstalledPackageDetail{Name: "installed-pkg-1"},
+				},
+			},
+		},
+		clusterDefaultNamespaces: map[string]string{
+			"":          "team-a-dev",
+			"cluster-b": "team-b-dev",
+		},
+	}
+	isolation := newTenantIsolation("X-Tenant-Id", map[string][]string{
+		"tenant-a": {"default/team-a-dev", "default/team-a-prod"},
+		"tenant-b": {"cluster-b/team-b-dev", "default/team-b-dev"},
+	})
+	isolation.setKubeappsCluster("default")
+
+	testCases := []struct {
+		name          string
+		tenants       []string
+		targetContext *corev1.Context
+		expectedCode  codes.Code
+	}{
+		{
+			name:          "it allows a reference to a namespace of the tenant",
+			tenants:       []string{"tenant-a"},
+			targetContext: &corev1.Context{Cluster: "default", Namespace: "team-a-prod"},
+			expectedCode:  codes.OK,
+		},
+		{
+			name:          "it rejects a reference to a namespace of another tenant",
+			tenants:       []string{"tenant-a"},
+			targetContext: &corev1.Context{Cluster: "default", Namespace: "team-b-dev"},
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "it rejects a reference to a namespace of the tenant in another cluster",
+			tenants:       []string{"tenant-a"},
+			targetContext: &corev1.Context{Cluster: "cluster-b", Namespace: "team-a-dev"},
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "it allows a blank namespace defaulted to a namespace of the tenant",
+			tenants:       []string{"tenant-b"},
+			targetContext: &corev1.Context{Cluster: "cluster-b"},
+			expectedCode:  codes.OK,
+		},
+		{
+			name:          "it rejects a blank namespace defaulted to a namespace of another tenant",
+			tenants:       []string{"tenant-b"},
+			targetContext: &corev1.Context{},
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "it rejects a blank namespace without default namespace",
+			tenants:       []string{"tenant-a"},
+			targetContext: &corev1.Context{Cluster: "cluster-c"},
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "it rejects any namespace for an unknown tenant",
+			tenants:       []string{"tenant-c"},
+			targetContext: &corev1.Context{Cluster: "default", Namespace: "team-a-dev"},
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "it rejects an ambiguous tenant",
+			tenants:       []string{"tenant-a", "tenant-b"},
+			targetContext: &corev1.Context{Cluster: "default", Namespace: "team-a-dev"},
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "it rejects a call without tenant",
+			targetContext: &corev1.Context{Cluster: "default", Namespace: "team-a-dev"},
+			expectedCode:  codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			md := metadata.MD{}
+			for _, tenant := range tc.tenants {
+				md.Append("x-tenant-id", tenant)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			for _, fullMethod := range []string{
+				"/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail",
+				"/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage",
+			} {
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					installedPackageRef := &corev1.InstalledPackageReference{
+						Context:    &corev1.Context{Cluster: tc.targetContext.Cluster, Namespace: tc.targetContext.Namespace},
+						Identifier: "installed-pkg-1",
+						Plugin:     plugin,
+					}
+					if fullMethod == "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage" {
+						return server.CreateInstalledPackage(ctx, &corev1.CreateInstalledPackageRequest{
+							AvailablePackageRef: &corev1.AvailablePackageReference{Identifier: "available-pkg-1", Plugin: plugin},
+							TargetContext:       installedPackageRef.Context,
+							Name:                installedPackageRef.Identifier,
+						})
+					}
+					return server.GetInstalledPackageDetail(ctx, &corev1.GetInstalledPackageDetailRequest{
+						InstalledPackageRef: installedPackageRef,
+					})
+				}
+
+				_, err := isolation.unaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+
+				if got, want := status.Code(err), tc.expectedCode; got != want {
+					t.Errorf("%s: got: %+v, want: %+v, err: %+v", fullMethod, got, want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestTenantIsolationOtherServices(t *testing.T) {
+	isolation := newTenantIsolation("X-Tenant-Id", map[string][]string{
+		"tenant-a": {"default/team-a-dev", "cluster-b/team-a-dev", "default/kubeapps"},
+	})
+	isolation.setKubeappsCluster("default")
+
+	testCases := []struct {
+		name         string
+		fullMethod   string
+		request      interface{}
+		expectedCode codes.Code
+	}{
+		{
+			name:         "it allows a plugin call in a namespace of the tenant",
+			fullMethod:   "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageSummaries",
+			request:      &corev1.GetInstalledPackageSummariesRequest{Context: &corev1.Context{Cluster: "cluster-b", Namespace: "team-a-dev"}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it checks a plugin call without cluster in the Kubeapps cluster",
+			fullMethod:   "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageSummaries",
+			request:      &corev1.GetInstalledPackageSummariesRequest{Context: &corev1.Context{Namespace: "team-a-dev"}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it rejects a plugin call in a namespace of another tenant",
+			fullMethod:   "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageSummaries",
+			request:      &corev1.GetInstalledPackageSummariesRequest{Context: &corev1.Context{Cluster: "default", Namespace: "team-b-dev"}},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "it rejects a plugin call without context",
+			fullMethod:   "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageSummaries",
+			request:      &corev1.GetInstalledPackageSummariesRequest{},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:       "it rejects a plugin call referencing a package of another tenant",
+			fullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/CreateInstalledPackage",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{Context: &corev1.Context{Cluster: "default", Namespace: "team-b-dev"}, Identifier: "bitnami/apache"},
+				TargetContext:       &corev1.Context{Cluster: "default", Namespace: "team-a-dev"},
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:       "it allows a plugin call referencing packages of the tenant",
+			fullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/CreateInstalledPackage",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{Context: &corev1.Context{Cluster: "default", Namespace: "kubeapps"}, Identifier: "bitnami/apache"},
+				TargetContext:       &corev1.Context{Cluster: "default", Namespace: "team-a-dev"},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:       "it checks the contexts of the repeated references",
+			fullMethod: "/kubeappsapis.plugins.example.packages.v1alpha1.ExamplePackagesService/GetInstalledPackageDetailsBatch",
+			request: &corev1.GetInstalledPackageDetailsBatchRequest{
+				InstalledPackageRefs: []*corev1.InstalledPackageReference{
+					{Context: &corev1.Context{Cluster: "default", Namespace: "team-a-dev"}, Identifier: "apache"},
+					{Context: &corev1.Context{Cluster: "default", Namespace: "team-b-dev"}, Identifier: "apache"},
+				},
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "it rejects a repositories call in a namespace of another tenant",
+			fullMethod:   "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetPackageRepositories",
+			request:      &fluxv2.GetPackageRepositoriesRequest{Context: &corev1.Context{Cluster: "default", Namespace: "team-b-dev"}},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "it allows a repositories call in a namespace of the tenant",
+			fullMethod:   "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetPackageRepositories",
+			request:      &fluxv2.GetPackageRepositoriesRequest{Context: &corev1.Context{Cluster: "default", Namespace: "team-a-dev"}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it allows a call without context",
+			fullMethod:   "/kubeappsapis.core.plugins.v1alpha1.PluginsService/GetConfiguredPlugins",
+			request:      &plugins.GetConfiguredPluginsRequest{},
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "tenant-a"))
+			handlerCalled := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCalled = true
+				return nil, nil
+			}
+
+			_, err := isolation.unaryInterceptor(ctx, tc.request, &grpc.UnaryServerInfo{FullMethod: tc.fullMethod}, handler)
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := handlerCalled, tc.expectedCode == codes.OK; got != want {
+				t.Errorf("got handler called: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestParseTenantNamespaces(t *testing.T) {
+	testCases := []struct {
+		name               string
+		specs              []string
+		expectedNamespaces map[string][]string
+		expectError        bool
+	}{
+		{
+			name:  "it parses the namespaces of several tenants",
+			specs: []string{"tenant-a:default/team-a-dev", "tenant-b:cluster-b/team-b-dev", "tenant-a:default/team-a-prod"},
+			expectedNamespaces: map[string][]string{
+				"tenant-a": {"default/team-a-dev", "default/team-a-prod"},
+				"tenant-b": {"cluster-b/team-b-dev"},
+			},
+		},
+		{
+			name: "it returns no namespaces when none are specified",
+		},
+		{
+			name:        "it rejects a spec without namespace",
+			specs:       []string{"tenant-a:"},
+			expectError: true,
+		},
+		{
+			name:        "it rejects a spec without tenant",
+			specs:       []string{"default/team-a-dev"},
+			expectError: true,
+		},
+		{
+			name:        "it rejects a spec without cluster",
+			specs:       []string{"tenant-a:team-a-dev"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			namespaces, err := ParseTenantNamespaces(tc.specs)
+			if got, want := err != nil, tc.expectError; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+			if got, want := namespaces, tc.expectedNamespaces; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestTenantIsolationStream(t *testing.T) {
+	plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+	server := &packagesServer{
+		plugins: []*pkgsPluginWithServer{
+			{
+				plugin: plugin,
+				server: plugin_test.TestPackagingPluginServer{
+					Plugin:                 plugin,
+					AvailablePackageDetail: plugin_test.MakeAvailablePackageDetail("pkg-1", plugin),
+				},
+			},
+		},
+	}
+	readmeServer := NewPackagesReadmeServer(server)
+	isolation := newTenantIsolation("X-Tenant-Id", map[string][]string{
+		"tenant-a": {"default/team-a-dev"},
+	})
+	isolation.setKubeappsCluster("default")
+
+	testCases := []struct {
+		name         string
+		namespace    string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "it streams the readme of a package in a namespace of the tenant",
+			namespace:    "team-a-dev",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it rejects the readme of a package in a namespace of another tenant",
+			namespace:    "team-b-dev",
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := &testServerStream{
+				ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "tenant-a")),
+			}
+			request := &corev1.GetAvailablePackageReadmeRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: tc.namespace},
+					Identifier: "pkg-1",
+					Plugin:     plugin,
+				},
+			}
+
+			err := isolation.streamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesReadmeService/GetAvailablePackageReadme"}, func(srv interface{}, stream grpc.ServerStream) error {
+				return readmeServer.GetAvailablePackageReadme(request, &testReadmeStream{ServerStream: stream})
+			})
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Errorf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+)
+
+// timeoutPackagesServer wraps the packages server implementation of a plugin
+// so that the calls to the plugin are cancelled once the timeout is reached,
+// failing with DeadlineExceeded.
+type timeoutPackagesServer struct {
+	PackagesPluginServer
+
+	timeout time.Duration
+}
+
+func newTimeoutPackagesServer(server PackagesPluginServer, timeout time.Duration) *timeoutPackagesServer {
+	return &timeoutPackagesServer{
+		PackagesPluginServer: server,
+		timeout:              timeout,
+	}
+}
+
+// timeoutForPlugin returns the timeout of the calls to the named plugin,
+// either overridden for the plugin or the global one.
+func timeoutForPlugin(pluginName string, globalTimeout time.Duration, pluginTimeouts map[string]time.Duration) time.Duration {
+	if timeout, ok := pluginTimeouts[pluginName]; ok {
+		return timeout
+	}
+	return globalTimeout
+}
+
+// ParsePluginCallTimeouts parses the timeouts specified per plugin name as
+// durations (eg. "fluxv2.packages" => "1m").
+func ParsePluginCallTimeouts(specs map[string]string) (map[string]time.Duration, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	timeouts := map[string]time.Duration{}
+	for pluginName, spec := range specs {
+		timeout, err := time.ParseDuration(spec)
+		if pluginName == "" || err != nil || timeout < 0 {
+			return nil, fmt.Errorf("invalid plugin call timeout %q=%q, expected <plugin-name>=<duration>", pluginName, spec)
+		}
+		timeouts[pluginName] = timeout
+	}
+	return timeouts, nil
+}
+
+func (s *timeoutPackagesServer) GetAvailablePackageSummaries(ctx context.Context, request *packages.GetAvailablePackageSummariesRequest) (*packages.GetAvailablePackageSummariesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetAvailablePackageSummaries(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetAvailablePackageDetail(ctx context.Context, request *packages.GetAvailablePackageDetailRequest) (*packages.GetAvailablePackageDetailResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetAvailablePackageDetail(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetAvailablePackageVersions(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetAvailablePackageVersions(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetAvailablePackageDependencies(ctx context.Context, request *packages.GetAvailablePackageDependenciesRequest) (*packages.GetAvailablePackageDependenciesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetAvailablePackageDependencies(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetAvailablePackageDefaultValues(ctx context.Context, request *packages.GetAvailablePackageDefaultValuesRequest) (*packages.GetAvailablePackageDefaultValuesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetAvailablePackageDefaultValues(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetAvailablePackageChangelog(ctx context.Context, request *packages.GetAvailablePackageChangelogRequest) (*packages.GetAvailablePackageChangelogResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetAvailablePackageChangelog(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetAvailablePackageValuesDiff(ctx context.Context, request *packages.GetAvailablePackageValuesDiffRequest) (*packages.GetAvailablePackageValuesDiffResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetAvailablePackageValuesDiff(ctx, request)
+}
+
+func (s *timeoutPackagesServer) RenderAvailablePackage(ctx context.Context, request *packages.RenderAvailablePackageRequest) (*packages.RenderAvailablePackageResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.RenderAvailablePackage(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetInstalledPackageSummaries(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetInstalledPackageDetail(ctx context.Context, request *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetInstalledPackageDetail(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetInstalledPackageValues(ctx context.Context, request *packages.GetInstalledPackageValuesRequest) (*packages.GetInstalledPackageValuesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetInstalledPackageValues(ctx, request)
+}
+
+func (s *timeoutPackagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.CreateInstalledPackage(ctx, request)
+}
+
+func (s *timeoutPackagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.UpdateInstalledPackage(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetInstalledPackageUpgradeDiff(ctx context.Context, request *packages.GetInstalledPackageUpgradeDiffRequest) (*packages.GetInstalledPackageUpgradeDiffResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetInstalledPackageUpgradeDiff(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetInstalledPackageDrift(ctx context.Context, request *packages.GetInstalledPackageDriftRequest) (*packages.GetInstalledPackageDriftResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetInstalledPackageDrift(ctx, request)
+}
+
+func (s *timeoutPackagesServer) ExportInstalledPackage(ctx context.Context, request *packages.ExportInstalledPackageRequest) (*packages.ExportInstalledPackageResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.ExportInstalledPackage(ctx, request)
+}
+
+func (s *timeoutPackagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.DeleteInstalledPackage(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetRecentActivity(ctx context.Context, request *packages.GetRecentActivityRequest) (*packages.GetRecentActivityResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetRecentActivity(ctx, request)
+}
+
+func (s *timeoutPackagesServer) GetUserPermissions(ctx context.Context, request *packages.GetUserPermissionsRequest) (*packages.GetUserPermissionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return s.PackagesPluginServer.GetUserPermissions(ctx, request)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// deadlineRecordingPackagingPluginServer is a test plugin recording the
+// remaining time until the deadline of the context with which it is called,
+// or failing once the deadline is exceeded when blocking.
+type deadlineRecordingPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	remaining *time.Duration
+	block     bool
+}
+
+func (s deadlineRecordingPackagingPluginServer) GetInstalledPackageSummaries(ctx context.Context, request *corev1.GetInstalledPackageSummariesRequest) (*corev1.GetInstalledPackageSummariesResponse, error) {
+	*s.remaining = 0
+	if deadline, ok := ctx.Deadline(); ok {
+		*s.remaining = time.Until(deadline)
+	}
+	if s.block {
+		<-ctx.Done()
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	return s.TestPackagingPluginServer.GetInstalledPackageSummaries(ctx, request)
+}
+
+func TestPluginCallTimeouts(t *testing.T) {
+	globalTimeout := 10 * time.Second
+	pluginTimeouts := map[string]time.Duration{
+		"slow-plugin": time.Minute,
+	}
+
+	testCases := []struct {
+		name            string
+		pluginName      string
+		globalTimeout   time.Duration
+		expectedTimeout time.Duration
+	}{
+		{
+			name:            "it uses the larger timeout of a slow plugin",
+			pluginName:      "slow-plugin",
+			globalTimeout:   globalTimeout,
+			expectedTimeout: time.Minute,
+		},
+		{
+			name:            "it uses the global timeout for the other plugins",
+			pluginName:      "plugin-1",
+			globalTimeout:   globalTimeout,
+			expectedTimeout: globalTimeout,
+		},
+		{
+			name:            "it uses the timeout of a slow plugin without a global timeout",
+			pluginName:      "slow-plugin",
+			globalTimeout:   0,
+			expectedTimeout: time.Minute,
+		},
+		{
+			name:            "it does not set a deadline without a global timeout for the other plugins",
+			pluginName:      "plugin-1",
+			globalTimeout:   0,
+			expectedTimeout: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: tc.pluginName, Version: "v1alpha1"}
+			var remaining time.Duration
+			server := NewPackagesServer([]*pkgsPluginWithServer{
+				{
+					plugin: plugin,
+					server: deadlineRecordingPackagingPluginServer{
+						TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+						remaining:                 &remaining,
+					},
+				},
+			}, ServeOptions{PluginCallTimeout: tc.globalTimeout, PluginCallTimeouts: pluginTimeouts}, nil)
+
+			_, err := server.plugins[0].server.GetInstalledPackageSummaries(context.Background(), &corev1.GetInstalledPackageSummariesRequest{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			// The deadline is set just before the call.
+			if got, want := remaining, tc.expectedTimeout; got > want || got < want-time.Second {
+				t.Errorf("got: %s, want: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestTimeoutPackagesServer(t *testing.T) {
+	plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+	var remaining time.Duration
+	server := newTimeoutPackagesServer(deadlineRecordingPackagingPluginServer{
+		TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{Plugin: plugin},
+		remaining:                 &remaining,
+		block:                     true,
+	}, 10*time.Millisecond)
+
+	_, err := server.GetInstalledPackageSummaries(context.Background(), &corev1.GetInstalledPackageSummariesRequest{})
+	if got, want := status.Code(err), codes.DeadlineExceeded; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
+
+func TestParsePluginCallTimeouts(t *testing.T) {
+	testCases := []struct {
+		name             string
+		specs            map[string]string
+		expectedTimeouts map[string]time.Duration
+		expectedErr      bool
+	}{
+		{
+			name:  "it parses the timeout of each plugin",
+			specs: map[string]string{"fluxv2.packages": "1m", "helm.packages": "45s"},
+			expectedTimeouts: map[string]time.Duration{
+				"fluxv2.packages": time.Minute,
+				"helm.packages":   45 * time.Second,
+			},
+		},
+		{
+			name:        "it errors with an invalid duration",
+			specs:       map[string]string{"fluxv2.packages": "slow"},
+			expectedErr: true,
+		},
+		{
+			name:        "it errors with a negative duration",
+			specs:       map[string]string{"fluxv2.packages": "-1m"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			timeouts, err := ParsePluginCallTimeouts(tc.specs)
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+
+			if got, want := timeouts, tc.expectedTimeouts; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
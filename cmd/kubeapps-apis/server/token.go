@@ -0,0 +1,103 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	log "k8s.io/klog/v2"
+)
+
+const (
+	// serviceAccountTokenPath is the path at which the token of the service
+	// account of the pod is mounted.
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+	// serviceAccountTokenRefreshInterval is the interval after which the
+	// mounted token is read again, as it is rotated by the kubelet well
+	// before it expires (as done by client-go for the in-cluster config).
+	serviceAccountTokenRefreshInterval = time.Minute
+)
+
+// TokenSource returns the token with which the server calls the clusters on
+// its own behalf, for the background operations made without a user request.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// fileTokenSource is a TokenSource reading the token from a file, such as
+// the mounted service account token, and reading it again after the refresh
+// interval so that the rotated tokens are used.
+type fileTokenSource struct {
+	path            string
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mutex  sync.Mutex
+	token  string
+	readAt time.Time
+}
+
+// NewServiceAccountTokenSource returns a TokenSource reading the mounted
+// token of the service account of the pod.
+func NewServiceAccountTokenSource() TokenSource {
+	return newFileTokenSource(serviceAccountTokenPath, serviceAccountTokenRefreshInterval)
+}
+
+func newFileTokenSource(path string, refreshInterval time.Duration) *fileTokenSource {
+	return &fileTokenSource{
+		path:            path,
+		refreshInterval: refreshInterval,
+		now:             time.Now,
+	}
+}
+
+// Token returns the token read from the file, reading it again once the
+// refresh interval elapsed. The previous token is returned if the file can't
+// be read while being rotated.
+func (s *fileTokenSource) Token() (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	if s.token != "" && now.Before(s.readAt.Add(s.refreshInterval)) {
+		return s.token, nil
+	}
+
+	token, err := readTokenFile(s.path)
+	if err != nil {
+		if s.token != "" {
+			log.Warningf("Unable to read the token again, using the previous one: %v", err)
+			return s.token, nil
+		}
+		return "", err
+	}
+	s.token, s.readAt = token, now
+	return token, nil
+}
+
+func readTokenFile(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the token file %q: %w", path, err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("the token file %q is empty", path)
+	}
+	return token, nil
+}
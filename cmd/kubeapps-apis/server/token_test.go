@@ -0,0 +1,130 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileTokenSource(t *testing.T) {
+	testCases := []struct {
+		name string
+		// initialContent is the content of the token file when first read,
+		// which is missing if initialMissing.
+		initialContent string
+		initialMissing bool
+		// rotatedContent replaces the token file, or removes it if
+		// rotatedMissing, before it is read again after rotateAfter.
+		rotatedContent string
+		rotatedMissing bool
+		rotateAfter    time.Duration
+		expectedToken  string
+		expectedErr    bool
+	}{
+		{
+			name:           "it reads the token",
+			initialContent: "token-1\n",
+			expectedToken:  "token-1",
+		},
+		{
+			name:           "it returns the cached token before the refresh interval",
+			initialContent: "token-1\n",
+			rotatedContent: "token-2\n",
+			rotateAfter:    30 * time.Second,
+			expectedToken:  "token-1",
+		},
+		{
+			name:           "it reads the rotated token after the refresh interval",
+			initialContent: "token-1\n",
+			rotatedContent: "token-2\n",
+			rotateAfter:    2 * time.Minute,
+			expectedToken:  "token-2",
+		},
+		{
+			name:           "it returns the previous token if the rotated one can't be read",
+			initialContent: "token-1\n",
+			rotatedMissing: true,
+			rotateAfter:    2 * time.Minute,
+			expectedToken:  "token-1",
+		},
+		{
+			name:           "it returns the previous token if the rotated one is empty",
+			initialContent: "token-1\n",
+			rotatedContent: "",
+			rotateAfter:    2 * time.Minute,
+			expectedToken:  "token-1",
+		},
+		{
+			name:           "it returns an error if the token file is missing",
+			initialMissing: true,
+			expectedErr:    true,
+		},
+		{
+			name:           "it returns an error if the token file is empty",
+			initialContent: "\n",
+			expectedErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "token")
+			if !tc.initialMissing {
+				if err := ioutil.WriteFile(path, []byte(tc.initialContent), 0600); err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+			start := time.Date(2021, time.September, 1, 10, 0, 0, 0, time.UTC)
+			tokenSource := newFileTokenSource(path, time.Minute)
+			tokenSource.now = func() time.Time { return start }
+
+			token, err := tokenSource.Token()
+			if tc.rotateAfter > 0 {
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if tc.rotatedMissing {
+					err = os.Remove(path)
+				} else {
+					err = ioutil.WriteFile(path, []byte(tc.rotatedContent), 0600)
+				}
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				tokenSource.now = func() time.Time { return start.Add(tc.rotateAfter) }
+				token, err = tokenSource.Token()
+			}
+
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+			if got, want := token, tc.expectedToken; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+// staticTokenSource is a TokenSource returning a fixed token or error.
+type staticTokenSource struct {
+	token string
+	err   error
+}
+
+func (s staticTokenSource) Token() (string, error) {
+	return s.token, s.err
+}
@@ -0,0 +1,132 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ExpiredTokenPolicyForward forwards the calls with an expired token,
+	// which then fail when the plugins use it with the cluster.
+	ExpiredTokenPolicyForward = "forward"
+	// ExpiredTokenPolicyReject rejects the calls with an expired token as
+	// unauthenticated before they reach the plugins, so that clients know
+	// to refresh the token.
+	ExpiredTokenPolicyReject = "reject"
+)
+
+// errNotJWT is returned when decoding the claims of a token which is not a
+// JWT, such as an opaque static token.
+var errNotJWT = errors.New("the token is not a JWT")
+
+// tokenExpiryChecker rejects the calls whose JWT expired, with a "token
+// expired" error distinct from the "invalid token" error of the tokens whose
+// claims cannot be decoded. The signature is not verified, which is done by
+// the cluster, and the tokens which are not JWTs or do not expire are
+// forwarded.
+type tokenExpiryChecker struct {
+	// leeway is the duration after its expiry during which a token is still
+	// accepted, to allow for the clock skew with the token issuer.
+	leeway time.Duration
+	now    func() time.Time
+}
+
+func newTokenExpiryChecker(leeway time.Duration) *tokenExpiryChecker {
+	return &tokenExpiryChecker{
+		leeway: leeway,
+		now:    time.Now,
+	}
+}
+
+func (c *tokenExpiryChecker) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (c *tokenExpiryChecker) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := c.check(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// check returns an Unauthenticated error if the token of the call expired or
+// its claims cannot be decoded.
+func (c *tokenExpiryChecker) check(ctx context.Context) error {
+	token, err := extractToken(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+	if token == "" {
+		return nil
+	}
+
+	claims := struct {
+		Exp *json.Number `json:"exp"`
+	}{}
+	err = decodeTokenClaims(token, &claims)
+	if err == errNotJWT || (err == nil && claims.Exp == nil) {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid token: unable to decode the claims: %v", err)
+	}
+	exp, err := claims.Exp.Float64()
+	if err != nil || math.IsNaN(exp) || math.IsInf(exp, 0) {
+		return status.Errorf(codes.Unauthenticated, "invalid token: invalid expiry %q", claims.Exp.String())
+	}
+	expiry := time.Unix(int64(exp), 0)
+	if c.now().After(expiry.Add(c.leeway)) {
+		return status.Errorf(codes.Unauthenticated, "token expired: the authorization token expired at %s and must be refreshed", expiry.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// decodeTokenClaims decodes, without verifying the signature, the claims of
+// the payload of a JWT into claims.
+func decodeTokenClaims(token string, claims interface{}) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errNotJWT
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(payload, claims)
+}
+
+// checkExpiredTokenPolicy checks that the policy is one of the
+// ExpiredTokenPolicy constants, a blank policy forwarding the expired tokens.
+func checkExpiredTokenPolicy(policy string) error {
+	switch policy {
+	case ExpiredTokenPolicyForward, ExpiredTokenPolicyReject, "":
+		return nil
+	default:
+		return fmt.Errorf("invalid expired token policy %q, expected %q or %q", policy, ExpiredTokenPolicyForward, ExpiredTokenPolicyReject)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requestValidator is implemented by the messages with protoc-gen-validate
+// rules, whose generated Validate method returns the first violation.
+type requestValidator interface {
+	Validate() error
+}
+
+// requestMultiValidator is implemented by the messages generated by the
+// versions of protoc-gen-validate returning all the violations at once.
+type requestMultiValidator interface {
+	ValidateAll() error
+}
+
+// validationMultiError is implemented by the errors of ValidateAll.
+type validationMultiError interface {
+	AllErrors() []error
+}
+
+// validationFieldError is implemented by the violations of the rules of a
+// field, as returned by the generated methods.
+type validationFieldError interface {
+	Field() string
+	Reason() string
+}
+
+// validateRequestsInterceptor rejects the requests violating the
+// protoc-gen-validate rules of their message before they reach the handlers,
+// so that the validation is not scattered across each method. The messages
+// without rules are not validated.
+func validateRequestsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// validateRequestsStreamInterceptor rejects the requests of the streaming
+// calls violating the protoc-gen-validate rules of their message, as they are
+// received by the handlers.
+func validateRequestsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingServerStream{ServerStream: ss})
+}
+
+// validatingServerStream is a server stream validating the received requests.
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(m)
+}
+
+// validateRequest returns an InvalidArgument error, with the violations as
+// BadRequest field details, if the request violates the rules of its message.
+func validateRequest(req interface{}) error {
+	var err error
+	switch r := req.(type) {
+	case requestMultiValidator:
+		err = r.ValidateAll()
+	case requestValidator:
+		err = r.Validate()
+	}
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	if multiErr, ok := err.(validationMultiError); ok {
+		errs = multiErr.AllErrors()
+	}
+	violations := []*errdetails.BadRequest_FieldViolation{}
+	for _, e := range errs {
+		if fieldErr, ok := e.(validationFieldError); ok {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fieldErr.Field(),
+				Description: fieldErr.Reason(),
+			})
+		}
+	}
+
+	st := status.Newf(codes.InvalidArgument, "invalid request: %v", err)
+	if len(violations) > 0 {
+		if detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); detailsErr == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
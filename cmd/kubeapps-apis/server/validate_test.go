@@ -0,0 +1,190 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testValidationError is a violation of the rules of a field, as generated
+// by protoc-gen-validate.
+type testValidationError struct {
+	field  string
+	reason string
+}
+
+func (e testValidationError) Field() string  { return e.field }
+func (e testValidationError) Reason() string { return e.reason }
+func (e testValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.field, e.reason)
+}
+
+// testValidationMultiError is the error returned by a generated ValidateAll.
+type testValidationMultiError []error
+
+func (e testValidationMultiError) AllErrors() []error { return e }
+func (e testValidationMultiError) Error() string {
+	msgs := []string{}
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// validatedRequest is a request with a generated Validate method.
+type validatedRequest struct {
+	err error
+}
+
+func (r validatedRequest) Validate() error { return r.err }
+
+// multiValidatedRequest is a request with generated Validate and ValidateAll
+// methods.
+type multiValidatedRequest struct {
+	errs []error
+}
+
+func (r multiValidatedRequest) Validate() error {
+	if len(r.errs) == 0 {
+		return nil
+	}
+	return r.errs[0]
+}
+
+func (r multiValidatedRequest) ValidateAll() error {
+	if len(r.errs) == 0 {
+		return nil
+	}
+	return testValidationMultiError(r.errs)
+}
+
+func TestValidateRequestsInterceptor(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            interface{}
+		expectedCode       codes.Code
+		expectedViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name:         "it passes a request without validation rules",
+			request:      &corev1.CreateInstalledPackageRequest{},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it passes a valid request",
+			request:      validatedRequest{},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it rejects an invalid request with the field details",
+			request:      validatedRequest{err: testValidationError{field: "Name", reason: "value length must be at least 1 runes"}},
+			expectedCode: codes.InvalidArgument,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "Name", Description: "value length must be at least 1 runes"},
+			},
+		},
+		{
+			name: "it rejects an invalid request with all the field details",
+			request: multiValidatedRequest{errs: []error{
+				testValidationError{field: "Name", reason: "value length must be at least 1 runes"},
+				testValidationError{field: "AvailablePackageRef", reason: "value is required"},
+			}},
+			expectedCode: codes.InvalidArgument,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "Name", Description: "value length must be at least 1 runes"},
+				{Field: "AvailablePackageRef", Description: "value is required"},
+			},
+		},
+		{
+			name:         "it rejects an invalid request without field details",
+			request:      validatedRequest{err: errors.New("invalid request")},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handlerCalled := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCalled = true
+				return nil, nil
+			}
+
+			_, err := validateRequestsInterceptor(context.Background(), tc.request, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := handlerCalled, tc.expectedCode == codes.OK; got != want {
+				t.Errorf("got handler called: %t, want: %t", got, want)
+			}
+
+			violations := []*errdetails.BadRequest_FieldViolation{}
+			for _, detail := range status.Convert(err).Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					violations = append(violations, badRequest.GetFieldViolations()...)
+				}
+			}
+			if tc.expectedViolations == nil {
+				tc.expectedViolations = []*errdetails.BadRequest_FieldViolation{}
+			}
+			opts := cmpopts.IgnoreUnexported(errdetails.BadRequest_FieldViolation{})
+			if got, want := violations, tc.expectedViolations; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
+func TestValidateRequestsStreamInterceptor(t *testing.T) {
+	testCases := []struct {
+		name         string
+		request      interface{}
+		expectedCode codes.Code
+	}{
+		{
+			name:         "it receives a valid request",
+			request:      validatedRequest{},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "it rejects an invalid request when received",
+			request:      validatedRequest{err: testValidationError{field: "AvailablePackageRef", reason: "value is required"}},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRequestsStreamInterceptor(nil, &testServerStream{}, &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}, func(srv interface{}, stream grpc.ServerStream) error {
+				return stream.RecvMsg(tc.request)
+			})
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+		})
+	}
+}
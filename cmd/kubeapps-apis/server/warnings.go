@@ -0,0 +1,102 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	log "k8s.io/klog/v2"
+)
+
+// warningsMetadataKey is the trailer metadata key of the non-fatal warnings,
+// such as the use of a deprecated API, of a successful call. It is exposed by
+// the gateway as the Grpc-Trailer-Warnings header.
+const warningsMetadataKey = "warnings"
+
+// warningsContextKey is the context key of the warnings collected for a call.
+type warningsContextKey struct{}
+
+// warnings collects the distinct warnings added, possibly concurrently by
+// several plugins, while handling a call. They are listed sorted so that the
+// trailer does not depend on the order in which the plugins added them.
+type warnings struct {
+	mutex    sync.Mutex
+	messages []string
+}
+
+func (w *warnings) add(message string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for _, m := range w.messages {
+		if m == message {
+			return
+		}
+	}
+	w.messages = append(w.messages, message)
+}
+
+func (w *warnings) list() []string {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	messages := append([]string{}, w.messages...)
+	sort.Strings(messages)
+	return messages
+}
+
+// AddWarning attaches a non-fatal warning to the response of the call being
+// handled, returned to the client in the warnings trailer if the call
+// succeeds. Outside of a call, the warning is only logged.
+func AddWarning(ctx context.Context, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	w, ok := ctx.Value(warningsContextKey{}).(*warnings)
+	if !ok {
+		log.Warningf("Warning outside of a call: %s", message)
+		return
+	}
+	w.add(message)
+}
+
+// warningsInterceptor collects the warnings added while handling the unary
+// calls and sets them in the trailer of the successful ones.
+func warningsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	w := &warnings{}
+	resp, err := handler(context.WithValue(ctx, warningsContextKey{}, w), req)
+	if err != nil {
+		return resp, err
+	}
+	if messages := w.list(); len(messages) > 0 {
+		if trailerErr := grpc.SetTrailer(ctx, metadata.MD{warningsMetadataKey: messages}); trailerErr != nil {
+			log.Warningf("Unable to set the warnings trailer of %q: %v", info.FullMethod, trailerErr)
+		}
+	}
+	return resp, nil
+}
+
+// warningsStreamInterceptor collects the warnings added while handling the
+// streaming calls and sets them in the trailer of the successful ones.
+func warningsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	w := &warnings{}
+	err := handler(srv, &contextServerStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), warningsContextKey{}, w)})
+	if err != nil {
+		return err
+	}
+	if messages := w.list(); len(messages) > 0 {
+		ss.SetTrailer(metadata.MD{warningsMetadataKey: messages})
+	}
+	return nil
+}
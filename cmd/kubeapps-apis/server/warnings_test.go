@@ -0,0 +1,182 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// warningPackagingPluginServer is a test plugin adding warnings to the
+// available package details it returns, or failing with the given error.
+type warningPackagingPluginServer struct {
+	plugin_test.TestPackagingPluginServer
+
+	warnings []string
+	err      error
+}
+
+func (s warningPackagingPluginServer) GetAvailablePackageDetail(ctx context.Context, request *corev1.GetAvailablePackageDetailRequest) (*corev1.GetAvailablePackageDetailResponse, error) {
+	for _, warning := range s.warnings {
+		AddWarning(ctx, "%s", warning)
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.TestPackagingPluginServer.GetAvailablePackageDetail(ctx, request)
+}
+
+func TestWarningsInterceptor(t *testing.T) {
+	testCases := []struct {
+		name            string
+		warnings        []string
+		err             error
+		statusCode      codes.Code
+		expectedTrailer metadata.MD
+	}{
+		{
+			name:       "it returns the warnings of the plugin in the trailer of a successful call",
+			warnings:   []string{"the API v1beta1 is deprecated", "the namespace quota is 90% used"},
+			statusCode: codes.OK,
+			expectedTrailer: metadata.MD{
+				warningsMetadataKey: []string{"the API v1beta1 is deprecated", "the namespace quota is 90% used"},
+			},
+		},
+		{
+			name:       "it returns the warnings sorted, whatever the order in which they were added",
+			warnings:   []string{"the namespace quota is 90% used", "the API v1beta1 is deprecated"},
+			statusCode: codes.OK,
+			expectedTrailer: metadata.MD{
+				warningsMetadataKey: []string{"the API v1beta1 is deprecated", "the namespace quota is 90% used"},
+			},
+		},
+		{
+			name:       "it returns the same warning once",
+			warnings:   []string{"the API v1beta1 is deprecated", "the API v1beta1 is deprecated"},
+			statusCode: codes.OK,
+			expectedTrailer: metadata.MD{
+				warningsMetadataKey: []string{"the API v1beta1 is deprecated"},
+			},
+		},
+		{
+			name:            "it does not set a trailer without warnings",
+			warnings:        nil,
+			statusCode:      codes.OK,
+			expectedTrailer: nil,
+		},
+		{
+			name:            "it does not return the warnings of a failed call",
+			warnings:        []string{"the API v1beta1 is deprecated"},
+			err:             status.Errorf(codes.NotFound, "package not found"),
+			statusCode:      codes.NotFound,
+			expectedTrailer: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+			server := &packagesServer{
+				plugins: []*pkgsPluginWithServer{
+					{
+						plugin: plugin,
+						server: warningPackagingPluginServer{
+							TestPackagingPluginServer: plugin_test.TestPackagingPluginServer{
+								Plugin:                 plugin,
+								AvailablePackageDetail: plugin_test.MakeAvailablePackageDetail("pkg-1", plugin),
+							},
+							warnings: tc.warnings,
+							err:      tc.err,
+						},
+					},
+				},
+			}
+			request := &corev1.GetAvailablePackageDetailRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: globalPackagingNamespace},
+					Identifier: "pkg-1",
+					Plugin:     plugin,
+				},
+			}
+			stream := &testServerTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			info := &grpc.UnaryServerInfo{FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageDetail"}
+
+			response, err := warningsInterceptor(ctx, request, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return server.GetAvailablePackageDetail(ctx, req.(*corev1.GetAvailablePackageDetailRequest))
+			})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode == codes.OK && response.(*corev1.GetAvailablePackageDetailResponse).GetAvailablePackageDetail() == nil {
+				t.Errorf("got no available package detail")
+			}
+			if got, want := stream.trailer, tc.expectedTrailer; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestAddWarningOutsideOfACall(t *testing.T) {
+	// The warning is only logged, without panicking.
+	AddWarning(context.Background(), "the API %s is deprecated", "v1beta1")
+}
+
+func TestWarningsStreamInterceptor(t *testing.T) {
+	testCases := []struct {
+		name            string
+		err             error
+		expectedTrailer metadata.MD
+	}{
+		{
+			name: "it returns the warnings in the trailer of a successful stream",
+			expectedTrailer: metadata.MD{
+				warningsMetadataKey: []string{"the API v1beta1 is deprecated"},
+			},
+		},
+		{
+			name:            "it does not return the warnings of a failed stream",
+			err:             status.Errorf(codes.NotFound, "package not found"),
+			expectedTrailer: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := &testServerStream{}
+
+			err := warningsStreamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}, func(srv interface{}, stream grpc.ServerStream) error {
+				AddWarning(stream.Context(), "the API v1beta1 is deprecated")
+				return tc.err
+			})
+
+			if got, want := err, tc.err; got != want {
+				t.Fatalf("got: %+v, want: %+v", got, want)
+			}
+			if got, want := stream.trailer, tc.expectedTrailer; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
@@ -33,6 +33,10 @@ const defaultAvailablePackageSummary: AvailablePackageSummary = {
   iconUrl: "",
   latestVersion: { appVersion: "v1.0.0", pkgVersion: "" },
   shortDescription: "",
+  installedCount: 0,
+  localizedDescriptions: [],
+  deprecated: false,
+  deprecationMessage: "",
   availablePackageRef: {
     identifier: "foo/foo",
     context: { cluster: "", namespace: "chart-namespace" } as Context,
@@ -50,6 +54,9 @@ const defaultAvailablePackageDetail: AvailablePackageDetail = {
   sourceUrls: [],
   shortDescription: "",
   longDescription: "",
+  localizedDescriptions: [],
+  deprecated: false,
+  deprecationMessage: "",
   availablePackageRef: {
     identifier: "foo/foo",
     context: { cluster: "", namespace: "chart-namespace" } as Context,
@@ -46,6 +46,10 @@ const availablePkgSummary1: AvailablePackageSummary = {
   iconUrl: "",
   latestVersion: { appVersion: "v1.0.0", pkgVersion: "" },
   shortDescription: "",
+  installedCount: 0,
+  localizedDescriptions: [],
+  deprecated: false,
+  deprecationMessage: "",
   availablePackageRef: {
     identifier: "foo/foo",
     context: { cluster: "", namespace: "chart-namespace" } as Context,
@@ -59,6 +63,10 @@ const availablePkgSummary2: AvailablePackageSummary = {
   iconUrl: "",
   latestVersion: { appVersion: "v2.0.0", pkgVersion: "" },
   shortDescription: "",
+  installedCount: 0,
+  localizedDescriptions: [],
+  deprecated: false,
+  deprecationMessage: "",
   availablePackageRef: {
     identifier: "bar/bar",
     context: { cluster: "", namespace: "chart-namespace" } as Context,
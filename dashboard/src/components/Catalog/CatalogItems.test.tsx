@@ -13,6 +13,10 @@ const chartItem: AvailablePackageSummary = {
   iconUrl: "",
   latestVersion: { appVersion: "v1.0.0", pkgVersion: "" },
   shortDescription: "",
+  installedCount: 0,
+  localizedDescriptions: [],
+  deprecated: false,
+  deprecationMessage: "",
   availablePackageRef: {
     identifier: "foo/foo",
     context: { cluster: "", namespace: "chart-namespace" } as Context,
@@ -26,6 +30,10 @@ const chartItem2: AvailablePackageSummary = {
   iconUrl: "",
   latestVersion: { appVersion: "v2.0.0", pkgVersion: "" },
   shortDescription: "",
+  installedCount: 0,
+  localizedDescriptions: [],
+  deprecated: false,
+  deprecationMessage: "",
   availablePackageRef: {
     identifier: "bar/bar",
     context: { cluster: "", namespace: "chart-namespace" } as Context,
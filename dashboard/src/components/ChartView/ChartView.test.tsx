@@ -46,6 +46,9 @@ const defaultAvailablePkgDetail: AvailablePackageDetail = {
   sourceUrls: ["test"],
   shortDescription: "test",
   longDescription: "test",
+  localizedDescriptions: [],
+  deprecated: false,
+  deprecationMessage: "",
   availablePackageRef: {
     identifier: "foo/foo",
     context: { cluster: "", namespace: "chart-namespace" } as Context,
@@ -71,6 +74,9 @@ const emptyAvailablePkg: AvailablePackageDetail = {
   sourceUrls: [],
   shortDescription: "",
   longDescription: "",
+  localizedDescriptions: [],
+  deprecated: false,
+  deprecationMessage: "",
   availablePackageRef: {
     identifier: "foo/foo",
     context: { cluster: "", namespace: "chart-namespace" } as Context,
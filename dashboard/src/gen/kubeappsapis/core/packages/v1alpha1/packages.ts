@@ -5,6 +5,8 @@ import _m0 from "protobufjs/minimal";
 import { Any } from "../../../../google/protobuf/any";
 import { Plugin } from "../../../../kubeappsapis/core/plugins/v1alpha1/plugins";
 import { BrowserHeaders } from "browser-headers";
+import { Observable } from "rxjs";
+import { share } from "rxjs/operators";
 
 export const protobufPackage = "kubeappsapis.core.packages.v1alpha1";
 
@@ -20,6 +22,57 @@ export interface GetAvailablePackageSummariesRequest {
   filterOptions?: FilterOptions;
   /** Pagination options specifying where to start and how many results to include. */
   paginationOptions?: PaginationOptions;
+  /**
+   * Include installed count
+   *
+   * Whether to compute, for each available package, the number of times it is
+   * installed. Disabled by default as it requires listing the installed packages.
+   */
+  includeInstalledCount: boolean;
+  /**
+   * Accept language
+   *
+   * The preferred languages of the user, in the format of an Accept-Language
+   * header (eg. "fr-CA,fr;q=0.9,en;q=0.8"). The package descriptions are
+   * returned in the best matching language available, falling back to the
+   * default descriptions otherwise.
+   */
+  acceptLanguage: string;
+  /**
+   * Max description length
+   *
+   * The optional maximum length, in characters, of the returned short
+   * descriptions. Longer descriptions are truncated with an ellipsis, while
+   * they are returned intact when unset (0).
+   */
+  maxDescriptionLength: number;
+  /**
+   * Modified since
+   *
+   * An optional RFC 3339 timestamp (eg. "2021-09-01T10:00:00Z") so that only
+   * the packages modified after it are returned, for clients polling for
+   * changes. Plugins without change tracking ignore it and return all the
+   * packages: currently only the helm plugin, matching the packages with a
+   * version created after it, supports it.
+   */
+  modifiedSince: string;
+  /**
+   * Include category counts
+   *
+   * Whether to return, for each category, the number of available packages
+   * matching the FilterOptions within it, for faceted browsing. Disabled by
+   * default as it requires fetching all the packages of every plugin, even
+   * when paginating.
+   */
+  includeCategoryCounts: boolean;
+  /**
+   * Plugin names
+   *
+   * Optional names of the plugins (eg. "helm.packages") whose available
+   * packages are returned, the other plugins not being queried. All the
+   * plugins are queried when empty.
+   */
+  pluginNames: string[];
 }
 
 /**
@@ -36,7 +89,50 @@ export interface GetAvailablePackageDetailRequest {
   /**
    * Optional specific version (or version reference) to request.
    * By default the latest version (or latest version matching the reference)
-   * will be returned.
+   * will be returned. The "latest" pseudo-version can also be requested
+   * explicitly and is resolved to the newest (semver) available version.
+   */
+  pkgVersion: string;
+  /**
+   * Accept language
+   *
+   * The preferred languages of the user, in the format of an Accept-Language
+   * header (eg. "fr-CA,fr;q=0.9,en;q=0.8"). The package descriptions are
+   * returned in the best matching language available, falling back to the
+   * default descriptions otherwise.
+   */
+  acceptLanguage: string;
+  /**
+   * Target cluster
+   *
+   * The optional cluster on which the package is to be installed. When set,
+   * the Kubernetes version of the cluster is checked against the kube_version
+   * of the package and a warning is returned if it is not supported.
+   */
+  targetCluster: string;
+  /**
+   * Include pre-release
+   *
+   * Whether the "latest" pseudo-version can be resolved to a pre-release
+   * version (eg. "2.0.0-beta.1"), rather than to the newest stable version.
+   */
+  includePrerelease: boolean;
+}
+
+/**
+ * GetAvailablePackageReadmeRequest
+ *
+ * Request for GetAvailablePackageReadme
+ */
+export interface GetAvailablePackageReadmeRequest {
+  /**
+   * The information required to uniquely
+   * identify an available package
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * Optional specific version (or version reference) to request, as for
+   * GetAvailablePackageDetail.
    */
   pkgVersion: string;
 }
@@ -59,6 +155,165 @@ export interface GetAvailablePackageVersionsRequest {
    * is provided for completeness only.
    */
   pkgVersion: string;
+  /**
+   * Optional channel (or track, eg. "stable" or "edge") to which the returned
+   * versions are restricted, for repositories organizing the versions of a
+   * package into channels. Plugins without channels ignore it.
+   */
+  channel: string;
+  /**
+   * Optional flag requesting the versions to be also returned grouped by
+   * major.minor, each group with its newest patch version, for the clients
+   * displaying a collapsed view of long version lists.
+   */
+  groupByMinor: boolean;
+  /**
+   * Include pre-release
+   *
+   * Whether to return the pre-release versions (eg. "2.0.0-beta.1"), which
+   * are excluded by default. The versions which are not valid semantic
+   * versions are always returned, their pre-release status being unknown.
+   */
+  includePrerelease: boolean;
+  /**
+   * Installed version
+   *
+   * Optional version of the package currently installed (eg. "10.2.5"), when
+   * listing the versions to which an installed package can be updated. Each
+   * returned version is then annotated with its relation to the installed
+   * one, using the semantic versioning ordering.
+   */
+  installedVersion: string;
+}
+
+/**
+ * GetAvailablePackageDependenciesRequest
+ *
+ * Request for GetAvailablePackageDependencies
+ */
+export interface GetAvailablePackageDependenciesRequest {
+  /**
+   * The information required to uniquely
+   * identify an available package
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * Optional specific version of the package for which the dependencies
+   * are requested. By default the dependencies of the latest version
+   * will be returned.
+   */
+  pkgVersion: string;
+}
+
+/**
+ * GetAvailablePackageDefaultValuesRequest
+ *
+ * Request for GetAvailablePackageDefaultValues
+ */
+export interface GetAvailablePackageDefaultValuesRequest {
+  /**
+   * The information required to uniquely
+   * identify an available package
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * Optional specific version of the package for which the default values
+   * are requested. By default the default values of the latest version
+   * will be returned.
+   */
+  pkgVersion: string;
+}
+
+/**
+ * GetAvailablePackageChangelogRequest
+ *
+ * Request for GetAvailablePackageChangelog
+ */
+export interface GetAvailablePackageChangelogRequest {
+  /**
+   * The information required to uniquely
+   * identify an available package
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * From package version
+   *
+   * The version from which the changes are requested, typically the version
+   * of an installed package, whose own changes are not returned. By default
+   * the changes of all the versions up to the target version are returned.
+   */
+  fromPkgVersion: string;
+  /**
+   * To package version
+   *
+   * The target version up to which the changes are requested, included.
+   * By default the changes up to the latest version are returned.
+   */
+  toPkgVersion: string;
+}
+
+/**
+ * GetAvailablePackageValuesDiffRequest
+ *
+ * Request for GetAvailablePackageValuesDiff
+ */
+export interface GetAvailablePackageValuesDiffRequest {
+  /**
+   * The information required to uniquely
+   * identify an available package
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * From package version
+   *
+   * The version whose default values are compared, typically the version of
+   * an installed package being upgraded.
+   * Required
+   */
+  fromPkgVersion: string;
+  /**
+   * To package version
+   *
+   * The version whose default values are compared with the ones of the from
+   * version. By default the latest version is used.
+   */
+  toPkgVersion: string;
+}
+
+/**
+ * RenderAvailablePackageRequest
+ *
+ * Request for RenderAvailablePackage
+ */
+export interface RenderAvailablePackageRequest {
+  /**
+   * The information required to uniquely
+   * identify an available package
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * Optional specific version of the package to be rendered. By default
+   * the latest version will be rendered.
+   */
+  pkgVersion: string;
+  /**
+   * The optional values with which the package is rendered, as in the
+   * CreateInstalledPackageRequest.
+   */
+  values: string;
+  /**
+   * The optional context (cluster/namespace) and name of the installed
+   * package as if it were created, used by the templates referring to them.
+   */
+  targetContext?: Context;
+  name: string;
+  /**
+   * Include apply order
+   *
+   * Whether to also return the order in which the rendered resources would
+   * be applied when installing the package, if supported by the plugin.
+   */
+  includeApplyOrder: boolean;
 }
 
 /**
@@ -84,6 +339,46 @@ export interface GetInstalledPackageDetailRequest {
    * identify an installed package
    */
   installedPackageRef?: InstalledPackageReference;
+  /**
+   * Optional flag to include, by plugins supporting it, a rollup of the
+   * status of the workloads (eg. deployments) of the installed package
+   * in the workload_status of the detail. Omitted by default as it requires
+   * fetching each workload from the cluster.
+   */
+  includeWorkloadStatus: boolean;
+}
+
+/**
+ * GetInstalledPackageDetailsBatchRequest
+ *
+ * Request for GetInstalledPackageDetailsBatch
+ */
+export interface GetInstalledPackageDetailsBatchRequest {
+  /**
+   * The references to uniquely identify the installed packages, possibly
+   * managed by different plugins.
+   */
+  installedPackageRefs: InstalledPackageReference[];
+  /**
+   * Include workload status
+   *
+   * Whether to include the rollup of the workload status of each installed
+   * package, as for GetInstalledPackageDetail.
+   */
+  includeWorkloadStatus: boolean;
+}
+
+/**
+ * GetInstalledPackageValuesRequest
+ *
+ * Request for GetInstalledPackageValues
+ */
+export interface GetInstalledPackageValuesRequest {
+  /**
+   * The information required to uniquely
+   * identify an installed package
+   */
+  installedPackageRef?: InstalledPackageReference;
 }
 
 /**
@@ -115,6 +410,50 @@ export interface CreateInstalledPackageRequest {
    * the package on the cluster.
    */
   reconciliationOptions?: ReconciliationOptions;
+  /**
+   * Reconcile interval
+   *
+   * An optional interval, as a duration (eg. "10m" or "1h30m"), with which
+   * the package is checked for reconciliation by plugins supporting it (eg.
+   * flux). It overrides the interval of the reconciliation options and is
+   * ignored by the other plugins.
+   */
+  reconcileInterval: string;
+  /**
+   * Labels
+   *
+   * Optional labels (eg. team or cost-center) applied to the resources created
+   * for the installed package by plugins supporting it. Ignored by the other
+   * plugins.
+   */
+  labels: { [key: string]: string };
+  /**
+   * Annotations
+   *
+   * Optional annotations applied to the resources created for the installed
+   * package by plugins supporting it. Ignored by the other plugins.
+   */
+  annotations: { [key: string]: string };
+  /**
+   * Install timeout
+   *
+   * An optional timeout, as a duration (eg. "5m"), forwarded to the plugins
+   * supporting it: the helm plugin bounds with it the Kubernetes operations of
+   * the install, such as the hooks, as the helm --timeout flag without waiting
+   * for the release, while the flux plugin sets the HelmRelease timeout. The
+   * default install timeout of the server, if any, is used when not specified.
+   */
+  installTimeout: string;
+}
+
+export interface CreateInstalledPackageRequest_LabelsEntry {
+  key: string;
+  value: string;
+}
+
+export interface CreateInstalledPackageRequest_AnnotationsEntry {
+  key: string;
+  value: string;
 }
 
 /**
@@ -159,830 +498,7666 @@ export interface UpdateInstalledPackageRequest {
 }
 
 /**
- * DeleteInstalledPackageRequest
+ * GetInstalledPackageUpgradeDiffRequest
  *
- * Request for DeleteInstalledPackage
+ * Request for GetInstalledPackageUpgradeDiff, with the same fields as the
+ * UpdateInstalledPackageRequest for which the diff is previewed.
  */
-export interface DeleteInstalledPackageRequest {
-  /** A reference to uniquely identify the installed package to be deleted. */
+export interface GetInstalledPackageUpgradeDiffRequest {
+  /**
+   * A reference uniquely identifying the installed package to be updated.
+   * Required
+   */
   installedPackageRef?: InstalledPackageReference;
+  /** The target version of the package, as in the UpdateInstalledPackageRequest. */
+  pkgVersionReference?: VersionReference;
+  /**
+   * The optional values for the target version, as in the
+   * UpdateInstalledPackageRequest.
+   */
+  values: string;
 }
 
 /**
- * GetAvailablePackageSummariesResponse
+ * GetInstalledPackageDriftRequest
  *
- * Response for GetAvailablePackageSummaries
+ * Request for GetInstalledPackageDrift
  */
-export interface GetAvailablePackageSummariesResponse {
+export interface GetInstalledPackageDriftRequest {
   /**
-   * Available packages summaries
-   *
-   * List of AvailablePackageSummary
+   * A reference uniquely identifying the installed package to be compared
+   * with the current state of the cluster.
+   * Required
    */
-  availablePackageSummaries: AvailablePackageSummary[];
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * GetInstalledPackageUpdateAvailableRequest
+ *
+ * Request for GetInstalledPackageUpdateAvailable
+ */
+export interface GetInstalledPackageUpdateAvailableRequest {
   /**
-   * Next page token
-   *
-   * This field represents the pagination token to retrieve the next page of
-   * results. If the value is "", it means no further results for the request.
+   * A reference uniquely identifying the installed package whose version is
+   * compared with the available versions.
+   * Required
    */
-  nextPageToken: string;
+  installedPackageRef?: InstalledPackageReference;
   /**
-   * Categories
+   * Include pre-release
    *
-   * This optional field contains the distinct category names considering the FilterOptions.
+   * Whether the pre-release versions (eg. "2.0.0-beta.1") are considered as
+   * updates, rather than only the stable versions.
    */
-  categories: string[];
+  includePrerelease: boolean;
 }
 
 /**
- * GetAvailablePackageDetailResponse
+ * ExportInstalledPackageRequest
  *
- * Response for GetAvailablePackageDetail
+ * Request for ExportInstalledPackage
  */
-export interface GetAvailablePackageDetailResponse {
+export interface ExportInstalledPackageRequest {
   /**
-   * Available package detail
-   *
-   * The requested AvailablePackageDetail
+   * A reference uniquely identifying the installed package to be exported.
+   * Required
    */
-  availablePackageDetail?: AvailablePackageDetail;
+  installedPackageRef?: InstalledPackageReference;
 }
 
 /**
- * GetAvailablePackageVersionsResponse
+ * DeleteInstalledPackageRequest
  *
- * Response for GetAvailablePackageVersions
+ * Request for DeleteInstalledPackage
  */
-export interface GetAvailablePackageVersionsResponse {
-  /**
-   * Package app versions
-   *
-   * By default (when version_query is empty or ignored) the response
-   * should contain an ordered summary of versions including the most recent three
-   * patch versions of the most recent three minor versions of the most recent three
-   * major versions when available, something like:
-   * [
-   *   { pkg_version: "10.3.19", app_version: "2.16.8" },
-   *   { pkg_version: "10.3.18", app_version: "2.16.8" },
-   *   { pkg_version: "10.3.17", app_version: "2.16.7" },
-   *   { pkg_version: "10.2.6", app_version: "2.15.3" },
-   *   { pkg_version: "10.2.5", app_version: "2.15.2" },
-   *   { pkg_version: "10.2.4", app_version: "2.15.2" },
-   *   { pkg_version: "10.1.8", app_version: "2.13.5" },
-   *   { pkg_version: "10.1.7", app_version: "2.13.5" },
-   *   { pkg_version: "10.1.6", app_version: "2.13.5" },
-   *   { pkg_version: "9.5.4", app_version: "2.8.9" },
-   *   ...
-   *   { pkg_version: "8.2.5", app_version: "1.19.5" },
-   *   ...
-   * ]
-   * If a version_query is present and the plugin chooses to support it,
-   * the full history of versions matching the version query should be returned.
-   */
-  packageAppVersions: PackageAppVersion[];
+export interface DeleteInstalledPackageRequest {
+  /** A reference to uniquely identify the installed package to be deleted. */
+  installedPackageRef?: InstalledPackageReference;
 }
 
 /**
- * GetInstalledPackageSummariesResponse
+ * DeleteInstalledPackagesBatchRequest
  *
- * Response for GetInstalledPackageSummaries
+ * Request for DeleteInstalledPackagesBatch
  */
-export interface GetInstalledPackageSummariesResponse {
+export interface DeleteInstalledPackagesBatchRequest {
+  /** The references to uniquely identify the installed packages to be deleted. */
+  installedPackageRefs: InstalledPackageReference[];
   /**
-   * Installed packages summaries
+   * Concurrent
    *
-   * List of InstalledPackageSummary
+   * Whether the installed packages are deleted concurrently rather than
+   * one after the other.
    */
-  installedPackageSummaries: InstalledPackageSummary[];
-  /**
-   * Next page token
-   *
-   * This field represents the pagination token to retrieve the next page of
-   * results. If the value is "", it means no further results for the request.
-   */
-  nextPageToken: string;
+  concurrent: boolean;
 }
 
 /**
- * GetInstalledPackageDetailResponse
+ * GetCatalogStatsRequest
  *
- * Response for GetInstalledPackageDetail
+ * Request for GetCatalogStats
  */
-export interface GetInstalledPackageDetailResponse {
-  /**
-   * InstalledPackageDetail
-   *
-   * The requested InstalledPackageDetail
-   */
-  installedPackageDetail?: InstalledPackageDetail;
+export interface GetCatalogStatsRequest {
+  /** The context (cluster/namespace) for the request */
+  context?: Context;
 }
 
 /**
- * CreateInstalledPackageResponse
+ * GetRecentActivityRequest
  *
- * Response for CreateInstalledPackage
+ * Request for GetRecentActivity
  */
-export interface CreateInstalledPackageResponse {
-  installedPackageRef?: InstalledPackageReference;
+export interface GetRecentActivityRequest {
+  /** The context (cluster/namespace) for the request */
+  context?: Context;
+  /** Pagination options specifying where to start and how many results to include. */
+  paginationOptions?: PaginationOptions;
 }
 
 /**
- * UpdateInstalledPackageResponse
+ * GetUserPermissionsRequest
  *
- * Response for UpdateInstalledPackage
+ * Request for GetUserPermissions
  */
-export interface UpdateInstalledPackageResponse {
-  installedPackageRef?: InstalledPackageReference;
+export interface GetUserPermissionsRequest {
+  /** The context (cluster/namespace) in which the permissions are checked */
+  context?: Context;
+  /** The plugin managing the packages for which the permissions are checked */
+  plugin?: Plugin;
 }
 
 /**
- * DeleteInstalledPackageResponse
+ * GetCatalogIndexRequest
  *
- * Response for DeleteInstalledPackage
+ * Request for GetCatalogIndex
  */
-export interface DeleteInstalledPackageResponse {}
+export interface GetCatalogIndexRequest {
+  /** The context (cluster/namespace) for the request */
+  context?: Context;
+}
 
 /**
- * AvailablePackageSummary
+ * GetAvailablePackageSummariesResponse
  *
- * An AvailablePackageSummary provides a summary of a package available for installation
- * useful when aggregating many available packages.
+ * Response for GetAvailablePackageSummaries
  */
-export interface AvailablePackageSummary {
-  /**
-   * Available package reference
-   *
-   * A reference uniquely identifying the package.
-   */
-  availablePackageRef?: AvailablePackageReference;
+export interface GetAvailablePackageSummariesResponse {
   /**
-   * Available package name
+   * Available packages summaries
    *
-   * The name of the available package
+   * List of AvailablePackageSummary
    */
-  name: string;
+  availablePackageSummaries: AvailablePackageSummary[];
   /**
-   * Latest available version
+   * Next page token
    *
-   * The latest version available for this package. Often expected when viewing
-   * a summary of many available packages.
+   * This field represents the pagination token to retrieve the next page of
+   * results. If the value is "", it means no further results for the request.
    */
-  latestVersion?: PackageAppVersion;
+  nextPageToken: string;
   /**
-   * Available package Icon URL
+   * Categories
    *
-   * A url for an icon.
+   * This optional field contains the distinct category names considering the FilterOptions.
    */
-  iconUrl: string;
+  categories: string[];
   /**
-   * Available package display name
+   * Category counts
    *
-   * A name as displayed to users
+   * The number of available packages matching the FilterOptions in each
+   * category, across all the pages, ordered as the categories. Only returned
+   * when include_category_counts is set.
    */
-  displayName: string;
+  categoryCounts: CategoryCount[];
+}
+
+/**
+ * CategoryCount
+ *
+ * The number of available packages in a category
+ */
+export interface CategoryCount {
   /**
-   * Available package short description
+   * Name
    *
-   * A short description of the app provided by the package
+   * The name of the category
    */
-  shortDescription: string;
+  name: string;
   /**
-   * Available package categories
+   * Count
    *
-   * A user-facing list of category names useful for creating richer user interfaces.
-   * Plugins can choose not to implement this
+   * The number of available packages in the category
    */
-  categories: string[];
+  count: number;
 }
 
 /**
- * AvailablePackageDetail
+ * GetAvailablePackageDetailResponse
  *
- * An AvailablePackageDetail provides additional details required when
- * inspecting an individual package.
+ * Response for GetAvailablePackageDetail
  */
-export interface AvailablePackageDetail {
+export interface GetAvailablePackageDetailResponse {
   /**
-   * Available package reference
+   * Available package detail
    *
-   * A reference uniquely identifying the package.
+   * The requested AvailablePackageDetail
    */
-  availablePackageRef?: AvailablePackageReference;
+  availablePackageDetail?: AvailablePackageDetail;
+}
+
+/**
+ * GetAvailablePackageReadmeResponse
+ *
+ * Response for GetAvailablePackageReadme, streamed once per chunk
+ */
+export interface GetAvailablePackageReadmeResponse {
   /**
-   * Available package name
+   * README chunk
    *
-   * The name of the available package
+   * The next chunk of the README of the package, to be appended to the
+   * previous ones. Chunks are split on character boundaries.
    */
-  name: string;
+  chunk: string;
+}
+
+/**
+ * GetAvailablePackageVersionsResponse
+ *
+ * Response for GetAvailablePackageVersions
+ */
+export interface GetAvailablePackageVersionsResponse {
   /**
-   * Available version
+   * Package app versions
    *
-   * The version of the package and application.
+   * By default (when version_query is empty or ignored) the response
+   * should contain an ordered summary of versions including the most recent three
+   * patch versions of the most recent three minor versions of the most recent three
+   * major versions when available, something like:
+   * [
+   *   { pkg_version: "10.3.19", app_version: "2.16.8" },
+   *   { pkg_version: "10.3.18", app_version: "2.16.8" },
+   *   { pkg_version: "10.3.17", app_version: "2.16.7" },
+   *   { pkg_version: "10.2.6", app_version: "2.15.3" },
+   *   { pkg_version: "10.2.5", app_version: "2.15.2" },
+   *   { pkg_version: "10.2.4", app_version: "2.15.2" },
+   *   { pkg_version: "10.1.8", app_version: "2.13.5" },
+   *   { pkg_version: "10.1.7", app_version: "2.13.5" },
+   *   { pkg_version: "10.1.6", app_version: "2.13.5" },
+   *   { pkg_version: "9.5.4", app_version: "2.8.9" },
+   *   ...
+   *   { pkg_version: "8.2.5", app_version: "1.19.5" },
+   *   ...
+   * ]
+   * If a version_query is present and the plugin chooses to support it,
+   * the full history of versions matching the version query should be returned.
    */
-  version?: PackageAppVersion;
-  /** the url of the package repository that contains this package */
-  repoUrl: string;
-  /** the url of the “home” for the package */
-  homeUrl: string;
+  packageAppVersions: PackageAppVersion[];
   /**
-   * Available package icon URL
+   * Version groups
    *
-   * A url for an icon.
+   * The package app versions grouped by major.minor, newest first, when
+   * requested with group_by_minor. The versions which are not valid semantic
+   * versions are not grouped.
    */
-  iconUrl: string;
+  versionGroups: PackageVersionGroup[];
+}
+
+/**
+ * GetAvailablePackageDependenciesResponse
+ *
+ * Response for GetAvailablePackageDependencies
+ */
+export interface GetAvailablePackageDependenciesResponse {
   /**
-   * Available package display name
+   * Package dependencies
    *
-   * A name as displayed to users
+   * The tree of dependencies of the requested package. Plugins which
+   * do not have any dependency information return an empty list.
    */
-  displayName: string;
+  packageDependencies: PackageDependency[];
+}
+
+/**
+ * GetAvailablePackageDefaultValuesResponse
+ *
+ * Response for GetAvailablePackageDefaultValues
+ */
+export interface GetAvailablePackageDefaultValuesResponse {
   /**
-   * Available package short description
+   * Default values
    *
-   * A short description of the app provided by the package
+   * The raw default values YAML of the requested package version, to be
+   * edited before installing it. Empty when the package has no values.
    */
-  shortDescription: string;
+  defaultValues: string;
+}
+
+/**
+ * GetAvailablePackageChangelogResponse
+ *
+ * Response for GetAvailablePackageChangelog
+ */
+export interface GetAvailablePackageChangelogResponse {
   /**
-   * Available package long description
+   * Changelog entries
    *
-   * A longer description of the package, a few sentences.
+   * The changes of each version in the requested range, the most recent
+   * version first. Empty when the package does not ship a changelog.
    */
-  longDescription: string;
+  changelogEntries: PackageChangelogEntry[];
+}
+
+/**
+ * GetAvailablePackageValuesDiffResponse
+ *
+ * Response for GetAvailablePackageValuesDiff. The keys are the dot-separated
+ * paths of the default values (eg. "image.tag"), in alphabetical order, the
+ * lists being compared as a whole.
+ */
+export interface GetAvailablePackageValuesDiffResponse {
   /**
-   * Available package readme
+   * Added keys
    *
-   * A longer README with potentially pages of formatted Markdown.
+   * The keys of the default values of the to version missing from the from
+   * version.
    */
-  readme: string;
+  addedKeys: string[];
   /**
-   * Available package default values
+   * Removed keys
    *
-   * An example of default values used during package templating that can serve
-   * as documentation or a starting point for user customization.
+   * The keys of the default values of the from version missing from the to
+   * version.
    */
-  defaultValues: string;
-  valuesSchema: string;
-  /** source urls for the package */
-  sourceUrls: string[];
+  removedKeys: string[];
   /**
-   * Available package maintainers
+   * Changed keys
    *
-   * List of Maintainer
+   * The keys of the default values of both versions with a different value.
    */
-  maintainers: Maintainer[];
+  changedKeys: string[];
+}
+
+/**
+ * RenderAvailablePackageResponse
+ *
+ * Response for RenderAvailablePackage
+ */
+export interface RenderAvailablePackageResponse {
   /**
-   * Available package categories
+   * Manifest
    *
-   * A user-facing list of category names useful for creating richer user interfaces.
-   * Plugins can choose not to implement this
+   * The manifests rendered from the templates of the package with the
+   * requested values, without anything being created in the cluster.
    */
-  categories: string[];
+  manifest: string;
   /**
-   * Custom data added by the plugin
+   * Apply order
    *
-   * A plugin can define custom details for data which is not yet, or never will
-   * be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
-   * of an `Any` field means that each plugin can define the structure of this
-   * message as required, while still satisfying the core interface.
-   * See https://developers.google.com/protocol-buffers/docs/proto3#any
+   * The rendered resources in the order in which they would be applied when
+   * installing the package (eg. the CustomResourceDefinitions and the
+   * Namespaces first). Only returned when requested, empty if the plugin
+   * does not support it.
    */
-  customDetail?: Any;
+  applyOrder: RenderedResource[];
 }
 
 /**
- * InstalledPackageSummary
+ * GetInstalledPackageSummariesResponse
  *
- * An InstalledPackageSummary provides a summary of an installed package
- * useful when aggregating many installed packages.
+ * Response for GetInstalledPackageSummaries
  */
-export interface InstalledPackageSummary {
+export interface GetInstalledPackageSummariesResponse {
   /**
-   * InstalledPackageReference
+   * Installed packages summaries
    *
-   * A reference uniquely identifying the package.
+   * List of InstalledPackageSummary
    */
-  installedPackageRef?: InstalledPackageReference;
+  installedPackageSummaries: InstalledPackageSummary[];
   /**
-   * Name
+   * Next page token
    *
-   * A name given to the installation of the package (eg. "my-postgresql-for-testing").
+   * This field represents the pagination token to retrieve the next page of
+   * results. If the value is "", it means no further results for the request.
    */
-  name: string;
+  nextPageToken: string;
+}
+
+/**
+ * GetInstalledPackageDetailResponse
+ *
+ * Response for GetInstalledPackageDetail
+ */
+export interface GetInstalledPackageDetailResponse {
   /**
-   * PkgVersionReference
+   * InstalledPackageDetail
    *
-   * The package version reference defines a version or constraint limiting
-   * matching package versions.
+   * The requested InstalledPackageDetail
    */
-  pkgVersionReference?: VersionReference;
+  installedPackageDetail?: InstalledPackageDetail;
+}
+
+/**
+ * GetInstalledPackageDetailsBatchResponse
+ *
+ * Response for GetInstalledPackageDetailsBatch
+ */
+export interface GetInstalledPackageDetailsBatchResponse {
   /**
-   * CurrentVersion
+   * Results
    *
-   * The current version of the package being reconciled, which may be
-   * in one of these states:
-   *  - has been successfully installed/upgraded or
-   *  - is currently being installed/upgraded or
-   *  - has failed to install/upgrade
+   * The detail, or the error, of each requested installed package, in the
+   * order of the request. A failed reference does not abort the others.
    */
-  currentVersion?: PackageAppVersion;
+  results: GetInstalledPackageDetailResult[];
+}
+
+/**
+ * GetInstalledPackageValuesResponse
+ *
+ * Response for GetInstalledPackageValues
+ */
+export interface GetInstalledPackageValuesResponse {
   /**
-   * Installed package icon URL
+   * Values
    *
-   * A url for an icon.
+   * The YAML values supplied by the user which are currently in effect for
+   * the installed package, without the default values of the package, to be
+   * edited before an UpdateInstalledPackage. Empty when none were supplied.
    */
-  iconUrl: string;
+  values: string;
+}
+
+/**
+ * CreateInstalledPackageResponse
+ *
+ * Response for CreateInstalledPackage
+ */
+export interface CreateInstalledPackageResponse {
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * UpdateInstalledPackageResponse
+ *
+ * Response for UpdateInstalledPackage
+ */
+export interface UpdateInstalledPackageResponse {
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * GetInstalledPackageUpgradeDiffResponse
+ *
+ * Response for GetInstalledPackageUpgradeDiff
+ */
+export interface GetInstalledPackageUpgradeDiffResponse {
   /**
-   * PackageDisplayName
+   * Diff
    *
-   * The package name as displayed to users (provided by the package, eg. "PostgreSQL")
+   * The unified diff between the rendered manifests of the installed package
+   * and the ones of the target version. Empty when they are the same.
    */
-  pkgDisplayName: string;
+  diff: string;
+}
+
+/**
+ * GetInstalledPackageDriftResponse
+ *
+ * Response for GetInstalledPackageDrift
+ */
+export interface GetInstalledPackageDriftResponse {
   /**
-   * ShortDescription
+   * Drifted
    *
-   * A short description of the package (provided by the package)
+   * Whether any resource of the installed package differs from the one
+   * currently in the cluster.
    */
-  shortDescription: string;
+  drifted: boolean;
   /**
-   * LatestMatchingVersion
+   * Resources
    *
-   * Only non-empty if an available upgrade matches the specified pkg_version_reference.
-   * For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
-   * is installed, then:
-   *   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
-   *   * if 10.4 is available while >10.3.1 is not, this should remain empty.
+   * The resources of the installed package which differ from the ones
+   * currently in the cluster, or are missing from it.
    */
-  latestMatchingVersion?: PackageAppVersion;
+  resources: ResourceDrift[];
+}
+
+/**
+ * GetInstalledPackageUpdateAvailableResponse
+ *
+ * Response for GetInstalledPackageUpdateAvailable
+ */
+export interface GetInstalledPackageUpdateAvailableResponse {
   /**
-   * LatestVersion
+   * Update available
    *
-   * The latest version available for this package, regardless of the pkg_version_reference.
+   * Whether a version newer than the installed one is available.
    */
-  latestVersion?: PackageAppVersion;
+  updateAvailable: boolean;
   /**
-   * Status
+   * Latest version
    *
-   * The current status of the installed package.
+   * The latest available version of the package, regardless of whether it
+   * is newer than the installed one.
    */
-  status?: InstalledPackageStatus;
+  latestVersion?: PackageAppVersion;
 }
 
 /**
- * InstalledPackageDetail
+ * ExportInstalledPackageResponse
  *
- * An InstalledPackageDetail includes details about the installed package that are
- * typically useful when presenting a single installed package.
+ * Response for ExportInstalledPackage
  */
-export interface InstalledPackageDetail {
+export interface ExportInstalledPackageResponse {
   /**
-   * InstalledPackageReference
+   * Manifest
    *
-   * A reference uniquely identifying the installed package.
+   * The YAML document declaring the installed package, such as the custom
+   * resource reconciled by a GitOps controller, without the state managed by
+   * the cluster, suitable for committing to a git repository.
    */
-  installedPackageRef?: InstalledPackageReference;
+  manifest: string;
+}
+
+/**
+ * DeleteInstalledPackageResponse
+ *
+ * Response for DeleteInstalledPackage
+ */
+export interface DeleteInstalledPackageResponse {}
+
+/**
+ * AvailablePackageSummary
+ *
+ * An AvailablePackageSummary provides a summary of a package available for installation
+ * useful when aggregating many available packages.
+ */
+export interface AvailablePackageSummary {
   /**
-   * PkgVersionReference
+   * Available package reference
    *
-   * The package version reference defines a version or constraint limiting
-   * matching package versions.
+   * A reference uniquely identifying the package.
    */
-  pkgVersionReference?: VersionReference;
+  availablePackageRef?: AvailablePackageReference;
   /**
-   * Installed package name
+   * Available package name
    *
-   * The name given to the installed package
+   * The name of the available package
    */
   name: string;
   /**
-   * CurrentVersion
+   * Latest available version
    *
-   * The version of the package which is currently installed.
+   * The latest version available for this package. Often expected when viewing
+   * a summary of many available packages.
    */
-  currentVersion?: PackageAppVersion;
+  latestVersion?: PackageAppVersion;
   /**
-   * ValuesApplied
+   * Available package Icon URL
    *
-   * The values applied currently for the installed package.
+   * A url for an icon.
    */
-  valuesApplied: string;
+  iconUrl: string;
   /**
-   * ReconciliationOptions
+   * Available package display name
    *
-   * An optional field specifying data common to systems that reconcile
-   * the package installation on the cluster asynchronously. In particular,
-   * this specifies the service account used to perform the reconcilliation.
+   * A name as displayed to users
    */
-  reconciliationOptions?: ReconciliationOptions;
+  displayName: string;
   /**
-   * Status
+   * Available package short description
    *
-   * The current status of the installed package.
+   * A short description of the app provided by the package
    */
-  status?: InstalledPackageStatus;
+  shortDescription: string;
   /**
-   * PostInstallationNotes
+   * Available package categories
    *
-   * Optional notes generated by package and intended for the user post installation.
+   * A user-facing list of category names useful for creating richer user interfaces.
+   * Plugins can choose not to implement this
    */
-  postInstallationNotes: string;
+  categories: string[];
   /**
-   * Available package reference
+   * Available package installed count
    *
-   * A reference to the available package for this installation.
-   * Useful to lookup the package display name, icon and other info.
+   * The number of times the package is installed, useful as a popularity metric.
+   * Only computed when requested with include_installed_count.
    */
-  availablePackageRef?: AvailablePackageReference;
+  installedCount: number;
   /**
-   * LatestMatchingVersion
+   * Available package repository
    *
-   * Only non-empty if an available upgrade matches the specified pkg_version_reference.
-   * For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
-   * is installed, then:
-   *   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
-   *   * if 10.4 is available while >10.3.1 is not, this should remain empty.
+   * A reference to the repository from which the package is available, useful
+   * for grouping packages by repository and linking to the source.
+   * Plugins can choose not to implement this
    */
-  latestMatchingVersion?: PackageAppVersion;
+  repository?: PackageRepositoryReference;
   /**
-   * LatestVersion
+   * Available package localized descriptions
    *
-   * The latest version available for this package, regardless of the pkg_version_reference.
+   * The descriptions of the package in other languages than the default one.
+   * Plugins can choose not to implement this
    */
-  latestVersion?: PackageAppVersion;
+  localizedDescriptions: LocalizedDescription[];
   /**
-   * Custom data added by the plugin
+   * Available package deprecated
    *
-   * A plugin can define custom details for data which is not yet, or never will
-   * be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
-   * of an `Any` field means that each plugin can define the structure of this
-   * message as required, while still satisfying the core interface.
-   * See https://developers.google.com/protocol-buffers/docs/proto3#any
+   * Whether the whole package, rather than some of its versions, is deprecated
+   * upstream.
+   * Plugins can choose not to implement this
    */
-  customDetail?: Any;
+  deprecated: boolean;
+  /**
+   * Available package deprecation message
+   *
+   * The reason for the deprecation of the package or its replacement, if any.
+   */
+  deprecationMessage: string;
 }
 
 /**
- * Context
+ * DeleteInstalledPackagesBatchResponse
  *
- * A Context specifies the context of the message
+ * Response for DeleteInstalledPackagesBatch
  */
-export interface Context {
-  /**
-   * Cluster
-   *
-   * A cluster name can be provided to target a specific cluster if multiple
-   * clusters are configured, otherwise all clusters will be assumed.
-   */
-  cluster: string;
+export interface DeleteInstalledPackagesBatchResponse {
   /**
-   * Namespace
+   * Results
    *
-   * A namespace must be provided if the context of the operation is for a resource
-   * or resources in a particular namespace.
-   * For requests to list items, not including a namespace here implies that the context
-   * for the request is everything the requesting user can read, though the result can
-   * be filtered by any filtering options of the request. Plugins may choose to return
-   * Unimplemented for some queries for which we do not yet have a need.
+   * The result of the deletion of each requested installed package, in the
+   * order of the request. A failed deletion does not abort the others.
    */
-  namespace: string;
+  results: DeleteInstalledPackageResult[];
 }
 
 /**
- * AvailablePackageReference
+ * GetCatalogStatsResponse
  *
- * An AvailablePackageReference has the minimum information required to uniquely
- * identify an available package. This is re-used on the summary and details of an
- * available package.
+ * Response for GetCatalogStats
  */
-export interface AvailablePackageReference {
-  /**
-   * Available package context
-   *
-   * The context (cluster/namespace) for the package.
-   */
-  context?: Context;
-  /**
-   * Available package identifier
-   *
-   * The fully qualified identifier for the available package
-   * (ie. a unique name for the context). For some packaging systems
-   * (particularly those where an available package is backed by a CR) this
-   * will just be the name, but for others such as those where an available
-   * package is not backed by a CR (eg. standard helm) it may be necessary
-   * to include the repository in the name or even the repo namespace
-   * to ensure this is unique.
-   * For example two helm repositories can define
-   * an "apache" chart that is available globally, the names would need to
-   * encode that to be unique (ie. "repoA:apache" and "repoB:apache").
-   */
-  identifier: string;
+export interface GetCatalogStatsResponse {
   /**
-   * Plugin for the available package
+   * Catalog stats
    *
-   * The plugin used to interact with this available package.
-   * This field should be omitted when the request is in the context of a specific plugin.
+   * The statistics of the available and installed packages of all the plugins
    */
-  plugin?: Plugin;
+  catalogStats?: CatalogStats;
 }
 
 /**
- * Maintainer
+ * GetRecentActivityResponse
  *
- * Maintainers for the package.
+ * Response for GetRecentActivity
  */
-export interface Maintainer {
+export interface GetRecentActivityResponse {
   /**
-   * Maintainer name
+   * Activities
    *
-   * A maintainer name
+   * The operations on the installed packages of the plugins tracking their
+   * history, most recent first.
    */
-  name: string;
+  activities: PackageActivity[];
   /**
-   * Maintainer email
+   * Next page token
    *
-   * A maintainer email
+   * This field represents the pagination token to retrieve the next page of
+   * results. If the value is "", it means no further results for the request.
    */
-  email: string;
+  nextPageToken: string;
 }
 
 /**
- * FilterOptions
+ * GetUserPermissionsResponse
  *
- * FilterOptions available when requesting summaries
+ * Response for GetUserPermissions
  */
-export interface FilterOptions {
+export interface GetUserPermissionsResponse {
   /**
-   * Text query
+   * Can install
    *
-   * Text query for the request
+   * Whether the user can create installed packages of the plugin in the
+   * requested context
    */
-  query: string;
+  canInstall: boolean;
   /**
-   * Categories
+   * Can update
    *
-   * Collection of categories for the request
+   * Whether the user can update the installed packages of the plugin in the
+   * requested context
    */
-  categories: string[];
+  canUpdate: boolean;
   /**
-   * Repositories
+   * Can delete
    *
-   * Collection of repositories where the packages belong to
+   * Whether the user can delete the installed packages of the plugin in the
+   * requested context
    */
-  repositories: string[];
+  canDelete: boolean;
+}
+
+/**
+ * GetCatalogIndexResponse
+ *
+ * Response for GetCatalogIndex
+ */
+export interface GetCatalogIndexResponse {
   /**
-   * Package version
+   * Index
    *
-   * Package version for the request
+   * The gzip-compressed JSON encoding of the CatalogIndex of the available
+   * packages of all the plugins, for the clients filtering the whole catalog
+   * themselves.
    */
-  pkgVersion: string;
+  index: Uint8Array;
   /**
-   * App version
+   * Package count
    *
-   * Packaged app version for the request
+   * The number of available packages in the index
    */
-  appVersion: string;
+  packageCount: number;
 }
 
 /**
- * PaginationOptions
+ * AvailablePackageDetail
  *
- * The PaginationOptions based on the example proto at:
- * https://cloud.google.com/apis/design/design_patterns#list_pagination
- * just encapsulated in a message so it can be reused on different request messages.
+ * An AvailablePackageDetail provides additional details required when
+ * inspecting an individual package.
  */
-export interface PaginationOptions {
+export interface AvailablePackageDetail {
   /**
-   * Page token
+   * Available package reference
    *
-   * The client uses this field to request a specific page of the list results.
+   * A reference uniquely identifying the package.
    */
-  pageToken: string;
+  availablePackageRef?: AvailablePackageReference;
   /**
-   * Page size
+   * Available package name
    *
-   * Clients use this field to specify the maximum number of results to be
-   * returned by the server. The server may further constrain the maximum number
-   * of results returned in a single page. If the page_size is 0, the server
-   * will decide the number of results to be returned.
+   * The name of the available package
    */
-  pageSize: number;
-}
-
-/**
- * InstalledPackageReference
- *
- * An InstalledPackageReference has the minimum information required to uniquely
- * identify an installed package.
- */
-export interface InstalledPackageReference {
+  name: string;
   /**
-   * Installed package context
+   * Available version
    *
-   * The context (cluster/namespace) for the package.
+   * The version of the package and application.
    */
-  context?: Context;
+  version?: PackageAppVersion;
+  /** the url of the package repository that contains this package */
+  repoUrl: string;
+  /** the url of the “home” for the package */
+  homeUrl: string;
   /**
-   * The fully qualified identifier for the installed package
-   * (ie. a unique name for the context).
+   * Available package icon URL
+   *
+   * A url for an icon.
    */
-  identifier: string;
+  iconUrl: string;
   /**
-   * The plugin used to identify and interact with the installed package.
-   * This field can be omitted when the request is in the context of a specific plugin.
+   * Available package display name
+   *
+   * A name as displayed to users
    */
-  plugin?: Plugin;
-}
-
-/**
- * VersionReference
- *
- * A VersionReference defines a version or constraint limiting matching versions.
- * The reason it is a separate message is so that in the future we can add other
- * fields as necessary (such as something similar to Carvel's `prereleases` option
- * to its versionSelection).
- */
-export interface VersionReference {
+  displayName: string;
   /**
-   * Version
+   * Available package short description
    *
-   * The format of the version constraint depends on the backend. For example,
-   * for a flux v2 and Carvel it’s a semver expression, such as ">=10.3 < 10.4"
+   * A short description of the app provided by the package
    */
-  version: string;
-}
-
-/**
- * InstalledPackageStatus
- *
- * An InstalledPackageStatus reports on the current status of the installation.
- */
-export interface InstalledPackageStatus {
+  shortDescription: string;
   /**
-   * Ready
+   * Available package long description
    *
-   * An indication of whether the installation is ready or not
+   * A longer description of the package, a few sentences.
    */
-  ready: boolean;
+  longDescription: string;
   /**
-   * Reason
+   * Available package readme
    *
-   * An enum indicating the reason for the current status.
+   * A longer README with potentially pages of formatted Markdown.
    */
-  reason: InstalledPackageStatus_StatusReason;
+  readme: string;
   /**
-   * UserReason
+   * Available package default values
    *
-   * Optional text to return for user context, which may be plugin specific.
+   * An example of default values used during package templating that can serve
+   * as documentation or a starting point for user customization.
    */
-  userReason: string;
-}
-
-/**
- * StatusReason
- *
- * Generic reasons why an installed package may be ready or not.
- * These should make sense across different packaging plugins.
- */
-export enum InstalledPackageStatus_StatusReason {
-  STATUS_REASON_UNSPECIFIED = 0,
-  STATUS_REASON_INSTALLED = 1,
-  STATUS_REASON_UNINSTALLED = 2,
-  STATUS_REASON_FAILED = 3,
-  STATUS_REASON_PENDING = 4,
-  UNRECOGNIZED = -1,
-}
-
-export function installedPackageStatus_StatusReasonFromJSON(
-  object: any,
-): InstalledPackageStatus_StatusReason {
-  switch (object) {
-    case 0:
-    case "STATUS_REASON_UNSPECIFIED":
-      return InstalledPackageStatus_StatusReason.STATUS_REASON_UNSPECIFIED;
-    case 1:
-    case "STATUS_REASON_INSTALLED":
-      return InstalledPackageStatus_StatusReason.STATUS_REASON_INSTALLED;
-    case 2:
-    case "STATUS_REASON_UNINSTALLED":
-      return InstalledPackageStatus_StatusReason.STATUS_REASON_UNINSTALLED;
-    case 3:
-    case "STATUS_REASON_FAILED":
-      return InstalledPackageStatus_StatusReason.STATUS_REASON_FAILED;
-    case 4:
-    case "STATUS_REASON_PENDING":
-      return InstalledPackageStatus_StatusReason.STATUS_REASON_PENDING;
-    case -1:
-    case "UNRECOGNIZED":
-    default:
-      return InstalledPackageStatus_StatusReason.UNRECOGNIZED;
-  }
-}
-
-export function installedPackageStatus_StatusReasonToJSON(
-  object: InstalledPackageStatus_StatusReason,
-): string {
-  switch (object) {
-    case InstalledPackageStatus_StatusReason.STATUS_REASON_UNSPECIFIED:
-      return "STATUS_REASON_UNSPECIFIED";
-    case InstalledPackageStatus_StatusReason.STATUS_REASON_INSTALLED:
-      return "STATUS_REASON_INSTALLED";
-    case InstalledPackageStatus_StatusReason.STATUS_REASON_UNINSTALLED:
-      return "STATUS_REASON_UNINSTALLED";
-    case InstalledPackageStatus_StatusReason.STATUS_REASON_FAILED:
-      return "STATUS_REASON_FAILED";
-    case InstalledPackageStatus_StatusReason.STATUS_REASON_PENDING:
-      return "STATUS_REASON_PENDING";
-    default:
-      return "UNKNOWN";
-  }
-}
-
-/**
- * ReconciliationOptions
- *
- * ReconciliationOptions enable specifying standard fields for backends that continuously
- * reconcile a package install as new matching versions are released. Most of the naming
- * is from the flux HelmReleaseSpec though it maps directly to equivalent fields on Carvel's
- * InstalledPackage.
- */
-export interface ReconciliationOptions {
+  defaultValues: string;
+  valuesSchema: string;
+  /** source urls for the package */
+  sourceUrls: string[];
   /**
-   * Reconciliation Interval
+   * Available package maintainers
    *
-   * The interval with which the package is checked for reconciliation (in seconds)
+   * List of Maintainer
    */
-  interval: number;
+  maintainers: Maintainer[];
   /**
-   * Suspend
+   * Available package categories
    *
-   * Whether reconciliation should be suspended until otherwise enabled.
+   * A user-facing list of category names useful for creating richer user interfaces.
+   * Plugins can choose not to implement this
    */
-  suspend: boolean;
+  categories: string[];
   /**
-   * ServiceAccountName
+   * Custom data added by the plugin
    *
-   * A name for a service account in the same namespace which should be used
-   * to perform the reconciliation.
+   * A plugin can define custom details for data which is not yet, or never will
+   * be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
+   * of an `Any` field means that each plugin can define the structure of this
+   * message as required, while still satisfying the core interface.
+   * See https://developers.google.com/protocol-buffers/docs/proto3#any
    */
-  serviceAccountName: string;
+  customDetail?: Any;
+  /**
+   * Available package provenance
+   *
+   * Provenance information (such as a signature) of the package, populated
+   * by plugins which support verifying it. Empty otherwise.
+   */
+  provenance?: PackageProvenance;
+  /**
+   * Available package localized descriptions
+   *
+   * The descriptions of the package in other languages than the default one.
+   * Plugins can choose not to implement this
+   */
+  localizedDescriptions: LocalizedDescription[];
+  /**
+   * Available package deprecated
+   *
+   * Whether the whole package, rather than some of its versions, is deprecated
+   * upstream.
+   * Plugins can choose not to implement this
+   */
+  deprecated: boolean;
+  /**
+   * Available package deprecation message
+   *
+   * The reason for the deprecation of the package or its replacement, if any.
+   */
+  deprecationMessage: string;
+  /**
+   * Available package Kubernetes version
+   *
+   * The semver constraint of the Kubernetes versions supported by the package
+   * (eg. ">=1.19.0-0"), empty when the package does not restrict them.
+   * Plugins can choose not to implement this
+   */
+  kubeVersion: string;
 }
 
 /**
- * Package AppVersion
+ * InstalledPackageSummary
  *
- * PackageAppVersion conveys both the package version and the packaged app version.
+ * An InstalledPackageSummary provides a summary of an installed package
+ * useful when aggregating many installed packages.
  */
-export interface PackageAppVersion {
+export interface InstalledPackageSummary {
   /**
-   * Package version
+   * InstalledPackageReference
    *
-   * Version of the package itself
+   * A reference uniquely identifying the package.
    */
-  pkgVersion: string;
+  installedPackageRef?: InstalledPackageReference;
   /**
-   * Application version
+   * Name
    *
-   * Version of the packaged application
+   * A name given to the installation of the package (eg. "my-postgresql-for-testing").
    */
-  appVersion: string;
-}
-
-const baseGetAvailablePackageSummariesRequest: object = {};
-
-export const GetAvailablePackageSummariesRequest = {
-  encode(
-    message: GetAvailablePackageSummariesRequest,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.context !== undefined) {
-      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.filterOptions !== undefined) {
-      FilterOptions.encode(message.filterOptions, writer.uint32(18).fork()).ldelim();
-    }
-    if (message.paginationOptions !== undefined) {
-      PaginationOptions.encode(message.paginationOptions, writer.uint32(26).fork()).ldelim();
-    }
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageSummariesRequest {
-    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseGetAvailablePackageSummariesRequest,
-    } as GetAvailablePackageSummariesRequest;
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          message.context = Context.decode(reader, reader.uint32());
-          break;
-        case 2:
-          message.filterOptions = FilterOptions.decode(reader, reader.uint32());
-          break;
+  name: string;
+  /**
+   * PkgVersionReference
+   *
+   * The package version reference defines a version or constraint limiting
+   * matching package versions.
+   */
+  pkgVersionReference?: VersionReference;
+  /**
+   * CurrentVersion
+   *
+   * The current version of the package being reconciled, which may be
+   * in one of these states:
+   *  - has been successfully installed/upgraded or
+   *  - is currently being installed/upgraded or
+   *  - has failed to install/upgrade
+   */
+  currentVersion?: PackageAppVersion;
+  /**
+   * Installed package icon URL
+   *
+   * A url for an icon.
+   */
+  iconUrl: string;
+  /**
+   * PackageDisplayName
+   *
+   * The package name as displayed to users (provided by the package, eg. "PostgreSQL")
+   */
+  pkgDisplayName: string;
+  /**
+   * ShortDescription
+   *
+   * A short description of the package (provided by the package)
+   */
+  shortDescription: string;
+  /**
+   * LatestMatchingVersion
+   *
+   * Only non-empty if an available upgrade matches the specified pkg_version_reference.
+   * For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
+   * is installed, then:
+   *   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
+   *   * if 10.4 is available while >10.3.1 is not, this should remain empty.
+   */
+  latestMatchingVersion?: PackageAppVersion;
+  /**
+   * LatestVersion
+   *
+   * The latest version available for this package, regardless of the pkg_version_reference.
+   */
+  latestVersion?: PackageAppVersion;
+  /**
+   * Status
+   *
+   * The current status of the installed package.
+   */
+  status?: InstalledPackageStatus;
+  /**
+   * Conflicting plugins
+   *
+   * The other plugins which also reported this installed package (same cluster,
+   * namespace and identifier), when the server is configured to merge them. Only
+   * the summary of the first configured plugin is returned, the others being
+   * listed here so clients can flag the conflict.
+   */
+  conflictingPlugins: Plugin[];
+  /**
+   * Available package reference
+   *
+   * A reference to the available package from which the package was installed,
+   * when the plugin can determine it.
+   */
+  availablePackageRef?: AvailablePackageReference;
+}
+
+/**
+ * InstalledPackageDetail
+ *
+ * An InstalledPackageDetail includes details about the installed package that are
+ * typically useful when presenting a single installed package.
+ */
+export interface InstalledPackageDetail {
+  /**
+   * InstalledPackageReference
+   *
+   * A reference uniquely identifying the installed package.
+   */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * PkgVersionReference
+   *
+   * The package version reference defines a version or constraint limiting
+   * matching package versions.
+   */
+  pkgVersionReference?: VersionReference;
+  /**
+   * Installed package name
+   *
+   * The name given to the installed package
+   */
+  name: string;
+  /**
+   * CurrentVersion
+   *
+   * The version of the package which is currently installed.
+   */
+  currentVersion?: PackageAppVersion;
+  /**
+   * ValuesApplied
+   *
+   * The values applied currently for the installed package.
+   */
+  valuesApplied: string;
+  /**
+   * ReconciliationOptions
+   *
+   * An optional field specifying data common to systems that reconcile
+   * the package installation on the cluster asynchronously. In particular,
+   * this specifies the service account used to perform the reconcilliation.
+   */
+  reconciliationOptions?: ReconciliationOptions;
+  /**
+   * Status
+   *
+   * The current status of the installed package.
+   */
+  status?: InstalledPackageStatus;
+  /**
+   * PostInstallationNotes
+   *
+   * Optional notes generated by package and intended for the user post installation.
+   */
+  postInstallationNotes: string;
+  /**
+   * Available package reference
+   *
+   * A reference to the available package for this installation.
+   * Useful to lookup the package display name, icon and other info.
+   * Only set when the plugin can determine the available package from which
+   * the package was installed, left empty otherwise.
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * LatestMatchingVersion
+   *
+   * Only non-empty if an available upgrade matches the specified pkg_version_reference.
+   * For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
+   * is installed, then:
+   *   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
+   *   * if 10.4 is available while >10.3.1 is not, this should remain empty.
+   */
+  latestMatchingVersion?: PackageAppVersion;
+  /**
+   * LatestVersion
+   *
+   * The latest version available for this package, regardless of the pkg_version_reference.
+   */
+  latestVersion?: PackageAppVersion;
+  /**
+   * Custom data added by the plugin
+   *
+   * A plugin can define custom details for data which is not yet, or never will
+   * be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
+   * of an `Any` field means that each plugin can define the structure of this
+   * message as required, while still satisfying the core interface.
+   * See https://developers.google.com/protocol-buffers/docs/proto3#any
+   */
+  customDetail?: Any;
+  /**
+   * WorkloadStatus
+   *
+   * A rollup of the status of the workloads of the installed package, only
+   * set when requested with include_workload_status by plugins supporting it.
+   */
+  workloadStatus?: WorkloadStatus;
+  /**
+   * Available package repository
+   *
+   * A reference to the repository of the available package from which the
+   * package was installed, to link back to its catalog entry. Only set, as
+   * the available_package_ref, when the plugin can determine it.
+   */
+  availablePackageRepository?: PackageRepositoryReference;
+}
+
+/**
+ * Context
+ *
+ * A Context specifies the context of the message
+ */
+export interface Context {
+  /**
+   * Cluster
+   *
+   * A cluster name can be provided to target a specific cluster if multiple
+   * clusters are configured, otherwise all clusters will be assumed.
+   */
+  cluster: string;
+  /**
+   * Namespace
+   *
+   * A namespace must be provided if the context of the operation is for a resource
+   * or resources in a particular namespace.
+   * For requests to list items, not including a namespace here implies that the context
+   * for the request is everything the requesting user can read, though the result can
+   * be filtered by any filtering options of the request. Plugins may choose to return
+   * Unimplemented for some queries for which we do not yet have a need.
+   */
+  namespace: string;
+}
+
+/**
+ * AvailablePackageReference
+ *
+ * An AvailablePackageReference has the minimum information required to uniquely
+ * identify an available package. This is re-used on the summary and details of an
+ * available package.
+ */
+export interface AvailablePackageReference {
+  /**
+   * Available package context
+   *
+   * The context (cluster/namespace) for the package.
+   */
+  context?: Context;
+  /**
+   * Available package identifier
+   *
+   * The fully qualified identifier for the available package
+   * (ie. a unique name for the context). For some packaging systems
+   * (particularly those where an available package is backed by a CR) this
+   * will just be the name, but for others such as those where an available
+   * package is not backed by a CR (eg. standard helm) it may be necessary
+   * to include the repository in the name or even the repo namespace
+   * to ensure this is unique.
+   * For example two helm repositories can define
+   * an "apache" chart that is available globally, the names would need to
+   * encode that to be unique (ie. "repoA:apache" and "repoB:apache").
+   */
+  identifier: string;
+  /**
+   * Plugin for the available package
+   *
+   * The plugin used to interact with this available package.
+   * This field should be omitted when the request is in the context of a specific plugin.
+   */
+  plugin?: Plugin;
+  /**
+   * Available package repository
+   *
+   * The repository from which the package is requested, set by the core
+   * server from a repository-qualified identifier, for the plugins in which
+   * a package identifier can be published by several repositories.
+   */
+  repository?: PackageRepositoryReference;
+}
+
+/**
+ * Maintainer
+ *
+ * Maintainers for the package.
+ */
+export interface Maintainer {
+  /**
+   * Maintainer name
+   *
+   * A maintainer name
+   */
+  name: string;
+  /**
+   * Maintainer email
+   *
+   * A maintainer email
+   */
+  email: string;
+}
+
+/**
+ * FilterOptions
+ *
+ * FilterOptions available when requesting summaries
+ */
+export interface FilterOptions {
+  /**
+   * Text query
+   *
+   * Text query for the request. The matching packages are ordered by
+   * relevance: first the ones named as the query, then the ones whose name
+   * starts with it or contains it, and then the ones whose description
+   * contains it.
+   */
+  query: string;
+  /**
+   * Categories
+   *
+   * Collection of categories for the request
+   */
+  categories: string[];
+  /**
+   * Repositories
+   *
+   * Collection of repositories where the packages belong to
+   */
+  repositories: string[];
+  /**
+   * Package version
+   *
+   * Package version for the request
+   */
+  pkgVersion: string;
+  /**
+   * App version
+   *
+   * Packaged app version for the request
+   */
+  appVersion: string;
+  /**
+   * Exclude deprecated
+   *
+   * Exclude the packages deprecated as a whole from the results
+   */
+  excludeDeprecated: boolean;
+  /**
+   * Maintainer
+   *
+   * Only include the packages with a maintainer whose name or email
+   * contains it, case-insensitively (eg. the domain of a vendor)
+   */
+  maintainer: string;
+}
+
+/**
+ * PaginationOptions
+ *
+ * The PaginationOptions based on the example proto at:
+ * https://cloud.google.com/apis/design/design_patterns#list_pagination
+ * just encapsulated in a message so it can be reused on different request messages.
+ */
+export interface PaginationOptions {
+  /**
+   * Page token
+   *
+   * The client uses this field to request a specific page of the list results.
+   */
+  pageToken: string;
+  /**
+   * Page size
+   *
+   * Clients use this field to specify the maximum number of results to be
+   * returned by the server. The server may further constrain the maximum number
+   * of results returned in a single page. If the page_size is 0, the server
+   * will decide the number of results to be returned.
+   */
+  pageSize: number;
+}
+
+/**
+ * InstalledPackageReference
+ *
+ * An InstalledPackageReference has the minimum information required to uniquely
+ * identify an installed package.
+ */
+export interface InstalledPackageReference {
+  /**
+   * Installed package context
+   *
+   * The context (cluster/namespace) for the package.
+   */
+  context?: Context;
+  /**
+   * The fully qualified identifier for the installed package
+   * (ie. a unique name for the context).
+   */
+  identifier: string;
+  /**
+   * The plugin used to identify and interact with the installed package.
+   * This field can be omitted when the request is in the context of a specific plugin.
+   */
+  plugin?: Plugin;
+}
+
+/**
+ * VersionReference
+ *
+ * A VersionReference defines a version or constraint limiting matching versions.
+ * The reason it is a separate message is so that in the future we can add other
+ * fields as necessary (such as something similar to Carvel's `prereleases` option
+ * to its versionSelection).
+ */
+export interface VersionReference {
+  /**
+   * Version
+   *
+   * The format of the version constraint depends on the backend. For example,
+   * for a flux v2 and Carvel it’s a semver expression, such as ">=10.3 < 10.4"
+   */
+  version: string;
+}
+
+/**
+ * InstalledPackageStatus
+ *
+ * An InstalledPackageStatus reports on the current status of the installation.
+ */
+export interface InstalledPackageStatus {
+  /**
+   * Ready
+   *
+   * An indication of whether the installation is ready or not
+   */
+  ready: boolean;
+  /**
+   * Reason
+   *
+   * An enum indicating the reason for the current status.
+   */
+  reason: InstalledPackageStatus_StatusReason;
+  /**
+   * UserReason
+   *
+   * Optional text to return for user context, which may be plugin specific.
+   */
+  userReason: string;
+  /**
+   * Conditions
+   *
+   * The conditions of the underlying resource of the installed package, for
+   * troubleshooting a failed or pending installation. Only returned with the
+   * installed package detail, by the plugins whose resources have conditions.
+   */
+  conditions: InstalledPackageCondition[];
+}
+
+/**
+ * InstalledPackageCondition
+ *
+ * A condition of the underlying resource of an installed package, such as
+ * the Ready condition of a Flux HelmRelease.
+ */
+export interface InstalledPackageCondition {
+  /**
+   * Type
+   *
+   * The type of the condition (eg. "Ready")
+   */
+  type: string;
+  /**
+   * Status
+   *
+   * The status of the condition, one of "True", "False" or "Unknown"
+   */
+  status: string;
+  /**
+   * Reason
+   *
+   * The machine-readable reason of the last transition of the condition
+   * (eg. "InstallFailed")
+   */
+  reason: string;
+  /**
+   * Message
+   *
+   * The human-readable details of the last transition of the condition
+   */
+  message: string;
+  /**
+   * Last transition time
+   *
+   * The RFC 3339 timestamp (eg. "2021-09-01T10:00:00Z") of the last
+   * transition of the condition
+   */
+  lastTransitionTime: string;
+}
+
+/**
+ * WorkloadStatus
+ *
+ * A WorkloadStatus rolls up the status of the workloads (deployments,
+ * stateful sets and daemon sets) of an installed package, so that users can
+ * check that its pods are actually running, not only that it was installed.
+ */
+export interface WorkloadStatus {
+  /**
+   * Workloads
+   *
+   * The number of workloads of the installed package.
+   */
+  workloads: number;
+  /**
+   * Ready workloads
+   *
+   * The number of workloads with all their desired replicas ready.
+   */
+  readyWorkloads: number;
+  /**
+   * Desired replicas
+   *
+   * The total number of replicas desired by the workloads.
+   */
+  desiredReplicas: number;
+  /**
+   * Ready replicas
+   *
+   * The total number of ready replicas of the workloads.
+   */
+  readyReplicas: number;
+  /**
+   * Progress percentage
+   *
+   * An estimate, from 0 to 100, of the progress of the installed package
+   * towards being ready, derived by the core from the ready replicas, or the
+   * ready workloads for workloads without desired replicas. It is zero when
+   * the installed package has no workloads from which to derive it.
+   */
+  progressPercentage: number;
+}
+
+/**
+ * StatusReason
+ *
+ * Generic reasons why an installed package may be ready or not.
+ * These should make sense across different packaging plugins.
+ */
+export enum InstalledPackageStatus_StatusReason {
+  STATUS_REASON_UNSPECIFIED = 0,
+  STATUS_REASON_INSTALLED = 1,
+  STATUS_REASON_UNINSTALLED = 2,
+  STATUS_REASON_FAILED = 3,
+  STATUS_REASON_PENDING = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function installedPackageStatus_StatusReasonFromJSON(
+  object: any,
+): InstalledPackageStatus_StatusReason {
+  switch (object) {
+    case 0:
+    case "STATUS_REASON_UNSPECIFIED":
+      return InstalledPackageStatus_StatusReason.STATUS_REASON_UNSPECIFIED;
+    case 1:
+    case "STATUS_REASON_INSTALLED":
+      return InstalledPackageStatus_StatusReason.STATUS_REASON_INSTALLED;
+    case 2:
+    case "STATUS_REASON_UNINSTALLED":
+      return InstalledPackageStatus_StatusReason.STATUS_REASON_UNINSTALLED;
+    case 3:
+    case "STATUS_REASON_FAILED":
+      return InstalledPackageStatus_StatusReason.STATUS_REASON_FAILED;
+    case 4:
+    case "STATUS_REASON_PENDING":
+      return InstalledPackageStatus_StatusReason.STATUS_REASON_PENDING;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return InstalledPackageStatus_StatusReason.UNRECOGNIZED;
+  }
+}
+
+export function installedPackageStatus_StatusReasonToJSON(
+  object: InstalledPackageStatus_StatusReason,
+): string {
+  switch (object) {
+    case InstalledPackageStatus_StatusReason.STATUS_REASON_UNSPECIFIED:
+      return "STATUS_REASON_UNSPECIFIED";
+    case InstalledPackageStatus_StatusReason.STATUS_REASON_INSTALLED:
+      return "STATUS_REASON_INSTALLED";
+    case InstalledPackageStatus_StatusReason.STATUS_REASON_UNINSTALLED:
+      return "STATUS_REASON_UNINSTALLED";
+    case InstalledPackageStatus_StatusReason.STATUS_REASON_FAILED:
+      return "STATUS_REASON_FAILED";
+    case InstalledPackageStatus_StatusReason.STATUS_REASON_PENDING:
+      return "STATUS_REASON_PENDING";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+/**
+ * ReconciliationOptions
+ *
+ * ReconciliationOptions enable specifying standard fields for backends that continuously
+ * reconcile a package install as new matching versions are released. Most of the naming
+ * is from the flux HelmReleaseSpec though it maps directly to equivalent fields on Carvel's
+ * InstalledPackage.
+ */
+export interface ReconciliationOptions {
+  /**
+   * Reconciliation Interval
+   *
+   * The interval with which the package is checked for reconciliation (in seconds)
+   */
+  interval: number;
+  /**
+   * Suspend
+   *
+   * Whether reconciliation should be suspended until otherwise enabled.
+   */
+  suspend: boolean;
+  /**
+   * ServiceAccountName
+   *
+   * A name for a service account in the same namespace which should be used
+   * to perform the reconciliation.
+   */
+  serviceAccountName: string;
+}
+
+/**
+ * Package AppVersion
+ *
+ * PackageAppVersion conveys both the package version and the packaged app version.
+ */
+export interface PackageAppVersion {
+  /**
+   * Package version
+   *
+   * Version of the package itself
+   */
+  pkgVersion: string;
+  /**
+   * Application version
+   *
+   * Version of the packaged application
+   */
+  appVersion: string;
+  /**
+   * Relation
+   *
+   * Whether the package version is older than, the same as or newer than the
+   * installed version requested with the versions. Unspecified when no
+   * installed version was requested or when either version is not a valid
+   * semantic version.
+   */
+  relation: PackageAppVersion_VersionRelation;
+}
+
+/**
+ * VersionRelation
+ *
+ * The relation of a package version to the installed version.
+ */
+export enum PackageAppVersion_VersionRelation {
+  VERSION_RELATION_UNSPECIFIED = 0,
+  VERSION_RELATION_OLDER = 1,
+  VERSION_RELATION_CURRENT = 2,
+  VERSION_RELATION_NEWER = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function packageAppVersion_VersionRelationFromJSON(
+  object: any,
+): PackageAppVersion_VersionRelation {
+  switch (object) {
+    case 0:
+    case "VERSION_RELATION_UNSPECIFIED":
+      return PackageAppVersion_VersionRelation.VERSION_RELATION_UNSPECIFIED;
+    case 1:
+    case "VERSION_RELATION_OLDER":
+      return PackageAppVersion_VersionRelation.VERSION_RELATION_OLDER;
+    case 2:
+    case "VERSION_RELATION_CURRENT":
+      return PackageAppVersion_VersionRelation.VERSION_RELATION_CURRENT;
+    case 3:
+    case "VERSION_RELATION_NEWER":
+      return PackageAppVersion_VersionRelation.VERSION_RELATION_NEWER;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return PackageAppVersion_VersionRelation.UNRECOGNIZED;
+  }
+}
+
+export function packageAppVersion_VersionRelationToJSON(
+  object: PackageAppVersion_VersionRelation,
+): string {
+  switch (object) {
+    case PackageAppVersion_VersionRelation.VERSION_RELATION_UNSPECIFIED:
+      return "VERSION_RELATION_UNSPECIFIED";
+    case PackageAppVersion_VersionRelation.VERSION_RELATION_OLDER:
+      return "VERSION_RELATION_OLDER";
+    case PackageAppVersion_VersionRelation.VERSION_RELATION_CURRENT:
+      return "VERSION_RELATION_CURRENT";
+    case PackageAppVersion_VersionRelation.VERSION_RELATION_NEWER:
+      return "VERSION_RELATION_NEWER";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+/**
+ * Package Version Group
+ *
+ * PackageVersionGroup summarizes the versions of a package sharing the same
+ * major and minor versions.
+ */
+export interface PackageVersionGroup {
+  /**
+   * Minor version
+   *
+   * The major.minor version shared by the versions of the group (eg. "10.3")
+   */
+  minorVersion: string;
+  /**
+   * Latest version
+   *
+   * The version of the group with the newest patch
+   */
+  latest?: PackageAppVersion;
+  /**
+   * Version count
+   *
+   * The number of versions in the group
+   */
+  count: number;
+}
+
+/**
+ * Package Dependency
+ *
+ * PackageDependency conveys the information of a package required by another
+ * package, such as a subchart of a Helm chart.
+ */
+export interface PackageDependency {
+  /**
+   * Dependency name
+   *
+   * The name of the required package
+   */
+  name: string;
+  /**
+   * Dependency version
+   *
+   * The version (or version constraint) of the required package
+   */
+  version: string;
+  /**
+   * Dependency repository
+   *
+   * The repository from which the required package is fetched
+   */
+  repository: string;
+  /**
+   * Dependency condition
+   *
+   * The condition which enables or disables the required package, if any
+   */
+  condition: string;
+  /**
+   * Dependencies
+   *
+   * The transitive dependencies of the required package
+   */
+  dependencies: PackageDependency[];
+}
+
+/**
+ * Package Provenance
+ *
+ * PackageProvenance conveys whether a package is signed, by whom and
+ * whether the signature could be verified.
+ */
+export interface PackageProvenance {
+  /**
+   * Signed
+   *
+   * Whether the package is signed
+   */
+  signed: boolean;
+  /**
+   * Signer
+   *
+   * The identity of the signer of the package, if any
+   */
+  signer: string;
+  /**
+   * Verification status
+   *
+   * An enum indicating the result of verifying the package signature.
+   */
+  verificationStatus: PackageProvenance_VerificationStatus;
+}
+
+/**
+ * VerificationStatus
+ *
+ * Generic verification statuses of a package signature.
+ */
+export enum PackageProvenance_VerificationStatus {
+  VERIFICATION_STATUS_UNSPECIFIED = 0,
+  VERIFICATION_STATUS_VERIFIED = 1,
+  VERIFICATION_STATUS_FAILED = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function packageProvenance_VerificationStatusFromJSON(
+  object: any,
+): PackageProvenance_VerificationStatus {
+  switch (object) {
+    case 0:
+    case "VERIFICATION_STATUS_UNSPECIFIED":
+      return PackageProvenance_VerificationStatus.VERIFICATION_STATUS_UNSPECIFIED;
+    case 1:
+    case "VERIFICATION_STATUS_VERIFIED":
+      return PackageProvenance_VerificationStatus.VERIFICATION_STATUS_VERIFIED;
+    case 2:
+    case "VERIFICATION_STATUS_FAILED":
+      return PackageProvenance_VerificationStatus.VERIFICATION_STATUS_FAILED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return PackageProvenance_VerificationStatus.UNRECOGNIZED;
+  }
+}
+
+export function packageProvenance_VerificationStatusToJSON(
+  object: PackageProvenance_VerificationStatus,
+): string {
+  switch (object) {
+    case PackageProvenance_VerificationStatus.VERIFICATION_STATUS_UNSPECIFIED:
+      return "VERIFICATION_STATUS_UNSPECIFIED";
+    case PackageProvenance_VerificationStatus.VERIFICATION_STATUS_VERIFIED:
+      return "VERIFICATION_STATUS_VERIFIED";
+    case PackageProvenance_VerificationStatus.VERIFICATION_STATUS_FAILED:
+      return "VERIFICATION_STATUS_FAILED";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+/**
+ * Package Repository Reference
+ *
+ * A PackageRepositoryReference identifies the repository from which a
+ * package is available.
+ */
+export interface PackageRepositoryReference {
+  /**
+   * Repository name
+   *
+   * The name of the repository
+   */
+  name: string;
+  /**
+   * Repository namespace
+   *
+   * The namespace in which the repository is defined, if any
+   */
+  namespace: string;
+  /**
+   * Repository type
+   *
+   * The plugin-specific type of the repository (eg. "helm" or "oci")
+   */
+  type: string;
+  /**
+   * Repository URL
+   *
+   * The url of the repository, to link to the source of the package
+   */
+  url: string;
+}
+
+/**
+ * Catalog Stats
+ *
+ * CatalogStats provides an overview of the available and installed packages,
+ * aggregated across the plugins.
+ */
+export interface CatalogStats {
+  /**
+   * Available package count
+   *
+   * The total number of available packages
+   */
+  availablePackageCount: number;
+  /**
+   * Installed package count
+   *
+   * The total number of installed packages, including the packages managed
+   * outside Kubeapps
+   */
+  installedPackageCount: number;
+  /**
+   * Plugin stats
+   *
+   * The number of available and installed packages of each plugin
+   */
+  pluginStats: PluginCatalogStats[];
+  /**
+   * Category stats
+   *
+   * The number of available packages of each category, in the same order
+   * as the categories of the available package summaries
+   */
+  categoryStats: CategoryCatalogStats[];
+}
+
+/**
+ * Plugin Catalog Stats
+ *
+ * PluginCatalogStats provides the number of packages of a plugin.
+ */
+export interface PluginCatalogStats {
+  /**
+   * Plugin
+   *
+   * The plugin to which the counts refer
+   */
+  plugin?: Plugin;
+  /**
+   * Available package count
+   *
+   * The number of available packages of the plugin
+   */
+  availablePackageCount: number;
+  /**
+   * Installed package count
+   *
+   * The number of installed packages of the plugin
+   */
+  installedPackageCount: number;
+}
+
+/**
+ * Category Catalog Stats
+ *
+ * CategoryCatalogStats provides the number of available packages of a category.
+ */
+export interface CategoryCatalogStats {
+  /**
+   * Category
+   *
+   * The name of the category
+   */
+  category: string;
+  /**
+   * Available package count
+   *
+   * The number of available packages of the category
+   */
+  availablePackageCount: number;
+}
+
+/**
+ * Catalog Index
+ *
+ * CatalogIndex provides a minimal entry for each available package of the
+ * catalog, suitable for filtering the catalog on the client side.
+ */
+export interface CatalogIndex {
+  /**
+   * Entries
+   *
+   * The entries of the available packages, in the order of the available
+   * package summaries
+   */
+  entries: CatalogIndexEntry[];
+}
+
+/**
+ * Catalog Index Entry
+ *
+ * CatalogIndexEntry provides the searchable fields of an available package.
+ */
+export interface CatalogIndexEntry {
+  /**
+   * Identifier
+   *
+   * The identifier of the available package
+   */
+  identifier: string;
+  /**
+   * Plugin
+   *
+   * The name of the plugin managing the available package
+   */
+  plugin: string;
+  /**
+   * Name
+   *
+   * The name of the available package
+   */
+  name: string;
+  /**
+   * Display name
+   *
+   * The name of the available package used for display
+   */
+  displayName: string;
+  /**
+   * Package version
+   *
+   * The latest version of the available package
+   */
+  pkgVersion: string;
+  /**
+   * App version
+   *
+   * The version of the application packaged by the latest version
+   */
+  appVersion: string;
+  /**
+   * Categories
+   *
+   * The categories of the available package
+   */
+  categories: string[];
+  /**
+   * Repository
+   *
+   * The name of the repository publishing the available package, if any
+   */
+  repository: string;
+  /**
+   * Package versions
+   *
+   * All the versions of the available package, starting with the latest,
+   * excluding the pre-releases
+   */
+  pkgVersions: string[];
+}
+
+/**
+ * Localized Description
+ *
+ * LocalizedDescription provides the descriptions of a package in a given language.
+ */
+export interface LocalizedDescription {
+  /**
+   * Language
+   *
+   * The language tag of the descriptions (eg. "fr" or "pt-BR")
+   */
+  language: string;
+  /**
+   * Short description
+   *
+   * The short description of the package in the language
+   */
+  shortDescription: string;
+  /**
+   * Long description
+   *
+   * The long description of the package in the language, only used
+   * for the available package detail
+   */
+  longDescription: string;
+}
+
+/**
+ * Delete Installed Package Result
+ *
+ * DeleteInstalledPackageResult provides the result of the deletion of an
+ * installed package as part of a batch.
+ */
+export interface DeleteInstalledPackageResult {
+  /** The reference of the installed package to be deleted. */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * Status code
+   *
+   * The gRPC status code of the deletion (0 when deleted).
+   */
+  statusCode: number;
+  /**
+   * Error message
+   *
+   * The error message of a failed deletion, empty when deleted.
+   */
+  errorMessage: string;
+}
+
+/**
+ * Get Installed Package Detail Result
+ *
+ * GetInstalledPackageDetailResult provides the detail of an installed package,
+ * or the error getting it, as part of a batch.
+ */
+export interface GetInstalledPackageDetailResult {
+  /** The reference of the requested installed package. */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * Installed package detail
+   *
+   * The detail of the installed package, unset when it could not be got.
+   */
+  installedPackageDetail?: InstalledPackageDetail;
+  /**
+   * Status code
+   *
+   * The gRPC status code of getting the detail (0 when got).
+   */
+  statusCode: number;
+  /**
+   * Error message
+   *
+   * The error message when the detail could not be got, empty otherwise.
+   */
+  errorMessage: string;
+}
+
+/**
+ * Resource Drift
+ *
+ * ResourceDrift reports how a resource of an installed package differs from
+ * the one currently in the cluster.
+ */
+export interface ResourceDrift {
+  /** The API version of the resource (eg. apps/v1). */
+  apiVersion: string;
+  /** The kind of the resource (eg. Deployment). */
+  kind: string;
+  /** The name of the resource. */
+  name: string;
+  /** The namespace of the resource, empty for cluster-scoped resources. */
+  namespace: string;
+  /**
+   * Missing
+   *
+   * Whether the resource is missing from the cluster, in which case no
+   * fields are reported.
+   */
+  missing: boolean;
+  /**
+   * Fields
+   *
+   * The fields of the resource set by the installed package with a
+   * different value in the cluster.
+   */
+  fields: FieldDrift[];
+}
+
+/**
+ * Rendered Resource
+ *
+ * RenderedResource identifies a resource rendered from the templates of a
+ * package.
+ */
+export interface RenderedResource {
+  /** The API version of the resource (eg. apps/v1). */
+  apiVersion: string;
+  /** The kind of the resource (eg. Deployment). */
+  kind: string;
+  /** The name of the resource. */
+  name: string;
+  /** The namespace of the resource, empty for cluster-scoped resources. */
+  namespace: string;
+}
+
+/**
+ * Field Drift
+ *
+ * FieldDrift reports a field of a resource set by an installed package with
+ * a different value in the cluster.
+ */
+export interface FieldDrift {
+  /** The path of the field (eg. spec.replicas). */
+  path: string;
+  /** The value of the field set by the installed package, as JSON. */
+  expected: string;
+  /** The value of the field in the cluster, as JSON, empty when unset. */
+  actual: string;
+}
+
+/**
+ * PackageActivity
+ *
+ * An operation, such as an install or an update, on an installed package.
+ */
+export interface PackageActivity {
+  /**
+   * Installed package reference
+   *
+   * A reference uniquely identifying the installed package
+   */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * Name
+   *
+   * The name given to the installed package by the user
+   */
+  name: string;
+  /**
+   * Operation
+   *
+   * The operation done on the installed package
+   */
+  operation: PackageActivity_Operation;
+  /**
+   * Package version
+   *
+   * The version of the package installed by the operation
+   */
+  pkgVersionReference?: PackageAppVersion;
+  /**
+   * Time
+   *
+   * The RFC 3339 timestamp (eg. "2021-09-01T10:00:00Z") of the operation
+   */
+  time: string;
+  /**
+   * Description
+   *
+   * An optional description of the operation, which may be plugin specific
+   */
+  description: string;
+}
+
+/**
+ * PackageChangelogEntry
+ *
+ * The human-readable changes introduced by a version of a package, as
+ * shipped with the package (eg. the chart annotations or CHANGELOG file).
+ */
+export interface PackageChangelogEntry {
+  /**
+   * Package version
+   *
+   * The version of the package introducing the changes
+   */
+  pkgVersion: string;
+  /**
+   * Changes
+   *
+   * The description of each change, in the order given by the package
+   */
+  changes: string[];
+}
+
+/**
+ * Operation
+ *
+ * The kinds of operations on an installed package.
+ */
+export enum PackageActivity_Operation {
+  OPERATION_UNSPECIFIED = 0,
+  OPERATION_INSTALL = 1,
+  OPERATION_UPDATE = 2,
+  OPERATION_ROLLBACK = 3,
+  OPERATION_DELETE = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function packageActivity_OperationFromJSON(object: any): PackageActivity_Operation {
+  switch (object) {
+    case 0:
+    case "OPERATION_UNSPECIFIED":
+      return PackageActivity_Operation.OPERATION_UNSPECIFIED;
+    case 1:
+    case "OPERATION_INSTALL":
+      return PackageActivity_Operation.OPERATION_INSTALL;
+    case 2:
+    case "OPERATION_UPDATE":
+      return PackageActivity_Operation.OPERATION_UPDATE;
+    case 3:
+    case "OPERATION_ROLLBACK":
+      return PackageActivity_Operation.OPERATION_ROLLBACK;
+    case 4:
+    case "OPERATION_DELETE":
+      return PackageActivity_Operation.OPERATION_DELETE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return PackageActivity_Operation.UNRECOGNIZED;
+  }
+}
+
+export function packageActivity_OperationToJSON(object: PackageActivity_Operation): string {
+  switch (object) {
+    case PackageActivity_Operation.OPERATION_UNSPECIFIED:
+      return "OPERATION_UNSPECIFIED";
+    case PackageActivity_Operation.OPERATION_INSTALL:
+      return "OPERATION_INSTALL";
+    case PackageActivity_Operation.OPERATION_UPDATE:
+      return "OPERATION_UPDATE";
+    case PackageActivity_Operation.OPERATION_ROLLBACK:
+      return "OPERATION_ROLLBACK";
+    case PackageActivity_Operation.OPERATION_DELETE:
+      return "OPERATION_DELETE";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+const baseGetAvailablePackageSummariesRequest: object = {
+  includeInstalledCount: false,
+  acceptLanguage: "",
+  maxDescriptionLength: 0,
+  modifiedSince: "",
+  includeCategoryCounts: false,
+  pluginNames: "",
+};
+
+export const GetAvailablePackageSummariesRequest = {
+  encode(
+    message: GetAvailablePackageSummariesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.filterOptions !== undefined) {
+      FilterOptions.encode(message.filterOptions, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.paginationOptions !== undefined) {
+      PaginationOptions.encode(message.paginationOptions, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.includeInstalledCount === true) {
+      writer.uint32(32).bool(message.includeInstalledCount);
+    }
+    if (message.acceptLanguage !== "") {
+      writer.uint32(42).string(message.acceptLanguage);
+    }
+    if (message.maxDescriptionLength !== 0) {
+      writer.uint32(48).int32(message.maxDescriptionLength);
+    }
+    if (message.modifiedSince !== "") {
+      writer.uint32(58).string(message.modifiedSince);
+    }
+    if (message.includeCategoryCounts === true) {
+      writer.uint32(64).bool(message.includeCategoryCounts);
+    }
+    for (const v of message.pluginNames) {
+      writer.uint32(74).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageSummariesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageSummariesRequest,
+    } as GetAvailablePackageSummariesRequest;
+    message.pluginNames = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.context = Context.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.filterOptions = FilterOptions.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.paginationOptions = PaginationOptions.decode(reader, reader.uint32());
+          break;
+        case 4:
+          message.includeInstalledCount = reader.bool();
+          break;
+        case 5:
+          message.acceptLanguage = reader.string();
+          break;
+        case 6:
+          message.maxDescriptionLength = reader.int32();
+          break;
+        case 7:
+          message.modifiedSince = reader.string();
+          break;
+        case 8:
+          message.includeCategoryCounts = reader.bool();
+          break;
+        case 9:
+          message.pluginNames.push(reader.string());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageSummariesRequest {
+    const message = {
+      ...baseGetAvailablePackageSummariesRequest,
+    } as GetAvailablePackageSummariesRequest;
+    message.pluginNames = [];
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromJSON(object.context);
+    } else {
+      message.context = undefined;
+    }
+    if (object.filterOptions !== undefined && object.filterOptions !== null) {
+      message.filterOptions = FilterOptions.fromJSON(object.filterOptions);
+    } else {
+      message.filterOptions = undefined;
+    }
+    if (object.paginationOptions !== undefined && object.paginationOptions !== null) {
+      message.paginationOptions = PaginationOptions.fromJSON(object.paginationOptions);
+    } else {
+      message.paginationOptions = undefined;
+    }
+    if (object.includeInstalledCount !== undefined && object.includeInstalledCount !== null) {
+      message.includeInstalledCount = Boolean(object.includeInstalledCount);
+    } else {
+      message.includeInstalledCount = false;
+    }
+    if (object.acceptLanguage !== undefined && object.acceptLanguage !== null) {
+      message.acceptLanguage = String(object.acceptLanguage);
+    } else {
+      message.acceptLanguage = "";
+    }
+    if (object.maxDescriptionLength !== undefined && object.maxDescriptionLength !== null) {
+      message.maxDescriptionLength = Number(object.maxDescriptionLength);
+    } else {
+      message.maxDescriptionLength = 0;
+    }
+    if (object.modifiedSince !== undefined && object.modifiedSince !== null) {
+      message.modifiedSince = String(object.modifiedSince);
+    } else {
+      message.modifiedSince = "";
+    }
+    if (object.includeCategoryCounts !== undefined && object.includeCategoryCounts !== null) {
+      message.includeCategoryCounts = Boolean(object.includeCategoryCounts);
+    } else {
+      message.includeCategoryCounts = false;
+    }
+    if (object.pluginNames !== undefined && object.pluginNames !== null) {
+      for (const e of object.pluginNames) {
+        message.pluginNames.push(String(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageSummariesRequest): unknown {
+    const obj: any = {};
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+    message.filterOptions !== undefined &&
+      (obj.filterOptions = message.filterOptions
+        ? FilterOptions.toJSON(message.filterOptions)
+        : undefined);
+    message.paginationOptions !== undefined &&
+      (obj.paginationOptions = message.paginationOptions
+        ? PaginationOptions.toJSON(message.paginationOptions)
+        : undefined);
+    message.includeInstalledCount !== undefined &&
+      (obj.includeInstalledCount = message.includeInstalledCount);
+    message.acceptLanguage !== undefined && (obj.acceptLanguage = message.acceptLanguage);
+    message.maxDescriptionLength !== undefined &&
+      (obj.maxDescriptionLength = message.maxDescriptionLength);
+    message.modifiedSince !== undefined && (obj.modifiedSince = message.modifiedSince);
+    message.includeCategoryCounts !== undefined &&
+      (obj.includeCategoryCounts = message.includeCategoryCounts);
+    if (message.pluginNames) {
+      obj.pluginNames = message.pluginNames.map(e => e);
+    } else {
+      obj.pluginNames = [];
+    }
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageSummariesRequest>,
+  ): GetAvailablePackageSummariesRequest {
+    const message = {
+      ...baseGetAvailablePackageSummariesRequest,
+    } as GetAvailablePackageSummariesRequest;
+    message.pluginNames = [];
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromPartial(object.context);
+    } else {
+      message.context = undefined;
+    }
+    if (object.filterOptions !== undefined && object.filterOptions !== null) {
+      message.filterOptions = FilterOptions.fromPartial(object.filterOptions);
+    } else {
+      message.filterOptions = undefined;
+    }
+    if (object.paginationOptions !== undefined && object.paginationOptions !== null) {
+      message.paginationOptions = PaginationOptions.fromPartial(object.paginationOptions);
+    } else {
+      message.paginationOptions = undefined;
+    }
+    if (object.includeInstalledCount !== undefined && object.includeInstalledCount !== null) {
+      message.includeInstalledCount = object.includeInstalledCount;
+    } else {
+      message.includeInstalledCount = false;
+    }
+    if (object.acceptLanguage !== undefined && object.acceptLanguage !== null) {
+      message.acceptLanguage = object.acceptLanguage;
+    } else {
+      message.acceptLanguage = "";
+    }
+    if (object.maxDescriptionLength !== undefined && object.maxDescriptionLength !== null) {
+      message.maxDescriptionLength = object.maxDescriptionLength;
+    } else {
+      message.maxDescriptionLength = 0;
+    }
+    if (object.modifiedSince !== undefined && object.modifiedSince !== null) {
+      message.modifiedSince = object.modifiedSince;
+    } else {
+      message.modifiedSince = "";
+    }
+    if (object.includeCategoryCounts !== undefined && object.includeCategoryCounts !== null) {
+      message.includeCategoryCounts = object.includeCategoryCounts;
+    } else {
+      message.includeCategoryCounts = false;
+    }
+    if (object.pluginNames !== undefined && object.pluginNames !== null) {
+      for (const e of object.pluginNames) {
+        message.pluginNames.push(e);
+      }
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageDetailRequest: object = {
+  pkgVersion: "",
+  acceptLanguage: "",
+  targetCluster: "",
+  includePrerelease: false,
+};
+
+export const GetAvailablePackageDetailRequest = {
+  encode(
+    message: GetAvailablePackageDetailRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersion !== "") {
+      writer.uint32(18).string(message.pkgVersion);
+    }
+    if (message.acceptLanguage !== "") {
+      writer.uint32(26).string(message.acceptLanguage);
+    }
+    if (message.targetCluster !== "") {
+      writer.uint32(34).string(message.targetCluster);
+    }
+    if (message.includePrerelease === true) {
+      writer.uint32(48).bool(message.includePrerelease);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageDetailRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageDetailRequest,
+    } as GetAvailablePackageDetailRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.pkgVersion = reader.string();
+          break;
+        case 3:
+          message.acceptLanguage = reader.string();
+          break;
+        case 4:
+          message.targetCluster = reader.string();
+          break;
+        case 6:
+          message.includePrerelease = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageDetailRequest {
+    const message = {
+      ...baseGetAvailablePackageDetailRequest,
+    } as GetAvailablePackageDetailRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = String(object.pkgVersion);
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.acceptLanguage !== undefined && object.acceptLanguage !== null) {
+      message.acceptLanguage = String(object.acceptLanguage);
+    } else {
+      message.acceptLanguage = "";
+    }
+    if (object.targetCluster !== undefined && object.targetCluster !== null) {
+      message.targetCluster = String(object.targetCluster);
+    } else {
+      message.targetCluster = "";
+    }
+    if (object.includePrerelease !== undefined && object.includePrerelease !== null) {
+      message.includePrerelease = Boolean(object.includePrerelease);
+    } else {
+      message.includePrerelease = false;
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageDetailRequest): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.acceptLanguage !== undefined && (obj.acceptLanguage = message.acceptLanguage);
+    message.targetCluster !== undefined && (obj.targetCluster = message.targetCluster);
+    message.includePrerelease !== undefined && (obj.includePrerelease = message.includePrerelease);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageDetailRequest>,
+  ): GetAvailablePackageDetailRequest {
+    const message = {
+      ...baseGetAvailablePackageDetailRequest,
+    } as GetAvailablePackageDetailRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = object.pkgVersion;
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.acceptLanguage !== undefined && object.acceptLanguage !== null) {
+      message.acceptLanguage = object.acceptLanguage;
+    } else {
+      message.acceptLanguage = "";
+    }
+    if (object.targetCluster !== undefined && object.targetCluster !== null) {
+      message.targetCluster = object.targetCluster;
+    } else {
+      message.targetCluster = "";
+    }
+    if (object.includePrerelease !== undefined && object.includePrerelease !== null) {
+      message.includePrerelease = object.includePrerelease;
+    } else {
+      message.includePrerelease = false;
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageReadmeRequest: object = { pkgVersion: "" };
+
+export const GetAvailablePackageReadmeRequest = {
+  encode(
+    message: GetAvailablePackageReadmeRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersion !== "") {
+      writer.uint32(18).string(message.pkgVersion);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageReadmeRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageReadmeRequest,
+    } as GetAvailablePackageReadmeRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.pkgVersion = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageReadmeRequest {
+    const message = {
+      ...baseGetAvailablePackageReadmeRequest,
+    } as GetAvailablePackageReadmeRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = String(object.pkgVersion);
+    } else {
+      message.pkgVersion = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageReadmeRequest): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageReadmeRequest>,
+  ): GetAvailablePackageReadmeRequest {
+    const message = {
+      ...baseGetAvailablePackageReadmeRequest,
+    } as GetAvailablePackageReadmeRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = object.pkgVersion;
+    } else {
+      message.pkgVersion = "";
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageVersionsRequest: object = {
+  pkgVersion: "",
+  channel: "",
+  groupByMinor: false,
+  includePrerelease: false,
+  installedVersion: "",
+};
+
+export const GetAvailablePackageVersionsRequest = {
+  encode(
+    message: GetAvailablePackageVersionsRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersion !== "") {
+      writer.uint32(18).string(message.pkgVersion);
+    }
+    if (message.channel !== "") {
+      writer.uint32(26).string(message.channel);
+    }
+    if (message.groupByMinor === true) {
+      writer.uint32(32).bool(message.groupByMinor);
+    }
+    if (message.includePrerelease === true) {
+      writer.uint32(40).bool(message.includePrerelease);
+    }
+    if (message.installedVersion !== "") {
+      writer.uint32(50).string(message.installedVersion);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageVersionsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageVersionsRequest,
+    } as GetAvailablePackageVersionsRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.pkgVersion = reader.string();
+          break;
+        case 3:
+          message.channel = reader.string();
+          break;
+        case 4:
+          message.groupByMinor = reader.bool();
+          break;
+        case 5:
+          message.includePrerelease = reader.bool();
+          break;
+        case 6:
+          message.installedVersion = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageVersionsRequest {
+    const message = {
+      ...baseGetAvailablePackageVersionsRequest,
+    } as GetAvailablePackageVersionsRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = String(object.pkgVersion);
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.channel !== undefined && object.channel !== null) {
+      message.channel = String(object.channel);
+    } else {
+      message.channel = "";
+    }
+    if (object.groupByMinor !== undefined && object.groupByMinor !== null) {
+      message.groupByMinor = Boolean(object.groupByMinor);
+    } else {
+      message.groupByMinor = false;
+    }
+    if (object.includePrerelease !== undefined && object.includePrerelease !== null) {
+      message.includePrerelease = Boolean(object.includePrerelease);
+    } else {
+      message.includePrerelease = false;
+    }
+    if (object.installedVersion !== undefined && object.installedVersion !== null) {
+      message.installedVersion = String(object.installedVersion);
+    } else {
+      message.installedVersion = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageVersionsRequest): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.channel !== undefined && (obj.channel = message.channel);
+    message.groupByMinor !== undefined && (obj.groupByMinor = message.groupByMinor);
+    message.includePrerelease !== undefined && (obj.includePrerelease = message.includePrerelease);
+    message.installedVersion !== undefined && (obj.installedVersion = message.installedVersion);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageVersionsRequest>,
+  ): GetAvailablePackageVersionsRequest {
+    const message = {
+      ...baseGetAvailablePackageVersionsRequest,
+    } as GetAvailablePackageVersionsRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = object.pkgVersion;
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.channel !== undefined && object.channel !== null) {
+      message.channel = object.channel;
+    } else {
+      message.channel = "";
+    }
+    if (object.groupByMinor !== undefined && object.groupByMinor !== null) {
+      message.groupByMinor = object.groupByMinor;
+    } else {
+      message.groupByMinor = false;
+    }
+    if (object.includePrerelease !== undefined && object.includePrerelease !== null) {
+      message.includePrerelease = object.includePrerelease;
+    } else {
+      message.includePrerelease = false;
+    }
+    if (object.installedVersion !== undefined && object.installedVersion !== null) {
+      message.installedVersion = object.installedVersion;
+    } else {
+      message.installedVersion = "";
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageDependenciesRequest: object = { pkgVersion: "" };
+
+export const GetAvailablePackageDependenciesRequest = {
+  encode(
+    message: GetAvailablePackageDependenciesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersion !== "") {
+      writer.uint32(18).string(message.pkgVersion);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageDependenciesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageDependenciesRequest,
+    } as GetAvailablePackageDependenciesRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.pkgVersion = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageDependenciesRequest {
+    const message = {
+      ...baseGetAvailablePackageDependenciesRequest,
+    } as GetAvailablePackageDependenciesRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = String(object.pkgVersion);
+    } else {
+      message.pkgVersion = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageDependenciesRequest): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageDependenciesRequest>,
+  ): GetAvailablePackageDependenciesRequest {
+    const message = {
+      ...baseGetAvailablePackageDependenciesRequest,
+    } as GetAvailablePackageDependenciesRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = object.pkgVersion;
+    } else {
+      message.pkgVersion = "";
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageDefaultValuesRequest: object = { pkgVersion: "" };
+
+export const GetAvailablePackageDefaultValuesRequest = {
+  encode(
+    message: GetAvailablePackageDefaultValuesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersion !== "") {
+      writer.uint32(18).string(message.pkgVersion);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageDefaultValuesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageDefaultValuesRequest,
+    } as GetAvailablePackageDefaultValuesRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.pkgVersion = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageDefaultValuesRequest {
+    const message = {
+      ...baseGetAvailablePackageDefaultValuesRequest,
+    } as GetAvailablePackageDefaultValuesRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = String(object.pkgVersion);
+    } else {
+      message.pkgVersion = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageDefaultValuesRequest): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageDefaultValuesRequest>,
+  ): GetAvailablePackageDefaultValuesRequest {
+    const message = {
+      ...baseGetAvailablePackageDefaultValuesRequest,
+    } as GetAvailablePackageDefaultValuesRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = object.pkgVersion;
+    } else {
+      message.pkgVersion = "";
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageChangelogRequest: object = {
+  fromPkgVersion: "",
+  toPkgVersion: "",
+};
+
+export const GetAvailablePackageChangelogRequest = {
+  encode(
+    message: GetAvailablePackageChangelogRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.fromPkgVersion !== "") {
+      writer.uint32(18).string(message.fromPkgVersion);
+    }
+    if (message.toPkgVersion !== "") {
+      writer.uint32(26).string(message.toPkgVersion);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageChangelogRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageChangelogRequest,
+    } as GetAvailablePackageChangelogRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.fromPkgVersion = reader.string();
+          break;
+        case 3:
+          message.toPkgVersion = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageChangelogRequest {
+    const message = {
+      ...baseGetAvailablePackageChangelogRequest,
+    } as GetAvailablePackageChangelogRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.fromPkgVersion !== undefined && object.fromPkgVersion !== null) {
+      message.fromPkgVersion = String(object.fromPkgVersion);
+    } else {
+      message.fromPkgVersion = "";
+    }
+    if (object.toPkgVersion !== undefined && object.toPkgVersion !== null) {
+      message.toPkgVersion = String(object.toPkgVersion);
+    } else {
+      message.toPkgVersion = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageChangelogRequest): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.fromPkgVersion !== undefined && (obj.fromPkgVersion = message.fromPkgVersion);
+    message.toPkgVersion !== undefined && (obj.toPkgVersion = message.toPkgVersion);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageChangelogRequest>,
+  ): GetAvailablePackageChangelogRequest {
+    const message = {
+      ...baseGetAvailablePackageChangelogRequest,
+    } as GetAvailablePackageChangelogRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.fromPkgVersion !== undefined && object.fromPkgVersion !== null) {
+      message.fromPkgVersion = object.fromPkgVersion;
+    } else {
+      message.fromPkgVersion = "";
+    }
+    if (object.toPkgVersion !== undefined && object.toPkgVersion !== null) {
+      message.toPkgVersion = object.toPkgVersion;
+    } else {
+      message.toPkgVersion = "";
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageValuesDiffRequest: object = {
+  fromPkgVersion: "",
+  toPkgVersion: "",
+};
+
+export const GetAvailablePackageValuesDiffRequest = {
+  encode(
+    message: GetAvailablePackageValuesDiffRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.fromPkgVersion !== "") {
+      writer.uint32(18).string(message.fromPkgVersion);
+    }
+    if (message.toPkgVersion !== "") {
+      writer.uint32(26).string(message.toPkgVersion);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageValuesDiffRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageValuesDiffRequest,
+    } as GetAvailablePackageValuesDiffRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.fromPkgVersion = reader.string();
+          break;
+        case 3:
+          message.toPkgVersion = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageValuesDiffRequest {
+    const message = {
+      ...baseGetAvailablePackageValuesDiffRequest,
+    } as GetAvailablePackageValuesDiffRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.fromPkgVersion !== undefined && object.fromPkgVersion !== null) {
+      message.fromPkgVersion = String(object.fromPkgVersion);
+    } else {
+      message.fromPkgVersion = "";
+    }
+    if (object.toPkgVersion !== undefined && object.toPkgVersion !== null) {
+      message.toPkgVersion = String(object.toPkgVersion);
+    } else {
+      message.toPkgVersion = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageValuesDiffRequest): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.fromPkgVersion !== undefined && (obj.fromPkgVersion = message.fromPkgVersion);
+    message.toPkgVersion !== undefined && (obj.toPkgVersion = message.toPkgVersion);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageValuesDiffRequest>,
+  ): GetAvailablePackageValuesDiffRequest {
+    const message = {
+      ...baseGetAvailablePackageValuesDiffRequest,
+    } as GetAvailablePackageValuesDiffRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.fromPkgVersion !== undefined && object.fromPkgVersion !== null) {
+      message.fromPkgVersion = object.fromPkgVersion;
+    } else {
+      message.fromPkgVersion = "";
+    }
+    if (object.toPkgVersion !== undefined && object.toPkgVersion !== null) {
+      message.toPkgVersion = object.toPkgVersion;
+    } else {
+      message.toPkgVersion = "";
+    }
+    return message;
+  },
+};
+
+const baseRenderAvailablePackageRequest: object = {
+  pkgVersion: "",
+  values: "",
+  name: "",
+  includeApplyOrder: false,
+};
+
+export const RenderAvailablePackageRequest = {
+  encode(
+    message: RenderAvailablePackageRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersion !== "") {
+      writer.uint32(18).string(message.pkgVersion);
+    }
+    if (message.values !== "") {
+      writer.uint32(26).string(message.values);
+    }
+    if (message.targetContext !== undefined) {
+      Context.encode(message.targetContext, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.name !== "") {
+      writer.uint32(42).string(message.name);
+    }
+    if (message.includeApplyOrder === true) {
+      writer.uint32(48).bool(message.includeApplyOrder);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RenderAvailablePackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseRenderAvailablePackageRequest,
+    } as RenderAvailablePackageRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.pkgVersion = reader.string();
+          break;
+        case 3:
+          message.values = reader.string();
+          break;
+        case 4:
+          message.targetContext = Context.decode(reader, reader.uint32());
+          break;
+        case 5:
+          message.name = reader.string();
+          break;
+        case 6:
+          message.includeApplyOrder = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RenderAvailablePackageRequest {
+    const message = {
+      ...baseRenderAvailablePackageRequest,
+    } as RenderAvailablePackageRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = String(object.pkgVersion);
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.values !== undefined && object.values !== null) {
+      message.values = String(object.values);
+    } else {
+      message.values = "";
+    }
+    if (object.targetContext !== undefined && object.targetContext !== null) {
+      message.targetContext = Context.fromJSON(object.targetContext);
+    } else {
+      message.targetContext = undefined;
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
+    } else {
+      message.name = "";
+    }
+    if (object.includeApplyOrder !== undefined && object.includeApplyOrder !== null) {
+      message.includeApplyOrder = Boolean(object.includeApplyOrder);
+    } else {
+      message.includeApplyOrder = false;
+    }
+    return message;
+  },
+
+  toJSON(message: RenderAvailablePackageRequest): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.values !== undefined && (obj.values = message.values);
+    message.targetContext !== undefined &&
+      (obj.targetContext = message.targetContext
+        ? Context.toJSON(message.targetContext)
+        : undefined);
+    message.name !== undefined && (obj.name = message.name);
+    message.includeApplyOrder !== undefined && (obj.includeApplyOrder = message.includeApplyOrder);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<RenderAvailablePackageRequest>): RenderAvailablePackageRequest {
+    const message = {
+      ...baseRenderAvailablePackageRequest,
+    } as RenderAvailablePackageRequest;
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = object.pkgVersion;
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.values !== undefined && object.values !== null) {
+      message.values = object.values;
+    } else {
+      message.values = "";
+    }
+    if (object.targetContext !== undefined && object.targetContext !== null) {
+      message.targetContext = Context.fromPartial(object.targetContext);
+    } else {
+      message.targetContext = undefined;
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
+    } else {
+      message.name = "";
+    }
+    if (object.includeApplyOrder !== undefined && object.includeApplyOrder !== null) {
+      message.includeApplyOrder = object.includeApplyOrder;
+    } else {
+      message.includeApplyOrder = false;
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageSummariesRequest: object = {};
+
+export const GetInstalledPackageSummariesRequest = {
+  encode(
+    message: GetInstalledPackageSummariesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.paginationOptions !== undefined) {
+      PaginationOptions.encode(message.paginationOptions, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageSummariesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageSummariesRequest,
+    } as GetInstalledPackageSummariesRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.context = Context.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.paginationOptions = PaginationOptions.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageSummariesRequest {
+    const message = {
+      ...baseGetInstalledPackageSummariesRequest,
+    } as GetInstalledPackageSummariesRequest;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromJSON(object.context);
+    } else {
+      message.context = undefined;
+    }
+    if (object.paginationOptions !== undefined && object.paginationOptions !== null) {
+      message.paginationOptions = PaginationOptions.fromJSON(object.paginationOptions);
+    } else {
+      message.paginationOptions = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageSummariesRequest): unknown {
+    const obj: any = {};
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+    message.paginationOptions !== undefined &&
+      (obj.paginationOptions = message.paginationOptions
+        ? PaginationOptions.toJSON(message.paginationOptions)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageSummariesRequest>,
+  ): GetInstalledPackageSummariesRequest {
+    const message = {
+      ...baseGetInstalledPackageSummariesRequest,
+    } as GetInstalledPackageSummariesRequest;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromPartial(object.context);
+    } else {
+      message.context = undefined;
+    }
+    if (object.paginationOptions !== undefined && object.paginationOptions !== null) {
+      message.paginationOptions = PaginationOptions.fromPartial(object.paginationOptions);
+    } else {
+      message.paginationOptions = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageDetailRequest: object = {
+  includeWorkloadStatus: false,
+};
+
+export const GetInstalledPackageDetailRequest = {
+  encode(
+    message: GetInstalledPackageDetailRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.includeWorkloadStatus === true) {
+      writer.uint32(16).bool(message.includeWorkloadStatus);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDetailRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageDetailRequest,
+    } as GetInstalledPackageDetailRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.includeWorkloadStatus = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageDetailRequest {
+    const message = {
+      ...baseGetInstalledPackageDetailRequest,
+    } as GetInstalledPackageDetailRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    if (object.includeWorkloadStatus !== undefined && object.includeWorkloadStatus !== null) {
+      message.includeWorkloadStatus = Boolean(object.includeWorkloadStatus);
+    } else {
+      message.includeWorkloadStatus = false;
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageDetailRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.includeWorkloadStatus !== undefined &&
+      (obj.includeWorkloadStatus = message.includeWorkloadStatus);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageDetailRequest>,
+  ): GetInstalledPackageDetailRequest {
+    const message = {
+      ...baseGetInstalledPackageDetailRequest,
+    } as GetInstalledPackageDetailRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    if (object.includeWorkloadStatus !== undefined && object.includeWorkloadStatus !== null) {
+      message.includeWorkloadStatus = object.includeWorkloadStatus;
+    } else {
+      message.includeWorkloadStatus = false;
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageDetailsBatchRequest: object = {
+  includeWorkloadStatus: false,
+};
+
+export const GetInstalledPackageDetailsBatchRequest = {
+  encode(
+    message: GetInstalledPackageDetailsBatchRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.installedPackageRefs) {
+      InstalledPackageReference.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.includeWorkloadStatus === true) {
+      writer.uint32(16).bool(message.includeWorkloadStatus);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDetailsBatchRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageDetailsBatchRequest,
+    } as GetInstalledPackageDetailsBatchRequest;
+    message.installedPackageRefs = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRefs.push(
+            InstalledPackageReference.decode(reader, reader.uint32()),
+          );
+          break;
+        case 2:
+          message.includeWorkloadStatus = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageDetailsBatchRequest {
+    const message = {
+      ...baseGetInstalledPackageDetailsBatchRequest,
+    } as GetInstalledPackageDetailsBatchRequest;
+    message.installedPackageRefs = [];
+    if (object.installedPackageRefs !== undefined && object.installedPackageRefs !== null) {
+      for (const e of object.installedPackageRefs) {
+        message.installedPackageRefs.push(InstalledPackageReference.fromJSON(e));
+      }
+    }
+    if (object.includeWorkloadStatus !== undefined && object.includeWorkloadStatus !== null) {
+      message.includeWorkloadStatus = Boolean(object.includeWorkloadStatus);
+    } else {
+      message.includeWorkloadStatus = false;
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageDetailsBatchRequest): unknown {
+    const obj: any = {};
+    if (message.installedPackageRefs) {
+      obj.installedPackageRefs = message.installedPackageRefs.map(e =>
+        e ? InstalledPackageReference.toJSON(e) : undefined,
+      );
+    } else {
+      obj.installedPackageRefs = [];
+    }
+    message.includeWorkloadStatus !== undefined &&
+      (obj.includeWorkloadStatus = message.includeWorkloadStatus);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageDetailsBatchRequest>,
+  ): GetInstalledPackageDetailsBatchRequest {
+    const message = {
+      ...baseGetInstalledPackageDetailsBatchRequest,
+    } as GetInstalledPackageDetailsBatchRequest;
+    message.installedPackageRefs = [];
+    if (object.installedPackageRefs !== undefined && object.installedPackageRefs !== null) {
+      for (const e of object.installedPackageRefs) {
+        message.installedPackageRefs.push(InstalledPackageReference.fromPartial(e));
+      }
+    }
+    if (object.includeWorkloadStatus !== undefined && object.includeWorkloadStatus !== null) {
+      message.includeWorkloadStatus = object.includeWorkloadStatus;
+    } else {
+      message.includeWorkloadStatus = false;
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageValuesRequest: object = {};
+
+export const GetInstalledPackageValuesRequest = {
+  encode(
+    message: GetInstalledPackageValuesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageValuesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageValuesRequest,
+    } as GetInstalledPackageValuesRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageValuesRequest {
+    const message = {
+      ...baseGetInstalledPackageValuesRequest,
+    } as GetInstalledPackageValuesRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageValuesRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageValuesRequest>,
+  ): GetInstalledPackageValuesRequest {
+    const message = {
+      ...baseGetInstalledPackageValuesRequest,
+    } as GetInstalledPackageValuesRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+};
+
+const baseCreateInstalledPackageRequest: object = {
+  name: "",
+  values: "",
+  reconcileInterval: "",
+  installTimeout: "",
+};
+
+export const CreateInstalledPackageRequest = {
+  encode(
+    message: CreateInstalledPackageRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.targetContext !== undefined) {
+      Context.encode(message.targetContext, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.pkgVersionReference !== undefined) {
+      VersionReference.encode(message.pkgVersionReference, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.values !== "") {
+      writer.uint32(42).string(message.values);
+    }
+    if (message.reconciliationOptions !== undefined) {
+      ReconciliationOptions.encode(
+        message.reconciliationOptions,
+        writer.uint32(50).fork(),
+      ).ldelim();
+    }
+    if (message.reconcileInterval !== "") {
+      writer.uint32(58).string(message.reconcileInterval);
+    }
+    Object.entries(message.labels).forEach(([key, value]) => {
+      CreateInstalledPackageRequest_LabelsEntry.encode(
+        { key: key as any, value },
+        writer.uint32(66).fork(),
+      ).ldelim();
+    });
+    Object.entries(message.annotations).forEach(([key, value]) => {
+      CreateInstalledPackageRequest_AnnotationsEntry.encode(
+        { key: key as any, value },
+        writer.uint32(74).fork(),
+      ).ldelim();
+    });
+    if (message.installTimeout !== "") {
+      writer.uint32(82).string(message.installTimeout);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateInstalledPackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseCreateInstalledPackageRequest,
+    } as CreateInstalledPackageRequest;
+    message.labels = {};
+    message.annotations = {};
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.targetContext = Context.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.name = reader.string();
+          break;
+        case 4:
+          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
+          break;
+        case 5:
+          message.values = reader.string();
+          break;
+        case 6:
+          message.reconciliationOptions = ReconciliationOptions.decode(reader, reader.uint32());
+          break;
+        case 7:
+          message.reconcileInterval = reader.string();
+          break;
+        case 8:
+          const entry8 = CreateInstalledPackageRequest_LabelsEntry.decode(reader, reader.uint32());
+          if (entry8.value !== undefined) {
+            message.labels[entry8.key] = entry8.value;
+          }
+          break;
+        case 9:
+          const entry9 = CreateInstalledPackageRequest_AnnotationsEntry.decode(
+            reader,
+            reader.uint32(),
+          );
+          if (entry9.value !== undefined) {
+            message.annotations[entry9.key] = entry9.value;
+          }
+          break;
+        case 10:
+          message.installTimeout = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateInstalledPackageRequest {
+    const message = {
+      ...baseCreateInstalledPackageRequest,
+    } as CreateInstalledPackageRequest;
+    message.labels = {};
+    message.annotations = {};
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.targetContext !== undefined && object.targetContext !== null) {
+      message.targetContext = Context.fromJSON(object.targetContext);
+    } else {
+      message.targetContext = undefined;
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
+    } else {
+      message.name = "";
+    }
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = VersionReference.fromJSON(object.pkgVersionReference);
+    } else {
+      message.pkgVersionReference = undefined;
+    }
+    if (object.values !== undefined && object.values !== null) {
+      message.values = String(object.values);
+    } else {
+      message.values = "";
+    }
+    if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
+      message.reconciliationOptions = ReconciliationOptions.fromJSON(object.reconciliationOptions);
+    } else {
+      message.reconciliationOptions = undefined;
+    }
+    if (object.reconcileInterval !== undefined && object.reconcileInterval !== null) {
+      message.reconcileInterval = String(object.reconcileInterval);
+    } else {
+      message.reconcileInterval = "";
+    }
+    if (object.labels !== undefined && object.labels !== null) {
+      Object.entries(object.labels).forEach(([key, value]) => {
+        message.labels[key] = String(value);
+      });
+    }
+    if (object.annotations !== undefined && object.annotations !== null) {
+      Object.entries(object.annotations).forEach(([key, value]) => {
+        message.annotations[key] = String(value);
+      });
+    }
+    if (object.installTimeout !== undefined && object.installTimeout !== null) {
+      message.installTimeout = String(object.installTimeout);
+    } else {
+      message.installTimeout = "";
+    }
+    return message;
+  },
+
+  toJSON(message: CreateInstalledPackageRequest): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.targetContext !== undefined &&
+      (obj.targetContext = message.targetContext
+        ? Context.toJSON(message.targetContext)
+        : undefined);
+    message.name !== undefined && (obj.name = message.name);
+    message.pkgVersionReference !== undefined &&
+      (obj.pkgVersionReference = message.pkgVersionReference
+        ? VersionReference.toJSON(message.pkgVersionReference)
+        : undefined);
+    message.values !== undefined && (obj.values = message.values);
+    message.reconciliationOptions !== undefined &&
+      (obj.reconciliationOptions = message.reconciliationOptions
+        ? ReconciliationOptions.toJSON(message.reconciliationOptions)
+        : undefined);
+    message.reconcileInterval !== undefined && (obj.reconcileInterval = message.reconcileInterval);
+    obj.labels = {};
+    if (message.labels) {
+      Object.entries(message.labels).forEach(([k, v]) => {
+        obj.labels[k] = v;
+      });
+    }
+    obj.annotations = {};
+    if (message.annotations) {
+      Object.entries(message.annotations).forEach(([k, v]) => {
+        obj.annotations[k] = v;
+      });
+    }
+    message.installTimeout !== undefined && (obj.installTimeout = message.installTimeout);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<CreateInstalledPackageRequest>): CreateInstalledPackageRequest {
+    const message = {
+      ...baseCreateInstalledPackageRequest,
+    } as CreateInstalledPackageRequest;
+    message.labels = {};
+    message.annotations = {};
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.targetContext !== undefined && object.targetContext !== null) {
+      message.targetContext = Context.fromPartial(object.targetContext);
+    } else {
+      message.targetContext = undefined;
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
+    } else {
+      message.name = "";
+    }
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = VersionReference.fromPartial(object.pkgVersionReference);
+    } else {
+      message.pkgVersionReference = undefined;
+    }
+    if (object.values !== undefined && object.values !== null) {
+      message.values = object.values;
+    } else {
+      message.values = "";
+    }
+    if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
+      message.reconciliationOptions = ReconciliationOptions.fromPartial(
+        object.reconciliationOptions,
+      );
+    } else {
+      message.reconciliationOptions = undefined;
+    }
+    if (object.reconcileInterval !== undefined && object.reconcileInterval !== null) {
+      message.reconcileInterval = object.reconcileInterval;
+    } else {
+      message.reconcileInterval = "";
+    }
+    if (object.labels !== undefined && object.labels !== null) {
+      Object.entries(object.labels).forEach(([key, value]) => {
+        if (value !== undefined) {
+          message.labels[key] = String(value);
+        }
+      });
+    }
+    if (object.annotations !== undefined && object.annotations !== null) {
+      Object.entries(object.annotations).forEach(([key, value]) => {
+        if (value !== undefined) {
+          message.annotations[key] = String(value);
+        }
+      });
+    }
+    if (object.installTimeout !== undefined && object.installTimeout !== null) {
+      message.installTimeout = object.installTimeout;
+    } else {
+      message.installTimeout = "";
+    }
+    return message;
+  },
+};
+
+const baseCreateInstalledPackageRequest_LabelsEntry: object = {
+  key: "",
+  value: "",
+};
+
+export const CreateInstalledPackageRequest_LabelsEntry = {
+  encode(
+    message: CreateInstalledPackageRequest_LabelsEntry,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number,
+  ): CreateInstalledPackageRequest_LabelsEntry {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseCreateInstalledPackageRequest_LabelsEntry,
+    } as CreateInstalledPackageRequest_LabelsEntry;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.key = reader.string();
+          break;
+        case 2:
+          message.value = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateInstalledPackageRequest_LabelsEntry {
+    const message = {
+      ...baseCreateInstalledPackageRequest_LabelsEntry,
+    } as CreateInstalledPackageRequest_LabelsEntry;
+    if (object.key !== undefined && object.key !== null) {
+      message.key = String(object.key);
+    } else {
+      message.key = "";
+    }
+    if (object.value !== undefined && object.value !== null) {
+      message.value = String(object.value);
+    } else {
+      message.value = "";
+    }
+    return message;
+  },
+
+  toJSON(message: CreateInstalledPackageRequest_LabelsEntry): unknown {
+    const obj: any = {};
+    message.key !== undefined && (obj.key = message.key);
+    message.value !== undefined && (obj.value = message.value);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<CreateInstalledPackageRequest_LabelsEntry>,
+  ): CreateInstalledPackageRequest_LabelsEntry {
+    const message = {
+      ...baseCreateInstalledPackageRequest_LabelsEntry,
+    } as CreateInstalledPackageRequest_LabelsEntry;
+    if (object.key !== undefined && object.key !== null) {
+      message.key = object.key;
+    } else {
+      message.key = "";
+    }
+    if (object.value !== undefined && object.value !== null) {
+      message.value = object.value;
+    } else {
+      message.value = "";
+    }
+    return message;
+  },
+};
+
+const baseCreateInstalledPackageRequest_AnnotationsEntry: object = {
+  key: "",
+  value: "",
+};
+
+export const CreateInstalledPackageRequest_AnnotationsEntry = {
+  encode(
+    message: CreateInstalledPackageRequest_AnnotationsEntry,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number,
+  ): CreateInstalledPackageRequest_AnnotationsEntry {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseCreateInstalledPackageRequest_AnnotationsEntry,
+    } as CreateInstalledPackageRequest_AnnotationsEntry;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.key = reader.string();
+          break;
+        case 2:
+          message.value = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateInstalledPackageRequest_AnnotationsEntry {
+    const message = {
+      ...baseCreateInstalledPackageRequest_AnnotationsEntry,
+    } as CreateInstalledPackageRequest_AnnotationsEntry;
+    if (object.key !== undefined && object.key !== null) {
+      message.key = String(object.key);
+    } else {
+      message.key = "";
+    }
+    if (object.value !== undefined && object.value !== null) {
+      message.value = String(object.value);
+    } else {
+      message.value = "";
+    }
+    return message;
+  },
+
+  toJSON(message: CreateInstalledPackageRequest_AnnotationsEntry): unknown {
+    const obj: any = {};
+    message.key !== undefined && (obj.key = message.key);
+    message.value !== undefined && (obj.value = message.value);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<CreateInstalledPackageRequest_AnnotationsEntry>,
+  ): CreateInstalledPackageRequest_AnnotationsEntry {
+    const message = {
+      ...baseCreateInstalledPackageRequest_AnnotationsEntry,
+    } as CreateInstalledPackageRequest_AnnotationsEntry;
+    if (object.key !== undefined && object.key !== null) {
+      message.key = object.key;
+    } else {
+      message.key = "";
+    }
+    if (object.value !== undefined && object.value !== null) {
+      message.value = object.value;
+    } else {
+      message.value = "";
+    }
+    return message;
+  },
+};
+
+const baseUpdateInstalledPackageRequest: object = { values: "" };
+
+export const UpdateInstalledPackageRequest = {
+  encode(
+    message: UpdateInstalledPackageRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersionReference !== undefined) {
+      VersionReference.encode(message.pkgVersionReference, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.values !== "") {
+      writer.uint32(26).string(message.values);
+    }
+    if (message.reconciliationOptions !== undefined) {
+      ReconciliationOptions.encode(
+        message.reconciliationOptions,
+        writer.uint32(34).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateInstalledPackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseUpdateInstalledPackageRequest,
+    } as UpdateInstalledPackageRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.values = reader.string();
+          break;
+        case 4:
+          message.reconciliationOptions = ReconciliationOptions.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateInstalledPackageRequest {
+    const message = {
+      ...baseUpdateInstalledPackageRequest,
+    } as UpdateInstalledPackageRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = VersionReference.fromJSON(object.pkgVersionReference);
+    } else {
+      message.pkgVersionReference = undefined;
+    }
+    if (object.values !== undefined && object.values !== null) {
+      message.values = String(object.values);
+    } else {
+      message.values = "";
+    }
+    if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
+      message.reconciliationOptions = ReconciliationOptions.fromJSON(object.reconciliationOptions);
+    } else {
+      message.reconciliationOptions = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: UpdateInstalledPackageRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.pkgVersionReference !== undefined &&
+      (obj.pkgVersionReference = message.pkgVersionReference
+        ? VersionReference.toJSON(message.pkgVersionReference)
+        : undefined);
+    message.values !== undefined && (obj.values = message.values);
+    message.reconciliationOptions !== undefined &&
+      (obj.reconciliationOptions = message.reconciliationOptions
+        ? ReconciliationOptions.toJSON(message.reconciliationOptions)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<UpdateInstalledPackageRequest>): UpdateInstalledPackageRequest {
+    const message = {
+      ...baseUpdateInstalledPackageRequest,
+    } as UpdateInstalledPackageRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = VersionReference.fromPartial(object.pkgVersionReference);
+    } else {
+      message.pkgVersionReference = undefined;
+    }
+    if (object.values !== undefined && object.values !== null) {
+      message.values = object.values;
+    } else {
+      message.values = "";
+    }
+    if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
+      message.reconciliationOptions = ReconciliationOptions.fromPartial(
+        object.reconciliationOptions,
+      );
+    } else {
+      message.reconciliationOptions = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageUpgradeDiffRequest: object = { values: "" };
+
+export const GetInstalledPackageUpgradeDiffRequest = {
+  encode(
+    message: GetInstalledPackageUpgradeDiffRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersionReference !== undefined) {
+      VersionReference.encode(message.pkgVersionReference, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.values !== "") {
+      writer.uint32(26).string(message.values);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageUpgradeDiffRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageUpgradeDiffRequest,
+    } as GetInstalledPackageUpgradeDiffRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.values = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageUpgradeDiffRequest {
+    const message = {
+      ...baseGetInstalledPackageUpgradeDiffRequest,
+    } as GetInstalledPackageUpgradeDiffRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = VersionReference.fromJSON(object.pkgVersionReference);
+    } else {
+      message.pkgVersionReference = undefined;
+    }
+    if (object.values !== undefined && object.values !== null) {
+      message.values = String(object.values);
+    } else {
+      message.values = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageUpgradeDiffRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.pkgVersionReference !== undefined &&
+      (obj.pkgVersionReference = message.pkgVersionReference
+        ? VersionReference.toJSON(message.pkgVersionReference)
+        : undefined);
+    message.values !== undefined && (obj.values = message.values);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageUpgradeDiffRequest>,
+  ): GetInstalledPackageUpgradeDiffRequest {
+    const message = {
+      ...baseGetInstalledPackageUpgradeDiffRequest,
+    } as GetInstalledPackageUpgradeDiffRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = VersionReference.fromPartial(object.pkgVersionReference);
+    } else {
+      message.pkgVersionReference = undefined;
+    }
+    if (object.values !== undefined && object.values !== null) {
+      message.values = object.values;
+    } else {
+      message.values = "";
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageDriftRequest: object = {};
+
+export const GetInstalledPackageDriftRequest = {
+  encode(
+    message: GetInstalledPackageDriftRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDriftRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageDriftRequest,
+    } as GetInstalledPackageDriftRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageDriftRequest {
+    const message = {
+      ...baseGetInstalledPackageDriftRequest,
+    } as GetInstalledPackageDriftRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageDriftRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageDriftRequest>,
+  ): GetInstalledPackageDriftRequest {
+    const message = {
+      ...baseGetInstalledPackageDriftRequest,
+    } as GetInstalledPackageDriftRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageUpdateAvailableRequest: object = {
+  includePrerelease: false,
+};
+
+export const GetInstalledPackageUpdateAvailableRequest = {
+  encode(
+    message: GetInstalledPackageUpdateAvailableRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.includePrerelease === true) {
+      writer.uint32(16).bool(message.includePrerelease);
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number,
+  ): GetInstalledPackageUpdateAvailableRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageUpdateAvailableRequest,
+    } as GetInstalledPackageUpdateAvailableRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.includePrerelease = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageUpdateAvailableRequest {
+    const message = {
+      ...baseGetInstalledPackageUpdateAvailableRequest,
+    } as GetInstalledPackageUpdateAvailableRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    if (object.includePrerelease !== undefined && object.includePrerelease !== null) {
+      message.includePrerelease = Boolean(object.includePrerelease);
+    } else {
+      message.includePrerelease = false;
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageUpdateAvailableRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.includePrerelease !== undefined && (obj.includePrerelease = message.includePrerelease);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageUpdateAvailableRequest>,
+  ): GetInstalledPackageUpdateAvailableRequest {
+    const message = {
+      ...baseGetInstalledPackageUpdateAvailableRequest,
+    } as GetInstalledPackageUpdateAvailableRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    if (object.includePrerelease !== undefined && object.includePrerelease !== null) {
+      message.includePrerelease = object.includePrerelease;
+    } else {
+      message.includePrerelease = false;
+    }
+    return message;
+  },
+};
+
+const baseExportInstalledPackageRequest: object = {};
+
+export const ExportInstalledPackageRequest = {
+  encode(
+    message: ExportInstalledPackageRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportInstalledPackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseExportInstalledPackageRequest,
+    } as ExportInstalledPackageRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExportInstalledPackageRequest {
+    const message = {
+      ...baseExportInstalledPackageRequest,
+    } as ExportInstalledPackageRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: ExportInstalledPackageRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<ExportInstalledPackageRequest>): ExportInstalledPackageRequest {
+    const message = {
+      ...baseExportInstalledPackageRequest,
+    } as ExportInstalledPackageRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+};
+
+const baseDeleteInstalledPackageRequest: object = {};
+
+export const DeleteInstalledPackageRequest = {
+  encode(
+    message: DeleteInstalledPackageRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseDeleteInstalledPackageRequest,
+    } as DeleteInstalledPackageRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeleteInstalledPackageRequest {
+    const message = {
+      ...baseDeleteInstalledPackageRequest,
+    } as DeleteInstalledPackageRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: DeleteInstalledPackageRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<DeleteInstalledPackageRequest>): DeleteInstalledPackageRequest {
+    const message = {
+      ...baseDeleteInstalledPackageRequest,
+    } as DeleteInstalledPackageRequest;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+};
+
+const baseDeleteInstalledPackagesBatchRequest: object = { concurrent: false };
+
+export const DeleteInstalledPackagesBatchRequest = {
+  encode(
+    message: DeleteInstalledPackagesBatchRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.installedPackageRefs) {
+      InstalledPackageReference.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.concurrent === true) {
+      writer.uint32(16).bool(message.concurrent);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackagesBatchRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseDeleteInstalledPackagesBatchRequest,
+    } as DeleteInstalledPackagesBatchRequest;
+    message.installedPackageRefs = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRefs.push(
+            InstalledPackageReference.decode(reader, reader.uint32()),
+          );
+          break;
+        case 2:
+          message.concurrent = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeleteInstalledPackagesBatchRequest {
+    const message = {
+      ...baseDeleteInstalledPackagesBatchRequest,
+    } as DeleteInstalledPackagesBatchRequest;
+    message.installedPackageRefs = [];
+    if (object.installedPackageRefs !== undefined && object.installedPackageRefs !== null) {
+      for (const e of object.installedPackageRefs) {
+        message.installedPackageRefs.push(InstalledPackageReference.fromJSON(e));
+      }
+    }
+    if (object.concurrent !== undefined && object.concurrent !== null) {
+      message.concurrent = Boolean(object.concurrent);
+    } else {
+      message.concurrent = false;
+    }
+    return message;
+  },
+
+  toJSON(message: DeleteInstalledPackagesBatchRequest): unknown {
+    const obj: any = {};
+    if (message.installedPackageRefs) {
+      obj.installedPackageRefs = message.installedPackageRefs.map(e =>
+        e ? InstalledPackageReference.toJSON(e) : undefined,
+      );
+    } else {
+      obj.installedPackageRefs = [];
+    }
+    message.concurrent !== undefined && (obj.concurrent = message.concurrent);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<DeleteInstalledPackagesBatchRequest>,
+  ): DeleteInstalledPackagesBatchRequest {
+    const message = {
+      ...baseDeleteInstalledPackagesBatchRequest,
+    } as DeleteInstalledPackagesBatchRequest;
+    message.installedPackageRefs = [];
+    if (object.installedPackageRefs !== undefined && object.installedPackageRefs !== null) {
+      for (const e of object.installedPackageRefs) {
+        message.installedPackageRefs.push(InstalledPackageReference.fromPartial(e));
+      }
+    }
+    if (object.concurrent !== undefined && object.concurrent !== null) {
+      message.concurrent = object.concurrent;
+    } else {
+      message.concurrent = false;
+    }
+    return message;
+  },
+};
+
+const baseGetCatalogStatsRequest: object = {};
+
+export const GetCatalogStatsRequest = {
+  encode(message: GetCatalogStatsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetCatalogStatsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseGetCatalogStatsRequest } as GetCatalogStatsRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.context = Context.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetCatalogStatsRequest {
+    const message = { ...baseGetCatalogStatsRequest } as GetCatalogStatsRequest;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromJSON(object.context);
+    } else {
+      message.context = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetCatalogStatsRequest): unknown {
+    const obj: any = {};
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<GetCatalogStatsRequest>): GetCatalogStatsRequest {
+    const message = { ...baseGetCatalogStatsRequest } as GetCatalogStatsRequest;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromPartial(object.context);
+    } else {
+      message.context = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetRecentActivityRequest: object = {};
+
+export const GetRecentActivityRequest = {
+  encode(message: GetRecentActivityRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.paginationOptions !== undefined) {
+      PaginationOptions.encode(message.paginationOptions, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetRecentActivityRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetRecentActivityRequest,
+    } as GetRecentActivityRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.context = Context.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.paginationOptions = PaginationOptions.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetRecentActivityRequest {
+    const message = {
+      ...baseGetRecentActivityRequest,
+    } as GetRecentActivityRequest;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromJSON(object.context);
+    } else {
+      message.context = undefined;
+    }
+    if (object.paginationOptions !== undefined && object.paginationOptions !== null) {
+      message.paginationOptions = PaginationOptions.fromJSON(object.paginationOptions);
+    } else {
+      message.paginationOptions = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetRecentActivityRequest): unknown {
+    const obj: any = {};
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+    message.paginationOptions !== undefined &&
+      (obj.paginationOptions = message.paginationOptions
+        ? PaginationOptions.toJSON(message.paginationOptions)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<GetRecentActivityRequest>): GetRecentActivityRequest {
+    const message = {
+      ...baseGetRecentActivityRequest,
+    } as GetRecentActivityRequest;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromPartial(object.context);
+    } else {
+      message.context = undefined;
+    }
+    if (object.paginationOptions !== undefined && object.paginationOptions !== null) {
+      message.paginationOptions = PaginationOptions.fromPartial(object.paginationOptions);
+    } else {
+      message.paginationOptions = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetUserPermissionsRequest: object = {};
+
+export const GetUserPermissionsRequest = {
+  encode(message: GetUserPermissionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.plugin !== undefined) {
+      Plugin.encode(message.plugin, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetUserPermissionsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetUserPermissionsRequest,
+    } as GetUserPermissionsRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.context = Context.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.plugin = Plugin.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetUserPermissionsRequest {
+    const message = {
+      ...baseGetUserPermissionsRequest,
+    } as GetUserPermissionsRequest;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromJSON(object.context);
+    } else {
+      message.context = undefined;
+    }
+    if (object.plugin !== undefined && object.plugin !== null) {
+      message.plugin = Plugin.fromJSON(object.plugin);
+    } else {
+      message.plugin = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetUserPermissionsRequest): unknown {
+    const obj: any = {};
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+    message.plugin !== undefined &&
+      (obj.plugin = message.plugin ? Plugin.toJSON(message.plugin) : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<GetUserPermissionsRequest>): GetUserPermissionsRequest {
+    const message = {
+      ...baseGetUserPermissionsRequest,
+    } as GetUserPermissionsRequest;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromPartial(object.context);
+    } else {
+      message.context = undefined;
+    }
+    if (object.plugin !== undefined && object.plugin !== null) {
+      message.plugin = Plugin.fromPartial(object.plugin);
+    } else {
+      message.plugin = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetCatalogIndexRequest: object = {};
+
+export const GetCatalogIndexRequest = {
+  encode(message: GetCatalogIndexRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetCatalogIndexRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseGetCatalogIndexRequest } as GetCatalogIndexRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.context = Context.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetCatalogIndexRequest {
+    const message = { ...baseGetCatalogIndexRequest } as GetCatalogIndexRequest;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromJSON(object.context);
+    } else {
+      message.context = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetCatalogIndexRequest): unknown {
+    const obj: any = {};
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<GetCatalogIndexRequest>): GetCatalogIndexRequest {
+    const message = { ...baseGetCatalogIndexRequest } as GetCatalogIndexRequest;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromPartial(object.context);
+    } else {
+      message.context = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageSummariesResponse: object = {
+  nextPageToken: "",
+  categories: "",
+};
+
+export const GetAvailablePackageSummariesResponse = {
+  encode(
+    message: GetAvailablePackageSummariesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.availablePackageSummaries) {
+      AvailablePackageSummary.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    for (const v of message.categories) {
+      writer.uint32(26).string(v!);
+    }
+    for (const v of message.categoryCounts) {
+      CategoryCount.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageSummariesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageSummariesResponse,
+    } as GetAvailablePackageSummariesResponse;
+    message.availablePackageSummaries = [];
+    message.categories = [];
+    message.categoryCounts = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageSummaries.push(
+            AvailablePackageSummary.decode(reader, reader.uint32()),
+          );
+          break;
+        case 2:
+          message.nextPageToken = reader.string();
+          break;
+        case 3:
+          message.categories.push(reader.string());
+          break;
+        case 4:
+          message.categoryCounts.push(CategoryCount.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageSummariesResponse {
+    const message = {
+      ...baseGetAvailablePackageSummariesResponse,
+    } as GetAvailablePackageSummariesResponse;
+    message.availablePackageSummaries = [];
+    message.categories = [];
+    message.categoryCounts = [];
+    if (
+      object.availablePackageSummaries !== undefined &&
+      object.availablePackageSummaries !== null
+    ) {
+      for (const e of object.availablePackageSummaries) {
+        message.availablePackageSummaries.push(AvailablePackageSummary.fromJSON(e));
+      }
+    }
+    if (object.nextPageToken !== undefined && object.nextPageToken !== null) {
+      message.nextPageToken = String(object.nextPageToken);
+    } else {
+      message.nextPageToken = "";
+    }
+    if (object.categories !== undefined && object.categories !== null) {
+      for (const e of object.categories) {
+        message.categories.push(String(e));
+      }
+    }
+    if (object.categoryCounts !== undefined && object.categoryCounts !== null) {
+      for (const e of object.categoryCounts) {
+        message.categoryCounts.push(CategoryCount.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageSummariesResponse): unknown {
+    const obj: any = {};
+    if (message.availablePackageSummaries) {
+      obj.availablePackageSummaries = message.availablePackageSummaries.map(e =>
+        e ? AvailablePackageSummary.toJSON(e) : undefined,
+      );
+    } else {
+      obj.availablePackageSummaries = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    if (message.categories) {
+      obj.categories = message.categories.map(e => e);
+    } else {
+      obj.categories = [];
+    }
+    if (message.categoryCounts) {
+      obj.categoryCounts = message.categoryCounts.map(e =>
+        e ? CategoryCount.toJSON(e) : undefined,
+      );
+    } else {
+      obj.categoryCounts = [];
+    }
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageSummariesResponse>,
+  ): GetAvailablePackageSummariesResponse {
+    const message = {
+      ...baseGetAvailablePackageSummariesResponse,
+    } as GetAvailablePackageSummariesResponse;
+    message.availablePackageSummaries = [];
+    message.categories = [];
+    message.categoryCounts = [];
+    if (
+      object.availablePackageSummaries !== undefined &&
+      object.availablePackageSummaries !== null
+    ) {
+      for (const e of object.availablePackageSummaries) {
+        message.availablePackageSummaries.push(AvailablePackageSummary.fromPartial(e));
+      }
+    }
+    if (object.nextPageToken !== undefined && object.nextPageToken !== null) {
+      message.nextPageToken = object.nextPageToken;
+    } else {
+      message.nextPageToken = "";
+    }
+    if (object.categories !== undefined && object.categories !== null) {
+      for (const e of object.categories) {
+        message.categories.push(e);
+      }
+    }
+    if (object.categoryCounts !== undefined && object.categoryCounts !== null) {
+      for (const e of object.categoryCounts) {
+        message.categoryCounts.push(CategoryCount.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseCategoryCount: object = { name: "", count: 0 };
+
+export const CategoryCount = {
+  encode(message: CategoryCount, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.count !== 0) {
+      writer.uint32(16).int32(message.count);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CategoryCount {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseCategoryCount } as CategoryCount;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.count = reader.int32();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CategoryCount {
+    const message = { ...baseCategoryCount } as CategoryCount;
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
+    } else {
+      message.name = "";
+    }
+    if (object.count !== undefined && object.count !== null) {
+      message.count = Number(object.count);
+    } else {
+      message.count = 0;
+    }
+    return message;
+  },
+
+  toJSON(message: CategoryCount): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.count !== undefined && (obj.count = message.count);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<CategoryCount>): CategoryCount {
+    const message = { ...baseCategoryCount } as CategoryCount;
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
+    } else {
+      message.name = "";
+    }
+    if (object.count !== undefined && object.count !== null) {
+      message.count = object.count;
+    } else {
+      message.count = 0;
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageDetailResponse: object = {};
+
+export const GetAvailablePackageDetailResponse = {
+  encode(
+    message: GetAvailablePackageDetailResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageDetail !== undefined) {
+      AvailablePackageDetail.encode(
+        message.availablePackageDetail,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageDetailResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageDetailResponse,
+    } as GetAvailablePackageDetailResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageDetail = AvailablePackageDetail.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageDetailResponse {
+    const message = {
+      ...baseGetAvailablePackageDetailResponse,
+    } as GetAvailablePackageDetailResponse;
+    if (object.availablePackageDetail !== undefined && object.availablePackageDetail !== null) {
+      message.availablePackageDetail = AvailablePackageDetail.fromJSON(
+        object.availablePackageDetail,
+      );
+    } else {
+      message.availablePackageDetail = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageDetailResponse): unknown {
+    const obj: any = {};
+    message.availablePackageDetail !== undefined &&
+      (obj.availablePackageDetail = message.availablePackageDetail
+        ? AvailablePackageDetail.toJSON(message.availablePackageDetail)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageDetailResponse>,
+  ): GetAvailablePackageDetailResponse {
+    const message = {
+      ...baseGetAvailablePackageDetailResponse,
+    } as GetAvailablePackageDetailResponse;
+    if (object.availablePackageDetail !== undefined && object.availablePackageDetail !== null) {
+      message.availablePackageDetail = AvailablePackageDetail.fromPartial(
+        object.availablePackageDetail,
+      );
+    } else {
+      message.availablePackageDetail = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageReadmeResponse: object = { chunk: "" };
+
+export const GetAvailablePackageReadmeResponse = {
+  encode(
+    message: GetAvailablePackageReadmeResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.chunk !== "") {
+      writer.uint32(10).string(message.chunk);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageReadmeResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageReadmeResponse,
+    } as GetAvailablePackageReadmeResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.chunk = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageReadmeResponse {
+    const message = {
+      ...baseGetAvailablePackageReadmeResponse,
+    } as GetAvailablePackageReadmeResponse;
+    if (object.chunk !== undefined && object.chunk !== null) {
+      message.chunk = String(object.chunk);
+    } else {
+      message.chunk = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageReadmeResponse): unknown {
+    const obj: any = {};
+    message.chunk !== undefined && (obj.chunk = message.chunk);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageReadmeResponse>,
+  ): GetAvailablePackageReadmeResponse {
+    const message = {
+      ...baseGetAvailablePackageReadmeResponse,
+    } as GetAvailablePackageReadmeResponse;
+    if (object.chunk !== undefined && object.chunk !== null) {
+      message.chunk = object.chunk;
+    } else {
+      message.chunk = "";
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageVersionsResponse: object = {};
+
+export const GetAvailablePackageVersionsResponse = {
+  encode(
+    message: GetAvailablePackageVersionsResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.packageAppVersions) {
+      PackageAppVersion.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.versionGroups) {
+      PackageVersionGroup.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageVersionsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageVersionsResponse,
+    } as GetAvailablePackageVersionsResponse;
+    message.packageAppVersions = [];
+    message.versionGroups = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.packageAppVersions.push(PackageAppVersion.decode(reader, reader.uint32()));
+          break;
+        case 2:
+          message.versionGroups.push(PackageVersionGroup.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageVersionsResponse {
+    const message = {
+      ...baseGetAvailablePackageVersionsResponse,
+    } as GetAvailablePackageVersionsResponse;
+    message.packageAppVersions = [];
+    message.versionGroups = [];
+    if (object.packageAppVersions !== undefined && object.packageAppVersions !== null) {
+      for (const e of object.packageAppVersions) {
+        message.packageAppVersions.push(PackageAppVersion.fromJSON(e));
+      }
+    }
+    if (object.versionGroups !== undefined && object.versionGroups !== null) {
+      for (const e of object.versionGroups) {
+        message.versionGroups.push(PackageVersionGroup.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageVersionsResponse): unknown {
+    const obj: any = {};
+    if (message.packageAppVersions) {
+      obj.packageAppVersions = message.packageAppVersions.map(e =>
+        e ? PackageAppVersion.toJSON(e) : undefined,
+      );
+    } else {
+      obj.packageAppVersions = [];
+    }
+    if (message.versionGroups) {
+      obj.versionGroups = message.versionGroups.map(e =>
+        e ? PackageVersionGroup.toJSON(e) : undefined,
+      );
+    } else {
+      obj.versionGroups = [];
+    }
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageVersionsResponse>,
+  ): GetAvailablePackageVersionsResponse {
+    const message = {
+      ...baseGetAvailablePackageVersionsResponse,
+    } as GetAvailablePackageVersionsResponse;
+    message.packageAppVersions = [];
+    message.versionGroups = [];
+    if (object.packageAppVersions !== undefined && object.packageAppVersions !== null) {
+      for (const e of object.packageAppVersions) {
+        message.packageAppVersions.push(PackageAppVersion.fromPartial(e));
+      }
+    }
+    if (object.versionGroups !== undefined && object.versionGroups !== null) {
+      for (const e of object.versionGroups) {
+        message.versionGroups.push(PackageVersionGroup.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageDependenciesResponse: object = {};
+
+export const GetAvailablePackageDependenciesResponse = {
+  encode(
+    message: GetAvailablePackageDependenciesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.packageDependencies) {
+      PackageDependency.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageDependenciesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageDependenciesResponse,
+    } as GetAvailablePackageDependenciesResponse;
+    message.packageDependencies = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.packageDependencies.push(PackageDependency.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageDependenciesResponse {
+    const message = {
+      ...baseGetAvailablePackageDependenciesResponse,
+    } as GetAvailablePackageDependenciesResponse;
+    message.packageDependencies = [];
+    if (object.packageDependencies !== undefined && object.packageDependencies !== null) {
+      for (const e of object.packageDependencies) {
+        message.packageDependencies.push(PackageDependency.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageDependenciesResponse): unknown {
+    const obj: any = {};
+    if (message.packageDependencies) {
+      obj.packageDependencies = message.packageDependencies.map(e =>
+        e ? PackageDependency.toJSON(e) : undefined,
+      );
+    } else {
+      obj.packageDependencies = [];
+    }
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageDependenciesResponse>,
+  ): GetAvailablePackageDependenciesResponse {
+    const message = {
+      ...baseGetAvailablePackageDependenciesResponse,
+    } as GetAvailablePackageDependenciesResponse;
+    message.packageDependencies = [];
+    if (object.packageDependencies !== undefined && object.packageDependencies !== null) {
+      for (const e of object.packageDependencies) {
+        message.packageDependencies.push(PackageDependency.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageDefaultValuesResponse: object = {
+  defaultValues: "",
+};
+
+export const GetAvailablePackageDefaultValuesResponse = {
+  encode(
+    message: GetAvailablePackageDefaultValuesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.defaultValues !== "") {
+      writer.uint32(10).string(message.defaultValues);
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number,
+  ): GetAvailablePackageDefaultValuesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageDefaultValuesResponse,
+    } as GetAvailablePackageDefaultValuesResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.defaultValues = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageDefaultValuesResponse {
+    const message = {
+      ...baseGetAvailablePackageDefaultValuesResponse,
+    } as GetAvailablePackageDefaultValuesResponse;
+    if (object.defaultValues !== undefined && object.defaultValues !== null) {
+      message.defaultValues = String(object.defaultValues);
+    } else {
+      message.defaultValues = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageDefaultValuesResponse): unknown {
+    const obj: any = {};
+    message.defaultValues !== undefined && (obj.defaultValues = message.defaultValues);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageDefaultValuesResponse>,
+  ): GetAvailablePackageDefaultValuesResponse {
+    const message = {
+      ...baseGetAvailablePackageDefaultValuesResponse,
+    } as GetAvailablePackageDefaultValuesResponse;
+    if (object.defaultValues !== undefined && object.defaultValues !== null) {
+      message.defaultValues = object.defaultValues;
+    } else {
+      message.defaultValues = "";
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageChangelogResponse: object = {};
+
+export const GetAvailablePackageChangelogResponse = {
+  encode(
+    message: GetAvailablePackageChangelogResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.changelogEntries) {
+      PackageChangelogEntry.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageChangelogResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageChangelogResponse,
+    } as GetAvailablePackageChangelogResponse;
+    message.changelogEntries = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.changelogEntries.push(PackageChangelogEntry.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageChangelogResponse {
+    const message = {
+      ...baseGetAvailablePackageChangelogResponse,
+    } as GetAvailablePackageChangelogResponse;
+    message.changelogEntries = [];
+    if (object.changelogEntries !== undefined && object.changelogEntries !== null) {
+      for (const e of object.changelogEntries) {
+        message.changelogEntries.push(PackageChangelogEntry.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageChangelogResponse): unknown {
+    const obj: any = {};
+    if (message.changelogEntries) {
+      obj.changelogEntries = message.changelogEntries.map(e =>
+        e ? PackageChangelogEntry.toJSON(e) : undefined,
+      );
+    } else {
+      obj.changelogEntries = [];
+    }
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageChangelogResponse>,
+  ): GetAvailablePackageChangelogResponse {
+    const message = {
+      ...baseGetAvailablePackageChangelogResponse,
+    } as GetAvailablePackageChangelogResponse;
+    message.changelogEntries = [];
+    if (object.changelogEntries !== undefined && object.changelogEntries !== null) {
+      for (const e of object.changelogEntries) {
+        message.changelogEntries.push(PackageChangelogEntry.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseGetAvailablePackageValuesDiffResponse: object = {
+  addedKeys: "",
+  removedKeys: "",
+  changedKeys: "",
+};
+
+export const GetAvailablePackageValuesDiffResponse = {
+  encode(
+    message: GetAvailablePackageValuesDiffResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.addedKeys) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.removedKeys) {
+      writer.uint32(18).string(v!);
+    }
+    for (const v of message.changedKeys) {
+      writer.uint32(26).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageValuesDiffResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetAvailablePackageValuesDiffResponse,
+    } as GetAvailablePackageValuesDiffResponse;
+    message.addedKeys = [];
+    message.removedKeys = [];
+    message.changedKeys = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.addedKeys.push(reader.string());
+          break;
+        case 2:
+          message.removedKeys.push(reader.string());
+          break;
+        case 3:
+          message.changedKeys.push(reader.string());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageValuesDiffResponse {
+    const message = {
+      ...baseGetAvailablePackageValuesDiffResponse,
+    } as GetAvailablePackageValuesDiffResponse;
+    message.addedKeys = [];
+    message.removedKeys = [];
+    message.changedKeys = [];
+    if (object.addedKeys !== undefined && object.addedKeys !== null) {
+      for (const e of object.addedKeys) {
+        message.addedKeys.push(String(e));
+      }
+    }
+    if (object.removedKeys !== undefined && object.removedKeys !== null) {
+      for (const e of object.removedKeys) {
+        message.removedKeys.push(String(e));
+      }
+    }
+    if (object.changedKeys !== undefined && object.changedKeys !== null) {
+      for (const e of object.changedKeys) {
+        message.changedKeys.push(String(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: GetAvailablePackageValuesDiffResponse): unknown {
+    const obj: any = {};
+    if (message.addedKeys) {
+      obj.addedKeys = message.addedKeys.map(e => e);
+    } else {
+      obj.addedKeys = [];
+    }
+    if (message.removedKeys) {
+      obj.removedKeys = message.removedKeys.map(e => e);
+    } else {
+      obj.removedKeys = [];
+    }
+    if (message.changedKeys) {
+      obj.changedKeys = message.changedKeys.map(e => e);
+    } else {
+      obj.changedKeys = [];
+    }
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetAvailablePackageValuesDiffResponse>,
+  ): GetAvailablePackageValuesDiffResponse {
+    const message = {
+      ...baseGetAvailablePackageValuesDiffResponse,
+    } as GetAvailablePackageValuesDiffResponse;
+    message.addedKeys = [];
+    message.removedKeys = [];
+    message.changedKeys = [];
+    if (object.addedKeys !== undefined && object.addedKeys !== null) {
+      for (const e of object.addedKeys) {
+        message.addedKeys.push(e);
+      }
+    }
+    if (object.removedKeys !== undefined && object.removedKeys !== null) {
+      for (const e of object.removedKeys) {
+        message.removedKeys.push(e);
+      }
+    }
+    if (object.changedKeys !== undefined && object.changedKeys !== null) {
+      for (const e of object.changedKeys) {
+        message.changedKeys.push(e);
+      }
+    }
+    return message;
+  },
+};
+
+const baseRenderAvailablePackageResponse: object = { manifest: "" };
+
+export const RenderAvailablePackageResponse = {
+  encode(
+    message: RenderAvailablePackageResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.manifest !== "") {
+      writer.uint32(10).string(message.manifest);
+    }
+    for (const v of message.applyOrder) {
+      RenderedResource.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RenderAvailablePackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseRenderAvailablePackageResponse,
+    } as RenderAvailablePackageResponse;
+    message.applyOrder = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.manifest = reader.string();
+          break;
+        case 2:
+          message.applyOrder.push(RenderedResource.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RenderAvailablePackageResponse {
+    const message = {
+      ...baseRenderAvailablePackageResponse,
+    } as RenderAvailablePackageResponse;
+    message.applyOrder = [];
+    if (object.manifest !== undefined && object.manifest !== null) {
+      message.manifest = String(object.manifest);
+    } else {
+      message.manifest = "";
+    }
+    if (object.applyOrder !== undefined && object.applyOrder !== null) {
+      for (const e of object.applyOrder) {
+        message.applyOrder.push(RenderedResource.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: RenderAvailablePackageResponse): unknown {
+    const obj: any = {};
+    message.manifest !== undefined && (obj.manifest = message.manifest);
+    if (message.applyOrder) {
+      obj.applyOrder = message.applyOrder.map(e => (e ? RenderedResource.toJSON(e) : undefined));
+    } else {
+      obj.applyOrder = [];
+    }
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<RenderAvailablePackageResponse>): RenderAvailablePackageResponse {
+    const message = {
+      ...baseRenderAvailablePackageResponse,
+    } as RenderAvailablePackageResponse;
+    message.applyOrder = [];
+    if (object.manifest !== undefined && object.manifest !== null) {
+      message.manifest = object.manifest;
+    } else {
+      message.manifest = "";
+    }
+    if (object.applyOrder !== undefined && object.applyOrder !== null) {
+      for (const e of object.applyOrder) {
+        message.applyOrder.push(RenderedResource.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageSummariesResponse: object = { nextPageToken: "" };
+
+export const GetInstalledPackageSummariesResponse = {
+  encode(
+    message: GetInstalledPackageSummariesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.installedPackageSummaries) {
+      InstalledPackageSummary.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageSummariesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageSummariesResponse,
+    } as GetInstalledPackageSummariesResponse;
+    message.installedPackageSummaries = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageSummaries.push(
+            InstalledPackageSummary.decode(reader, reader.uint32()),
+          );
+          break;
+        case 2:
+          message.nextPageToken = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageSummariesResponse {
+    const message = {
+      ...baseGetInstalledPackageSummariesResponse,
+    } as GetInstalledPackageSummariesResponse;
+    message.installedPackageSummaries = [];
+    if (
+      object.installedPackageSummaries !== undefined &&
+      object.installedPackageSummaries !== null
+    ) {
+      for (const e of object.installedPackageSummaries) {
+        message.installedPackageSummaries.push(InstalledPackageSummary.fromJSON(e));
+      }
+    }
+    if (object.nextPageToken !== undefined && object.nextPageToken !== null) {
+      message.nextPageToken = String(object.nextPageToken);
+    } else {
+      message.nextPageToken = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageSummariesResponse): unknown {
+    const obj: any = {};
+    if (message.installedPackageSummaries) {
+      obj.installedPackageSummaries = message.installedPackageSummaries.map(e =>
+        e ? InstalledPackageSummary.toJSON(e) : undefined,
+      );
+    } else {
+      obj.installedPackageSummaries = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageSummariesResponse>,
+  ): GetInstalledPackageSummariesResponse {
+    const message = {
+      ...baseGetInstalledPackageSummariesResponse,
+    } as GetInstalledPackageSummariesResponse;
+    message.installedPackageSummaries = [];
+    if (
+      object.installedPackageSummaries !== undefined &&
+      object.installedPackageSummaries !== null
+    ) {
+      for (const e of object.installedPackageSummaries) {
+        message.installedPackageSummaries.push(InstalledPackageSummary.fromPartial(e));
+      }
+    }
+    if (object.nextPageToken !== undefined && object.nextPageToken !== null) {
+      message.nextPageToken = object.nextPageToken;
+    } else {
+      message.nextPageToken = "";
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageDetailResponse: object = {};
+
+export const GetInstalledPackageDetailResponse = {
+  encode(
+    message: GetInstalledPackageDetailResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageDetail !== undefined) {
+      InstalledPackageDetail.encode(
+        message.installedPackageDetail,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDetailResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageDetailResponse,
+    } as GetInstalledPackageDetailResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageDetail = InstalledPackageDetail.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageDetailResponse {
+    const message = {
+      ...baseGetInstalledPackageDetailResponse,
+    } as GetInstalledPackageDetailResponse;
+    if (object.installedPackageDetail !== undefined && object.installedPackageDetail !== null) {
+      message.installedPackageDetail = InstalledPackageDetail.fromJSON(
+        object.installedPackageDetail,
+      );
+    } else {
+      message.installedPackageDetail = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageDetailResponse): unknown {
+    const obj: any = {};
+    message.installedPackageDetail !== undefined &&
+      (obj.installedPackageDetail = message.installedPackageDetail
+        ? InstalledPackageDetail.toJSON(message.installedPackageDetail)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageDetailResponse>,
+  ): GetInstalledPackageDetailResponse {
+    const message = {
+      ...baseGetInstalledPackageDetailResponse,
+    } as GetInstalledPackageDetailResponse;
+    if (object.installedPackageDetail !== undefined && object.installedPackageDetail !== null) {
+      message.installedPackageDetail = InstalledPackageDetail.fromPartial(
+        object.installedPackageDetail,
+      );
+    } else {
+      message.installedPackageDetail = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageDetailsBatchResponse: object = {};
+
+export const GetInstalledPackageDetailsBatchResponse = {
+  encode(
+    message: GetInstalledPackageDetailsBatchResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.results) {
+      GetInstalledPackageDetailResult.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDetailsBatchResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageDetailsBatchResponse,
+    } as GetInstalledPackageDetailsBatchResponse;
+    message.results = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.results.push(GetInstalledPackageDetailResult.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageDetailsBatchResponse {
+    const message = {
+      ...baseGetInstalledPackageDetailsBatchResponse,
+    } as GetInstalledPackageDetailsBatchResponse;
+    message.results = [];
+    if (object.results !== undefined && object.results !== null) {
+      for (const e of object.results) {
+        message.results.push(GetInstalledPackageDetailResult.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageDetailsBatchResponse): unknown {
+    const obj: any = {};
+    if (message.results) {
+      obj.results = message.results.map(e =>
+        e ? GetInstalledPackageDetailResult.toJSON(e) : undefined,
+      );
+    } else {
+      obj.results = [];
+    }
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageDetailsBatchResponse>,
+  ): GetInstalledPackageDetailsBatchResponse {
+    const message = {
+      ...baseGetInstalledPackageDetailsBatchResponse,
+    } as GetInstalledPackageDetailsBatchResponse;
+    message.results = [];
+    if (object.results !== undefined && object.results !== null) {
+      for (const e of object.results) {
+        message.results.push(GetInstalledPackageDetailResult.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageValuesResponse: object = { values: "" };
+
+export const GetInstalledPackageValuesResponse = {
+  encode(
+    message: GetInstalledPackageValuesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.values !== "") {
+      writer.uint32(10).string(message.values);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageValuesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageValuesResponse,
+    } as GetInstalledPackageValuesResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.values = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageValuesResponse {
+    const message = {
+      ...baseGetInstalledPackageValuesResponse,
+    } as GetInstalledPackageValuesResponse;
+    if (object.values !== undefined && object.values !== null) {
+      message.values = String(object.values);
+    } else {
+      message.values = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageValuesResponse): unknown {
+    const obj: any = {};
+    message.values !== undefined && (obj.values = message.values);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageValuesResponse>,
+  ): GetInstalledPackageValuesResponse {
+    const message = {
+      ...baseGetInstalledPackageValuesResponse,
+    } as GetInstalledPackageValuesResponse;
+    if (object.values !== undefined && object.values !== null) {
+      message.values = object.values;
+    } else {
+      message.values = "";
+    }
+    return message;
+  },
+};
+
+const baseCreateInstalledPackageResponse: object = {};
+
+export const CreateInstalledPackageResponse = {
+  encode(
+    message: CreateInstalledPackageResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateInstalledPackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseCreateInstalledPackageResponse,
+    } as CreateInstalledPackageResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateInstalledPackageResponse {
+    const message = {
+      ...baseCreateInstalledPackageResponse,
+    } as CreateInstalledPackageResponse;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: CreateInstalledPackageResponse): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<CreateInstalledPackageResponse>): CreateInstalledPackageResponse {
+    const message = {
+      ...baseCreateInstalledPackageResponse,
+    } as CreateInstalledPackageResponse;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+};
+
+const baseUpdateInstalledPackageResponse: object = {};
+
+export const UpdateInstalledPackageResponse = {
+  encode(
+    message: UpdateInstalledPackageResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateInstalledPackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseUpdateInstalledPackageResponse,
+    } as UpdateInstalledPackageResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateInstalledPackageResponse {
+    const message = {
+      ...baseUpdateInstalledPackageResponse,
+    } as UpdateInstalledPackageResponse;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: UpdateInstalledPackageResponse): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<UpdateInstalledPackageResponse>): UpdateInstalledPackageResponse {
+    const message = {
+      ...baseUpdateInstalledPackageResponse,
+    } as UpdateInstalledPackageResponse;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageUpgradeDiffResponse: object = { diff: "" };
+
+export const GetInstalledPackageUpgradeDiffResponse = {
+  encode(
+    message: GetInstalledPackageUpgradeDiffResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.diff !== "") {
+      writer.uint32(10).string(message.diff);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageUpgradeDiffResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageUpgradeDiffResponse,
+    } as GetInstalledPackageUpgradeDiffResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.diff = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageUpgradeDiffResponse {
+    const message = {
+      ...baseGetInstalledPackageUpgradeDiffResponse,
+    } as GetInstalledPackageUpgradeDiffResponse;
+    if (object.diff !== undefined && object.diff !== null) {
+      message.diff = String(object.diff);
+    } else {
+      message.diff = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageUpgradeDiffResponse): unknown {
+    const obj: any = {};
+    message.diff !== undefined && (obj.diff = message.diff);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageUpgradeDiffResponse>,
+  ): GetInstalledPackageUpgradeDiffResponse {
+    const message = {
+      ...baseGetInstalledPackageUpgradeDiffResponse,
+    } as GetInstalledPackageUpgradeDiffResponse;
+    if (object.diff !== undefined && object.diff !== null) {
+      message.diff = object.diff;
+    } else {
+      message.diff = "";
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageDriftResponse: object = { drifted: false };
+
+export const GetInstalledPackageDriftResponse = {
+  encode(
+    message: GetInstalledPackageDriftResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.drifted === true) {
+      writer.uint32(8).bool(message.drifted);
+    }
+    for (const v of message.resources) {
+      ResourceDrift.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDriftResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageDriftResponse,
+    } as GetInstalledPackageDriftResponse;
+    message.resources = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.drifted = reader.bool();
+          break;
+        case 2:
+          message.resources.push(ResourceDrift.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageDriftResponse {
+    const message = {
+      ...baseGetInstalledPackageDriftResponse,
+    } as GetInstalledPackageDriftResponse;
+    message.resources = [];
+    if (object.drifted !== undefined && object.drifted !== null) {
+      message.drifted = Boolean(object.drifted);
+    } else {
+      message.drifted = false;
+    }
+    if (object.resources !== undefined && object.resources !== null) {
+      for (const e of object.resources) {
+        message.resources.push(ResourceDrift.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageDriftResponse): unknown {
+    const obj: any = {};
+    message.drifted !== undefined && (obj.drifted = message.drifted);
+    if (message.resources) {
+      obj.resources = message.resources.map(e => (e ? ResourceDrift.toJSON(e) : undefined));
+    } else {
+      obj.resources = [];
+    }
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageDriftResponse>,
+  ): GetInstalledPackageDriftResponse {
+    const message = {
+      ...baseGetInstalledPackageDriftResponse,
+    } as GetInstalledPackageDriftResponse;
+    message.resources = [];
+    if (object.drifted !== undefined && object.drifted !== null) {
+      message.drifted = object.drifted;
+    } else {
+      message.drifted = false;
+    }
+    if (object.resources !== undefined && object.resources !== null) {
+      for (const e of object.resources) {
+        message.resources.push(ResourceDrift.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseGetInstalledPackageUpdateAvailableResponse: object = {
+  updateAvailable: false,
+};
+
+export const GetInstalledPackageUpdateAvailableResponse = {
+  encode(
+    message: GetInstalledPackageUpdateAvailableResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.updateAvailable === true) {
+      writer.uint32(8).bool(message.updateAvailable);
+    }
+    if (message.latestVersion !== undefined) {
+      PackageAppVersion.encode(message.latestVersion, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number,
+  ): GetInstalledPackageUpdateAvailableResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetInstalledPackageUpdateAvailableResponse,
+    } as GetInstalledPackageUpdateAvailableResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.updateAvailable = reader.bool();
+          break;
+        case 2:
+          message.latestVersion = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageUpdateAvailableResponse {
+    const message = {
+      ...baseGetInstalledPackageUpdateAvailableResponse,
+    } as GetInstalledPackageUpdateAvailableResponse;
+    if (object.updateAvailable !== undefined && object.updateAvailable !== null) {
+      message.updateAvailable = Boolean(object.updateAvailable);
+    } else {
+      message.updateAvailable = false;
+    }
+    if (object.latestVersion !== undefined && object.latestVersion !== null) {
+      message.latestVersion = PackageAppVersion.fromJSON(object.latestVersion);
+    } else {
+      message.latestVersion = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetInstalledPackageUpdateAvailableResponse): unknown {
+    const obj: any = {};
+    message.updateAvailable !== undefined && (obj.updateAvailable = message.updateAvailable);
+    message.latestVersion !== undefined &&
+      (obj.latestVersion = message.latestVersion
+        ? PackageAppVersion.toJSON(message.latestVersion)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageUpdateAvailableResponse>,
+  ): GetInstalledPackageUpdateAvailableResponse {
+    const message = {
+      ...baseGetInstalledPackageUpdateAvailableResponse,
+    } as GetInstalledPackageUpdateAvailableResponse;
+    if (object.updateAvailable !== undefined && object.updateAvailable !== null) {
+      message.updateAvailable = object.updateAvailable;
+    } else {
+      message.updateAvailable = false;
+    }
+    if (object.latestVersion !== undefined && object.latestVersion !== null) {
+      message.latestVersion = PackageAppVersion.fromPartial(object.latestVersion);
+    } else {
+      message.latestVersion = undefined;
+    }
+    return message;
+  },
+};
+
+const baseExportInstalledPackageResponse: object = { manifest: "" };
+
+export const ExportInstalledPackageResponse = {
+  encode(
+    message: ExportInstalledPackageResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.manifest !== "") {
+      writer.uint32(10).string(message.manifest);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportInstalledPackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseExportInstalledPackageResponse,
+    } as ExportInstalledPackageResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.manifest = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExportInstalledPackageResponse {
+    const message = {
+      ...baseExportInstalledPackageResponse,
+    } as ExportInstalledPackageResponse;
+    if (object.manifest !== undefined && object.manifest !== null) {
+      message.manifest = String(object.manifest);
+    } else {
+      message.manifest = "";
+    }
+    return message;
+  },
+
+  toJSON(message: ExportInstalledPackageResponse): unknown {
+    const obj: any = {};
+    message.manifest !== undefined && (obj.manifest = message.manifest);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<ExportInstalledPackageResponse>): ExportInstalledPackageResponse {
+    const message = {
+      ...baseExportInstalledPackageResponse,
+    } as ExportInstalledPackageResponse;
+    if (object.manifest !== undefined && object.manifest !== null) {
+      message.manifest = object.manifest;
+    } else {
+      message.manifest = "";
+    }
+    return message;
+  },
+};
+
+const baseDeleteInstalledPackageResponse: object = {};
+
+export const DeleteInstalledPackageResponse = {
+  encode(_: DeleteInstalledPackageResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseDeleteInstalledPackageResponse,
+    } as DeleteInstalledPackageResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(_: any): DeleteInstalledPackageResponse {
+    const message = {
+      ...baseDeleteInstalledPackageResponse,
+    } as DeleteInstalledPackageResponse;
+    return message;
+  },
+
+  toJSON(_: DeleteInstalledPackageResponse): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  fromPartial(_: DeepPartial<DeleteInstalledPackageResponse>): DeleteInstalledPackageResponse {
+    const message = {
+      ...baseDeleteInstalledPackageResponse,
+    } as DeleteInstalledPackageResponse;
+    return message;
+  },
+};
+
+const baseDeleteInstalledPackagesBatchResponse: object = {};
+
+export const DeleteInstalledPackagesBatchResponse = {
+  encode(
+    message: DeleteInstalledPackagesBatchResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.results) {
+      DeleteInstalledPackageResult.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackagesBatchResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseDeleteInstalledPackagesBatchResponse,
+    } as DeleteInstalledPackagesBatchResponse;
+    message.results = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.results.push(DeleteInstalledPackageResult.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeleteInstalledPackagesBatchResponse {
+    const message = {
+      ...baseDeleteInstalledPackagesBatchResponse,
+    } as DeleteInstalledPackagesBatchResponse;
+    message.results = [];
+    if (object.results !== undefined && object.results !== null) {
+      for (const e of object.results) {
+        message.results.push(DeleteInstalledPackageResult.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: DeleteInstalledPackagesBatchResponse): unknown {
+    const obj: any = {};
+    if (message.results) {
+      obj.results = message.results.map(e =>
+        e ? DeleteInstalledPackageResult.toJSON(e) : undefined,
+      );
+    } else {
+      obj.results = [];
+    }
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<DeleteInstalledPackagesBatchResponse>,
+  ): DeleteInstalledPackagesBatchResponse {
+    const message = {
+      ...baseDeleteInstalledPackagesBatchResponse,
+    } as DeleteInstalledPackagesBatchResponse;
+    message.results = [];
+    if (object.results !== undefined && object.results !== null) {
+      for (const e of object.results) {
+        message.results.push(DeleteInstalledPackageResult.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseGetCatalogStatsResponse: object = {};
+
+export const GetCatalogStatsResponse = {
+  encode(message: GetCatalogStatsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.catalogStats !== undefined) {
+      CatalogStats.encode(message.catalogStats, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetCatalogStatsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetCatalogStatsResponse,
+    } as GetCatalogStatsResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.catalogStats = CatalogStats.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetCatalogStatsResponse {
+    const message = {
+      ...baseGetCatalogStatsResponse,
+    } as GetCatalogStatsResponse;
+    if (object.catalogStats !== undefined && object.catalogStats !== null) {
+      message.catalogStats = CatalogStats.fromJSON(object.catalogStats);
+    } else {
+      message.catalogStats = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: GetCatalogStatsResponse): unknown {
+    const obj: any = {};
+    message.catalogStats !== undefined &&
+      (obj.catalogStats = message.catalogStats
+        ? CatalogStats.toJSON(message.catalogStats)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<GetCatalogStatsResponse>): GetCatalogStatsResponse {
+    const message = {
+      ...baseGetCatalogStatsResponse,
+    } as GetCatalogStatsResponse;
+    if (object.catalogStats !== undefined && object.catalogStats !== null) {
+      message.catalogStats = CatalogStats.fromPartial(object.catalogStats);
+    } else {
+      message.catalogStats = undefined;
+    }
+    return message;
+  },
+};
+
+const baseGetRecentActivityResponse: object = { nextPageToken: "" };
+
+export const GetRecentActivityResponse = {
+  encode(message: GetRecentActivityResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.activities) {
+      PackageActivity.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetRecentActivityResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetRecentActivityResponse,
+    } as GetRecentActivityResponse;
+    message.activities = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.activities.push(PackageActivity.decode(reader, reader.uint32()));
+          break;
+        case 2:
+          message.nextPageToken = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetRecentActivityResponse {
+    const message = {
+      ...baseGetRecentActivityResponse,
+    } as GetRecentActivityResponse;
+    message.activities = [];
+    if (object.activities !== undefined && object.activities !== null) {
+      for (const e of object.activities) {
+        message.activities.push(PackageActivity.fromJSON(e));
+      }
+    }
+    if (object.nextPageToken !== undefined && object.nextPageToken !== null) {
+      message.nextPageToken = String(object.nextPageToken);
+    } else {
+      message.nextPageToken = "";
+    }
+    return message;
+  },
+
+  toJSON(message: GetRecentActivityResponse): unknown {
+    const obj: any = {};
+    if (message.activities) {
+      obj.activities = message.activities.map(e => (e ? PackageActivity.toJSON(e) : undefined));
+    } else {
+      obj.activities = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<GetRecentActivityResponse>): GetRecentActivityResponse {
+    const message = {
+      ...baseGetRecentActivityResponse,
+    } as GetRecentActivityResponse;
+    message.activities = [];
+    if (object.activities !== undefined && object.activities !== null) {
+      for (const e of object.activities) {
+        message.activities.push(PackageActivity.fromPartial(e));
+      }
+    }
+    if (object.nextPageToken !== undefined && object.nextPageToken !== null) {
+      message.nextPageToken = object.nextPageToken;
+    } else {
+      message.nextPageToken = "";
+    }
+    return message;
+  },
+};
+
+const baseGetUserPermissionsResponse: object = {
+  canInstall: false,
+  canUpdate: false,
+  canDelete: false,
+};
+
+export const GetUserPermissionsResponse = {
+  encode(
+    message: GetUserPermissionsResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.canInstall === true) {
+      writer.uint32(8).bool(message.canInstall);
+    }
+    if (message.canUpdate === true) {
+      writer.uint32(16).bool(message.canUpdate);
+    }
+    if (message.canDelete === true) {
+      writer.uint32(24).bool(message.canDelete);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetUserPermissionsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetUserPermissionsResponse,
+    } as GetUserPermissionsResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.canInstall = reader.bool();
+          break;
+        case 2:
+          message.canUpdate = reader.bool();
+          break;
+        case 3:
+          message.canDelete = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetUserPermissionsResponse {
+    const message = {
+      ...baseGetUserPermissionsResponse,
+    } as GetUserPermissionsResponse;
+    if (object.canInstall !== undefined && object.canInstall !== null) {
+      message.canInstall = Boolean(object.canInstall);
+    } else {
+      message.canInstall = false;
+    }
+    if (object.canUpdate !== undefined && object.canUpdate !== null) {
+      message.canUpdate = Boolean(object.canUpdate);
+    } else {
+      message.canUpdate = false;
+    }
+    if (object.canDelete !== undefined && object.canDelete !== null) {
+      message.canDelete = Boolean(object.canDelete);
+    } else {
+      message.canDelete = false;
+    }
+    return message;
+  },
+
+  toJSON(message: GetUserPermissionsResponse): unknown {
+    const obj: any = {};
+    message.canInstall !== undefined && (obj.canInstall = message.canInstall);
+    message.canUpdate !== undefined && (obj.canUpdate = message.canUpdate);
+    message.canDelete !== undefined && (obj.canDelete = message.canDelete);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<GetUserPermissionsResponse>): GetUserPermissionsResponse {
+    const message = {
+      ...baseGetUserPermissionsResponse,
+    } as GetUserPermissionsResponse;
+    if (object.canInstall !== undefined && object.canInstall !== null) {
+      message.canInstall = object.canInstall;
+    } else {
+      message.canInstall = false;
+    }
+    if (object.canUpdate !== undefined && object.canUpdate !== null) {
+      message.canUpdate = object.canUpdate;
+    } else {
+      message.canUpdate = false;
+    }
+    if (object.canDelete !== undefined && object.canDelete !== null) {
+      message.canDelete = object.canDelete;
+    } else {
+      message.canDelete = false;
+    }
+    return message;
+  },
+};
+
+const baseGetCatalogIndexResponse: object = { packageCount: 0 };
+
+export const GetCatalogIndexResponse = {
+  encode(message: GetCatalogIndexResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.index.length !== 0) {
+      writer.uint32(10).bytes(message.index);
+    }
+    if (message.packageCount !== 0) {
+      writer.uint32(16).int32(message.packageCount);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetCatalogIndexResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetCatalogIndexResponse,
+    } as GetCatalogIndexResponse;
+    message.index = new Uint8Array();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.index = reader.bytes();
+          break;
+        case 2:
+          message.packageCount = reader.int32();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetCatalogIndexResponse {
+    const message = {
+      ...baseGetCatalogIndexResponse,
+    } as GetCatalogIndexResponse;
+    message.index = new Uint8Array();
+    if (object.index !== undefined && object.index !== null) {
+      message.index = bytesFromBase64(object.index);
+    }
+    if (object.packageCount !== undefined && object.packageCount !== null) {
+      message.packageCount = Number(object.packageCount);
+    } else {
+      message.packageCount = 0;
+    }
+    return message;
+  },
+
+  toJSON(message: GetCatalogIndexResponse): unknown {
+    const obj: any = {};
+    message.index !== undefined &&
+      (obj.index = base64FromBytes(message.index !== undefined ? message.index : new Uint8Array()));
+    message.packageCount !== undefined && (obj.packageCount = message.packageCount);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<GetCatalogIndexResponse>): GetCatalogIndexResponse {
+    const message = {
+      ...baseGetCatalogIndexResponse,
+    } as GetCatalogIndexResponse;
+    if (object.index !== undefined && object.index !== null) {
+      message.index = object.index;
+    } else {
+      message.index = new Uint8Array();
+    }
+    if (object.packageCount !== undefined && object.packageCount !== null) {
+      message.packageCount = object.packageCount;
+    } else {
+      message.packageCount = 0;
+    }
+    return message;
+  },
+};
+
+const baseAvailablePackageSummary: object = {
+  name: "",
+  iconUrl: "",
+  displayName: "",
+  shortDescription: "",
+  categories: "",
+  installedCount: 0,
+  deprecated: false,
+  deprecationMessage: "",
+};
+
+export const AvailablePackageSummary = {
+  encode(message: AvailablePackageSummary, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.latestVersion !== undefined) {
+      PackageAppVersion.encode(message.latestVersion, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.iconUrl !== "") {
+      writer.uint32(34).string(message.iconUrl);
+    }
+    if (message.displayName !== "") {
+      writer.uint32(42).string(message.displayName);
+    }
+    if (message.shortDescription !== "") {
+      writer.uint32(50).string(message.shortDescription);
+    }
+    for (const v of message.categories) {
+      writer.uint32(58).string(v!);
+    }
+    if (message.installedCount !== 0) {
+      writer.uint32(64).int32(message.installedCount);
+    }
+    if (message.repository !== undefined) {
+      PackageRepositoryReference.encode(message.repository, writer.uint32(74).fork()).ldelim();
+    }
+    for (const v of message.localizedDescriptions) {
+      LocalizedDescription.encode(v!, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.deprecated === true) {
+      writer.uint32(88).bool(message.deprecated);
+    }
+    if (message.deprecationMessage !== "") {
+      writer.uint32(98).string(message.deprecationMessage);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AvailablePackageSummary {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseAvailablePackageSummary,
+    } as AvailablePackageSummary;
+    message.categories = [];
+    message.localizedDescriptions = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.name = reader.string();
+          break;
+        case 3:
+          message.latestVersion = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        case 4:
+          message.iconUrl = reader.string();
+          break;
+        case 5:
+          message.displayName = reader.string();
+          break;
+        case 6:
+          message.shortDescription = reader.string();
+          break;
+        case 7:
+          message.categories.push(reader.string());
+          break;
+        case 8:
+          message.installedCount = reader.int32();
+          break;
+        case 9:
+          message.repository = PackageRepositoryReference.decode(reader, reader.uint32());
+          break;
+        case 10:
+          message.localizedDescriptions.push(LocalizedDescription.decode(reader, reader.uint32()));
+          break;
+        case 11:
+          message.deprecated = reader.bool();
+          break;
+        case 12:
+          message.deprecationMessage = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AvailablePackageSummary {
+    const message = {
+      ...baseAvailablePackageSummary,
+    } as AvailablePackageSummary;
+    message.categories = [];
+    message.localizedDescriptions = [];
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
+    } else {
+      message.name = "";
+    }
+    if (object.latestVersion !== undefined && object.latestVersion !== null) {
+      message.latestVersion = PackageAppVersion.fromJSON(object.latestVersion);
+    } else {
+      message.latestVersion = undefined;
+    }
+    if (object.iconUrl !== undefined && object.iconUrl !== null) {
+      message.iconUrl = String(object.iconUrl);
+    } else {
+      message.iconUrl = "";
+    }
+    if (object.displayName !== undefined && object.displayName !== null) {
+      message.displayName = String(object.displayName);
+    } else {
+      message.displayName = "";
+    }
+    if (object.shortDescription !== undefined && object.shortDescription !== null) {
+      message.shortDescription = String(object.shortDescription);
+    } else {
+      message.shortDescription = "";
+    }
+    if (object.categories !== undefined && object.categories !== null) {
+      for (const e of object.categories) {
+        message.categories.push(String(e));
+      }
+    }
+    if (object.installedCount !== undefined && object.installedCount !== null) {
+      message.installedCount = Number(object.installedCount);
+    } else {
+      message.installedCount = 0;
+    }
+    if (object.repository !== undefined && object.repository !== null) {
+      message.repository = PackageRepositoryReference.fromJSON(object.repository);
+    } else {
+      message.repository = undefined;
+    }
+    if (object.localizedDescriptions !== undefined && object.localizedDescriptions !== null) {
+      for (const e of object.localizedDescriptions) {
+        message.localizedDescriptions.push(LocalizedDescription.fromJSON(e));
+      }
+    }
+    if (object.deprecated !== undefined && object.deprecated !== null) {
+      message.deprecated = Boolean(object.deprecated);
+    } else {
+      message.deprecated = false;
+    }
+    if (object.deprecationMessage !== undefined && object.deprecationMessage !== null) {
+      message.deprecationMessage = String(object.deprecationMessage);
+    } else {
+      message.deprecationMessage = "";
+    }
+    return message;
+  },
+
+  toJSON(message: AvailablePackageSummary): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.name !== undefined && (obj.name = message.name);
+    message.latestVersion !== undefined &&
+      (obj.latestVersion = message.latestVersion
+        ? PackageAppVersion.toJSON(message.latestVersion)
+        : undefined);
+    message.iconUrl !== undefined && (obj.iconUrl = message.iconUrl);
+    message.displayName !== undefined && (obj.displayName = message.displayName);
+    message.shortDescription !== undefined && (obj.shortDescription = message.shortDescription);
+    if (message.categories) {
+      obj.categories = message.categories.map(e => e);
+    } else {
+      obj.categories = [];
+    }
+    message.installedCount !== undefined && (obj.installedCount = message.installedCount);
+    message.repository !== undefined &&
+      (obj.repository = message.repository
+        ? PackageRepositoryReference.toJSON(message.repository)
+        : undefined);
+    if (message.localizedDescriptions) {
+      obj.localizedDescriptions = message.localizedDescriptions.map(e =>
+        e ? LocalizedDescription.toJSON(e) : undefined,
+      );
+    } else {
+      obj.localizedDescriptions = [];
+    }
+    message.deprecated !== undefined && (obj.deprecated = message.deprecated);
+    message.deprecationMessage !== undefined &&
+      (obj.deprecationMessage = message.deprecationMessage);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<AvailablePackageSummary>): AvailablePackageSummary {
+    const message = {
+      ...baseAvailablePackageSummary,
+    } as AvailablePackageSummary;
+    message.categories = [];
+    message.localizedDescriptions = [];
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
+    } else {
+      message.name = "";
+    }
+    if (object.latestVersion !== undefined && object.latestVersion !== null) {
+      message.latestVersion = PackageAppVersion.fromPartial(object.latestVersion);
+    } else {
+      message.latestVersion = undefined;
+    }
+    if (object.iconUrl !== undefined && object.iconUrl !== null) {
+      message.iconUrl = object.iconUrl;
+    } else {
+      message.iconUrl = "";
+    }
+    if (object.displayName !== undefined && object.displayName !== null) {
+      message.displayName = object.displayName;
+    } else {
+      message.displayName = "";
+    }
+    if (object.shortDescription !== undefined && object.shortDescription !== null) {
+      message.shortDescription = object.shortDescription;
+    } else {
+      message.shortDescription = "";
+    }
+    if (object.categories !== undefined && object.categories !== null) {
+      for (const e of object.categories) {
+        message.categories.push(e);
+      }
+    }
+    if (object.installedCount !== undefined && object.installedCount !== null) {
+      message.installedCount = object.installedCount;
+    } else {
+      message.installedCount = 0;
+    }
+    if (object.repository !== undefined && object.repository !== null) {
+      message.repository = PackageRepositoryReference.fromPartial(object.repository);
+    } else {
+      message.repository = undefined;
+    }
+    if (object.localizedDescriptions !== undefined && object.localizedDescriptions !== null) {
+      for (const e of object.localizedDescriptions) {
+        message.localizedDescriptions.push(LocalizedDescription.fromPartial(e));
+      }
+    }
+    if (object.deprecated !== undefined && object.deprecated !== null) {
+      message.deprecated = object.deprecated;
+    } else {
+      message.deprecated = false;
+    }
+    if (object.deprecationMessage !== undefined && object.deprecationMessage !== null) {
+      message.deprecationMessage = object.deprecationMessage;
+    } else {
+      message.deprecationMessage = "";
+    }
+    return message;
+  },
+};
+
+const baseAvailablePackageDetail: object = {
+  name: "",
+  repoUrl: "",
+  homeUrl: "",
+  iconUrl: "",
+  displayName: "",
+  shortDescription: "",
+  longDescription: "",
+  readme: "",
+  defaultValues: "",
+  valuesSchema: "",
+  sourceUrls: "",
+  categories: "",
+  deprecated: false,
+  deprecationMessage: "",
+  kubeVersion: "",
+};
+
+export const AvailablePackageDetail = {
+  encode(message: AvailablePackageDetail, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.version !== undefined) {
+      PackageAppVersion.encode(message.version, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.repoUrl !== "") {
+      writer.uint32(34).string(message.repoUrl);
+    }
+    if (message.homeUrl !== "") {
+      writer.uint32(42).string(message.homeUrl);
+    }
+    if (message.iconUrl !== "") {
+      writer.uint32(50).string(message.iconUrl);
+    }
+    if (message.displayName !== "") {
+      writer.uint32(58).string(message.displayName);
+    }
+    if (message.shortDescription !== "") {
+      writer.uint32(66).string(message.shortDescription);
+    }
+    if (message.longDescription !== "") {
+      writer.uint32(74).string(message.longDescription);
+    }
+    if (message.readme !== "") {
+      writer.uint32(82).string(message.readme);
+    }
+    if (message.defaultValues !== "") {
+      writer.uint32(90).string(message.defaultValues);
+    }
+    if (message.valuesSchema !== "") {
+      writer.uint32(98).string(message.valuesSchema);
+    }
+    for (const v of message.sourceUrls) {
+      writer.uint32(106).string(v!);
+    }
+    for (const v of message.maintainers) {
+      Maintainer.encode(v!, writer.uint32(114).fork()).ldelim();
+    }
+    for (const v of message.categories) {
+      writer.uint32(122).string(v!);
+    }
+    if (message.customDetail !== undefined) {
+      Any.encode(message.customDetail, writer.uint32(130).fork()).ldelim();
+    }
+    if (message.provenance !== undefined) {
+      PackageProvenance.encode(message.provenance, writer.uint32(138).fork()).ldelim();
+    }
+    for (const v of message.localizedDescriptions) {
+      LocalizedDescription.encode(v!, writer.uint32(146).fork()).ldelim();
+    }
+    if (message.deprecated === true) {
+      writer.uint32(152).bool(message.deprecated);
+    }
+    if (message.deprecationMessage !== "") {
+      writer.uint32(162).string(message.deprecationMessage);
+    }
+    if (message.kubeVersion !== "") {
+      writer.uint32(170).string(message.kubeVersion);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AvailablePackageDetail {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseAvailablePackageDetail } as AvailablePackageDetail;
+    message.sourceUrls = [];
+    message.maintainers = [];
+    message.categories = [];
+    message.localizedDescriptions = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.name = reader.string();
+          break;
         case 3:
-          message.paginationOptions = PaginationOptions.decode(reader, reader.uint32());
+          message.version = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        case 4:
+          message.repoUrl = reader.string();
+          break;
+        case 5:
+          message.homeUrl = reader.string();
+          break;
+        case 6:
+          message.iconUrl = reader.string();
+          break;
+        case 7:
+          message.displayName = reader.string();
+          break;
+        case 8:
+          message.shortDescription = reader.string();
+          break;
+        case 9:
+          message.longDescription = reader.string();
+          break;
+        case 10:
+          message.readme = reader.string();
+          break;
+        case 11:
+          message.defaultValues = reader.string();
+          break;
+        case 12:
+          message.valuesSchema = reader.string();
+          break;
+        case 13:
+          message.sourceUrls.push(reader.string());
+          break;
+        case 14:
+          message.maintainers.push(Maintainer.decode(reader, reader.uint32()));
+          break;
+        case 15:
+          message.categories.push(reader.string());
+          break;
+        case 16:
+          message.customDetail = Any.decode(reader, reader.uint32());
+          break;
+        case 17:
+          message.provenance = PackageProvenance.decode(reader, reader.uint32());
+          break;
+        case 18:
+          message.localizedDescriptions.push(LocalizedDescription.decode(reader, reader.uint32()));
+          break;
+        case 19:
+          message.deprecated = reader.bool();
+          break;
+        case 20:
+          message.deprecationMessage = reader.string();
+          break;
+        case 21:
+          message.kubeVersion = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -992,192 +8167,389 @@ export const GetAvailablePackageSummariesRequest = {
     return message;
   },
 
-  fromJSON(object: any): GetAvailablePackageSummariesRequest {
-    const message = {
-      ...baseGetAvailablePackageSummariesRequest,
-    } as GetAvailablePackageSummariesRequest;
-    if (object.context !== undefined && object.context !== null) {
-      message.context = Context.fromJSON(object.context);
+  fromJSON(object: any): AvailablePackageDetail {
+    const message = { ...baseAvailablePackageDetail } as AvailablePackageDetail;
+    message.sourceUrls = [];
+    message.maintainers = [];
+    message.categories = [];
+    message.localizedDescriptions = [];
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
     } else {
-      message.context = undefined;
+      message.availablePackageRef = undefined;
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
+    } else {
+      message.name = "";
+    }
+    if (object.version !== undefined && object.version !== null) {
+      message.version = PackageAppVersion.fromJSON(object.version);
+    } else {
+      message.version = undefined;
+    }
+    if (object.repoUrl !== undefined && object.repoUrl !== null) {
+      message.repoUrl = String(object.repoUrl);
+    } else {
+      message.repoUrl = "";
+    }
+    if (object.homeUrl !== undefined && object.homeUrl !== null) {
+      message.homeUrl = String(object.homeUrl);
+    } else {
+      message.homeUrl = "";
+    }
+    if (object.iconUrl !== undefined && object.iconUrl !== null) {
+      message.iconUrl = String(object.iconUrl);
+    } else {
+      message.iconUrl = "";
+    }
+    if (object.displayName !== undefined && object.displayName !== null) {
+      message.displayName = String(object.displayName);
+    } else {
+      message.displayName = "";
     }
-    if (object.filterOptions !== undefined && object.filterOptions !== null) {
-      message.filterOptions = FilterOptions.fromJSON(object.filterOptions);
+    if (object.shortDescription !== undefined && object.shortDescription !== null) {
+      message.shortDescription = String(object.shortDescription);
     } else {
-      message.filterOptions = undefined;
+      message.shortDescription = "";
     }
-    if (object.paginationOptions !== undefined && object.paginationOptions !== null) {
-      message.paginationOptions = PaginationOptions.fromJSON(object.paginationOptions);
+    if (object.longDescription !== undefined && object.longDescription !== null) {
+      message.longDescription = String(object.longDescription);
     } else {
-      message.paginationOptions = undefined;
+      message.longDescription = "";
     }
-    return message;
-  },
-
-  toJSON(message: GetAvailablePackageSummariesRequest): unknown {
-    const obj: any = {};
-    message.context !== undefined &&
-      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
-    message.filterOptions !== undefined &&
-      (obj.filterOptions = message.filterOptions
-        ? FilterOptions.toJSON(message.filterOptions)
-        : undefined);
-    message.paginationOptions !== undefined &&
-      (obj.paginationOptions = message.paginationOptions
-        ? PaginationOptions.toJSON(message.paginationOptions)
-        : undefined);
-    return obj;
-  },
-
-  fromPartial(
-    object: DeepPartial<GetAvailablePackageSummariesRequest>,
-  ): GetAvailablePackageSummariesRequest {
-    const message = {
-      ...baseGetAvailablePackageSummariesRequest,
-    } as GetAvailablePackageSummariesRequest;
-    if (object.context !== undefined && object.context !== null) {
-      message.context = Context.fromPartial(object.context);
+    if (object.readme !== undefined && object.readme !== null) {
+      message.readme = String(object.readme);
     } else {
-      message.context = undefined;
+      message.readme = "";
     }
-    if (object.filterOptions !== undefined && object.filterOptions !== null) {
-      message.filterOptions = FilterOptions.fromPartial(object.filterOptions);
+    if (object.defaultValues !== undefined && object.defaultValues !== null) {
+      message.defaultValues = String(object.defaultValues);
     } else {
-      message.filterOptions = undefined;
+      message.defaultValues = "";
     }
-    if (object.paginationOptions !== undefined && object.paginationOptions !== null) {
-      message.paginationOptions = PaginationOptions.fromPartial(object.paginationOptions);
+    if (object.valuesSchema !== undefined && object.valuesSchema !== null) {
+      message.valuesSchema = String(object.valuesSchema);
     } else {
-      message.paginationOptions = undefined;
+      message.valuesSchema = "";
     }
-    return message;
-  },
-};
-
-const baseGetAvailablePackageDetailRequest: object = { pkgVersion: "" };
-
-export const GetAvailablePackageDetailRequest = {
-  encode(
-    message: GetAvailablePackageDetailRequest,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.availablePackageRef !== undefined) {
-      AvailablePackageReference.encode(
-        message.availablePackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
+    if (object.sourceUrls !== undefined && object.sourceUrls !== null) {
+      for (const e of object.sourceUrls) {
+        message.sourceUrls.push(String(e));
+      }
     }
-    if (message.pkgVersion !== "") {
-      writer.uint32(18).string(message.pkgVersion);
+    if (object.maintainers !== undefined && object.maintainers !== null) {
+      for (const e of object.maintainers) {
+        message.maintainers.push(Maintainer.fromJSON(e));
+      }
     }
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageDetailRequest {
-    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseGetAvailablePackageDetailRequest,
-    } as GetAvailablePackageDetailRequest;
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
-          break;
-        case 2:
-          message.pkgVersion = reader.string();
-          break;
-        default:
-          reader.skipType(tag & 7);
-          break;
+    if (object.categories !== undefined && object.categories !== null) {
+      for (const e of object.categories) {
+        message.categories.push(String(e));
       }
     }
-    return message;
-  },
-
-  fromJSON(object: any): GetAvailablePackageDetailRequest {
-    const message = {
-      ...baseGetAvailablePackageDetailRequest,
-    } as GetAvailablePackageDetailRequest;
-    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
-      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    if (object.customDetail !== undefined && object.customDetail !== null) {
+      message.customDetail = Any.fromJSON(object.customDetail);
     } else {
-      message.availablePackageRef = undefined;
+      message.customDetail = undefined;
     }
-    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
-      message.pkgVersion = String(object.pkgVersion);
+    if (object.provenance !== undefined && object.provenance !== null) {
+      message.provenance = PackageProvenance.fromJSON(object.provenance);
     } else {
-      message.pkgVersion = "";
+      message.provenance = undefined;
+    }
+    if (object.localizedDescriptions !== undefined && object.localizedDescriptions !== null) {
+      for (const e of object.localizedDescriptions) {
+        message.localizedDescriptions.push(LocalizedDescription.fromJSON(e));
+      }
+    }
+    if (object.deprecated !== undefined && object.deprecated !== null) {
+      message.deprecated = Boolean(object.deprecated);
+    } else {
+      message.deprecated = false;
+    }
+    if (object.deprecationMessage !== undefined && object.deprecationMessage !== null) {
+      message.deprecationMessage = String(object.deprecationMessage);
+    } else {
+      message.deprecationMessage = "";
+    }
+    if (object.kubeVersion !== undefined && object.kubeVersion !== null) {
+      message.kubeVersion = String(object.kubeVersion);
+    } else {
+      message.kubeVersion = "";
     }
     return message;
   },
 
-  toJSON(message: GetAvailablePackageDetailRequest): unknown {
+  toJSON(message: AvailablePackageDetail): unknown {
     const obj: any = {};
     message.availablePackageRef !== undefined &&
       (obj.availablePackageRef = message.availablePackageRef
         ? AvailablePackageReference.toJSON(message.availablePackageRef)
         : undefined);
-    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.name !== undefined && (obj.name = message.name);
+    message.version !== undefined &&
+      (obj.version = message.version ? PackageAppVersion.toJSON(message.version) : undefined);
+    message.repoUrl !== undefined && (obj.repoUrl = message.repoUrl);
+    message.homeUrl !== undefined && (obj.homeUrl = message.homeUrl);
+    message.iconUrl !== undefined && (obj.iconUrl = message.iconUrl);
+    message.displayName !== undefined && (obj.displayName = message.displayName);
+    message.shortDescription !== undefined && (obj.shortDescription = message.shortDescription);
+    message.longDescription !== undefined && (obj.longDescription = message.longDescription);
+    message.readme !== undefined && (obj.readme = message.readme);
+    message.defaultValues !== undefined && (obj.defaultValues = message.defaultValues);
+    message.valuesSchema !== undefined && (obj.valuesSchema = message.valuesSchema);
+    if (message.sourceUrls) {
+      obj.sourceUrls = message.sourceUrls.map(e => e);
+    } else {
+      obj.sourceUrls = [];
+    }
+    if (message.maintainers) {
+      obj.maintainers = message.maintainers.map(e => (e ? Maintainer.toJSON(e) : undefined));
+    } else {
+      obj.maintainers = [];
+    }
+    if (message.categories) {
+      obj.categories = message.categories.map(e => e);
+    } else {
+      obj.categories = [];
+    }
+    message.customDetail !== undefined &&
+      (obj.customDetail = message.customDetail ? Any.toJSON(message.customDetail) : undefined);
+    message.provenance !== undefined &&
+      (obj.provenance = message.provenance
+        ? PackageProvenance.toJSON(message.provenance)
+        : undefined);
+    if (message.localizedDescriptions) {
+      obj.localizedDescriptions = message.localizedDescriptions.map(e =>
+        e ? LocalizedDescription.toJSON(e) : undefined,
+      );
+    } else {
+      obj.localizedDescriptions = [];
+    }
+    message.deprecated !== undefined && (obj.deprecated = message.deprecated);
+    message.deprecationMessage !== undefined &&
+      (obj.deprecationMessage = message.deprecationMessage);
+    message.kubeVersion !== undefined && (obj.kubeVersion = message.kubeVersion);
     return obj;
   },
 
-  fromPartial(
-    object: DeepPartial<GetAvailablePackageDetailRequest>,
-  ): GetAvailablePackageDetailRequest {
-    const message = {
-      ...baseGetAvailablePackageDetailRequest,
-    } as GetAvailablePackageDetailRequest;
+  fromPartial(object: DeepPartial<AvailablePackageDetail>): AvailablePackageDetail {
+    const message = { ...baseAvailablePackageDetail } as AvailablePackageDetail;
+    message.sourceUrls = [];
+    message.maintainers = [];
+    message.categories = [];
+    message.localizedDescriptions = [];
     if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
       message.availablePackageRef = AvailablePackageReference.fromPartial(
         object.availablePackageRef,
       );
     } else {
-      message.availablePackageRef = undefined;
+      message.availablePackageRef = undefined;
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
+    } else {
+      message.name = "";
+    }
+    if (object.version !== undefined && object.version !== null) {
+      message.version = PackageAppVersion.fromPartial(object.version);
+    } else {
+      message.version = undefined;
+    }
+    if (object.repoUrl !== undefined && object.repoUrl !== null) {
+      message.repoUrl = object.repoUrl;
+    } else {
+      message.repoUrl = "";
+    }
+    if (object.homeUrl !== undefined && object.homeUrl !== null) {
+      message.homeUrl = object.homeUrl;
+    } else {
+      message.homeUrl = "";
+    }
+    if (object.iconUrl !== undefined && object.iconUrl !== null) {
+      message.iconUrl = object.iconUrl;
+    } else {
+      message.iconUrl = "";
+    }
+    if (object.displayName !== undefined && object.displayName !== null) {
+      message.displayName = object.displayName;
+    } else {
+      message.displayName = "";
+    }
+    if (object.shortDescription !== undefined && object.shortDescription !== null) {
+      message.shortDescription = object.shortDescription;
+    } else {
+      message.shortDescription = "";
+    }
+    if (object.longDescription !== undefined && object.longDescription !== null) {
+      message.longDescription = object.longDescription;
+    } else {
+      message.longDescription = "";
+    }
+    if (object.readme !== undefined && object.readme !== null) {
+      message.readme = object.readme;
+    } else {
+      message.readme = "";
+    }
+    if (object.defaultValues !== undefined && object.defaultValues !== null) {
+      message.defaultValues = object.defaultValues;
+    } else {
+      message.defaultValues = "";
+    }
+    if (object.valuesSchema !== undefined && object.valuesSchema !== null) {
+      message.valuesSchema = object.valuesSchema;
+    } else {
+      message.valuesSchema = "";
+    }
+    if (object.sourceUrls !== undefined && object.sourceUrls !== null) {
+      for (const e of object.sourceUrls) {
+        message.sourceUrls.push(e);
+      }
+    }
+    if (object.maintainers !== undefined && object.maintainers !== null) {
+      for (const e of object.maintainers) {
+        message.maintainers.push(Maintainer.fromPartial(e));
+      }
+    }
+    if (object.categories !== undefined && object.categories !== null) {
+      for (const e of object.categories) {
+        message.categories.push(e);
+      }
+    }
+    if (object.customDetail !== undefined && object.customDetail !== null) {
+      message.customDetail = Any.fromPartial(object.customDetail);
+    } else {
+      message.customDetail = undefined;
+    }
+    if (object.provenance !== undefined && object.provenance !== null) {
+      message.provenance = PackageProvenance.fromPartial(object.provenance);
+    } else {
+      message.provenance = undefined;
+    }
+    if (object.localizedDescriptions !== undefined && object.localizedDescriptions !== null) {
+      for (const e of object.localizedDescriptions) {
+        message.localizedDescriptions.push(LocalizedDescription.fromPartial(e));
+      }
+    }
+    if (object.deprecated !== undefined && object.deprecated !== null) {
+      message.deprecated = object.deprecated;
+    } else {
+      message.deprecated = false;
     }
-    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
-      message.pkgVersion = object.pkgVersion;
+    if (object.deprecationMessage !== undefined && object.deprecationMessage !== null) {
+      message.deprecationMessage = object.deprecationMessage;
     } else {
-      message.pkgVersion = "";
+      message.deprecationMessage = "";
+    }
+    if (object.kubeVersion !== undefined && object.kubeVersion !== null) {
+      message.kubeVersion = object.kubeVersion;
+    } else {
+      message.kubeVersion = "";
     }
     return message;
   },
 };
 
-const baseGetAvailablePackageVersionsRequest: object = { pkgVersion: "" };
+const baseInstalledPackageSummary: object = {
+  name: "",
+  iconUrl: "",
+  pkgDisplayName: "",
+  shortDescription: "",
+};
 
-export const GetAvailablePackageVersionsRequest = {
-  encode(
-    message: GetAvailablePackageVersionsRequest,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
+export const InstalledPackageSummary = {
+  encode(message: InstalledPackageSummary, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.pkgVersionReference !== undefined) {
+      VersionReference.encode(message.pkgVersionReference, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.currentVersion !== undefined) {
+      PackageAppVersion.encode(message.currentVersion, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.iconUrl !== "") {
+      writer.uint32(42).string(message.iconUrl);
+    }
+    if (message.pkgDisplayName !== "") {
+      writer.uint32(50).string(message.pkgDisplayName);
+    }
+    if (message.shortDescription !== "") {
+      writer.uint32(58).string(message.shortDescription);
+    }
+    if (message.latestMatchingVersion !== undefined) {
+      PackageAppVersion.encode(message.latestMatchingVersion, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.latestVersion !== undefined) {
+      PackageAppVersion.encode(message.latestVersion, writer.uint32(74).fork()).ldelim();
+    }
+    if (message.status !== undefined) {
+      InstalledPackageStatus.encode(message.status, writer.uint32(82).fork()).ldelim();
+    }
+    for (const v of message.conflictingPlugins) {
+      Plugin.encode(v!, writer.uint32(90).fork()).ldelim();
+    }
     if (message.availablePackageRef !== undefined) {
       AvailablePackageReference.encode(
         message.availablePackageRef,
-        writer.uint32(10).fork(),
+        writer.uint32(98).fork(),
       ).ldelim();
     }
-    if (message.pkgVersion !== "") {
-      writer.uint32(18).string(message.pkgVersion);
-    }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageVersionsRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageSummary {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
     const message = {
-      ...baseGetAvailablePackageVersionsRequest,
-    } as GetAvailablePackageVersionsRequest;
+      ...baseInstalledPackageSummary,
+    } as InstalledPackageSummary;
+    message.conflictingPlugins = [];
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
         case 2:
-          message.pkgVersion = reader.string();
+          message.name = reader.string();
+          break;
+        case 3:
+          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
+          break;
+        case 4:
+          message.currentVersion = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        case 5:
+          message.iconUrl = reader.string();
+          break;
+        case 6:
+          message.pkgDisplayName = reader.string();
+          break;
+        case 7:
+          message.shortDescription = reader.string();
+          break;
+        case 8:
+          message.latestMatchingVersion = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        case 9:
+          message.latestVersion = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        case 10:
+          message.status = InstalledPackageStatus.decode(reader, reader.uint32());
+          break;
+        case 11:
+          message.conflictingPlugins.push(Plugin.decode(reader, reader.uint32()));
+          break;
+        case 12:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
           break;
         default:
           reader.skipType(tag & 7);
@@ -1187,205 +8559,121 @@ export const GetAvailablePackageVersionsRequest = {
     return message;
   },
 
-  fromJSON(object: any): GetAvailablePackageVersionsRequest {
+  fromJSON(object: any): InstalledPackageSummary {
     const message = {
-      ...baseGetAvailablePackageVersionsRequest,
-    } as GetAvailablePackageVersionsRequest;
-    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
-      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+      ...baseInstalledPackageSummary,
+    } as InstalledPackageSummary;
+    message.conflictingPlugins = [];
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
     } else {
-      message.availablePackageRef = undefined;
+      message.installedPackageRef = undefined;
     }
-    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
-      message.pkgVersion = String(object.pkgVersion);
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
     } else {
-      message.pkgVersion = "";
+      message.name = "";
     }
-    return message;
-  },
-
-  toJSON(message: GetAvailablePackageVersionsRequest): unknown {
-    const obj: any = {};
-    message.availablePackageRef !== undefined &&
-      (obj.availablePackageRef = message.availablePackageRef
-        ? AvailablePackageReference.toJSON(message.availablePackageRef)
-        : undefined);
-    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
-    return obj;
-  },
-
-  fromPartial(
-    object: DeepPartial<GetAvailablePackageVersionsRequest>,
-  ): GetAvailablePackageVersionsRequest {
-    const message = {
-      ...baseGetAvailablePackageVersionsRequest,
-    } as GetAvailablePackageVersionsRequest;
-    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
-      message.availablePackageRef = AvailablePackageReference.fromPartial(
-        object.availablePackageRef,
-      );
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = VersionReference.fromJSON(object.pkgVersionReference);
     } else {
-      message.availablePackageRef = undefined;
+      message.pkgVersionReference = undefined;
     }
-    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
-      message.pkgVersion = object.pkgVersion;
+    if (object.currentVersion !== undefined && object.currentVersion !== null) {
+      message.currentVersion = PackageAppVersion.fromJSON(object.currentVersion);
     } else {
-      message.pkgVersion = "";
-    }
-    return message;
-  },
-};
-
-const baseGetInstalledPackageSummariesRequest: object = {};
-
-export const GetInstalledPackageSummariesRequest = {
-  encode(
-    message: GetInstalledPackageSummariesRequest,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.context !== undefined) {
-      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.paginationOptions !== undefined) {
-      PaginationOptions.encode(message.paginationOptions, writer.uint32(18).fork()).ldelim();
-    }
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageSummariesRequest {
-    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseGetInstalledPackageSummariesRequest,
-    } as GetInstalledPackageSummariesRequest;
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          message.context = Context.decode(reader, reader.uint32());
-          break;
-        case 2:
-          message.paginationOptions = PaginationOptions.decode(reader, reader.uint32());
-          break;
-        default:
-          reader.skipType(tag & 7);
-          break;
-      }
+      message.currentVersion = undefined;
     }
-    return message;
-  },
-
-  fromJSON(object: any): GetInstalledPackageSummariesRequest {
-    const message = {
-      ...baseGetInstalledPackageSummariesRequest,
-    } as GetInstalledPackageSummariesRequest;
-    if (object.context !== undefined && object.context !== null) {
-      message.context = Context.fromJSON(object.context);
+    if (object.iconUrl !== undefined && object.iconUrl !== null) {
+      message.iconUrl = String(object.iconUrl);
     } else {
-      message.context = undefined;
+      message.iconUrl = "";
     }
-    if (object.paginationOptions !== undefined && object.paginationOptions !== null) {
-      message.paginationOptions = PaginationOptions.fromJSON(object.paginationOptions);
+    if (object.pkgDisplayName !== undefined && object.pkgDisplayName !== null) {
+      message.pkgDisplayName = String(object.pkgDisplayName);
     } else {
-      message.paginationOptions = undefined;
+      message.pkgDisplayName = "";
     }
-    return message;
-  },
-
-  toJSON(message: GetInstalledPackageSummariesRequest): unknown {
-    const obj: any = {};
-    message.context !== undefined &&
-      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
-    message.paginationOptions !== undefined &&
-      (obj.paginationOptions = message.paginationOptions
-        ? PaginationOptions.toJSON(message.paginationOptions)
-        : undefined);
-    return obj;
-  },
-
-  fromPartial(
-    object: DeepPartial<GetInstalledPackageSummariesRequest>,
-  ): GetInstalledPackageSummariesRequest {
-    const message = {
-      ...baseGetInstalledPackageSummariesRequest,
-    } as GetInstalledPackageSummariesRequest;
-    if (object.context !== undefined && object.context !== null) {
-      message.context = Context.fromPartial(object.context);
+    if (object.shortDescription !== undefined && object.shortDescription !== null) {
+      message.shortDescription = String(object.shortDescription);
     } else {
-      message.context = undefined;
+      message.shortDescription = "";
     }
-    if (object.paginationOptions !== undefined && object.paginationOptions !== null) {
-      message.paginationOptions = PaginationOptions.fromPartial(object.paginationOptions);
+    if (object.latestMatchingVersion !== undefined && object.latestMatchingVersion !== null) {
+      message.latestMatchingVersion = PackageAppVersion.fromJSON(object.latestMatchingVersion);
     } else {
-      message.paginationOptions = undefined;
+      message.latestMatchingVersion = undefined;
     }
-    return message;
-  },
-};
-
-const baseGetInstalledPackageDetailRequest: object = {};
-
-export const GetInstalledPackageDetailRequest = {
-  encode(
-    message: GetInstalledPackageDetailRequest,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.installedPackageRef !== undefined) {
-      InstalledPackageReference.encode(
-        message.installedPackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
+    if (object.latestVersion !== undefined && object.latestVersion !== null) {
+      message.latestVersion = PackageAppVersion.fromJSON(object.latestVersion);
+    } else {
+      message.latestVersion = undefined;
     }
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDetailRequest {
-    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseGetInstalledPackageDetailRequest,
-    } as GetInstalledPackageDetailRequest;
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
-          break;
-        default:
-          reader.skipType(tag & 7);
-          break;
+    if (object.status !== undefined && object.status !== null) {
+      message.status = InstalledPackageStatus.fromJSON(object.status);
+    } else {
+      message.status = undefined;
+    }
+    if (object.conflictingPlugins !== undefined && object.conflictingPlugins !== null) {
+      for (const e of object.conflictingPlugins) {
+        message.conflictingPlugins.push(Plugin.fromJSON(e));
       }
     }
-    return message;
-  },
-
-  fromJSON(object: any): GetInstalledPackageDetailRequest {
-    const message = {
-      ...baseGetInstalledPackageDetailRequest,
-    } as GetInstalledPackageDetailRequest;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
     } else {
-      message.installedPackageRef = undefined;
+      message.availablePackageRef = undefined;
     }
     return message;
   },
 
-  toJSON(message: GetInstalledPackageDetailRequest): unknown {
+  toJSON(message: InstalledPackageSummary): unknown {
     const obj: any = {};
     message.installedPackageRef !== undefined &&
       (obj.installedPackageRef = message.installedPackageRef
         ? InstalledPackageReference.toJSON(message.installedPackageRef)
         : undefined);
+    message.name !== undefined && (obj.name = message.name);
+    message.pkgVersionReference !== undefined &&
+      (obj.pkgVersionReference = message.pkgVersionReference
+        ? VersionReference.toJSON(message.pkgVersionReference)
+        : undefined);
+    message.currentVersion !== undefined &&
+      (obj.currentVersion = message.currentVersion
+        ? PackageAppVersion.toJSON(message.currentVersion)
+        : undefined);
+    message.iconUrl !== undefined && (obj.iconUrl = message.iconUrl);
+    message.pkgDisplayName !== undefined && (obj.pkgDisplayName = message.pkgDisplayName);
+    message.shortDescription !== undefined && (obj.shortDescription = message.shortDescription);
+    message.latestMatchingVersion !== undefined &&
+      (obj.latestMatchingVersion = message.latestMatchingVersion
+        ? PackageAppVersion.toJSON(message.latestMatchingVersion)
+        : undefined);
+    message.latestVersion !== undefined &&
+      (obj.latestVersion = message.latestVersion
+        ? PackageAppVersion.toJSON(message.latestVersion)
+        : undefined);
+    message.status !== undefined &&
+      (obj.status = message.status ? InstalledPackageStatus.toJSON(message.status) : undefined);
+    if (message.conflictingPlugins) {
+      obj.conflictingPlugins = message.conflictingPlugins.map(e =>
+        e ? Plugin.toJSON(e) : undefined,
+      );
+    } else {
+      obj.conflictingPlugins = [];
+    }
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
     return obj;
   },
 
-  fromPartial(
-    object: DeepPartial<GetInstalledPackageDetailRequest>,
-  ): GetInstalledPackageDetailRequest {
+  fromPartial(object: DeepPartial<InstalledPackageSummary>): InstalledPackageSummary {
     const message = {
-      ...baseGetInstalledPackageDetailRequest,
-    } as GetInstalledPackageDetailRequest;
+      ...baseInstalledPackageSummary,
+    } as InstalledPackageSummary;
+    message.conflictingPlugins = [];
     if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
       message.installedPackageRef = InstalledPackageReference.fromPartial(
         object.installedPackageRef,
@@ -1393,34 +8681,92 @@ export const GetInstalledPackageDetailRequest = {
     } else {
       message.installedPackageRef = undefined;
     }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
+    } else {
+      message.name = "";
+    }
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = VersionReference.fromPartial(object.pkgVersionReference);
+    } else {
+      message.pkgVersionReference = undefined;
+    }
+    if (object.currentVersion !== undefined && object.currentVersion !== null) {
+      message.currentVersion = PackageAppVersion.fromPartial(object.currentVersion);
+    } else {
+      message.currentVersion = undefined;
+    }
+    if (object.iconUrl !== undefined && object.iconUrl !== null) {
+      message.iconUrl = object.iconUrl;
+    } else {
+      message.iconUrl = "";
+    }
+    if (object.pkgDisplayName !== undefined && object.pkgDisplayName !== null) {
+      message.pkgDisplayName = object.pkgDisplayName;
+    } else {
+      message.pkgDisplayName = "";
+    }
+    if (object.shortDescription !== undefined && object.shortDescription !== null) {
+      message.shortDescription = object.shortDescription;
+    } else {
+      message.shortDescription = "";
+    }
+    if (object.latestMatchingVersion !== undefined && object.latestMatchingVersion !== null) {
+      message.latestMatchingVersion = PackageAppVersion.fromPartial(object.latestMatchingVersion);
+    } else {
+      message.latestMatchingVersion = undefined;
+    }
+    if (object.latestVersion !== undefined && object.latestVersion !== null) {
+      message.latestVersion = PackageAppVersion.fromPartial(object.latestVersion);
+    } else {
+      message.latestVersion = undefined;
+    }
+    if (object.status !== undefined && object.status !== null) {
+      message.status = InstalledPackageStatus.fromPartial(object.status);
+    } else {
+      message.status = undefined;
+    }
+    if (object.conflictingPlugins !== undefined && object.conflictingPlugins !== null) {
+      for (const e of object.conflictingPlugins) {
+        message.conflictingPlugins.push(Plugin.fromPartial(e));
+      }
+    }
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
     return message;
   },
 };
 
-const baseCreateInstalledPackageRequest: object = { name: "", values: "" };
+const baseInstalledPackageDetail: object = {
+  name: "",
+  valuesApplied: "",
+  postInstallationNotes: "",
+};
 
-export const CreateInstalledPackageRequest = {
-  encode(
-    message: CreateInstalledPackageRequest,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.availablePackageRef !== undefined) {
-      AvailablePackageReference.encode(
-        message.availablePackageRef,
+export const InstalledPackageDetail = {
+  encode(message: InstalledPackageDetail, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
         writer.uint32(10).fork(),
       ).ldelim();
     }
-    if (message.targetContext !== undefined) {
-      Context.encode(message.targetContext, writer.uint32(18).fork()).ldelim();
+    if (message.pkgVersionReference !== undefined) {
+      VersionReference.encode(message.pkgVersionReference, writer.uint32(18).fork()).ldelim();
     }
     if (message.name !== "") {
       writer.uint32(26).string(message.name);
     }
-    if (message.pkgVersionReference !== undefined) {
-      VersionReference.encode(message.pkgVersionReference, writer.uint32(34).fork()).ldelim();
+    if (message.currentVersion !== undefined) {
+      PackageAppVersion.encode(message.currentVersion, writer.uint32(34).fork()).ldelim();
     }
-    if (message.values !== "") {
-      writer.uint32(42).string(message.values);
+    if (message.valuesApplied !== "") {
+      writer.uint32(42).string(message.valuesApplied);
     }
     if (message.reconciliationOptions !== undefined) {
       ReconciliationOptions.encode(
@@ -1428,134 +8774,253 @@ export const CreateInstalledPackageRequest = {
         writer.uint32(50).fork(),
       ).ldelim();
     }
+    if (message.status !== undefined) {
+      InstalledPackageStatus.encode(message.status, writer.uint32(58).fork()).ldelim();
+    }
+    if (message.postInstallationNotes !== "") {
+      writer.uint32(66).string(message.postInstallationNotes);
+    }
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(74).fork(),
+      ).ldelim();
+    }
+    if (message.latestMatchingVersion !== undefined) {
+      PackageAppVersion.encode(message.latestMatchingVersion, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.latestVersion !== undefined) {
+      PackageAppVersion.encode(message.latestVersion, writer.uint32(90).fork()).ldelim();
+    }
+    if (message.customDetail !== undefined) {
+      Any.encode(message.customDetail, writer.uint32(114).fork()).ldelim();
+    }
+    if (message.workloadStatus !== undefined) {
+      WorkloadStatus.encode(message.workloadStatus, writer.uint32(122).fork()).ldelim();
+    }
+    if (message.availablePackageRepository !== undefined) {
+      PackageRepositoryReference.encode(
+        message.availablePackageRepository,
+        writer.uint32(130).fork(),
+      ).ldelim();
+    }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): CreateInstalledPackageRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageDetail {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseCreateInstalledPackageRequest,
-    } as CreateInstalledPackageRequest;
+    const message = { ...baseInstalledPackageDetail } as InstalledPackageDetail;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
         case 2:
-          message.targetContext = Context.decode(reader, reader.uint32());
+          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
           break;
         case 3:
           message.name = reader.string();
           break;
         case 4:
-          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
+          message.currentVersion = PackageAppVersion.decode(reader, reader.uint32());
           break;
         case 5:
-          message.values = reader.string();
+          message.valuesApplied = reader.string();
           break;
         case 6:
           message.reconciliationOptions = ReconciliationOptions.decode(reader, reader.uint32());
           break;
+        case 7:
+          message.status = InstalledPackageStatus.decode(reader, reader.uint32());
+          break;
+        case 8:
+          message.postInstallationNotes = reader.string();
+          break;
+        case 9:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 10:
+          message.latestMatchingVersion = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        case 11:
+          message.latestVersion = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        case 14:
+          message.customDetail = Any.decode(reader, reader.uint32());
+          break;
+        case 15:
+          message.workloadStatus = WorkloadStatus.decode(reader, reader.uint32());
+          break;
+        case 16:
+          message.availablePackageRepository = PackageRepositoryReference.decode(reader, reader.uint32());
+          break;
         default:
           reader.skipType(tag & 7);
           break;
       }
     }
-    return message;
-  },
-
-  fromJSON(object: any): CreateInstalledPackageRequest {
-    const message = {
-      ...baseCreateInstalledPackageRequest,
-    } as CreateInstalledPackageRequest;
+    return message;
+  },
+
+  fromJSON(object: any): InstalledPackageDetail {
+    const message = { ...baseInstalledPackageDetail } as InstalledPackageDetail;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    } else {
+      message.installedPackageRef = undefined;
+    }
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = VersionReference.fromJSON(object.pkgVersionReference);
+    } else {
+      message.pkgVersionReference = undefined;
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
+    } else {
+      message.name = "";
+    }
+    if (object.currentVersion !== undefined && object.currentVersion !== null) {
+      message.currentVersion = PackageAppVersion.fromJSON(object.currentVersion);
+    } else {
+      message.currentVersion = undefined;
+    }
+    if (object.valuesApplied !== undefined && object.valuesApplied !== null) {
+      message.valuesApplied = String(object.valuesApplied);
+    } else {
+      message.valuesApplied = "";
+    }
+    if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
+      message.reconciliationOptions = ReconciliationOptions.fromJSON(object.reconciliationOptions);
+    } else {
+      message.reconciliationOptions = undefined;
+    }
+    if (object.status !== undefined && object.status !== null) {
+      message.status = InstalledPackageStatus.fromJSON(object.status);
+    } else {
+      message.status = undefined;
+    }
+    if (object.postInstallationNotes !== undefined && object.postInstallationNotes !== null) {
+      message.postInstallationNotes = String(object.postInstallationNotes);
+    } else {
+      message.postInstallationNotes = "";
+    }
     if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
       message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
     } else {
       message.availablePackageRef = undefined;
     }
-    if (object.targetContext !== undefined && object.targetContext !== null) {
-      message.targetContext = Context.fromJSON(object.targetContext);
+    if (object.latestMatchingVersion !== undefined && object.latestMatchingVersion !== null) {
+      message.latestMatchingVersion = PackageAppVersion.fromJSON(object.latestMatchingVersion);
     } else {
-      message.targetContext = undefined;
+      message.latestMatchingVersion = undefined;
     }
-    if (object.name !== undefined && object.name !== null) {
-      message.name = String(object.name);
+    if (object.latestVersion !== undefined && object.latestVersion !== null) {
+      message.latestVersion = PackageAppVersion.fromJSON(object.latestVersion);
     } else {
-      message.name = "";
+      message.latestVersion = undefined;
     }
-    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
-      message.pkgVersionReference = VersionReference.fromJSON(object.pkgVersionReference);
+    if (object.customDetail !== undefined && object.customDetail !== null) {
+      message.customDetail = Any.fromJSON(object.customDetail);
     } else {
-      message.pkgVersionReference = undefined;
+      message.customDetail = undefined;
     }
-    if (object.values !== undefined && object.values !== null) {
-      message.values = String(object.values);
+    if (object.workloadStatus !== undefined && object.workloadStatus !== null) {
+      message.workloadStatus = WorkloadStatus.fromJSON(object.workloadStatus);
     } else {
-      message.values = "";
+      message.workloadStatus = undefined;
     }
-    if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
-      message.reconciliationOptions = ReconciliationOptions.fromJSON(object.reconciliationOptions);
+    if (
+      object.availablePackageRepository !== undefined &&
+      object.availablePackageRepository !== null
+    ) {
+      message.availablePackageRepository = PackageRepositoryReference.fromJSON(
+        object.availablePackageRepository,
+      );
     } else {
-      message.reconciliationOptions = undefined;
+      message.availablePackageRepository = undefined;
     }
     return message;
   },
 
-  toJSON(message: CreateInstalledPackageRequest): unknown {
+  toJSON(message: InstalledPackageDetail): unknown {
     const obj: any = {};
-    message.availablePackageRef !== undefined &&
-      (obj.availablePackageRef = message.availablePackageRef
-        ? AvailablePackageReference.toJSON(message.availablePackageRef)
-        : undefined);
-    message.targetContext !== undefined &&
-      (obj.targetContext = message.targetContext
-        ? Context.toJSON(message.targetContext)
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
         : undefined);
-    message.name !== undefined && (obj.name = message.name);
     message.pkgVersionReference !== undefined &&
       (obj.pkgVersionReference = message.pkgVersionReference
         ? VersionReference.toJSON(message.pkgVersionReference)
         : undefined);
-    message.values !== undefined && (obj.values = message.values);
+    message.name !== undefined && (obj.name = message.name);
+    message.currentVersion !== undefined &&
+      (obj.currentVersion = message.currentVersion
+        ? PackageAppVersion.toJSON(message.currentVersion)
+        : undefined);
+    message.valuesApplied !== undefined && (obj.valuesApplied = message.valuesApplied);
     message.reconciliationOptions !== undefined &&
       (obj.reconciliationOptions = message.reconciliationOptions
         ? ReconciliationOptions.toJSON(message.reconciliationOptions)
         : undefined);
+    message.status !== undefined &&
+      (obj.status = message.status ? InstalledPackageStatus.toJSON(message.status) : undefined);
+    message.postInstallationNotes !== undefined &&
+      (obj.postInstallationNotes = message.postInstallationNotes);
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.latestMatchingVersion !== undefined &&
+      (obj.latestMatchingVersion = message.latestMatchingVersion
+        ? PackageAppVersion.toJSON(message.latestMatchingVersion)
+        : undefined);
+    message.latestVersion !== undefined &&
+      (obj.latestVersion = message.latestVersion
+        ? PackageAppVersion.toJSON(message.latestVersion)
+        : undefined);
+    message.customDetail !== undefined &&
+      (obj.customDetail = message.customDetail ? Any.toJSON(message.customDetail) : undefined);
+    message.workloadStatus !== undefined &&
+      (obj.workloadStatus = message.workloadStatus
+        ? WorkloadStatus.toJSON(message.workloadStatus)
+        : undefined);
+    message.availablePackageRepository !== undefined &&
+      (obj.availablePackageRepository = message.availablePackageRepository
+        ? PackageRepositoryReference.toJSON(message.availablePackageRepository)
+        : undefined);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<CreateInstalledPackageRequest>): CreateInstalledPackageRequest {
-    const message = {
-      ...baseCreateInstalledPackageRequest,
-    } as CreateInstalledPackageRequest;
-    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
-      message.availablePackageRef = AvailablePackageReference.fromPartial(
-        object.availablePackageRef,
+  fromPartial(object: DeepPartial<InstalledPackageDetail>): InstalledPackageDetail {
+    const message = { ...baseInstalledPackageDetail } as InstalledPackageDetail;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
       );
     } else {
-      message.availablePackageRef = undefined;
+      message.installedPackageRef = undefined;
     }
-    if (object.targetContext !== undefined && object.targetContext !== null) {
-      message.targetContext = Context.fromPartial(object.targetContext);
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = VersionReference.fromPartial(object.pkgVersionReference);
     } else {
-      message.targetContext = undefined;
+      message.pkgVersionReference = undefined;
     }
     if (object.name !== undefined && object.name !== null) {
       message.name = object.name;
     } else {
       message.name = "";
     }
-    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
-      message.pkgVersionReference = VersionReference.fromPartial(object.pkgVersionReference);
+    if (object.currentVersion !== undefined && object.currentVersion !== null) {
+      message.currentVersion = PackageAppVersion.fromPartial(object.currentVersion);
     } else {
-      message.pkgVersionReference = undefined;
+      message.currentVersion = undefined;
     }
-    if (object.values !== undefined && object.values !== null) {
-      message.values = object.values;
+    if (object.valuesApplied !== undefined && object.valuesApplied !== null) {
+      message.valuesApplied = object.valuesApplied;
     } else {
-      message.values = "";
+      message.valuesApplied = "";
     }
     if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
       message.reconciliationOptions = ReconciliationOptions.fromPartial(
@@ -1564,58 +9029,168 @@ export const CreateInstalledPackageRequest = {
     } else {
       message.reconciliationOptions = undefined;
     }
+    if (object.status !== undefined && object.status !== null) {
+      message.status = InstalledPackageStatus.fromPartial(object.status);
+    } else {
+      message.status = undefined;
+    }
+    if (object.postInstallationNotes !== undefined && object.postInstallationNotes !== null) {
+      message.postInstallationNotes = object.postInstallationNotes;
+    } else {
+      message.postInstallationNotes = "";
+    }
+    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
+      message.availablePackageRef = AvailablePackageReference.fromPartial(
+        object.availablePackageRef,
+      );
+    } else {
+      message.availablePackageRef = undefined;
+    }
+    if (object.latestMatchingVersion !== undefined && object.latestMatchingVersion !== null) {
+      message.latestMatchingVersion = PackageAppVersion.fromPartial(object.latestMatchingVersion);
+    } else {
+      message.latestMatchingVersion = undefined;
+    }
+    if (object.latestVersion !== undefined && object.latestVersion !== null) {
+      message.latestVersion = PackageAppVersion.fromPartial(object.latestVersion);
+    } else {
+      message.latestVersion = undefined;
+    }
+    if (object.customDetail !== undefined && object.customDetail !== null) {
+      message.customDetail = Any.fromPartial(object.customDetail);
+    } else {
+      message.customDetail = undefined;
+    }
+    if (object.workloadStatus !== undefined && object.workloadStatus !== null) {
+      message.workloadStatus = WorkloadStatus.fromPartial(object.workloadStatus);
+    } else {
+      message.workloadStatus = undefined;
+    }
+    if (
+      object.availablePackageRepository !== undefined &&
+      object.availablePackageRepository !== null
+    ) {
+      message.availablePackageRepository = PackageRepositoryReference.fromPartial(
+        object.availablePackageRepository,
+      );
+    } else {
+      message.availablePackageRepository = undefined;
+    }
     return message;
   },
 };
 
-const baseUpdateInstalledPackageRequest: object = { values: "" };
+const baseContext: object = { cluster: "", namespace: "" };
 
-export const UpdateInstalledPackageRequest = {
-  encode(
-    message: UpdateInstalledPackageRequest,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.installedPackageRef !== undefined) {
-      InstalledPackageReference.encode(
-        message.installedPackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
+export const Context = {
+  encode(message: Context, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.cluster !== "") {
+      writer.uint32(10).string(message.cluster);
     }
-    if (message.pkgVersionReference !== undefined) {
-      VersionReference.encode(message.pkgVersionReference, writer.uint32(18).fork()).ldelim();
+    if (message.namespace !== "") {
+      writer.uint32(18).string(message.namespace);
     }
-    if (message.values !== "") {
-      writer.uint32(26).string(message.values);
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Context {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseContext } as Context;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.cluster = reader.string();
+          break;
+        case 2:
+          message.namespace = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
     }
-    if (message.reconciliationOptions !== undefined) {
-      ReconciliationOptions.encode(
-        message.reconciliationOptions,
-        writer.uint32(34).fork(),
-      ).ldelim();
+    return message;
+  },
+
+  fromJSON(object: any): Context {
+    const message = { ...baseContext } as Context;
+    if (object.cluster !== undefined && object.cluster !== null) {
+      message.cluster = String(object.cluster);
+    } else {
+      message.cluster = "";
+    }
+    if (object.namespace !== undefined && object.namespace !== null) {
+      message.namespace = String(object.namespace);
+    } else {
+      message.namespace = "";
+    }
+    return message;
+  },
+
+  toJSON(message: Context): unknown {
+    const obj: any = {};
+    message.cluster !== undefined && (obj.cluster = message.cluster);
+    message.namespace !== undefined && (obj.namespace = message.namespace);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<Context>): Context {
+    const message = { ...baseContext } as Context;
+    if (object.cluster !== undefined && object.cluster !== null) {
+      message.cluster = object.cluster;
+    } else {
+      message.cluster = "";
+    }
+    if (object.namespace !== undefined && object.namespace !== null) {
+      message.namespace = object.namespace;
+    } else {
+      message.namespace = "";
+    }
+    return message;
+  },
+};
+
+const baseAvailablePackageReference: object = { identifier: "" };
+
+export const AvailablePackageReference = {
+  encode(message: AvailablePackageReference, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.identifier !== "") {
+      writer.uint32(18).string(message.identifier);
+    }
+    if (message.plugin !== undefined) {
+      Plugin.encode(message.plugin, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.repository !== undefined) {
+      PackageRepositoryReference.encode(message.repository, writer.uint32(34).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateInstalledPackageRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): AvailablePackageReference {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
     const message = {
-      ...baseUpdateInstalledPackageRequest,
-    } as UpdateInstalledPackageRequest;
+      ...baseAvailablePackageReference,
+    } as AvailablePackageReference;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          message.context = Context.decode(reader, reader.uint32());
           break;
         case 2:
-          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
+          message.identifier = reader.string();
           break;
         case 3:
-          message.values = reader.string();
+          message.plugin = Plugin.decode(reader, reader.uint32());
           break;
         case 4:
-          message.reconciliationOptions = ReconciliationOptions.decode(reader, reader.uint32());
+          message.repository = PackageRepositoryReference.decode(reader, reader.uint32());
           break;
         default:
           reader.skipType(tag & 7);
@@ -1625,198 +9200,351 @@ export const UpdateInstalledPackageRequest = {
     return message;
   },
 
-  fromJSON(object: any): UpdateInstalledPackageRequest {
+  fromJSON(object: any): AvailablePackageReference {
     const message = {
-      ...baseUpdateInstalledPackageRequest,
-    } as UpdateInstalledPackageRequest;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+      ...baseAvailablePackageReference,
+    } as AvailablePackageReference;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromJSON(object.context);
     } else {
-      message.installedPackageRef = undefined;
+      message.context = undefined;
     }
-    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
-      message.pkgVersionReference = VersionReference.fromJSON(object.pkgVersionReference);
+    if (object.identifier !== undefined && object.identifier !== null) {
+      message.identifier = String(object.identifier);
     } else {
-      message.pkgVersionReference = undefined;
+      message.identifier = "";
     }
-    if (object.values !== undefined && object.values !== null) {
-      message.values = String(object.values);
+    if (object.plugin !== undefined && object.plugin !== null) {
+      message.plugin = Plugin.fromJSON(object.plugin);
     } else {
-      message.values = "";
+      message.plugin = undefined;
     }
-    if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
-      message.reconciliationOptions = ReconciliationOptions.fromJSON(object.reconciliationOptions);
+    if (object.repository !== undefined && object.repository !== null) {
+      message.repository = PackageRepositoryReference.fromJSON(object.repository);
     } else {
-      message.reconciliationOptions = undefined;
+      message.repository = undefined;
     }
     return message;
   },
 
-  toJSON(message: UpdateInstalledPackageRequest): unknown {
+  toJSON(message: AvailablePackageReference): unknown {
     const obj: any = {};
-    message.installedPackageRef !== undefined &&
-      (obj.installedPackageRef = message.installedPackageRef
-        ? InstalledPackageReference.toJSON(message.installedPackageRef)
-        : undefined);
-    message.pkgVersionReference !== undefined &&
-      (obj.pkgVersionReference = message.pkgVersionReference
-        ? VersionReference.toJSON(message.pkgVersionReference)
-        : undefined);
-    message.values !== undefined && (obj.values = message.values);
-    message.reconciliationOptions !== undefined &&
-      (obj.reconciliationOptions = message.reconciliationOptions
-        ? ReconciliationOptions.toJSON(message.reconciliationOptions)
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+    message.identifier !== undefined && (obj.identifier = message.identifier);
+    message.plugin !== undefined &&
+      (obj.plugin = message.plugin ? Plugin.toJSON(message.plugin) : undefined);
+    message.repository !== undefined &&
+      (obj.repository = message.repository
+        ? PackageRepositoryReference.toJSON(message.repository)
         : undefined);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<UpdateInstalledPackageRequest>): UpdateInstalledPackageRequest {
+  fromPartial(object: DeepPartial<AvailablePackageReference>): AvailablePackageReference {
     const message = {
-      ...baseUpdateInstalledPackageRequest,
-    } as UpdateInstalledPackageRequest;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromPartial(
-        object.installedPackageRef,
-      );
+      ...baseAvailablePackageReference,
+    } as AvailablePackageReference;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromPartial(object.context);
     } else {
-      message.installedPackageRef = undefined;
+      message.context = undefined;
     }
-    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
-      message.pkgVersionReference = VersionReference.fromPartial(object.pkgVersionReference);
+    if (object.identifier !== undefined && object.identifier !== null) {
+      message.identifier = object.identifier;
     } else {
-      message.pkgVersionReference = undefined;
+      message.identifier = "";
     }
-    if (object.values !== undefined && object.values !== null) {
-      message.values = object.values;
+    if (object.plugin !== undefined && object.plugin !== null) {
+      message.plugin = Plugin.fromPartial(object.plugin);
     } else {
-      message.values = "";
+      message.plugin = undefined;
     }
-    if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
-      message.reconciliationOptions = ReconciliationOptions.fromPartial(
-        object.reconciliationOptions,
-      );
+    if (object.repository !== undefined && object.repository !== null) {
+      message.repository = PackageRepositoryReference.fromPartial(object.repository);
     } else {
-      message.reconciliationOptions = undefined;
+      message.repository = undefined;
     }
     return message;
   },
 };
 
-const baseDeleteInstalledPackageRequest: object = {};
+const baseMaintainer: object = { name: "", email: "" };
 
-export const DeleteInstalledPackageRequest = {
-  encode(
-    message: DeleteInstalledPackageRequest,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.installedPackageRef !== undefined) {
-      InstalledPackageReference.encode(
-        message.installedPackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
+export const Maintainer = {
+  encode(message: Maintainer, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.email !== "") {
+      writer.uint32(18).string(message.email);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackageRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): Maintainer {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseDeleteInstalledPackageRequest,
-    } as DeleteInstalledPackageRequest;
+    const message = { ...baseMaintainer } as Maintainer;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          message.name = reader.string();
+          break;
+        case 2:
+          message.email = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Maintainer {
+    const message = { ...baseMaintainer } as Maintainer;
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
+    } else {
+      message.name = "";
+    }
+    if (object.email !== undefined && object.email !== null) {
+      message.email = String(object.email);
+    } else {
+      message.email = "";
+    }
+    return message;
+  },
+
+  toJSON(message: Maintainer): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.email !== undefined && (obj.email = message.email);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<Maintainer>): Maintainer {
+    const message = { ...baseMaintainer } as Maintainer;
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
+    } else {
+      message.name = "";
+    }
+    if (object.email !== undefined && object.email !== null) {
+      message.email = object.email;
+    } else {
+      message.email = "";
+    }
+    return message;
+  },
+};
+
+const baseFilterOptions: object = {
+  query: "",
+  categories: "",
+  repositories: "",
+  pkgVersion: "",
+  appVersion: "",
+  excludeDeprecated: false,
+  maintainer: "",
+};
+
+export const FilterOptions = {
+  encode(message: FilterOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.query !== "") {
+      writer.uint32(10).string(message.query);
+    }
+    for (const v of message.categories) {
+      writer.uint32(18).string(v!);
+    }
+    for (const v of message.repositories) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.pkgVersion !== "") {
+      writer.uint32(34).string(message.pkgVersion);
+    }
+    if (message.appVersion !== "") {
+      writer.uint32(42).string(message.appVersion);
+    }
+    if (message.excludeDeprecated === true) {
+      writer.uint32(48).bool(message.excludeDeprecated);
+    }
+    if (message.maintainer !== "") {
+      writer.uint32(58).string(message.maintainer);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FilterOptions {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseFilterOptions } as FilterOptions;
+    message.categories = [];
+    message.repositories = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.query = reader.string();
+          break;
+        case 2:
+          message.categories.push(reader.string());
+          break;
+        case 3:
+          message.repositories.push(reader.string());
+          break;
+        case 4:
+          message.pkgVersion = reader.string();
+          break;
+        case 5:
+          message.appVersion = reader.string();
+          break;
+        case 6:
+          message.excludeDeprecated = reader.bool();
+          break;
+        case 7:
+          message.maintainer = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
           break;
       }
     }
-    return message;
-  },
-
-  fromJSON(object: any): DeleteInstalledPackageRequest {
-    const message = {
-      ...baseDeleteInstalledPackageRequest,
-    } as DeleteInstalledPackageRequest;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+    return message;
+  },
+
+  fromJSON(object: any): FilterOptions {
+    const message = { ...baseFilterOptions } as FilterOptions;
+    message.categories = [];
+    message.repositories = [];
+    if (object.query !== undefined && object.query !== null) {
+      message.query = String(object.query);
+    } else {
+      message.query = "";
+    }
+    if (object.categories !== undefined && object.categories !== null) {
+      for (const e of object.categories) {
+        message.categories.push(String(e));
+      }
+    }
+    if (object.repositories !== undefined && object.repositories !== null) {
+      for (const e of object.repositories) {
+        message.repositories.push(String(e));
+      }
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = String(object.pkgVersion);
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.appVersion !== undefined && object.appVersion !== null) {
+      message.appVersion = String(object.appVersion);
+    } else {
+      message.appVersion = "";
+    }
+    if (object.excludeDeprecated !== undefined && object.excludeDeprecated !== null) {
+      message.excludeDeprecated = Boolean(object.excludeDeprecated);
     } else {
-      message.installedPackageRef = undefined;
+      message.excludeDeprecated = false;
+    }
+    if (object.maintainer !== undefined && object.maintainer !== null) {
+      message.maintainer = String(object.maintainer);
+    } else {
+      message.maintainer = "";
     }
     return message;
   },
 
-  toJSON(message: DeleteInstalledPackageRequest): unknown {
+  toJSON(message: FilterOptions): unknown {
     const obj: any = {};
-    message.installedPackageRef !== undefined &&
-      (obj.installedPackageRef = message.installedPackageRef
-        ? InstalledPackageReference.toJSON(message.installedPackageRef)
-        : undefined);
+    message.query !== undefined && (obj.query = message.query);
+    if (message.categories) {
+      obj.categories = message.categories.map(e => e);
+    } else {
+      obj.categories = [];
+    }
+    if (message.repositories) {
+      obj.repositories = message.repositories.map(e => e);
+    } else {
+      obj.repositories = [];
+    }
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    message.excludeDeprecated !== undefined && (obj.excludeDeprecated = message.excludeDeprecated);
+    message.maintainer !== undefined && (obj.maintainer = message.maintainer);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<DeleteInstalledPackageRequest>): DeleteInstalledPackageRequest {
-    const message = {
-      ...baseDeleteInstalledPackageRequest,
-    } as DeleteInstalledPackageRequest;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromPartial(
-        object.installedPackageRef,
-      );
+  fromPartial(object: DeepPartial<FilterOptions>): FilterOptions {
+    const message = { ...baseFilterOptions } as FilterOptions;
+    message.categories = [];
+    message.repositories = [];
+    if (object.query !== undefined && object.query !== null) {
+      message.query = object.query;
     } else {
-      message.installedPackageRef = undefined;
+      message.query = "";
+    }
+    if (object.categories !== undefined && object.categories !== null) {
+      for (const e of object.categories) {
+        message.categories.push(e);
+      }
+    }
+    if (object.repositories !== undefined && object.repositories !== null) {
+      for (const e of object.repositories) {
+        message.repositories.push(e);
+      }
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = object.pkgVersion;
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.appVersion !== undefined && object.appVersion !== null) {
+      message.appVersion = object.appVersion;
+    } else {
+      message.appVersion = "";
+    }
+    if (object.excludeDeprecated !== undefined && object.excludeDeprecated !== null) {
+      message.excludeDeprecated = object.excludeDeprecated;
+    } else {
+      message.excludeDeprecated = false;
+    }
+    if (object.maintainer !== undefined && object.maintainer !== null) {
+      message.maintainer = object.maintainer;
+    } else {
+      message.maintainer = "";
     }
     return message;
   },
 };
 
-const baseGetAvailablePackageSummariesResponse: object = {
-  nextPageToken: "",
-  categories: "",
-};
+const basePaginationOptions: object = { pageToken: "", pageSize: 0 };
 
-export const GetAvailablePackageSummariesResponse = {
-  encode(
-    message: GetAvailablePackageSummariesResponse,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    for (const v of message.availablePackageSummaries) {
-      AvailablePackageSummary.encode(v!, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.nextPageToken !== "") {
-      writer.uint32(18).string(message.nextPageToken);
+export const PaginationOptions = {
+  encode(message: PaginationOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pageToken !== "") {
+      writer.uint32(10).string(message.pageToken);
     }
-    for (const v of message.categories) {
-      writer.uint32(26).string(v!);
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageSummariesResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PaginationOptions {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseGetAvailablePackageSummariesResponse,
-    } as GetAvailablePackageSummariesResponse;
-    message.availablePackageSummaries = [];
-    message.categories = [];
+    const message = { ...basePaginationOptions } as PaginationOptions;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.availablePackageSummaries.push(
-            AvailablePackageSummary.decode(reader, reader.uint32()),
-          );
+          message.pageToken = reader.string();
           break;
         case 2:
-          message.nextPageToken = reader.string();
-          break;
-        case 3:
-          message.categories.push(reader.string());
+          message.pageSize = reader.int32();
           break;
         default:
           reader.skipType(tag & 7);
@@ -1826,108 +9554,77 @@ export const GetAvailablePackageSummariesResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetAvailablePackageSummariesResponse {
-    const message = {
-      ...baseGetAvailablePackageSummariesResponse,
-    } as GetAvailablePackageSummariesResponse;
-    message.availablePackageSummaries = [];
-    message.categories = [];
-    if (
-      object.availablePackageSummaries !== undefined &&
-      object.availablePackageSummaries !== null
-    ) {
-      for (const e of object.availablePackageSummaries) {
-        message.availablePackageSummaries.push(AvailablePackageSummary.fromJSON(e));
-      }
-    }
-    if (object.nextPageToken !== undefined && object.nextPageToken !== null) {
-      message.nextPageToken = String(object.nextPageToken);
+  fromJSON(object: any): PaginationOptions {
+    const message = { ...basePaginationOptions } as PaginationOptions;
+    if (object.pageToken !== undefined && object.pageToken !== null) {
+      message.pageToken = String(object.pageToken);
     } else {
-      message.nextPageToken = "";
+      message.pageToken = "";
     }
-    if (object.categories !== undefined && object.categories !== null) {
-      for (const e of object.categories) {
-        message.categories.push(String(e));
-      }
+    if (object.pageSize !== undefined && object.pageSize !== null) {
+      message.pageSize = Number(object.pageSize);
+    } else {
+      message.pageSize = 0;
     }
     return message;
   },
 
-  toJSON(message: GetAvailablePackageSummariesResponse): unknown {
+  toJSON(message: PaginationOptions): unknown {
     const obj: any = {};
-    if (message.availablePackageSummaries) {
-      obj.availablePackageSummaries = message.availablePackageSummaries.map(e =>
-        e ? AvailablePackageSummary.toJSON(e) : undefined,
-      );
-    } else {
-      obj.availablePackageSummaries = [];
-    }
-    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
-    if (message.categories) {
-      obj.categories = message.categories.map(e => e);
-    } else {
-      obj.categories = [];
-    }
+    message.pageToken !== undefined && (obj.pageToken = message.pageToken);
+    message.pageSize !== undefined && (obj.pageSize = message.pageSize);
     return obj;
   },
 
-  fromPartial(
-    object: DeepPartial<GetAvailablePackageSummariesResponse>,
-  ): GetAvailablePackageSummariesResponse {
-    const message = {
-      ...baseGetAvailablePackageSummariesResponse,
-    } as GetAvailablePackageSummariesResponse;
-    message.availablePackageSummaries = [];
-    message.categories = [];
-    if (
-      object.availablePackageSummaries !== undefined &&
-      object.availablePackageSummaries !== null
-    ) {
-      for (const e of object.availablePackageSummaries) {
-        message.availablePackageSummaries.push(AvailablePackageSummary.fromPartial(e));
-      }
-    }
-    if (object.nextPageToken !== undefined && object.nextPageToken !== null) {
-      message.nextPageToken = object.nextPageToken;
+  fromPartial(object: DeepPartial<PaginationOptions>): PaginationOptions {
+    const message = { ...basePaginationOptions } as PaginationOptions;
+    if (object.pageToken !== undefined && object.pageToken !== null) {
+      message.pageToken = object.pageToken;
     } else {
-      message.nextPageToken = "";
+      message.pageToken = "";
     }
-    if (object.categories !== undefined && object.categories !== null) {
-      for (const e of object.categories) {
-        message.categories.push(e);
-      }
+    if (object.pageSize !== undefined && object.pageSize !== null) {
+      message.pageSize = object.pageSize;
+    } else {
+      message.pageSize = 0;
     }
     return message;
   },
 };
 
-const baseGetAvailablePackageDetailResponse: object = {};
+const baseInstalledPackageReference: object = { identifier: "" };
 
-export const GetAvailablePackageDetailResponse = {
-  encode(
-    message: GetAvailablePackageDetailResponse,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.availablePackageDetail !== undefined) {
-      AvailablePackageDetail.encode(
-        message.availablePackageDetail,
-        writer.uint32(10).fork(),
-      ).ldelim();
+export const InstalledPackageReference = {
+  encode(message: InstalledPackageReference, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.identifier !== "") {
+      writer.uint32(18).string(message.identifier);
+    }
+    if (message.plugin !== undefined) {
+      Plugin.encode(message.plugin, writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageDetailResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageReference {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
     const message = {
-      ...baseGetAvailablePackageDetailResponse,
-    } as GetAvailablePackageDetailResponse;
+      ...baseInstalledPackageReference,
+    } as InstalledPackageReference;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.availablePackageDetail = AvailablePackageDetail.decode(reader, reader.uint32());
+          message.context = Context.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.identifier = reader.string();
+          break;
+        case 3:
+          message.plugin = Plugin.decode(reader, reader.uint32());
           break;
         default:
           reader.skipType(tag & 7);
@@ -1937,71 +9634,80 @@ export const GetAvailablePackageDetailResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetAvailablePackageDetailResponse {
+  fromJSON(object: any): InstalledPackageReference {
     const message = {
-      ...baseGetAvailablePackageDetailResponse,
-    } as GetAvailablePackageDetailResponse;
-    if (object.availablePackageDetail !== undefined && object.availablePackageDetail !== null) {
-      message.availablePackageDetail = AvailablePackageDetail.fromJSON(
-        object.availablePackageDetail,
-      );
+      ...baseInstalledPackageReference,
+    } as InstalledPackageReference;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromJSON(object.context);
     } else {
-      message.availablePackageDetail = undefined;
+      message.context = undefined;
+    }
+    if (object.identifier !== undefined && object.identifier !== null) {
+      message.identifier = String(object.identifier);
+    } else {
+      message.identifier = "";
+    }
+    if (object.plugin !== undefined && object.plugin !== null) {
+      message.plugin = Plugin.fromJSON(object.plugin);
+    } else {
+      message.plugin = undefined;
     }
     return message;
   },
 
-  toJSON(message: GetAvailablePackageDetailResponse): unknown {
-    const obj: any = {};
-    message.availablePackageDetail !== undefined &&
-      (obj.availablePackageDetail = message.availablePackageDetail
-        ? AvailablePackageDetail.toJSON(message.availablePackageDetail)
-        : undefined);
+  toJSON(message: InstalledPackageReference): unknown {
+    const obj: any = {};
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+    message.identifier !== undefined && (obj.identifier = message.identifier);
+    message.plugin !== undefined &&
+      (obj.plugin = message.plugin ? Plugin.toJSON(message.plugin) : undefined);
     return obj;
   },
 
-  fromPartial(
-    object: DeepPartial<GetAvailablePackageDetailResponse>,
-  ): GetAvailablePackageDetailResponse {
+  fromPartial(object: DeepPartial<InstalledPackageReference>): InstalledPackageReference {
     const message = {
-      ...baseGetAvailablePackageDetailResponse,
-    } as GetAvailablePackageDetailResponse;
-    if (object.availablePackageDetail !== undefined && object.availablePackageDetail !== null) {
-      message.availablePackageDetail = AvailablePackageDetail.fromPartial(
-        object.availablePackageDetail,
-      );
+      ...baseInstalledPackageReference,
+    } as InstalledPackageReference;
+    if (object.context !== undefined && object.context !== null) {
+      message.context = Context.fromPartial(object.context);
     } else {
-      message.availablePackageDetail = undefined;
+      message.context = undefined;
+    }
+    if (object.identifier !== undefined && object.identifier !== null) {
+      message.identifier = object.identifier;
+    } else {
+      message.identifier = "";
+    }
+    if (object.plugin !== undefined && object.plugin !== null) {
+      message.plugin = Plugin.fromPartial(object.plugin);
+    } else {
+      message.plugin = undefined;
     }
     return message;
   },
 };
 
-const baseGetAvailablePackageVersionsResponse: object = {};
+const baseVersionReference: object = { version: "" };
 
-export const GetAvailablePackageVersionsResponse = {
-  encode(
-    message: GetAvailablePackageVersionsResponse,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    for (const v of message.packageAppVersions) {
-      PackageAppVersion.encode(v!, writer.uint32(10).fork()).ldelim();
+export const VersionReference = {
+  encode(message: VersionReference, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.version !== "") {
+      writer.uint32(10).string(message.version);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageVersionsResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): VersionReference {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseGetAvailablePackageVersionsResponse,
-    } as GetAvailablePackageVersionsResponse;
-    message.packageAppVersions = [];
+    const message = { ...baseVersionReference } as VersionReference;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.packageAppVersions.push(PackageAppVersion.decode(reader, reader.uint32()));
+          message.version = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -2011,80 +9717,75 @@ export const GetAvailablePackageVersionsResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetAvailablePackageVersionsResponse {
-    const message = {
-      ...baseGetAvailablePackageVersionsResponse,
-    } as GetAvailablePackageVersionsResponse;
-    message.packageAppVersions = [];
-    if (object.packageAppVersions !== undefined && object.packageAppVersions !== null) {
-      for (const e of object.packageAppVersions) {
-        message.packageAppVersions.push(PackageAppVersion.fromJSON(e));
-      }
+  fromJSON(object: any): VersionReference {
+    const message = { ...baseVersionReference } as VersionReference;
+    if (object.version !== undefined && object.version !== null) {
+      message.version = String(object.version);
+    } else {
+      message.version = "";
     }
     return message;
   },
 
-  toJSON(message: GetAvailablePackageVersionsResponse): unknown {
+  toJSON(message: VersionReference): unknown {
     const obj: any = {};
-    if (message.packageAppVersions) {
-      obj.packageAppVersions = message.packageAppVersions.map(e =>
-        e ? PackageAppVersion.toJSON(e) : undefined,
-      );
-    } else {
-      obj.packageAppVersions = [];
-    }
+    message.version !== undefined && (obj.version = message.version);
     return obj;
   },
 
-  fromPartial(
-    object: DeepPartial<GetAvailablePackageVersionsResponse>,
-  ): GetAvailablePackageVersionsResponse {
-    const message = {
-      ...baseGetAvailablePackageVersionsResponse,
-    } as GetAvailablePackageVersionsResponse;
-    message.packageAppVersions = [];
-    if (object.packageAppVersions !== undefined && object.packageAppVersions !== null) {
-      for (const e of object.packageAppVersions) {
-        message.packageAppVersions.push(PackageAppVersion.fromPartial(e));
-      }
+  fromPartial(object: DeepPartial<VersionReference>): VersionReference {
+    const message = { ...baseVersionReference } as VersionReference;
+    if (object.version !== undefined && object.version !== null) {
+      message.version = object.version;
+    } else {
+      message.version = "";
     }
     return message;
   },
 };
 
-const baseGetInstalledPackageSummariesResponse: object = { nextPageToken: "" };
+const baseInstalledPackageStatus: object = {
+  ready: false,
+  reason: 0,
+  userReason: "",
+};
 
-export const GetInstalledPackageSummariesResponse = {
-  encode(
-    message: GetInstalledPackageSummariesResponse,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    for (const v of message.installedPackageSummaries) {
-      InstalledPackageSummary.encode(v!, writer.uint32(10).fork()).ldelim();
+export const InstalledPackageStatus = {
+  encode(message: InstalledPackageStatus, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.ready === true) {
+      writer.uint32(8).bool(message.ready);
     }
-    if (message.nextPageToken !== "") {
-      writer.uint32(18).string(message.nextPageToken);
+    if (message.reason !== 0) {
+      writer.uint32(16).int32(message.reason);
+    }
+    if (message.userReason !== "") {
+      writer.uint32(26).string(message.userReason);
+    }
+    for (const v of message.conditions) {
+      InstalledPackageCondition.encode(v!, writer.uint32(34).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageSummariesResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageStatus {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseGetInstalledPackageSummariesResponse,
-    } as GetInstalledPackageSummariesResponse;
-    message.installedPackageSummaries = [];
+    const message = { ...baseInstalledPackageStatus } as InstalledPackageStatus;
+    message.conditions = [];
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.installedPackageSummaries.push(
-            InstalledPackageSummary.decode(reader, reader.uint32()),
-          );
+          message.ready = reader.bool();
           break;
         case 2:
-          message.nextPageToken = reader.string();
+          message.reason = reader.int32() as any;
+          break;
+        case 3:
+          message.userReason = reader.string();
+          break;
+        case 4:
+          message.conditions.push(InstalledPackageCondition.decode(reader, reader.uint32()));
           break;
         default:
           reader.skipType(tag & 7);
@@ -2094,91 +9795,126 @@ export const GetInstalledPackageSummariesResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetInstalledPackageSummariesResponse {
-    const message = {
-      ...baseGetInstalledPackageSummariesResponse,
-    } as GetInstalledPackageSummariesResponse;
-    message.installedPackageSummaries = [];
-    if (
-      object.installedPackageSummaries !== undefined &&
-      object.installedPackageSummaries !== null
-    ) {
-      for (const e of object.installedPackageSummaries) {
-        message.installedPackageSummaries.push(InstalledPackageSummary.fromJSON(e));
-      }
+  fromJSON(object: any): InstalledPackageStatus {
+    const message = { ...baseInstalledPackageStatus } as InstalledPackageStatus;
+    message.conditions = [];
+    if (object.ready !== undefined && object.ready !== null) {
+      message.ready = Boolean(object.ready);
+    } else {
+      message.ready = false;
     }
-    if (object.nextPageToken !== undefined && object.nextPageToken !== null) {
-      message.nextPageToken = String(object.nextPageToken);
+    if (object.reason !== undefined && object.reason !== null) {
+      message.reason = installedPackageStatus_StatusReasonFromJSON(object.reason);
     } else {
-      message.nextPageToken = "";
+      message.reason = 0;
+    }
+    if (object.userReason !== undefined && object.userReason !== null) {
+      message.userReason = String(object.userReason);
+    } else {
+      message.userReason = "";
+    }
+    if (object.conditions !== undefined && object.conditions !== null) {
+      for (const e of object.conditions) {
+        message.conditions.push(InstalledPackageCondition.fromJSON(e));
+      }
     }
     return message;
   },
 
-  toJSON(message: GetInstalledPackageSummariesResponse): unknown {
+  toJSON(message: InstalledPackageStatus): unknown {
     const obj: any = {};
-    if (message.installedPackageSummaries) {
-      obj.installedPackageSummaries = message.installedPackageSummaries.map(e =>
-        e ? InstalledPackageSummary.toJSON(e) : undefined,
+    message.ready !== undefined && (obj.ready = message.ready);
+    message.reason !== undefined &&
+      (obj.reason = installedPackageStatus_StatusReasonToJSON(message.reason));
+    message.userReason !== undefined && (obj.userReason = message.userReason);
+    if (message.conditions) {
+      obj.conditions = message.conditions.map(e =>
+        e ? InstalledPackageCondition.toJSON(e) : undefined,
       );
     } else {
-      obj.installedPackageSummaries = [];
+      obj.conditions = [];
     }
-    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
     return obj;
   },
 
-  fromPartial(
-    object: DeepPartial<GetInstalledPackageSummariesResponse>,
-  ): GetInstalledPackageSummariesResponse {
-    const message = {
-      ...baseGetInstalledPackageSummariesResponse,
-    } as GetInstalledPackageSummariesResponse;
-    message.installedPackageSummaries = [];
-    if (
-      object.installedPackageSummaries !== undefined &&
-      object.installedPackageSummaries !== null
-    ) {
-      for (const e of object.installedPackageSummaries) {
-        message.installedPackageSummaries.push(InstalledPackageSummary.fromPartial(e));
-      }
+  fromPartial(object: DeepPartial<InstalledPackageStatus>): InstalledPackageStatus {
+    const message = { ...baseInstalledPackageStatus } as InstalledPackageStatus;
+    message.conditions = [];
+    if (object.ready !== undefined && object.ready !== null) {
+      message.ready = object.ready;
+    } else {
+      message.ready = false;
     }
-    if (object.nextPageToken !== undefined && object.nextPageToken !== null) {
-      message.nextPageToken = object.nextPageToken;
+    if (object.reason !== undefined && object.reason !== null) {
+      message.reason = object.reason;
     } else {
-      message.nextPageToken = "";
+      message.reason = 0;
+    }
+    if (object.userReason !== undefined && object.userReason !== null) {
+      message.userReason = object.userReason;
+    } else {
+      message.userReason = "";
+    }
+    if (object.conditions !== undefined && object.conditions !== null) {
+      for (const e of object.conditions) {
+        message.conditions.push(InstalledPackageCondition.fromPartial(e));
+      }
     }
     return message;
   },
 };
 
-const baseGetInstalledPackageDetailResponse: object = {};
+const baseInstalledPackageCondition: object = {
+  type: "",
+  status: "",
+  reason: "",
+  message: "",
+  lastTransitionTime: "",
+};
 
-export const GetInstalledPackageDetailResponse = {
-  encode(
-    message: GetInstalledPackageDetailResponse,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.installedPackageDetail !== undefined) {
-      InstalledPackageDetail.encode(
-        message.installedPackageDetail,
-        writer.uint32(10).fork(),
-      ).ldelim();
+export const InstalledPackageCondition = {
+  encode(message: InstalledPackageCondition, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== "") {
+      writer.uint32(10).string(message.type);
+    }
+    if (message.status !== "") {
+      writer.uint32(18).string(message.status);
+    }
+    if (message.reason !== "") {
+      writer.uint32(26).string(message.reason);
+    }
+    if (message.message !== "") {
+      writer.uint32(34).string(message.message);
+    }
+    if (message.lastTransitionTime !== "") {
+      writer.uint32(42).string(message.lastTransitionTime);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDetailResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageCondition {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
     const message = {
-      ...baseGetInstalledPackageDetailResponse,
-    } as GetInstalledPackageDetailResponse;
+      ...baseInstalledPackageCondition,
+    } as InstalledPackageCondition;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.installedPackageDetail = InstalledPackageDetail.decode(reader, reader.uint32());
+          message.type = reader.string();
+          break;
+        case 2:
+          message.status = reader.string();
+          break;
+        case 3:
+          message.reason = reader.string();
+          break;
+        case 4:
+          message.message = reader.string();
+          break;
+        case 5:
+          message.lastTransitionTime = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -2188,73 +9924,131 @@ export const GetInstalledPackageDetailResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetInstalledPackageDetailResponse {
+  fromJSON(object: any): InstalledPackageCondition {
     const message = {
-      ...baseGetInstalledPackageDetailResponse,
-    } as GetInstalledPackageDetailResponse;
-    if (object.installedPackageDetail !== undefined && object.installedPackageDetail !== null) {
-      message.installedPackageDetail = InstalledPackageDetail.fromJSON(
-        object.installedPackageDetail,
-      );
+      ...baseInstalledPackageCondition,
+    } as InstalledPackageCondition;
+    if (object.type !== undefined && object.type !== null) {
+      message.type = String(object.type);
+    } else {
+      message.type = "";
+    }
+    if (object.status !== undefined && object.status !== null) {
+      message.status = String(object.status);
+    } else {
+      message.status = "";
+    }
+    if (object.reason !== undefined && object.reason !== null) {
+      message.reason = String(object.reason);
     } else {
-      message.installedPackageDetail = undefined;
+      message.reason = "";
+    }
+    if (object.message !== undefined && object.message !== null) {
+      message.message = String(object.message);
+    } else {
+      message.message = "";
+    }
+    if (object.lastTransitionTime !== undefined && object.lastTransitionTime !== null) {
+      message.lastTransitionTime = String(object.lastTransitionTime);
+    } else {
+      message.lastTransitionTime = "";
     }
     return message;
   },
 
-  toJSON(message: GetInstalledPackageDetailResponse): unknown {
+  toJSON(message: InstalledPackageCondition): unknown {
     const obj: any = {};
-    message.installedPackageDetail !== undefined &&
-      (obj.installedPackageDetail = message.installedPackageDetail
-        ? InstalledPackageDetail.toJSON(message.installedPackageDetail)
-        : undefined);
+    message.type !== undefined && (obj.type = message.type);
+    message.status !== undefined && (obj.status = message.status);
+    message.reason !== undefined && (obj.reason = message.reason);
+    message.message !== undefined && (obj.message = message.message);
+    message.lastTransitionTime !== undefined &&
+      (obj.lastTransitionTime = message.lastTransitionTime);
     return obj;
   },
 
-  fromPartial(
-    object: DeepPartial<GetInstalledPackageDetailResponse>,
-  ): GetInstalledPackageDetailResponse {
+  fromPartial(object: DeepPartial<InstalledPackageCondition>): InstalledPackageCondition {
     const message = {
-      ...baseGetInstalledPackageDetailResponse,
-    } as GetInstalledPackageDetailResponse;
-    if (object.installedPackageDetail !== undefined && object.installedPackageDetail !== null) {
-      message.installedPackageDetail = InstalledPackageDetail.fromPartial(
-        object.installedPackageDetail,
-      );
+      ...baseInstalledPackageCondition,
+    } as InstalledPackageCondition;
+    if (object.type !== undefined && object.type !== null) {
+      message.type = object.type;
     } else {
-      message.installedPackageDetail = undefined;
+      message.type = "";
+    }
+    if (object.status !== undefined && object.status !== null) {
+      message.status = object.status;
+    } else {
+      message.status = "";
+    }
+    if (object.reason !== undefined && object.reason !== null) {
+      message.reason = object.reason;
+    } else {
+      message.reason = "";
+    }
+    if (object.message !== undefined && object.message !== null) {
+      message.message = object.message;
+    } else {
+      message.message = "";
+    }
+    if (object.lastTransitionTime !== undefined && object.lastTransitionTime !== null) {
+      message.lastTransitionTime = object.lastTransitionTime;
+    } else {
+      message.lastTransitionTime = "";
     }
     return message;
   },
 };
 
-const baseCreateInstalledPackageResponse: object = {};
+const baseWorkloadStatus: object = {
+  workloads: 0,
+  readyWorkloads: 0,
+  desiredReplicas: 0,
+  readyReplicas: 0,
+  progressPercentage: 0,
+};
 
-export const CreateInstalledPackageResponse = {
-  encode(
-    message: CreateInstalledPackageResponse,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.installedPackageRef !== undefined) {
-      InstalledPackageReference.encode(
-        message.installedPackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
+export const WorkloadStatus = {
+  encode(message: WorkloadStatus, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.workloads !== 0) {
+      writer.uint32(8).int32(message.workloads);
+    }
+    if (message.readyWorkloads !== 0) {
+      writer.uint32(16).int32(message.readyWorkloads);
+    }
+    if (message.desiredReplicas !== 0) {
+      writer.uint32(24).int32(message.desiredReplicas);
+    }
+    if (message.readyReplicas !== 0) {
+      writer.uint32(32).int32(message.readyReplicas);
+    }
+    if (message.progressPercentage !== 0) {
+      writer.uint32(40).int32(message.progressPercentage);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): CreateInstalledPackageResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): WorkloadStatus {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseCreateInstalledPackageResponse,
-    } as CreateInstalledPackageResponse;
+    const message = { ...baseWorkloadStatus } as WorkloadStatus;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          message.workloads = reader.int32();
+          break;
+        case 2:
+          message.readyWorkloads = reader.int32();
+          break;
+        case 3:
+          message.desiredReplicas = reader.int32();
+          break;
+        case 4:
+          message.readyReplicas = reader.int32();
+          break;
+        case 5:
+          message.progressPercentage = reader.int32();
           break;
         default:
           reader.skipType(tag & 7);
@@ -2264,69 +10058,113 @@ export const CreateInstalledPackageResponse = {
     return message;
   },
 
-  fromJSON(object: any): CreateInstalledPackageResponse {
-    const message = {
-      ...baseCreateInstalledPackageResponse,
-    } as CreateInstalledPackageResponse;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+  fromJSON(object: any): WorkloadStatus {
+    const message = { ...baseWorkloadStatus } as WorkloadStatus;
+    if (object.workloads !== undefined && object.workloads !== null) {
+      message.workloads = Number(object.workloads);
     } else {
-      message.installedPackageRef = undefined;
+      message.workloads = 0;
+    }
+    if (object.readyWorkloads !== undefined && object.readyWorkloads !== null) {
+      message.readyWorkloads = Number(object.readyWorkloads);
+    } else {
+      message.readyWorkloads = 0;
+    }
+    if (object.desiredReplicas !== undefined && object.desiredReplicas !== null) {
+      message.desiredReplicas = Number(object.desiredReplicas);
+    } else {
+      message.desiredReplicas = 0;
+    }
+    if (object.readyReplicas !== undefined && object.readyReplicas !== null) {
+      message.readyReplicas = Number(object.readyReplicas);
+    } else {
+      message.readyReplicas = 0;
+    }
+    if (object.progressPercentage !== undefined && object.progressPercentage !== null) {
+      message.progressPercentage = Number(object.progressPercentage);
+    } else {
+      message.progressPercentage = 0;
     }
     return message;
   },
 
-  toJSON(message: CreateInstalledPackageResponse): unknown {
+  toJSON(message: WorkloadStatus): unknown {
     const obj: any = {};
-    message.installedPackageRef !== undefined &&
-      (obj.installedPackageRef = message.installedPackageRef
-        ? InstalledPackageReference.toJSON(message.installedPackageRef)
-        : undefined);
+    message.workloads !== undefined && (obj.workloads = message.workloads);
+    message.readyWorkloads !== undefined && (obj.readyWorkloads = message.readyWorkloads);
+    message.desiredReplicas !== undefined && (obj.desiredReplicas = message.desiredReplicas);
+    message.readyReplicas !== undefined && (obj.readyReplicas = message.readyReplicas);
+    message.progressPercentage !== undefined &&
+      (obj.progressPercentage = message.progressPercentage);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<CreateInstalledPackageResponse>): CreateInstalledPackageResponse {
-    const message = {
-      ...baseCreateInstalledPackageResponse,
-    } as CreateInstalledPackageResponse;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromPartial(
-        object.installedPackageRef,
-      );
+  fromPartial(object: DeepPartial<WorkloadStatus>): WorkloadStatus {
+    const message = { ...baseWorkloadStatus } as WorkloadStatus;
+    if (object.workloads !== undefined && object.workloads !== null) {
+      message.workloads = object.workloads;
     } else {
-      message.installedPackageRef = undefined;
+      message.workloads = 0;
+    }
+    if (object.readyWorkloads !== undefined && object.readyWorkloads !== null) {
+      message.readyWorkloads = object.readyWorkloads;
+    } else {
+      message.readyWorkloads = 0;
+    }
+    if (object.desiredReplicas !== undefined && object.desiredReplicas !== null) {
+      message.desiredReplicas = object.desiredReplicas;
+    } else {
+      message.desiredReplicas = 0;
+    }
+    if (object.readyReplicas !== undefined && object.readyReplicas !== null) {
+      message.readyReplicas = object.readyReplicas;
+    } else {
+      message.readyReplicas = 0;
+    }
+    if (object.progressPercentage !== undefined && object.progressPercentage !== null) {
+      message.progressPercentage = object.progressPercentage;
+    } else {
+      message.progressPercentage = 0;
     }
     return message;
   },
 };
 
-const baseUpdateInstalledPackageResponse: object = {};
+const baseReconciliationOptions: object = {
+  interval: 0,
+  suspend: false,
+  serviceAccountName: "",
+};
 
-export const UpdateInstalledPackageResponse = {
-  encode(
-    message: UpdateInstalledPackageResponse,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.installedPackageRef !== undefined) {
-      InstalledPackageReference.encode(
-        message.installedPackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
+export const ReconciliationOptions = {
+  encode(message: ReconciliationOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.interval !== 0) {
+      writer.uint32(8).int32(message.interval);
+    }
+    if (message.suspend === true) {
+      writer.uint32(16).bool(message.suspend);
+    }
+    if (message.serviceAccountName !== "") {
+      writer.uint32(26).string(message.serviceAccountName);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateInstalledPackageResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ReconciliationOptions {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseUpdateInstalledPackageResponse,
-    } as UpdateInstalledPackageResponse;
+    const message = { ...baseReconciliationOptions } as ReconciliationOptions;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          message.interval = reader.int32();
+          break;
+        case 2:
+          message.suspend = reader.bool();
+          break;
+        case 3:
+          message.serviceAccountName = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -2336,58 +10174,92 @@ export const UpdateInstalledPackageResponse = {
     return message;
   },
 
-  fromJSON(object: any): UpdateInstalledPackageResponse {
-    const message = {
-      ...baseUpdateInstalledPackageResponse,
-    } as UpdateInstalledPackageResponse;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
+  fromJSON(object: any): ReconciliationOptions {
+    const message = { ...baseReconciliationOptions } as ReconciliationOptions;
+    if (object.interval !== undefined && object.interval !== null) {
+      message.interval = Number(object.interval);
     } else {
-      message.installedPackageRef = undefined;
+      message.interval = 0;
+    }
+    if (object.suspend !== undefined && object.suspend !== null) {
+      message.suspend = Boolean(object.suspend);
+    } else {
+      message.suspend = false;
+    }
+    if (object.serviceAccountName !== undefined && object.serviceAccountName !== null) {
+      message.serviceAccountName = String(object.serviceAccountName);
+    } else {
+      message.serviceAccountName = "";
     }
     return message;
   },
 
-  toJSON(message: UpdateInstalledPackageResponse): unknown {
+  toJSON(message: ReconciliationOptions): unknown {
     const obj: any = {};
-    message.installedPackageRef !== undefined &&
-      (obj.installedPackageRef = message.installedPackageRef
-        ? InstalledPackageReference.toJSON(message.installedPackageRef)
-        : undefined);
+    message.interval !== undefined && (obj.interval = message.interval);
+    message.suspend !== undefined && (obj.suspend = message.suspend);
+    message.serviceAccountName !== undefined &&
+      (obj.serviceAccountName = message.serviceAccountName);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<UpdateInstalledPackageResponse>): UpdateInstalledPackageResponse {
-    const message = {
-      ...baseUpdateInstalledPackageResponse,
-    } as UpdateInstalledPackageResponse;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromPartial(
-        object.installedPackageRef,
-      );
+  fromPartial(object: DeepPartial<ReconciliationOptions>): ReconciliationOptions {
+    const message = { ...baseReconciliationOptions } as ReconciliationOptions;
+    if (object.interval !== undefined && object.interval !== null) {
+      message.interval = object.interval;
     } else {
-      message.installedPackageRef = undefined;
+      message.interval = 0;
+    }
+    if (object.suspend !== undefined && object.suspend !== null) {
+      message.suspend = object.suspend;
+    } else {
+      message.suspend = false;
+    }
+    if (object.serviceAccountName !== undefined && object.serviceAccountName !== null) {
+      message.serviceAccountName = object.serviceAccountName;
+    } else {
+      message.serviceAccountName = "";
     }
     return message;
   },
 };
 
-const baseDeleteInstalledPackageResponse: object = {};
+const basePackageAppVersion: object = {
+  pkgVersion: "",
+  appVersion: "",
+  relation: 0,
+};
 
-export const DeleteInstalledPackageResponse = {
-  encode(_: DeleteInstalledPackageResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const PackageAppVersion = {
+  encode(message: PackageAppVersion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pkgVersion !== "") {
+      writer.uint32(10).string(message.pkgVersion);
+    }
+    if (message.appVersion !== "") {
+      writer.uint32(18).string(message.appVersion);
+    }
+    if (message.relation !== 0) {
+      writer.uint32(24).int32(message.relation);
+    }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackageResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PackageAppVersion {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseDeleteInstalledPackageResponse,
-    } as DeleteInstalledPackageResponse;
+    const message = { ...basePackageAppVersion } as PackageAppVersion;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1:
+          message.pkgVersion = reader.string();
+          break;
+        case 2:
+          message.appVersion = reader.string();
+          break;
+        case 3:
+          message.relation = reader.int32() as any;
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -2396,93 +10268,87 @@ export const DeleteInstalledPackageResponse = {
     return message;
   },
 
-  fromJSON(_: any): DeleteInstalledPackageResponse {
-    const message = {
-      ...baseDeleteInstalledPackageResponse,
-    } as DeleteInstalledPackageResponse;
+  fromJSON(object: any): PackageAppVersion {
+    const message = { ...basePackageAppVersion } as PackageAppVersion;
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = String(object.pkgVersion);
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.appVersion !== undefined && object.appVersion !== null) {
+      message.appVersion = String(object.appVersion);
+    } else {
+      message.appVersion = "";
+    }
+    if (object.relation !== undefined && object.relation !== null) {
+      message.relation = packageAppVersion_VersionRelationFromJSON(object.relation);
+    } else {
+      message.relation = 0;
+    }
     return message;
   },
 
-  toJSON(_: DeleteInstalledPackageResponse): unknown {
+  toJSON(message: PackageAppVersion): unknown {
     const obj: any = {};
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    message.relation !== undefined &&
+      (obj.relation = packageAppVersion_VersionRelationToJSON(message.relation));
     return obj;
   },
 
-  fromPartial(_: DeepPartial<DeleteInstalledPackageResponse>): DeleteInstalledPackageResponse {
-    const message = {
-      ...baseDeleteInstalledPackageResponse,
-    } as DeleteInstalledPackageResponse;
+  fromPartial(object: DeepPartial<PackageAppVersion>): PackageAppVersion {
+    const message = { ...basePackageAppVersion } as PackageAppVersion;
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = object.pkgVersion;
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.appVersion !== undefined && object.appVersion !== null) {
+      message.appVersion = object.appVersion;
+    } else {
+      message.appVersion = "";
+    }
+    if (object.relation !== undefined && object.relation !== null) {
+      message.relation = object.relation;
+    } else {
+      message.relation = 0;
+    }
     return message;
   },
 };
 
-const baseAvailablePackageSummary: object = {
-  name: "",
-  iconUrl: "",
-  displayName: "",
-  shortDescription: "",
-  categories: "",
-};
+const basePackageVersionGroup: object = { minorVersion: "", count: 0 };
 
-export const AvailablePackageSummary = {
-  encode(message: AvailablePackageSummary, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.availablePackageRef !== undefined) {
-      AvailablePackageReference.encode(
-        message.availablePackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
-    }
-    if (message.name !== "") {
-      writer.uint32(18).string(message.name);
-    }
-    if (message.latestVersion !== undefined) {
-      PackageAppVersion.encode(message.latestVersion, writer.uint32(26).fork()).ldelim();
-    }
-    if (message.iconUrl !== "") {
-      writer.uint32(34).string(message.iconUrl);
-    }
-    if (message.displayName !== "") {
-      writer.uint32(42).string(message.displayName);
+export const PackageVersionGroup = {
+  encode(message: PackageVersionGroup, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.minorVersion !== "") {
+      writer.uint32(10).string(message.minorVersion);
     }
-    if (message.shortDescription !== "") {
-      writer.uint32(50).string(message.shortDescription);
+    if (message.latest !== undefined) {
+      PackageAppVersion.encode(message.latest, writer.uint32(18).fork()).ldelim();
     }
-    for (const v of message.categories) {
-      writer.uint32(58).string(v!);
+    if (message.count !== 0) {
+      writer.uint32(24).int32(message.count);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): AvailablePackageSummary {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PackageVersionGroup {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseAvailablePackageSummary,
-    } as AvailablePackageSummary;
-    message.categories = [];
+    const message = { ...basePackageVersionGroup } as PackageVersionGroup;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          message.minorVersion = reader.string();
           break;
         case 2:
-          message.name = reader.string();
+          message.latest = PackageAppVersion.decode(reader, reader.uint32());
           break;
         case 3:
-          message.latestVersion = PackageAppVersion.decode(reader, reader.uint32());
-          break;
-        case 4:
-          message.iconUrl = reader.string();
-          break;
-        case 5:
-          message.displayName = reader.string();
-          break;
-        case 6:
-          message.shortDescription = reader.string();
-          break;
-        case 7:
-          message.categories.push(reader.string());
+          message.count = reader.int32();
           break;
         default:
           reader.skipType(tag & 7);
@@ -2492,245 +10358,105 @@ export const AvailablePackageSummary = {
     return message;
   },
 
-  fromJSON(object: any): AvailablePackageSummary {
-    const message = {
-      ...baseAvailablePackageSummary,
-    } as AvailablePackageSummary;
-    message.categories = [];
-    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
-      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
-    } else {
-      message.availablePackageRef = undefined;
-    }
-    if (object.name !== undefined && object.name !== null) {
-      message.name = String(object.name);
-    } else {
-      message.name = "";
-    }
-    if (object.latestVersion !== undefined && object.latestVersion !== null) {
-      message.latestVersion = PackageAppVersion.fromJSON(object.latestVersion);
-    } else {
-      message.latestVersion = undefined;
-    }
-    if (object.iconUrl !== undefined && object.iconUrl !== null) {
-      message.iconUrl = String(object.iconUrl);
+  fromJSON(object: any): PackageVersionGroup {
+    const message = { ...basePackageVersionGroup } as PackageVersionGroup;
+    if (object.minorVersion !== undefined && object.minorVersion !== null) {
+      message.minorVersion = String(object.minorVersion);
     } else {
-      message.iconUrl = "";
+      message.minorVersion = "";
     }
-    if (object.displayName !== undefined && object.displayName !== null) {
-      message.displayName = String(object.displayName);
+    if (object.latest !== undefined && object.latest !== null) {
+      message.latest = PackageAppVersion.fromJSON(object.latest);
     } else {
-      message.displayName = "";
+      message.latest = undefined;
     }
-    if (object.shortDescription !== undefined && object.shortDescription !== null) {
-      message.shortDescription = String(object.shortDescription);
+    if (object.count !== undefined && object.count !== null) {
+      message.count = Number(object.count);
     } else {
-      message.shortDescription = "";
-    }
-    if (object.categories !== undefined && object.categories !== null) {
-      for (const e of object.categories) {
-        message.categories.push(String(e));
-      }
+      message.count = 0;
     }
     return message;
   },
 
-  toJSON(message: AvailablePackageSummary): unknown {
+  toJSON(message: PackageVersionGroup): unknown {
     const obj: any = {};
-    message.availablePackageRef !== undefined &&
-      (obj.availablePackageRef = message.availablePackageRef
-        ? AvailablePackageReference.toJSON(message.availablePackageRef)
-        : undefined);
-    message.name !== undefined && (obj.name = message.name);
-    message.latestVersion !== undefined &&
-      (obj.latestVersion = message.latestVersion
-        ? PackageAppVersion.toJSON(message.latestVersion)
-        : undefined);
-    message.iconUrl !== undefined && (obj.iconUrl = message.iconUrl);
-    message.displayName !== undefined && (obj.displayName = message.displayName);
-    message.shortDescription !== undefined && (obj.shortDescription = message.shortDescription);
-    if (message.categories) {
-      obj.categories = message.categories.map(e => e);
-    } else {
-      obj.categories = [];
-    }
+    message.minorVersion !== undefined && (obj.minorVersion = message.minorVersion);
+    message.latest !== undefined &&
+      (obj.latest = message.latest ? PackageAppVersion.toJSON(message.latest) : undefined);
+    message.count !== undefined && (obj.count = message.count);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<AvailablePackageSummary>): AvailablePackageSummary {
-    const message = {
-      ...baseAvailablePackageSummary,
-    } as AvailablePackageSummary;
-    message.categories = [];
-    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
-      message.availablePackageRef = AvailablePackageReference.fromPartial(
-        object.availablePackageRef,
-      );
-    } else {
-      message.availablePackageRef = undefined;
-    }
-    if (object.name !== undefined && object.name !== null) {
-      message.name = object.name;
-    } else {
-      message.name = "";
-    }
-    if (object.latestVersion !== undefined && object.latestVersion !== null) {
-      message.latestVersion = PackageAppVersion.fromPartial(object.latestVersion);
-    } else {
-      message.latestVersion = undefined;
-    }
-    if (object.iconUrl !== undefined && object.iconUrl !== null) {
-      message.iconUrl = object.iconUrl;
+  fromPartial(object: DeepPartial<PackageVersionGroup>): PackageVersionGroup {
+    const message = { ...basePackageVersionGroup } as PackageVersionGroup;
+    if (object.minorVersion !== undefined && object.minorVersion !== null) {
+      message.minorVersion = object.minorVersion;
     } else {
-      message.iconUrl = "";
+      message.minorVersion = "";
     }
-    if (object.displayName !== undefined && object.displayName !== null) {
-      message.displayName = object.displayName;
+    if (object.latest !== undefined && object.latest !== null) {
+      message.latest = PackageAppVersion.fromPartial(object.latest);
     } else {
-      message.displayName = "";
+      message.latest = undefined;
     }
-    if (object.shortDescription !== undefined && object.shortDescription !== null) {
-      message.shortDescription = object.shortDescription;
+    if (object.count !== undefined && object.count !== null) {
+      message.count = object.count;
     } else {
-      message.shortDescription = "";
-    }
-    if (object.categories !== undefined && object.categories !== null) {
-      for (const e of object.categories) {
-        message.categories.push(e);
-      }
+      message.count = 0;
     }
     return message;
   },
 };
 
-const baseAvailablePackageDetail: object = {
+const basePackageDependency: object = {
   name: "",
-  repoUrl: "",
-  homeUrl: "",
-  iconUrl: "",
-  displayName: "",
-  shortDescription: "",
-  longDescription: "",
-  readme: "",
-  defaultValues: "",
-  valuesSchema: "",
-  sourceUrls: "",
-  categories: "",
+  version: "",
+  repository: "",
+  condition: "",
 };
 
-export const AvailablePackageDetail = {
-  encode(message: AvailablePackageDetail, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.availablePackageRef !== undefined) {
-      AvailablePackageReference.encode(
-        message.availablePackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
-    }
+export const PackageDependency = {
+  encode(message: PackageDependency, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
-      writer.uint32(18).string(message.name);
-    }
-    if (message.version !== undefined) {
-      PackageAppVersion.encode(message.version, writer.uint32(26).fork()).ldelim();
-    }
-    if (message.repoUrl !== "") {
-      writer.uint32(34).string(message.repoUrl);
-    }
-    if (message.homeUrl !== "") {
-      writer.uint32(42).string(message.homeUrl);
-    }
-    if (message.iconUrl !== "") {
-      writer.uint32(50).string(message.iconUrl);
-    }
-    if (message.displayName !== "") {
-      writer.uint32(58).string(message.displayName);
-    }
-    if (message.shortDescription !== "") {
-      writer.uint32(66).string(message.shortDescription);
-    }
-    if (message.longDescription !== "") {
-      writer.uint32(74).string(message.longDescription);
-    }
-    if (message.readme !== "") {
-      writer.uint32(82).string(message.readme);
-    }
-    if (message.defaultValues !== "") {
-      writer.uint32(90).string(message.defaultValues);
-    }
-    if (message.valuesSchema !== "") {
-      writer.uint32(98).string(message.valuesSchema);
-    }
-    for (const v of message.sourceUrls) {
-      writer.uint32(106).string(v!);
-    }
-    for (const v of message.maintainers) {
-      Maintainer.encode(v!, writer.uint32(114).fork()).ldelim();
-    }
-    for (const v of message.categories) {
-      writer.uint32(122).string(v!);
-    }
-    if (message.customDetail !== undefined) {
-      Any.encode(message.customDetail, writer.uint32(130).fork()).ldelim();
+      writer.uint32(10).string(message.name);
     }
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): AvailablePackageDetail {
-    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseAvailablePackageDetail } as AvailablePackageDetail;
-    message.sourceUrls = [];
-    message.maintainers = [];
-    message.categories = [];
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
-          break;
-        case 2:
-          message.name = reader.string();
-          break;
-        case 3:
-          message.version = PackageAppVersion.decode(reader, reader.uint32());
-          break;
-        case 4:
-          message.repoUrl = reader.string();
-          break;
-        case 5:
-          message.homeUrl = reader.string();
-          break;
-        case 6:
-          message.iconUrl = reader.string();
-          break;
-        case 7:
-          message.displayName = reader.string();
-          break;
-        case 8:
-          message.shortDescription = reader.string();
-          break;
-        case 9:
-          message.longDescription = reader.string();
-          break;
-        case 10:
-          message.readme = reader.string();
-          break;
-        case 11:
-          message.defaultValues = reader.string();
-          break;
-        case 12:
-          message.valuesSchema = reader.string();
+    if (message.version !== "") {
+      writer.uint32(18).string(message.version);
+    }
+    if (message.repository !== "") {
+      writer.uint32(26).string(message.repository);
+    }
+    if (message.condition !== "") {
+      writer.uint32(34).string(message.condition);
+    }
+    for (const v of message.dependencies) {
+      PackageDependency.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PackageDependency {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...basePackageDependency } as PackageDependency;
+    message.dependencies = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
           break;
-        case 13:
-          message.sourceUrls.push(reader.string());
+        case 2:
+          message.version = reader.string();
           break;
-        case 14:
-          message.maintainers.push(Maintainer.decode(reader, reader.uint32()));
+        case 3:
+          message.repository = reader.string();
           break;
-        case 15:
-          message.categories.push(reader.string());
+        case 4:
+          message.condition = reader.string();
           break;
-        case 16:
-          message.customDetail = Any.decode(reader, reader.uint32());
+        case 5:
+          message.dependencies.push(PackageDependency.decode(reader, reader.uint32()));
           break;
         default:
           reader.skipType(tag & 7);
@@ -2740,306 +10466,228 @@ export const AvailablePackageDetail = {
     return message;
   },
 
-  fromJSON(object: any): AvailablePackageDetail {
-    const message = { ...baseAvailablePackageDetail } as AvailablePackageDetail;
-    message.sourceUrls = [];
-    message.maintainers = [];
-    message.categories = [];
-    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
-      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
-    } else {
-      message.availablePackageRef = undefined;
-    }
+  fromJSON(object: any): PackageDependency {
+    const message = { ...basePackageDependency } as PackageDependency;
+    message.dependencies = [];
     if (object.name !== undefined && object.name !== null) {
       message.name = String(object.name);
     } else {
       message.name = "";
     }
     if (object.version !== undefined && object.version !== null) {
-      message.version = PackageAppVersion.fromJSON(object.version);
-    } else {
-      message.version = undefined;
-    }
-    if (object.repoUrl !== undefined && object.repoUrl !== null) {
-      message.repoUrl = String(object.repoUrl);
-    } else {
-      message.repoUrl = "";
-    }
-    if (object.homeUrl !== undefined && object.homeUrl !== null) {
-      message.homeUrl = String(object.homeUrl);
-    } else {
-      message.homeUrl = "";
-    }
-    if (object.iconUrl !== undefined && object.iconUrl !== null) {
-      message.iconUrl = String(object.iconUrl);
-    } else {
-      message.iconUrl = "";
-    }
-    if (object.displayName !== undefined && object.displayName !== null) {
-      message.displayName = String(object.displayName);
-    } else {
-      message.displayName = "";
-    }
-    if (object.shortDescription !== undefined && object.shortDescription !== null) {
-      message.shortDescription = String(object.shortDescription);
-    } else {
-      message.shortDescription = "";
-    }
-    if (object.longDescription !== undefined && object.longDescription !== null) {
-      message.longDescription = String(object.longDescription);
-    } else {
-      message.longDescription = "";
-    }
-    if (object.readme !== undefined && object.readme !== null) {
-      message.readme = String(object.readme);
+      message.version = String(object.version);
     } else {
-      message.readme = "";
+      message.version = "";
     }
-    if (object.defaultValues !== undefined && object.defaultValues !== null) {
-      message.defaultValues = String(object.defaultValues);
+    if (object.repository !== undefined && object.repository !== null) {
+      message.repository = String(object.repository);
     } else {
-      message.defaultValues = "";
+      message.repository = "";
     }
-    if (object.valuesSchema !== undefined && object.valuesSchema !== null) {
-      message.valuesSchema = String(object.valuesSchema);
+    if (object.condition !== undefined && object.condition !== null) {
+      message.condition = String(object.condition);
     } else {
-      message.valuesSchema = "";
-    }
-    if (object.sourceUrls !== undefined && object.sourceUrls !== null) {
-      for (const e of object.sourceUrls) {
-        message.sourceUrls.push(String(e));
-      }
+      message.condition = "";
     }
-    if (object.maintainers !== undefined && object.maintainers !== null) {
-      for (const e of object.maintainers) {
-        message.maintainers.push(Maintainer.fromJSON(e));
-      }
-    }
-    if (object.categories !== undefined && object.categories !== null) {
-      for (const e of object.categories) {
-        message.categories.push(String(e));
+    if (object.dependencies !== undefined && object.dependencies !== null) {
+      for (const e of object.dependencies) {
+        message.dependencies.push(PackageDependency.fromJSON(e));
       }
     }
-    if (object.customDetail !== undefined && object.customDetail !== null) {
-      message.customDetail = Any.fromJSON(object.customDetail);
-    } else {
-      message.customDetail = undefined;
-    }
     return message;
   },
 
-  toJSON(message: AvailablePackageDetail): unknown {
+  toJSON(message: PackageDependency): unknown {
     const obj: any = {};
-    message.availablePackageRef !== undefined &&
-      (obj.availablePackageRef = message.availablePackageRef
-        ? AvailablePackageReference.toJSON(message.availablePackageRef)
-        : undefined);
     message.name !== undefined && (obj.name = message.name);
-    message.version !== undefined &&
-      (obj.version = message.version ? PackageAppVersion.toJSON(message.version) : undefined);
-    message.repoUrl !== undefined && (obj.repoUrl = message.repoUrl);
-    message.homeUrl !== undefined && (obj.homeUrl = message.homeUrl);
-    message.iconUrl !== undefined && (obj.iconUrl = message.iconUrl);
-    message.displayName !== undefined && (obj.displayName = message.displayName);
-    message.shortDescription !== undefined && (obj.shortDescription = message.shortDescription);
-    message.longDescription !== undefined && (obj.longDescription = message.longDescription);
-    message.readme !== undefined && (obj.readme = message.readme);
-    message.defaultValues !== undefined && (obj.defaultValues = message.defaultValues);
-    message.valuesSchema !== undefined && (obj.valuesSchema = message.valuesSchema);
-    if (message.sourceUrls) {
-      obj.sourceUrls = message.sourceUrls.map(e => e);
-    } else {
-      obj.sourceUrls = [];
-    }
-    if (message.maintainers) {
-      obj.maintainers = message.maintainers.map(e => (e ? Maintainer.toJSON(e) : undefined));
-    } else {
-      obj.maintainers = [];
-    }
-    if (message.categories) {
-      obj.categories = message.categories.map(e => e);
+    message.version !== undefined && (obj.version = message.version);
+    message.repository !== undefined && (obj.repository = message.repository);
+    message.condition !== undefined && (obj.condition = message.condition);
+    if (message.dependencies) {
+      obj.dependencies = message.dependencies.map(e =>
+        e ? PackageDependency.toJSON(e) : undefined,
+      );
     } else {
-      obj.categories = [];
+      obj.dependencies = [];
     }
-    message.customDetail !== undefined &&
-      (obj.customDetail = message.customDetail ? Any.toJSON(message.customDetail) : undefined);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<AvailablePackageDetail>): AvailablePackageDetail {
-    const message = { ...baseAvailablePackageDetail } as AvailablePackageDetail;
-    message.sourceUrls = [];
-    message.maintainers = [];
-    message.categories = [];
-    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
-      message.availablePackageRef = AvailablePackageReference.fromPartial(
-        object.availablePackageRef,
-      );
-    } else {
-      message.availablePackageRef = undefined;
-    }
+  fromPartial(object: DeepPartial<PackageDependency>): PackageDependency {
+    const message = { ...basePackageDependency } as PackageDependency;
+    message.dependencies = [];
     if (object.name !== undefined && object.name !== null) {
       message.name = object.name;
     } else {
       message.name = "";
     }
     if (object.version !== undefined && object.version !== null) {
-      message.version = PackageAppVersion.fromPartial(object.version);
+      message.version = object.version;
     } else {
-      message.version = undefined;
+      message.version = "";
     }
-    if (object.repoUrl !== undefined && object.repoUrl !== null) {
-      message.repoUrl = object.repoUrl;
+    if (object.repository !== undefined && object.repository !== null) {
+      message.repository = object.repository;
     } else {
-      message.repoUrl = "";
+      message.repository = "";
     }
-    if (object.homeUrl !== undefined && object.homeUrl !== null) {
-      message.homeUrl = object.homeUrl;
+    if (object.condition !== undefined && object.condition !== null) {
+      message.condition = object.condition;
     } else {
-      message.homeUrl = "";
+      message.condition = "";
     }
-    if (object.iconUrl !== undefined && object.iconUrl !== null) {
-      message.iconUrl = object.iconUrl;
-    } else {
-      message.iconUrl = "";
+    if (object.dependencies !== undefined && object.dependencies !== null) {
+      for (const e of object.dependencies) {
+        message.dependencies.push(PackageDependency.fromPartial(e));
+      }
     }
-    if (object.displayName !== undefined && object.displayName !== null) {
-      message.displayName = object.displayName;
-    } else {
-      message.displayName = "";
+    return message;
+  },
+};
+
+const basePackageProvenance: object = {
+  signed: false,
+  signer: "",
+  verificationStatus: 0,
+};
+
+export const PackageProvenance = {
+  encode(message: PackageProvenance, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.signed === true) {
+      writer.uint32(8).bool(message.signed);
     }
-    if (object.shortDescription !== undefined && object.shortDescription !== null) {
-      message.shortDescription = object.shortDescription;
-    } else {
-      message.shortDescription = "";
+    if (message.signer !== "") {
+      writer.uint32(18).string(message.signer);
     }
-    if (object.longDescription !== undefined && object.longDescription !== null) {
-      message.longDescription = object.longDescription;
-    } else {
-      message.longDescription = "";
+    if (message.verificationStatus !== 0) {
+      writer.uint32(24).int32(message.verificationStatus);
     }
-    if (object.readme !== undefined && object.readme !== null) {
-      message.readme = object.readme;
-    } else {
-      message.readme = "";
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PackageProvenance {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...basePackageProvenance } as PackageProvenance;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.signed = reader.bool();
+          break;
+        case 2:
+          message.signer = reader.string();
+          break;
+        case 3:
+          message.verificationStatus = reader.int32() as any;
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
     }
-    if (object.defaultValues !== undefined && object.defaultValues !== null) {
-      message.defaultValues = object.defaultValues;
+    return message;
+  },
+
+  fromJSON(object: any): PackageProvenance {
+    const message = { ...basePackageProvenance } as PackageProvenance;
+    if (object.signed !== undefined && object.signed !== null) {
+      message.signed = Boolean(object.signed);
     } else {
-      message.defaultValues = "";
+      message.signed = false;
     }
-    if (object.valuesSchema !== undefined && object.valuesSchema !== null) {
-      message.valuesSchema = object.valuesSchema;
+    if (object.signer !== undefined && object.signer !== null) {
+      message.signer = String(object.signer);
     } else {
-      message.valuesSchema = "";
-    }
-    if (object.sourceUrls !== undefined && object.sourceUrls !== null) {
-      for (const e of object.sourceUrls) {
-        message.sourceUrls.push(e);
-      }
-    }
-    if (object.maintainers !== undefined && object.maintainers !== null) {
-      for (const e of object.maintainers) {
-        message.maintainers.push(Maintainer.fromPartial(e));
-      }
-    }
-    if (object.categories !== undefined && object.categories !== null) {
-      for (const e of object.categories) {
-        message.categories.push(e);
-      }
+      message.signer = "";
     }
-    if (object.customDetail !== undefined && object.customDetail !== null) {
-      message.customDetail = Any.fromPartial(object.customDetail);
+    if (object.verificationStatus !== undefined && object.verificationStatus !== null) {
+      message.verificationStatus = packageProvenance_VerificationStatusFromJSON(
+        object.verificationStatus,
+      );
     } else {
-      message.customDetail = undefined;
+      message.verificationStatus = 0;
     }
     return message;
   },
-};
 
-const baseInstalledPackageSummary: object = {
-  name: "",
-  iconUrl: "",
-  pkgDisplayName: "",
-  shortDescription: "",
-};
+  toJSON(message: PackageProvenance): unknown {
+    const obj: any = {};
+    message.signed !== undefined && (obj.signed = message.signed);
+    message.signer !== undefined && (obj.signer = message.signer);
+    message.verificationStatus !== undefined &&
+      (obj.verificationStatus = packageProvenance_VerificationStatusToJSON(message.verificationStatus));
+    return obj;
+  },
 
-export const InstalledPackageSummary = {
-  encode(message: InstalledPackageSummary, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.installedPackageRef !== undefined) {
-      InstalledPackageReference.encode(
-        message.installedPackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
-    }
-    if (message.name !== "") {
-      writer.uint32(18).string(message.name);
-    }
-    if (message.pkgVersionReference !== undefined) {
-      VersionReference.encode(message.pkgVersionReference, writer.uint32(26).fork()).ldelim();
-    }
-    if (message.currentVersion !== undefined) {
-      PackageAppVersion.encode(message.currentVersion, writer.uint32(34).fork()).ldelim();
+  fromPartial(object: DeepPartial<PackageProvenance>): PackageProvenance {
+    const message = { ...basePackageProvenance } as PackageProvenance;
+    if (object.signed !== undefined && object.signed !== null) {
+      message.signed = object.signed;
+    } else {
+      message.signed = false;
     }
-    if (message.iconUrl !== "") {
-      writer.uint32(42).string(message.iconUrl);
+    if (object.signer !== undefined && object.signer !== null) {
+      message.signer = object.signer;
+    } else {
+      message.signer = "";
     }
-    if (message.pkgDisplayName !== "") {
-      writer.uint32(50).string(message.pkgDisplayName);
+    if (object.verificationStatus !== undefined && object.verificationStatus !== null) {
+      message.verificationStatus = object.verificationStatus;
+    } else {
+      message.verificationStatus = 0;
     }
-    if (message.shortDescription !== "") {
-      writer.uint32(58).string(message.shortDescription);
+    return message;
+  },
+};
+
+const basePackageRepositoryReference: object = {
+  name: "",
+  namespace: "",
+  type: "",
+  url: "",
+};
+
+export const PackageRepositoryReference = {
+  encode(
+    message: PackageRepositoryReference,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.latestMatchingVersion !== undefined) {
-      PackageAppVersion.encode(message.latestMatchingVersion, writer.uint32(66).fork()).ldelim();
+    if (message.namespace !== "") {
+      writer.uint32(18).string(message.namespace);
     }
-    if (message.latestVersion !== undefined) {
-      PackageAppVersion.encode(message.latestVersion, writer.uint32(74).fork()).ldelim();
+    if (message.type !== "") {
+      writer.uint32(26).string(message.type);
     }
-    if (message.status !== undefined) {
-      InstalledPackageStatus.encode(message.status, writer.uint32(82).fork()).ldelim();
+    if (message.url !== "") {
+      writer.uint32(34).string(message.url);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageSummary {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PackageRepositoryReference {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
     const message = {
-      ...baseInstalledPackageSummary,
-    } as InstalledPackageSummary;
+      ...basePackageRepositoryReference,
+    } as PackageRepositoryReference;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          message.name = reader.string();
           break;
         case 2:
-          message.name = reader.string();
+          message.namespace = reader.string();
           break;
         case 3:
-          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
+          message.type = reader.string();
           break;
         case 4:
-          message.currentVersion = PackageAppVersion.decode(reader, reader.uint32());
-          break;
-        case 5:
-          message.iconUrl = reader.string();
-          break;
-        case 6:
-          message.pkgDisplayName = reader.string();
-          break;
-        case 7:
-          message.shortDescription = reader.string();
-          break;
-        case 8:
-          message.latestMatchingVersion = PackageAppVersion.decode(reader, reader.uint32());
-          break;
-        case 9:
-          message.latestVersion = PackageAppVersion.decode(reader, reader.uint32());
-          break;
-        case 10:
-          message.status = InstalledPackageStatus.decode(reader, reader.uint32());
+          message.url = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -3049,252 +10697,112 @@ export const InstalledPackageSummary = {
     return message;
   },
 
-  fromJSON(object: any): InstalledPackageSummary {
+  fromJSON(object: any): PackageRepositoryReference {
     const message = {
-      ...baseInstalledPackageSummary,
-    } as InstalledPackageSummary;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
-    } else {
-      message.installedPackageRef = undefined;
-    }
+      ...basePackageRepositoryReference,
+    } as PackageRepositoryReference;
     if (object.name !== undefined && object.name !== null) {
       message.name = String(object.name);
     } else {
       message.name = "";
     }
-    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
-      message.pkgVersionReference = VersionReference.fromJSON(object.pkgVersionReference);
-    } else {
-      message.pkgVersionReference = undefined;
-    }
-    if (object.currentVersion !== undefined && object.currentVersion !== null) {
-      message.currentVersion = PackageAppVersion.fromJSON(object.currentVersion);
-    } else {
-      message.currentVersion = undefined;
-    }
-    if (object.iconUrl !== undefined && object.iconUrl !== null) {
-      message.iconUrl = String(object.iconUrl);
-    } else {
-      message.iconUrl = "";
-    }
-    if (object.pkgDisplayName !== undefined && object.pkgDisplayName !== null) {
-      message.pkgDisplayName = String(object.pkgDisplayName);
-    } else {
-      message.pkgDisplayName = "";
-    }
-    if (object.shortDescription !== undefined && object.shortDescription !== null) {
-      message.shortDescription = String(object.shortDescription);
-    } else {
-      message.shortDescription = "";
-    }
-    if (object.latestMatchingVersion !== undefined && object.latestMatchingVersion !== null) {
-      message.latestMatchingVersion = PackageAppVersion.fromJSON(object.latestMatchingVersion);
+    if (object.namespace !== undefined && object.namespace !== null) {
+      message.namespace = String(object.namespace);
     } else {
-      message.latestMatchingVersion = undefined;
+      message.namespace = "";
     }
-    if (object.latestVersion !== undefined && object.latestVersion !== null) {
-      message.latestVersion = PackageAppVersion.fromJSON(object.latestVersion);
+    if (object.type !== undefined && object.type !== null) {
+      message.type = String(object.type);
     } else {
-      message.latestVersion = undefined;
+      message.type = "";
     }
-    if (object.status !== undefined && object.status !== null) {
-      message.status = InstalledPackageStatus.fromJSON(object.status);
+    if (object.url !== undefined && object.url !== null) {
+      message.url = String(object.url);
     } else {
-      message.status = undefined;
+      message.url = "";
     }
     return message;
   },
 
-  toJSON(message: InstalledPackageSummary): unknown {
+  toJSON(message: PackageRepositoryReference): unknown {
     const obj: any = {};
-    message.installedPackageRef !== undefined &&
-      (obj.installedPackageRef = message.installedPackageRef
-        ? InstalledPackageReference.toJSON(message.installedPackageRef)
-        : undefined);
     message.name !== undefined && (obj.name = message.name);
-    message.pkgVersionReference !== undefined &&
-      (obj.pkgVersionReference = message.pkgVersionReference
-        ? VersionReference.toJSON(message.pkgVersionReference)
-        : undefined);
-    message.currentVersion !== undefined &&
-      (obj.currentVersion = message.currentVersion
-        ? PackageAppVersion.toJSON(message.currentVersion)
-        : undefined);
-    message.iconUrl !== undefined && (obj.iconUrl = message.iconUrl);
-    message.pkgDisplayName !== undefined && (obj.pkgDisplayName = message.pkgDisplayName);
-    message.shortDescription !== undefined && (obj.shortDescription = message.shortDescription);
-    message.latestMatchingVersion !== undefined &&
-      (obj.latestMatchingVersion = message.latestMatchingVersion
-        ? PackageAppVersion.toJSON(message.latestMatchingVersion)
-        : undefined);
-    message.latestVersion !== undefined &&
-      (obj.latestVersion = message.latestVersion
-        ? PackageAppVersion.toJSON(message.latestVersion)
-        : undefined);
-    message.status !== undefined &&
-      (obj.status = message.status ? InstalledPackageStatus.toJSON(message.status) : undefined);
+    message.namespace !== undefined && (obj.namespace = message.namespace);
+    message.type !== undefined && (obj.type = message.type);
+    message.url !== undefined && (obj.url = message.url);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<InstalledPackageSummary>): InstalledPackageSummary {
+  fromPartial(object: DeepPartial<PackageRepositoryReference>): PackageRepositoryReference {
     const message = {
-      ...baseInstalledPackageSummary,
-    } as InstalledPackageSummary;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromPartial(
-        object.installedPackageRef,
-      );
-    } else {
-      message.installedPackageRef = undefined;
-    }
+      ...basePackageRepositoryReference,
+    } as PackageRepositoryReference;
     if (object.name !== undefined && object.name !== null) {
       message.name = object.name;
     } else {
       message.name = "";
     }
-    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
-      message.pkgVersionReference = VersionReference.fromPartial(object.pkgVersionReference);
-    } else {
-      message.pkgVersionReference = undefined;
-    }
-    if (object.currentVersion !== undefined && object.currentVersion !== null) {
-      message.currentVersion = PackageAppVersion.fromPartial(object.currentVersion);
-    } else {
-      message.currentVersion = undefined;
-    }
-    if (object.iconUrl !== undefined && object.iconUrl !== null) {
-      message.iconUrl = object.iconUrl;
-    } else {
-      message.iconUrl = "";
-    }
-    if (object.pkgDisplayName !== undefined && object.pkgDisplayName !== null) {
-      message.pkgDisplayName = object.pkgDisplayName;
-    } else {
-      message.pkgDisplayName = "";
-    }
-    if (object.shortDescription !== undefined && object.shortDescription !== null) {
-      message.shortDescription = object.shortDescription;
-    } else {
-      message.shortDescription = "";
-    }
-    if (object.latestMatchingVersion !== undefined && object.latestMatchingVersion !== null) {
-      message.latestMatchingVersion = PackageAppVersion.fromPartial(object.latestMatchingVersion);
+    if (object.namespace !== undefined && object.namespace !== null) {
+      message.namespace = object.namespace;
     } else {
-      message.latestMatchingVersion = undefined;
+      message.namespace = "";
     }
-    if (object.latestVersion !== undefined && object.latestVersion !== null) {
-      message.latestVersion = PackageAppVersion.fromPartial(object.latestVersion);
+    if (object.type !== undefined && object.type !== null) {
+      message.type = object.type;
     } else {
-      message.latestVersion = undefined;
+      message.type = "";
     }
-    if (object.status !== undefined && object.status !== null) {
-      message.status = InstalledPackageStatus.fromPartial(object.status);
+    if (object.url !== undefined && object.url !== null) {
+      message.url = object.url;
     } else {
-      message.status = undefined;
+      message.url = "";
     }
     return message;
   },
 };
 
-const baseInstalledPackageDetail: object = {
-  name: "",
-  valuesApplied: "",
-  postInstallationNotes: "",
+const baseCatalogStats: object = {
+  availablePackageCount: 0,
+  installedPackageCount: 0,
 };
 
-export const InstalledPackageDetail = {
-  encode(message: InstalledPackageDetail, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.installedPackageRef !== undefined) {
-      InstalledPackageReference.encode(
-        message.installedPackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
-    }
-    if (message.pkgVersionReference !== undefined) {
-      VersionReference.encode(message.pkgVersionReference, writer.uint32(18).fork()).ldelim();
-    }
-    if (message.name !== "") {
-      writer.uint32(26).string(message.name);
-    }
-    if (message.currentVersion !== undefined) {
-      PackageAppVersion.encode(message.currentVersion, writer.uint32(34).fork()).ldelim();
-    }
-    if (message.valuesApplied !== "") {
-      writer.uint32(42).string(message.valuesApplied);
-    }
-    if (message.reconciliationOptions !== undefined) {
-      ReconciliationOptions.encode(
-        message.reconciliationOptions,
-        writer.uint32(50).fork(),
-      ).ldelim();
-    }
-    if (message.status !== undefined) {
-      InstalledPackageStatus.encode(message.status, writer.uint32(58).fork()).ldelim();
-    }
-    if (message.postInstallationNotes !== "") {
-      writer.uint32(66).string(message.postInstallationNotes);
-    }
-    if (message.availablePackageRef !== undefined) {
-      AvailablePackageReference.encode(
-        message.availablePackageRef,
-        writer.uint32(74).fork(),
-      ).ldelim();
+export const CatalogStats = {
+  encode(message: CatalogStats, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.availablePackageCount !== 0) {
+      writer.uint32(8).int32(message.availablePackageCount);
     }
-    if (message.latestMatchingVersion !== undefined) {
-      PackageAppVersion.encode(message.latestMatchingVersion, writer.uint32(82).fork()).ldelim();
+    if (message.installedPackageCount !== 0) {
+      writer.uint32(16).int32(message.installedPackageCount);
     }
-    if (message.latestVersion !== undefined) {
-      PackageAppVersion.encode(message.latestVersion, writer.uint32(90).fork()).ldelim();
+    for (const v of message.pluginStats) {
+      PluginCatalogStats.encode(v!, writer.uint32(26).fork()).ldelim();
     }
-    if (message.customDetail !== undefined) {
-      Any.encode(message.customDetail, writer.uint32(114).fork()).ldelim();
+    for (const v of message.categoryStats) {
+      CategoryCatalogStats.encode(v!, writer.uint32(34).fork()).ldelim();
     }
     return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageDetail {
-    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseInstalledPackageDetail } as InstalledPackageDetail;
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
-          break;
-        case 2:
-          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
-          break;
-        case 3:
-          message.name = reader.string();
-          break;
-        case 4:
-          message.currentVersion = PackageAppVersion.decode(reader, reader.uint32());
-          break;
-        case 5:
-          message.valuesApplied = reader.string();
-          break;
-        case 6:
-          message.reconciliationOptions = ReconciliationOptions.decode(reader, reader.uint32());
-          break;
-        case 7:
-          message.status = InstalledPackageStatus.decode(reader, reader.uint32());
-          break;
-        case 8:
-          message.postInstallationNotes = reader.string();
-          break;
-        case 9:
-          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CatalogStats {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseCatalogStats } as CatalogStats;
+    message.pluginStats = [];
+    message.categoryStats = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageCount = reader.int32();
           break;
-        case 10:
-          message.latestMatchingVersion = PackageAppVersion.decode(reader, reader.uint32());
+        case 2:
+          message.installedPackageCount = reader.int32();
           break;
-        case 11:
-          message.latestVersion = PackageAppVersion.decode(reader, reader.uint32());
+        case 3:
+          message.pluginStats.push(PluginCatalogStats.decode(reader, reader.uint32()));
           break;
-        case 14:
-          message.customDetail = Any.decode(reader, reader.uint32());
+        case 4:
+          message.categoryStats.push(CategoryCatalogStats.decode(reader, reader.uint32()));
           break;
         default:
           reader.skipType(tag & 7);
@@ -3304,209 +10812,207 @@ export const InstalledPackageDetail = {
     return message;
   },
 
-  fromJSON(object: any): InstalledPackageDetail {
-    const message = { ...baseInstalledPackageDetail } as InstalledPackageDetail;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
-    } else {
-      message.installedPackageRef = undefined;
-    }
-    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
-      message.pkgVersionReference = VersionReference.fromJSON(object.pkgVersionReference);
-    } else {
-      message.pkgVersionReference = undefined;
-    }
-    if (object.name !== undefined && object.name !== null) {
-      message.name = String(object.name);
+  fromJSON(object: any): CatalogStats {
+    const message = { ...baseCatalogStats } as CatalogStats;
+    message.pluginStats = [];
+    message.categoryStats = [];
+    if (object.availablePackageCount !== undefined && object.availablePackageCount !== null) {
+      message.availablePackageCount = Number(object.availablePackageCount);
     } else {
-      message.name = "";
+      message.availablePackageCount = 0;
     }
-    if (object.currentVersion !== undefined && object.currentVersion !== null) {
-      message.currentVersion = PackageAppVersion.fromJSON(object.currentVersion);
+    if (object.installedPackageCount !== undefined && object.installedPackageCount !== null) {
+      message.installedPackageCount = Number(object.installedPackageCount);
     } else {
-      message.currentVersion = undefined;
+      message.installedPackageCount = 0;
     }
-    if (object.valuesApplied !== undefined && object.valuesApplied !== null) {
-      message.valuesApplied = String(object.valuesApplied);
-    } else {
-      message.valuesApplied = "";
+    if (object.pluginStats !== undefined && object.pluginStats !== null) {
+      for (const e of object.pluginStats) {
+        message.pluginStats.push(PluginCatalogStats.fromJSON(e));
+      }
     }
-    if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
-      message.reconciliationOptions = ReconciliationOptions.fromJSON(object.reconciliationOptions);
-    } else {
-      message.reconciliationOptions = undefined;
+    if (object.categoryStats !== undefined && object.categoryStats !== null) {
+      for (const e of object.categoryStats) {
+        message.categoryStats.push(CategoryCatalogStats.fromJSON(e));
+      }
     }
-    if (object.status !== undefined && object.status !== null) {
-      message.status = InstalledPackageStatus.fromJSON(object.status);
+    return message;
+  },
+
+  toJSON(message: CatalogStats): unknown {
+    const obj: any = {};
+    message.availablePackageCount !== undefined &&
+      (obj.availablePackageCount = message.availablePackageCount);
+    message.installedPackageCount !== undefined &&
+      (obj.installedPackageCount = message.installedPackageCount);
+    if (message.pluginStats) {
+      obj.pluginStats = message.pluginStats.map(e =>
+        e ? PluginCatalogStats.toJSON(e) : undefined,
+      );
     } else {
-      message.status = undefined;
+      obj.pluginStats = [];
     }
-    if (object.postInstallationNotes !== undefined && object.postInstallationNotes !== null) {
-      message.postInstallationNotes = String(object.postInstallationNotes);
+    if (message.categoryStats) {
+      obj.categoryStats = message.categoryStats.map(e =>
+        e ? CategoryCatalogStats.toJSON(e) : undefined,
+      );
     } else {
-      message.postInstallationNotes = "";
+      obj.categoryStats = [];
     }
-    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
-      message.availablePackageRef = AvailablePackageReference.fromJSON(object.availablePackageRef);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<CatalogStats>): CatalogStats {
+    const message = { ...baseCatalogStats } as CatalogStats;
+    message.pluginStats = [];
+    message.categoryStats = [];
+    if (object.availablePackageCount !== undefined && object.availablePackageCount !== null) {
+      message.availablePackageCount = object.availablePackageCount;
     } else {
-      message.availablePackageRef = undefined;
+      message.availablePackageCount = 0;
     }
-    if (object.latestMatchingVersion !== undefined && object.latestMatchingVersion !== null) {
-      message.latestMatchingVersion = PackageAppVersion.fromJSON(object.latestMatchingVersion);
+    if (object.installedPackageCount !== undefined && object.installedPackageCount !== null) {
+      message.installedPackageCount = object.installedPackageCount;
     } else {
-      message.latestMatchingVersion = undefined;
+      message.installedPackageCount = 0;
     }
-    if (object.latestVersion !== undefined && object.latestVersion !== null) {
-      message.latestVersion = PackageAppVersion.fromJSON(object.latestVersion);
-    } else {
-      message.latestVersion = undefined;
+    if (object.pluginStats !== undefined && object.pluginStats !== null) {
+      for (const e of object.pluginStats) {
+        message.pluginStats.push(PluginCatalogStats.fromPartial(e));
+      }
     }
-    if (object.customDetail !== undefined && object.customDetail !== null) {
-      message.customDetail = Any.fromJSON(object.customDetail);
-    } else {
-      message.customDetail = undefined;
+    if (object.categoryStats !== undefined && object.categoryStats !== null) {
+      for (const e of object.categoryStats) {
+        message.categoryStats.push(CategoryCatalogStats.fromPartial(e));
+      }
     }
     return message;
   },
+};
 
-  toJSON(message: InstalledPackageDetail): unknown {
-    const obj: any = {};
-    message.installedPackageRef !== undefined &&
-      (obj.installedPackageRef = message.installedPackageRef
-        ? InstalledPackageReference.toJSON(message.installedPackageRef)
-        : undefined);
-    message.pkgVersionReference !== undefined &&
-      (obj.pkgVersionReference = message.pkgVersionReference
-        ? VersionReference.toJSON(message.pkgVersionReference)
-        : undefined);
-    message.name !== undefined && (obj.name = message.name);
-    message.currentVersion !== undefined &&
-      (obj.currentVersion = message.currentVersion
-        ? PackageAppVersion.toJSON(message.currentVersion)
-        : undefined);
-    message.valuesApplied !== undefined && (obj.valuesApplied = message.valuesApplied);
-    message.reconciliationOptions !== undefined &&
-      (obj.reconciliationOptions = message.reconciliationOptions
-        ? ReconciliationOptions.toJSON(message.reconciliationOptions)
-        : undefined);
-    message.status !== undefined &&
-      (obj.status = message.status ? InstalledPackageStatus.toJSON(message.status) : undefined);
-    message.postInstallationNotes !== undefined &&
-      (obj.postInstallationNotes = message.postInstallationNotes);
-    message.availablePackageRef !== undefined &&
-      (obj.availablePackageRef = message.availablePackageRef
-        ? AvailablePackageReference.toJSON(message.availablePackageRef)
-        : undefined);
-    message.latestMatchingVersion !== undefined &&
-      (obj.latestMatchingVersion = message.latestMatchingVersion
-        ? PackageAppVersion.toJSON(message.latestMatchingVersion)
-        : undefined);
-    message.latestVersion !== undefined &&
-      (obj.latestVersion = message.latestVersion
-        ? PackageAppVersion.toJSON(message.latestVersion)
-        : undefined);
-    message.customDetail !== undefined &&
-      (obj.customDetail = message.customDetail ? Any.toJSON(message.customDetail) : undefined);
-    return obj;
-  },
+const basePluginCatalogStats: object = {
+  availablePackageCount: 0,
+  installedPackageCount: 0,
+};
 
-  fromPartial(object: DeepPartial<InstalledPackageDetail>): InstalledPackageDetail {
-    const message = { ...baseInstalledPackageDetail } as InstalledPackageDetail;
-    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
-      message.installedPackageRef = InstalledPackageReference.fromPartial(
-        object.installedPackageRef,
-      );
-    } else {
-      message.installedPackageRef = undefined;
-    }
-    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
-      message.pkgVersionReference = VersionReference.fromPartial(object.pkgVersionReference);
-    } else {
-      message.pkgVersionReference = undefined;
-    }
-    if (object.name !== undefined && object.name !== null) {
-      message.name = object.name;
-    } else {
-      message.name = "";
+export const PluginCatalogStats = {
+  encode(message: PluginCatalogStats, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.plugin !== undefined) {
+      Plugin.encode(message.plugin, writer.uint32(10).fork()).ldelim();
     }
-    if (object.currentVersion !== undefined && object.currentVersion !== null) {
-      message.currentVersion = PackageAppVersion.fromPartial(object.currentVersion);
-    } else {
-      message.currentVersion = undefined;
+    if (message.availablePackageCount !== 0) {
+      writer.uint32(16).int32(message.availablePackageCount);
     }
-    if (object.valuesApplied !== undefined && object.valuesApplied !== null) {
-      message.valuesApplied = object.valuesApplied;
-    } else {
-      message.valuesApplied = "";
+    if (message.installedPackageCount !== 0) {
+      writer.uint32(24).int32(message.installedPackageCount);
     }
-    if (object.reconciliationOptions !== undefined && object.reconciliationOptions !== null) {
-      message.reconciliationOptions = ReconciliationOptions.fromPartial(
-        object.reconciliationOptions,
-      );
-    } else {
-      message.reconciliationOptions = undefined;
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PluginCatalogStats {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...basePluginCatalogStats } as PluginCatalogStats;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.plugin = Plugin.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.availablePackageCount = reader.int32();
+          break;
+        case 3:
+          message.installedPackageCount = reader.int32();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
     }
-    if (object.status !== undefined && object.status !== null) {
-      message.status = InstalledPackageStatus.fromPartial(object.status);
+    return message;
+  },
+
+  fromJSON(object: any): PluginCatalogStats {
+    const message = { ...basePluginCatalogStats } as PluginCatalogStats;
+    if (object.plugin !== undefined && object.plugin !== null) {
+      message.plugin = Plugin.fromJSON(object.plugin);
     } else {
-      message.status = undefined;
+      message.plugin = undefined;
     }
-    if (object.postInstallationNotes !== undefined && object.postInstallationNotes !== null) {
-      message.postInstallationNotes = object.postInstallationNotes;
+    if (object.availablePackageCount !== undefined && object.availablePackageCount !== null) {
+      message.availablePackageCount = Number(object.availablePackageCount);
     } else {
-      message.postInstallationNotes = "";
+      message.availablePackageCount = 0;
     }
-    if (object.availablePackageRef !== undefined && object.availablePackageRef !== null) {
-      message.availablePackageRef = AvailablePackageReference.fromPartial(
-        object.availablePackageRef,
-      );
+    if (object.installedPackageCount !== undefined && object.installedPackageCount !== null) {
+      message.installedPackageCount = Number(object.installedPackageCount);
     } else {
-      message.availablePackageRef = undefined;
+      message.installedPackageCount = 0;
     }
-    if (object.latestMatchingVersion !== undefined && object.latestMatchingVersion !== null) {
-      message.latestMatchingVersion = PackageAppVersion.fromPartial(object.latestMatchingVersion);
+    return message;
+  },
+
+  toJSON(message: PluginCatalogStats): unknown {
+    const obj: any = {};
+    message.plugin !== undefined &&
+      (obj.plugin = message.plugin ? Plugin.toJSON(message.plugin) : undefined);
+    message.availablePackageCount !== undefined &&
+      (obj.availablePackageCount = message.availablePackageCount);
+    message.installedPackageCount !== undefined &&
+      (obj.installedPackageCount = message.installedPackageCount);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<PluginCatalogStats>): PluginCatalogStats {
+    const message = { ...basePluginCatalogStats } as PluginCatalogStats;
+    if (object.plugin !== undefined && object.plugin !== null) {
+      message.plugin = Plugin.fromPartial(object.plugin);
     } else {
-      message.latestMatchingVersion = undefined;
+      message.plugin = undefined;
     }
-    if (object.latestVersion !== undefined && object.latestVersion !== null) {
-      message.latestVersion = PackageAppVersion.fromPartial(object.latestVersion);
+    if (object.availablePackageCount !== undefined && object.availablePackageCount !== null) {
+      message.availablePackageCount = object.availablePackageCount;
     } else {
-      message.latestVersion = undefined;
+      message.availablePackageCount = 0;
     }
-    if (object.customDetail !== undefined && object.customDetail !== null) {
-      message.customDetail = Any.fromPartial(object.customDetail);
+    if (object.installedPackageCount !== undefined && object.installedPackageCount !== null) {
+      message.installedPackageCount = object.installedPackageCount;
     } else {
-      message.customDetail = undefined;
+      message.installedPackageCount = 0;
     }
     return message;
   },
 };
 
-const baseContext: object = { cluster: "", namespace: "" };
+const baseCategoryCatalogStats: object = {
+  category: "",
+  availablePackageCount: 0,
+};
 
-export const Context = {
-  encode(message: Context, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.cluster !== "") {
-      writer.uint32(10).string(message.cluster);
+export const CategoryCatalogStats = {
+  encode(message: CategoryCatalogStats, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.category !== "") {
+      writer.uint32(10).string(message.category);
     }
-    if (message.namespace !== "") {
-      writer.uint32(18).string(message.namespace);
+    if (message.availablePackageCount !== 0) {
+      writer.uint32(16).int32(message.availablePackageCount);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Context {
+  decode(input: _m0.Reader | Uint8Array, length?: number): CategoryCatalogStats {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseContext } as Context;
+    const message = { ...baseCategoryCatalogStats } as CategoryCatalogStats;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.cluster = reader.string();
+          message.category = reader.string();
           break;
         case 2:
-          message.namespace = reader.string();
+          message.availablePackageCount = reader.int32();
           break;
         default:
           reader.skipType(tag & 7);
@@ -3516,77 +11022,186 @@ export const Context = {
     return message;
   },
 
-  fromJSON(object: any): Context {
-    const message = { ...baseContext } as Context;
-    if (object.cluster !== undefined && object.cluster !== null) {
-      message.cluster = String(object.cluster);
+  fromJSON(object: any): CategoryCatalogStats {
+    const message = { ...baseCategoryCatalogStats } as CategoryCatalogStats;
+    if (object.category !== undefined && object.category !== null) {
+      message.category = String(object.category);
     } else {
-      message.cluster = "";
+      message.category = "";
+    }
+    if (object.availablePackageCount !== undefined && object.availablePackageCount !== null) {
+      message.availablePackageCount = Number(object.availablePackageCount);
+    } else {
+      message.availablePackageCount = 0;
+    }
+    return message;
+  },
+
+  toJSON(message: CategoryCatalogStats): unknown {
+    const obj: any = {};
+    message.category !== undefined && (obj.category = message.category);
+    message.availablePackageCount !== undefined &&
+      (obj.availablePackageCount = message.availablePackageCount);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<CategoryCatalogStats>): CategoryCatalogStats {
+    const message = { ...baseCategoryCatalogStats } as CategoryCatalogStats;
+    if (object.category !== undefined && object.category !== null) {
+      message.category = object.category;
+    } else {
+      message.category = "";
+    }
+    if (object.availablePackageCount !== undefined && object.availablePackageCount !== null) {
+      message.availablePackageCount = object.availablePackageCount;
+    } else {
+      message.availablePackageCount = 0;
+    }
+    return message;
+  },
+};
+
+const baseCatalogIndex: object = {};
+
+export const CatalogIndex = {
+  encode(message: CatalogIndex, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.entries) {
+      CatalogIndexEntry.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CatalogIndex {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseCatalogIndex } as CatalogIndex;
+    message.entries = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.entries.push(CatalogIndexEntry.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
     }
-    if (object.namespace !== undefined && object.namespace !== null) {
-      message.namespace = String(object.namespace);
-    } else {
-      message.namespace = "";
+    return message;
+  },
+
+  fromJSON(object: any): CatalogIndex {
+    const message = { ...baseCatalogIndex } as CatalogIndex;
+    message.entries = [];
+    if (object.entries !== undefined && object.entries !== null) {
+      for (const e of object.entries) {
+        message.entries.push(CatalogIndexEntry.fromJSON(e));
+      }
     }
     return message;
   },
 
-  toJSON(message: Context): unknown {
+  toJSON(message: CatalogIndex): unknown {
     const obj: any = {};
-    message.cluster !== undefined && (obj.cluster = message.cluster);
-    message.namespace !== undefined && (obj.namespace = message.namespace);
+    if (message.entries) {
+      obj.entries = message.entries.map(e => (e ? CatalogIndexEntry.toJSON(e) : undefined));
+    } else {
+      obj.entries = [];
+    }
     return obj;
   },
 
-  fromPartial(object: DeepPartial<Context>): Context {
-    const message = { ...baseContext } as Context;
-    if (object.cluster !== undefined && object.cluster !== null) {
-      message.cluster = object.cluster;
-    } else {
-      message.cluster = "";
-    }
-    if (object.namespace !== undefined && object.namespace !== null) {
-      message.namespace = object.namespace;
-    } else {
-      message.namespace = "";
+  fromPartial(object: DeepPartial<CatalogIndex>): CatalogIndex {
+    const message = { ...baseCatalogIndex } as CatalogIndex;
+    message.entries = [];
+    if (object.entries !== undefined && object.entries !== null) {
+      for (const e of object.entries) {
+        message.entries.push(CatalogIndexEntry.fromPartial(e));
+      }
     }
     return message;
   },
 };
 
-const baseAvailablePackageReference: object = { identifier: "" };
+const baseCatalogIndexEntry: object = {
+  identifier: "",
+  plugin: "",
+  name: "",
+  displayName: "",
+  pkgVersion: "",
+  appVersion: "",
+  categories: "",
+  repository: "",
+  pkgVersions: "",
+};
 
-export const AvailablePackageReference = {
-  encode(message: AvailablePackageReference, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.context !== undefined) {
-      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
-    }
+export const CatalogIndexEntry = {
+  encode(message: CatalogIndexEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.identifier !== "") {
-      writer.uint32(18).string(message.identifier);
+      writer.uint32(10).string(message.identifier);
     }
-    if (message.plugin !== undefined) {
-      Plugin.encode(message.plugin, writer.uint32(26).fork()).ldelim();
+    if (message.plugin !== "") {
+      writer.uint32(18).string(message.plugin);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.displayName !== "") {
+      writer.uint32(34).string(message.displayName);
+    }
+    if (message.pkgVersion !== "") {
+      writer.uint32(42).string(message.pkgVersion);
+    }
+    if (message.appVersion !== "") {
+      writer.uint32(50).string(message.appVersion);
+    }
+    for (const v of message.categories) {
+      writer.uint32(58).string(v!);
+    }
+    if (message.repository !== "") {
+      writer.uint32(66).string(message.repository);
+    }
+    for (const v of message.pkgVersions) {
+      writer.uint32(74).string(v!);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): AvailablePackageReference {
+  decode(input: _m0.Reader | Uint8Array, length?: number): CatalogIndexEntry {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseAvailablePackageReference,
-    } as AvailablePackageReference;
+    const message = { ...baseCatalogIndexEntry } as CatalogIndexEntry;
+    message.categories = [];
+    message.pkgVersions = [];
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.context = Context.decode(reader, reader.uint32());
+          message.identifier = reader.string();
           break;
         case 2:
-          message.identifier = reader.string();
+          message.plugin = reader.string();
           break;
         case 3:
-          message.plugin = Plugin.decode(reader, reader.uint32());
+          message.name = reader.string();
+          break;
+        case 4:
+          message.displayName = reader.string();
+          break;
+        case 5:
+          message.pkgVersion = reader.string();
+          break;
+        case 6:
+          message.appVersion = reader.string();
+          break;
+        case 7:
+          message.categories.push(reader.string());
+          break;
+        case 8:
+          message.repository = reader.string();
+          break;
+        case 9:
+          message.pkgVersions.push(reader.string());
           break;
         default:
           reader.skipType(tag & 7);
@@ -3596,86 +11211,168 @@ export const AvailablePackageReference = {
     return message;
   },
 
-  fromJSON(object: any): AvailablePackageReference {
-    const message = {
-      ...baseAvailablePackageReference,
-    } as AvailablePackageReference;
-    if (object.context !== undefined && object.context !== null) {
-      message.context = Context.fromJSON(object.context);
-    } else {
-      message.context = undefined;
-    }
+  fromJSON(object: any): CatalogIndexEntry {
+    const message = { ...baseCatalogIndexEntry } as CatalogIndexEntry;
+    message.categories = [];
+    message.pkgVersions = [];
     if (object.identifier !== undefined && object.identifier !== null) {
       message.identifier = String(object.identifier);
     } else {
       message.identifier = "";
     }
     if (object.plugin !== undefined && object.plugin !== null) {
-      message.plugin = Plugin.fromJSON(object.plugin);
+      message.plugin = String(object.plugin);
     } else {
-      message.plugin = undefined;
+      message.plugin = "";
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
+    } else {
+      message.name = "";
+    }
+    if (object.displayName !== undefined && object.displayName !== null) {
+      message.displayName = String(object.displayName);
+    } else {
+      message.displayName = "";
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = String(object.pkgVersion);
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.appVersion !== undefined && object.appVersion !== null) {
+      message.appVersion = String(object.appVersion);
+    } else {
+      message.appVersion = "";
+    }
+    if (object.categories !== undefined && object.categories !== null) {
+      for (const e of object.categories) {
+        message.categories.push(String(e));
+      }
+    }
+    if (object.repository !== undefined && object.repository !== null) {
+      message.repository = String(object.repository);
+    } else {
+      message.repository = "";
+    }
+    if (object.pkgVersions !== undefined && object.pkgVersions !== null) {
+      for (const e of object.pkgVersions) {
+        message.pkgVersions.push(String(e));
+      }
     }
     return message;
   },
 
-  toJSON(message: AvailablePackageReference): unknown {
+  toJSON(message: CatalogIndexEntry): unknown {
     const obj: any = {};
-    message.context !== undefined &&
-      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
     message.identifier !== undefined && (obj.identifier = message.identifier);
-    message.plugin !== undefined &&
-      (obj.plugin = message.plugin ? Plugin.toJSON(message.plugin) : undefined);
+    message.plugin !== undefined && (obj.plugin = message.plugin);
+    message.name !== undefined && (obj.name = message.name);
+    message.displayName !== undefined && (obj.displayName = message.displayName);
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    if (message.categories) {
+      obj.categories = message.categories.map(e => e);
+    } else {
+      obj.categories = [];
+    }
+    message.repository !== undefined && (obj.repository = message.repository);
+    if (message.pkgVersions) {
+      obj.pkgVersions = message.pkgVersions.map(e => e);
+    } else {
+      obj.pkgVersions = [];
+    }
     return obj;
   },
 
-  fromPartial(object: DeepPartial<AvailablePackageReference>): AvailablePackageReference {
-    const message = {
-      ...baseAvailablePackageReference,
-    } as AvailablePackageReference;
-    if (object.context !== undefined && object.context !== null) {
-      message.context = Context.fromPartial(object.context);
-    } else {
-      message.context = undefined;
-    }
+  fromPartial(object: DeepPartial<CatalogIndexEntry>): CatalogIndexEntry {
+    const message = { ...baseCatalogIndexEntry } as CatalogIndexEntry;
+    message.categories = [];
+    message.pkgVersions = [];
     if (object.identifier !== undefined && object.identifier !== null) {
       message.identifier = object.identifier;
     } else {
       message.identifier = "";
     }
     if (object.plugin !== undefined && object.plugin !== null) {
-      message.plugin = Plugin.fromPartial(object.plugin);
+      message.plugin = object.plugin;
     } else {
-      message.plugin = undefined;
+      message.plugin = "";
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
+    } else {
+      message.name = "";
+    }
+    if (object.displayName !== undefined && object.displayName !== null) {
+      message.displayName = object.displayName;
+    } else {
+      message.displayName = "";
+    }
+    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
+      message.pkgVersion = object.pkgVersion;
+    } else {
+      message.pkgVersion = "";
+    }
+    if (object.appVersion !== undefined && object.appVersion !== null) {
+      message.appVersion = object.appVersion;
+    } else {
+      message.appVersion = "";
+    }
+    if (object.categories !== undefined && object.categories !== null) {
+      for (const e of object.categories) {
+        message.categories.push(e);
+      }
+    }
+    if (object.repository !== undefined && object.repository !== null) {
+      message.repository = object.repository;
+    } else {
+      message.repository = "";
+    }
+    if (object.pkgVersions !== undefined && object.pkgVersions !== null) {
+      for (const e of object.pkgVersions) {
+        message.pkgVersions.push(e);
+      }
     }
     return message;
   },
 };
 
-const baseMaintainer: object = { name: "", email: "" };
+const baseLocalizedDescription: object = {
+  language: "",
+  shortDescription: "",
+  longDescription: "",
+};
 
-export const Maintainer = {
-  encode(message: Maintainer, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const LocalizedDescription = {
+  encode(message: LocalizedDescription, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.language !== "") {
+      writer.uint32(10).string(message.language);
     }
-    if (message.email !== "") {
-      writer.uint32(18).string(message.email);
+    if (message.shortDescription !== "") {
+      writer.uint32(18).string(message.shortDescription);
+    }
+    if (message.longDescription !== "") {
+      writer.uint32(26).string(message.longDescription);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Maintainer {
+  decode(input: _m0.Reader | Uint8Array, length?: number): LocalizedDescription {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseMaintainer } as Maintainer;
+    const message = { ...baseLocalizedDescription } as LocalizedDescription;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.name = reader.string();
+          message.language = reader.string();
           break;
         case 2:
-          message.email = reader.string();
+          message.shortDescription = reader.string();
+          break;
+        case 3:
+          message.longDescription = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -3685,95 +11382,97 @@ export const Maintainer = {
     return message;
   },
 
-  fromJSON(object: any): Maintainer {
-    const message = { ...baseMaintainer } as Maintainer;
-    if (object.name !== undefined && object.name !== null) {
-      message.name = String(object.name);
+  fromJSON(object: any): LocalizedDescription {
+    const message = { ...baseLocalizedDescription } as LocalizedDescription;
+    if (object.language !== undefined && object.language !== null) {
+      message.language = String(object.language);
     } else {
-      message.name = "";
+      message.language = "";
     }
-    if (object.email !== undefined && object.email !== null) {
-      message.email = String(object.email);
+    if (object.shortDescription !== undefined && object.shortDescription !== null) {
+      message.shortDescription = String(object.shortDescription);
     } else {
-      message.email = "";
+      message.shortDescription = "";
+    }
+    if (object.longDescription !== undefined && object.longDescription !== null) {
+      message.longDescription = String(object.longDescription);
+    } else {
+      message.longDescription = "";
     }
     return message;
   },
 
-  toJSON(message: Maintainer): unknown {
+  toJSON(message: LocalizedDescription): unknown {
     const obj: any = {};
-    message.name !== undefined && (obj.name = message.name);
-    message.email !== undefined && (obj.email = message.email);
+    message.language !== undefined && (obj.language = message.language);
+    message.shortDescription !== undefined && (obj.shortDescription = message.shortDescription);
+    message.longDescription !== undefined && (obj.longDescription = message.longDescription);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<Maintainer>): Maintainer {
-    const message = { ...baseMaintainer } as Maintainer;
-    if (object.name !== undefined && object.name !== null) {
-      message.name = object.name;
+  fromPartial(object: DeepPartial<LocalizedDescription>): LocalizedDescription {
+    const message = { ...baseLocalizedDescription } as LocalizedDescription;
+    if (object.language !== undefined && object.language !== null) {
+      message.language = object.language;
     } else {
-      message.name = "";
+      message.language = "";
     }
-    if (object.email !== undefined && object.email !== null) {
-      message.email = object.email;
+    if (object.shortDescription !== undefined && object.shortDescription !== null) {
+      message.shortDescription = object.shortDescription;
     } else {
-      message.email = "";
+      message.shortDescription = "";
+    }
+    if (object.longDescription !== undefined && object.longDescription !== null) {
+      message.longDescription = object.longDescription;
+    } else {
+      message.longDescription = "";
     }
     return message;
   },
 };
 
-const baseFilterOptions: object = {
-  query: "",
-  categories: "",
-  repositories: "",
-  pkgVersion: "",
-  appVersion: "",
+const baseDeleteInstalledPackageResult: object = {
+  statusCode: 0,
+  errorMessage: "",
 };
 
-export const FilterOptions = {
-  encode(message: FilterOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.query !== "") {
-      writer.uint32(10).string(message.query);
-    }
-    for (const v of message.categories) {
-      writer.uint32(18).string(v!);
-    }
-    for (const v of message.repositories) {
-      writer.uint32(26).string(v!);
+export const DeleteInstalledPackageResult = {
+  encode(
+    message: DeleteInstalledPackageResult,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
     }
-    if (message.pkgVersion !== "") {
-      writer.uint32(34).string(message.pkgVersion);
+    if (message.statusCode !== 0) {
+      writer.uint32(16).int32(message.statusCode);
     }
-    if (message.appVersion !== "") {
-      writer.uint32(42).string(message.appVersion);
+    if (message.errorMessage !== "") {
+      writer.uint32(26).string(message.errorMessage);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): FilterOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackageResult {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseFilterOptions } as FilterOptions;
-    message.categories = [];
-    message.repositories = [];
+    const message = {
+      ...baseDeleteInstalledPackageResult,
+    } as DeleteInstalledPackageResult;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.query = reader.string();
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
         case 2:
-          message.categories.push(reader.string());
+          message.statusCode = reader.int32();
           break;
         case 3:
-          message.repositories.push(reader.string());
-          break;
-        case 4:
-          message.pkgVersion = reader.string();
-          break;
-        case 5:
-          message.appVersion = reader.string();
+          message.errorMessage = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -3783,114 +11482,115 @@ export const FilterOptions = {
     return message;
   },
 
-  fromJSON(object: any): FilterOptions {
-    const message = { ...baseFilterOptions } as FilterOptions;
-    message.categories = [];
-    message.repositories = [];
-    if (object.query !== undefined && object.query !== null) {
-      message.query = String(object.query);
+  fromJSON(object: any): DeleteInstalledPackageResult {
+    const message = {
+      ...baseDeleteInstalledPackageResult,
+    } as DeleteInstalledPackageResult;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
     } else {
-      message.query = "";
-    }
-    if (object.categories !== undefined && object.categories !== null) {
-      for (const e of object.categories) {
-        message.categories.push(String(e));
-      }
-    }
-    if (object.repositories !== undefined && object.repositories !== null) {
-      for (const e of object.repositories) {
-        message.repositories.push(String(e));
-      }
+      message.installedPackageRef = undefined;
     }
-    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
-      message.pkgVersion = String(object.pkgVersion);
+    if (object.statusCode !== undefined && object.statusCode !== null) {
+      message.statusCode = Number(object.statusCode);
     } else {
-      message.pkgVersion = "";
+      message.statusCode = 0;
     }
-    if (object.appVersion !== undefined && object.appVersion !== null) {
-      message.appVersion = String(object.appVersion);
+    if (object.errorMessage !== undefined && object.errorMessage !== null) {
+      message.errorMessage = String(object.errorMessage);
     } else {
-      message.appVersion = "";
+      message.errorMessage = "";
     }
     return message;
   },
 
-  toJSON(message: FilterOptions): unknown {
+  toJSON(message: DeleteInstalledPackageResult): unknown {
     const obj: any = {};
-    message.query !== undefined && (obj.query = message.query);
-    if (message.categories) {
-      obj.categories = message.categories.map(e => e);
-    } else {
-      obj.categories = [];
-    }
-    if (message.repositories) {
-      obj.repositories = message.repositories.map(e => e);
-    } else {
-      obj.repositories = [];
-    }
-    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
-    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.statusCode !== undefined && (obj.statusCode = message.statusCode);
+    message.errorMessage !== undefined && (obj.errorMessage = message.errorMessage);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<FilterOptions>): FilterOptions {
-    const message = { ...baseFilterOptions } as FilterOptions;
-    message.categories = [];
-    message.repositories = [];
-    if (object.query !== undefined && object.query !== null) {
-      message.query = object.query;
+  fromPartial(object: DeepPartial<DeleteInstalledPackageResult>): DeleteInstalledPackageResult {
+    const message = {
+      ...baseDeleteInstalledPackageResult,
+    } as DeleteInstalledPackageResult;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
     } else {
-      message.query = "";
-    }
-    if (object.categories !== undefined && object.categories !== null) {
-      for (const e of object.categories) {
-        message.categories.push(e);
-      }
-    }
-    if (object.repositories !== undefined && object.repositories !== null) {
-      for (const e of object.repositories) {
-        message.repositories.push(e);
-      }
+      message.installedPackageRef = undefined;
     }
-    if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
-      message.pkgVersion = object.pkgVersion;
+    if (object.statusCode !== undefined && object.statusCode !== null) {
+      message.statusCode = object.statusCode;
     } else {
-      message.pkgVersion = "";
+      message.statusCode = 0;
     }
-    if (object.appVersion !== undefined && object.appVersion !== null) {
-      message.appVersion = object.appVersion;
+    if (object.errorMessage !== undefined && object.errorMessage !== null) {
+      message.errorMessage = object.errorMessage;
     } else {
-      message.appVersion = "";
+      message.errorMessage = "";
     }
     return message;
   },
 };
 
-const basePaginationOptions: object = { pageToken: "", pageSize: 0 };
+const baseGetInstalledPackageDetailResult: object = {
+  statusCode: 0,
+  errorMessage: "",
+};
 
-export const PaginationOptions = {
-  encode(message: PaginationOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.pageToken !== "") {
-      writer.uint32(10).string(message.pageToken);
+export const GetInstalledPackageDetailResult = {
+  encode(
+    message: GetInstalledPackageDetailResult,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
     }
-    if (message.pageSize !== 0) {
-      writer.uint32(16).int32(message.pageSize);
+    if (message.installedPackageDetail !== undefined) {
+      InstalledPackageDetail.encode(
+        message.installedPackageDetail,
+        writer.uint32(18).fork(),
+      ).ldelim();
+    }
+    if (message.statusCode !== 0) {
+      writer.uint32(24).int32(message.statusCode);
+    }
+    if (message.errorMessage !== "") {
+      writer.uint32(34).string(message.errorMessage);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PaginationOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDetailResult {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...basePaginationOptions } as PaginationOptions;
+    const message = {
+      ...baseGetInstalledPackageDetailResult,
+    } as GetInstalledPackageDetailResult;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.pageToken = reader.string();
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
         case 2:
-          message.pageSize = reader.int32();
+          message.installedPackageDetail = InstalledPackageDetail.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.statusCode = reader.int32();
+          break;
+        case 4:
+          message.errorMessage = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -3900,77 +11600,140 @@ export const PaginationOptions = {
     return message;
   },
 
-  fromJSON(object: any): PaginationOptions {
-    const message = { ...basePaginationOptions } as PaginationOptions;
-    if (object.pageToken !== undefined && object.pageToken !== null) {
-      message.pageToken = String(object.pageToken);
+  fromJSON(object: any): GetInstalledPackageDetailResult {
+    const message = {
+      ...baseGetInstalledPackageDetailResult,
+    } as GetInstalledPackageDetailResult;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
     } else {
-      message.pageToken = "";
+      message.installedPackageRef = undefined;
     }
-    if (object.pageSize !== undefined && object.pageSize !== null) {
-      message.pageSize = Number(object.pageSize);
+    if (object.installedPackageDetail !== undefined && object.installedPackageDetail !== null) {
+      message.installedPackageDetail = InstalledPackageDetail.fromJSON(
+        object.installedPackageDetail,
+      );
     } else {
-      message.pageSize = 0;
+      message.installedPackageDetail = undefined;
+    }
+    if (object.statusCode !== undefined && object.statusCode !== null) {
+      message.statusCode = Number(object.statusCode);
+    } else {
+      message.statusCode = 0;
+    }
+    if (object.errorMessage !== undefined && object.errorMessage !== null) {
+      message.errorMessage = String(object.errorMessage);
+    } else {
+      message.errorMessage = "";
     }
     return message;
   },
 
-  toJSON(message: PaginationOptions): unknown {
+  toJSON(message: GetInstalledPackageDetailResult): unknown {
     const obj: any = {};
-    message.pageToken !== undefined && (obj.pageToken = message.pageToken);
-    message.pageSize !== undefined && (obj.pageSize = message.pageSize);
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.installedPackageDetail !== undefined &&
+      (obj.installedPackageDetail = message.installedPackageDetail
+        ? InstalledPackageDetail.toJSON(message.installedPackageDetail)
+        : undefined);
+    message.statusCode !== undefined && (obj.statusCode = message.statusCode);
+    message.errorMessage !== undefined && (obj.errorMessage = message.errorMessage);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<PaginationOptions>): PaginationOptions {
-    const message = { ...basePaginationOptions } as PaginationOptions;
-    if (object.pageToken !== undefined && object.pageToken !== null) {
-      message.pageToken = object.pageToken;
+  fromPartial(
+    object: DeepPartial<GetInstalledPackageDetailResult>,
+  ): GetInstalledPackageDetailResult {
+    const message = {
+      ...baseGetInstalledPackageDetailResult,
+    } as GetInstalledPackageDetailResult;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
     } else {
-      message.pageToken = "";
+      message.installedPackageRef = undefined;
     }
-    if (object.pageSize !== undefined && object.pageSize !== null) {
-      message.pageSize = object.pageSize;
+    if (object.installedPackageDetail !== undefined && object.installedPackageDetail !== null) {
+      message.installedPackageDetail = InstalledPackageDetail.fromPartial(
+        object.installedPackageDetail,
+      );
     } else {
-      message.pageSize = 0;
+      message.installedPackageDetail = undefined;
+    }
+    if (object.statusCode !== undefined && object.statusCode !== null) {
+      message.statusCode = object.statusCode;
+    } else {
+      message.statusCode = 0;
+    }
+    if (object.errorMessage !== undefined && object.errorMessage !== null) {
+      message.errorMessage = object.errorMessage;
+    } else {
+      message.errorMessage = "";
     }
     return message;
   },
 };
 
-const baseInstalledPackageReference: object = { identifier: "" };
+const baseResourceDrift: object = {
+  apiVersion: "",
+  kind: "",
+  name: "",
+  namespace: "",
+  missing: false,
+};
 
-export const InstalledPackageReference = {
-  encode(message: InstalledPackageReference, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.context !== undefined) {
-      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+export const ResourceDrift = {
+  encode(message: ResourceDrift, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.apiVersion !== "") {
+      writer.uint32(10).string(message.apiVersion);
     }
-    if (message.identifier !== "") {
-      writer.uint32(18).string(message.identifier);
+    if (message.kind !== "") {
+      writer.uint32(18).string(message.kind);
     }
-    if (message.plugin !== undefined) {
-      Plugin.encode(message.plugin, writer.uint32(26).fork()).ldelim();
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.namespace !== "") {
+      writer.uint32(34).string(message.namespace);
+    }
+    if (message.missing === true) {
+      writer.uint32(40).bool(message.missing);
+    }
+    for (const v of message.fields) {
+      FieldDrift.encode(v!, writer.uint32(50).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageReference {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceDrift {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = {
-      ...baseInstalledPackageReference,
-    } as InstalledPackageReference;
+    const message = { ...baseResourceDrift } as ResourceDrift;
+    message.fields = [];
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.context = Context.decode(reader, reader.uint32());
+          message.apiVersion = reader.string();
           break;
         case 2:
-          message.identifier = reader.string();
+          message.kind = reader.string();
           break;
         case 3:
-          message.plugin = Plugin.decode(reader, reader.uint32());
+          message.name = reader.string();
+          break;
+        case 4:
+          message.namespace = reader.string();
+          break;
+        case 5:
+          message.missing = reader.bool();
+          break;
+        case 6:
+          message.fields.push(FieldDrift.decode(reader, reader.uint32()));
           break;
         default:
           reader.skipType(tag & 7);
@@ -3980,80 +11743,136 @@ export const InstalledPackageReference = {
     return message;
   },
 
-  fromJSON(object: any): InstalledPackageReference {
-    const message = {
-      ...baseInstalledPackageReference,
-    } as InstalledPackageReference;
-    if (object.context !== undefined && object.context !== null) {
-      message.context = Context.fromJSON(object.context);
+  fromJSON(object: any): ResourceDrift {
+    const message = { ...baseResourceDrift } as ResourceDrift;
+    message.fields = [];
+    if (object.apiVersion !== undefined && object.apiVersion !== null) {
+      message.apiVersion = String(object.apiVersion);
     } else {
-      message.context = undefined;
+      message.apiVersion = "";
     }
-    if (object.identifier !== undefined && object.identifier !== null) {
-      message.identifier = String(object.identifier);
+    if (object.kind !== undefined && object.kind !== null) {
+      message.kind = String(object.kind);
     } else {
-      message.identifier = "";
+      message.kind = "";
     }
-    if (object.plugin !== undefined && object.plugin !== null) {
-      message.plugin = Plugin.fromJSON(object.plugin);
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
     } else {
-      message.plugin = undefined;
+      message.name = "";
+    }
+    if (object.namespace !== undefined && object.namespace !== null) {
+      message.namespace = String(object.namespace);
+    } else {
+      message.namespace = "";
+    }
+    if (object.missing !== undefined && object.missing !== null) {
+      message.missing = Boolean(object.missing);
+    } else {
+      message.missing = false;
+    }
+    if (object.fields !== undefined && object.fields !== null) {
+      for (const e of object.fields) {
+        message.fields.push(FieldDrift.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: ResourceDrift): unknown {
+    const obj: any = {};
+    message.apiVersion !== undefined && (obj.apiVersion = message.apiVersion);
+    message.kind !== undefined && (obj.kind = message.kind);
+    message.name !== undefined && (obj.name = message.name);
+    message.namespace !== undefined && (obj.namespace = message.namespace);
+    message.missing !== undefined && (obj.missing = message.missing);
+    if (message.fields) {
+      obj.fields = message.fields.map(e => (e ? FieldDrift.toJSON(e) : undefined));
+    } else {
+      obj.fields = [];
     }
-    return message;
-  },
-
-  toJSON(message: InstalledPackageReference): unknown {
-    const obj: any = {};
-    message.context !== undefined &&
-      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
-    message.identifier !== undefined && (obj.identifier = message.identifier);
-    message.plugin !== undefined &&
-      (obj.plugin = message.plugin ? Plugin.toJSON(message.plugin) : undefined);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<InstalledPackageReference>): InstalledPackageReference {
-    const message = {
-      ...baseInstalledPackageReference,
-    } as InstalledPackageReference;
-    if (object.context !== undefined && object.context !== null) {
-      message.context = Context.fromPartial(object.context);
+  fromPartial(object: DeepPartial<ResourceDrift>): ResourceDrift {
+    const message = { ...baseResourceDrift } as ResourceDrift;
+    message.fields = [];
+    if (object.apiVersion !== undefined && object.apiVersion !== null) {
+      message.apiVersion = object.apiVersion;
     } else {
-      message.context = undefined;
+      message.apiVersion = "";
     }
-    if (object.identifier !== undefined && object.identifier !== null) {
-      message.identifier = object.identifier;
+    if (object.kind !== undefined && object.kind !== null) {
+      message.kind = object.kind;
     } else {
-      message.identifier = "";
+      message.kind = "";
     }
-    if (object.plugin !== undefined && object.plugin !== null) {
-      message.plugin = Plugin.fromPartial(object.plugin);
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
     } else {
-      message.plugin = undefined;
+      message.name = "";
+    }
+    if (object.namespace !== undefined && object.namespace !== null) {
+      message.namespace = object.namespace;
+    } else {
+      message.namespace = "";
+    }
+    if (object.missing !== undefined && object.missing !== null) {
+      message.missing = object.missing;
+    } else {
+      message.missing = false;
+    }
+    if (object.fields !== undefined && object.fields !== null) {
+      for (const e of object.fields) {
+        message.fields.push(FieldDrift.fromPartial(e));
+      }
     }
     return message;
   },
 };
 
-const baseVersionReference: object = { version: "" };
+const baseRenderedResource: object = {
+  apiVersion: "",
+  kind: "",
+  name: "",
+  namespace: "",
+};
 
-export const VersionReference = {
-  encode(message: VersionReference, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.version !== "") {
-      writer.uint32(10).string(message.version);
+export const RenderedResource = {
+  encode(message: RenderedResource, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.apiVersion !== "") {
+      writer.uint32(10).string(message.apiVersion);
+    }
+    if (message.kind !== "") {
+      writer.uint32(18).string(message.kind);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.namespace !== "") {
+      writer.uint32(34).string(message.namespace);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): VersionReference {
+  decode(input: _m0.Reader | Uint8Array, length?: number): RenderedResource {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseVersionReference } as VersionReference;
+    const message = { ...baseRenderedResource } as RenderedResource;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.version = reader.string();
+          message.apiVersion = reader.string();
+          break;
+        case 2:
+          message.kind = reader.string();
+          break;
+        case 3:
+          message.name = reader.string();
+          break;
+        case 4:
+          message.namespace = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -4063,68 +11882,97 @@ export const VersionReference = {
     return message;
   },
 
-  fromJSON(object: any): VersionReference {
-    const message = { ...baseVersionReference } as VersionReference;
-    if (object.version !== undefined && object.version !== null) {
-      message.version = String(object.version);
+  fromJSON(object: any): RenderedResource {
+    const message = { ...baseRenderedResource } as RenderedResource;
+    if (object.apiVersion !== undefined && object.apiVersion !== null) {
+      message.apiVersion = String(object.apiVersion);
     } else {
-      message.version = "";
+      message.apiVersion = "";
+    }
+    if (object.kind !== undefined && object.kind !== null) {
+      message.kind = String(object.kind);
+    } else {
+      message.kind = "";
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
+    } else {
+      message.name = "";
+    }
+    if (object.namespace !== undefined && object.namespace !== null) {
+      message.namespace = String(object.namespace);
+    } else {
+      message.namespace = "";
     }
     return message;
   },
 
-  toJSON(message: VersionReference): unknown {
+  toJSON(message: RenderedResource): unknown {
     const obj: any = {};
-    message.version !== undefined && (obj.version = message.version);
+    message.apiVersion !== undefined && (obj.apiVersion = message.apiVersion);
+    message.kind !== undefined && (obj.kind = message.kind);
+    message.name !== undefined && (obj.name = message.name);
+    message.namespace !== undefined && (obj.namespace = message.namespace);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<VersionReference>): VersionReference {
-    const message = { ...baseVersionReference } as VersionReference;
-    if (object.version !== undefined && object.version !== null) {
-      message.version = object.version;
+  fromPartial(object: DeepPartial<RenderedResource>): RenderedResource {
+    const message = { ...baseRenderedResource } as RenderedResource;
+    if (object.apiVersion !== undefined && object.apiVersion !== null) {
+      message.apiVersion = object.apiVersion;
     } else {
-      message.version = "";
+      message.apiVersion = "";
+    }
+    if (object.kind !== undefined && object.kind !== null) {
+      message.kind = object.kind;
+    } else {
+      message.kind = "";
+    }
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
+    } else {
+      message.name = "";
+    }
+    if (object.namespace !== undefined && object.namespace !== null) {
+      message.namespace = object.namespace;
+    } else {
+      message.namespace = "";
     }
     return message;
   },
 };
 
-const baseInstalledPackageStatus: object = {
-  ready: false,
-  reason: 0,
-  userReason: "",
-};
+const baseFieldDrift: object = { path: "", expected: "", actual: "" };
 
-export const InstalledPackageStatus = {
-  encode(message: InstalledPackageStatus, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.ready === true) {
-      writer.uint32(8).bool(message.ready);
+export const FieldDrift = {
+  encode(message: FieldDrift, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.path !== "") {
+      writer.uint32(10).string(message.path);
     }
-    if (message.reason !== 0) {
-      writer.uint32(16).int32(message.reason);
+    if (message.expected !== "") {
+      writer.uint32(18).string(message.expected);
     }
-    if (message.userReason !== "") {
-      writer.uint32(26).string(message.userReason);
+    if (message.actual !== "") {
+      writer.uint32(26).string(message.actual);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageStatus {
+  decode(input: _m0.Reader | Uint8Array, length?: number): FieldDrift {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseInstalledPackageStatus } as InstalledPackageStatus;
+    const message = { ...baseFieldDrift } as FieldDrift;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.ready = reader.bool();
+          message.path = reader.string();
           break;
         case 2:
-          message.reason = reader.int32() as any;
+          message.expected = reader.string();
           break;
         case 3:
-          message.userReason = reader.string();
+          message.actual = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -4134,91 +11982,112 @@ export const InstalledPackageStatus = {
     return message;
   },
 
-  fromJSON(object: any): InstalledPackageStatus {
-    const message = { ...baseInstalledPackageStatus } as InstalledPackageStatus;
-    if (object.ready !== undefined && object.ready !== null) {
-      message.ready = Boolean(object.ready);
+  fromJSON(object: any): FieldDrift {
+    const message = { ...baseFieldDrift } as FieldDrift;
+    if (object.path !== undefined && object.path !== null) {
+      message.path = String(object.path);
     } else {
-      message.ready = false;
+      message.path = "";
     }
-    if (object.reason !== undefined && object.reason !== null) {
-      message.reason = installedPackageStatus_StatusReasonFromJSON(object.reason);
+    if (object.expected !== undefined && object.expected !== null) {
+      message.expected = String(object.expected);
     } else {
-      message.reason = 0;
+      message.expected = "";
     }
-    if (object.userReason !== undefined && object.userReason !== null) {
-      message.userReason = String(object.userReason);
+    if (object.actual !== undefined && object.actual !== null) {
+      message.actual = String(object.actual);
     } else {
-      message.userReason = "";
+      message.actual = "";
     }
     return message;
   },
 
-  toJSON(message: InstalledPackageStatus): unknown {
+  toJSON(message: FieldDrift): unknown {
     const obj: any = {};
-    message.ready !== undefined && (obj.ready = message.ready);
-    message.reason !== undefined &&
-      (obj.reason = installedPackageStatus_StatusReasonToJSON(message.reason));
-    message.userReason !== undefined && (obj.userReason = message.userReason);
+    message.path !== undefined && (obj.path = message.path);
+    message.expected !== undefined && (obj.expected = message.expected);
+    message.actual !== undefined && (obj.actual = message.actual);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<InstalledPackageStatus>): InstalledPackageStatus {
-    const message = { ...baseInstalledPackageStatus } as InstalledPackageStatus;
-    if (object.ready !== undefined && object.ready !== null) {
-      message.ready = object.ready;
+  fromPartial(object: DeepPartial<FieldDrift>): FieldDrift {
+    const message = { ...baseFieldDrift } as FieldDrift;
+    if (object.path !== undefined && object.path !== null) {
+      message.path = object.path;
     } else {
-      message.ready = false;
+      message.path = "";
     }
-    if (object.reason !== undefined && object.reason !== null) {
-      message.reason = object.reason;
+    if (object.expected !== undefined && object.expected !== null) {
+      message.expected = object.expected;
     } else {
-      message.reason = 0;
+      message.expected = "";
     }
-    if (object.userReason !== undefined && object.userReason !== null) {
-      message.userReason = object.userReason;
+    if (object.actual !== undefined && object.actual !== null) {
+      message.actual = object.actual;
     } else {
-      message.userReason = "";
+      message.actual = "";
     }
     return message;
   },
 };
 
-const baseReconciliationOptions: object = {
-  interval: 0,
-  suspend: false,
-  serviceAccountName: "",
+const basePackageActivity: object = {
+  name: "",
+  operation: 0,
+  time: "",
+  description: "",
 };
 
-export const ReconciliationOptions = {
-  encode(message: ReconciliationOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.interval !== 0) {
-      writer.uint32(8).int32(message.interval);
+export const PackageActivity = {
+  encode(message: PackageActivity, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
     }
-    if (message.suspend === true) {
-      writer.uint32(16).bool(message.suspend);
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
     }
-    if (message.serviceAccountName !== "") {
-      writer.uint32(26).string(message.serviceAccountName);
+    if (message.operation !== 0) {
+      writer.uint32(24).int32(message.operation);
+    }
+    if (message.pkgVersionReference !== undefined) {
+      PackageAppVersion.encode(message.pkgVersionReference, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.time !== "") {
+      writer.uint32(42).string(message.time);
+    }
+    if (message.description !== "") {
+      writer.uint32(50).string(message.description);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ReconciliationOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PackageActivity {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseReconciliationOptions } as ReconciliationOptions;
+    const message = { ...basePackageActivity } as PackageActivity;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.interval = reader.int32();
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
         case 2:
-          message.suspend = reader.bool();
+          message.name = reader.string();
           break;
         case 3:
-          message.serviceAccountName = reader.string();
+          message.operation = reader.int32() as any;
+          break;
+        case 4:
+          message.pkgVersionReference = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        case 5:
+          message.time = reader.string();
+          break;
+        case 6:
+          message.description = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -4228,73 +12097,115 @@ export const ReconciliationOptions = {
     return message;
   },
 
-  fromJSON(object: any): ReconciliationOptions {
-    const message = { ...baseReconciliationOptions } as ReconciliationOptions;
-    if (object.interval !== undefined && object.interval !== null) {
-      message.interval = Number(object.interval);
+  fromJSON(object: any): PackageActivity {
+    const message = { ...basePackageActivity } as PackageActivity;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromJSON(object.installedPackageRef);
     } else {
-      message.interval = 0;
+      message.installedPackageRef = undefined;
     }
-    if (object.suspend !== undefined && object.suspend !== null) {
-      message.suspend = Boolean(object.suspend);
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
     } else {
-      message.suspend = false;
+      message.name = "";
     }
-    if (object.serviceAccountName !== undefined && object.serviceAccountName !== null) {
-      message.serviceAccountName = String(object.serviceAccountName);
+    if (object.operation !== undefined && object.operation !== null) {
+      message.operation = packageActivity_OperationFromJSON(object.operation);
     } else {
-      message.serviceAccountName = "";
+      message.operation = 0;
+    }
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = PackageAppVersion.fromJSON(object.pkgVersionReference);
+    } else {
+      message.pkgVersionReference = undefined;
+    }
+    if (object.time !== undefined && object.time !== null) {
+      message.time = String(object.time);
+    } else {
+      message.time = "";
+    }
+    if (object.description !== undefined && object.description !== null) {
+      message.description = String(object.description);
+    } else {
+      message.description = "";
     }
     return message;
   },
 
-  toJSON(message: ReconciliationOptions): unknown {
+  toJSON(message: PackageActivity): unknown {
     const obj: any = {};
-    message.interval !== undefined && (obj.interval = message.interval);
-    message.suspend !== undefined && (obj.suspend = message.suspend);
-    message.serviceAccountName !== undefined &&
-      (obj.serviceAccountName = message.serviceAccountName);
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.name !== undefined && (obj.name = message.name);
+    message.operation !== undefined &&
+      (obj.operation = packageActivity_OperationToJSON(message.operation));
+    message.pkgVersionReference !== undefined &&
+      (obj.pkgVersionReference = message.pkgVersionReference
+        ? PackageAppVersion.toJSON(message.pkgVersionReference)
+        : undefined);
+    message.time !== undefined && (obj.time = message.time);
+    message.description !== undefined && (obj.description = message.description);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<ReconciliationOptions>): ReconciliationOptions {
-    const message = { ...baseReconciliationOptions } as ReconciliationOptions;
-    if (object.interval !== undefined && object.interval !== null) {
-      message.interval = object.interval;
+  fromPartial(object: DeepPartial<PackageActivity>): PackageActivity {
+    const message = { ...basePackageActivity } as PackageActivity;
+    if (object.installedPackageRef !== undefined && object.installedPackageRef !== null) {
+      message.installedPackageRef = InstalledPackageReference.fromPartial(
+        object.installedPackageRef,
+      );
     } else {
-      message.interval = 0;
+      message.installedPackageRef = undefined;
     }
-    if (object.suspend !== undefined && object.suspend !== null) {
-      message.suspend = object.suspend;
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
     } else {
-      message.suspend = false;
+      message.name = "";
     }
-    if (object.serviceAccountName !== undefined && object.serviceAccountName !== null) {
-      message.serviceAccountName = object.serviceAccountName;
+    if (object.operation !== undefined && object.operation !== null) {
+      message.operation = object.operation;
     } else {
-      message.serviceAccountName = "";
+      message.operation = 0;
+    }
+    if (object.pkgVersionReference !== undefined && object.pkgVersionReference !== null) {
+      message.pkgVersionReference = PackageAppVersion.fromPartial(object.pkgVersionReference);
+    } else {
+      message.pkgVersionReference = undefined;
+    }
+    if (object.time !== undefined && object.time !== null) {
+      message.time = object.time;
+    } else {
+      message.time = "";
+    }
+    if (object.description !== undefined && object.description !== null) {
+      message.description = object.description;
+    } else {
+      message.description = "";
     }
     return message;
   },
 };
 
-const basePackageAppVersion: object = { pkgVersion: "", appVersion: "" };
+const basePackageChangelogEntry: object = { pkgVersion: "", changes: "" };
 
-export const PackageAppVersion = {
-  encode(message: PackageAppVersion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const PackageChangelogEntry = {
+  encode(message: PackageChangelogEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.pkgVersion !== "") {
       writer.uint32(10).string(message.pkgVersion);
     }
-    if (message.appVersion !== "") {
-      writer.uint32(18).string(message.appVersion);
+    for (const v of message.changes) {
+      writer.uint32(18).string(v!);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PackageAppVersion {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PackageChangelogEntry {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...basePackageAppVersion } as PackageAppVersion;
+    const message = { ...basePackageChangelogEntry } as PackageChangelogEntry;
+    message.changes = [];
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4302,7 +12213,7 @@ export const PackageAppVersion = {
           message.pkgVersion = reader.string();
           break;
         case 2:
-          message.appVersion = reader.string();
+          message.changes.push(reader.string());
           break;
         default:
           reader.skipType(tag & 7);
@@ -4312,39 +12223,45 @@ export const PackageAppVersion = {
     return message;
   },
 
-  fromJSON(object: any): PackageAppVersion {
-    const message = { ...basePackageAppVersion } as PackageAppVersion;
+  fromJSON(object: any): PackageChangelogEntry {
+    const message = { ...basePackageChangelogEntry } as PackageChangelogEntry;
+    message.changes = [];
     if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
       message.pkgVersion = String(object.pkgVersion);
     } else {
       message.pkgVersion = "";
     }
-    if (object.appVersion !== undefined && object.appVersion !== null) {
-      message.appVersion = String(object.appVersion);
-    } else {
-      message.appVersion = "";
+    if (object.changes !== undefined && object.changes !== null) {
+      for (const e of object.changes) {
+        message.changes.push(String(e));
+      }
     }
     return message;
   },
 
-  toJSON(message: PackageAppVersion): unknown {
+  toJSON(message: PackageChangelogEntry): unknown {
     const obj: any = {};
     message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
-    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    if (message.changes) {
+      obj.changes = message.changes.map(e => e);
+    } else {
+      obj.changes = [];
+    }
     return obj;
   },
 
-  fromPartial(object: DeepPartial<PackageAppVersion>): PackageAppVersion {
-    const message = { ...basePackageAppVersion } as PackageAppVersion;
+  fromPartial(object: DeepPartial<PackageChangelogEntry>): PackageChangelogEntry {
+    const message = { ...basePackageChangelogEntry } as PackageChangelogEntry;
+    message.changes = [];
     if (object.pkgVersion !== undefined && object.pkgVersion !== null) {
       message.pkgVersion = object.pkgVersion;
     } else {
       message.pkgVersion = "";
     }
-    if (object.appVersion !== undefined && object.appVersion !== null) {
-      message.appVersion = object.appVersion;
-    } else {
-      message.appVersion = "";
+    if (object.changes !== undefined && object.changes !== null) {
+      for (const e of object.changes) {
+        message.changes.push(e);
+      }
     }
     return message;
   },
@@ -4364,6 +12281,26 @@ export interface PackagesService {
     request: DeepPartial<GetAvailablePackageVersionsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetAvailablePackageVersionsResponse>;
+  GetAvailablePackageDependencies(
+    request: DeepPartial<GetAvailablePackageDependenciesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDependenciesResponse>;
+  GetAvailablePackageDefaultValues(
+    request: DeepPartial<GetAvailablePackageDefaultValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDefaultValuesResponse>;
+  GetAvailablePackageChangelog(
+    request: DeepPartial<GetAvailablePackageChangelogRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageChangelogResponse>;
+  GetAvailablePackageValuesDiff(
+    request: DeepPartial<GetAvailablePackageValuesDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageValuesDiffResponse>;
+  RenderAvailablePackage(
+    request: DeepPartial<RenderAvailablePackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RenderAvailablePackageResponse>;
   GetInstalledPackageSummaries(
     request: DeepPartial<GetInstalledPackageSummariesRequest>,
     metadata?: grpc.Metadata,
@@ -4372,6 +12309,14 @@ export interface PackagesService {
     request: DeepPartial<GetInstalledPackageDetailRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageDetailResponse>;
+  GetInstalledPackageDetailsBatch(
+    request: DeepPartial<GetInstalledPackageDetailsBatchRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageDetailsBatchResponse>;
+  GetInstalledPackageValues(
+    request: DeepPartial<GetInstalledPackageValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageValuesResponse>;
   CreateInstalledPackage(
     request: DeepPartial<CreateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -4380,10 +12325,46 @@ export interface PackagesService {
     request: DeepPartial<UpdateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<UpdateInstalledPackageResponse>;
+  GetInstalledPackageUpgradeDiff(
+    request: DeepPartial<GetInstalledPackageUpgradeDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpgradeDiffResponse>;
+  GetInstalledPackageDrift(
+    request: DeepPartial<GetInstalledPackageDriftRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageDriftResponse>;
+  GetInstalledPackageUpdateAvailable(
+    request: DeepPartial<GetInstalledPackageUpdateAvailableRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpdateAvailableResponse>;
+  ExportInstalledPackage(
+    request: DeepPartial<ExportInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ExportInstalledPackageResponse>;
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<DeleteInstalledPackageResponse>;
+  DeleteInstalledPackagesBatch(
+    request: DeepPartial<DeleteInstalledPackagesBatchRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<DeleteInstalledPackagesBatchResponse>;
+  GetCatalogStats(
+    request: DeepPartial<GetCatalogStatsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetCatalogStatsResponse>;
+  GetRecentActivity(
+    request: DeepPartial<GetRecentActivityRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetRecentActivityResponse>;
+  GetUserPermissions(
+    request: DeepPartial<GetUserPermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetUserPermissionsResponse>;
+  GetCatalogIndex(
+    request: DeepPartial<GetCatalogIndexRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetCatalogIndexResponse>;
 }
 
 export class PackagesServiceClientImpl implements PackagesService {
@@ -4394,11 +12375,27 @@ export class PackagesServiceClientImpl implements PackagesService {
     this.GetAvailablePackageSummaries = this.GetAvailablePackageSummaries.bind(this);
     this.GetAvailablePackageDetail = this.GetAvailablePackageDetail.bind(this);
     this.GetAvailablePackageVersions = this.GetAvailablePackageVersions.bind(this);
+    this.GetAvailablePackageDependencies = this.GetAvailablePackageDependencies.bind(this);
+    this.GetAvailablePackageDefaultValues = this.GetAvailablePackageDefaultValues.bind(this);
+    this.GetAvailablePackageChangelog = this.GetAvailablePackageChangelog.bind(this);
+    this.GetAvailablePackageValuesDiff = this.GetAvailablePackageValuesDiff.bind(this);
+    this.RenderAvailablePackage = this.RenderAvailablePackage.bind(this);
     this.GetInstalledPackageSummaries = this.GetInstalledPackageSummaries.bind(this);
     this.GetInstalledPackageDetail = this.GetInstalledPackageDetail.bind(this);
+    this.GetInstalledPackageDetailsBatch = this.GetInstalledPackageDetailsBatch.bind(this);
+    this.GetInstalledPackageValues = this.GetInstalledPackageValues.bind(this);
     this.CreateInstalledPackage = this.CreateInstalledPackage.bind(this);
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
+    this.GetInstalledPackageUpgradeDiff = this.GetInstalledPackageUpgradeDiff.bind(this);
+    this.GetInstalledPackageDrift = this.GetInstalledPackageDrift.bind(this);
+    this.GetInstalledPackageUpdateAvailable = this.GetInstalledPackageUpdateAvailable.bind(this);
+    this.ExportInstalledPackage = this.ExportInstalledPackage.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
+    this.DeleteInstalledPackagesBatch = this.DeleteInstalledPackagesBatch.bind(this);
+    this.GetCatalogStats = this.GetCatalogStats.bind(this);
+    this.GetRecentActivity = this.GetRecentActivity.bind(this);
+    this.GetUserPermissions = this.GetUserPermissions.bind(this);
+    this.GetCatalogIndex = this.GetCatalogIndex.bind(this);
   }
 
   GetAvailablePackageSummaries(
@@ -4434,6 +12431,61 @@ export class PackagesServiceClientImpl implements PackagesService {
     );
   }
 
+  GetAvailablePackageDependencies(
+    request: DeepPartial<GetAvailablePackageDependenciesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDependenciesResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetAvailablePackageDependenciesDesc,
+      GetAvailablePackageDependenciesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageDefaultValues(
+    request: DeepPartial<GetAvailablePackageDefaultValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDefaultValuesResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetAvailablePackageDefaultValuesDesc,
+      GetAvailablePackageDefaultValuesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageChangelog(
+    request: DeepPartial<GetAvailablePackageChangelogRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageChangelogResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetAvailablePackageChangelogDesc,
+      GetAvailablePackageChangelogRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageValuesDiff(
+    request: DeepPartial<GetAvailablePackageValuesDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageValuesDiffResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetAvailablePackageValuesDiffDesc,
+      GetAvailablePackageValuesDiffRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  RenderAvailablePackage(
+    request: DeepPartial<RenderAvailablePackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RenderAvailablePackageResponse> {
+    return this.rpc.unary(
+      PackagesServiceRenderAvailablePackageDesc,
+      RenderAvailablePackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   GetInstalledPackageSummaries(
     request: DeepPartial<GetInstalledPackageSummariesRequest>,
     metadata?: grpc.Metadata,
@@ -4456,6 +12508,28 @@ export class PackagesServiceClientImpl implements PackagesService {
     );
   }
 
+  GetInstalledPackageDetailsBatch(
+    request: DeepPartial<GetInstalledPackageDetailsBatchRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageDetailsBatchResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetInstalledPackageDetailsBatchDesc,
+      GetInstalledPackageDetailsBatchRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetInstalledPackageValues(
+    request: DeepPartial<GetInstalledPackageValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageValuesResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetInstalledPackageValuesDesc,
+      GetInstalledPackageValuesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   CreateInstalledPackage(
     request: DeepPartial<CreateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -4478,6 +12552,50 @@ export class PackagesServiceClientImpl implements PackagesService {
     );
   }
 
+  GetInstalledPackageUpgradeDiff(
+    request: DeepPartial<GetInstalledPackageUpgradeDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpgradeDiffResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetInstalledPackageUpgradeDiffDesc,
+      GetInstalledPackageUpgradeDiffRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetInstalledPackageDrift(
+    request: DeepPartial<GetInstalledPackageDriftRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageDriftResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetInstalledPackageDriftDesc,
+      GetInstalledPackageDriftRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetInstalledPackageUpdateAvailable(
+    request: DeepPartial<GetInstalledPackageUpdateAvailableRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpdateAvailableResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetInstalledPackageUpdateAvailableDesc,
+      GetInstalledPackageUpdateAvailableRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ExportInstalledPackage(
+    request: DeepPartial<ExportInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ExportInstalledPackageResponse> {
+    return this.rpc.unary(
+      PackagesServiceExportInstalledPackageDesc,
+      ExportInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -4488,26 +12606,235 @@ export class PackagesServiceClientImpl implements PackagesService {
       metadata,
     );
   }
+
+  DeleteInstalledPackagesBatch(
+    request: DeepPartial<DeleteInstalledPackagesBatchRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<DeleteInstalledPackagesBatchResponse> {
+    return this.rpc.unary(
+      PackagesServiceDeleteInstalledPackagesBatchDesc,
+      DeleteInstalledPackagesBatchRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetCatalogStats(
+    request: DeepPartial<GetCatalogStatsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetCatalogStatsResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetCatalogStatsDesc,
+      GetCatalogStatsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetRecentActivity(
+    request: DeepPartial<GetRecentActivityRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetRecentActivityResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetRecentActivityDesc,
+      GetRecentActivityRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetUserPermissions(
+    request: DeepPartial<GetUserPermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetUserPermissionsResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetUserPermissionsDesc,
+      GetUserPermissionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetCatalogIndex(
+    request: DeepPartial<GetCatalogIndexRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetCatalogIndexResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetCatalogIndexDesc,
+      GetCatalogIndexRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const PackagesServiceDesc = {
   serviceName: "kubeappsapis.core.packages.v1alpha1.PackagesService",
 };
 
-export const PackagesServiceGetAvailablePackageSummariesDesc: UnaryMethodDefinitionish = {
-  methodName: "GetAvailablePackageSummaries",
+export const PackagesServiceGetAvailablePackageSummariesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageSummaries",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageSummariesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageSummariesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetAvailablePackageDetailDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageDetail",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageDetailRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageDetailResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetAvailablePackageVersionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageVersions",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageVersionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageVersionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetAvailablePackageDependenciesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageDependencies",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageDependenciesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageDependenciesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetAvailablePackageDefaultValuesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageDefaultValues",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageDefaultValuesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageDefaultValuesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetAvailablePackageChangelogDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageChangelog",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageChangelogRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageChangelogResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetAvailablePackageValuesDiffDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageValuesDiff",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageValuesDiffRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageValuesDiffResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceRenderAvailablePackageDesc: UnaryMethodDefinitionish = {
+  methodName: "RenderAvailablePackage",
   service: PackagesServiceDesc,
   requestStream: false,
   responseStream: false,
   requestType: {
     serializeBinary() {
-      return GetAvailablePackageSummariesRequest.encode(this).finish();
+      return RenderAvailablePackageRequest.encode(this).finish();
     },
   } as any,
   responseType: {
     deserializeBinary(data: Uint8Array) {
       return {
-        ...GetAvailablePackageSummariesResponse.decode(data),
+        ...RenderAvailablePackageResponse.decode(data),
         toObject() {
           return this;
         },
@@ -4516,20 +12843,20 @@ export const PackagesServiceGetAvailablePackageSummariesDesc: UnaryMethodDefinit
   } as any,
 };
 
-export const PackagesServiceGetAvailablePackageDetailDesc: UnaryMethodDefinitionish = {
-  methodName: "GetAvailablePackageDetail",
+export const PackagesServiceGetInstalledPackageSummariesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageSummaries",
   service: PackagesServiceDesc,
   requestStream: false,
   responseStream: false,
   requestType: {
     serializeBinary() {
-      return GetAvailablePackageDetailRequest.encode(this).finish();
+      return GetInstalledPackageSummariesRequest.encode(this).finish();
     },
   } as any,
   responseType: {
     deserializeBinary(data: Uint8Array) {
       return {
-        ...GetAvailablePackageDetailResponse.decode(data),
+        ...GetInstalledPackageSummariesResponse.decode(data),
         toObject() {
           return this;
         },
@@ -4538,20 +12865,20 @@ export const PackagesServiceGetAvailablePackageDetailDesc: UnaryMethodDefinition
   } as any,
 };
 
-export const PackagesServiceGetAvailablePackageVersionsDesc: UnaryMethodDefinitionish = {
-  methodName: "GetAvailablePackageVersions",
+export const PackagesServiceGetInstalledPackageDetailDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageDetail",
   service: PackagesServiceDesc,
   requestStream: false,
   responseStream: false,
   requestType: {
     serializeBinary() {
-      return GetAvailablePackageVersionsRequest.encode(this).finish();
+      return GetInstalledPackageDetailRequest.encode(this).finish();
     },
   } as any,
   responseType: {
     deserializeBinary(data: Uint8Array) {
       return {
-        ...GetAvailablePackageVersionsResponse.decode(data),
+        ...GetInstalledPackageDetailResponse.decode(data),
         toObject() {
           return this;
         },
@@ -4560,20 +12887,20 @@ export const PackagesServiceGetAvailablePackageVersionsDesc: UnaryMethodDefiniti
   } as any,
 };
 
-export const PackagesServiceGetInstalledPackageSummariesDesc: UnaryMethodDefinitionish = {
-  methodName: "GetInstalledPackageSummaries",
+export const PackagesServiceGetInstalledPackageDetailsBatchDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageDetailsBatch",
   service: PackagesServiceDesc,
   requestStream: false,
   responseStream: false,
   requestType: {
     serializeBinary() {
-      return GetInstalledPackageSummariesRequest.encode(this).finish();
+      return GetInstalledPackageDetailsBatchRequest.encode(this).finish();
     },
   } as any,
   responseType: {
     deserializeBinary(data: Uint8Array) {
       return {
-        ...GetInstalledPackageSummariesResponse.decode(data),
+        ...GetInstalledPackageDetailsBatchResponse.decode(data),
         toObject() {
           return this;
         },
@@ -4582,20 +12909,20 @@ export const PackagesServiceGetInstalledPackageSummariesDesc: UnaryMethodDefinit
   } as any,
 };
 
-export const PackagesServiceGetInstalledPackageDetailDesc: UnaryMethodDefinitionish = {
-  methodName: "GetInstalledPackageDetail",
+export const PackagesServiceGetInstalledPackageValuesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageValues",
   service: PackagesServiceDesc,
   requestStream: false,
   responseStream: false,
   requestType: {
     serializeBinary() {
-      return GetInstalledPackageDetailRequest.encode(this).finish();
+      return GetInstalledPackageValuesRequest.encode(this).finish();
     },
   } as any,
   responseType: {
     deserializeBinary(data: Uint8Array) {
       return {
-        ...GetInstalledPackageDetailResponse.decode(data),
+        ...GetInstalledPackageValuesResponse.decode(data),
         toObject() {
           return this;
         },
@@ -4648,6 +12975,94 @@ export const PackagesServiceUpdateInstalledPackageDesc: UnaryMethodDefinitionish
   } as any,
 };
 
+export const PackagesServiceGetInstalledPackageUpgradeDiffDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageUpgradeDiff",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageUpgradeDiffRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageUpgradeDiffResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetInstalledPackageDriftDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageDrift",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageDriftRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageDriftResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetInstalledPackageUpdateAvailableDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageUpdateAvailable",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageUpdateAvailableRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageUpdateAvailableResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceExportInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "ExportInstalledPackage",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ExportInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ExportInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const PackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "DeleteInstalledPackage",
   service: PackagesServiceDesc,
@@ -4670,6 +13085,174 @@ export const PackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinitionish
   } as any,
 };
 
+export const PackagesServiceDeleteInstalledPackagesBatchDesc: UnaryMethodDefinitionish = {
+  methodName: "DeleteInstalledPackagesBatch",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return DeleteInstalledPackagesBatchRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...DeleteInstalledPackagesBatchResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetCatalogStatsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetCatalogStats",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetCatalogStatsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetCatalogStatsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetRecentActivityDesc: UnaryMethodDefinitionish = {
+  methodName: "GetRecentActivity",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetRecentActivityRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetRecentActivityResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetUserPermissionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetUserPermissions",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetUserPermissionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetUserPermissionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetCatalogIndexDesc: UnaryMethodDefinitionish = {
+  methodName: "GetCatalogIndex",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetCatalogIndexRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetCatalogIndexResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+/**
+ * The PackagesReadmeService is implemented by the core server only, using the
+ * GetAvailablePackageDetail rpc of the plugins, so that the READMEs are streamed
+ * in chunks staying under the message size limits.
+ */
+export interface PackagesReadmeService {
+  GetAvailablePackageReadme(
+    request: DeepPartial<GetAvailablePackageReadmeRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<GetAvailablePackageReadmeResponse>;
+}
+
+export class PackagesReadmeServiceClientImpl implements PackagesReadmeService {
+  private readonly rpc: Rpc;
+
+  constructor(rpc: Rpc) {
+    this.rpc = rpc;
+    this.GetAvailablePackageReadme = this.GetAvailablePackageReadme.bind(this);
+  }
+
+  GetAvailablePackageReadme(
+    request: DeepPartial<GetAvailablePackageReadmeRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<GetAvailablePackageReadmeResponse> {
+    return this.rpc.invoke(
+      PackagesReadmeServiceGetAvailablePackageReadmeDesc,
+      GetAvailablePackageReadmeRequest.fromPartial(request),
+      metadata,
+    );
+  }
+}
+
+export const PackagesReadmeServiceDesc = {
+  serviceName: "kubeappsapis.core.packages.v1alpha1.PackagesReadmeService",
+};
+
+export const PackagesReadmeServiceGetAvailablePackageReadmeDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageReadme",
+  service: PackagesReadmeServiceDesc,
+  requestStream: false,
+  responseStream: true,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageReadmeRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageReadmeResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
@@ -4683,13 +13266,18 @@ interface Rpc {
     request: any,
     metadata: grpc.Metadata | undefined,
   ): Promise<any>;
+  invoke<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Observable<any>;
 }
 
 export class GrpcWebImpl {
   private host: string;
   private options: {
     transport?: grpc.TransportFactory;
-
+    streamingTransport?: grpc.TransportFactory;
     debug?: boolean;
     metadata?: grpc.Metadata;
   };
@@ -4698,7 +13286,7 @@ export class GrpcWebImpl {
     host: string,
     options: {
       transport?: grpc.TransportFactory;
-
+      streamingTransport?: grpc.TransportFactory;
       debug?: boolean;
       metadata?: grpc.Metadata;
     },
@@ -4740,6 +13328,79 @@ export class GrpcWebImpl {
       });
     });
   }
+
+  invoke<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    _request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Observable<any> {
+    // Status Response Codes (https://developers.google.com/maps-booking/reference/grpc-api/status_codes)
+    const upStreamCodes = [2, 4, 8, 9, 10, 13, 14, 15];
+    const DEFAULT_TIMEOUT_TIME: number = 3_000;
+    const request = { ..._request, ...methodDesc.requestType };
+    const maybeCombinedMetadata =
+      metadata && this.options.metadata
+        ? new BrowserHeaders({
+            ...this.options?.metadata.headersMap,
+            ...metadata?.headersMap,
+          })
+        : metadata || this.options.metadata;
+    return new Observable(observer => {
+      const upStream = () => {
+        const client = grpc.invoke(methodDesc, {
+          host: this.host,
+          request,
+          transport: this.options.streamingTransport || this.options.transport,
+          metadata: maybeCombinedMetadata,
+          debug: this.options.debug,
+          onMessage: next => observer.next(next),
+          onEnd: (code: grpc.Code, message: string) => {
+            if (code === 0) {
+              observer.complete();
+            } else if (upStreamCodes.includes(code)) {
+              setTimeout(upStream, DEFAULT_TIMEOUT_TIME);
+            } else {
+              observer.error(new Error(`Error ${code} ${message}`));
+            }
+          },
+        });
+        observer.add(() => client.close());
+      };
+      upStream();
+    }).pipe(share());
+  }
+}
+
+declare var self: any | undefined;
+declare var window: any | undefined;
+declare var global: any | undefined;
+var globalThis: any = (() => {
+  if (typeof globalThis !== "undefined") return globalThis;
+  if (typeof self !== "undefined") return self;
+  if (typeof window !== "undefined") return window;
+  if (typeof global !== "undefined") return global;
+  throw "Unable to locate global object";
+})();
+
+const atob: (b64: string) => string =
+  globalThis.atob || (b64 => globalThis.Buffer.from(b64, "base64").toString("binary"));
+function bytesFromBase64(b64: string): Uint8Array {
+  const bin = atob(b64);
+  const arr = new Uint8Array(bin.length);
+  for (let i = 0; i < bin.length; ++i) {
+    arr[i] = bin.charCodeAt(i);
+  }
+  return arr;
+}
+
+const btoa: (bin: string) => string =
+  globalThis.btoa || (bin => globalThis.Buffer.from(bin, "binary").toString("base64"));
+function base64FromBytes(arr: Uint8Array): string {
+  const bin: string[] = [];
+  for (const byte of arr) {
+    bin.push(String.fromCharCode(byte));
+  }
+  return btoa(bin.join(""));
 }
 
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
@@ -11,7 +11,15 @@ export const protobufPackage = "kubeappsapis.core.plugins.v1alpha1";
  *
  * Request for GetConfiguredPlugins
  */
-export interface GetConfiguredPluginsRequest {}
+export interface GetConfiguredPluginsRequest {
+  /**
+   * Group by service
+   *
+   * Whether to also return the plugins grouped by the core service they
+   * implement, such as the packages service.
+   */
+  groupByService: boolean;
+}
 
 /**
  * GetConfiguredPluginsResponse
@@ -25,6 +33,78 @@ export interface GetConfiguredPluginsResponse {
    * List of Plugin
    */
   plugins: Plugin[];
+  /**
+   * Service plugins
+   *
+   * The plugins grouped by the core service they implement, only returned
+   * when requested with group_by_service. Plugins implementing no core
+   * service are only included in the plugins list.
+   */
+  servicePlugins: ServicePlugins[];
+}
+
+/**
+ * GetConfiguredClustersRequest
+ *
+ * Request for GetConfiguredClusters
+ */
+export interface GetConfiguredClustersRequest {
+  /**
+   * Check access
+   *
+   * Whether to also check, with the credentials of the user, that each
+   * cluster can be accessed.
+   */
+  checkAccess: boolean;
+}
+
+/**
+ * GetConfiguredClustersResponse
+ *
+ * Response for GetConfiguredClusters
+ */
+export interface GetConfiguredClustersResponse {
+  /**
+   * Clusters
+   *
+   * The configured clusters, ordered by name
+   */
+  clusters: ConfiguredCluster[];
+}
+
+/**
+ * ConfiguredCluster
+ *
+ * A cluster of the clusters configuration, without its credentials.
+ */
+export interface ConfiguredCluster {
+  /**
+   * Cluster name
+   *
+   * The name of the cluster, as used in the package contexts.
+   */
+  name: string;
+  /**
+   * API service URL
+   *
+   * The URL of the API server of the cluster, without any user information.
+   * Empty for the cluster on which Kubeapps is installed when it is accessed
+   * from within the cluster.
+   */
+  apiServiceUrl: string;
+  /**
+   * Is Kubeapps cluster
+   *
+   * Whether Kubeapps is installed on this cluster.
+   */
+  isKubeappsCluster: boolean;
+  /**
+   * Accessible
+   *
+   * Whether the API server of the cluster could be reached and accepted the
+   * credentials of the user. Only set when requested with check_access.
+   */
+  accessible: boolean;
 }
 
 /**
@@ -47,10 +127,37 @@ export interface Plugin {
   version: string;
 }
 
-const baseGetConfiguredPluginsRequest: object = {};
+/**
+ * ServicePlugins
+ *
+ * The plugins implementing a core service.
+ */
+export interface ServicePlugins {
+  /**
+   * Service
+   *
+   * The fully qualified name of the core service, such as
+   * `kubeappsapis.core.packages.v1alpha1.PackagesService`.
+   */
+  service: string;
+  /**
+   * Plugins
+   *
+   * The plugins implementing the service
+   */
+  plugins: Plugin[];
+}
+
+const baseGetConfiguredPluginsRequest: object = { groupByService: false };
 
 export const GetConfiguredPluginsRequest = {
-  encode(_: GetConfiguredPluginsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+  encode(
+    message: GetConfiguredPluginsRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.groupByService === true) {
+      writer.uint32(8).bool(message.groupByService);
+    }
     return writer;
   },
 
@@ -63,6 +170,9 @@ export const GetConfiguredPluginsRequest = {
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1:
+          message.groupByService = reader.bool();
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -71,22 +181,33 @@ export const GetConfiguredPluginsRequest = {
     return message;
   },
 
-  fromJSON(_: any): GetConfiguredPluginsRequest {
+  fromJSON(object: any): GetConfiguredPluginsRequest {
     const message = {
       ...baseGetConfiguredPluginsRequest,
     } as GetConfiguredPluginsRequest;
+    if (object.groupByService !== undefined && object.groupByService !== null) {
+      message.groupByService = Boolean(object.groupByService);
+    } else {
+      message.groupByService = false;
+    }
     return message;
   },
 
-  toJSON(_: GetConfiguredPluginsRequest): unknown {
+  toJSON(message: GetConfiguredPluginsRequest): unknown {
     const obj: any = {};
+    message.groupByService !== undefined && (obj.groupByService = message.groupByService);
     return obj;
   },
 
-  fromPartial(_: DeepPartial<GetConfiguredPluginsRequest>): GetConfiguredPluginsRequest {
+  fromPartial(object: DeepPartial<GetConfiguredPluginsRequest>): GetConfiguredPluginsRequest {
     const message = {
       ...baseGetConfiguredPluginsRequest,
     } as GetConfiguredPluginsRequest;
+    if (object.groupByService !== undefined && object.groupByService !== null) {
+      message.groupByService = object.groupByService;
+    } else {
+      message.groupByService = false;
+    }
     return message;
   },
 };
@@ -101,6 +222,9 @@ export const GetConfiguredPluginsResponse = {
     for (const v of message.plugins) {
       Plugin.encode(v!, writer.uint32(10).fork()).ldelim();
     }
+    for (const v of message.servicePlugins) {
+      ServicePlugins.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -111,12 +235,16 @@ export const GetConfiguredPluginsResponse = {
       ...baseGetConfiguredPluginsResponse,
     } as GetConfiguredPluginsResponse;
     message.plugins = [];
+    message.servicePlugins = [];
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
           message.plugins.push(Plugin.decode(reader, reader.uint32()));
           break;
+        case 2:
+          message.servicePlugins.push(ServicePlugins.decode(reader, reader.uint32()));
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -130,11 +258,17 @@ export const GetConfiguredPluginsResponse = {
       ...baseGetConfiguredPluginsResponse,
     } as GetConfiguredPluginsResponse;
     message.plugins = [];
+    message.servicePlugins = [];
     if (object.plugins !== undefined && object.plugins !== null) {
       for (const e of object.plugins) {
         message.plugins.push(Plugin.fromJSON(e));
       }
     }
+    if (object.servicePlugins !== undefined && object.servicePlugins !== null) {
+      for (const e of object.servicePlugins) {
+        message.servicePlugins.push(ServicePlugins.fromJSON(e));
+      }
+    }
     return message;
   },
 
@@ -145,6 +279,13 @@ export const GetConfiguredPluginsResponse = {
     } else {
       obj.plugins = [];
     }
+    if (message.servicePlugins) {
+      obj.servicePlugins = message.servicePlugins.map(e =>
+        e ? ServicePlugins.toJSON(e) : undefined,
+      );
+    } else {
+      obj.servicePlugins = [];
+    }
     return obj;
   },
 
@@ -153,11 +294,263 @@ export const GetConfiguredPluginsResponse = {
       ...baseGetConfiguredPluginsResponse,
     } as GetConfiguredPluginsResponse;
     message.plugins = [];
+    message.servicePlugins = [];
     if (object.plugins !== undefined && object.plugins !== null) {
       for (const e of object.plugins) {
         message.plugins.push(Plugin.fromPartial(e));
       }
     }
+    if (object.servicePlugins !== undefined && object.servicePlugins !== null) {
+      for (const e of object.servicePlugins) {
+        message.servicePlugins.push(ServicePlugins.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseGetConfiguredClustersRequest: object = { checkAccess: false };
+
+export const GetConfiguredClustersRequest = {
+  encode(
+    message: GetConfiguredClustersRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.checkAccess === true) {
+      writer.uint32(8).bool(message.checkAccess);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetConfiguredClustersRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetConfiguredClustersRequest,
+    } as GetConfiguredClustersRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.checkAccess = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetConfiguredClustersRequest {
+    const message = {
+      ...baseGetConfiguredClustersRequest,
+    } as GetConfiguredClustersRequest;
+    if (object.checkAccess !== undefined && object.checkAccess !== null) {
+      message.checkAccess = Boolean(object.checkAccess);
+    } else {
+      message.checkAccess = false;
+    }
+    return message;
+  },
+
+  toJSON(message: GetConfiguredClustersRequest): unknown {
+    const obj: any = {};
+    message.checkAccess !== undefined && (obj.checkAccess = message.checkAccess);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<GetConfiguredClustersRequest>): GetConfiguredClustersRequest {
+    const message = {
+      ...baseGetConfiguredClustersRequest,
+    } as GetConfiguredClustersRequest;
+    if (object.checkAccess !== undefined && object.checkAccess !== null) {
+      message.checkAccess = object.checkAccess;
+    } else {
+      message.checkAccess = false;
+    }
+    return message;
+  },
+};
+
+const baseGetConfiguredClustersResponse: object = {};
+
+export const GetConfiguredClustersResponse = {
+  encode(
+    message: GetConfiguredClustersResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.clusters) {
+      ConfiguredCluster.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetConfiguredClustersResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseGetConfiguredClustersResponse,
+    } as GetConfiguredClustersResponse;
+    message.clusters = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.clusters.push(ConfiguredCluster.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetConfiguredClustersResponse {
+    const message = {
+      ...baseGetConfiguredClustersResponse,
+    } as GetConfiguredClustersResponse;
+    message.clusters = [];
+    if (object.clusters !== undefined && object.clusters !== null) {
+      for (const e of object.clusters) {
+        message.clusters.push(ConfiguredCluster.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: GetConfiguredClustersResponse): unknown {
+    const obj: any = {};
+    if (message.clusters) {
+      obj.clusters = message.clusters.map(e => (e ? ConfiguredCluster.toJSON(e) : undefined));
+    } else {
+      obj.clusters = [];
+    }
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<GetConfiguredClustersResponse>): GetConfiguredClustersResponse {
+    const message = {
+      ...baseGetConfiguredClustersResponse,
+    } as GetConfiguredClustersResponse;
+    message.clusters = [];
+    if (object.clusters !== undefined && object.clusters !== null) {
+      for (const e of object.clusters) {
+        message.clusters.push(ConfiguredCluster.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseConfiguredCluster: object = {
+  name: "",
+  apiServiceUrl: "",
+  isKubeappsCluster: false,
+  accessible: false,
+};
+
+export const ConfiguredCluster = {
+  encode(message: ConfiguredCluster, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.apiServiceUrl !== "") {
+      writer.uint32(18).string(message.apiServiceUrl);
+    }
+    if (message.isKubeappsCluster === true) {
+      writer.uint32(24).bool(message.isKubeappsCluster);
+    }
+    if (message.accessible === true) {
+      writer.uint32(32).bool(message.accessible);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ConfiguredCluster {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseConfiguredCluster } as ConfiguredCluster;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.apiServiceUrl = reader.string();
+          break;
+        case 3:
+          message.isKubeappsCluster = reader.bool();
+          break;
+        case 4:
+          message.accessible = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConfiguredCluster {
+    const message = { ...baseConfiguredCluster } as ConfiguredCluster;
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
+    } else {
+      message.name = "";
+    }
+    if (object.apiServiceUrl !== undefined && object.apiServiceUrl !== null) {
+      message.apiServiceUrl = String(object.apiServiceUrl);
+    } else {
+      message.apiServiceUrl = "";
+    }
+    if (object.isKubeappsCluster !== undefined && object.isKubeappsCluster !== null) {
+      message.isKubeappsCluster = Boolean(object.isKubeappsCluster);
+    } else {
+      message.isKubeappsCluster = false;
+    }
+    if (object.accessible !== undefined && object.accessible !== null) {
+      message.accessible = Boolean(object.accessible);
+    } else {
+      message.accessible = false;
+    }
+    return message;
+  },
+
+  toJSON(message: ConfiguredCluster): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.apiServiceUrl !== undefined && (obj.apiServiceUrl = message.apiServiceUrl);
+    message.isKubeappsCluster !== undefined && (obj.isKubeappsCluster = message.isKubeappsCluster);
+    message.accessible !== undefined && (obj.accessible = message.accessible);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<ConfiguredCluster>): ConfiguredCluster {
+    const message = { ...baseConfiguredCluster } as ConfiguredCluster;
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
+    } else {
+      message.name = "";
+    }
+    if (object.apiServiceUrl !== undefined && object.apiServiceUrl !== null) {
+      message.apiServiceUrl = object.apiServiceUrl;
+    } else {
+      message.apiServiceUrl = "";
+    }
+    if (object.isKubeappsCluster !== undefined && object.isKubeappsCluster !== null) {
+      message.isKubeappsCluster = object.isKubeappsCluster;
+    } else {
+      message.isKubeappsCluster = false;
+    }
+    if (object.accessible !== undefined && object.accessible !== null) {
+      message.accessible = object.accessible;
+    } else {
+      message.accessible = false;
+    }
     return message;
   },
 };
@@ -234,12 +627,99 @@ export const Plugin = {
   },
 };
 
+const baseServicePlugins: object = { service: "" };
+
+export const ServicePlugins = {
+  encode(message: ServicePlugins, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.service !== "") {
+      writer.uint32(10).string(message.service);
+    }
+    for (const v of message.plugins) {
+      Plugin.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ServicePlugins {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseServicePlugins } as ServicePlugins;
+    message.plugins = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.service = reader.string();
+          break;
+        case 2:
+          message.plugins.push(Plugin.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ServicePlugins {
+    const message = { ...baseServicePlugins } as ServicePlugins;
+    message.plugins = [];
+    if (object.service !== undefined && object.service !== null) {
+      message.service = String(object.service);
+    } else {
+      message.service = "";
+    }
+    if (object.plugins !== undefined && object.plugins !== null) {
+      for (const e of object.plugins) {
+        message.plugins.push(Plugin.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: ServicePlugins): unknown {
+    const obj: any = {};
+    message.service !== undefined && (obj.service = message.service);
+    if (message.plugins) {
+      obj.plugins = message.plugins.map(e => (e ? Plugin.toJSON(e) : undefined));
+    } else {
+      obj.plugins = [];
+    }
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<ServicePlugins>): ServicePlugins {
+    const message = { ...baseServicePlugins } as ServicePlugins;
+    message.plugins = [];
+    if (object.service !== undefined && object.service !== null) {
+      message.service = object.service;
+    } else {
+      message.service = "";
+    }
+    if (object.plugins !== undefined && object.plugins !== null) {
+      for (const e of object.plugins) {
+        message.plugins.push(Plugin.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
 export interface PluginsService {
   /** GetConfiguredPlugins returns a map of short and longnames for the configured plugins. */
   GetConfiguredPlugins(
     request: DeepPartial<GetConfiguredPluginsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetConfiguredPluginsResponse>;
+  /**
+   * GetConfiguredClusters returns the configured clusters and, when requested,
+   * whether the user can access each of them.
+   */
+  GetConfiguredClusters(
+    request: DeepPartial<GetConfiguredClustersRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetConfiguredClustersResponse>;
 }
 
 export class PluginsServiceClientImpl implements PluginsService {
@@ -248,6 +728,7 @@ export class PluginsServiceClientImpl implements PluginsService {
   constructor(rpc: Rpc) {
     this.rpc = rpc;
     this.GetConfiguredPlugins = this.GetConfiguredPlugins.bind(this);
+    this.GetConfiguredClusters = this.GetConfiguredClusters.bind(this);
   }
 
   GetConfiguredPlugins(
@@ -260,6 +741,17 @@ export class PluginsServiceClientImpl implements PluginsService {
       metadata,
     );
   }
+
+  GetConfiguredClusters(
+    request: DeepPartial<GetConfiguredClustersRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetConfiguredClustersResponse> {
+    return this.rpc.unary(
+      PluginsServiceGetConfiguredClustersDesc,
+      GetConfiguredClustersRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const PluginsServiceDesc = {
@@ -288,6 +780,28 @@ export const PluginsServiceGetConfiguredPluginsDesc: UnaryMethodDefinitionish =
   } as any,
 };
 
+export const PluginsServiceGetConfiguredClustersDesc: UnaryMethodDefinitionish = {
+  methodName: "GetConfiguredClusters",
+  service: PluginsServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetConfiguredClustersRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetConfiguredClustersResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
@@ -7,19 +7,43 @@ import {
   GetAvailablePackageSummariesRequest,
   GetAvailablePackageDetailRequest,
   GetAvailablePackageVersionsRequest,
+  GetAvailablePackageDependenciesRequest,
+  GetAvailablePackageDefaultValuesRequest,
+  GetAvailablePackageChangelogRequest,
+  GetAvailablePackageValuesDiffRequest,
+  RenderAvailablePackageRequest,
   GetInstalledPackageSummariesRequest,
   GetInstalledPackageDetailRequest,
+  GetInstalledPackageValuesRequest,
   CreateInstalledPackageRequest,
   UpdateInstalledPackageRequest,
+  GetInstalledPackageUpgradeDiffRequest,
+  GetInstalledPackageDriftRequest,
+  GetInstalledPackageUpdateAvailableRequest,
+  ExportInstalledPackageRequest,
   DeleteInstalledPackageRequest,
+  GetRecentActivityRequest,
+  GetUserPermissionsRequest,
   GetAvailablePackageSummariesResponse,
   GetAvailablePackageDetailResponse,
   GetAvailablePackageVersionsResponse,
+  GetAvailablePackageDependenciesResponse,
+  GetAvailablePackageDefaultValuesResponse,
+  GetAvailablePackageChangelogResponse,
+  GetAvailablePackageValuesDiffResponse,
+  RenderAvailablePackageResponse,
   GetInstalledPackageSummariesResponse,
   GetInstalledPackageDetailResponse,
+  GetInstalledPackageValuesResponse,
   CreateInstalledPackageResponse,
   UpdateInstalledPackageResponse,
+  GetInstalledPackageUpgradeDiffResponse,
+  GetInstalledPackageDriftResponse,
+  GetInstalledPackageUpdateAvailableResponse,
+  ExportInstalledPackageResponse,
   DeleteInstalledPackageResponse,
+  GetRecentActivityResponse,
+  GetUserPermissionsResponse,
 } from "../../../../../kubeappsapis/core/packages/v1alpha1/packages";
 import { Plugin } from "../../../../../kubeappsapis/core/plugins/v1alpha1/plugins";
 import { BrowserHeaders } from "browser-headers";
@@ -343,6 +367,31 @@ export interface FluxV2PackagesService {
     request: DeepPartial<GetAvailablePackageVersionsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetAvailablePackageVersionsResponse>;
+  /** GetAvailablePackageDependencies returns the dependencies of a package managed by the 'fluxv2' plugin */
+  GetAvailablePackageDependencies(
+    request: DeepPartial<GetAvailablePackageDependenciesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDependenciesResponse>;
+  /** GetAvailablePackageDefaultValues returns the default values of an available package managed by the 'fluxv2' plugin */
+  GetAvailablePackageDefaultValues(
+    request: DeepPartial<GetAvailablePackageDefaultValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDefaultValuesResponse>;
+  /** GetAvailablePackageChangelog returns the changelog between two versions of an available package managed by the 'fluxv2' plugin */
+  GetAvailablePackageChangelog(
+    request: DeepPartial<GetAvailablePackageChangelogRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageChangelogResponse>;
+  /** GetAvailablePackageValuesDiff returns the differences between the default values of two versions of an available package managed by the 'fluxv2' plugin */
+  GetAvailablePackageValuesDiff(
+    request: DeepPartial<GetAvailablePackageValuesDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageValuesDiffResponse>;
+  /** RenderAvailablePackage renders the templates of an available package managed by the 'fluxv2' plugin with the given values */
+  RenderAvailablePackage(
+    request: DeepPartial<RenderAvailablePackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RenderAvailablePackageResponse>;
   /** GetPackageRepositories returns the repositories managed by the 'fluxv2' plugin */
   GetPackageRepositories(
     request: DeepPartial<GetPackageRepositoriesRequest>,
@@ -358,6 +407,11 @@ export interface FluxV2PackagesService {
     request: DeepPartial<GetInstalledPackageDetailRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageDetailResponse>;
+  /** GetInstalledPackageValues returns the values currently in effect for an installed package managed by the 'fluxv2' plugin */
+  GetInstalledPackageValues(
+    request: DeepPartial<GetInstalledPackageValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageValuesResponse>;
   /** CreateInstalledPackage creates an installed package based on the request. */
   CreateInstalledPackage(
     request: DeepPartial<CreateInstalledPackageRequest>,
@@ -368,11 +422,36 @@ export interface FluxV2PackagesService {
     request: DeepPartial<UpdateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<UpdateInstalledPackageResponse>;
+  /** GetInstalledPackageUpgradeDiff returns the diff of the manifests of an upgrade of an installed package managed by the 'fluxv2' plugin */
+  GetInstalledPackageUpgradeDiff(
+    request: DeepPartial<GetInstalledPackageUpgradeDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpgradeDiffResponse>;
+  /** GetInstalledPackageDrift returns how an installed package managed by the 'fluxv2' plugin differs from the current state of the cluster */
+  GetInstalledPackageDrift(
+    request: DeepPartial<GetInstalledPackageDriftRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageDriftResponse>;
+  /** ExportInstalledPackage returns a declarative manifest of an installed package managed by the 'fluxv2' plugin */
+  ExportInstalledPackage(
+    request: DeepPartial<ExportInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ExportInstalledPackageResponse>;
   /** DeleteInstalledPackage deletes an installed package based on the request. */
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<DeleteInstalledPackageResponse>;
+  /** GetRecentActivity returns the recent operations on the installed packages managed by the 'fluxv2' plugin */
+  GetRecentActivity(
+    request: DeepPartial<GetRecentActivityRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetRecentActivityResponse>;
+  /** GetUserPermissions returns the operations the user can perform on the installed packages managed by the 'fluxv2' plugin */
+  GetUserPermissions(
+    request: DeepPartial<GetUserPermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetUserPermissionsResponse>;
 }
 
 export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
@@ -383,12 +462,23 @@ export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
     this.GetAvailablePackageSummaries = this.GetAvailablePackageSummaries.bind(this);
     this.GetAvailablePackageDetail = this.GetAvailablePackageDetail.bind(this);
     this.GetAvailablePackageVersions = this.GetAvailablePackageVersions.bind(this);
+    this.GetAvailablePackageDependencies = this.GetAvailablePackageDependencies.bind(this);
+    this.GetAvailablePackageDefaultValues = this.GetAvailablePackageDefaultValues.bind(this);
+    this.GetAvailablePackageChangelog = this.GetAvailablePackageChangelog.bind(this);
+    this.GetAvailablePackageValuesDiff = this.GetAvailablePackageValuesDiff.bind(this);
+    this.RenderAvailablePackage = this.RenderAvailablePackage.bind(this);
     this.GetPackageRepositories = this.GetPackageRepositories.bind(this);
     this.GetInstalledPackageSummaries = this.GetInstalledPackageSummaries.bind(this);
     this.GetInstalledPackageDetail = this.GetInstalledPackageDetail.bind(this);
+    this.GetInstalledPackageValues = this.GetInstalledPackageValues.bind(this);
     this.CreateInstalledPackage = this.CreateInstalledPackage.bind(this);
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
+    this.GetInstalledPackageUpgradeDiff = this.GetInstalledPackageUpgradeDiff.bind(this);
+    this.GetInstalledPackageDrift = this.GetInstalledPackageDrift.bind(this);
+    this.ExportInstalledPackage = this.ExportInstalledPackage.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
+    this.GetRecentActivity = this.GetRecentActivity.bind(this);
+    this.GetUserPermissions = this.GetUserPermissions.bind(this);
   }
 
   GetAvailablePackageSummaries(
@@ -424,6 +514,61 @@ export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
     );
   }
 
+  GetAvailablePackageDependencies(
+    request: DeepPartial<GetAvailablePackageDependenciesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDependenciesResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetAvailablePackageDependenciesDesc,
+      GetAvailablePackageDependenciesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageDefaultValues(
+    request: DeepPartial<GetAvailablePackageDefaultValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDefaultValuesResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetAvailablePackageDefaultValuesDesc,
+      GetAvailablePackageDefaultValuesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageChangelog(
+    request: DeepPartial<GetAvailablePackageChangelogRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageChangelogResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetAvailablePackageChangelogDesc,
+      GetAvailablePackageChangelogRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageValuesDiff(
+    request: DeepPartial<GetAvailablePackageValuesDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageValuesDiffResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetAvailablePackageValuesDiffDesc,
+      GetAvailablePackageValuesDiffRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  RenderAvailablePackage(
+    request: DeepPartial<RenderAvailablePackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RenderAvailablePackageResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceRenderAvailablePackageDesc,
+      RenderAvailablePackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   GetPackageRepositories(
     request: DeepPartial<GetPackageRepositoriesRequest>,
     metadata?: grpc.Metadata,
@@ -457,6 +602,17 @@ export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
     );
   }
 
+  GetInstalledPackageValues(
+    request: DeepPartial<GetInstalledPackageValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageValuesResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetInstalledPackageValuesDesc,
+      GetInstalledPackageValuesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   CreateInstalledPackage(
     request: DeepPartial<CreateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -479,6 +635,39 @@ export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
     );
   }
 
+  GetInstalledPackageUpgradeDiff(
+    request: DeepPartial<GetInstalledPackageUpgradeDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpgradeDiffResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetInstalledPackageUpgradeDiffDesc,
+      GetInstalledPackageUpgradeDiffRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetInstalledPackageDrift(
+    request: DeepPartial<GetInstalledPackageDriftRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageDriftResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetInstalledPackageDriftDesc,
+      GetInstalledPackageDriftRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ExportInstalledPackage(
+    request: DeepPartial<ExportInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ExportInstalledPackageResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceExportInstalledPackageDesc,
+      ExportInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -489,6 +678,28 @@ export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
       metadata,
     );
   }
+
+  GetRecentActivity(
+    request: DeepPartial<GetRecentActivityRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetRecentActivityResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetRecentActivityDesc,
+      GetRecentActivityRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetUserPermissions(
+    request: DeepPartial<GetUserPermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetUserPermissionsResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetUserPermissionsDesc,
+      GetUserPermissionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const FluxV2PackagesServiceDesc = {
@@ -561,6 +772,116 @@ export const FluxV2PackagesServiceGetAvailablePackageVersionsDesc: UnaryMethodDe
   } as any,
 };
 
+export const FluxV2PackagesServiceGetAvailablePackageDependenciesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageDependencies",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageDependenciesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageDependenciesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceGetAvailablePackageDefaultValuesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageDefaultValues",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageDefaultValuesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageDefaultValuesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceGetAvailablePackageChangelogDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageChangelog",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageChangelogRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageChangelogResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceGetAvailablePackageValuesDiffDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageValuesDiff",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageValuesDiffRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageValuesDiffResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceRenderAvailablePackageDesc: UnaryMethodDefinitionish = {
+  methodName: "RenderAvailablePackage",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return RenderAvailablePackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...RenderAvailablePackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const FluxV2PackagesServiceGetPackageRepositoriesDesc: UnaryMethodDefinitionish = {
   methodName: "GetPackageRepositories",
   service: FluxV2PackagesServiceDesc,
@@ -627,6 +948,28 @@ export const FluxV2PackagesServiceGetInstalledPackageDetailDesc: UnaryMethodDefi
   } as any,
 };
 
+export const FluxV2PackagesServiceGetInstalledPackageValuesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageValues",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageValuesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageValuesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const FluxV2PackagesServiceCreateInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "CreateInstalledPackage",
   service: FluxV2PackagesServiceDesc,
@@ -671,6 +1014,95 @@ export const FluxV2PackagesServiceUpdateInstalledPackageDesc: UnaryMethodDefinit
   } as any,
 };
 
+export const FluxV2PackagesServiceGetInstalledPackageUpgradeDiffDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageUpgradeDiff",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageUpgradeDiffRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageUpgradeDiffResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceGetInstalledPackageDriftDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageDrift",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageDriftRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageDriftResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceGetInstalledPackageUpdateAvailableDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "GetInstalledPackageUpdateAvailable",
+    service: FluxV2PackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return GetInstalledPackageUpdateAvailableRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...GetInstalledPackageUpdateAvailableResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
+export const FluxV2PackagesServiceExportInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "ExportInstalledPackage",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ExportInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ExportInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const FluxV2PackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "DeleteInstalledPackage",
   service: FluxV2PackagesServiceDesc,
@@ -693,6 +1125,50 @@ export const FluxV2PackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinit
   } as any,
 };
 
+export const FluxV2PackagesServiceGetRecentActivityDesc: UnaryMethodDefinitionish = {
+  methodName: "GetRecentActivity",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetRecentActivityRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetRecentActivityResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceGetUserPermissionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetUserPermissions",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetUserPermissionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetUserPermissionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
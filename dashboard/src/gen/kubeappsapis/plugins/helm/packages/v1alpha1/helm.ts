@@ -7,19 +7,41 @@ import {
   GetAvailablePackageSummariesRequest,
   GetAvailablePackageDetailRequest,
   GetAvailablePackageVersionsRequest,
+  GetAvailablePackageDependenciesRequest,
+  GetAvailablePackageDefaultValuesRequest,
+  GetAvailablePackageChangelogRequest,
+  GetAvailablePackageValuesDiffRequest,
+  RenderAvailablePackageRequest,
   GetInstalledPackageSummariesRequest,
   GetInstalledPackageDetailRequest,
+  GetInstalledPackageValuesRequest,
   CreateInstalledPackageRequest,
   UpdateInstalledPackageRequest,
+  GetInstalledPackageUpgradeDiffRequest,
+  GetInstalledPackageDriftRequest,
+  ExportInstalledPackageRequest,
   DeleteInstalledPackageRequest,
+  GetRecentActivityRequest,
+  GetUserPermissionsRequest,
   GetAvailablePackageSummariesResponse,
   GetAvailablePackageDetailResponse,
   GetAvailablePackageVersionsResponse,
+  GetAvailablePackageDependenciesResponse,
+  GetAvailablePackageDefaultValuesResponse,
+  GetAvailablePackageChangelogResponse,
+  GetAvailablePackageValuesDiffResponse,
+  RenderAvailablePackageResponse,
   GetInstalledPackageSummariesResponse,
   GetInstalledPackageDetailResponse,
+  GetInstalledPackageValuesResponse,
   CreateInstalledPackageResponse,
   UpdateInstalledPackageResponse,
+  GetInstalledPackageUpgradeDiffResponse,
+  GetInstalledPackageDriftResponse,
+  ExportInstalledPackageResponse,
   DeleteInstalledPackageResponse,
+  GetRecentActivityResponse,
+  GetUserPermissionsResponse,
 } from "../../../../../kubeappsapis/core/packages/v1alpha1/packages";
 import { BrowserHeaders } from "browser-headers";
 
@@ -317,6 +339,31 @@ export interface HelmPackagesService {
     request: DeepPartial<GetAvailablePackageVersionsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetAvailablePackageVersionsResponse>;
+  /** GetAvailablePackageDependencies returns the dependencies of a package managed by the 'helm' plugin */
+  GetAvailablePackageDependencies(
+    request: DeepPartial<GetAvailablePackageDependenciesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDependenciesResponse>;
+  /** GetAvailablePackageDefaultValues returns the default values of an available package managed by the 'helm' plugin */
+  GetAvailablePackageDefaultValues(
+    request: DeepPartial<GetAvailablePackageDefaultValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDefaultValuesResponse>;
+  /** GetAvailablePackageChangelog returns the changelog between two versions of an available package managed by the 'helm' plugin */
+  GetAvailablePackageChangelog(
+    request: DeepPartial<GetAvailablePackageChangelogRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageChangelogResponse>;
+  /** GetAvailablePackageValuesDiff returns the differences between the default values of two versions of an available package managed by the 'helm' plugin */
+  GetAvailablePackageValuesDiff(
+    request: DeepPartial<GetAvailablePackageValuesDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageValuesDiffResponse>;
+  /** RenderAvailablePackage renders the templates of an available package managed by the 'helm' plugin with the given values */
+  RenderAvailablePackage(
+    request: DeepPartial<RenderAvailablePackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RenderAvailablePackageResponse>;
   /** GetInstalledPackageSummaries returns the installed packages managed by the 'helm' plugin */
   GetInstalledPackageSummaries(
     request: DeepPartial<GetInstalledPackageSummariesRequest>,
@@ -327,6 +374,11 @@ export interface HelmPackagesService {
     request: DeepPartial<GetInstalledPackageDetailRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageDetailResponse>;
+  /** GetInstalledPackageValues returns the values currently in effect for an installed package managed by the 'helm' plugin */
+  GetInstalledPackageValues(
+    request: DeepPartial<GetInstalledPackageValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageValuesResponse>;
   /** CreateInstalledPackage creates an installed package based on the request. */
   CreateInstalledPackage(
     request: DeepPartial<CreateInstalledPackageRequest>,
@@ -337,11 +389,36 @@ export interface HelmPackagesService {
     request: DeepPartial<UpdateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<UpdateInstalledPackageResponse>;
+  /** GetInstalledPackageUpgradeDiff returns the diff of the manifests of an upgrade of an installed package managed by the 'helm' plugin */
+  GetInstalledPackageUpgradeDiff(
+    request: DeepPartial<GetInstalledPackageUpgradeDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpgradeDiffResponse>;
+  /** GetInstalledPackageDrift returns how an installed package managed by the 'helm' plugin differs from the current state of the cluster */
+  GetInstalledPackageDrift(
+    request: DeepPartial<GetInstalledPackageDriftRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageDriftResponse>;
+  /** ExportInstalledPackage returns a declarative manifest of an installed package managed by the 'helm' plugin */
+  ExportInstalledPackage(
+    request: DeepPartial<ExportInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ExportInstalledPackageResponse>;
   /** DeleteInstalledPackage deletes an installed package based on the request. */
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<DeleteInstalledPackageResponse>;
+  /** GetRecentActivity returns the recent operations on the installed packages managed by the 'helm' plugin */
+  GetRecentActivity(
+    request: DeepPartial<GetRecentActivityRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetRecentActivityResponse>;
+  /** GetUserPermissions returns the operations the user can perform on the installed packages managed by the 'helm' plugin */
+  GetUserPermissions(
+    request: DeepPartial<GetUserPermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetUserPermissionsResponse>;
   /** RollbackInstalledPackage updates an installed package based on the request. */
   RollbackInstalledPackage(
     request: DeepPartial<RollbackInstalledPackageRequest>,
@@ -357,11 +434,22 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
     this.GetAvailablePackageSummaries = this.GetAvailablePackageSummaries.bind(this);
     this.GetAvailablePackageDetail = this.GetAvailablePackageDetail.bind(this);
     this.GetAvailablePackageVersions = this.GetAvailablePackageVersions.bind(this);
+    this.GetAvailablePackageDependencies = this.GetAvailablePackageDependencies.bind(this);
+    this.GetAvailablePackageDefaultValues = this.GetAvailablePackageDefaultValues.bind(this);
+    this.GetAvailablePackageChangelog = this.GetAvailablePackageChangelog.bind(this);
+    this.GetAvailablePackageValuesDiff = this.GetAvailablePackageValuesDiff.bind(this);
+    this.RenderAvailablePackage = this.RenderAvailablePackage.bind(this);
     this.GetInstalledPackageSummaries = this.GetInstalledPackageSummaries.bind(this);
     this.GetInstalledPackageDetail = this.GetInstalledPackageDetail.bind(this);
+    this.GetInstalledPackageValues = this.GetInstalledPackageValues.bind(this);
     this.CreateInstalledPackage = this.CreateInstalledPackage.bind(this);
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
+    this.GetInstalledPackageUpgradeDiff = this.GetInstalledPackageUpgradeDiff.bind(this);
+    this.GetInstalledPackageDrift = this.GetInstalledPackageDrift.bind(this);
+    this.ExportInstalledPackage = this.ExportInstalledPackage.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
+    this.GetRecentActivity = this.GetRecentActivity.bind(this);
+    this.GetUserPermissions = this.GetUserPermissions.bind(this);
     this.RollbackInstalledPackage = this.RollbackInstalledPackage.bind(this);
   }
 
@@ -398,6 +486,61 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
     );
   }
 
+  GetAvailablePackageDependencies(
+    request: DeepPartial<GetAvailablePackageDependenciesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDependenciesResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetAvailablePackageDependenciesDesc,
+      GetAvailablePackageDependenciesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageDefaultValues(
+    request: DeepPartial<GetAvailablePackageDefaultValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDefaultValuesResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetAvailablePackageDefaultValuesDesc,
+      GetAvailablePackageDefaultValuesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageChangelog(
+    request: DeepPartial<GetAvailablePackageChangelogRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageChangelogResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetAvailablePackageChangelogDesc,
+      GetAvailablePackageChangelogRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageValuesDiff(
+    request: DeepPartial<GetAvailablePackageValuesDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageValuesDiffResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetAvailablePackageValuesDiffDesc,
+      GetAvailablePackageValuesDiffRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  RenderAvailablePackage(
+    request: DeepPartial<RenderAvailablePackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RenderAvailablePackageResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceRenderAvailablePackageDesc,
+      RenderAvailablePackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   GetInstalledPackageSummaries(
     request: DeepPartial<GetInstalledPackageSummariesRequest>,
     metadata?: grpc.Metadata,
@@ -420,6 +563,17 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
     );
   }
 
+  GetInstalledPackageValues(
+    request: DeepPartial<GetInstalledPackageValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageValuesResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetInstalledPackageValuesDesc,
+      GetInstalledPackageValuesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   CreateInstalledPackage(
     request: DeepPartial<CreateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -442,6 +596,39 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
     );
   }
 
+  GetInstalledPackageUpgradeDiff(
+    request: DeepPartial<GetInstalledPackageUpgradeDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpgradeDiffResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetInstalledPackageUpgradeDiffDesc,
+      GetInstalledPackageUpgradeDiffRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetInstalledPackageDrift(
+    request: DeepPartial<GetInstalledPackageDriftRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageDriftResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetInstalledPackageDriftDesc,
+      GetInstalledPackageDriftRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ExportInstalledPackage(
+    request: DeepPartial<ExportInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ExportInstalledPackageResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceExportInstalledPackageDesc,
+      ExportInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -453,6 +640,28 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
     );
   }
 
+  GetRecentActivity(
+    request: DeepPartial<GetRecentActivityRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetRecentActivityResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetRecentActivityDesc,
+      GetRecentActivityRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetUserPermissions(
+    request: DeepPartial<GetUserPermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetUserPermissionsResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetUserPermissionsDesc,
+      GetUserPermissionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   RollbackInstalledPackage(
     request: DeepPartial<RollbackInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -535,6 +744,116 @@ export const HelmPackagesServiceGetAvailablePackageVersionsDesc: UnaryMethodDefi
   } as any,
 };
 
+export const HelmPackagesServiceGetAvailablePackageDependenciesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageDependencies",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageDependenciesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageDependenciesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const HelmPackagesServiceGetAvailablePackageDefaultValuesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageDefaultValues",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageDefaultValuesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageDefaultValuesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const HelmPackagesServiceGetAvailablePackageChangelogDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageChangelog",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageChangelogRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageChangelogResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const HelmPackagesServiceGetAvailablePackageValuesDiffDesc: UnaryMethodDefinitionish = {
+  methodName: "GetAvailablePackageValuesDiff",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetAvailablePackageValuesDiffRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetAvailablePackageValuesDiffResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const HelmPackagesServiceRenderAvailablePackageDesc: UnaryMethodDefinitionish = {
+  methodName: "RenderAvailablePackage",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return RenderAvailablePackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...RenderAvailablePackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const HelmPackagesServiceGetInstalledPackageSummariesDesc: UnaryMethodDefinitionish = {
   methodName: "GetInstalledPackageSummaries",
   service: HelmPackagesServiceDesc,
@@ -579,6 +898,28 @@ export const HelmPackagesServiceGetInstalledPackageDetailDesc: UnaryMethodDefini
   } as any,
 };
 
+export const HelmPackagesServiceGetInstalledPackageValuesDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageValues",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageValuesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageValuesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const HelmPackagesServiceCreateInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "CreateInstalledPackage",
   service: HelmPackagesServiceDesc,
@@ -623,6 +964,72 @@ export const HelmPackagesServiceUpdateInstalledPackageDesc: UnaryMethodDefinitio
   } as any,
 };
 
+export const HelmPackagesServiceGetInstalledPackageUpgradeDiffDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageUpgradeDiff",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageUpgradeDiffRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageUpgradeDiffResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const HelmPackagesServiceGetInstalledPackageDriftDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageDrift",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageDriftRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageDriftResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const HelmPackagesServiceExportInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "ExportInstalledPackage",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ExportInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ExportInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const HelmPackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "DeleteInstalledPackage",
   service: HelmPackagesServiceDesc,
@@ -645,6 +1052,50 @@ export const HelmPackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinitio
   } as any,
 };
 
+export const HelmPackagesServiceGetRecentActivityDesc: UnaryMethodDefinitionish = {
+  methodName: "GetRecentActivity",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetRecentActivityRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetRecentActivityResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const HelmPackagesServiceGetUserPermissionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetUserPermissions",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetUserPermissionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetUserPermissionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const HelmPackagesServiceRollbackInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "RollbackInstalledPackage",
   service: HelmPackagesServiceDesc,
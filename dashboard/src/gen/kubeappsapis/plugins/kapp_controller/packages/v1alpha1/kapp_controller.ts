@@ -7,19 +7,47 @@ import {
   GetAvailablePackageSummariesRequest,
   GetAvailablePackageDetailRequest,
   GetAvailablePackageVersionsRequest,
+  GetAvailablePackageDependenciesRequest,
+  GetAvailablePackageDefaultValuesRequest,
+  GetAvailablePackageChangelogRequest,
+  GetAvailablePackageValuesDiffRequest,
+  RenderAvailablePackageRequest,
   GetInstalledPackageSummariesRequest,
   GetInstalledPackageDetailRequest,
+  GetInstalledPackageDetailsBatchRequest,
+  GetInstalledPackageValuesRequest,
   CreateInstalledPackageRequest,
   UpdateInstalledPackageRequest,
+  GetInstalledPackageUpgradeDiffRequest,
+  GetInstalledPackageDriftRequest,
+  GetInstalledPackageUpdateAvailableRequest,
+  ExportInstalledPackageRequest,
   DeleteInstalledPackageRequest,
+  DeleteInstalledPackagesBatchRequest,
+  GetRecentActivityRequest,
+  GetUserPermissionsRequest,
   GetAvailablePackageSummariesResponse,
   GetAvailablePackageDetailResponse,
   GetAvailablePackageVersionsResponse,
+  GetAvailablePackageDependenciesResponse,
+  GetAvailablePackageDefaultValuesResponse,
+  GetAvailablePackageChangelogResponse,
+  GetAvailablePackageValuesDiffResponse,
+  RenderAvailablePackageResponse,
   GetInstalledPackageSummariesResponse,
   GetInstalledPackageDetailResponse,
+  GetInstalledPackageDetailsBatchResponse,
+  GetInstalledPackageValuesResponse,
   CreateInstalledPackageResponse,
   UpdateInstalledPackageResponse,
+  GetInstalledPackageUpgradeDiffResponse,
+  GetInstalledPackageDriftResponse,
+  GetInstalledPackageUpdateAvailableResponse,
+  ExportInstalledPackageResponse,
   DeleteInstalledPackageResponse,
+  DeleteInstalledPackagesBatchResponse,
+  GetRecentActivityResponse,
+  GetUserPermissionsResponse,
 } from "../../../../../kubeappsapis/core/packages/v1alpha1/packages";
 import { Plugin } from "../../../../../kubeappsapis/core/plugins/v1alpha1/plugins";
 import { BrowserHeaders } from "browser-headers";
@@ -348,6 +376,31 @@ export interface KappControllerPackagesService {
     request: DeepPartial<GetAvailablePackageVersionsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetAvailablePackageVersionsResponse>;
+  /** GetAvailablePackageDependencies returns the dependencies of a package managed by the 'kapp_controller' plugin */
+  GetAvailablePackageDependencies(
+    request: DeepPartial<GetAvailablePackageDependenciesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDependenciesResponse>;
+  /** GetAvailablePackageDefaultValues returns the default values of an available package managed by the 'kapp_controller' plugin */
+  GetAvailablePackageDefaultValues(
+    request: DeepPartial<GetAvailablePackageDefaultValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDefaultValuesResponse>;
+  /** GetAvailablePackageChangelog returns the changelog between two versions of an available package managed by the 'kapp_controller' plugin */
+  GetAvailablePackageChangelog(
+    request: DeepPartial<GetAvailablePackageChangelogRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageChangelogResponse>;
+  /** GetAvailablePackageValuesDiff returns the differences between the default values of two versions of an available package managed by the 'kapp_controller' plugin */
+  GetAvailablePackageValuesDiff(
+    request: DeepPartial<GetAvailablePackageValuesDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageValuesDiffResponse>;
+  /** RenderAvailablePackage renders the templates of an available package managed by the 'kapp_controller' plugin with the given values */
+  RenderAvailablePackage(
+    request: DeepPartial<RenderAvailablePackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RenderAvailablePackageResponse>;
   /** GetInstalledPackageSummaries returns the installed packages managed by the 'kapp_controller' plugin */
   GetInstalledPackageSummaries(
     request: DeepPartial<GetInstalledPackageSummariesRequest>,
@@ -358,6 +411,11 @@ export interface KappControllerPackagesService {
     request: DeepPartial<GetInstalledPackageDetailRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageDetailResponse>;
+  /** GetInstalledPackageValues returns the values currently in effect for an installed package managed by the 'kapp_controller' plugin */
+  GetInstalledPackageValues(
+    request: DeepPartial<GetInstalledPackageValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageValuesResponse>;
   /** CreateInstalledPackage creates an installed package based on the request. */
   CreateInstalledPackage(
     request: DeepPartial<CreateInstalledPackageRequest>,
@@ -368,11 +426,36 @@ export interface KappControllerPackagesService {
     request: DeepPartial<UpdateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<UpdateInstalledPackageResponse>;
+  /** GetInstalledPackageUpgradeDiff returns the diff of the manifests of an upgrade of an installed package managed by the 'kapp_controller' plugin */
+  GetInstalledPackageUpgradeDiff(
+    request: DeepPartial<GetInstalledPackageUpgradeDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpgradeDiffResponse>;
+  /** GetInstalledPackageDrift returns how an installed package managed by the 'kapp_controller' plugin differs from the current state of the cluster */
+  GetInstalledPackageDrift(
+    request: DeepPartial<GetInstalledPackageDriftRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageDriftResponse>;
+  /** ExportInstalledPackage returns a declarative manifest of an installed package managed by the 'kapp_controller' plugin */
+  ExportInstalledPackage(
+    request: DeepPartial<ExportInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ExportInstalledPackageResponse>;
   /** DeleteInstalledPackage deletes an installed package based on the request. */
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<DeleteInstalledPackageResponse>;
+  /** GetRecentActivity returns the recent operations on the installed packages managed by the 'kapp_controller' plugin */
+  GetRecentActivity(
+    request: DeepPartial<GetRecentActivityRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetRecentActivityResponse>;
+  /** GetUserPermissions returns the operations the user can perform on the installed packages managed by the 'kapp_controller' plugin */
+  GetUserPermissions(
+    request: DeepPartial<GetUserPermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetUserPermissionsResponse>;
 }
 
 export class KappControllerPackagesServiceClientImpl implements KappControllerPackagesService {
@@ -384,11 +467,22 @@ export class KappControllerPackagesServiceClientImpl implements KappControllerPa
     this.GetAvailablePackageDetail = this.GetAvailablePackageDetail.bind(this);
     this.GetPackageRepositories = this.GetPackageRepositories.bind(this);
     this.GetAvailablePackageVersions = this.GetAvailablePackageVersions.bind(this);
+    this.GetAvailablePackageDependencies = this.GetAvailablePackageDependencies.bind(this);
+    this.GetAvailablePackageDefaultValues = this.GetAvailablePackageDefaultValues.bind(this);
+    this.GetAvailablePackageChangelog = this.GetAvailablePackageChangelog.bind(this);
+    this.GetAvailablePackageValuesDiff = this.GetAvailablePackageValuesDiff.bind(this);
+    this.RenderAvailablePackage = this.RenderAvailablePackage.bind(this);
     this.GetInstalledPackageSummaries = this.GetInstalledPackageSummaries.bind(this);
     this.GetInstalledPackageDetail = this.GetInstalledPackageDetail.bind(this);
+    this.GetInstalledPackageValues = this.GetInstalledPackageValues.bind(this);
     this.CreateInstalledPackage = this.CreateInstalledPackage.bind(this);
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
+    this.GetInstalledPackageUpgradeDiff = this.GetInstalledPackageUpgradeDiff.bind(this);
+    this.GetInstalledPackageDrift = this.GetInstalledPackageDrift.bind(this);
+    this.ExportInstalledPackage = this.ExportInstalledPackage.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
+    this.GetRecentActivity = this.GetRecentActivity.bind(this);
+    this.GetUserPermissions = this.GetUserPermissions.bind(this);
   }
 
   GetAvailablePackageSummaries(
@@ -435,6 +529,61 @@ export class KappControllerPackagesServiceClientImpl implements KappControllerPa
     );
   }
 
+  GetAvailablePackageDependencies(
+    request: DeepPartial<GetAvailablePackageDependenciesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDependenciesResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceGetAvailablePackageDependenciesDesc,
+      GetAvailablePackageDependenciesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageDefaultValues(
+    request: DeepPartial<GetAvailablePackageDefaultValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageDefaultValuesResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceGetAvailablePackageDefaultValuesDesc,
+      GetAvailablePackageDefaultValuesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageChangelog(
+    request: DeepPartial<GetAvailablePackageChangelogRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageChangelogResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceGetAvailablePackageChangelogDesc,
+      GetAvailablePackageChangelogRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetAvailablePackageValuesDiff(
+    request: DeepPartial<GetAvailablePackageValuesDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetAvailablePackageValuesDiffResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceGetAvailablePackageValuesDiffDesc,
+      GetAvailablePackageValuesDiffRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  RenderAvailablePackage(
+    request: DeepPartial<RenderAvailablePackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RenderAvailablePackageResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceRenderAvailablePackageDesc,
+      RenderAvailablePackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   GetInstalledPackageSummaries(
     request: DeepPartial<GetInstalledPackageSummariesRequest>,
     metadata?: grpc.Metadata,
@@ -457,6 +606,17 @@ export class KappControllerPackagesServiceClientImpl implements KappControllerPa
     );
   }
 
+  GetInstalledPackageValues(
+    request: DeepPartial<GetInstalledPackageValuesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageValuesResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceGetInstalledPackageValuesDesc,
+      GetInstalledPackageValuesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   CreateInstalledPackage(
     request: DeepPartial<CreateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -479,6 +639,39 @@ export class KappControllerPackagesServiceClientImpl implements KappControllerPa
     );
   }
 
+  GetInstalledPackageUpgradeDiff(
+    request: DeepPartial<GetInstalledPackageUpgradeDiffRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpgradeDiffResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceGetInstalledPackageUpgradeDiffDesc,
+      GetInstalledPackageUpgradeDiffRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetInstalledPackageDrift(
+    request: DeepPartial<GetInstalledPackageDriftRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageDriftResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceGetInstalledPackageDriftDesc,
+      GetInstalledPackageDriftRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ExportInstalledPackage(
+    request: DeepPartial<ExportInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ExportInstalledPackageResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceExportInstalledPackageDesc,
+      ExportInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -489,6 +682,28 @@ export class KappControllerPackagesServiceClientImpl implements KappControllerPa
       metadata,
     );
   }
+
+  GetRecentActivity(
+    request: DeepPartial<GetRecentActivityRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetRecentActivityResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceGetRecentActivityDesc,
+      GetRecentActivityRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetUserPermissions(
+    request: DeepPartial<GetUserPermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetUserPermissionsResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceGetUserPermissionsDesc,
+      GetUserPermissionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const KappControllerPackagesServiceDesc = {
@@ -587,6 +802,120 @@ export const KappControllerPackagesServiceGetAvailablePackageVersionsDesc: Unary
     } as any,
   };
 
+export const KappControllerPackagesServiceGetAvailablePackageDependenciesDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "GetAvailablePackageDependencies",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return GetAvailablePackageDependenciesRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...GetAvailablePackageDependenciesResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
+export const KappControllerPackagesServiceGetAvailablePackageDefaultValuesDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "GetAvailablePackageDefaultValues",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return GetAvailablePackageDefaultValuesRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...GetAvailablePackageDefaultValuesResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
+export const KappControllerPackagesServiceGetAvailablePackageChangelogDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "GetAvailablePackageChangelog",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return GetAvailablePackageChangelogRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...GetAvailablePackageChangelogResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
+export const KappControllerPackagesServiceGetAvailablePackageValuesDiffDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "GetAvailablePackageValuesDiff",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return GetAvailablePackageValuesDiffRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...GetAvailablePackageValuesDiffResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
+export const KappControllerPackagesServiceRenderAvailablePackageDesc: UnaryMethodDefinitionish = {
+  methodName: "RenderAvailablePackage",
+  service: KappControllerPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return RenderAvailablePackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...RenderAvailablePackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const KappControllerPackagesServiceGetInstalledPackageSummariesDesc: UnaryMethodDefinitionish =
   {
     methodName: "GetInstalledPackageSummaries",
@@ -633,6 +962,52 @@ export const KappControllerPackagesServiceGetInstalledPackageDetailDesc: UnaryMe
     } as any,
   };
 
+export const KappControllerPackagesServiceGetInstalledPackageDetailsBatchDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "GetInstalledPackageDetailsBatch",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return GetInstalledPackageDetailsBatchRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...GetInstalledPackageDetailsBatchResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
+export const KappControllerPackagesServiceGetInstalledPackageValuesDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "GetInstalledPackageValues",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return GetInstalledPackageValuesRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...GetInstalledPackageValuesResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
 export const KappControllerPackagesServiceCreateInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "CreateInstalledPackage",
   service: KappControllerPackagesServiceDesc,
@@ -677,6 +1052,96 @@ export const KappControllerPackagesServiceUpdateInstalledPackageDesc: UnaryMetho
   } as any,
 };
 
+export const KappControllerPackagesServiceGetInstalledPackageUpgradeDiffDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "GetInstalledPackageUpgradeDiff",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return GetInstalledPackageUpgradeDiffRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...GetInstalledPackageUpgradeDiffResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
+export const KappControllerPackagesServiceGetInstalledPackageDriftDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageDrift",
+  service: KappControllerPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageDriftRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageDriftResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const KappControllerPackagesServiceGetInstalledPackageUpdateAvailableDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "GetInstalledPackageUpdateAvailable",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return GetInstalledPackageUpdateAvailableRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...GetInstalledPackageUpdateAvailableResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
+export const KappControllerPackagesServiceExportInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "ExportInstalledPackage",
+  service: KappControllerPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ExportInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ExportInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const KappControllerPackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "DeleteInstalledPackage",
   service: KappControllerPackagesServiceDesc,
@@ -699,6 +1164,73 @@ export const KappControllerPackagesServiceDeleteInstalledPackageDesc: UnaryMetho
   } as any,
 };
 
+export const KappControllerPackagesServiceDeleteInstalledPackagesBatchDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "DeleteInstalledPackagesBatch",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return DeleteInstalledPackagesBatchRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...DeleteInstalledPackagesBatchResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
+export const KappControllerPackagesServiceGetRecentActivityDesc: UnaryMethodDefinitionish = {
+  methodName: "GetRecentActivity",
+  service: KappControllerPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetRecentActivityRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetRecentActivityResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const KappControllerPackagesServiceGetUserPermissionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetUserPermissions",
+  service: KappControllerPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetUserPermissionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetUserPermissionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
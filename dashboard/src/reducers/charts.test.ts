@@ -14,6 +14,10 @@ describe("chartReducer", () => {
     iconUrl: "",
     latestVersion: { appVersion: "v1.0.0", pkgVersion: "" },
     shortDescription: "",
+    installedCount: 0,
+    localizedDescriptions: [],
+    deprecated: false,
+    deprecationMessage: "",
     availablePackageRef: {
       identifier: "foo/foo",
       context: { cluster: "", namespace: "chart-namespace" } as Context,
@@ -28,6 +32,10 @@ describe("chartReducer", () => {
     iconUrl: "",
     latestVersion: { appVersion: "v2.0.0", pkgVersion: "" },
     shortDescription: "",
+    installedCount: 0,
+    localizedDescriptions: [],
+    deprecated: false,
+    deprecationMessage: "",
     availablePackageRef: {
       identifier: "bar/bar",
       context: { cluster: "", namespace: "chart-namespace" } as Context,
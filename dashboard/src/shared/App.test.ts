@@ -15,6 +15,9 @@ const availablePackageDetail: AvailablePackageDetail = {
   homeUrl: "",
   iconUrl: "",
   longDescription: "bla bla",
+  localizedDescriptions: [],
+  deprecated: false,
+  deprecationMessage: "",
   maintainers: [],
   readme: "",
   repoUrl: "http://example.com/charts",
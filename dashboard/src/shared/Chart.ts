@@ -24,6 +24,7 @@ export default class Chart {
         repositories: repos.split(","),
       },
       paginationOptions: { pageSize: size, pageToken: page.toString() },
+      includeInstalledCount: false,
     });
   }
 
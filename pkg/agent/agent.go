@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kubeapps/kubeapps/pkg/chart/helm3to2"
 	"github.com/kubeapps/kubeapps/pkg/proxy"
@@ -68,16 +69,15 @@ func ListReleases(actionConfig *action.Configuration, namespace string, listLimi
 	return appOverviews, nil
 }
 
-// CreateRelease creates a release.
-func CreateRelease(actionConfig *action.Configuration, name, namespace, valueString string, ch *chart.Chart, registrySecrets map[string]string) (*release.Release, error) {
+// CreateRelease creates a release. A positive timeout is used as the timeout
+// of the install operations, as with the helm --timeout flag.
+func CreateRelease(actionConfig *action.Configuration, name, namespace, valueString string, ch *chart.Chart, registrySecrets map[string]string, timeout time.Duration) (*release.Release, error) {
 	// Check if the release already exists
 	_, err := GetRelease(actionConfig, name)
 	if err == nil {
 		return nil, fmt.Errorf("release %s already exists", name)
 	}
-	cmd := action.NewInstall(actionConfig)
-	cmd.ReleaseName = name
-	cmd.Namespace = namespace
+	cmd := newInstallAction(actionConfig, name, namespace, timeout)
 	cmd.PostRenderer, err = NewDockerSecretsPostRenderer(registrySecrets)
 	if err != nil {
 		return nil, err
@@ -98,6 +98,19 @@ func CreateRelease(actionConfig *action.Configuration, name, namespace, valueStr
 	return release, nil
 }
 
+// newInstallAction returns the action installing the release. As with the
+// helm --timeout flag, the timeout, if any, only bounds the individual
+// Kubernetes operations, such as the hooks, without waiting for the release.
+func newInstallAction(actionConfig *action.Configuration, name, namespace string, timeout time.Duration) *action.Install {
+	cmd := action.NewInstall(actionConfig)
+	cmd.ReleaseName = name
+	cmd.Namespace = namespace
+	if timeout > 0 {
+		cmd.Timeout = timeout
+	}
+	return cmd
+}
+
 // UpgradeRelease upgrades a release.
 func UpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string, ch *chart.Chart, registrySecrets map[string]string) (*release.Release, error) {
 	// Check if the release already exists:
@@ -123,6 +136,36 @@ func UpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string,
 	return res, nil
 }
 
+// RenderRelease renders the manifests, including the hooks, of a release of
+// the chart with the given values, without touching the cluster.
+func RenderRelease(name, namespace, valuesYaml string, ch *chart.Chart, registrySecrets map[string]string) (string, error) {
+	cmd := action.NewInstall(&action.Configuration{Log: log.Infof})
+	cmd.ReleaseName = name
+	cmd.Namespace = namespace
+	cmd.DryRun = true
+	cmd.ClientOnly = true
+	cmd.Replace = true
+	var err error
+	cmd.PostRenderer, err = NewDockerSecretsPostRenderer(registrySecrets)
+	if err != nil {
+		return "", err
+	}
+	values, err := chartutil.ReadValues([]byte(valuesYaml))
+	if err != nil {
+		return "", fmt.Errorf("Unable to render the release because values could not be parsed: %v", err)
+	}
+	rel, err := cmd.Run(ch, values)
+	if err != nil {
+		return "", fmt.Errorf("Unable to render the release: %v", err)
+	}
+	var manifest strings.Builder
+	manifest.WriteString(rel.Manifest)
+	for _, hook := range rel.Hooks {
+		fmt.Fprintf(&manifest, "---\n# Source: %s\n%s\n", hook.Path, hook.Manifest)
+	}
+	return manifest.String(), nil
+}
+
 // RollbackRelease rolls back a release to the specified revision.
 func RollbackRelease(actionConfig *action.Configuration, releaseName string, revision int) (*release.Release, error) {
 	log.Printf("Rolling back %s to revision %d.", releaseName, revision)
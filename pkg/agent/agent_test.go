@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"sort"
 	"testing"
+	"time"
 
 	kubechart "github.com/kubeapps/kubeapps/pkg/chart"
 	chartFake "github.com/kubeapps/kubeapps/pkg/chart/fake"
@@ -194,7 +195,7 @@ func TestCreateReleases(t *testing.T) {
 				ChartName: tc.chartName,
 			}, "")
 			// Perform test
-			rls, err := CreateRelease(actionConfig, tc.chartName, tc.namespace, tc.values, ch, nil)
+			rls, err := CreateRelease(actionConfig, tc.chartName, tc.namespace, tc.values, ch, nil, 0)
 			// Check result
 			if tc.shouldFail && err == nil {
 				t.Errorf("Should fail with %v; instead got %s in %s", tc.desc, tc.releaseName, tc.namespace)
@@ -213,6 +214,42 @@ func TestCreateReleases(t *testing.T) {
 	}
 }
 
+func TestNewInstallAction(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		timeout time.Duration
+	}{
+		{
+			desc: "install without a timeout",
+		},
+		{
+			desc:    "install with a timeout without waiting for the release",
+			timeout: 5 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cmd := newInstallAction(newActionConfigFixture(t), "mychart", "default", tc.timeout)
+
+			if got, want := cmd.ReleaseName, "mychart"; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := cmd.Namespace, "default"; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if cmd.Wait || cmd.Atomic {
+				t.Errorf("got wait: %t, atomic: %t, want: neither", cmd.Wait, cmd.Atomic)
+			}
+			if tc.timeout > 0 {
+				if got, want := cmd.Timeout, tc.timeout; got != want {
+					t.Errorf("got timeout: %s, want: %s", got, want)
+				}
+			}
+		})
+	}
+}
+
 func TestListReleases(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -710,6 +747,74 @@ func TestUpgradeRelease(t *testing.T) {
 	}
 }
 
+func TestRenderRelease(t *testing.T) {
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{
+			APIVersion: chart.APIVersionV2,
+			Name:       "mychart",
+			Version:    "1.0.0",
+		},
+		Templates: []*chart.File{
+			{
+				Name: "templates/configmap.yaml",
+				Data: []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+data:
+  greeting: {{ required "a greeting is required" .Values.greeting }}
+`),
+			},
+		},
+	}
+
+	testCases := []struct {
+		description      string
+		valuesYaml       string
+		expectedManifest string
+		shouldFail       bool
+	}{
+		{
+			description: "render a release with values",
+			valuesYaml:  "greeting: hello",
+			expectedManifest: `---
+# Source: mychart/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: myrls
+  namespace: dev
+data:
+  greeting: hello
+`,
+		},
+		{
+			description: "render a release with invalid values",
+			valuesYaml:  "\\-xx-@myval:\"test value\"\\\n", // ← invalid yaml
+			shouldFail:  true,
+		},
+		{
+			description: "render a release with values failing the templates",
+			valuesYaml:  "other: value",
+			shouldFail:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			manifest, err := RenderRelease("myrls", "dev", tc.valuesYaml, ch, nil)
+			if got, want := err != nil, tc.shouldFail; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+
+			if got, want := manifest, tc.expectedManifest; got != want {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
 func TestNewConfigFlagsFromCluster(t *testing.T) {
 	testCases := []struct {
 		name   string
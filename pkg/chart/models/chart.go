@@ -75,11 +75,12 @@ type ChartIconString struct {
 
 // ChartVersion is a representation of a specific version of a chart
 type ChartVersion struct {
-	Version    string    `json:"version"`
-	AppVersion string    `json:"app_version"`
-	Created    time.Time `json:"created"`
-	Digest     string    `json:"digest"`
-	URLs       []string  `json:"urls"`
+	Version     string    `json:"version"`
+	AppVersion  string    `json:"app_version"`
+	KubeVersion string    `json:"kube_version"`
+	Created     time.Time `json:"created"`
+	Digest      string    `json:"digest"`
+	URLs        []string  `json:"urls"`
 	// The following three fields get set with the URL paths to the respective
 	// chart files (as opposed to the similar fields on ChartFiles which
 	// contain the actual content).
@@ -85,6 +85,11 @@ type ClusterConfig struct {
 	// if every cluster defines an APIServiceURL, we can no longer infer the cluster
 	// on which Kubeapps is installed.
 	IsKubeappsCluster bool `json:"isKubeappsCluster,omitempty"`
+
+	// DefaultNamespace is an optional per-cluster configuration specifying
+	// the namespace used for the requests to this cluster which don't
+	// include a namespace.
+	DefaultNamespace string `json:"defaultNamespace,omitempty"`
 }
 
 // PinnipedConciergeConfig enables each cluster configuration to specify the